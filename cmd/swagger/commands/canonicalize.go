@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/generator"
+)
+
+// CanonicalizeSpec is a command that rewrites a swagger spec in its canonical form,
+// so that its diffs only show the changes of the api
+type CanonicalizeSpec struct {
+	Compact bool           `long:"compact" description:"when present, doesn't prettify the json"`
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write to, as yaml when it ends with .yml or .yaml and as json otherwise (default: stdout, in the format of the spec)"`
+	Check   bool           `long:"check" description:"write nothing, fail when the spec isn't in its canonical form already"`
+}
+
+// NotCanonicalError is returned by the check of a spec which isn't in its canonical form.
+// The swagger command exits with 1 for it.
+type NotCanonicalError struct {
+	Spec string
+}
+
+func (e *NotCanonicalError) Error() string {
+	return fmt.Sprintf("the spec at %q isn't in its canonical form, rewrite it with swagger canonicalize", e.Spec)
+}
+
+// Execute canonicalizes the spec
+func (c *CanonicalizeSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The canonicalize command requires the swagger document url to be specified")
+	}
+
+	specPath := args[0]
+	specDoc, err := loads.Spec(specPath)
+	if err != nil {
+		return err
+	}
+	generator.CanonicalizeSpec(specDoc.Spec())
+
+	output := string(c.Output)
+	if c.Check {
+		output = ""
+	}
+	asYAML := isYAMLPath(output) || output == "" && isYAMLPath(specPath)
+	b, err := generator.MarshalCanonical(specDoc.Spec(), asYAML, c.Compact)
+	if err != nil {
+		return err
+	}
+	b = bytes.TrimRight(b, "\n")
+
+	if c.Check {
+		// a spec read from a url can't be rewritten, it is never canonical
+		current, err := ioutil.ReadFile(specPath)
+		if err != nil || !bytes.Equal(bytes.TrimRight(current, "\n"), b) {
+			return &NotCanonicalError{Spec: specPath}
+		}
+		return nil
+	}
+	if output == "" {
+		fmt.Println(string(b))
+		return nil
+	}
+	return ioutil.WriteFile(output, append(b, '\n'), 0644)
+}
+
+func isYAMLPath(pth string) bool {
+	return strings.HasSuffix(pth, ".yml") || strings.HasSuffix(pth, ".yaml")
+}
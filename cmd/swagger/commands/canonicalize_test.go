@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeSpec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "canonicalize")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	source := "../../../fixtures/codegen/canonical.yml"
+	err = (&CanonicalizeSpec{Check: true}).Execute([]string{source})
+	assert.IsType(t, &NotCanonicalError{}, err)
+
+	for _, name := range []string{"swagger.yml", "swagger.json"} {
+		output := filepath.Join(dir, name)
+		require.NoError(t, (&CanonicalizeSpec{Output: flags.Filename(output)}).Execute([]string{source}))
+		// a canonical spec is its own canonical form
+		assert.NoError(t, (&CanonicalizeSpec{Check: true}).Execute([]string{output}), name)
+	}
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v2"
+)
+
+// ConvertSpec is a command that converts a swagger spec to another specification
+type ConvertSpec struct {
+	Target  string         `long:"target" description:"the specification to convert to" default:"openapi3" choice:"openapi3"`
+	Compact bool           `long:"compact" description:"when present, doesn't prettify the json"`
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write to, as yaml when it ends with .yml or .yaml and as json otherwise"`
+}
+
+// Execute converts the spec
+func (c *ConvertSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The convert command requires the swagger document url to be specified")
+	}
+
+	specDoc, err := loads.Spec(args[0])
+	if err != nil {
+		return err
+	}
+
+	converted, warnings := ConvertToOpenAPI3(specDoc.Spec())
+	for _, warning := range warnings {
+		log.Println("warning:", warning)
+	}
+
+	output := string(c.Output)
+	var b []byte
+	switch {
+	case strings.HasSuffix(output, ".yml") || strings.HasSuffix(output, ".yaml"):
+		b, err = yaml.Marshal(swag.ToDynamicJSON(converted))
+	case c.Compact:
+		b, err = json.Marshal(converted)
+	default:
+		b, err = json.MarshalIndent(converted, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Println(string(b))
+		return nil
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
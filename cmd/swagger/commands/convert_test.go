@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/importcmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const convertedSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "pets", "version": "1.0"},
+  "host": "petstore.example.com",
+  "basePath": "/v1",
+  "schemes": ["https", "http"],
+  "consumes": ["application/json"],
+  "produces": ["application/json", "application/xml"],
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "listPets",
+        "parameters": [
+          {"name": "tags", "in": "query", "type": "array", "items": {"type": "string"}, "collectionFormat": "multi"},
+          {"$ref": "#/parameters/limit"}
+        ],
+        "responses": {
+          "200": {
+            "description": "the pets",
+            "headers": {"X-Next": {"type": "string"}},
+            "schema": {"type": "array", "items": {"$ref": "#/definitions/Pet"}}
+          },
+          "default": {"$ref": "#/responses/error"}
+        }
+      },
+      "post": {
+        "operationId": "createPet",
+        "parameters": [{"$ref": "#/parameters/pet"}],
+        "responses": {"201": {"description": "created"}}
+      }
+    },
+    "/pets/{id}/photo": {
+      "parameters": [{"name": "id", "in": "path", "required": true, "type": "integer", "format": "int64"}],
+      "put": {
+        "operationId": "uploadPhoto",
+        "consumes": ["multipart/form-data"],
+        "parameters": [
+          {"name": "photo", "in": "formData", "type": "file", "required": true},
+          {"name": "caption", "in": "formData", "type": "string"}
+        ],
+        "responses": {"204": {"description": "uploaded"}}
+      }
+    }
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "discriminator": "kind",
+      "required": ["kind"],
+      "properties": {
+        "kind": {"type": "string"},
+        "nickname": {"type": "string", "x-nullable": true}
+      }
+    },
+    "Error": {"type": "object", "properties": {"message": {"type": "string"}}}
+  },
+  "parameters": {
+    "limit": {"name": "limit", "in": "query", "type": "integer", "maximum": 100},
+    "pet": {"name": "pet", "in": "body", "required": true, "schema": {"$ref": "#/definitions/Pet"}}
+  },
+  "responses": {
+    "error": {"description": "an error", "schema": {"$ref": "#/definitions/Error"}}
+  },
+  "securityDefinitions": {
+    "oauth": {"type": "oauth2", "flow": "application", "tokenUrl": "https://petstore.example.com/token", "scopes": {"read": "read the pets"}}
+  }
+}`
+
+func TestConvertToOpenAPI3(t *testing.T) {
+	specDoc, err := loads.Analyzed(json.RawMessage(convertedSpec), "")
+	require.NoError(t, err)
+
+	converted, warnings := ConvertToOpenAPI3(specDoc.Spec())
+	assert.Empty(t, warnings)
+	b, err := json.Marshal(converted)
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &doc))
+
+	assert.Equal(t, "3.0.3", doc["openapi"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"url": "https://petstore.example.com/v1"},
+		map[string]interface{}{"url": "http://petstore.example.com/v1"},
+	}, doc["servers"])
+
+	get := lookup(t, doc, "/paths/~1pets/get")
+	assert.Equal(t, map[string]interface{}{
+		"name":    "tags",
+		"in":      "query",
+		"style":   "form",
+		"explode": true,
+		"schema":  map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+	}, lookup(t, get, "/parameters/0"))
+	assert.Equal(t, "#/components/parameters/limit", lookup(t, get, "/parameters/1/$ref"))
+	assert.Equal(t, "#/components/schemas/Pet", lookup(t, get, "/responses/200/content/application~1xml/schema/items/$ref"))
+	assert.Equal(t, "string", lookup(t, get, "/responses/200/headers/X-Next/schema/type"))
+	assert.Equal(t, "#/components/responses/error", lookup(t, get, "/responses/default/$ref"))
+
+	assert.Equal(t, "#/components/requestBodies/pet", lookup(t, doc, "/paths/~1pets/post/requestBody/$ref"))
+	assert.Equal(t, true, lookup(t, doc, "/components/requestBodies/pet/required"))
+	assert.Equal(t, "pet", lookup(t, doc, "/components/requestBodies/pet/x-codegen-request-body-name"))
+
+	form := lookup(t, doc, "/paths/~1pets~1{id}~1photo/put/requestBody/content/multipart~1form-data/schema")
+	assert.Equal(t, "binary", lookup(t, form, "/properties/photo/format"))
+	assert.Equal(t, []interface{}{"photo"}, lookup(t, form, "/required"))
+
+	assert.Equal(t, map[string]interface{}{"propertyName": "kind"}, lookup(t, doc, "/components/schemas/Pet/discriminator"))
+	assert.Equal(t, true, lookup(t, doc, "/components/schemas/Pet/properties/nickname/nullable"))
+	assert.Equal(t, "https://petstore.example.com/token", lookup(t, doc, "/components/securitySchemes/oauth/flows/clientCredentials/tokenUrl"))
+
+	// converting back gives the same api
+	downgraded, warnings, err := importcmd.DowngradeOpenAPI3(doc)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	b, err = json.Marshal(downgraded)
+	require.NoError(t, err)
+	back, err := loads.Analyzed(b, "")
+	require.NoError(t, err)
+	sw := back.Spec()
+	assert.Equal(t, "petstore.example.com", sw.Host)
+	assert.Equal(t, "/v1", sw.BasePath)
+	assert.Equal(t, []string{"https", "http"}, sw.Schemes)
+	create := sw.Paths.Paths["/pets"].Post
+	require.Len(t, create.Parameters, 1)
+	assert.Equal(t, "pet", create.Parameters[0].Name)
+	assert.Equal(t, "#/definitions/Pet", create.Parameters[0].Schema.Ref.String())
+	upload := sw.Paths.Paths["/pets/{id}/photo"].Put
+	require.Len(t, upload.Parameters, 2)
+	assert.Equal(t, "file", upload.Parameters[1].Type)
+}
+
+func TestConvertToOpenAPI3_Warnings(t *testing.T) {
+	specDoc, err := loads.Analyzed(json.RawMessage(`{
+  "swagger": "2.0",
+  "info": {"title": "pets", "version": "1.0"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "schemes": ["wss"],
+        "parameters": [{"name": "tags", "in": "query", "type": "array", "items": {"type": "string"}, "collectionFormat": "tsv"}],
+        "responses": {"200": {"description": "the pets", "examples": {"text/plain": "rex"}}}
+      }
+    }
+  }
+}`), "")
+	require.NoError(t, err)
+
+	_, warnings := ConvertToOpenAPI3(specDoc.Spec())
+	assert.Equal(t, []string{
+		"#/paths/~1pets/get/parameters/0/collectionFormat: the tsv collection format can't be converted, it is dropped",
+		"#/paths/~1pets/get/responses/200/examples/text~1plain: the operation doesn't produce text/plain, the example is dropped",
+		"#/paths/~1pets/get/schemes: the schemes of an operation can't be converted, they are dropped",
+	}, warnings)
+}
+
+func lookup(t *testing.T, doc interface{}, pointer string) interface{} {
+	ptr, err := jsonpointer.New(pointer)
+	require.NoError(t, err)
+	value, _, err := ptr.Get(doc)
+	require.NoError(t, err, pointer)
+	return value
+}
@@ -0,0 +1,634 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+const (
+	openAPI3Version = "3.0.3"
+	defaultMedia    = "application/json"
+	formMedia       = "application/x-www-form-urlencoded"
+	multipartMedia  = "multipart/form-data"
+)
+
+// ConvertToOpenAPI3 converts a swagger 2.0 spec to an OpenAPI 3.0 spec.
+//
+// The definitions, parameters, responses and security definitions become components, the body and form data
+// parameters become request bodies, and the host, base path and schemes become servers.
+// The media types an operation consumes and produces become the content of its request body and responses.
+// What can't be converted is dropped, with a warning that tells where it was found.
+func ConvertToOpenAPI3(sw *spec.Swagger) (map[string]interface{}, []string) {
+	c := &openAPI3Converter{sw: sw}
+	result := map[string]interface{}{"openapi": openAPI3Version}
+
+	if sw.Info != nil {
+		result["info"] = sw.Info
+	}
+	if servers := c.servers(); len(servers) > 0 {
+		result["servers"] = servers
+	}
+	if len(sw.Tags) > 0 {
+		result["tags"] = sw.Tags
+	}
+	if sw.ExternalDocs != nil {
+		result["externalDocs"] = sw.ExternalDocs
+	}
+	if len(sw.Security) > 0 {
+		result["security"] = sw.Security
+	}
+	for key, value := range sw.Extensions {
+		result[key] = value
+	}
+
+	paths := make(map[string]interface{})
+	if sw.Paths != nil {
+		for pth, item := range sw.Paths.Paths {
+			paths[pth] = c.pathItem("#/paths/"+jsonpointer.Escape(pth), item)
+		}
+		for key, value := range sw.Paths.Extensions {
+			paths[key] = value
+		}
+	}
+	result["paths"] = paths
+
+	if components := c.components(); len(components) > 0 {
+		result["components"] = components
+	}
+	sort.Strings(c.warnings)
+	return result, c.warnings
+}
+
+type openAPI3Converter struct {
+	sw       *spec.Swagger
+	warnings []string
+}
+
+func (c *openAPI3Converter) warn(location, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, args...)))
+}
+
+// servers are the urls of the api for each of its schemes
+func (c *openAPI3Converter) servers() []interface{} {
+	if c.sw.Host == "" && c.sw.BasePath == "" {
+		return nil
+	}
+	if c.sw.Host == "" {
+		return []interface{}{map[string]interface{}{"url": c.sw.BasePath}}
+	}
+	if len(c.sw.Schemes) == 0 {
+		// the scheme the spec is served with
+		return []interface{}{map[string]interface{}{"url": "//" + c.sw.Host + c.sw.BasePath}}
+	}
+	servers := make([]interface{}, 0, len(c.sw.Schemes))
+	for _, scheme := range c.sw.Schemes {
+		servers = append(servers, map[string]interface{}{"url": scheme + "://" + c.sw.Host + c.sw.BasePath})
+	}
+	return servers
+}
+
+func (c *openAPI3Converter) components() map[string]interface{} {
+	components := make(map[string]interface{})
+
+	if len(c.sw.Definitions) > 0 {
+		schemas := make(map[string]interface{}, len(c.sw.Definitions))
+		for name, schema := range c.sw.Definitions {
+			schemas[name] = c.schema("#/definitions/"+jsonpointer.Escape(name), schema)
+		}
+		components["schemas"] = schemas
+	}
+
+	parameters := make(map[string]interface{})
+	requestBodies := make(map[string]interface{})
+	for name, param := range c.sw.Parameters {
+		at := "#/parameters/" + jsonpointer.Escape(name)
+		switch param.In {
+		case "body":
+			requestBodies[name] = c.requestBody(at, []spec.Parameter{param}, c.sw.Consumes)
+		case "formData":
+			// the form data parameters are merged in the request body of the operations using them
+		default:
+			parameters[name] = c.parameter(at, param)
+		}
+	}
+	if len(parameters) > 0 {
+		components["parameters"] = parameters
+	}
+	if len(requestBodies) > 0 {
+		components["requestBodies"] = requestBodies
+	}
+
+	if len(c.sw.Responses) > 0 {
+		responses := make(map[string]interface{}, len(c.sw.Responses))
+		for name, response := range c.sw.Responses {
+			responses[name] = c.response("#/responses/"+jsonpointer.Escape(name), response, c.sw.Produces)
+		}
+		components["responses"] = responses
+	}
+
+	if len(c.sw.SecurityDefinitions) > 0 {
+		schemes := make(map[string]interface{}, len(c.sw.SecurityDefinitions))
+		for name, scheme := range c.sw.SecurityDefinitions {
+			schemes[name] = securityScheme(scheme)
+		}
+		components["securitySchemes"] = schemes
+	}
+	return components
+}
+
+func securityScheme(scheme *spec.SecurityScheme) map[string]interface{} {
+	result := make(map[string]interface{})
+	if scheme.Description != "" {
+		result["description"] = scheme.Description
+	}
+	switch scheme.Type {
+	case "basic":
+		result["type"] = "http"
+		result["scheme"] = "basic"
+	case "apiKey":
+		result["type"] = "apiKey"
+		result["name"] = scheme.Name
+		result["in"] = scheme.In
+	case "oauth2":
+		flow := map[string]interface{}{"scopes": scheme.Scopes}
+		if scheme.Scopes == nil {
+			flow["scopes"] = map[string]string{}
+		}
+		if scheme.AuthorizationURL != "" {
+			flow["authorizationUrl"] = scheme.AuthorizationURL
+		}
+		if scheme.TokenURL != "" {
+			flow["tokenUrl"] = scheme.TokenURL
+		}
+		name := map[string]string{
+			"implicit":    "implicit",
+			"password":    "password",
+			"application": "clientCredentials",
+			"accessCode":  "authorizationCode",
+		}[scheme.Flow]
+		result["type"] = "oauth2"
+		result["flows"] = map[string]interface{}{name: flow}
+	}
+	for key, value := range scheme.Extensions {
+		result[key] = value
+	}
+	return result
+}
+
+func (c *openAPI3Converter) pathItem(location string, item spec.PathItem) map[string]interface{} {
+	result := make(map[string]interface{})
+	if item.Ref.String() != "" {
+		result["$ref"] = item.Ref.String()
+	}
+	for key, value := range item.Extensions {
+		result[key] = value
+	}
+
+	// the body and form data parameters of the path item become the request body of its operations
+	var shared, payload []spec.Parameter
+	for _, param := range item.Parameters {
+		if in := c.resolveParameter(param).In; in == "body" || in == "formData" {
+			payload = append(payload, param)
+			continue
+		}
+		shared = append(shared, param)
+	}
+	if len(shared) > 0 {
+		result["parameters"] = c.parameters(location+"/parameters", shared)
+	}
+
+	for method, operation := range map[string]*spec.Operation{
+		"get":     item.Get,
+		"put":     item.Put,
+		"post":    item.Post,
+		"delete":  item.Delete,
+		"options": item.Options,
+		"head":    item.Head,
+		"patch":   item.Patch,
+	} {
+		if operation != nil {
+			result[method] = c.operation(location+"/"+method, operation, payload)
+		}
+	}
+	return result
+}
+
+func (c *openAPI3Converter) operation(location string, operation *spec.Operation, inherited []spec.Parameter) map[string]interface{} {
+	result := make(map[string]interface{})
+	if len(operation.Tags) > 0 {
+		result["tags"] = operation.Tags
+	}
+	if operation.Summary != "" {
+		result["summary"] = operation.Summary
+	}
+	if operation.Description != "" {
+		result["description"] = operation.Description
+	}
+	if operation.ExternalDocs != nil {
+		result["externalDocs"] = operation.ExternalDocs
+	}
+	if operation.ID != "" {
+		result["operationId"] = operation.ID
+	}
+	if operation.Deprecated {
+		result["deprecated"] = true
+	}
+	if operation.Security != nil {
+		result["security"] = operation.Security
+	}
+	if len(operation.Schemes) > 0 {
+		c.warn(location+"/schemes", "the schemes of an operation can't be converted, they are dropped")
+	}
+	for key, value := range operation.Extensions {
+		result[key] = value
+	}
+
+	consumes := operation.Consumes
+	if len(consumes) == 0 {
+		consumes = c.sw.Consumes
+	}
+	produces := operation.Produces
+	if len(produces) == 0 {
+		produces = c.sw.Produces
+	}
+
+	var params, payload []spec.Parameter
+	for _, param := range operation.Parameters {
+		if in := c.resolveParameter(param).In; in == "body" || in == "formData" {
+			payload = append(payload, param)
+			continue
+		}
+		params = append(params, param)
+	}
+	if len(payload) == 0 {
+		payload = inherited
+	}
+	if len(params) > 0 {
+		result["parameters"] = c.parameters(location+"/parameters", params)
+	}
+	if len(payload) > 0 {
+		result["requestBody"] = c.operationRequestBody(location+"/parameters", payload, consumes, operation.Consumes)
+	}
+
+	responses := make(map[string]interface{})
+	if operation.Responses != nil {
+		if operation.Responses.Default != nil {
+			responses["default"] = c.response(location+"/responses/default", *operation.Responses.Default, produces)
+		}
+		for code, response := range operation.Responses.StatusCodeResponses {
+			responses[fmt.Sprintf("%d", code)] = c.response(fmt.Sprintf("%s/responses/%d", location, code), response, produces)
+		}
+		for key, value := range operation.Responses.Extensions {
+			responses[key] = value
+		}
+	}
+	result["responses"] = responses
+	return result
+}
+
+// resolveParameter finds the parameter a $ref points to among the parameters of the spec
+func (c *openAPI3Converter) resolveParameter(param spec.Parameter) spec.Parameter {
+	if resolved, ok := c.parameterRef(param.Ref.String()); ok {
+		return resolved
+	}
+	return param
+}
+
+func (c *openAPI3Converter) parameterRef(ref string) (spec.Parameter, bool) {
+	if !strings.HasPrefix(ref, "#/parameters/") {
+		return spec.Parameter{}, false
+	}
+	name, err := jsonpointer.New(strings.TrimPrefix(ref, "#/parameters"))
+	if err != nil || len(name.DecodedTokens()) != 1 {
+		return spec.Parameter{}, false
+	}
+	param, ok := c.sw.Parameters[name.DecodedTokens()[0]]
+	return param, ok
+}
+
+func (c *openAPI3Converter) parameters(location string, params []spec.Parameter) []interface{} {
+	result := make([]interface{}, 0, len(params))
+	for i, param := range params {
+		if ref := param.Ref.String(); ref != "" {
+			result = append(result, map[string]interface{}{"$ref": c.ref(ref)})
+			continue
+		}
+		result = append(result, c.parameter(fmt.Sprintf("%s/%d", location, i), param))
+	}
+	return result
+}
+
+// parameter converts a non-body parameter, its type and validations become its schema
+func (c *openAPI3Converter) parameter(location string, param spec.Parameter) map[string]interface{} {
+	result := map[string]interface{}{
+		"name": param.Name,
+		"in":   param.In,
+	}
+	if param.Description != "" {
+		result["description"] = param.Description
+	}
+	if param.Required || param.In == "path" {
+		result["required"] = true
+	}
+	if param.AllowEmptyValue {
+		result["allowEmptyValue"] = true
+	}
+	for key, value := range param.Extensions {
+		result[key] = value
+	}
+	result["schema"] = c.simpleSchema(location, param.CommonValidations, param.SimpleSchema)
+
+	if param.Type == "array" {
+		switch param.CollectionFormat {
+		case "", "csv":
+			if param.In == "query" {
+				result["style"] = "form"
+				result["explode"] = false
+			}
+		case "multi":
+			result["style"] = "form"
+			result["explode"] = true
+		case "ssv":
+			result["style"] = "spaceDelimited"
+		case "pipes":
+			result["style"] = "pipeDelimited"
+		default:
+			c.warn(location+"/collectionFormat", "the %s collection format can't be converted, it is dropped", param.CollectionFormat)
+		}
+	}
+	return result
+}
+
+// simpleSchema converts the type and validations of a non-body parameter, a header or items to a schema
+func (c *openAPI3Converter) simpleSchema(location string, validations spec.CommonValidations, simple spec.SimpleSchema) map[string]interface{} {
+	result := make(map[string]interface{})
+	if b, err := json.Marshal(validations); err == nil {
+		_ = json.Unmarshal(b, &result)
+	}
+	result["type"] = simple.Type
+	if simple.Format != "" {
+		result["format"] = simple.Format
+	}
+	if simple.Default != nil {
+		result["default"] = simple.Default
+	}
+	if simple.Example != nil {
+		result["example"] = simple.Example
+	}
+	if simple.Type == "file" {
+		result["type"] = "string"
+		result["format"] = "binary"
+	}
+	if simple.Items != nil {
+		result["items"] = c.simpleSchema(location+"/items", simple.Items.CommonValidations, simple.Items.SimpleSchema)
+		if simple.Items.CollectionFormat != "" && simple.Items.CollectionFormat != "csv" {
+			c.warn(location+"/items/collectionFormat", "the collection format of nested arrays can't be converted, it is dropped")
+		}
+	}
+	return result
+}
+
+// operationRequestBody converts the body or form data parameters of an operation.
+// A body parameter of the spec is referred to when the operation consumes the media types of the spec.
+func (c *openAPI3Converter) operationRequestBody(location string, params []spec.Parameter, consumes, own []string) map[string]interface{} {
+	if len(params) == 1 && params[0].Ref.String() != "" && c.resolveParameter(params[0]).In == "body" && len(own) == 0 {
+		return map[string]interface{}{"$ref": c.ref(params[0].Ref.String())}
+	}
+	resolved := make([]spec.Parameter, 0, len(params))
+	for _, param := range params {
+		resolved = append(resolved, c.resolveParameter(param))
+	}
+	return c.requestBody(location, resolved, consumes)
+}
+
+// requestBody converts a body parameter, or form data parameters, to a request body for some media types
+func (c *openAPI3Converter) requestBody(location string, params []spec.Parameter, consumes []string) map[string]interface{} {
+	result := make(map[string]interface{})
+	content := make(map[string]interface{})
+
+	if len(params) == 1 && params[0].In == "body" {
+		body := params[0]
+		if body.Description != "" {
+			result["description"] = body.Description
+		}
+		if body.Required {
+			result["required"] = true
+		}
+		if body.Name != "" && body.Name != "body" {
+			// the name of the body parameter is kept for the generators using it
+			result["x-codegen-request-body-name"] = body.Name
+		}
+		var schema interface{} = map[string]interface{}{}
+		if body.Schema != nil {
+			schema = c.schema(location+"/schema", *body.Schema)
+		}
+		if len(consumes) == 0 {
+			consumes = []string{defaultMedia}
+		}
+		for _, mediaType := range consumes {
+			content[mediaType] = map[string]interface{}{"schema": schema}
+		}
+		result["content"] = content
+		return result
+	}
+
+	properties := make(map[string]interface{})
+	var required []string
+	var files bool
+	for i, param := range params {
+		at := fmt.Sprintf("%s/%d", location, i)
+		property := c.simpleSchema(at, param.CommonValidations, param.SimpleSchema)
+		if param.Description != "" {
+			property["description"] = param.Description
+		}
+		properties[param.Name] = property
+		if param.Required {
+			required = append(required, param.Name)
+			result["required"] = true
+		}
+		files = files || param.Type == "file"
+	}
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	var forms []string
+	for _, mediaType := range consumes {
+		if mediaType == formMedia || mediaType == multipartMedia {
+			forms = append(forms, mediaType)
+		}
+	}
+	if len(forms) == 0 {
+		forms = []string{formMedia}
+		if files {
+			forms = []string{multipartMedia}
+		}
+	}
+	for _, mediaType := range forms {
+		content[mediaType] = map[string]interface{}{"schema": schema}
+	}
+	result["content"] = content
+	return result
+}
+
+// response converts a response, its schema becomes the content for the media types the operation produces
+func (c *openAPI3Converter) response(location string, response spec.Response, produces []string) map[string]interface{} {
+	if ref := response.Ref.String(); ref != "" {
+		return map[string]interface{}{"$ref": c.ref(ref)}
+	}
+	result := map[string]interface{}{"description": response.Description}
+	for key, value := range response.Extensions {
+		result[key] = value
+	}
+
+	if len(response.Headers) > 0 {
+		headers := make(map[string]interface{}, len(response.Headers))
+		for name, header := range response.Headers {
+			at := location + "/headers/" + jsonpointer.Escape(name)
+			converted := map[string]interface{}{"schema": c.simpleSchema(at, header.CommonValidations, header.SimpleSchema)}
+			if header.Description != "" {
+				converted["description"] = header.Description
+			}
+			headers[name] = converted
+		}
+		result["headers"] = headers
+	}
+
+	if response.Schema == nil && len(response.Examples) == 0 {
+		return result
+	}
+	if len(produces) == 0 {
+		produces = []string{defaultMedia}
+	}
+	content := make(map[string]interface{}, len(produces))
+	for _, mediaType := range produces {
+		media := make(map[string]interface{})
+		if response.Schema != nil {
+			media["schema"] = c.schema(location+"/schema", *response.Schema)
+		}
+		if example, ok := response.Examples[mediaType]; ok {
+			media["example"] = example
+		}
+		content[mediaType] = media
+	}
+	for mediaType := range response.Examples {
+		if _, ok := content[mediaType]; !ok {
+			c.warn(location+"/examples/"+jsonpointer.Escape(mediaType), "the operation doesn't produce %s, the example is dropped", mediaType)
+		}
+	}
+	result["content"] = content
+	return result
+}
+
+// schema converts a swagger schema to an OpenAPI 3.0 schema
+func (c *openAPI3Converter) schema(location string, schema spec.Schema) map[string]interface{} {
+	var result map[string]interface{}
+	if b, err := json.Marshal(schema); err == nil {
+		_ = json.Unmarshal(b, &result)
+	}
+	return c.convertSchema(location, result)
+}
+
+func (c *openAPI3Converter) convertSchema(location string, schema map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(schema))
+	for key, value := range schema {
+		at := location + "/" + jsonpointer.Escape(key)
+		switch key {
+		case "$ref":
+			ref, _ := value.(string)
+			result[key] = c.ref(ref)
+
+		case "x-nullable", "x-isnullable":
+			if nullable, ok := value.(bool); ok && nullable {
+				result["nullable"] = true
+			}
+
+		case "x-one-of":
+			members, _ := value.([]interface{})
+			result["oneOf"] = c.convertSchemaList(at, members)
+
+		case "discriminator":
+			result[key] = map[string]interface{}{"propertyName": value}
+
+		case "type":
+			if value == "file" {
+				result["type"] = "string"
+				result["format"] = "binary"
+				continue
+			}
+			result[key] = value
+
+		case "allOf":
+			members, _ := value.([]interface{})
+			result[key] = c.convertSchemaList(at, members)
+
+		case "items":
+			switch items := value.(type) {
+			case map[string]interface{}:
+				result[key] = c.convertSchema(at, items)
+			case []interface{}:
+				c.warn(at, "tuples can't be converted, the items are the first schema of the tuple")
+				if len(items) > 0 {
+					first, _ := items[0].(map[string]interface{})
+					result[key] = c.convertSchema(at+"/0", first)
+				}
+			}
+
+		case "properties":
+			properties, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(properties))
+			for name, prop := range properties {
+				if prop, ok := prop.(map[string]interface{}); ok {
+					converted[name] = c.convertSchema(at+"/"+jsonpointer.Escape(name), prop)
+				}
+			}
+			result[key] = converted
+
+		case "additionalProperties":
+			if additional, ok := value.(map[string]interface{}); ok {
+				result[key] = c.convertSchema(at, additional)
+				continue
+			}
+			result[key] = value
+
+		case "additionalItems":
+			c.warn(at, "additionalItems can't be converted, it is dropped")
+
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func (c *openAPI3Converter) convertSchemaList(location string, members []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(members))
+	for i, member := range members {
+		if schema, ok := member.(map[string]interface{}); ok {
+			result = append(result, c.convertSchema(fmt.Sprintf("%s/%d", location, i), schema))
+		}
+	}
+	return result
+}
+
+// ref points a $ref at the OpenAPI 3.0 components
+func (c *openAPI3Converter) ref(ref string) string {
+	switch {
+	case strings.HasPrefix(ref, "#/definitions/"):
+		return "#/components/schemas/" + strings.TrimPrefix(ref, "#/definitions/")
+	case strings.HasPrefix(ref, "#/responses/"):
+		return "#/components/responses/" + strings.TrimPrefix(ref, "#/responses/")
+	case strings.HasPrefix(ref, "#/parameters/"):
+		if param, ok := c.parameterRef(ref); ok && param.In == "body" {
+			return "#/components/requestBodies/" + strings.TrimPrefix(ref, "#/parameters/")
+		}
+		return "#/components/parameters/" + strings.TrimPrefix(ref, "#/parameters/")
+	}
+	return ref
+}
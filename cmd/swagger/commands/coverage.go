@@ -0,0 +1,172 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/record"
+)
+
+// CoverageCmd is a command that reports which operations and responses of a spec were exercised,
+// from the profiles of the coverage middleware or from recorded traffic
+type CoverageCmd struct {
+	Spec      flags.Filename   `long:"spec" short:"f" required:"true" description:"the spec the coverage is reported for"`
+	Profiles  []flags.Filename `long:"profile" short:"p" description:"a profile written by the coverage middleware or by swagger record --coverage, repeat for multiple"`
+	HAR       []flags.Filename `long:"har" description:"a HAR file of the traffic to the api, repeat for multiple"`
+	HARHost   string           `long:"har-host" description:"the host of the requests of the HAR files (default: the host with the most requests)"`
+	Format    string           `long:"format" description:"the format of the report" default:"text" choice:"text" choice:"json"`
+	FailUnder float64          `long:"fail-under" description:"fail when less than this percentage of the operations were called"`
+}
+
+// CoverageError is returned when fewer operations than required were called, once the coverage is reported.
+// The swagger command exits with 1 for it.
+type CoverageError struct {
+	Tested   int
+	Total    int
+	Required float64
+}
+
+func (e *CoverageError) Error() string {
+	return fmt.Sprintf("%d of the %d operations were called (%.1f%%), less than the required %.1f%%", e.Tested, e.Total, percent(e.Tested, e.Total), e.Required)
+}
+
+// Execute reports the coverage
+func (c *CoverageCmd) Execute(args []string) error {
+	if len(c.Profiles) == 0 && len(c.HAR) == 0 {
+		return errors.New("The coverage command requires a coverage profile (--profile) or a HAR file (--har)")
+	}
+	specDoc, err := loads.Spec(string(c.Spec))
+	if err != nil {
+		return err
+	}
+	coverage, err := record.NewCoverage(specDoc)
+	if err != nil {
+		return err
+	}
+	for _, pth := range c.Profiles {
+		profile, err := record.ReadCoverageProfile(string(pth))
+		if err != nil {
+			return err
+		}
+		coverage.Add(profile)
+	}
+	for _, pth := range c.HAR {
+		if err := observeHAR(coverage, string(pth), c.HARHost); err != nil {
+			return err
+		}
+	}
+
+	report := coverage.Report()
+	if c.Format == "json" {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else {
+		printCoverage(os.Stdout, report)
+	}
+	if tested, total := report.TestedOperations(); c.FailUnder > 0 && percent(tested, total) < c.FailUnder {
+		return &CoverageError{Tested: tested, Total: total, Required: c.FailUnder}
+	}
+	return nil
+}
+
+// observeHAR counts the requests of a HAR file to a host, the ones for pages and their assets are skipped
+func observeHAR(coverage *record.Coverage, path, host string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var har harLog
+	if err := json.Unmarshal(b, &har); err != nil {
+		return fmt.Errorf("%s is not a HAR file: %v", path, err)
+	}
+
+	if host == "" {
+		host = mostRequestedHost(har.Log.Entries)
+	}
+	for _, entry := range har.Log.Entries {
+		u, err := url.Parse(entry.Request.URL)
+		if err != nil {
+			return err
+		}
+		// a status of 0 is a request which got no response
+		if u.Host != host || entry.Response.Status == 0 || harAssets.MatchString(mediaType(entry.Response.Content.MimeType)) {
+			continue
+		}
+		coverage.Record(entry.Request.Method, u.Path, entry.Response.Status)
+	}
+	return nil
+}
+
+func printCoverage(w io.Writer, report *record.CoverageReport) {
+	var untested, responses, undocumented []string
+	for _, operation := range report.Operations {
+		if operation.Calls == 0 {
+			untested = append(untested, operation.String())
+			continue
+		}
+		var statuses []string
+		for _, response := range operation.Responses {
+			if response.Calls == 0 {
+				statuses = append(statuses, response.Status)
+			}
+		}
+		if len(statuses) > 0 {
+			responses = append(responses, fmt.Sprintf("%s: %s", operation, strings.Join(statuses, ", ")))
+		}
+		for _, response := range operation.Undocumented {
+			undocumented = append(undocumented, fmt.Sprintf("%s: %s (%s)", operation, response.Status, callCount(response.Calls)))
+		}
+	}
+	var unmatched []string
+	for _, call := range report.Unmatched {
+		unmatched = append(unmatched, fmt.Sprintf("%s %s: %d (%s)", call.Method, call.Path, call.Status, callCount(call.Count)))
+	}
+	if report.Dropped > 0 {
+		unmatched = append(unmatched, fmt.Sprintf("%d more requests", report.Dropped))
+	}
+
+	printCoverageSection(w, "Untested operations :", untested)
+	printCoverageSection(w, "Untested responses :", responses)
+	printCoverageSection(w, "Undocumented status codes :", undocumented)
+	printCoverageSection(w, "Requests matching no operation :", unmatched)
+	tested, total := report.TestedOperations()
+	testedResponses, totalResponses := report.TestedResponses()
+	fmt.Fprintf(w, "%d of %d operations tested (%.1f%%), %d of %d responses (%.1f%%)\n",
+		tested, total, percent(tested, total), testedResponses, totalResponses, percent(testedResponses, totalResponses))
+}
+
+func printCoverageSection(w io.Writer, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintln(w, title)
+	for _, line := range lines {
+		fmt.Fprintf(w, "  - %s\n", line)
+	}
+}
+
+func callCount(count int) string {
+	if count == 1 {
+		return "1 call"
+	}
+	return fmt.Sprintf("%d calls", count)
+}
+
+// percent is 100 for an empty total, there is nothing left to test
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return 100 * float64(part) / float64(total)
+}
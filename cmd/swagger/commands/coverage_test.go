@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverageCmd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "coverage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	specPath := filepath.Join(dir, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(verifiedSpec), 0644))
+
+	doc, err := loads.Spec(specPath)
+	require.NoError(t, err)
+	coverage, err := record.NewCoverage(doc)
+	require.NoError(t, err)
+	require.NoError(t, observeHAR(coverage, "../../../fixtures/infer/petstore.har", ""))
+
+	var out bytes.Buffer
+	printCoverage(&out, coverage.Report())
+	assert.Equal(t, `Undocumented status codes :
+  - GET /pets/{id}: 404 (1 call)
+Requests matching no operation :
+  - POST /api/pets: 201 (1 call)
+  - POST /api/pets/3/photos/0c2b8f43-5d5a-4c8e-9bd1-7f1d8f0e6a11: 204 (1 call)
+  - GET /api/v1/status: 200 (1 call)
+2 of 2 operations tested (100.0%), 2 of 2 responses (100.0%)
+`, out.String())
+
+	profilePath := filepath.Join(dir, "coverage.json")
+	require.NoError(t, ioutil.WriteFile(profilePath, []byte(`{"calls": [{"method": "GET", "path": "/pets", "status": 200, "count": 1}]}`), 0644))
+	cmd := &CoverageCmd{
+		Spec:      flags.Filename(specPath),
+		Profiles:  []flags.Filename{flags.Filename(profilePath)},
+		Format:    "json",
+		FailUnder: 60,
+	}
+	err = cmd.Execute(nil)
+	require.Error(t, err)
+	coverageErr, ok := err.(*CoverageError)
+	require.True(t, ok)
+	assert.Equal(t, 1, coverageErr.Tested)
+	assert.Equal(t, 2, coverageErr.Total)
+
+	cmd.FailUnder = 50
+	assert.NoError(t, cmd.Execute(nil))
+	assert.Error(t, (&CoverageCmd{Spec: flags.Filename(specPath)}).Execute(nil))
+}
@@ -2,39 +2,97 @@ package commands
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/go-openapi/analysis"
-	"github.com/go-openapi/loads"
+	"github.com/go-openapi/jsonpointer"
 	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/watch"
+	"github.com/sidewalklabs/go-swagger/generator"
 )
 
 // FlattenSpec is a command that flattens a swagger document
 // Which will expand the remote references in a spec and move inline schemas to definitions
 // after flattening there are no complex inlined anymore
 type FlattenSpec struct {
-	Compact bool           `long:"compact" description:"when present, doesn't prettify the json"`
-	Output  flags.Filename `long:"output" short:"o" description:"the file to write to"`
+	Compact           bool           `long:"compact" description:"when present, doesn't prettify the json"`
+	Output            flags.Filename `long:"output" short:"o" description:"the file to write to"`
+	ExtractDuplicates bool           `long:"extract-duplicates" description:"replace the identical inline schemas of the body parameters and responses with $refs to shared definitions first"`
+	Renames           []string       `long:"rename" description:"rename a definition and update the $refs to it, as OLD=NEW, before flattening: the names may also be $refs to a parameter or a response, like #/parameters/old=#/parameters/new"`
+	Watch             bool           `long:"watch" short:"w" description:"flatten the spec again each time it or a document it includes changes"`
 }
 
 // Execute expands the spec
 func (c *FlattenSpec) Execute(args []string) error {
 	if len(args) == 0 {
-		return errors.New("The validate command requires the swagger document url to be specified")
+		return errors.New("The flatten command requires the swagger document url to be specified")
 	}
 
 	swaggerDoc := args[0]
-	specDoc, err := loads.Spec(swaggerDoc)
+	if c.Watch {
+		if c.Output == "" {
+			return errors.New("The flatten command writes to a file when it watches the spec, use --output")
+		}
+		return watch.Run(swaggerDoc, func() error {
+			if err := c.flatten(swaggerDoc); err != nil {
+				return err
+			}
+			log.Printf("flattened %s to %s", swaggerDoc, c.Output)
+			return nil
+		})
+	}
+	return c.flatten(swaggerDoc)
+}
+
+func (c *FlattenSpec) flatten(swaggerDoc string) error {
+	// the documents a multi-file spec includes are bundled first
+	specDoc, err := generator.LoadSpec(swaggerDoc)
 	if err != nil {
-		log.Fatalln(err)
+		return err
+	}
+
+	if c.ExtractDuplicates {
+		for _, duplicate := range generator.ExtractDuplicateSchemas(specDoc.Spec()) {
+			log.Printf("%s: %s", duplicate.Definition, strings.Join(duplicate.Uses, ", "))
+		}
 	}
 
-	if er := analysis.Flatten(analysis.FlattenOpts{
+	if len(c.Renames) > 0 {
+		mapping, err := renameMapping(c.Renames)
+		if err != nil {
+			return err
+		}
+		if err := generator.RewriteRefs(specDoc.Spec(), mapping); err != nil {
+			return err
+		}
+	}
+
+	if err := analysis.Flatten(analysis.FlattenOpts{
 		BasePath: specDoc.SpecFilePath(),
 		Spec:     analysis.New(specDoc.Spec()),
-	}); er != nil {
-		log.Fatalln(er)
+	}); err != nil {
+		return err
 	}
 
 	return writeToFile(specDoc.Spec(), !c.Compact, string(c.Output))
 }
+
+// renameMapping reads the renames of the flags as a mapping of $refs, a plain name being a definition's
+func renameMapping(renames []string) (map[string]string, error) {
+	mapping := make(map[string]string, len(renames))
+	for _, rename := range renames {
+		parts := strings.SplitN(rename, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid --rename %q, expected OLD=NEW", rename)
+		}
+		for i, name := range parts {
+			if !strings.HasPrefix(name, "#") {
+				parts[i] = "#/definitions/" + jsonpointer.Escape(name)
+			}
+		}
+		mapping[parts[0]] = parts[1]
+	}
+	return mapping, nil
+}
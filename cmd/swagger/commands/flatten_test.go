@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSpec_MultiFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flatten")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "swagger.json")
+	cmd := &FlattenSpec{Output: flags.Filename(output)}
+	require.NoError(t, cmd.Execute([]string{"../../../fixtures/codegen/multi-file/swagger.yml"}))
+
+	b, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	var doc interface{}
+	require.NoError(t, json.Unmarshal(b, &doc))
+	// the distributable spec is a single document
+	for _, ref := range collectJSONRefs(doc) {
+		assert.True(t, strings.HasPrefix(ref, "#/"), ref)
+	}
+	assert.Contains(t, string(b), `"#/definitions/Vaccine"`)
+}
+
+func TestFlattenSpec_Rename(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flatten")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "swagger.json")
+	cmd := &FlattenSpec{
+		Output:            flags.Filename(output),
+		ExtractDuplicates: true,
+		// the definitions extracted from the duplicates get a better name
+		Renames: []string{"ListPetsOKBodyItems=Pet", "#/responses/notFound=#/responses/missing"},
+	}
+	require.NoError(t, cmd.Execute([]string{"../../../fixtures/codegen/duplicates.yml"}))
+
+	b, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	var doc struct {
+		Definitions map[string]interface{}
+		Responses   map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal(b, &doc))
+	assert.Contains(t, doc.Definitions, "Pet")
+	assert.NotContains(t, doc.Definitions, "ListPetsOKBodyItems")
+	assert.Contains(t, doc.Responses, "missing")
+	assert.Contains(t, string(b), `"#/definitions/Pet"`)
+
+	cmd.Renames = []string{"NewPet"}
+	assert.Error(t, cmd.Execute([]string{"../../../fixtures/codegen/duplicates.yml"}))
+}
+
+func collectJSONRefs(node interface{}) []string {
+	var refs []string
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, collectJSONRefs(value)...)
+		}
+	case []interface{}:
+		for _, value := range n {
+			refs = append(refs, collectJSONRefs(value)...)
+		}
+	}
+	return refs
+}
@@ -24,4 +24,10 @@ type Generate struct {
 	Server    *generate.Server    `command:"server"`
 	Spec      *generate.SpecFile  `command:"spec"`
 	Client    *generate.Client    `command:"client"`
+	CLI       *generate.CLI       `command:"cli"`
+	Markdown  *generate.Markdown  `command:"markdown"`
+	HTML      *generate.HTML      `command:"html"`
+	Schema    *generate.Schema    `command:"schema"`
+	Proto     *generate.Proto     `command:"proto"`
+	Examples  *generate.Examples  `command:"examples"`
 }
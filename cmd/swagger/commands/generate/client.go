@@ -16,7 +16,6 @@ package generate
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -29,6 +28,8 @@ type Client struct {
 	Name            string   `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
 	Operations      []string `long:"operation" short:"O" description:"specify an operation to include, repeat for multiple"`
 	Tags            []string `long:"tags" description:"the tags to include, if not specified defaults to all"`
+	Include         []string `long:"include" description:"a regular expression matched against the id and the 'METHOD /path' of the operations to include, repeat for multiple"`
+	Exclude         []string `long:"exclude" description:"a regular expression matched against the id and the 'METHOD /path' of the operations to exclude, repeat for multiple"`
 	Principal       string   `long:"principal" short:"P" description:"the model to use for the security principal"`
 	Models          []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
 	DefaultScheme   string   `long:"default-scheme" description:"the default scheme for this client" default:"http"`
@@ -38,6 +39,9 @@ type Client struct {
 	DumpData        bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
 	SkipValidation  bool     `long:"skip-validation" description:"skips validation of spec prior to generation"`
 	SkipFlattening  bool     `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+	WithMocks       bool     `long:"with-mocks" description:"generate a mock of the client of each tag, recording the calls and returning canned responses"`
+
+	withCLI bool
 }
 
 // Execute runs this command
@@ -52,18 +56,9 @@ func (c *Client) Execute(args []string) error {
 		c.SkipModels = true
 	}
 
-	var bytebuffer []byte
-	var copyrightstr string
-	copyrightfile := string(c.CopyrightFile)
-	if copyrightfile != "" {
-		//Read the Copyright from file path in opts
-		bytebuffer, err = ioutil.ReadFile(copyrightfile)
-		if err != nil {
-			return err
-		}
-		copyrightstr = string(bytebuffer)
-	} else {
-		copyrightstr = ""
+	copyrightstr, err := readCopyright(c.CopyrightFile)
+	if err != nil {
+		return err
 	}
 
 	opts := &generator.GenOpts{
@@ -85,11 +80,21 @@ func (c *Client) Execute(args []string) error {
 		ValidateSpec:      !c.SkipValidation,
 		FlattenSpec:			 !c.SkipFlattening,
 		Tags:              c.Tags,
+		IncludeOperations: c.Include,
+		ExcludeOperations: c.Exclude,
 		IncludeSupport:    true,
+		IncludeCLI:        c.withCLI,
+		IncludeMocks:      c.WithMocks && !c.SkipOperations,
 		TemplateDir:       string(c.TemplateDir),
 		DumpData:          c.DumpData,
 		ExistingModels:    c.ExistingModels,
+		Clean:             c.Clean,
+		DryRun:            c.DryRun,
+		CompileCheck:      c.CompileCheck,
+		CompileCheckVet:   c.CompileVet,
 		Copyright:         copyrightstr,
+		BuildTags:         c.BuildTags,
+		TargetImportPath:  c.TargetImport,
 	}
 
 	if err = opts.EnsureDefaults(true); err != nil {
@@ -103,6 +108,10 @@ func (c *Client) Execute(args []string) error {
 	if err = generator.GenerateClient(c.Name, c.Models, c.Operations, opts); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		printDryRun(opts)
+		return nil
+	}
 
 	var basepath, rp, targetAbs string
 
@@ -119,6 +128,11 @@ func (c *Client) Execute(args []string) error {
 		return err
 	}
 
+	var extra string
+	if c.withCLI {
+		extra = "  * github.com/jessevdk/go-flags\n"
+	}
+
 	fmt.Fprintf(os.Stderr, `Generation completed!
 
 For this generation to compile you need to have some packages in your GOPATH:
@@ -126,9 +140,9 @@ For this generation to compile you need to have some packages in your GOPATH:
   * github.com/go-openapi/runtime
   * golang.org/x/net/context
   * golang.org/x/net/context/ctxhttp
-
+%s
 You can get these now with: go get -u -f %s/...
-`, rp)
+`, extra, rp)
 
 	return nil
 }
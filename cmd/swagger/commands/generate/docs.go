@@ -0,0 +1,97 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/sidewalklabs/go-swagger/generator"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type docsOpts struct {
+	Spec           flags.Filename `long:"spec" short:"f" description:"the spec file to use (default swagger.{json,yml,yaml})"`
+	Target         flags.Filename `long:"target" short:"t" default:"./" description:"the base directory for generating the files"`
+	TemplateDir    flags.Filename `long:"template-dir" short:"T" description:"alternative template override directory"`
+	CopyrightFile  flags.Filename `long:"copyright-file" short:"r" description:"copyright file used to add copyright header"`
+	SkipValidation bool           `long:"skip-validation" description:"skips validation of spec prior to generation"`
+	SkipFlattening bool           `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+}
+
+func (d *docsOpts) genOpts() (*generator.GenOpts, error) {
+	var copyrightstr string
+	if copyrightfile := string(d.CopyrightFile); copyrightfile != "" {
+		//Read the Copyright from file path in opts
+		bytebuffer, err := ioutil.ReadFile(copyrightfile)
+		if err != nil {
+			return nil, err
+		}
+		copyrightstr = string(bytebuffer)
+	}
+
+	return &generator.GenOpts{
+		Spec:         string(d.Spec),
+		Target:       string(d.Target),
+		TemplateDir:  string(d.TemplateDir),
+		ValidateSpec: !d.SkipValidation,
+		FlattenSpec:  !d.SkipFlattening,
+		Copyright:    copyrightstr,
+	}, nil
+}
+
+func (d *docsOpts) completed(output string) {
+	fmt.Fprintf(os.Stderr, "Generation completed!\n\nThe documentation was written to %s\n", filepath.Join(string(d.Target), output))
+}
+
+// Markdown the command to generate the reference documentation of a spec as markdown
+type Markdown struct {
+	docsOpts
+	Output string `long:"output" short:"o" description:"the name of the generated file" default:"api.md"`
+}
+
+// Execute runs this command
+func (m *Markdown) Execute(args []string) error {
+	opts, err := m.genOpts()
+	if err != nil {
+		return err
+	}
+	if err := generator.GenerateMarkdown(m.Output, opts); err != nil {
+		return err
+	}
+	m.completed(m.Output)
+	return nil
+}
+
+// HTML the command to generate the reference documentation of a spec as a standalone HTML page
+type HTML struct {
+	docsOpts
+	Output string `long:"output" short:"o" description:"the name of the generated file" default:"api.html"`
+}
+
+// Execute runs this command
+func (h *HTML) Execute(args []string) error {
+	opts, err := h.genOpts()
+	if err != nil {
+		return err
+	}
+	if err := generator.GenerateHTML(h.Output, opts); err != nil {
+		return err
+	}
+	h.completed(h.Output)
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/generator"
+	"gopkg.in/yaml.v2"
+)
+
+// Examples the command to fill the missing examples of the schemas and responses of a spec
+type Examples struct {
+	Spec   flags.Filename `long:"spec" short:"f" description:"the spec file to use, when it isn't given as argument"`
+	Output flags.Filename `long:"output" short:"o" description:"the file to write to, as yaml when it ends with .yml or .yaml and as json otherwise (default: stdout, in the format of the spec)"`
+}
+
+// Execute runs this command
+func (e *Examples) Execute(args []string) error {
+	specPath := string(e.Spec)
+	if len(args) > 0 {
+		specPath = args[0]
+	}
+	if specPath == "" {
+		return errors.New("The examples command requires the swagger document to be specified")
+	}
+
+	specDoc, err := loads.Spec(specPath)
+	if err != nil {
+		return err
+	}
+	if err := generator.CheckSpecVersion(specPath, specDoc); err != nil {
+		return err
+	}
+
+	result := generator.AddExamples(specDoc.Spec())
+	for _, skipped := range result.Skipped {
+		log.Printf("no example for %s", skipped)
+	}
+	log.Printf("added %d examples to %s", len(result.Added), specPath)
+
+	output := string(e.Output)
+	asYAML := isYAMLFile(output) || output == "" && isYAMLFile(specPath)
+	var b []byte
+	if asYAML {
+		b, err = yaml.Marshal(swag.ToDynamicJSON(specDoc.Spec()))
+	} else {
+		b, err = json.MarshalIndent(specDoc.Spec(), "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Println(string(b))
+		return nil
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
+
+func isYAMLFile(pth string) bool {
+	return strings.HasSuffix(pth, ".yml") || strings.HasSuffix(pth, ".yaml")
+}
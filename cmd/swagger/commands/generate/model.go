@@ -22,7 +22,7 @@ import (
 // Model the generate model file command
 type Model struct {
 	shared
-	Name        []string `long:"name" short:"n" description:"the model to generate"`
+	Name        []string `long:"name" short:"n" description:"the model to generate, repeat for multiple (or pass them as arguments)"`
 	NoValidator bool     `long:"skip-validator" description:"when present will not generate a model validator"`
 	NoStruct    bool     `long:"skip-struct" description:"when present will not generate the model struct"`
 	DumpData    bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
@@ -30,7 +30,7 @@ type Model struct {
 
 // Execute generates a model file
 func (m *Model) Execute(args []string) error {
-
+	m.Name = append(m.Name, args...)
 	if m.DumpData && len(m.Name) > 1 {
 		return errors.New("only 1 model at a time is supported for dumping data")
 	}
@@ -49,5 +49,5 @@ func (m *Model) Execute(args []string) error {
 		SkipValidation: m.NoValidator,
 		SkipModels:     m.NoStruct,
 	}
-	return s.Execute(args)
+	return s.Execute(nil)
 }
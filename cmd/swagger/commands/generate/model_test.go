@@ -44,3 +44,29 @@ func TestGenerateModel(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateModel_Arguments(t *testing.T) {
+	path := filepath.Join(filepath.FromSlash("../../../../"), "fixtures/codegen", "trim.yml")
+	generated, err := ioutil.TempDir(filepath.Dir(path), "generated")
+	if err != nil {
+		t.Fatalf("TempDir()=%s", generated)
+	}
+	defer os.RemoveAll(generated)
+	m := &generate.Model{}
+	flags.Parse(m)
+	m.Spec = flags.Filename(path)
+	m.Target = flags.Filename(generated)
+	m.NoValidator = true
+
+	if err := m.Execute([]string{"Pet", "Owner"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"pet.go", "owner.go"} {
+		if _, err := os.Stat(filepath.Join(generated, "models", name)); err != nil {
+			t.Errorf("expected %s to be generated: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(generated, "models", "store.go")); !os.IsNotExist(err) {
+		t.Errorf("expected store.go not to be generated")
+	}
+}
@@ -26,7 +26,7 @@ import (
 // Operation the generate operation files command
 type Operation struct {
 	shared
-	Name          []string `long:"name" short:"n" required:"true" description:"the operations to generate, repeat for multiple"`
+	Name          []string `long:"name" short:"n" description:"the operations to generate, repeat for multiple (or pass them as arguments)"`
 	Tags          []string `long:"tags" description:"the tags to include, if not specified defaults to all"`
 	Principal     string   `short:"P" long:"principal" description:"the model to use for the security principal"`
 	DefaultScheme string   `long:"default-scheme" description:"the default scheme for this API" default:"http"`
@@ -42,6 +42,10 @@ type Operation struct {
 
 // Execute generates a model file
 func (o *Operation) Execute(args []string) error {
+	o.Name = append(o.Name, args...)
+	if len(o.Name) == 0 {
+		return errors.New("the operations to generate are required, as arguments or with --name")
+	}
 	if o.DumpData && len(o.Name) > 1 {
 		return errors.New("only 1 operation at a time is supported for dumping data")
 	}
@@ -52,6 +56,11 @@ func (o *Operation) Execute(args []string) error {
 	}
 	setDebug(cfg)
 
+	copyrightstr, err := readCopyright(o.CopyrightFile)
+	if err != nil {
+		return err
+	}
+
 	opts := &generator.GenOpts{
 		Spec:              string(o.Spec),
 		Target:            string(o.Target),
@@ -71,6 +80,13 @@ func (o *Operation) Execute(args []string) error {
 		Tags:              o.Tags,
 		FlattenSpec:			 !o.SkipFlattening,
 		ValidateSpec:      !o.SkipValidation,
+		Clean:             o.Clean,
+		DryRun:            o.DryRun,
+		CompileCheck:      o.CompileCheck,
+		CompileCheckVet:   o.CompileVet,
+		Copyright:         copyrightstr,
+		BuildTags:         o.BuildTags,
+		TargetImportPath:  o.TargetImport,
 	}
 
 	if err = opts.EnsureDefaults(false); err != nil {
@@ -84,6 +100,10 @@ func (o *Operation) Execute(args []string) error {
 	if err = generator.GenerateServerOperation(o.Name, opts); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		printDryRun(opts)
+		return nil
+	}
 
 	var basepath, rp, targetAbs string
 
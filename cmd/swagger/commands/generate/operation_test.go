@@ -0,0 +1,46 @@
+package generate_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/generate"
+	flags "github.com/jessevdk/go-flags"
+)
+
+func TestGenerateOperation_Arguments(t *testing.T) {
+	path := filepath.Join(filepath.FromSlash("../../../../"), "fixtures/codegen", "trim.yml")
+	generated, err := ioutil.TempDir(filepath.Dir(path), "generated")
+	if err != nil {
+		t.Fatalf("TempDir()=%s", generated)
+	}
+	defer os.RemoveAll(generated)
+	newOperation := func() *generate.Operation {
+		o := &generate.Operation{}
+		flags.Parse(o)
+		o.Spec = flags.Filename(path)
+		o.Target = flags.Filename(generated)
+		o.SkipValidation = true
+		return o
+	}
+
+	if err := newOperation().Execute([]string{"getPet"}); err != nil {
+		t.Fatal(err)
+	}
+	operations := filepath.Join(generated, "restapi", "operations", "pets")
+	if _, err := os.Stat(filepath.Join(operations, "get_pet.go")); err != nil {
+		t.Errorf("expected get_pet.go to be generated: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(operations, "list_pets.go")); !os.IsNotExist(err) {
+		t.Errorf("expected list_pets.go not to be generated")
+	}
+
+	if err := newOperation().Execute(nil); err == nil {
+		t.Error("expected an error without operations")
+	}
+	if err := newOperation().Execute([]string{"getPet", "getUnicorn"}); err == nil {
+		t.Error("expected an error for an unknown operation")
+	}
+}
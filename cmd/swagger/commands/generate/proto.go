@@ -0,0 +1,48 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sidewalklabs/go-swagger/generator"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Proto the command to generate the proto3 messages and gRPC service of a spec
+type Proto struct {
+	Spec           flags.Filename `long:"spec" short:"f" description:"the spec file to use (default swagger.{json,yml,yaml})"`
+	Target         flags.Filename `long:"target" short:"t" default:"./" description:"the directory for the generated files"`
+	Output         string         `long:"output" short:"o" description:"the name of the proto file (default {package}.proto)"`
+	Package        string         `long:"package" short:"p" description:"the package of the proto file (default the title of the spec)"`
+	SkipValidation bool           `long:"skip-validation" description:"skips validation of spec prior to generation"`
+	SkipFlattening bool           `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+}
+
+// Execute runs this command
+func (p *Proto) Execute(args []string) error {
+	opts := &generator.GenOpts{
+		Spec:         string(p.Spec),
+		Target:       string(p.Target),
+		ValidateSpec: !p.SkipValidation,
+		FlattenSpec:  !p.SkipFlattening,
+	}
+	if err := generator.GenerateProto(p.Output, p.Package, opts); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generation completed!\n\nThe proto file was written to %s\n", p.Target)
+	return nil
+}
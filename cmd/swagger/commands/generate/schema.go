@@ -0,0 +1,57 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sidewalklabs/go-swagger/generator"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Schema the command to generate standalone json schemas for the definitions of a spec
+type Schema struct {
+	Spec           flags.Filename `long:"spec" short:"f" description:"the spec file to use (default swagger.{json,yml,yaml})"`
+	Target         flags.Filename `long:"target" short:"t" default:"./" description:"the directory for the generated files"`
+	Definitions    []string       `long:"definitions" short:"d" description:"the definitions to generate a schema for, as a comma separated list (default all of them)"`
+	SkipValidation bool           `long:"skip-validation" description:"skips validation of spec prior to generation"`
+	SkipFlattening bool           `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+}
+
+// Execute runs this command
+func (s *Schema) Execute(args []string) error {
+	var names []string
+	for _, value := range s.Definitions {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	opts := &generator.GenOpts{
+		Spec:         string(s.Spec),
+		Target:       string(s.Target),
+		ValidateSpec: !s.SkipValidation,
+		FlattenSpec:  !s.SkipFlattening,
+	}
+	if err := generator.GenerateJSONSchema(names, opts); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generation completed!\n\nThe json schemas were written to %s\n", s.Target)
+	return nil
+}
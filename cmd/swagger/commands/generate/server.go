@@ -15,12 +15,13 @@
 package generate
 
 import (
+	"errors"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/watch"
 	"github.com/sidewalklabs/go-swagger/generator"
 )
 
@@ -30,6 +31,8 @@ type Server struct {
 	Name              string   `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
 	Operations        []string `long:"operation" short:"O" description:"specify an operation to include, repeat for multiple"`
 	Tags              []string `long:"tags" description:"the tags to include, if not specified defaults to all"`
+	Include           []string `long:"include" description:"a regular expression matched against the id and the 'METHOD /path' of the operations to include, repeat for multiple"`
+	Exclude           []string `long:"exclude" description:"a regular expression matched against the id and the 'METHOD /path' of the operations to exclude, repeat for multiple"`
 	Principal         string   `long:"principal" short:"P" description:"the model to use for the security principal"`
 	DefaultScheme     string   `long:"default-scheme" description:"the default scheme for this API" default:"http"`
 	Models            []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
@@ -39,15 +42,29 @@ type Server struct {
 	ExcludeMain       bool     `long:"exclude-main" description:"exclude main function, so just generate the library"`
 	ExcludeSpec       bool     `long:"exclude-spec" description:"don't embed the swagger specification"`
 	WithContext       bool     `long:"with-context" description:"handlers get a context as first arg (deprecated)"`
+	WithTests         bool     `long:"with-tests" description:"generate a _test.go file for each operation, testing its parameters, security and responses"`
+	WithHealth        bool     `long:"with-health" description:"serve /healthz and /readyz probes, which run the checks registered in the api"`
+	HealthInSpec      bool     `long:"health-in-spec" description:"document the probes of --with-health in the embedded spec"`
+	WarnDeprecated    bool     `long:"with-deprecation-headers" description:"set the Warning and Sunset headers of the responses to deprecated operations"`
 	DumpData          bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
-	FlagStrategy      string   `long:"flag-strategy" description:"the strategy to provide flags for the server" default:"go-flags" choice:"go-flags" choice:"pflag"`
+	FlagStrategy      string   `long:"flag-strategy" description:"the strategy to provide flags for the server" default:"go-flags" choice:"go-flags" choice:"pflag" choice:"stdlib"`
 	CompatibilityMode string   `long:"compatibility-mode" description:"the compatibility mode for the tls server" default:"modern" choice:"modern" choice:"intermediate"`
 	SkipValidation    bool     `long:"skip-validation" description:"skips validation of spec prior to generation"`
 	SkipFlattening  bool     `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+	Watch             bool     `long:"watch" description:"generate the server again each time the spec, or a document it refers to, changes"`
+	APIVersions       []string `long:"api-version" description:"a version of the api to serve, as NAME=SPEC, repeat for multiple: the server serves each version from its own spec"`
+	VersionStrategy   string   `long:"version-strategy" description:"how the server selects the version of a request, with --api-version" default:"path" choice:"path" choice:"header"`
+	VersionHeader     string   `long:"version-header" description:"the header naming the version of a request, with --version-strategy=header" default:"X-API-Version"`
+	DefaultVersion    string   `long:"default-version" description:"the version serving the requests which select none, the last --api-version by default"`
+	Mounts            []string `long:"mount" description:"an api to serve under the base path of its spec, as NAME=SPEC, repeat for multiple: unlike --api-version, the apis are named after their spec and have no default"`
 }
 
 // Execute runs this command
 func (s *Server) Execute(args []string) error {
+	if s.Watch {
+		return s.watch()
+	}
+
 	cfg, err := readConfig(string(s.ConfigFile))
 	if err != nil {
 		return err
@@ -62,18 +79,9 @@ func (s *Server) Execute(args []string) error {
 		s.SkipModels = true
 	}
 
-	var bytebuffer []byte
-	var copyrightstr string
-	copyrightfile := string(s.CopyrightFile)
-	if copyrightfile != "" {
-		//Read the Copyright from file path in opts
-		bytebuffer, err = ioutil.ReadFile(copyrightfile)
-		if err != nil {
-			return err
-		}
-		copyrightstr = string(bytebuffer)
-	} else {
-		copyrightstr = ""
+	copyrightstr, err := readCopyright(s.CopyrightFile)
+	if err != nil {
+		return err
 	}
 
 	opts := &generator.GenOpts{
@@ -91,6 +99,10 @@ func (s *Server) Execute(args []string) error {
 		IncludeParameters: !s.SkipOperations,
 		IncludeResponses:  !s.SkipOperations,
 		IncludeURLBuilder: !s.SkipOperations,
+		IncludeTests:      s.WithTests && !s.SkipOperations,
+		IncludeHealth:     s.WithHealth,
+		HealthInSpec:      s.WithHealth && s.HealthInSpec,
+		WarnDeprecated:    s.WarnDeprecated,
 		IncludeMain:       !s.ExcludeMain,
 		IncludeSupport:    !s.SkipSupport,
 		ValidateSpec:      !s.SkipValidation,
@@ -102,11 +114,19 @@ func (s *Server) Execute(args []string) error {
 		Models:            s.Models,
 		Operations:        s.Operations,
 		Tags:              s.Tags,
+		IncludeOperations: s.Include,
+		ExcludeOperations: s.Exclude,
 		Name:              s.Name,
 		FlagStrategy:      s.FlagStrategy,
 		CompatibilityMode: s.CompatibilityMode,
 		ExistingModels:    s.ExistingModels,
+		Clean:             s.Clean,
+		DryRun:            s.DryRun,
+		CompileCheck:      s.CompileCheck,
+		CompileCheckVet:   s.CompileVet,
 		Copyright:         copyrightstr,
+		BuildTags:         s.BuildTags,
+		TargetImportPath:  s.TargetImport,
 	}
 
 	if e := opts.EnsureDefaults(false); e != nil {
@@ -117,9 +137,21 @@ func (s *Server) Execute(args []string) error {
 		return e
 	}
 
-	if e := generator.GenerateServer(s.Name, s.Models, s.Operations, opts); e != nil {
+	if len(s.APIVersions) > 0 || len(s.Mounts) > 0 {
+		versions, e := s.versionOpts()
+		if e != nil {
+			return e
+		}
+		if e := generator.GenerateVersionedServer(s.Name, versions, opts); e != nil {
+			return e
+		}
+	} else if e := generator.GenerateServer(s.Name, s.Models, s.Operations, opts); e != nil {
 		return e
 	}
+	if opts.DryRun {
+		printDryRun(opts)
+		return nil
+	}
 	var basepath, rp, targetAbs string
 
 	basepath, err = filepath.Abs(".")
@@ -134,9 +166,12 @@ func (s *Server) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	flagsPackage := "github.com/jessevdk/go-flags"
-	if strings.HasPrefix(s.FlagStrategy, "pflag") {
-		flagsPackage = "github.com/spf13/pflag"
+	flagsPackage := "\n  * github.com/jessevdk/go-flags"
+	switch {
+	case strings.HasPrefix(s.FlagStrategy, "pflag"):
+		flagsPackage = "\n  * github.com/spf13/pflag"
+	case s.FlagStrategy == "stdlib":
+		flagsPackage = ""
 	}
 
 	fmt.Fprintf(os.Stderr, `Generation completed!
@@ -144,11 +179,57 @@ func (s *Server) Execute(args []string) error {
 For this generation to compile you need to have some packages in your GOPATH:
 
   * github.com/go-openapi/runtime
-  * github.com/tylerb/graceful
-  * `+flagsPackage+`
+  * github.com/tylerb/graceful`+flagsPackage+`
 
 You can get these now with: go get -u -f %s/...
 `, rp)
 
 	return nil
 }
+
+// versionOpts reads the versions of the api the server serves, or the apis it mounts
+func (s *Server) versionOpts() (generator.VersionOpts, error) {
+	flag, specs := "--api-version", s.APIVersions
+	if len(s.Mounts) > 0 {
+		if len(s.APIVersions) > 0 {
+			return generator.VersionOpts{}, errors.New("--mount serves different apis, it can't be used with --api-version")
+		}
+		flag, specs = "--mount", s.Mounts
+	}
+	if len(s.Models) > 0 || len(s.Operations) > 0 || len(s.Tags) > 0 {
+		return generator.VersionOpts{}, fmt.Errorf("%s generates whole apis: it can't be used with --model, --operation or --tags", flag)
+	}
+	versions := generator.VersionOpts{
+		Strategy: s.VersionStrategy,
+		Header:   s.VersionHeader,
+		Default:  s.DefaultVersion,
+		Mount:    len(s.Mounts) > 0,
+	}
+	for _, version := range specs {
+		parts := strings.SplitN(version, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return generator.VersionOpts{}, fmt.Errorf("invalid %s %q, expected NAME=SPEC", flag, version)
+		}
+		versions.Versions = append(versions.Versions, generator.APIVersion{Name: parts[0], Spec: parts[1]})
+	}
+	return versions, nil
+}
+
+// watch generates the server again each time the spec changes, and prints the files each generation changed
+func (s *Server) watch() error {
+	target := string(s.Target)
+	before, err := watch.Snapshot(target)
+	if err != nil {
+		return err
+	}
+	return watch.Run(string(s.Spec), func() error {
+		err := watch.Command(os.Stderr, os.Stderr)
+		after, serr := watch.Snapshot(target)
+		if serr != nil {
+			return serr
+		}
+		watch.PrintChanges(os.Stdout, before, after)
+		before = after
+		return err
+	})
+}
@@ -1,6 +1,8 @@
 package generate
 
 import (
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -21,6 +23,12 @@ type shared struct {
 	ConfigFile     flags.Filename `long:"config-file" short:"C" description:"configuration file to use for overriding template options"`
 	CopyrightFile  flags.Filename `long:"copyright-file" short:"r" description:"copyright file used to add copyright header"`
 	ExistingModels string         `long:"existing-models" description:"use pre-generated models e.g. github.com/foobar/model"`
+	Clean          bool           `long:"clean" description:"remove the files of the previous generation that this one doesn't produce anymore"`
+	DryRun         bool           `long:"dry-run" description:"print the files the generation would write, without writing anything"`
+	CompileCheck   bool           `long:"with-compile-check" description:"build the generated code in a temporary GOPATH before writing it to the target"`
+	CompileVet     bool           `long:"compile-check-vet" description:"vet the generated code as well with --with-compile-check"`
+	BuildTags      string         `long:"build-tags" description:"build constraint of the generated go files, e.g. integration or 'linux,!arm'"`
+	TargetImport   string         `long:"with-target-import" description:"the import path of the target, when it isn't found from the GOPATH or the go.mod enclosing the target"`
 }
 
 func readConfig(filename string) (*viper.Viper, error) {
@@ -36,6 +44,18 @@ func readConfig(filename string) (*viper.Viper, error) {
 	return generator.ReadConfig(abspath)
 }
 
+// readCopyright reads the header of the generated files, when a copyright file is given
+func readCopyright(file flags.Filename) (string, error) {
+	if file == "" {
+		return "", nil
+	}
+	b, err := ioutil.ReadFile(string(file))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func configureOptsFromConfig(cfg *viper.Viper, opts *generator.GenOpts) error {
 	if cfg == nil {
 		return nil
@@ -57,3 +77,12 @@ func setDebug(cfg *viper.Viper) {
 		}
 	}
 }
+
+// printDryRun prints the files a dry run would have written, one per line
+func printDryRun(opts *generator.GenOpts) {
+	planned := opts.PlannedFiles()
+	for _, file := range planned {
+		fmt.Println(file)
+	}
+	fmt.Fprintf(os.Stderr, "Dry run completed: %d files would be written\n", len(planned))
+}
@@ -35,25 +35,40 @@ type Support struct {
 
 // Execute generates the supporting files file
 func (s *Support) Execute(args []string) error {
+	copyrightstr, err := readCopyright(s.CopyrightFile)
+	if err != nil {
+		return err
+	}
+
 	opts := generator.GenOpts{
-		Spec:          string(s.Spec),
-		Target:        string(s.Target),
-		APIPackage:    s.APIPackage,
-		ModelPackage:  s.ModelPackage,
-		ServerPackage: s.ServerPackage,
-		ClientPackage: s.ClientPackage,
-		Principal:     s.Principal,
-		DumpData:      s.DumpData,
-		DefaultScheme: s.DefaultScheme,
-		TemplateDir:   string(s.TemplateDir),
+		Spec:             string(s.Spec),
+		Target:           string(s.Target),
+		APIPackage:       s.APIPackage,
+		ModelPackage:     s.ModelPackage,
+		ServerPackage:    s.ServerPackage,
+		ClientPackage:    s.ClientPackage,
+		Principal:        s.Principal,
+		DumpData:         s.DumpData,
+		DefaultScheme:    s.DefaultScheme,
+		TemplateDir:      string(s.TemplateDir),
+		Clean:            s.Clean,
+		DryRun:           s.DryRun,
+		CompileCheck:     s.CompileCheck,
+		CompileCheckVet:  s.CompileVet,
+		Copyright:        copyrightstr,
+		BuildTags:        s.BuildTags,
+		TargetImportPath: s.TargetImport,
 	}
 
 	if err := generator.GenerateSupport(s.Name, nil, nil, &opts); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		printDryRun(&opts)
+		return nil
+	}
 
 	var basepath, rp, targetAbs string
-	var err error
 	basepath, err = filepath.Abs(".")
 	if err != nil {
 		return err
@@ -0,0 +1,1004 @@
+package importcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+)
+
+var (
+	apibMetadata        = regexp.MustCompile(`^([A-Za-z][A-Za-z_-]*):\s*(.*)$`)
+	apibHeading         = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	apibListItem        = regexp.MustCompile(`^(\s*)[+*-]\s+(.*)$`)
+	apibGroup           = regexp.MustCompile(`^Group\s+(.+)$`)
+	apibResourceHeading = regexp.MustCompile(`^(.*?)\s*\[(/[^\]]*)\]$`)
+	apibActionHeading   = regexp.MustCompile(`^(.*?)\s*\[([A-Z]+)(?:\s+(/[^\]]*))?\]$`)
+	apibEndpointHeading = regexp.MustCompile(`^([A-Z]+)\s+(/\S*)$`)
+	apibPayloadItem     = regexp.MustCompile(`^(Request|Response|Model)(?:\s+([^(]*?))?\s*(?:\(([^)]*)\))?$`)
+	apibModelRef        = regexp.MustCompile(`^\[([^\]]+)\]\[\]$`)
+	apibURIVariables    = regexp.MustCompile(`{([+#./;?&]?)([^}]*)}`)
+	msonMemberLine      = regexp.MustCompile(`^(.*?)(?:\s*\(([^()]*)\))?(?:\s+-\s+(.*))?$`)
+)
+
+// the attributes of a MSON type definition, the other words of a definition are its type
+var msonAttributes = map[string]bool{
+	"required":   true,
+	"optional":   true,
+	"fixed":      true,
+	"fixed-type": true,
+	"nullable":   true,
+	"sample":     true,
+	"default":    true,
+}
+
+// APIBlueprint a command struct to import an API Blueprint document as a new spec
+type APIBlueprint struct {
+	Output flags.Filename `long:"output" short:"o" description:"the file to write the spec to, as json when it ends with .json and as yaml otherwise (default stdout, as yaml)"`
+}
+
+// Execute this command
+func (a *APIBlueprint) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("the import apib command requires the API Blueprint document")
+	}
+	doc, warnings, err := ImportAPIBlueprint(args[0])
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		log.Println("warning:", warning)
+	}
+	if len(warnings) > 0 {
+		log.Printf("%d features of %s couldn't be mapped to swagger", len(warnings), args[0])
+	}
+
+	var b []byte
+	if strings.HasSuffix(string(a.Output), ".json") {
+		b, err = json.MarshalIndent(doc, "", "  ")
+	} else {
+		b, err = yaml.Marshal(swag.ToDynamicJSON(doc))
+	}
+	if err != nil {
+		return err
+	}
+	if a.Output == "" {
+		fmt.Print(string(b))
+		return nil
+	}
+	log.Printf("imported %s into %s", args[0], a.Output)
+	return ioutil.WriteFile(string(a.Output), b, 0644)
+}
+
+// ImportAPIBlueprint converts an API Blueprint document to a swagger spec.
+//
+// The resources and their actions become paths and operations, the resource groups become tags,
+// and the MSON data structures and attributes become definitions.
+// The features swagger can't describe are reported as warnings that tell on which line they were found.
+func ImportAPIBlueprint(path string) (*spec.Swagger, []string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	metadata, sections := parseBlueprint(string(b))
+	format, ok := metadata["FORMAT"]
+	switch {
+	case !ok && strings.ToLower(filepath.Ext(path)) != ".apib":
+		return nil, nil, fmt.Errorf("%s is not an API Blueprint document: it doesn't start with FORMAT: 1A", path)
+	case ok && format != "1A":
+		return nil, nil, fmt.Errorf("%s is an API Blueprint %s document, only the format 1A is supported", path, format)
+	}
+
+	imp := &apibImporter{
+		metadata:    metadata,
+		sections:    sections,
+		types:       make(map[string]bool),
+		models:      make(map[string]*apibItem),
+		definitions: make(map[string]interface{}),
+	}
+	doc, err := imp.importAPI()
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, imp.warnings, nil
+}
+
+// apibSection is a heading of the document, with the text and the list which follow it
+type apibSection struct {
+	line  int
+	level int
+	title string
+	text  []string
+	items []*apibItem
+}
+
+// apibItem is an item of a list, with the lines indented under it and the nested items
+type apibItem struct {
+	line     int
+	indent   int
+	text     string
+	content  []string
+	children []*apibItem
+}
+
+// parseBlueprint splits a document in its metadata and its sections
+func parseBlueprint(content string) (map[string]string, []*apibSection) {
+	metadata := make(map[string]string)
+	var sections []*apibSection
+	var current *apibSection
+	var open []*apibItem
+	inMetadata := true
+	for i, line := range strings.Split(strings.Replace(content, "\t", "    ", -1), "\n") {
+		line = strings.TrimRight(line, " \r")
+		if inMetadata {
+			if match := apibMetadata.FindStringSubmatch(line); match != nil {
+				metadata[strings.ToUpper(match[1])] = match[2]
+				continue
+			}
+			inMetadata = false
+		}
+		if match := apibHeading.FindStringSubmatch(line); match != nil {
+			current = &apibSection{line: i + 1, level: len(match[1]), title: match[2]}
+			sections = append(sections, current)
+			open = nil
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		// an item is at most nested one level deeper than the items before it, deeper ones are the content of a code block
+		if match := apibListItem.FindStringSubmatch(line); match != nil && (len(open) == 0 && indent < 4 || len(open) > 0 && indent <= open[len(open)-1].indent+4) {
+			for len(open) > 0 && open[len(open)-1].indent >= indent {
+				open = open[:len(open)-1]
+			}
+			item := &apibItem{line: i + 1, indent: indent, text: strings.TrimSpace(match[2])}
+			if len(open) == 0 {
+				current.items = append(current.items, item)
+			} else {
+				parent := open[len(open)-1]
+				parent.children = append(parent.children, item)
+			}
+			open = append(open, item)
+			continue
+		}
+		if line != "" {
+			for len(open) > 0 && open[len(open)-1].indent >= indent {
+				open = open[:len(open)-1]
+			}
+		}
+		if len(open) > 0 {
+			top := open[len(open)-1]
+			top.content = append(top.content, line)
+		} else {
+			current.text = append(current.text, line)
+		}
+	}
+	return metadata, sections
+}
+
+// textBlock joins lines without the blank lines around them and their common indentation
+func textBlock(lines []string) string {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	common := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if indent := len(line) - len(strings.TrimLeft(line, " ")); common < 0 || indent < common {
+			common = indent
+		}
+	}
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if len(line) >= common && common > 0 {
+			line = line[common:]
+		}
+		result = append(result, line)
+	}
+	return strings.Join(result, "\n")
+}
+
+// msonMember is the definition of a member of a MSON type: `name: value (type, attributes) - description`
+type msonMember struct {
+	head        string
+	name        string
+	value       string
+	typeName    string
+	attributes  map[string]bool
+	description string
+}
+
+func parseMember(text string) *msonMember {
+	match := msonMemberLine.FindStringSubmatch(strings.TrimSpace(text))
+	member := &msonMember{attributes: make(map[string]bool), description: match[3]}
+	head := strings.TrimSpace(match[1])
+	if strings.HasPrefix(head, "`") {
+		if end := strings.Index(head[1:], "`"); end >= 0 {
+			member.name = head[1 : end+1]
+			rest := strings.TrimSpace(head[end+2:])
+			if strings.HasPrefix(rest, ":") {
+				member.value = strings.Trim(strings.TrimSpace(rest[1:]), "`")
+			}
+		}
+	} else if i := strings.Index(head, ":"); i >= 0 {
+		member.name = strings.TrimSpace(head[:i])
+		member.value = strings.Trim(strings.TrimSpace(head[i+1:]), "`")
+	} else {
+		member.name = head
+	}
+	member.head = strings.Trim(head, "`")
+
+	for _, word := range strings.Split(match[2], ",") {
+		word = strings.TrimSpace(word)
+		switch {
+		case word == "":
+		case msonAttributes[word]:
+			member.attributes[word] = true
+		case member.typeName == "":
+			member.typeName = word
+		}
+	}
+	return member
+}
+
+// splitType splits a MSON type like array[Pet] in its base type and the types of its members
+func splitType(typeName string) (string, []string) {
+	i := strings.Index(typeName, "[")
+	if i < 0 || !strings.HasSuffix(typeName, "]") {
+		return typeName, nil
+	}
+	var nested []string
+	for _, t := range strings.Split(typeName[i+1:len(typeName)-1], ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			nested = append(nested, t)
+		}
+	}
+	return typeName[:i], nested
+}
+
+// definitionName is the name of the definition a MSON type is imported as
+func definitionName(name string) string {
+	return strings.Join(strings.Fields(name), "")
+}
+
+type apibResource struct {
+	section *apibSection
+	name    string
+	uri     string
+	group   string
+	params  []*apibItem
+}
+
+type apibImporter struct {
+	metadata    map[string]string
+	sections    []*apibSection
+	types       map[string]bool
+	models      map[string]*apibItem
+	definitions map[string]interface{}
+	paths       map[string]interface{}
+	warnings    []string
+}
+
+func (imp *apibImporter) warn(line int, format string, args ...interface{}) {
+	imp.warnings = append(imp.warnings, fmt.Sprintf("line %d: %s", line, fmt.Sprintf(format, args...)))
+}
+
+func (imp *apibImporter) importAPI() (*spec.Swagger, error) {
+	result := map[string]interface{}{"swagger": "2.0"}
+	info := map[string]interface{}{"title": "", "version": "1.0"}
+	if version, ok := imp.metadata["VERSION"]; ok {
+		info["version"] = version
+	}
+	result["info"] = info
+	if host, ok := imp.metadata["HOST"]; ok {
+		imp.host(host, result)
+	}
+
+	// the types are collected first, for the actions to refer to the ones declared after them
+	dataLevel := 0
+	for _, section := range imp.sections {
+		switch {
+		case section.title == "Data Structures":
+			dataLevel = section.level
+		case dataLevel > 0 && section.level > dataLevel:
+			imp.types[parseMember(section.title).name] = true
+		default:
+			dataLevel = 0
+			if match := apibResourceHeading.FindStringSubmatch(section.title); match != nil {
+				for _, item := range section.items {
+					if parseMember(item.text).name == "Attributes" && match[1] != "" {
+						imp.types[match[1]] = true
+					}
+					if payload := apibPayloadItem.FindStringSubmatch(item.text); payload != nil && payload[1] == "Model" {
+						imp.models[match[1]] = item
+					}
+				}
+			}
+		}
+	}
+
+	imp.paths = make(map[string]interface{})
+	var tags []interface{}
+	var resource *apibResource
+	group := ""
+	dataLevel = 0
+	for i, section := range imp.sections {
+		title := section.title
+		if section.title == "Data Structures" {
+			dataLevel = section.level
+			continue
+		}
+		if dataLevel > 0 && section.level > dataLevel {
+			member := parseMember(title)
+			imp.definitions[definitionName(member.name)] = imp.typeDefinition(section.line, member, textBlock(section.text), section.items)
+			continue
+		}
+		dataLevel = 0
+
+		if match := apibGroup.FindStringSubmatch(title); match != nil {
+			group = match[1]
+			resource = nil
+			tag := map[string]interface{}{"name": group}
+			if description := textBlock(section.text); description != "" {
+				tag["description"] = description
+			}
+			tags = append(tags, tag)
+			continue
+		}
+		if match := apibActionHeading.FindStringSubmatch(title); match != nil {
+			if resource == nil && match[3] == "" {
+				imp.warn(section.line, "the action %s isn't part of a resource, it is dropped", title)
+				continue
+			}
+			imp.action(resource, section, strings.TrimSpace(match[1]), match[2], match[3], group)
+			continue
+		}
+		if match := apibResourceHeading.FindStringSubmatch(title); match != nil {
+			resource = imp.resource(section, strings.TrimSpace(match[1]), match[2], group)
+			continue
+		}
+		if match := apibEndpointHeading.FindStringSubmatch(title); match != nil {
+			// a resource with a single action
+			resource = imp.resource(section, "", match[2], group)
+			imp.action(resource, section, "", match[1], "", group)
+			continue
+		}
+		if i == 0 {
+			info["title"] = title
+			if description := textBlock(section.text); description != "" {
+				info["description"] = description
+			}
+		}
+	}
+
+	result["paths"] = imp.paths
+	if len(tags) > 0 {
+		result["tags"] = tags
+	}
+	if len(imp.definitions) > 0 {
+		result["definitions"] = imp.definitions
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var doc spec.Swagger
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func (imp *apibImporter) host(raw string, result map[string]interface{}) {
+	u, err := url.Parse(raw)
+	if err != nil || strings.Contains(raw, "{") {
+		imp.warn(1, "the host %q has parameters, which swagger doesn't support, it is dropped", raw)
+		return
+	}
+	if u.Host != "" {
+		result["host"] = u.Host
+	}
+	if u.Path != "" && u.Path != "/" {
+		result["basePath"] = strings.TrimSuffix(u.Path, "/")
+	}
+	if u.Scheme != "" {
+		result["schemes"] = []string{u.Scheme}
+	}
+}
+
+// resource reads the parameters of a resource, and imports its attributes as a definition
+func (imp *apibImporter) resource(section *apibSection, name, uri, group string) *apibResource {
+	resource := &apibResource{section: section, name: name, uri: uri, group: group}
+	for _, item := range section.items {
+		member := parseMember(item.text)
+		switch {
+		case item.text == "Parameters":
+			resource.params = append(resource.params, item.children...)
+		case member.name == "Attributes" && name != "":
+			member.name = name
+			imp.definitions[definitionName(name)] = imp.typeDefinition(item.line, member, textBlock(item.content), item.children)
+		}
+	}
+	return resource
+}
+
+// uriTemplate splits a uri template in the swagger path and the names of the path and query parameters
+func (imp *apibImporter) uriTemplate(line int, uri string) (string, []string, []string) {
+	var pathParams, queryParams []string
+	pth := apibURIVariables.ReplaceAllStringFunc(uri, func(expression string) string {
+		match := apibURIVariables.FindStringSubmatch(expression)
+		var names []string
+		for _, name := range strings.Split(match[2], ",") {
+			name = strings.TrimSuffix(strings.SplitN(strings.TrimSpace(name), ":", 2)[0], "*")
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+		switch match[1] {
+		case "", "+":
+			if len(names) != 1 {
+				imp.warn(line, "swagger path parameters are a single variable, the expression %s is dropped", expression)
+				return ""
+			}
+			pathParams = append(pathParams, names[0])
+			return "{" + names[0] + "}"
+		case "?", "&":
+			queryParams = append(queryParams, names...)
+			return ""
+		default:
+			imp.warn(line, "swagger doesn't support the %s expansion of uri templates, the expression %s is dropped", match[1], expression)
+			return ""
+		}
+	})
+	return pth, pathParams, queryParams
+}
+
+// action converts an action to the operation of a path
+func (imp *apibImporter) action(resource *apibResource, section *apibSection, name, method, uri, group string) {
+	if uri == "" {
+		uri = resource.uri
+	}
+	pth, pathParams, queryParams := imp.uriTemplate(section.line, uri)
+	method = strings.ToLower(method)
+	if !isRAMLMethod(method) {
+		imp.warn(section.line, "swagger doesn't support the %s method, the action is dropped", strings.ToUpper(method))
+		return
+	}
+
+	op := make(map[string]interface{})
+	if name != "" {
+		op["summary"] = name
+	}
+	if description := textBlock(section.text); description != "" {
+		op["description"] = description
+	}
+	if group != "" {
+		op["tags"] = []string{group}
+	}
+
+	// the parameters of the action override the ones of its resource
+	declared := make(map[string]*apibItem)
+	var attributes *apibItem
+	var requests, responses []*apibItem
+	var paramItems []*apibItem
+	if resource != nil {
+		paramItems = append(paramItems, resource.params...)
+	}
+	for _, item := range section.items {
+		switch {
+		case item.text == "Parameters":
+			paramItems = append(paramItems, item.children...)
+		case parseMember(item.text).name == "Attributes":
+			attributes = item
+		case strings.HasPrefix(item.text, "Request"):
+			requests = append(requests, item)
+		case strings.HasPrefix(item.text, "Response"):
+			responses = append(responses, item)
+		}
+	}
+	for _, item := range paramItems {
+		declared[parseMember(item.text).name] = item
+	}
+
+	var params []interface{}
+	placed := make(map[string]bool)
+	for _, name := range pathParams {
+		param := map[string]interface{}{"name": name, "in": "path", "required": true, "type": "string"}
+		if item, ok := declared[name]; ok {
+			param = imp.parameter(item, "path")
+		}
+		params = append(params, param)
+		placed[name] = true
+	}
+	for _, name := range queryParams {
+		param := map[string]interface{}{"name": name, "in": "query", "type": "string"}
+		if item, ok := declared[name]; ok {
+			param = imp.parameter(item, "query")
+		}
+		params = append(params, param)
+		placed[name] = true
+	}
+	for _, item := range paramItems {
+		if name := parseMember(item.text).name; !placed[name] {
+			imp.warn(item.line, "the parameter %s isn't in the uri template %s, it is dropped", name, uri)
+			placed[name] = true
+		}
+	}
+
+	var consumes []string
+	for i, item := range requests {
+		request := imp.payload(item)
+		if i > 0 {
+			if request.mediaType != "" && request.mediaType != consumes[0] {
+				imp.warn(item.line, "swagger supports a single schema for a body, the one of %s is used", consumes[0])
+			}
+			continue
+		}
+		if request.mediaType != "" {
+			consumes = []string{request.mediaType}
+		}
+		for _, header := range request.headers {
+			params = append(params, map[string]interface{}{"name": header[0], "in": "header", "type": "string"})
+		}
+		schema := request.schema
+		if schema == nil && attributes != nil {
+			schema = imp.typeDefinition(attributes.line, parseMember(attributes.text), "", attributes.children)
+		}
+		if schema == nil && request.body != "" {
+			schema = map[string]interface{}{}
+		}
+		if schema != nil {
+			param := map[string]interface{}{"name": "body", "in": "body", "required": true, "schema": schema}
+			if request.description != "" {
+				param["description"] = request.description
+			}
+			params = append(params, param)
+		}
+	}
+	if len(requests) == 0 && attributes != nil {
+		schema := imp.typeDefinition(attributes.line, parseMember(attributes.text), "", attributes.children)
+		params = append(params, map[string]interface{}{"name": "body", "in": "body", "required": true, "schema": schema})
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	if len(consumes) > 0 {
+		op["consumes"] = consumes
+	}
+
+	converted := make(map[string]interface{})
+	mediaTypes := make(map[string]string)
+	var produces []string
+	for _, item := range responses {
+		response := imp.payload(item)
+		code := strings.Fields(response.name + " 200")[0]
+		if _, err := strconv.Atoi(code); err != nil {
+			imp.warn(item.line, "%s isn't a status code, the response is dropped", code)
+			continue
+		}
+		if response.mediaType != "" {
+			produces = appendMissing(produces, response.mediaType)
+		}
+		if _, ok := converted[code]; ok {
+			if response.mediaType != mediaTypes[code] {
+				imp.warn(item.line, "swagger supports a single schema for a response, the one of %s is used", mediaTypes[code])
+			}
+			continue
+		}
+		mediaTypes[code] = response.mediaType
+
+		result := map[string]interface{}{"description": "response " + code}
+		if response.description != "" {
+			result["description"] = response.description
+		}
+		if response.schema != nil {
+			result["schema"] = response.schema
+		}
+		if len(response.headers) > 0 {
+			headers := make(map[string]interface{}, len(response.headers))
+			for _, header := range response.headers {
+				headers[header[0]] = map[string]interface{}{"type": "string"}
+			}
+			result["headers"] = headers
+		}
+		if response.body != "" {
+			mediaType := response.mediaType
+			if mediaType == "" {
+				mediaType = "text/plain"
+			}
+			var example interface{} = response.body
+			if strings.Contains(mediaType, "json") {
+				var parsed interface{}
+				if err := json.Unmarshal([]byte(response.body), &parsed); err == nil {
+					example = parsed
+				}
+			}
+			result["examples"] = map[string]interface{}{mediaType: example}
+		}
+		converted[code] = result
+	}
+	if len(converted) == 0 {
+		imp.warn(section.line, "the action documents no response, it gets a default one")
+		converted["default"] = map[string]interface{}{"description": "default response"}
+	}
+	op["responses"] = converted
+	if len(produces) > 0 {
+		op["produces"] = produces
+	}
+
+	item, _ := imp.paths[pth].(map[string]interface{})
+	if item == nil {
+		item = make(map[string]interface{})
+		imp.paths[pth] = item
+	}
+	if _, ok := item[method]; ok {
+		imp.warn(section.line, "the %s action of %s is already defined, it is dropped", strings.ToUpper(method), pth)
+		return
+	}
+	item[method] = op
+}
+
+// parameter converts an uri parameter, which is required unless said otherwise
+func (imp *apibImporter) parameter(item *apibItem, in string) map[string]interface{} {
+	member := parseMember(item.text)
+	param := map[string]interface{}{"name": member.name, "in": in}
+	if in == "path" || !member.attributes["optional"] {
+		param["required"] = true
+	}
+	if member.description != "" {
+		param["description"] = member.description
+	}
+
+	base, nested := splitType(member.typeName)
+	tpe := base
+	switch base {
+	case "", "string", "number", "boolean":
+		if tpe == "" {
+			tpe = "string"
+		}
+	case "enum":
+		tpe = "string"
+		if len(nested) > 0 {
+			tpe = nested[0]
+		}
+	default:
+		imp.warn(item.line, "swagger only supports primitive parameters, the %s parameter %s is a string", base, member.name)
+		tpe = "string"
+	}
+	param["type"] = tpe
+	if member.value != "" {
+		param["x-example"] = msonValue(tpe, member.value)
+	}
+	for _, child := range item.children {
+		c := parseMember(child.text)
+		switch {
+		case c.name == "Default":
+			param["default"] = msonValue(tpe, c.value)
+		case child.text == "Members":
+			var enum []interface{}
+			for _, value := range child.children {
+				enum = append(enum, msonValue(tpe, parseMember(value.text).head))
+			}
+			param["enum"] = enum
+		}
+	}
+	return param
+}
+
+// apibPayload is a request, a response or a model
+type apibPayload struct {
+	name        string
+	mediaType   string
+	description string
+	headers     [][2]string
+	body        string
+	schema      map[string]interface{}
+}
+
+func (imp *apibImporter) payload(item *apibItem) *apibPayload {
+	match := apibPayloadItem.FindStringSubmatch(item.text)
+	if match == nil {
+		match = []string{item.text, item.text, "", ""}
+	}
+	payload := &apibPayload{name: strings.TrimSpace(match[2]), mediaType: strings.TrimSpace(match[3])}
+	text := textBlock(item.content)
+	if ref := apibModelRef.FindStringSubmatch(text); ref != nil {
+		model, ok := imp.models[ref[1]]
+		if !ok {
+			imp.warn(item.line, "the model %s isn't declared, it is dropped", ref[1])
+			return payload
+		}
+		resolved := imp.payload(model)
+		resolved.name = payload.name
+		if payload.mediaType != "" {
+			resolved.mediaType = payload.mediaType
+		}
+		return resolved
+	}
+
+	if len(item.children) == 0 {
+		payload.body = text
+	} else {
+		payload.description = text
+	}
+	var attributes map[string]interface{}
+	for _, child := range item.children {
+		switch {
+		case child.text == "Headers":
+			for _, line := range strings.Split(textBlock(child.content), "\n") {
+				parts := strings.SplitN(line, ":", 2)
+				if len(parts) != 2 {
+					continue
+				}
+				name, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+				switch strings.ToLower(name) {
+				case "content-type":
+					if payload.mediaType == "" {
+						payload.mediaType = value
+					}
+				case "accept":
+				default:
+					payload.headers = append(payload.headers, [2]string{name, value})
+				}
+			}
+		case child.text == "Body":
+			payload.body = textBlock(child.content)
+		case child.text == "Schema":
+			payload.schema = imp.jsonSchema(child.line, textBlock(child.content))
+		case parseMember(child.text).name == "Attributes":
+			attributes = imp.typeDefinition(child.line, parseMember(child.text), "", child.children)
+		}
+	}
+	// the attributes describe the body better than a json schema
+	if attributes != nil {
+		payload.schema = attributes
+	}
+	return payload
+}
+
+// jsonSchema converts the json schema of a payload
+func (imp *apibImporter) jsonSchema(line int, text string) map[string]interface{} {
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(text), &schema); err != nil {
+		imp.warn(line, "the json schema can't be read, it is dropped: %v", err)
+		return nil
+	}
+	file := &schemaFile{rel: fmt.Sprintf("line %d: #", line)}
+	schemas := &schemaImporter{}
+	schemas.refs = func(file *schemaFile, at, ref string) string {
+		schemas.warn(file, at, "%q can't be resolved in an API Blueprint, it is kept as is", ref)
+		return ref
+	}
+	converted := schemas.convert(file, "", schema)
+	imp.warnings = append(imp.warnings, schemas.warnings...)
+	return converted
+}
+
+// typeDefinition converts a MSON type, declared by its member line and its nested members
+func (imp *apibImporter) typeDefinition(line int, member *msonMember, description string, items []*apibItem) map[string]interface{} {
+	schema := imp.memberSchema(line, member, items)
+	if description != "" {
+		if _, isRef := schema["$ref"]; !isRef {
+			schema["description"] = description
+		}
+	}
+	return schema
+}
+
+// memberSchema converts a MSON member and its nested members to a schema
+func (imp *apibImporter) memberSchema(line int, member *msonMember, items []*apibItem) map[string]interface{} {
+	base, nested := splitType(member.typeName)
+
+	var properties, elements []*apibItem
+	var includes []string
+	var defaultValue, sample string
+	for _, item := range items {
+		child := parseMember(item.text)
+		switch {
+		case item.text == "Properties":
+			properties = append(properties, item.children...)
+		case item.text == "Items", item.text == "Members":
+			elements = append(elements, item.children...)
+		case child.name == "Default":
+			defaultValue = child.value
+		case child.name == "Sample":
+			sample = child.value
+		case strings.HasPrefix(item.text, "Include "):
+			includes = append(includes, strings.TrimSpace(strings.TrimPrefix(item.text, "Include ")))
+		case item.text == "One Of":
+			imp.warn(item.line, "swagger can't require one of the properties, they are optional")
+			for _, option := range item.children {
+				option.text = strings.Replace(option.text, "required", "optional", -1)
+				properties = append(properties, option)
+			}
+		case base == "array" || base == "enum":
+			elements = append(elements, item)
+		default:
+			properties = append(properties, item)
+		}
+	}
+	if base == "" {
+		base = "string"
+		if len(properties) > 0 || len(includes) > 0 {
+			base = "object"
+		}
+	}
+
+	schema := make(map[string]interface{})
+	valueType := base
+	switch base {
+	case "string", "number", "boolean", "object":
+		schema["type"] = base
+	case "array":
+		schema["type"] = "array"
+		itemType := "string"
+		items := map[string]interface{}{}
+		if len(nested) > 0 {
+			items = imp.typeSchema(line, nested[0])
+			itemType = nested[0]
+			if len(nested) > 1 {
+				imp.warn(line, "swagger arrays have a single type of items, %s is used", nested[0])
+			}
+		}
+		var examples []interface{}
+		for _, element := range elements {
+			m := parseMember(element.text)
+			if m.typeName != "" || len(element.children) > 0 {
+				if len(nested) == 0 && len(items) == 0 {
+					items = imp.memberSchema(element.line, m, element.children)
+				}
+				continue
+			}
+			examples = append(examples, msonValue(itemType, m.head))
+		}
+		if member.value != "" {
+			for _, value := range strings.Split(member.value, ",") {
+				examples = append(examples, msonValue(itemType, strings.TrimSpace(value)))
+			}
+		}
+		schema["items"] = items
+		if len(examples) > 0 {
+			schema["example"] = examples
+		}
+	case "enum":
+		valueType = "string"
+		if len(nested) > 0 {
+			valueType = nested[0]
+		}
+		schema["type"] = valueType
+		var enum []interface{}
+		for _, element := range elements {
+			enum = append(enum, msonValue(valueType, parseMember(element.text).head))
+		}
+		schema["enum"] = enum
+	default:
+		schema = imp.typeSchema(line, base)
+		valueType = ""
+	}
+
+	own := map[string]interface{}{"type": "object"}
+	ownProperties := make(map[string]interface{})
+	var required []string
+	for _, item := range properties {
+		property := parseMember(item.text)
+		if strings.HasPrefix(item.text, "Include ") {
+			includes = append(includes, strings.TrimSpace(strings.TrimPrefix(item.text, "Include ")))
+			continue
+		}
+		if property.name == "" {
+			imp.warn(item.line, "the member has no name, it is dropped")
+			continue
+		}
+		ownProperties[property.name] = imp.memberSchema(item.line, property, item.children)
+		if property.attributes["required"] {
+			required = append(required, property.name)
+		}
+	}
+	if len(ownProperties) > 0 {
+		own["properties"] = ownProperties
+	}
+	if len(required) > 0 {
+		own["required"] = required
+	}
+
+	if _, isRef := schema["$ref"]; isRef || len(includes) > 0 {
+		var allOf []interface{}
+		if isRef {
+			allOf = append(allOf, schema)
+		}
+		for _, include := range includes {
+			allOf = append(allOf, imp.typeSchema(line, include))
+		}
+		if len(ownProperties) > 0 {
+			allOf = append(allOf, own)
+		}
+		if len(allOf) == 1 {
+			return allOf[0].(map[string]interface{})
+		}
+		return map[string]interface{}{"allOf": allOf}
+	}
+	if base == "object" {
+		for key, value := range own {
+			schema[key] = value
+		}
+	} else if len(ownProperties) > 0 {
+		imp.warn(line, "a %s has no properties, they are dropped", base)
+	}
+
+	if member.description != "" {
+		schema["description"] = member.description
+	}
+	if member.attributes["nullable"] {
+		schema["x-nullable"] = true
+	}
+	if member.value != "" && valueType != "" && base != "array" && base != "object" {
+		value := msonValue(valueType, member.value)
+		switch {
+		case member.attributes["default"]:
+			schema["default"] = value
+		case member.attributes["fixed"]:
+			schema["enum"] = []interface{}{value}
+		default:
+			schema["example"] = value
+		}
+	}
+	if defaultValue != "" {
+		schema["default"] = msonValue(valueType, defaultValue)
+	}
+	if sample != "" {
+		schema["example"] = msonValue(valueType, sample)
+	}
+	return schema
+}
+
+// typeSchema refers to a primitive or a declared type
+func (imp *apibImporter) typeSchema(line int, name string) map[string]interface{} {
+	switch name {
+	case "string", "number", "boolean", "object":
+		return map[string]interface{}{"type": name}
+	case "array":
+		return map[string]interface{}{"type": "array", "items": map[string]interface{}{}}
+	}
+	if !imp.types[name] {
+		imp.warn(line, "the type %s isn't declared, it is dropped", name)
+		return map[string]interface{}{}
+	}
+	return map[string]interface{}{"$ref": "#/definitions/" + jsonpointer.Escape(definitionName(name))}
+}
+
+// msonValue converts the value of a member to its type
+func msonValue(typeName, value string) interface{} {
+	value = strings.Trim(value, "`")
+	switch typeName {
+	case "number":
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
@@ -0,0 +1,126 @@
+package importcmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const apibFixture = "../../../../fixtures/importapib/notes.apib"
+
+func TestImportAPIBlueprint(t *testing.T) {
+	doc, warnings, err := ImportAPIBlueprint(apibFixture)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"line 28: swagger supports a single schema for a response, the one of application/json is used",
+		"line 76: #/properties/reply/not: not isn't supported by swagger, it is dropped",
+		"line 93: swagger doesn't support the # expansion of uri templates, the expression {#section} is dropped",
+		"line 126: the type Unknown isn't declared, it is dropped",
+	}, warnings)
+
+	assert.Equal(t, "Notes API", doc.Info.Title)
+	assert.Equal(t, "A simple api to keep notes.", doc.Info.Description)
+	assert.Equal(t, "notes.example.com", doc.Host)
+	assert.Equal(t, "/v1", doc.BasePath)
+	assert.Equal(t, []spec.Tag{
+		spec.NewTag("Notes", "The notes and their comments.", nil),
+		spec.NewTag("Archive", "", nil),
+	}, doc.Tags)
+
+	list := doc.Paths.Paths["/notes"].Get
+	require.NotNil(t, list)
+	assert.Equal(t, "List the notes", list.Summary)
+	assert.Equal(t, []string{"Notes"}, list.Tags)
+	assert.Equal(t, []string{"application/json", "application/xml"}, list.Produces)
+	require.Len(t, list.Parameters, 2)
+	assert.Equal(t, "query", list.Parameters[0].In)
+	assert.False(t, list.Parameters[0].Required)
+	assert.Equal(t, "number", list.Parameters[1].Type)
+	assert.Equal(t, float64(10), list.Parameters[1].Default)
+	ok := list.Responses.StatusCodeResponses[200]
+	assert.Equal(t, "#/definitions/Note", refOf(*ok.Schema.Items.Schema))
+	assert.Contains(t, ok.Headers, "X-Total")
+
+	create := doc.Paths.Paths["/notes"].Post
+	require.NotNil(t, create)
+	assert.Equal(t, []string{"application/json"}, create.Consumes)
+	body := create.Parameters[len(create.Parameters)-1]
+	assert.Equal(t, "body", body.In)
+	assert.Equal(t, "#/definitions/NoteDraft", refOf(*body.Schema))
+	// the response refers to the model of the Note resource
+	created := create.Responses.StatusCodeResponses[201]
+	assert.Equal(t, "#/definitions/Note", refOf(*created.Schema))
+	assert.Equal(t, map[string]interface{}{"id": float64(1), "title": "groceries"}, created.Examples["application/json"])
+
+	note := doc.Paths.Paths["/notes/{id}"]
+	require.NotNil(t, note.Get)
+	require.NotNil(t, note.Delete)
+	require.Len(t, note.Delete.Parameters, 1)
+	assert.Equal(t, "path", note.Delete.Parameters[0].In)
+	assert.True(t, note.Delete.Parameters[0].Required)
+	assert.Contains(t, note.Get.Responses.StatusCodeResponses, 404)
+
+	comment := doc.Paths.Paths["/notes/{id}/comments"].Post
+	require.NotNil(t, comment)
+	schema := comment.Parameters[1].Schema
+	assert.Equal(t, []interface{}{"hi"}, schema.Properties["text"].Enum)
+	assert.Equal(t, true, schema.Properties["author"].Extensions["x-nullable"])
+
+	archive := doc.Paths.Paths["/archive"].Get
+	require.NotNil(t, archive)
+	assert.Equal(t, "the archive", archive.Responses.StatusCodeResponses[200].Examples["text/plain"])
+
+	draft := doc.Definitions["NoteDraft"]
+	require.Len(t, draft.AllOf, 2)
+	assert.Equal(t, "#/definitions/Metadata", refOf(draft.AllOf[0]))
+	own := draft.AllOf[1]
+	assert.Equal(t, []string{"title"}, own.Required)
+	assert.Equal(t, "groceries", own.Properties["title"].Example)
+	assert.Equal(t, []interface{}{"home", "shopping"}, own.Properties["tags"].Example)
+	assert.Equal(t, []interface{}{"low", "high"}, own.Properties["priority"].Enum)
+	assert.Equal(t, "#/definitions/NoteDraft", refOf(doc.Definitions["Note"].AllOf[0]))
+	assert.Equal(t, float64(3), doc.Definitions["Metadata"].Properties["x-rank"].Default)
+	assert.Equal(t, []interface{}{"person"}, doc.Definitions["Author"].Properties["kind"].Enum)
+}
+
+func TestParseMember(t *testing.T) {
+	member := parseMember("`x-rank`: 3 (number, required) - the rank")
+	assert.Equal(t, "x-rank", member.name)
+	assert.Equal(t, "3", member.value)
+	assert.Equal(t, "number", member.typeName)
+	assert.True(t, member.attributes["required"])
+	assert.Equal(t, "the rank", member.description)
+
+	member = parseMember("website: http://example.com - the site (optional)")
+	assert.Equal(t, "website", member.name)
+	assert.Equal(t, "http://example.com", member.value)
+	assert.Equal(t, "", member.typeName)
+	assert.Equal(t, "the site (optional)", member.description)
+
+	member = parseMember("(array[Tag])")
+	assert.Equal(t, "", member.name)
+	assert.Equal(t, "array[Tag]", member.typeName)
+}
+
+func TestImportAPIBlueprint_NotABlueprint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import-apib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pth := filepath.Join(dir, "api.md")
+	require.NoError(t, ioutil.WriteFile(pth, []byte("# An api\n"), 0644))
+	_, _, err = ImportAPIBlueprint(pth)
+	assert.EqualError(t, err, pth+" is not an API Blueprint document: it doesn't start with FORMAT: 1A")
+
+	require.NoError(t, ioutil.WriteFile(pth, []byte("FORMAT: X-1\n\n# An api\n"), 0644))
+	_, _, err = ImportAPIBlueprint(pth)
+	assert.EqualError(t, err, pth+" is an API Blueprint X-1 document, only the format 1A is supported")
+
+	cmd := &APIBlueprint{}
+	assert.Error(t, cmd.Execute(nil))
+}
@@ -0,0 +1,870 @@
+package importcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// the keywords of an OpenAPI 3.0 schema that swagger schemas support as they are
+var openAPI3SchemaKeywords = map[string]struct{}{
+	"type":             struct{}{},
+	"format":           struct{}{},
+	"title":            struct{}{},
+	"description":      struct{}{},
+	"default":          struct{}{},
+	"multipleOf":       struct{}{},
+	"maximum":          struct{}{},
+	"exclusiveMaximum": struct{}{},
+	"minimum":          struct{}{},
+	"exclusiveMinimum": struct{}{},
+	"maxLength":        struct{}{},
+	"minLength":        struct{}{},
+	"pattern":          struct{}{},
+	"maxItems":         struct{}{},
+	"minItems":         struct{}{},
+	"uniqueItems":      struct{}{},
+	"maxProperties":    struct{}{},
+	"minProperties":    struct{}{},
+	"required":         struct{}{},
+	"enum":             struct{}{},
+	"readOnly":         struct{}{},
+	"xml":              struct{}{},
+	"externalDocs":     struct{}{},
+	"example":          struct{}{},
+}
+
+// the validations a non-body parameter, a header or items take from their schema
+var simpleSchemaKeywords = []string{
+	"type", "format", "default", "maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum",
+	"maxLength", "minLength", "pattern", "maxItems", "minItems", "uniqueItems", "enum", "multipleOf",
+}
+
+var openAPI3Methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// OpenAPI3 a command struct to convert an OpenAPI 3.0 spec to a swagger 2.0 spec
+type OpenAPI3 struct {
+	Output flags.Filename `long:"output" short:"o" description:"the file to write the spec to, as json when it ends with .json and as yaml otherwise (default stdout, as yaml)"`
+}
+
+// Execute this command
+func (o *OpenAPI3) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("the import openapi3 command requires the OpenAPI 3.0 spec")
+	}
+	b, err := ReadDocument(args[0])
+	if err != nil {
+		return err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return fmt.Errorf("%s is not an OpenAPI spec: %v", args[0], err)
+	}
+
+	downgraded, warnings, err := DowngradeOpenAPI3(doc)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		log.Println("warning:", warning)
+	}
+
+	if strings.HasSuffix(string(o.Output), ".json") {
+		b, err = json.MarshalIndent(downgraded, "", "  ")
+	} else {
+		b, err = yaml.Marshal(swag.ToDynamicJSON(downgraded))
+	}
+	if err != nil {
+		return err
+	}
+	if o.Output == "" {
+		fmt.Print(string(b))
+		return nil
+	}
+	log.Printf("converted %s into %s", args[0], o.Output)
+	return ioutil.WriteFile(string(o.Output), b, 0644)
+}
+
+// ReadDocument reads a json or a yaml document, local or remote, as json
+func ReadDocument(path string) (json.RawMessage, error) {
+	if swag.YAMLMatcher(path) {
+		return swag.YAMLDoc(path)
+	}
+	return loads.JSONDoc(path)
+}
+
+var (
+	warnedMu sync.Mutex
+	warned   = make(map[string]bool)
+)
+
+// LoadDocument loads a json or a yaml document like the default loaders, and converts an OpenAPI 3.0 spec
+// to a swagger 2.0 spec on the fly. The warnings of the conversion are logged, once for each document.
+func LoadDocument(path string) (json.RawMessage, error) {
+	return DocumentLoader(ReadDocument)(path)
+}
+
+// DocumentLoader is a loader like LoadDocument, which reads the documents with read
+func DocumentLoader(read func(string) (json.RawMessage, error)) func(string) (json.RawMessage, error) {
+	return func(path string) (json.RawMessage, error) {
+		b, err := read(path)
+		if err != nil {
+			return nil, err
+		}
+		return convertDocument(path, b)
+	}
+}
+
+func convertDocument(path string, b json.RawMessage) (json.RawMessage, error) {
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(b, &version); err != nil || !strings.HasPrefix(version.OpenAPI, "3.0.") {
+		// not an OpenAPI 3.0 spec: a swagger spec, or a document a spec refers to
+		return b, nil
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	downgraded, warnings, err := DowngradeOpenAPI3(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	warnedMu.Lock()
+	if !warned[path] {
+		warned[path] = true
+		log.Printf("%s is an OpenAPI %s spec, it is converted to swagger 2.0", path, version.OpenAPI)
+		for _, warning := range warnings {
+			log.Println("warning:", warning)
+		}
+	}
+	warnedMu.Unlock()
+	return json.Marshal(downgraded)
+}
+
+// DowngradeOpenAPI3 converts an OpenAPI 3.0 spec to a swagger 2.0 spec.
+//
+// The components become definitions, parameters, responses and security definitions, the request bodies
+// become body or form data parameters, and the first server becomes the host, base path and schemes.
+// What swagger 2.0 can't describe is dropped, with a warning that tells where it was found.
+func DowngradeOpenAPI3(doc map[string]interface{}) (map[string]interface{}, []string, error) {
+	version, _ := doc["openapi"].(string)
+	if !strings.HasPrefix(version, "3.0.") {
+		return nil, nil, fmt.Errorf("unsupported OpenAPI version %q, expected 3.0.x", version)
+	}
+
+	d := &downgrader{doc: doc}
+	result := map[string]interface{}{"swagger": "2.0"}
+	for _, key := range sortedKeys(doc) {
+		value := doc[key]
+		at := "#/" + jsonpointer.Escape(key)
+		switch key {
+		case "openapi":
+		case "info", "tags", "externalDocs", "security":
+			result[key] = value
+		case "servers":
+			servers, _ := value.([]interface{})
+			d.servers(at, servers, result)
+		case "paths":
+			paths, _ := value.(map[string]interface{})
+			result[key] = d.paths(at, paths)
+		case "components":
+			components, _ := value.(map[string]interface{})
+			d.components(at, components, result)
+		default:
+			if isExtension(key) {
+				result[key] = value
+				continue
+			}
+			d.warn(at, "%s isn't supported by swagger, it is dropped", key)
+		}
+	}
+	if _, ok := result["paths"]; !ok {
+		result["paths"] = map[string]interface{}{}
+	}
+	return result, d.warnings, nil
+}
+
+type downgrader struct {
+	doc      map[string]interface{}
+	warnings []string
+}
+
+func (d *downgrader) warn(location, format string, args ...interface{}) {
+	d.warnings = append(d.warnings, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, args...)))
+}
+
+func isExtension(key string) bool {
+	return strings.HasPrefix(strings.ToLower(key), "x-")
+}
+
+// servers keeps the first server, swagger 2.0 describes a single host and base path for all its schemes
+func (d *downgrader) servers(location string, servers []interface{}, result map[string]interface{}) {
+	var host, basePath string
+	var schemes []interface{}
+	var kept bool
+	for i, s := range servers {
+		server, _ := s.(map[string]interface{})
+		at := fmt.Sprintf("%s/%d", location, i)
+		raw, _ := server["url"].(string)
+		if variables, ok := server["variables"].(map[string]interface{}); ok {
+			for _, name := range sortedKeys(variables) {
+				variable, _ := variables[name].(map[string]interface{})
+				raw = strings.Replace(raw, "{"+name+"}", fmt.Sprintf("%v", variable["default"]), -1)
+			}
+		}
+		u, err := url.Parse(raw)
+		if err != nil || strings.Contains(raw, "{") {
+			d.warn(at, "the url %q can't be used as the host and base path, it is dropped", raw)
+			continue
+		}
+		if kept && (u.Host != host || u.Path != basePath) {
+			d.warn(at, "swagger supports a single host and base path, the server %q is dropped", raw)
+			continue
+		}
+		host, basePath, kept = u.Host, u.Path, true
+		if u.Scheme != "" {
+			schemes = append(schemes, u.Scheme)
+		}
+	}
+	if host != "" {
+		result["host"] = host
+	}
+	if basePath != "" && basePath != "/" {
+		result["basePath"] = basePath
+	}
+	if len(schemes) > 0 {
+		result["schemes"] = schemes
+	}
+}
+
+func (d *downgrader) components(location string, components map[string]interface{}, result map[string]interface{}) {
+	for _, key := range sortedKeys(components) {
+		at := location + "/" + jsonpointer.Escape(key)
+		entries, _ := components[key].(map[string]interface{})
+		converted := make(map[string]interface{}, len(entries))
+		for _, name := range sortedKeys(entries) {
+			entry, _ := entries[name].(map[string]interface{})
+			entryAt := at + "/" + jsonpointer.Escape(name)
+			switch key {
+			case "schemas":
+				converted[name] = d.schema(entryAt, entry)
+			case "parameters":
+				if param := d.parameter(entryAt, entry); param != nil {
+					converted[name] = param
+				}
+			case "responses":
+				converted[name] = d.response(entryAt, entry, nil)
+			case "securitySchemes":
+				if scheme := d.securityScheme(entryAt, entry); scheme != nil {
+					converted[name] = scheme
+				}
+			}
+		}
+		switch key {
+		case "schemas":
+			result["definitions"] = converted
+		case "parameters":
+			result["parameters"] = converted
+		case "responses":
+			result["responses"] = converted
+		case "securitySchemes":
+			result["securityDefinitions"] = converted
+		case "requestBodies", "headers":
+			// swagger 2.0 has no such components, they are inlined where they are used
+		default:
+			if !isExtension(key) {
+				d.warn(at, "%s aren't supported by swagger, they are dropped", key)
+			}
+		}
+	}
+}
+
+func (d *downgrader) securityScheme(location string, scheme map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if description, ok := scheme["description"]; ok {
+		result["description"] = description
+	}
+	switch scheme["type"] {
+	case "apiKey":
+		if scheme["in"] == "cookie" {
+			d.warn(location, "swagger doesn't support api keys in cookies, the scheme is dropped")
+			return nil
+		}
+		result["type"] = "apiKey"
+		result["name"] = scheme["name"]
+		result["in"] = scheme["in"]
+	case "http":
+		switch strings.ToLower(fmt.Sprintf("%v", scheme["scheme"])) {
+		case "basic":
+			result["type"] = "basic"
+		case "bearer":
+			d.warn(location, "swagger has no bearer scheme, it becomes an api key in the Authorization header")
+			result["type"] = "apiKey"
+			result["name"] = "Authorization"
+			result["in"] = "header"
+		default:
+			d.warn(location, "swagger doesn't support the http scheme %v, the scheme is dropped", scheme["scheme"])
+			return nil
+		}
+	case "oauth2":
+		flows, _ := scheme["flows"].(map[string]interface{})
+		names := sortedKeys(flows)
+		if len(names) == 0 {
+			d.warn(location, "the oauth2 scheme has no flow, it is dropped")
+			return nil
+		}
+		if len(names) > 1 {
+			d.warn(location, "swagger supports a single oauth2 flow, only %s is kept", names[0])
+		}
+		flow, _ := flows[names[0]].(map[string]interface{})
+		result["type"] = "oauth2"
+		result["flow"] = map[string]string{
+			"implicit":          "implicit",
+			"password":          "password",
+			"clientCredentials": "application",
+			"authorizationCode": "accessCode",
+		}[names[0]]
+		for _, key := range []string{"authorizationUrl", "tokenUrl", "scopes"} {
+			if value, ok := flow[key]; ok {
+				result[key] = value
+			}
+		}
+	default:
+		d.warn(location, "swagger doesn't support %v security schemes, the scheme is dropped", scheme["type"])
+		return nil
+	}
+	return result
+}
+
+func (d *downgrader) paths(location string, paths map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(paths))
+	for _, pth := range sortedKeys(paths) {
+		at := location + "/" + jsonpointer.Escape(pth)
+		item, _ := paths[pth].(map[string]interface{})
+		converted := make(map[string]interface{})
+		for _, key := range sortedKeys(item) {
+			value := item[key]
+			keyAt := at + "/" + jsonpointer.Escape(key)
+			switch {
+			case key == "$ref":
+				converted[key] = value
+			case key == "parameters":
+				params, _ := value.([]interface{})
+				converted[key] = d.parameters(keyAt, params)
+			case isMethod(key):
+				operation, _ := value.(map[string]interface{})
+				converted[key] = d.operation(keyAt, operation)
+			case isExtension(key):
+				converted[key] = value
+			default:
+				d.warn(keyAt, "%s isn't supported by swagger on a path, it is dropped", key)
+			}
+		}
+		result[pth] = converted
+	}
+	return result
+}
+
+func isMethod(key string) bool {
+	for _, method := range openAPI3Methods {
+		if key == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *downgrader) operation(location string, operation map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	var params []interface{}
+	var produces []string
+	for _, key := range sortedKeys(operation) {
+		value := operation[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		switch key {
+		case "tags", "summary", "description", "externalDocs", "operationId", "deprecated", "security":
+			result[key] = value
+		case "parameters":
+			list, _ := value.([]interface{})
+			params = append(d.parameters(at, list), params...)
+		case "requestBody":
+			body, _ := value.(map[string]interface{})
+			bodyParams, consumes := d.requestBody(at, body)
+			params = append(params, bodyParams...)
+			if len(consumes) > 0 {
+				result["consumes"] = consumes
+			}
+		case "responses":
+			responses, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(responses))
+			for _, code := range sortedKeys(responses) {
+				response, _ := responses[code].(map[string]interface{})
+				converted[code] = d.response(at+"/"+jsonpointer.Escape(code), response, &produces)
+			}
+			result[key] = converted
+		default:
+			if isExtension(key) {
+				result[key] = value
+				continue
+			}
+			d.warn(at, "%s isn't supported by swagger, it is dropped", key)
+		}
+	}
+	if len(params) > 0 {
+		result["parameters"] = params
+	}
+	if len(produces) > 0 {
+		result["produces"] = produces
+	}
+	return result
+}
+
+func (d *downgrader) parameters(location string, params []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(params))
+	for i, p := range params {
+		param, _ := p.(map[string]interface{})
+		if converted := d.parameter(fmt.Sprintf("%s/%d", location, i), param); converted != nil {
+			result = append(result, converted)
+		}
+	}
+	return result
+}
+
+// parameter converts a parameter, its schema becomes the type and validations of the parameter
+func (d *downgrader) parameter(location string, param map[string]interface{}) map[string]interface{} {
+	if ref, ok := param["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": d.ref(location, ref)}
+	}
+	if param["in"] == "cookie" {
+		d.warn(location, "swagger doesn't support cookie parameters, %v is dropped", param["name"])
+		return nil
+	}
+	result := make(map[string]interface{})
+	for _, key := range sortedKeys(param) {
+		value := param[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		switch key {
+		case "name", "in", "description", "required", "allowEmptyValue":
+			result[key] = value
+		case "schema":
+			schema, _ := value.(map[string]interface{})
+			if !d.simpleSchema(at, schema, result) {
+				d.warn(location, "swagger only supports primitive and array parameters, %v is dropped", param["name"])
+				return nil
+			}
+		case "style", "explode":
+			// they become the collection format of an array
+		case "content":
+			d.warn(at, "swagger doesn't support parameters described by a media type, %v is dropped", param["name"])
+			return nil
+		case "deprecated", "example", "examples":
+			d.warn(at, "%s isn't supported by swagger on a parameter, it is dropped", key)
+		default:
+			if isExtension(key) {
+				result[key] = value
+				continue
+			}
+			d.warn(at, "%s isn't supported by swagger on a parameter, it is dropped", key)
+		}
+	}
+	if _, ok := result["type"]; !ok {
+		d.warn(location, "the parameter %v has no type, it is dropped", param["name"])
+		return nil
+	}
+	if result["type"] == "array" {
+		if format := collectionFormat(param); format != "" {
+			result["collectionFormat"] = format
+		}
+	}
+	return result
+}
+
+// collectionFormat is the swagger equivalent of the style and explode of an array parameter
+func collectionFormat(param map[string]interface{}) string {
+	style, _ := param["style"].(string)
+	if style == "" {
+		style = "simple"
+		if param["in"] == "query" {
+			style = "form"
+		}
+	}
+	explode, ok := param["explode"].(bool)
+	if !ok {
+		explode = style == "form"
+	}
+	switch style {
+	case "form":
+		if explode {
+			return "multi"
+		}
+		return "csv"
+	case "spaceDelimited":
+		return "ssv"
+	case "pipeDelimited":
+		return "pipes"
+	}
+	return "csv"
+}
+
+// simpleSchema copies the type and validations of a primitive or array schema into a parameter, a header or items.
+// It tells if the schema could be converted.
+func (d *downgrader) simpleSchema(location string, schema map[string]interface{}, result map[string]interface{}) bool {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, ok := d.resolve(ref)
+		if !ok {
+			return false
+		}
+		schema = resolved
+	}
+	if schema["type"] == "object" || schema["type"] == nil {
+		return false
+	}
+	for _, key := range simpleSchemaKeywords {
+		if value, ok := schema[key]; ok {
+			result[key] = value
+		}
+	}
+	if schema["type"] == "array" {
+		items, _ := schema["items"].(map[string]interface{})
+		converted := make(map[string]interface{})
+		if !d.simpleSchema(location+"/items", items, converted) {
+			return false
+		}
+		result["items"] = converted
+	}
+	return true
+}
+
+// resolve finds the target of a $ref inside the spec
+func (d *downgrader) resolve(ref string) (map[string]interface{}, bool) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, false
+	}
+	ptr, err := jsonpointer.New(strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return nil, false
+	}
+	target, _, err := ptr.Get(d.doc)
+	if err != nil {
+		return nil, false
+	}
+	resolved, ok := target.(map[string]interface{})
+	return resolved, ok
+}
+
+// ref points a $ref at the swagger 2.0 components
+func (d *downgrader) ref(location, ref string) string {
+	for prefix, replacement := range map[string]string{
+		"#/components/schemas/":    "#/definitions/",
+		"#/components/parameters/": "#/parameters/",
+		"#/components/responses/":  "#/responses/",
+	} {
+		if strings.HasPrefix(ref, prefix) {
+			return replacement + strings.TrimPrefix(ref, prefix)
+		}
+	}
+	if strings.Contains(ref, "#/components/") {
+		d.warn(location, "%q can't be converted, it is kept as is", ref)
+	}
+	return ref
+}
+
+// requestBody converts a request body to a body parameter, or to form data parameters for a form,
+// and returns them with the media types it consumes
+func (d *downgrader) requestBody(location string, body map[string]interface{}) ([]interface{}, []string) {
+	if ref, ok := body["$ref"].(string); ok {
+		resolved, ok := d.resolve(ref)
+		if !ok {
+			d.warn(location, "%q can't be resolved, the request body is dropped", ref)
+			return nil, nil
+		}
+		body = resolved
+	}
+	content, _ := body["content"].(map[string]interface{})
+	mediaTypes := preferJSON(sortedKeys(content))
+	if len(mediaTypes) == 0 {
+		return nil, nil
+	}
+	media, _ := content[mediaTypes[0]].(map[string]interface{})
+	schema, _ := media["schema"].(map[string]interface{})
+	for _, mediaType := range mediaTypes[1:] {
+		other, _ := content[mediaType].(map[string]interface{})
+		if !jsonEqual(other["schema"], media["schema"]) {
+			d.warn(location+"/content/"+jsonpointer.Escape(mediaType), "swagger supports a single schema for a request body, the one of %s is used", mediaTypes[0])
+		}
+	}
+
+	required, _ := body["required"].(bool)
+	if isForm(mediaTypes[0]) {
+		return d.formParameters(location+"/content/"+jsonpointer.Escape(mediaTypes[0])+"/schema", schema), mediaTypes
+	}
+
+	name := "body"
+	if extension, ok := body["x-codegen-request-body-name"].(string); ok {
+		name = extension
+	}
+	param := map[string]interface{}{
+		"name":     name,
+		"in":       "body",
+		"required": required,
+		"schema":   d.schema(location+"/content/"+jsonpointer.Escape(mediaTypes[0])+"/schema", schema),
+	}
+	if description, ok := body["description"]; ok {
+		param["description"] = description
+	}
+	return []interface{}{param}, mediaTypes
+}
+
+// formParameters converts the properties of the schema of a form to form data parameters
+func (d *downgrader) formParameters(location string, schema map[string]interface{}) []interface{} {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, ok := d.resolve(ref)
+		if !ok {
+			d.warn(location, "%q can't be resolved, the form is dropped", ref)
+			return nil
+		}
+		schema = resolved
+	}
+	required := make(map[string]bool)
+	if names, ok := schema["required"].([]interface{}); ok {
+		for _, name := range names {
+			required[fmt.Sprintf("%v", name)] = true
+		}
+	}
+	properties, _ := schema["properties"].(map[string]interface{})
+	params := make([]interface{}, 0, len(properties))
+	for _, name := range sortedKeys(properties) {
+		property, _ := properties[name].(map[string]interface{})
+		at := location + "/properties/" + jsonpointer.Escape(name)
+		param := map[string]interface{}{"name": name, "in": "formData", "required": required[name]}
+		if description, ok := property["description"]; ok {
+			param["description"] = description
+		}
+		if property["type"] == "string" && property["format"] == "binary" {
+			param["type"] = "file"
+		} else if !d.simpleSchema(at, property, param) {
+			d.warn(at, "swagger only supports primitive and array form data, %s is dropped", name)
+			continue
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// response converts a response, its media types are added to the ones the operation produces
+func (d *downgrader) response(location string, response map[string]interface{}, produces *[]string) map[string]interface{} {
+	if ref, ok := response["$ref"].(string); ok {
+		if produces != nil {
+			if resolved, ok := d.resolve(ref); ok {
+				content, _ := resolved["content"].(map[string]interface{})
+				*produces = appendMissing(*produces, preferJSON(sortedKeys(content))...)
+			}
+		}
+		return map[string]interface{}{"$ref": d.ref(location, ref)}
+	}
+
+	result := map[string]interface{}{"description": response["description"]}
+	for _, key := range sortedKeys(response) {
+		value := response[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		switch key {
+		case "description":
+		case "content":
+			content, _ := value.(map[string]interface{})
+			mediaTypes := preferJSON(sortedKeys(content))
+			if produces != nil {
+				*produces = appendMissing(*produces, mediaTypes...)
+			}
+			if len(mediaTypes) == 0 {
+				continue
+			}
+			media, _ := content[mediaTypes[0]].(map[string]interface{})
+			if schema, ok := media["schema"].(map[string]interface{}); ok {
+				result["schema"] = d.schema(at+"/"+jsonpointer.Escape(mediaTypes[0])+"/schema", schema)
+			}
+			if example, ok := media["example"]; ok {
+				result["examples"] = map[string]interface{}{mediaTypes[0]: example}
+			}
+			for _, mediaType := range mediaTypes[1:] {
+				other, _ := content[mediaType].(map[string]interface{})
+				if !jsonEqual(other["schema"], media["schema"]) {
+					d.warn(at+"/"+jsonpointer.Escape(mediaType), "swagger supports a single schema for a response, the one of %s is used", mediaTypes[0])
+				}
+			}
+		case "headers":
+			headers, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(headers))
+			for _, name := range sortedKeys(headers) {
+				header, _ := headers[name].(map[string]interface{})
+				if header := d.header(at+"/"+jsonpointer.Escape(name), header); header != nil {
+					converted[name] = header
+				}
+			}
+			result[key] = converted
+		default:
+			if isExtension(key) {
+				result[key] = value
+				continue
+			}
+			d.warn(at, "%s isn't supported by swagger on a response, it is dropped", key)
+		}
+	}
+	return result
+}
+
+func (d *downgrader) header(location string, header map[string]interface{}) map[string]interface{} {
+	if ref, ok := header["$ref"].(string); ok {
+		resolved, ok := d.resolve(ref)
+		if !ok {
+			d.warn(location, "%q can't be resolved, the header is dropped", ref)
+			return nil
+		}
+		header = resolved
+	}
+	result := make(map[string]interface{})
+	if description, ok := header["description"]; ok {
+		result["description"] = description
+	}
+	schema, _ := header["schema"].(map[string]interface{})
+	if !d.simpleSchema(location+"/schema", schema, result) {
+		d.warn(location, "swagger only supports primitive and array headers, the header is dropped")
+		return nil
+	}
+	return result
+}
+
+// schema converts an OpenAPI 3.0 schema to a swagger schema
+func (d *downgrader) schema(location string, schema map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(schema))
+	for _, key := range sortedKeys(schema) {
+		value := schema[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		if _, ok := openAPI3SchemaKeywords[key]; ok {
+			result[key] = value
+			continue
+		}
+		if isExtension(key) {
+			result[key] = value
+			continue
+		}
+
+		switch key {
+		case "$ref":
+			ref, _ := value.(string)
+			result[key] = d.ref(at, ref)
+
+		case "nullable":
+			if nullable, ok := value.(bool); ok && nullable {
+				result["x-nullable"] = true
+			}
+
+		case "discriminator":
+			discriminator, _ := value.(map[string]interface{})
+			result[key] = discriminator["propertyName"]
+			if _, ok := discriminator["mapping"]; ok {
+				d.warn(at+"/mapping", "swagger discriminators use the names of the definitions, the mapping is dropped")
+			}
+
+		case "oneOf":
+			members, _ := value.([]interface{})
+			result["x-one-of"] = d.schemaList(at, members)
+
+		case "allOf":
+			members, _ := value.([]interface{})
+			result[key] = d.schemaList(at, members)
+
+		case "items":
+			items, _ := value.(map[string]interface{})
+			result[key] = d.schema(at, items)
+
+		case "properties":
+			properties, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(properties))
+			for _, name := range sortedKeys(properties) {
+				if prop, ok := properties[name].(map[string]interface{}); ok {
+					converted[name] = d.schema(at+"/"+jsonpointer.Escape(name), prop)
+				}
+			}
+			result[key] = converted
+
+		case "additionalProperties":
+			if additional, ok := value.(map[string]interface{}); ok {
+				result[key] = d.schema(at, additional)
+				continue
+			}
+			result[key] = value
+
+		default:
+			d.warn(at, "%s isn't supported by swagger, it is dropped", key)
+		}
+	}
+	return result
+}
+
+func (d *downgrader) schemaList(location string, members []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(members))
+	for i, member := range members {
+		if schema, ok := member.(map[string]interface{}); ok {
+			result = append(result, d.schema(fmt.Sprintf("%s/%d", location, i), schema))
+		}
+	}
+	return result
+}
+
+func isForm(mediaType string) bool {
+	return mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data"
+}
+
+// preferJSON puts the json media types first: their schema is the one swagger keeps
+func preferJSON(mediaTypes []string) []string {
+	result := make([]string, 0, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		if strings.Contains(mediaType, "json") {
+			result = append(result, mediaType)
+		}
+	}
+	for _, mediaType := range mediaTypes {
+		if !strings.Contains(mediaType, "json") {
+			result = append(result, mediaType)
+		}
+	}
+	return result
+}
+
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func jsonEqual(a, b interface{}) bool {
+	ja, erra := json.Marshal(a)
+	jb, errb := json.Marshal(b)
+	return erra == nil && errb == nil && string(ja) == string(jb)
+}
@@ -0,0 +1,105 @@
+package importcmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const openAPI3Fixture = "../../../../fixtures/importopenapi3/petstore.yml"
+
+func TestDowngradeOpenAPI3(t *testing.T) {
+	b, err := ReadDocument(openAPI3Fixture)
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &doc))
+
+	downgraded, warnings, err := DowngradeOpenAPI3(doc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"#/components/schemas/Pet/properties/secret/writeOnly: writeOnly isn't supported by swagger, it is dropped",
+		"#/components/securitySchemes/oauth: swagger supports a single oauth2 flow, only clientCredentials is kept",
+		"#/components/securitySchemes/token: swagger has no bearer scheme, it becomes an api key in the Authorization header",
+		"#/paths/~1pets/get/parameters/2: swagger doesn't support cookie parameters, session is dropped",
+		"#/paths/~1pets/get/responses/200/content/application~1xml: swagger supports a single schema for a response, the one of application/json is used",
+		"#/paths/~1pets/post/callbacks: callbacks isn't supported by swagger, it is dropped",
+		`#/servers/2: swagger supports a single host and base path, the server "https://staging.petstore.example.com/v2" is dropped`,
+	}, warnings)
+
+	b, err = json.Marshal(downgraded)
+	require.NoError(t, err)
+	specDoc, err := loads.Analyzed(b, "")
+	require.NoError(t, err)
+	sw := specDoc.Spec()
+
+	assert.Equal(t, "2.0", sw.Swagger)
+	assert.Equal(t, "eu.petstore.example.com", sw.Host)
+	assert.Equal(t, "/v1", sw.BasePath)
+	assert.Equal(t, []string{"https", "http"}, sw.Schemes)
+
+	assert.Len(t, sw.Definitions, 5)
+	pet := sw.Definitions["Pet"]
+	assert.Equal(t, true, pet.Properties["nickname"].Extensions["x-nullable"])
+	oneOf, ok := pet.Properties["food"].Extensions["x-one-of"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"$ref": "#/definitions/Kibble"}, oneOf[0])
+
+	list := sw.Paths.Paths["/pets"].Get
+	require.NotNil(t, list)
+	require.Len(t, list.Parameters, 2)
+	assert.Equal(t, "multi", list.Parameters[0].CollectionFormat)
+	assert.Equal(t, "integer", list.Parameters[1].Type)
+	require.NotNil(t, list.Parameters[1].Maximum)
+	assert.Equal(t, float64(100), *list.Parameters[1].Maximum)
+	assert.Equal(t, []string{"application/json", "application/xml"}, list.Produces)
+	ok200 := list.Responses.StatusCodeResponses[200]
+	assert.Equal(t, "#/definitions/Pet", ok200.Schema.Items.Schema.Ref.String())
+	assert.Equal(t, "string", ok200.Headers["X-Next"].Type)
+	assert.Equal(t, "#/responses/Error", list.Responses.Default.Ref.String())
+
+	create := sw.Paths.Paths["/pets"].Post
+	require.NotNil(t, create)
+	require.Len(t, create.Parameters, 1)
+	assert.Equal(t, "body", create.Parameters[0].In)
+	assert.True(t, create.Parameters[0].Required)
+	assert.Equal(t, "#/definitions/Pet", create.Parameters[0].Schema.Ref.String())
+	assert.Equal(t, []string{"application/json"}, create.Consumes)
+
+	photo := sw.Paths.Paths["/pets/{id}/photo"]
+	assert.Equal(t, "#/parameters/PetID", photo.Parameters[0].Ref.String())
+	require.NotNil(t, photo.Put)
+	require.Len(t, photo.Put.Parameters, 2)
+	assert.Equal(t, "caption", photo.Put.Parameters[0].Name)
+	assert.Equal(t, "photo", photo.Put.Parameters[1].Name)
+	assert.Equal(t, "file", photo.Put.Parameters[1].Type)
+	assert.True(t, photo.Put.Parameters[1].Required)
+
+	assert.Equal(t, "application", sw.SecurityDefinitions["oauth"].Flow)
+	assert.Equal(t, &spec.SecurityScheme{SecuritySchemeProps: spec.SecuritySchemeProps{Type: "apiKey", Name: "Authorization", In: "header"}}, sw.SecurityDefinitions["token"])
+}
+
+func TestDowngradeOpenAPI3_Version(t *testing.T) {
+	_, _, err := DowngradeOpenAPI3(map[string]interface{}{"openapi": "3.1.0"})
+	assert.EqualError(t, err, `unsupported OpenAPI version "3.1.0", expected 3.0.x`)
+}
+
+func TestLoadDocument(t *testing.T) {
+	b, err := LoadDocument(openAPI3Fixture)
+	require.NoError(t, err)
+	var version struct {
+		Swagger string `json:"swagger"`
+	}
+	require.NoError(t, json.Unmarshal(b, &version))
+	assert.Equal(t, "2.0", version.Swagger)
+
+	// the other documents are loaded as they are
+	b, err = LoadDocument("../../../../fixtures/importschema/pet.json")
+	require.NoError(t, err)
+	expected, err := ReadDocument("../../../../fixtures/importschema/pet.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(b))
+}
@@ -0,0 +1,1028 @@
+package importcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+)
+
+var (
+	ramlHeader  = regexp.MustCompile(`^#%RAML (0\.8|1\.0)[ \t]*(\S*)`)
+	includeLine = regexp.MustCompile(`^(\s*)((-\s+)?(?:[^\s#:-][^:]*:\s+)?)!include\s+(\S+)\s*$`)
+	uriParam    = regexp.MustCompile(`{([^}]+)}`)
+)
+
+var ramlMethods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// the facets of a RAML type that swagger schemas support as they are
+var ramlFacets = map[string]string{
+	"description":   "description",
+	"displayName":   "title",
+	"default":       "default",
+	"example":       "example",
+	"enum":          "enum",
+	"pattern":       "pattern",
+	"minLength":     "minLength",
+	"maxLength":     "maxLength",
+	"minimum":       "minimum",
+	"maximum":       "maximum",
+	"multipleOf":    "multipleOf",
+	"minItems":      "minItems",
+	"maxItems":      "maxItems",
+	"uniqueItems":   "uniqueItems",
+	"minProperties": "minProperties",
+	"maxProperties": "maxProperties",
+	"xml":           "xml",
+}
+
+// the formats of the RAML numbers, by the swagger format they become
+var ramlNumberFormats = map[string]string{
+	"int8":   "int32",
+	"int16":  "int32",
+	"int32":  "int32",
+	"int":    "int32",
+	"int64":  "int64",
+	"long":   "int64",
+	"float":  "float",
+	"double": "double",
+}
+
+// RAML a command struct to import a RAML 0.8 or 1.0 api as a new spec
+type RAML struct {
+	Output flags.Filename `long:"output" short:"o" description:"the file to write the spec to, as json when it ends with .json and as yaml otherwise (default stdout, as yaml)"`
+}
+
+// Execute this command
+func (r *RAML) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("the import raml command requires the RAML api")
+	}
+	doc, warnings, err := ImportRAML(args[0])
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		log.Println("warning:", warning)
+	}
+	if len(warnings) > 0 {
+		log.Printf("%d features of %s couldn't be mapped to swagger", len(warnings), args[0])
+	}
+
+	var b []byte
+	if strings.HasSuffix(string(r.Output), ".json") {
+		b, err = json.MarshalIndent(doc, "", "  ")
+	} else {
+		b, err = yaml.Marshal(swag.ToDynamicJSON(doc))
+	}
+	if err != nil {
+		return err
+	}
+	if r.Output == "" {
+		fmt.Print(string(b))
+		return nil
+	}
+	log.Printf("imported %s into %s", args[0], r.Output)
+	return ioutil.WriteFile(string(r.Output), b, 0644)
+}
+
+// ImportRAML converts a RAML 0.8 or 1.0 api to a swagger spec.
+//
+// The resources and their methods become paths and operations, the types (or the schemas of RAML 0.8) become
+// definitions, and the traits the methods are marked with are merged into them.
+// The features swagger can't describe are reported as warnings that tell where they were found.
+func ImportRAML(path string) (*spec.Swagger, []string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	header := ramlHeader.FindStringSubmatch(string(b))
+	if header == nil {
+		return nil, nil, fmt.Errorf("%s is not a RAML document: it doesn't start with #%%RAML 0.8 or #%%RAML 1.0", path)
+	}
+	if header[2] != "" {
+		return nil, nil, fmt.Errorf("%s is a RAML %s fragment, import the api which uses it", path, header[2])
+	}
+	inlined, err := inlineIncludes(path, string(b))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	yml, err := swag.BytesToYAMLDoc([]byte(inlined))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+	jsn, err := swag.YAMLToJSON(yml)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+	var api map[string]interface{}
+	if err := json.Unmarshal(jsn, &api); err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	imp := &ramlImporter{
+		version:     header[1],
+		api:         api,
+		types:       make(map[string]interface{}),
+		definitions: make(map[string]interface{}),
+	}
+	doc, err := imp.importAPI()
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(imp.warnings)
+	return doc, imp.warnings, nil
+}
+
+// inlineIncludes replaces the !include tags by the content of the files they refer to:
+// the RAML and yaml files as yaml, the other ones as a text block
+func inlineIncludes(path, content string) (string, error) {
+	lines := strings.Split(content, "\n")
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		match := includeLine.FindStringSubmatch(line)
+		if match == nil {
+			result = append(result, line)
+			continue
+		}
+		included := match[4]
+		if !filepath.IsAbs(included) {
+			included = filepath.Join(filepath.Dir(path), filepath.FromSlash(included))
+		}
+		b, err := ioutil.ReadFile(included)
+		if err != nil {
+			return "", fmt.Errorf("%s: can't include %s: %v", path, match[4], err)
+		}
+		// the content is indented under the key, or under the item of a list
+		indent := match[1] + strings.Repeat(" ", len(match[3])) + "  "
+
+		text := string(b)
+		switch strings.ToLower(filepath.Ext(included)) {
+		case ".raml", ".yaml", ".yml":
+			if text, err = inlineIncludes(included, text); err != nil {
+				return "", err
+			}
+			result = append(result, match[1]+strings.TrimRight(match[2], " "))
+		default:
+			result = append(result, match[1]+match[2]+"|")
+		}
+		for _, included := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+			if strings.HasPrefix(included, "#%RAML") {
+				continue
+			}
+			result = append(result, indent+included)
+		}
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+type ramlImporter struct {
+	version     string
+	api         map[string]interface{}
+	types       map[string]interface{}
+	traits      map[string]interface{}
+	mediaTypes  []string
+	definitions map[string]interface{}
+	warnings    []string
+}
+
+func (imp *ramlImporter) warn(location, format string, args ...interface{}) {
+	imp.warnings = append(imp.warnings, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, args...)))
+}
+
+func (imp *ramlImporter) importAPI() (*spec.Swagger, error) {
+	result := map[string]interface{}{"swagger": "2.0"}
+	info := map[string]interface{}{"title": imp.api["title"], "version": "1.0"}
+	if version, ok := imp.api["version"]; ok {
+		info["version"] = fmt.Sprintf("%v", version)
+	}
+	if description, ok := imp.api["description"]; ok {
+		info["description"] = description
+	}
+	result["info"] = info
+
+	// the types are collected first, for the resources to refer to them
+	for _, key := range []string{"types", "schemas"} {
+		for _, entry := range namedEntries(imp.api[key]) {
+			imp.types[entry.name] = entry.value
+		}
+	}
+	imp.traits = make(map[string]interface{})
+	for _, entry := range namedEntries(imp.api["traits"]) {
+		imp.traits[entry.name] = entry.value
+	}
+	for _, name := range sortedKeys(imp.types) {
+		imp.definitions[name] = imp.typeSchema("#/types/"+jsonpointer.Escape(name), imp.types[name])
+	}
+
+	switch mediaType := imp.api["mediaType"].(type) {
+	case string:
+		imp.mediaTypes = []string{mediaType}
+	case []interface{}:
+		for _, m := range mediaType {
+			imp.mediaTypes = append(imp.mediaTypes, fmt.Sprintf("%v", m))
+		}
+	}
+
+	paths := make(map[string]interface{})
+	for _, key := range sortedKeys(imp.api) {
+		value := imp.api[key]
+		at := "#/" + jsonpointer.Escape(key)
+		switch {
+		case strings.HasPrefix(key, "/"):
+			resource, _ := value.(map[string]interface{})
+			imp.resource(at, key, resource, nil, paths)
+		case key == "title", key == "version", key == "description", key == "types", key == "schemas", key == "traits", key == "mediaType":
+		case key == "baseUri":
+			imp.baseURI(at, fmt.Sprintf("%v", value), result)
+		case key == "protocols":
+			protocols, _ := value.([]interface{})
+			var schemes []string
+			for _, protocol := range protocols {
+				schemes = append(schemes, strings.ToLower(fmt.Sprintf("%v", protocol)))
+			}
+			result["schemes"] = schemes
+		case key == "securitySchemes":
+			definitions := make(map[string]interface{})
+			for _, entry := range namedEntries(value) {
+				if scheme := imp.securityScheme(at+"/"+jsonpointer.Escape(entry.name), entry.value); scheme != nil {
+					definitions[entry.name] = scheme
+				}
+			}
+			result["securityDefinitions"] = definitions
+		case key == "securedBy":
+			result["security"] = securedBy(value)
+		case key == "documentation":
+			imp.warn(at, "the documentation can't be mapped to swagger, it is dropped")
+		default:
+			imp.warn(at, "%s can't be mapped to swagger, it is dropped", key)
+		}
+	}
+	if len(imp.mediaTypes) > 0 {
+		result["consumes"] = imp.mediaTypes
+		result["produces"] = imp.mediaTypes
+	}
+	result["paths"] = paths
+	if len(imp.definitions) > 0 {
+		result["definitions"] = imp.definitions
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var doc spec.Swagger
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+type namedEntry struct {
+	name  string
+	value interface{}
+}
+
+// namedEntries reads the declarations of RAML, which are a map in RAML 1.0 and a list of maps in RAML 0.8
+func namedEntries(value interface{}) []namedEntry {
+	var entries []namedEntry
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range sortedKeys(v) {
+			entries = append(entries, namedEntry{name: name, value: v[name]})
+		}
+	case []interface{}:
+		for _, item := range v {
+			if m, ok := item.(map[string]interface{}); ok {
+				entries = append(entries, namedEntries(m)...)
+			}
+		}
+	}
+	return entries
+}
+
+func (imp *ramlImporter) baseURI(location, raw string, result map[string]interface{}) {
+	if version, ok := imp.api["version"]; ok {
+		raw = strings.Replace(raw, "{version}", fmt.Sprintf("%v", version), -1)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || strings.Contains(raw, "{") {
+		imp.warn(location, "the base uri %q has parameters, which swagger doesn't support, it is dropped", raw)
+		return
+	}
+	if u.Host != "" {
+		result["host"] = u.Host
+	}
+	if u.Path != "" && u.Path != "/" {
+		result["basePath"] = strings.TrimSuffix(u.Path, "/")
+	}
+	if u.Scheme != "" {
+		if _, ok := imp.api["protocols"]; !ok {
+			result["schemes"] = []string{u.Scheme}
+		}
+	}
+}
+
+func (imp *ramlImporter) securityScheme(location string, value interface{}) map[string]interface{} {
+	scheme, _ := value.(map[string]interface{})
+	settings, _ := scheme["settings"].(map[string]interface{})
+	result := make(map[string]interface{})
+	if description, ok := scheme["description"]; ok {
+		result["description"] = description
+	}
+	switch scheme["type"] {
+	case "Basic Authentication":
+		result["type"] = "basic"
+	case "OAuth 2.0":
+		result["type"] = "oauth2"
+		grants, _ := settings["authorizationGrants"].([]interface{})
+		flows := map[string]string{
+			"authorization_code": "accessCode",
+			"code":               "accessCode",
+			"implicit":           "implicit",
+			"token":              "implicit",
+			"password":           "password",
+			"client_credentials": "application",
+			"credentials":        "application",
+		}
+		flow := "accessCode"
+		if len(grants) > 0 {
+			if f, ok := flows[fmt.Sprintf("%v", grants[0])]; ok {
+				flow = f
+			}
+			if len(grants) > 1 {
+				imp.warn(location+"/settings/authorizationGrants", "swagger supports a single oauth2 flow, only %v is kept", grants[0])
+			}
+		}
+		result["flow"] = flow
+		if flow == "accessCode" || flow == "implicit" {
+			result["authorizationUrl"] = settings["authorizationUri"]
+		}
+		if flow != "implicit" {
+			result["tokenUrl"] = settings["accessTokenUri"]
+		}
+		scopes := make(map[string]string)
+		if list, ok := settings["scopes"].([]interface{}); ok {
+			for _, scope := range list {
+				scopes[fmt.Sprintf("%v", scope)] = ""
+			}
+		}
+		result["scopes"] = scopes
+	case "Pass Through":
+		describedBy, _ := scheme["describedBy"].(map[string]interface{})
+		for _, key := range []string{"headers", "queryParameters"} {
+			params, _ := describedBy[key].(map[string]interface{})
+			names := sortedKeys(params)
+			if len(names) == 0 {
+				continue
+			}
+			if len(names) > 1 || len(describedBy) > 1 {
+				imp.warn(location+"/describedBy", "swagger api keys are a single header or query parameter, only %s is kept", names[0])
+			}
+			result["type"] = "apiKey"
+			result["name"] = strings.TrimSuffix(names[0], "?")
+			result["in"] = map[string]string{"headers": "header", "queryParameters": "query"}[key]
+			return result
+		}
+		imp.warn(location, "the pass through scheme describes no header nor query parameter, it is dropped")
+		return nil
+	default:
+		imp.warn(location, "swagger doesn't support %v security schemes, the scheme is dropped", scheme["type"])
+		return nil
+	}
+	return result
+}
+
+// securedBy converts the security schemes of RAML to security requirements, null being the anonymous access
+func securedBy(value interface{}) []map[string][]string {
+	list, _ := value.([]interface{})
+	requirements := make([]map[string][]string, 0, len(list))
+	for _, item := range list {
+		switch scheme := item.(type) {
+		case nil:
+			requirements = append(requirements, map[string][]string{})
+		case string:
+			requirements = append(requirements, map[string][]string{scheme: {}})
+		case map[string]interface{}:
+			for name, params := range scheme {
+				var scopes []string
+				if p, ok := params.(map[string]interface{}); ok {
+					if list, ok := p["scopes"].([]interface{}); ok {
+						for _, scope := range list {
+							scopes = append(scopes, fmt.Sprintf("%v", scope))
+						}
+					}
+				}
+				if scopes == nil {
+					scopes = []string{}
+				}
+				requirements = append(requirements, map[string][]string{name: scopes})
+			}
+		}
+	}
+	return requirements
+}
+
+// resource converts a resource and its nested resources to paths
+func (imp *ramlImporter) resource(location, pth string, resource map[string]interface{}, inherited []interface{}, paths map[string]interface{}) {
+	uriParameters, _ := resource["uriParameters"].(map[string]interface{})
+	params := append([]interface{}{}, inherited...)
+	declared := make(map[string]bool)
+	for _, param := range params {
+		declared[param.(map[string]interface{})["name"].(string)] = true
+	}
+	for _, match := range uriParam.FindAllStringSubmatch(pth, -1) {
+		name := match[1]
+		if declared[name] {
+			continue
+		}
+		var decl interface{} = "string"
+		if d, ok := uriParameters[name]; ok {
+			decl = d
+		}
+		if param := imp.parameter(location+"/uriParameters/"+jsonpointer.Escape(name), name, "path", decl, true); param != nil {
+			param["required"] = true
+			params = append(params, param)
+		}
+		declared[name] = true
+	}
+
+	item := make(map[string]interface{})
+	for _, key := range sortedKeys(resource) {
+		value := resource[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		switch {
+		case strings.HasPrefix(key, "/"):
+			nested, _ := value.(map[string]interface{})
+			imp.resource(at, pth+key, nested, params, paths)
+		case isRAMLMethod(strings.TrimSuffix(key, "?")):
+			method, _ := value.(map[string]interface{})
+			item[strings.TrimSuffix(key, "?")] = imp.method(at, method, resource["is"], resource["securedBy"])
+		case key == "uriParameters", key == "displayName", key == "description", key == "is", key == "securedBy":
+		default:
+			imp.warn(at, "%s can't be mapped to swagger, it is dropped", key)
+		}
+	}
+	if len(item) == 0 {
+		return
+	}
+	if len(params) > 0 {
+		item["parameters"] = params
+	}
+	paths[pth] = item
+}
+
+func isRAMLMethod(key string) bool {
+	for _, method := range ramlMethods {
+		if key == method {
+			return true
+		}
+	}
+	return false
+}
+
+// method converts a method to an operation, with the traits it and its resource are marked with
+func (imp *ramlImporter) method(location string, method map[string]interface{}, resourceTraits, resourceSecurity interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(method))
+	var traits []interface{}
+	if list, ok := resourceTraits.([]interface{}); ok {
+		traits = append(traits, list...)
+	}
+	if list, ok := method["is"].([]interface{}); ok {
+		traits = append(traits, list...)
+	}
+	for _, trait := range traits {
+		name, ok := trait.(string)
+		if !ok {
+			imp.warn(location+"/is", "the parameters of the traits aren't supported, the trait is dropped")
+			continue
+		}
+		definition, ok := imp.traits[name].(map[string]interface{})
+		if !ok {
+			imp.warn(location+"/is", "the trait %s isn't declared, it is dropped", name)
+			continue
+		}
+		mergeTrait(merged, definition)
+	}
+	mergeTrait(merged, method)
+
+	result := make(map[string]interface{})
+	var params []interface{}
+	for _, key := range sortedKeys(merged) {
+		value := merged[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		switch key {
+		case "displayName":
+			result["summary"] = value
+		case "description":
+			result["description"] = value
+		case "queryParameters", "headers":
+			in := "query"
+			if key == "headers" {
+				in = "header"
+			}
+			declarations, _ := value.(map[string]interface{})
+			for _, name := range sortedKeys(declarations) {
+				param := imp.parameter(at+"/"+jsonpointer.Escape(name), name, in, declarations[name], false)
+				if param != nil {
+					params = append(params, param)
+				}
+			}
+		case "body":
+			body, _ := value.(map[string]interface{})
+			bodyParams, consumes := imp.body(at, body)
+			params = append(params, bodyParams...)
+			if len(consumes) > 0 {
+				result["consumes"] = consumes
+			}
+		case "responses":
+			responses, produces := imp.responses(at, value)
+			result["responses"] = responses
+			if len(produces) > 0 {
+				result["produces"] = produces
+			}
+		case "securedBy":
+			result["security"] = securedBy(value)
+		case "is", "protocols":
+		default:
+			imp.warn(at, "%s can't be mapped to swagger, it is dropped", key)
+		}
+	}
+	if _, ok := result["security"]; !ok && resourceSecurity != nil {
+		result["security"] = securedBy(resourceSecurity)
+	}
+	if _, ok := result["responses"]; !ok {
+		result["responses"] = map[string]interface{}{"default": map[string]interface{}{"description": "the response isn't described"}}
+	}
+	if len(params) > 0 {
+		result["parameters"] = params
+	}
+	return result
+}
+
+// mergeTrait adds the declarations of a trait or a method to the ones merged so far, the last one winning
+func mergeTrait(merged, declarations map[string]interface{}) {
+	for key, value := range declarations {
+		existing, ok := merged[key].(map[string]interface{})
+		incoming, isMap := value.(map[string]interface{})
+		if !ok || !isMap {
+			merged[key] = value
+			continue
+		}
+		combined := make(map[string]interface{}, len(existing)+len(incoming))
+		for k, v := range existing {
+			combined[k] = v
+		}
+		for k, v := range incoming {
+			combined[k] = v
+		}
+		merged[key] = combined
+	}
+}
+
+// parameter converts a query parameter, a header or a uri parameter
+func (imp *ramlImporter) parameter(location, name, in string, decl interface{}, path bool) map[string]interface{} {
+	optional := strings.HasSuffix(name, "?")
+	name = strings.TrimSuffix(name, "?")
+
+	// in RAML 0.8 the parameters are optional unless said otherwise, in RAML 1.0 they are required
+	required := imp.version == "1.0" && !optional
+	if m, ok := decl.(map[string]interface{}); ok {
+		if r, ok := m["required"].(bool); ok {
+			required = r
+		}
+		if strings.Contains(fmt.Sprintf("%v", m["type"]), "<<") {
+			imp.warn(location, "the parameters of the traits aren't supported, %s is dropped", name)
+			return nil
+		}
+	}
+	if decl == nil {
+		decl = "string"
+	}
+
+	schema := imp.resolveType(imp.typeSchema(location, decl))
+	param := map[string]interface{}{"name": name, "in": in}
+	if required || path {
+		param["required"] = true
+	}
+	if description, ok := schema["description"]; ok {
+		param["description"] = description
+	}
+	if !imp.simpleParameter(schema, param) {
+		imp.warn(location, "swagger only supports primitive and array parameters, %s is dropped", name)
+		return nil
+	}
+	if param["type"] == "array" && in == "query" {
+		param["collectionFormat"] = "multi"
+	}
+	if m, ok := decl.(map[string]interface{}); ok && m["repeat"] == true && param["type"] != "array" {
+		// a repeated RAML 0.8 parameter is an array
+		items := map[string]interface{}{}
+		for _, key := range simpleSchemaKeywords {
+			if value, ok := param[key]; ok {
+				items[key] = value
+				delete(param, key)
+			}
+		}
+		param["type"] = "array"
+		param["items"] = items
+		if in == "query" {
+			param["collectionFormat"] = "multi"
+		}
+	}
+	return param
+}
+
+// resolveType follows the ref of a schema to the declaration of its type, for the parameters
+func (imp *ramlImporter) resolveType(schema map[string]interface{}) map[string]interface{} {
+	for i := 0; i < 10; i++ {
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema
+		}
+		resolved, ok := imp.definitions[strings.TrimPrefix(ref, "#/definitions/")].(map[string]interface{})
+		if !ok {
+			return schema
+		}
+		schema = resolved
+	}
+	return schema
+}
+
+// simpleParameter copies the type and validations of a primitive or array schema into a parameter
+func (imp *ramlImporter) simpleParameter(schema, param map[string]interface{}) bool {
+	schema = imp.resolveType(schema)
+	tpe, _ := schema["type"].(string)
+	if tpe == "" || tpe == "object" {
+		return false
+	}
+	for _, key := range simpleSchemaKeywords {
+		if value, ok := schema[key]; ok {
+			param[key] = value
+		}
+	}
+	if tpe == "array" {
+		items, _ := schema["items"].(map[string]interface{})
+		converted := make(map[string]interface{})
+		if !imp.simpleParameter(items, converted) {
+			return false
+		}
+		param["items"] = converted
+	}
+	return true
+}
+
+// body converts the body of a method to a body parameter, or to form data parameters for a form
+func (imp *ramlImporter) body(location string, body map[string]interface{}) ([]interface{}, []string) {
+	mediaTypes := make([]string, 0, len(body))
+	for _, key := range sortedKeys(body) {
+		if strings.Contains(key, "/") {
+			mediaTypes = append(mediaTypes, key)
+		}
+	}
+	decl := interface{}(body)
+	if len(mediaTypes) > 0 {
+		mediaTypes = preferJSON(mediaTypes)
+		decl = body[mediaTypes[0]]
+		for _, mediaType := range mediaTypes[1:] {
+			if !jsonEqual(body[mediaType], decl) && !isForm(mediaType) {
+				imp.warn(location+"/"+jsonpointer.Escape(mediaType), "swagger supports a single schema for a body, the one of %s is used", mediaTypes[0])
+			}
+		}
+		location += "/" + jsonpointer.Escape(mediaTypes[0])
+	}
+
+	if len(mediaTypes) > 0 && isForm(mediaTypes[0]) {
+		return imp.formParameters(location, decl), mediaTypes
+	}
+
+	param := map[string]interface{}{"name": "body", "in": "body", "required": true}
+	if decl == nil {
+		decl = map[string]interface{}{}
+	}
+	schema := imp.typeSchema(location, decl)
+	if description, ok := schema["description"]; ok {
+		param["description"] = description
+	}
+	param["schema"] = schema
+	return []interface{}{param}, mediaTypes
+}
+
+func (imp *ramlImporter) formParameters(location string, decl interface{}) []interface{} {
+	form, _ := decl.(map[string]interface{})
+	properties, ok := form["properties"].(map[string]interface{})
+	if !ok {
+		properties, _ = form["formParameters"].(map[string]interface{})
+	}
+	params := make([]interface{}, 0, len(properties))
+	for _, name := range sortedKeys(properties) {
+		param := imp.parameter(location+"/properties/"+jsonpointer.Escape(name), name, "formData", properties[name], false)
+		if param != nil {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// responses converts the responses of a method, with the media types they produce
+func (imp *ramlImporter) responses(location string, value interface{}) (map[string]interface{}, []string) {
+	responses, _ := value.(map[string]interface{})
+	result := make(map[string]interface{}, len(responses))
+	var produces []string
+	for _, code := range sortedKeys(responses) {
+		at := location + "/" + jsonpointer.Escape(code)
+		response, _ := responses[code].(map[string]interface{})
+		converted := map[string]interface{}{"description": "response " + code}
+		if description, ok := response["description"].(string); ok {
+			converted["description"] = description
+		}
+		if headers, ok := response["headers"].(map[string]interface{}); ok {
+			convertedHeaders := make(map[string]interface{}, len(headers))
+			for _, name := range sortedKeys(headers) {
+				header := make(map[string]interface{})
+				schema := imp.resolveType(imp.typeSchema(at+"/headers/"+jsonpointer.Escape(name), headers[name]))
+				if description, ok := schema["description"]; ok {
+					header["description"] = description
+				}
+				if !imp.simpleParameter(schema, header) {
+					imp.warn(at+"/headers/"+jsonpointer.Escape(name), "swagger only supports primitive and array headers, the header is dropped")
+					continue
+				}
+				convertedHeaders[strings.TrimSuffix(name, "?")] = header
+			}
+			converted["headers"] = convertedHeaders
+		}
+		if body, ok := response["body"].(map[string]interface{}); ok {
+			var mediaTypes []string
+			for _, key := range sortedKeys(body) {
+				if strings.Contains(key, "/") {
+					mediaTypes = append(mediaTypes, key)
+				}
+			}
+			decl := interface{}(body)
+			bodyAt := at + "/body"
+			if len(mediaTypes) > 0 {
+				mediaTypes = preferJSON(mediaTypes)
+				produces = appendMissing(produces, mediaTypes...)
+				decl = body[mediaTypes[0]]
+				bodyAt += "/" + jsonpointer.Escape(mediaTypes[0])
+				for _, mediaType := range mediaTypes[1:] {
+					if !jsonEqual(body[mediaType], decl) {
+						imp.warn(at+"/body/"+jsonpointer.Escape(mediaType), "swagger supports a single schema for a response, the one of %s is used", mediaTypes[0])
+					}
+				}
+			}
+			if m, ok := decl.(map[string]interface{}); ok {
+				// the example of the body is the one of the response, the schema may be a ref
+				if example, ok := m["example"]; ok {
+					mediaType := "application/json"
+					if len(mediaTypes) > 0 {
+						mediaType = mediaTypes[0]
+					} else if len(imp.mediaTypes) > 0 {
+						mediaType = imp.mediaTypes[0]
+					}
+					converted["examples"] = map[string]interface{}{mediaType: example}
+					withoutExample := make(map[string]interface{}, len(m))
+					for k, v := range m {
+						if k != "example" {
+							withoutExample[k] = v
+						}
+					}
+					decl = withoutExample
+				}
+			}
+			if decl != nil {
+				converted["schema"] = imp.typeSchema(bodyAt, decl)
+			}
+		}
+		result[code] = converted
+	}
+	return result, produces
+}
+
+// typeSchema converts the declaration of a RAML type, or a RAML 0.8 schema, to a swagger schema
+func (imp *ramlImporter) typeSchema(location string, decl interface{}) map[string]interface{} {
+	switch d := decl.(type) {
+	case string:
+		return imp.typeExpression(location, d)
+	case []interface{}:
+		// multiple inheritance
+		members := make([]interface{}, 0, len(d))
+		for i, parent := range d {
+			members = append(members, imp.typeSchema(fmt.Sprintf("%s/%d", location, i), parent))
+		}
+		return map[string]interface{}{"allOf": members}
+	case map[string]interface{}:
+		return imp.typeDeclaration(location, d)
+	}
+	return map[string]interface{}{}
+}
+
+// typeExpression converts a type expression: a type name, an array, a union or an inline json schema
+func (imp *ramlImporter) typeExpression(location, expr string) map[string]interface{} {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") {
+		return imp.jsonSchema(location, expr)
+	}
+	if strings.Contains(expr, "<<") {
+		imp.warn(location, "the parameters of the resource types and traits aren't supported, %q is dropped", expr)
+		return map[string]interface{}{}
+	}
+	if strings.HasSuffix(expr, "[]") {
+		items := strings.TrimSuffix(expr, "[]")
+		if strings.HasPrefix(items, "(") && strings.HasSuffix(items, ")") {
+			items = items[1 : len(items)-1]
+		}
+		return map[string]interface{}{"type": "array", "items": imp.typeExpression(location, items)}
+	}
+	if strings.Contains(expr, "|") {
+		var members []interface{}
+		nullable := false
+		for _, member := range strings.Split(expr, "|") {
+			if strings.TrimSpace(member) == "nil" {
+				nullable = true
+				continue
+			}
+			members = append(members, imp.typeExpression(location, member))
+		}
+		var result map[string]interface{}
+		if len(members) == 1 {
+			result = members[0].(map[string]interface{})
+		} else {
+			result = map[string]interface{}{"x-one-of": members}
+		}
+		if nullable {
+			result["x-nullable"] = true
+		}
+		return result
+	}
+
+	switch expr {
+	case "string", "number", "integer", "boolean", "object", "array":
+		return map[string]interface{}{"type": expr}
+	case "date-only", "date":
+		return map[string]interface{}{"type": "string", "format": "date"}
+	case "datetime":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case "datetime-only", "time-only":
+		imp.warn(location, "swagger has no %s format, the type is a string", expr)
+		return map[string]interface{}{"type": "string"}
+	case "file":
+		return map[string]interface{}{"type": "file"}
+	case "any":
+		return map[string]interface{}{}
+	case "nil":
+		return map[string]interface{}{"x-nullable": true}
+	}
+	if _, ok := imp.types[expr]; ok {
+		return map[string]interface{}{"$ref": "#/definitions/" + jsonpointer.Escape(expr)}
+	}
+	imp.warn(location, "the type %s isn't declared, it is dropped", expr)
+	return map[string]interface{}{}
+}
+
+// typeDeclaration converts a type declaration with facets
+func (imp *ramlImporter) typeDeclaration(location string, decl map[string]interface{}) map[string]interface{} {
+	var base map[string]interface{}
+	var parent interface{}
+	if t, ok := decl["type"]; ok {
+		parent = t
+	} else if s, ok := decl["schema"]; ok {
+		parent = s
+	}
+	switch {
+	case parent != nil:
+		base = imp.typeSchema(location+"/type", parent)
+	case decl["properties"] != nil:
+		base = map[string]interface{}{"type": "object"}
+	case decl["items"] != nil:
+		base = map[string]interface{}{"type": "array"}
+	default:
+		base = map[string]interface{}{"type": "string"}
+	}
+
+	own := make(map[string]interface{})
+	for _, key := range sortedKeys(decl) {
+		value := decl[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		if facet, ok := ramlFacets[key]; ok {
+			own[facet] = value
+			continue
+		}
+		switch key {
+		case "type", "schema", "required", "repeat", "fileTypes":
+		case "examples":
+			if examples, ok := value.(map[string]interface{}); ok && len(examples) > 0 {
+				example := examples[sortedKeys(examples)[0]]
+				if m, ok := example.(map[string]interface{}); ok {
+					if v, ok := m["value"]; ok {
+						example = v
+					}
+				}
+				own["example"] = example
+			}
+		case "format":
+			format := fmt.Sprintf("%v", value)
+			if mapped, ok := ramlNumberFormats[format]; ok {
+				own["format"] = mapped
+				continue
+			}
+			own["format"] = format
+		case "items":
+			own["items"] = imp.typeSchema(at, value)
+		case "properties":
+			properties, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(properties))
+			var required []interface{}
+			for _, name := range sortedKeys(properties) {
+				prop := properties[name]
+				propName := strings.TrimSuffix(name, "?")
+				isRequired := !strings.HasSuffix(name, "?")
+				if m, ok := prop.(map[string]interface{}); ok {
+					if r, ok := m["required"].(bool); ok {
+						isRequired = r
+					}
+				}
+				converted[propName] = imp.typeSchema(at+"/"+jsonpointer.Escape(name), prop)
+				if isRequired {
+					required = append(required, propName)
+				}
+			}
+			own["properties"] = converted
+			if len(required) > 0 {
+				own["required"] = required
+			}
+		case "additionalProperties":
+			own["additionalProperties"] = value
+		case "discriminator":
+			own["discriminator"] = value
+		case "discriminatorValue":
+			imp.warn(at, "swagger discriminators use the names of the definitions, the discriminator value is dropped")
+		default:
+			if strings.HasPrefix(key, "(") {
+				imp.warn(at, "the annotations can't be mapped to swagger, %s is dropped", key)
+				continue
+			}
+			imp.warn(at, "%s can't be mapped to swagger, it is dropped", key)
+		}
+	}
+
+	if _, isObject := own["properties"]; isObject {
+		own["type"] = "object"
+	}
+	// a type which extends other ones, or refines them with facets
+	if _, ok := base["$ref"]; ok && len(own) > 0 {
+		return map[string]interface{}{"allOf": []interface{}{base, own}}
+	}
+	if members, ok := base["allOf"].([]interface{}); ok && len(own) > 0 {
+		base["allOf"] = append(members, own)
+		return base
+	}
+	for key, value := range own {
+		if key == "items" {
+			if _, ok := base["items"]; ok {
+				continue
+			}
+		}
+		base[key] = value
+	}
+	return base
+}
+
+// jsonSchema converts an inline json schema of RAML 0.8
+func (imp *ramlImporter) jsonSchema(location, text string) map[string]interface{} {
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(text), &schema); err != nil {
+		imp.warn(location, "the json schema can't be read, it is dropped: %v", err)
+		return map[string]interface{}{}
+	}
+	file := &schemaFile{rel: location}
+	schemas := &schemaImporter{
+		// the refs of RAML 0.8 schemas name the other schemas of the api
+		refs: func(file *schemaFile, at, ref string) string {
+			name := strings.TrimSuffix(strings.SplitN(ref, "#", 2)[0], ".json")
+			if _, ok := imp.types[name]; ok {
+				return "#/definitions/" + jsonpointer.Escape(name)
+			}
+			imp.warn(file.rel+at, "%q isn't one of the schemas of the api, it is kept as is", ref)
+			return ref
+		},
+	}
+	converted := schemas.convert(file, "", schema)
+	imp.warnings = append(imp.warnings, schemas.warnings...)
+	return converted
+}
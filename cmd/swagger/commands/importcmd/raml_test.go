@@ -0,0 +1,119 @@
+package importcmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ramlFixtures = "../../../../fixtures/importraml"
+
+func TestImportRAML(t *testing.T) {
+	doc, warnings, err := ImportRAML(filepath.Join(ramlFixtures, "petstore.raml"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"#/resourceTypes: resourceTypes can't be mapped to swagger, it is dropped",
+		"#/securitySchemes/oauth/settings/authorizationGrants: swagger supports a single oauth2 flow, only authorization_code is kept",
+		"#/~1pets/get/responses/200/body/application~1xml: swagger supports a single schema for a response, the one of application/json is used",
+		"#/~1pets/~1{petId}/get/(cached): (cached) can't be mapped to swagger, it is dropped",
+	}, warnings)
+
+	assert.Equal(t, "petstore.example.com", doc.Host)
+	assert.Equal(t, "/v1", doc.BasePath)
+	assert.Equal(t, []string{"https"}, doc.Schemes)
+	assert.Equal(t, []string{"application/json"}, doc.Produces)
+
+	list := doc.Paths.Paths["/pets"].Get
+	require.NotNil(t, list)
+	assert.Equal(t, "list the pets", list.Summary)
+	require.Len(t, list.Parameters, 3)
+	tags := list.Parameters[2]
+	assert.Equal(t, "tags", tags.Name)
+	assert.Equal(t, "array", tags.Type)
+	assert.Equal(t, "multi", tags.CollectionFormat)
+	require.NotNil(t, tags.Items)
+	assert.Equal(t, "^[a-z]+$", tags.Items.Pattern)
+	ok := list.Responses.StatusCodeResponses[200]
+	assert.Equal(t, "#/definitions/Pet", refOf(*ok.Schema.Items.Schema))
+	assert.Contains(t, ok.Headers, "X-Next")
+
+	create := doc.Paths.Paths["/pets"].Post
+	require.NotNil(t, create)
+	require.Len(t, create.Parameters, 1)
+	assert.Equal(t, "body", create.Parameters[0].In)
+	assert.Equal(t, "#/definitions/Pet", refOf(*create.Parameters[0].Schema))
+
+	pet := doc.Paths.Paths["/pets/{petId}"]
+	require.Len(t, pet.Parameters, 1)
+	assert.Equal(t, "path", pet.Parameters[0].In)
+	assert.Equal(t, "int64", pet.Parameters[0].Format)
+	assert.Equal(t, map[string]interface{}{"name": "rex"}, pet.Get.Responses.StatusCodeResponses[200].Examples["application/json"])
+
+	upload := doc.Paths.Paths["/pets/{petId}/photo"].Put
+	require.NotNil(t, upload)
+	assert.Equal(t, []string{"multipart/form-data"}, upload.Consumes)
+	require.Len(t, upload.Parameters, 2)
+	assert.Equal(t, "file", upload.Parameters[1].Type)
+	assert.Equal(t, "formData", upload.Parameters[1].In)
+
+	// the type included from types/pet.raml
+	definition := doc.Definitions["Pet"]
+	assert.Equal(t, "kind", definition.Discriminator)
+	assert.Equal(t, []string{"food", "kind", "name", "nickname"}, definition.Required)
+	assert.Equal(t, true, definition.Properties["nickname"].Extensions["x-nullable"])
+	assert.Equal(t, "#/definitions/Tag", refOf(*definition.Properties["tags"].Items.Schema))
+	assert.Equal(t, "date-time", definition.Properties["born"].Format)
+	oneOf, isList := definition.Properties["food"].Extensions["x-one-of"].([]interface{})
+	require.True(t, isList)
+	assert.Equal(t, map[string]interface{}{"$ref": "#/definitions/Kibble"}, oneOf[0])
+	assert.Equal(t, "#/definitions/Pet", refOf(doc.Definitions["Dog"].AllOf[0]))
+}
+
+func TestImportRAML_08(t *testing.T) {
+	doc, warnings, err := ImportRAML(filepath.Join(ramlFixtures, "api08.raml"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"#/types/address/not: not isn't supported by swagger, it is dropped",
+		`#/types/owner/properties/website/$ref: "http://example.org/website.json" isn't one of the schemas of the api, it is kept as is`,
+	}, warnings)
+
+	assert.Equal(t, "legacy.example.com", doc.Host)
+	// the schema included from schemas/owner.json
+	owner := doc.Definitions["owner"]
+	assert.Equal(t, "#/definitions/address", refOf(owner.Properties["address"]))
+	assert.Equal(t, "http://example.org/website.json", refOf(owner.Properties["website"]))
+
+	list := doc.Paths.Paths["/owners"].Get
+	require.NotNil(t, list)
+	require.Len(t, list.Parameters, 3)
+	sort := list.Parameters[2]
+	assert.Equal(t, "array", sort.Type)
+	assert.Equal(t, "multi", sort.CollectionFormat)
+	assert.Equal(t, "#/definitions/owner", refOf(*list.Responses.StatusCodeResponses[200].Schema))
+
+	create := doc.Paths.Paths["/owners"].Post
+	require.NotNil(t, create)
+	assert.Equal(t, []string{"application/x-www-form-urlencoded"}, create.Consumes)
+	assert.Equal(t, []spec.Parameter{*spec.FormDataParam("name").Typed("string", "").AsRequired()}, create.Parameters)
+}
+
+func TestImportRAML_NotAnAPI(t *testing.T) {
+	_, _, err := ImportRAML(filepath.Join(ramlFixtures, "types", "pet.raml"))
+	assert.EqualError(t, err, filepath.Join(ramlFixtures, "types", "pet.raml")+" is a RAML DataType fragment, import the api which uses it")
+
+	dir, err := ioutil.TempDir("", "import-raml")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pth := filepath.Join(dir, "api.yml")
+	require.NoError(t, ioutil.WriteFile(pth, []byte("title: not raml\n"), 0644))
+	_, _, err = ImportRAML(pth)
+	assert.Error(t, err)
+
+	cmd := &RAML{}
+	assert.Error(t, cmd.Execute(nil))
+}
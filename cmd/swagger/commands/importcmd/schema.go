@@ -0,0 +1,439 @@
+package importcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// the keywords of json schema that swagger schemas support as they are
+var swaggerKeywords = map[string]struct{}{
+	"format":               struct{}{},
+	"title":                struct{}{},
+	"description":          struct{}{},
+	"default":              struct{}{},
+	"multipleOf":           struct{}{},
+	"maximum":              struct{}{},
+	"minimum":              struct{}{},
+	"maxLength":            struct{}{},
+	"minLength":            struct{}{},
+	"pattern":              struct{}{},
+	"maxItems":             struct{}{},
+	"minItems":             struct{}{},
+	"uniqueItems":          struct{}{},
+	"maxProperties":        struct{}{},
+	"minProperties":        struct{}{},
+	"enum":                 struct{}{},
+	"discriminator":        struct{}{},
+	"readOnly":             struct{}{},
+	"xml":                  struct{}{},
+	"externalDocs":         struct{}{},
+	"example":              struct{}{},
+	"required":             struct{}{},
+	"additionalProperties": struct{}{},
+}
+
+// the keywords that only identify or annotate a json schema document
+var documentKeywords = map[string]struct{}{
+	"$schema":  struct{}{},
+	"$id":      struct{}{},
+	"id":       struct{}{},
+	"$comment": struct{}{},
+}
+
+// Schema a command struct to import a directory of json schemas as the definitions of a new spec
+type Schema struct {
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write the spec to, as json when it ends with .json and as yaml otherwise (default stdout, as yaml)"`
+	Title   string         `long:"title" description:"the title of the API (default the name of the directory)"`
+	Version string         `long:"version" description:"the version of the API" default:"0.1.0"`
+}
+
+// Execute this command
+func (s *Schema) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("the import schema command requires the directory of the json schemas")
+	}
+	dir, err := filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+
+	definitions, warnings, err := ImportSchemas(dir)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		log.Println("warning:", warning)
+	}
+
+	var doc spec.Swagger
+	doc.Swagger = "2.0"
+	doc.Info = new(spec.Info)
+	doc.Info.Title = s.Title
+	if doc.Info.Title == "" {
+		doc.Info.Title = swag.ToHumanNameTitle(filepath.Base(dir))
+	}
+	doc.Info.Version = s.Version
+	doc.Paths = new(spec.Paths)
+	doc.Definitions = definitions
+
+	var b []byte
+	if strings.HasSuffix(string(s.Output), ".json") {
+		b, err = json.MarshalIndent(doc, "", "  ")
+	} else {
+		b, err = yaml.Marshal(swag.ToDynamicJSON(doc))
+	}
+	if err != nil {
+		return err
+	}
+	if s.Output == "" {
+		fmt.Print(string(b))
+		return nil
+	}
+	log.Printf("imported %d definitions into %s", len(definitions), s.Output)
+	return ioutil.WriteFile(string(s.Output), b, 0644)
+}
+
+// schemaFile is a json schema document of the imported directory
+type schemaFile struct {
+	path   string
+	rel    string
+	name   string
+	id     *url.URL
+	schema map[string]interface{}
+}
+
+// ImportSchemas converts the json schema files of a directory to swagger definitions.
+//
+// Each file becomes a definition named after the file, the definitions nested in a file become
+// definitions named {file}.{definition}, and the $refs are rewritten to point at them. The keywords
+// swagger doesn't support are converted when they have an equivalent, and dropped with a warning otherwise.
+func ImportSchemas(dir string) (spec.Definitions, []string, error) {
+	files, err := readSchemaFiles(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	imp := &schemaImporter{
+		byPath:      make(map[string]*schemaFile, len(files)),
+		byID:        make(map[string]*schemaFile),
+		definitions: make(map[string]interface{}),
+	}
+	for _, file := range files {
+		if other, ok := imp.names()[file.name]; ok {
+			return nil, nil, fmt.Errorf("%s and %s would both be imported as the definition %s", other, file.rel, file.name)
+		}
+		imp.byPath[file.path] = file
+		if file.id != nil {
+			imp.byID[file.id.String()] = file
+		}
+	}
+
+	for _, file := range files {
+		for _, key := range []string{"definitions", "$defs"} {
+			nested, ok := file.schema[key].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, name := range sortedKeys(nested) {
+				location := "#/" + key + "/" + jsonpointer.Escape(name)
+				if schema, ok := nested[name].(map[string]interface{}); ok {
+					imp.definitions[file.name+"."+name] = imp.convert(file, location, schema)
+				}
+			}
+		}
+		imp.definitions[file.name] = imp.convert(file, "#", file.schema)
+	}
+
+	b, err := json.Marshal(imp.definitions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var definitions spec.Definitions
+	if err := json.Unmarshal(b, &definitions); err != nil {
+		return nil, nil, err
+	}
+	return definitions, imp.warnings, nil
+}
+
+func readSchemaFiles(dir string) ([]*schemaFile, error) {
+	var files []*schemaFile
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		file := &schemaFile{
+			path: path,
+			rel:  filepath.ToSlash(rel),
+			name: strings.TrimSuffix(filepath.Base(path), ".json"),
+		}
+		if err := json.Unmarshal(b, &file.schema); err != nil {
+			return fmt.Errorf("%s is not a json schema: %v", path, err)
+		}
+		for _, key := range []string{"$id", "id"} {
+			if id, ok := file.schema[key].(string); ok {
+				if file.id, err = url.Parse(strings.TrimSuffix(id, "#")); err != nil {
+					return fmt.Errorf("%s has an invalid %s: %v", path, key, err)
+				}
+				break
+			}
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("there are no json schemas in %s", dir)
+	}
+	return files, nil
+}
+
+type schemaImporter struct {
+	byPath      map[string]*schemaFile
+	byID        map[string]*schemaFile
+	definitions map[string]interface{}
+	warnings    []string
+	// refs rewrites the $refs instead of rewriteRef, for the schemas which aren't files of a directory
+	refs func(file *schemaFile, location, ref string) string
+}
+
+func (imp *schemaImporter) names() map[string]string {
+	names := make(map[string]string, len(imp.byPath))
+	for _, file := range imp.byPath {
+		names[file.name] = file.rel
+	}
+	return names
+}
+
+func (imp *schemaImporter) warn(file *schemaFile, location, format string, args ...interface{}) {
+	imp.warnings = append(imp.warnings, fmt.Sprintf("%s%s: %s", file.rel, location, fmt.Sprintf(format, args...)))
+}
+
+// convert turns the json schema at location in file into a swagger schema
+func (imp *schemaImporter) convert(file *schemaFile, location string, schema map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(schema))
+	exclusive := make(map[string]interface{})
+	for _, key := range sortedKeys(schema) {
+		value := schema[key]
+		at := location + "/" + jsonpointer.Escape(key)
+		if _, ok := swaggerKeywords[key]; ok {
+			result[key] = value
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(key), "x-") {
+			result[key] = value
+			continue
+		}
+		if _, ok := documentKeywords[key]; ok {
+			continue
+		}
+
+		switch key {
+		case "$ref":
+			ref, _ := value.(string)
+			if imp.refs != nil {
+				result[key] = imp.refs(file, at, ref)
+				continue
+			}
+			result[key] = imp.rewriteRef(file, at, ref)
+
+		case "definitions", "$defs":
+			// the definitions at the root of the file are imported on their own
+			if location != "#" {
+				imp.warn(file, at, "nested definitions aren't supported, they are dropped")
+			}
+
+		case "type":
+			switch tpe := value.(type) {
+			case string:
+				result[key] = tpe
+			case []interface{}:
+				var types []interface{}
+				for _, t := range tpe {
+					if t == "null" {
+						result["x-nullable"] = true
+						continue
+					}
+					types = append(types, t)
+				}
+				if len(types) == 1 {
+					result[key] = types[0]
+				} else {
+					imp.warn(file, at, "a list of types isn't supported, the type is dropped")
+				}
+			}
+
+		case "exclusiveMaximum", "exclusiveMinimum":
+			// draft 6 made the exclusive limits numbers, instead of flags of maximum and minimum
+			limit := "maximum"
+			if key == "exclusiveMinimum" {
+				limit = "minimum"
+			}
+			if _, ok := value.(bool); ok {
+				result[key] = value
+				continue
+			}
+			exclusive[limit] = value
+
+		case "const":
+			result["enum"] = []interface{}{value}
+
+		case "examples":
+			if examples, ok := value.([]interface{}); ok && len(examples) > 0 {
+				if _, ok := schema["example"]; !ok {
+					result["example"] = examples[0]
+				}
+			}
+
+		case "oneOf":
+			members, _ := value.([]interface{})
+			result["x-one-of"] = imp.convertList(file, at, members)
+
+		case "allOf":
+			members, _ := value.([]interface{})
+			result[key] = imp.convertList(file, at, members)
+
+		case "items":
+			switch items := value.(type) {
+			case map[string]interface{}:
+				result[key] = imp.convert(file, at, items)
+			case []interface{}:
+				result[key] = imp.convertList(file, at, items)
+			}
+
+		case "properties":
+			properties, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(properties))
+			for _, name := range sortedKeys(properties) {
+				if prop, ok := properties[name].(map[string]interface{}); ok {
+					converted[name] = imp.convert(file, at+"/"+jsonpointer.Escape(name), prop)
+				}
+			}
+			result[key] = converted
+
+		default:
+			imp.warn(file, at, "%s isn't supported by swagger, it is dropped", key)
+		}
+	}
+
+	for limit, value := range exclusive {
+		if _, ok := schema[limit]; ok {
+			imp.warn(file, location+"/"+limit, "%s is replaced by the exclusive one", limit)
+		}
+		result[limit] = value
+		result["exclusive"+strings.ToUpper(limit[:1])+limit[1:]] = true
+	}
+	if additional, ok := result["additionalProperties"].(map[string]interface{}); ok {
+		converted := imp.convert(file, location+"/additionalProperties", additional)
+		if len(converted) == 0 {
+			// an empty schema would read as no additional properties
+			result["additionalProperties"] = true
+		} else {
+			result["additionalProperties"] = converted
+		}
+	}
+	if required, ok := result["required"].(bool); ok {
+		// draft 3 required flags are replaced by the required lists of the objects
+		delete(result, "required")
+		imp.warn(file, location+"/required", "required must be a list of properties, %t is dropped", required)
+	}
+	return result
+}
+
+func (imp *schemaImporter) convertList(file *schemaFile, location string, members []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(members))
+	for i, member := range members {
+		if schema, ok := member.(map[string]interface{}); ok {
+			result = append(result, imp.convert(file, fmt.Sprintf("%s/%d", location, i), schema))
+		}
+	}
+	return result
+}
+
+// rewriteRef points a $ref of file at the definition it is imported as
+func (imp *schemaImporter) rewriteRef(file *schemaFile, location, ref string) string {
+	doc, fragment := ref, ""
+	if i := strings.Index(ref, "#"); i >= 0 {
+		doc, fragment = ref[:i], ref[i+1:]
+	}
+
+	target := imp.refTarget(file, doc)
+	if target == nil {
+		imp.warn(file, location, "%q isn't one of the imported schemas, it is kept as is", ref)
+		return ref
+	}
+	if fragment != "" && !strings.HasPrefix(fragment, "/") {
+		imp.warn(file, location, "%q refers to an anchor, which isn't supported, it is kept as is", ref)
+		return ref
+	}
+
+	for _, key := range []string{"/definitions/", "/$defs/"} {
+		if strings.HasPrefix(fragment, key) {
+			parts := strings.SplitN(strings.TrimPrefix(fragment, key), "/", 2)
+			rewritten := "#/definitions/" + jsonpointer.Escape(target.name+"."+jsonpointer.Unescape(parts[0]))
+			if len(parts) > 1 {
+				rewritten += "/" + parts[1]
+			}
+			return rewritten
+		}
+	}
+	return "#/definitions/" + jsonpointer.Escape(target.name) + fragment
+}
+
+// refTarget finds the file a $ref refers to, by $id or by path
+func (imp *schemaImporter) refTarget(file *schemaFile, doc string) *schemaFile {
+	if doc == "" {
+		return file
+	}
+	docURL, err := url.Parse(doc)
+	if err != nil {
+		return nil
+	}
+	resolved := docURL
+	if file.id != nil {
+		resolved = file.id.ResolveReference(docURL)
+	}
+	if target, ok := imp.byID[resolved.String()]; ok {
+		return target
+	}
+	if docURL.IsAbs() {
+		return nil
+	}
+	return imp.byPath[filepath.Join(filepath.Dir(file.path), filepath.FromSlash(docURL.Path))]
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
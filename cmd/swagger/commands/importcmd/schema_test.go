@@ -0,0 +1,100 @@
+package importcmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	goflags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixtures = "../../../../fixtures/importschema"
+
+func TestImportSchemas(t *testing.T) {
+	definitions, warnings, err := ImportSchemas(fixtures)
+	require.NoError(t, err)
+
+	assert.Len(t, definitions, 6)
+	for _, name := range []string{"pet", "pet.Tag", "pet.Kibble", "owner", "owner.Address", "money"} {
+		assert.Contains(t, definitions, name)
+	}
+
+	pet := definitions["pet"]
+	assert.Equal(t, "a pet", pet.Title)
+	assert.Equal(t, "#/definitions/pet.Tag", refOf(*pet.Properties["tags"].Items.Schema))
+	assert.Equal(t, "#/definitions/owner", refOf(pet.Properties["owner"]))
+	assert.Equal(t, "#/definitions/pet", refOf(pet.Properties["parent"]))
+	assert.Equal(t, "doggie", pet.Properties["name"].Example)
+	assert.Equal(t, []interface{}{"pet"}, pet.Properties["kind"].Enum)
+
+	nickname := pet.Properties["nickname"]
+	assert.Equal(t, spec.StringOrArray{"string"}, nickname.Type)
+	assert.Equal(t, true, nickname.Extensions["x-nullable"])
+
+	age := pet.Properties["age"]
+	require.NotNil(t, age.Minimum)
+	assert.Equal(t, float64(0), *age.Minimum)
+	assert.True(t, age.ExclusiveMinimum)
+
+	oneOf, ok := pet.Properties["food"].Extensions["x-one-of"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"$ref": "#/definitions/pet.Kibble"}, oneOf[0])
+
+	owner := definitions["owner"]
+	assert.Equal(t, "#/definitions/pet/properties/name", refOf(owner.Properties["name"]))
+	assert.Equal(t, "#/definitions/money", refOf(owner.Properties["balance"]))
+	assert.Equal(t, "#/definitions/owner.Address", refOf(owner.Properties["address"]))
+	assert.Equal(t, "http://example.org/website.json", refOf(owner.Properties["website"]))
+
+	address := definitions["owner.Address"]
+	require.NotNil(t, address.AdditionalProperties)
+	assert.True(t, address.AdditionalProperties.Allows)
+
+	assert.Equal(t, []string{
+		"common/money.json#/if: if isn't supported by swagger, it is dropped",
+		"common/money.json#/then: then isn't supported by swagger, it is dropped",
+		"owner.json#/definitions/Address/additionalProperties/not: not isn't supported by swagger, it is dropped",
+		`owner.json#/properties/website/$ref: "http://example.org/website.json" isn't one of the imported schemas, it is kept as is`,
+		"pet.json#/properties/extra/propertyNames: propertyNames isn't supported by swagger, it is dropped",
+	}, warnings)
+}
+
+func TestImportSchemas_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import-schema")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, _, err = ImportSchemas(dir)
+	assert.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "v2"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "pet.json"), []byte(`{"type": "object"}`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "v2", "pet.json"), []byte(`{"type": "object"}`), 0644))
+	_, _, err = ImportSchemas(dir)
+	assert.Error(t, err)
+}
+
+func TestImportSchemaCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import-schema")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "swagger.yml")
+	cmd := &Schema{Output: goflags.Filename(output), Version: "1.0.0"}
+	require.NoError(t, cmd.Execute([]string{fixtures}))
+
+	doc, err := loads.Spec(output)
+	require.NoError(t, err)
+	assert.Equal(t, "Importschema", doc.Spec().Info.Title)
+	assert.Equal(t, "1.0.0", doc.Spec().Info.Version)
+	assert.Len(t, doc.Spec().Definitions, 6)
+}
+
+func refOf(schema spec.Schema) string {
+	return schema.Ref.String()
+}
@@ -0,0 +1,696 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v2"
+)
+
+// InferSpec is a command that infers a swagger spec from recorded http traffic
+type InferSpec struct {
+	Host     string         `long:"host" description:"the host of the api, the requests to other hosts are skipped (default: the host with the most requests)"`
+	BasePath string         `long:"base-path" description:"the base path of the api, the requests outside of it are skipped"`
+	Title    string         `long:"title" description:"the title of the spec (default: the host)"`
+	Compact  bool           `long:"compact" description:"when present, doesn't prettify the json"`
+	Output   flags.Filename `long:"output" short:"o" description:"the file to write to, as yaml when it ends with .yml or .yaml and as json otherwise"`
+}
+
+// Execute infers the spec
+func (c *InferSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The infer command requires the HAR file to be specified")
+	}
+
+	b, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var har harLog
+	if err := json.Unmarshal(b, &har); err != nil {
+		return fmt.Errorf("%s is not a HAR file: %v", args[0], err)
+	}
+
+	inferred, stats, err := InferFromHAR(har.Log.Entries, HARFilter{Host: c.Host, BasePath: c.BasePath})
+	if err != nil {
+		return err
+	}
+	if c.Title != "" {
+		inferred.Info.Title = c.Title
+	}
+	log.Printf("inferred %d operations from %d requests of %s, %d requests were skipped", stats.Operations, stats.Requests, args[0], stats.Skipped)
+
+	return writeInferred(inferred, string(c.Output), c.Compact)
+}
+
+// writeInferred writes an inferred spec as yaml when the output ends with .yml or .yaml, and as json otherwise
+func writeInferred(inferred *spec.Swagger, output string, compact bool) error {
+	var b []byte
+	var err error
+	switch {
+	case strings.HasSuffix(output, ".yml") || strings.HasSuffix(output, ".yaml"):
+		b, err = yaml.Marshal(swag.ToDynamicJSON(inferred))
+	case compact:
+		b, err = json.Marshal(inferred)
+	default:
+		b, err = json.MarshalIndent(inferred, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Println(string(b))
+		return nil
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
+
+type harLog struct {
+	Log struct {
+		Entries []HAREntry `json:"entries"`
+	} `json:"log"`
+}
+
+// HAREntry is a request of a HAR file, with its response
+type HAREntry struct {
+	Request struct {
+		Method   string         `json:"method"`
+		URL      string         `json:"url"`
+		Headers  []harNameValue `json:"headers"`
+		PostData *harPostData   `json:"postData"`
+	} `json:"request"`
+	Response struct {
+		Status  int        `json:"status"`
+		Content harContent `json:"content"`
+	} `json:"response"`
+}
+
+type harPostData struct {
+	MimeType string         `json:"mimeType"`
+	Text     string         `json:"text"`
+	Params   []harNameValue `json:"params"`
+}
+
+type harContent struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Encoding string `json:"encoding"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HARFilter selects the requests of a HAR file an api is inferred from
+type HARFilter struct {
+	// Host is the host of the api, the host with the most requests when empty
+	Host string
+	// BasePath is the path all the requests of the api start with
+	BasePath string
+}
+
+// InferStats counts what the inference used
+type InferStats struct {
+	Requests   int
+	Skipped    int
+	Operations int
+}
+
+// the media types of the pages and their assets, which aren't calls to an api
+var harAssets = regexp.MustCompile(`^(text/html|text/css|image/|font/|audio/|video/|application/(x-)?javascript|text/javascript|application/font)`)
+
+var (
+	tokenSegment = regexp.MustCompile(`^[0-9a-zA-Z_-]*[0-9][0-9a-zA-Z_-]*$`)
+	uuidValue    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	dateValue    = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// the shortest token with digits a path segment is taken as an id for, v1 or utf8 aren't ids
+const minTokenLength = 16
+
+// InferFromHAR infers the paths, parameters and responses of an api from the requests of a HAR file.
+//
+// The segments of the paths which look like ids (numbers, uuids, tokens with digits) become path parameters,
+// and the observations of an operation are merged: a query parameter is required when every request has it,
+// a property when every object has it, and a value seen as null makes the schema nullable.
+func InferFromHAR(entries []HAREntry, filter HARFilter) (*spec.Swagger, InferStats, error) {
+	host := filter.Host
+	if host == "" {
+		host = mostRequestedHost(entries)
+	}
+	inf := NewInference(HARFilter{Host: host, BasePath: filter.BasePath})
+	for _, entry := range entries {
+		inf.Observe(entry)
+	}
+	if inf.stats.Requests == 0 {
+		return nil, inf.stats, fmt.Errorf("no request of the HAR file is a call to %s%s", host, inf.basePath)
+	}
+	doc, err := inf.Spec()
+	return doc, inf.stats, err
+}
+
+// Inference builds a spec from the requests to an api as they are observed
+type Inference struct {
+	host       string
+	basePath   string
+	schemes    []string
+	operations map[string]*inferredOperation
+	order      []string
+	stats      InferStats
+}
+
+// NewInference creates an inference for the api the filter selects, its host is required
+func NewInference(filter HARFilter) *Inference {
+	return &Inference{
+		host:       filter.Host,
+		basePath:   "/" + strings.Trim(filter.BasePath, "/"),
+		operations: make(map[string]*inferredOperation),
+	}
+}
+
+// Observe merges a request into the inferred spec, it tells whether the request is a call to the api
+func (inf *Inference) Observe(entry HAREntry) bool {
+	u, err := url.Parse(entry.Request.URL)
+	method := strings.ToLower(entry.Request.Method)
+	if err != nil || u.Host != inf.host || !strings.HasPrefix(u.Path, strings.TrimSuffix(inf.basePath, "/")+"/") && u.Path != inf.basePath ||
+		harAssets.MatchString(mediaType(entry.Response.Content.MimeType)) || !isHTTPMethod(method) {
+		inf.stats.Skipped++
+		return false
+	}
+	inf.stats.Requests++
+	inf.schemes = appendString(inf.schemes, u.Scheme)
+	inf.merge(method, u.Path, u.Query(), entry)
+	inf.stats.Operations = len(inf.operations)
+	return true
+}
+
+// Stats counts the requests observed so far
+func (inf *Inference) Stats() InferStats {
+	return inf.stats
+}
+
+// Spec builds the spec inferred from the requests observed so far
+func (inf *Inference) Spec() (*spec.Swagger, error) {
+	result := map[string]interface{}{
+		"swagger": "2.0",
+		"info":    map[string]interface{}{"title": inf.host, "version": "1.0"},
+		"host":    inf.host,
+		"paths":   inf.paths(),
+	}
+	if len(inf.schemes) > 0 {
+		result["schemes"] = inf.schemes
+	}
+	if inf.basePath != "/" {
+		result["basePath"] = inf.basePath
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var doc spec.Swagger
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func mostRequestedHost(entries []HAREntry) string {
+	counts := make(map[string]int)
+	best := ""
+	for _, entry := range entries {
+		u, err := url.Parse(entry.Request.URL)
+		if err != nil || harAssets.MatchString(mediaType(entry.Response.Content.MimeType)) {
+			continue
+		}
+		counts[u.Host]++
+		if counts[u.Host] > counts[best] || counts[u.Host] == counts[best] && u.Host < best {
+			best = u.Host
+		}
+	}
+	return best
+}
+
+func isHTTPMethod(method string) bool {
+	switch method {
+	case "get", "put", "post", "delete", "options", "head", "patch":
+		return true
+	}
+	return false
+}
+
+// mediaType strips the parameters of a content type
+func mediaType(contentType string) string {
+	if parsed, _, err := mime.ParseMediaType(contentType); err == nil {
+		return parsed
+	}
+	return strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+}
+
+func appendString(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+type inferredOperation struct {
+	method     string
+	path       string
+	count      int
+	pathParams []*inferredParam
+	query      map[string]*inferredParam
+	form       map[string]*inferredParam
+	body       map[string]interface{}
+	consumes   []string
+	produces   []string
+	responses  map[int]*inferredResponse
+}
+
+type inferredParam struct {
+	name   string
+	seen   int
+	schema map[string]interface{}
+}
+
+type inferredResponse struct {
+	schema    map[string]interface{}
+	mediaType string
+	example   interface{}
+}
+
+// merge merges a request into the operation it is a call to
+func (inf *Inference) merge(method, pth string, query url.Values, entry HAREntry) {
+	// the ids are named after the segment before them, which may be part of the base path
+	template, values := templatePath(pth)
+	if template = strings.TrimPrefix(template, strings.TrimSuffix(inf.basePath, "/")); template == "" {
+		template = "/"
+	}
+	key := method + " " + template
+	op, ok := inf.operations[key]
+	if !ok {
+		op = &inferredOperation{
+			method:    method,
+			path:      template,
+			query:     make(map[string]*inferredParam),
+			form:      make(map[string]*inferredParam),
+			responses: make(map[int]*inferredResponse),
+		}
+		inf.operations[key] = op
+		inf.order = append(inf.order, key)
+	}
+	op.count++
+
+	for i, value := range values {
+		if i == len(op.pathParams) {
+			op.pathParams = append(op.pathParams, &inferredParam{name: value.name})
+		}
+		op.pathParams[i].observe(value.value)
+	}
+	for name, list := range query {
+		param, ok := op.query[name]
+		if !ok {
+			param = &inferredParam{name: name}
+			op.query[name] = param
+		}
+		for _, value := range list {
+			param.observe(value)
+		}
+	}
+
+	if data := entry.Request.PostData; data != nil {
+		requestType := mediaType(data.MimeType)
+		if requestType != "" {
+			op.consumes = appendString(op.consumes, requestType)
+		}
+		switch {
+		case requestType == "application/x-www-form-urlencoded" || requestType == "multipart/form-data":
+			params := data.Params
+			if len(params) == 0 {
+				if parsed, err := url.ParseQuery(data.Text); err == nil {
+					for name, list := range parsed {
+						for _, value := range list {
+							params = append(params, harNameValue{Name: name, Value: value})
+						}
+					}
+				}
+			}
+			for _, p := range params {
+				param, ok := op.form[p.Name]
+				if !ok {
+					param = &inferredParam{name: p.Name}
+					op.form[p.Name] = param
+				}
+				param.observe(p.Value)
+			}
+		case data.Text != "":
+			if value, ok := decodeJSON(data.Text); ok {
+				op.body = mergeSchemas(op.body, inferSchema(value))
+			} else if op.body == nil {
+				op.body = map[string]interface{}{}
+			}
+		}
+	}
+
+	status := entry.Response.Status
+	if status <= 0 {
+		return
+	}
+	response, ok := op.responses[status]
+	if !ok {
+		response = &inferredResponse{}
+		op.responses[status] = response
+	}
+	responseType := mediaType(entry.Response.Content.MimeType)
+	text := entry.Response.Content.Text
+	if text == "" || responseType == "" {
+		return
+	}
+	op.produces = appendString(op.produces, responseType)
+	if entry.Response.Content.Encoding == "base64" {
+		return
+	}
+	if value, ok := decodeJSON(text); ok {
+		response.schema = mergeSchemas(response.schema, inferSchema(value))
+		if response.example == nil {
+			response.mediaType, response.example = responseType, value
+		}
+	}
+}
+
+// observe merges the value of a parameter in its schema
+func (p *inferredParam) observe(value string) {
+	p.seen++
+	p.schema = mergeSchemas(p.schema, inferValue(value))
+}
+
+type pathValue struct {
+	name  string
+	value string
+}
+
+// templatePath turns the segments of a path which look like ids into parameters named after the segment before them
+func templatePath(pth string) (string, []pathValue) {
+	segments := strings.Split(pth, "/")
+	var values []pathValue
+	names := make(map[string]bool)
+	for i, segment := range segments {
+		if !isNumber(segment) && !uuidValue.MatchString(segment) && (len(segment) < minTokenLength || !tokenSegment.MatchString(segment)) {
+			continue
+		}
+		name := "id"
+		if i > 0 && segments[i-1] != "" && !strings.HasPrefix(segments[i-1], "{") {
+			name = swag.ToJSONName(singular(segments[i-1]) + " id")
+		}
+		for n := 2; names[name]; n++ {
+			name = strings.TrimRight(name, "0123456789") + strconv.Itoa(n)
+		}
+		names[name] = true
+		values = append(values, pathValue{name: name, value: segment})
+		segments[i] = "{" + name + "}"
+	}
+	return strings.Join(segments, "/"), values
+}
+
+func isNumber(segment string) bool {
+	_, err := strconv.ParseUint(segment, 10, 64)
+	return err == nil
+}
+
+func singular(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "ses"), strings.HasSuffix(word, "xes"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+func decodeJSON(text string) (interface{}, bool) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(text)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// inferValue infers the schema of the string value of a parameter
+func inferValue(value string) map[string]interface{} {
+	if isNumber(strings.TrimPrefix(value, "-")) {
+		return map[string]interface{}{"type": "integer"}
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return map[string]interface{}{"type": "number"}
+	}
+	if value == "true" || value == "false" {
+		return map[string]interface{}{"type": "boolean"}
+	}
+	return inferSchema(value)
+}
+
+// inferSchema infers the schema of a json value
+func inferSchema(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case nil:
+		return map[string]interface{}{"x-nullable": true}
+	case bool:
+		return map[string]interface{}{"type": "boolean"}
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return map[string]interface{}{"type": "integer"}
+		}
+		return map[string]interface{}{"type": "number"}
+	case string:
+		schema := map[string]interface{}{"type": "string"}
+		if format := stringFormat(v); format != "" {
+			schema["format"] = format
+		}
+		return schema
+	case []interface{}:
+		var items map[string]interface{}
+		for _, item := range v {
+			items = mergeSchemas(items, inferSchema(item))
+		}
+		if items == nil {
+			items = map[string]interface{}{}
+		}
+		return map[string]interface{}{"type": "array", "items": items}
+	case map[string]interface{}:
+		properties := make(map[string]interface{}, len(v))
+		required := make([]string, 0, len(v))
+		for key, property := range v {
+			properties[key] = inferSchema(property)
+			required = append(required, key)
+		}
+		sort.Strings(required)
+		schema := map[string]interface{}{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	}
+	return map[string]interface{}{}
+}
+
+func stringFormat(value string) string {
+	switch {
+	case uuidValue.MatchString(value):
+		return "uuid"
+	case dateValue.MatchString(value):
+		return "date"
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return "date-time"
+	}
+	return ""
+}
+
+// mergeSchemas merges the schemas inferred from two observations of a value
+func mergeSchemas(a, b map[string]interface{}) map[string]interface{} {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	nullable := a["x-nullable"] == true || b["x-nullable"] == true
+	typeA, _ := a["type"].(string)
+	typeB, _ := b["type"].(string)
+	var result map[string]interface{}
+	switch {
+	case typeA == "" && len(a) <= 1:
+		// only a null was seen, or an empty array
+		result = copySchema(b)
+	case typeB == "" && len(b) <= 1:
+		result = copySchema(a)
+	case typeA != typeB:
+		if typeA == "integer" && typeB == "number" || typeA == "number" && typeB == "integer" {
+			result = map[string]interface{}{"type": "number"}
+		} else {
+			// the value isn't always of the same type
+			result = map[string]interface{}{}
+		}
+	case typeA == "object":
+		propertiesA, _ := a["properties"].(map[string]interface{})
+		propertiesB, _ := b["properties"].(map[string]interface{})
+		properties := make(map[string]interface{}, len(propertiesA))
+		for key, property := range propertiesA {
+			properties[key] = property
+		}
+		for key, property := range propertiesB {
+			if existing, ok := properties[key].(map[string]interface{}); ok {
+				properties[key] = mergeSchemas(existing, property.(map[string]interface{}))
+				continue
+			}
+			properties[key] = property
+		}
+		// a property is required when every object has it
+		var required []string
+		requiredB, _ := b["required"].([]string)
+		for _, key := range stringList(a["required"]) {
+			for _, other := range requiredB {
+				if key == other {
+					required = append(required, key)
+				}
+			}
+		}
+		result = map[string]interface{}{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			result["required"] = required
+		}
+	case typeA == "array":
+		itemsA, _ := a["items"].(map[string]interface{})
+		itemsB, _ := b["items"].(map[string]interface{})
+		result = map[string]interface{}{"type": "array", "items": mergeSchemas(itemsA, itemsB)}
+	default:
+		result = map[string]interface{}{"type": typeA}
+		if a["format"] != nil && a["format"] == b["format"] {
+			result["format"] = a["format"]
+		}
+	}
+	if nullable {
+		result["x-nullable"] = true
+	}
+	return result
+}
+
+func copySchema(schema map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(schema))
+	for key, value := range schema {
+		result[key] = value
+	}
+	return result
+}
+
+func stringList(value interface{}) []string {
+	list, _ := value.([]string)
+	return list
+}
+
+// paths builds the paths of the inferred operations
+func (inf *Inference) paths() map[string]interface{} {
+	paths := make(map[string]interface{})
+	sort.Strings(inf.order)
+	for _, key := range inf.order {
+		op := inf.operations[key]
+		item, ok := paths[op.path].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[op.path] = item
+		}
+
+		var params []interface{}
+		for _, param := range op.pathParams {
+			params = append(params, param.parameter("path", true))
+		}
+		for _, name := range sortedNames(op.query) {
+			param := op.query[name]
+			params = append(params, param.parameter("query", param.seen == op.count))
+		}
+		for _, name := range sortedNames(op.form) {
+			param := op.form[name]
+			params = append(params, param.parameter("formData", param.seen == op.count))
+		}
+		if op.body != nil && len(op.form) == 0 {
+			params = append(params, map[string]interface{}{"name": "body", "in": "body", "required": true, "schema": op.body})
+		}
+
+		operation := make(map[string]interface{})
+		if len(params) > 0 {
+			operation["parameters"] = params
+		}
+		if len(op.consumes) > 0 {
+			operation["consumes"] = op.consumes
+		}
+		if len(op.produces) > 0 {
+			operation["produces"] = op.produces
+		}
+		responses := make(map[string]interface{}, len(op.responses))
+		for status, observed := range op.responses {
+			response := map[string]interface{}{"description": http.StatusText(status)}
+			if response["description"] == "" {
+				response["description"] = "response " + strconv.Itoa(status)
+			}
+			if observed.schema != nil {
+				response["schema"] = observed.schema
+			}
+			if observed.example != nil {
+				response["examples"] = map[string]interface{}{observed.mediaType: observed.example}
+			}
+			responses[strconv.Itoa(status)] = response
+		}
+		if len(responses) == 0 {
+			responses["default"] = map[string]interface{}{"description": "default response"}
+		}
+		operation["responses"] = responses
+		item[op.method] = operation
+	}
+	return paths
+}
+
+func sortedNames(params map[string]*inferredParam) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parameter converts an inferred parameter, a string when its values aren't always of the same type
+func (p *inferredParam) parameter(in string, required bool) map[string]interface{} {
+	param := map[string]interface{}{"name": p.name, "in": in, "type": "string"}
+	if tpe, ok := p.schema["type"].(string); ok {
+		param["type"] = tpe
+	}
+	if format, ok := p.schema["format"]; ok {
+		param["format"] = format
+	}
+	if required {
+		param["required"] = true
+	}
+	return param
+}
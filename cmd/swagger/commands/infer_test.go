@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readHAR(t *testing.T) []HAREntry {
+	b, err := ioutil.ReadFile("../../../fixtures/infer/petstore.har")
+	require.NoError(t, err)
+	var har harLog
+	require.NoError(t, json.Unmarshal(b, &har))
+	return har.Log.Entries
+}
+
+func TestInferFromHAR(t *testing.T) {
+	doc, stats, err := InferFromHAR(readHAR(t), HARFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, InferStats{Requests: 8, Skipped: 3, Operations: 5}, stats)
+	assert.Equal(t, "petstore.example.com", doc.Host)
+	assert.Equal(t, []string{"https"}, doc.Schemes)
+	assert.Len(t, doc.Paths.Paths, 4)
+
+	list := doc.Paths.Paths["/api/pets"].Get
+	require.NotNil(t, list)
+	require.Len(t, list.Parameters, 2)
+	// an integer and a number
+	assert.Equal(t, "number", list.Parameters[0].Type)
+	assert.True(t, list.Parameters[0].Required)
+	assert.Equal(t, "status", list.Parameters[1].Name)
+	assert.False(t, list.Parameters[1].Required)
+	pet := list.Responses.StatusCodeResponses[200].Schema.Items.Schema
+	assert.Equal(t, []string{"id", "name", "tag"}, pet.Required)
+	assert.Equal(t, true, pet.Properties["tag"].Extensions["x-nullable"])
+	assert.Equal(t, spec.StringOrArray{"string"}, pet.Properties["tag"].Type)
+	assert.Equal(t, "date-time", pet.Properties["born"].Format)
+
+	get := doc.Paths.Paths["/api/pets/{petId}"].Get
+	require.NotNil(t, get)
+	assert.Equal(t, "petId", get.Parameters[0].Name)
+	assert.Equal(t, "integer", get.Parameters[0].Type)
+	assert.Equal(t, spec.StringOrArray{"number"}, get.Responses.StatusCodeResponses[200].Schema.Properties["weight"].Type)
+	assert.Equal(t, "Not Found", get.Responses.StatusCodeResponses[404].Description)
+
+	create := doc.Paths.Paths["/api/pets"].Post
+	require.NotNil(t, create)
+	assert.Equal(t, []string{"application/json"}, create.Consumes)
+	assert.Equal(t, "body", create.Parameters[0].In)
+
+	upload := doc.Paths.Paths["/api/pets/{petId}/photos/{photoId}"].Post
+	require.NotNil(t, upload)
+	require.Len(t, upload.Parameters, 4)
+	assert.Equal(t, "uuid", upload.Parameters[1].Format)
+	assert.Equal(t, "formData", upload.Parameters[3].In)
+	assert.Equal(t, "boolean", upload.Parameters[3].Type)
+
+	// v1 isn't an id
+	assert.Contains(t, doc.Paths.Paths, "/api/v1/status")
+
+	doc, _, err = InferFromHAR(readHAR(t), HARFilter{Host: "petstore.example.com", BasePath: "/api/pets"})
+	require.NoError(t, err)
+	assert.Equal(t, "/api/pets", doc.BasePath)
+	assert.Contains(t, doc.Paths.Paths, "/{petId}")
+	assert.Contains(t, doc.Paths.Paths, "/")
+
+	_, _, err = InferFromHAR(readHAR(t), HARFilter{Host: "unknown.example.com"})
+	assert.Error(t, err)
+}
+
+func TestMergeSchemas(t *testing.T) {
+	integer := map[string]interface{}{"type": "integer"}
+	assert.Equal(t, map[string]interface{}{"type": "number"}, mergeSchemas(integer, map[string]interface{}{"type": "number"}))
+	assert.Equal(t, map[string]interface{}{}, mergeSchemas(integer, map[string]interface{}{"type": "string"}))
+	assert.Equal(t, map[string]interface{}{"type": "integer", "x-nullable": true}, mergeSchemas(inferSchema(nil), integer))
+
+	// the items of an empty array are learnt from the next ones
+	empty := inferSchema([]interface{}{})
+	assert.Equal(t, map[string]interface{}{"type": "array", "items": integer}, mergeSchemas(empty, map[string]interface{}{"type": "array", "items": integer}))
+
+	date := inferSchema("2017-04-01")
+	assert.Equal(t, "date", date["format"])
+	assert.Equal(t, map[string]interface{}{"type": "string"}, mergeSchemas(date, inferSchema("tomorrow")))
+}
+
+func TestTemplatePath(t *testing.T) {
+	template, values := templatePath("/users/12/addresses/34/v2")
+	assert.Equal(t, "/users/{userId}/addresses/{addressId}/v2", template)
+	assert.Equal(t, []pathValue{{name: "userId", value: "12"}, {name: "addressId", value: "34"}}, values)
+
+	template, _ = templatePath("/files/3fa85f64a1b2c3d4e5f6/12")
+	assert.Equal(t, "/files/{fileId}/{id}", template)
+}
@@ -0,0 +1,15 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/lsp"
+)
+
+// LSPCmd is a command that runs a language server for swagger specs, over stdio
+type LSPCmd struct{}
+
+// Execute runs the language server until the editor asks it to exit
+func (l *LSPCmd) Execute(args []string) error {
+	return lsp.NewServer(os.Stdin, os.Stdout).Run()
+}
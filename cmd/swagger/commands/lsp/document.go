@@ -0,0 +1,390 @@
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	swaggererrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// document is a spec opened in the editor.
+//
+// The text is kept as the editor sent it, to map findings back to positions,
+// along with the last version of the text that could be parsed.
+type document struct {
+	uri    string
+	text   string
+	parsed interface{}
+	err    error
+}
+
+func newDocument(uri, text string) *document {
+	d := &document{uri: uri}
+	d.update(text)
+	return d
+}
+
+func (d *document) update(text string) {
+	d.text = text
+	parsed, err := parseDocument(text)
+	d.err = err
+	if err == nil {
+		d.parsed = parsed
+	}
+}
+
+// parseDocument parses a yaml or json spec into its json representation
+func parseDocument(text string) (interface{}, error) {
+	yml, err := swag.BytesToYAMLDoc([]byte(text))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := swag.YAMLToJSON(yml)
+	if err != nil {
+		return nil, err
+	}
+	var parsed interface{}
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+var yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+// diagnostics validates the document and reports the errors and warnings of the validator
+func (d *document) diagnostics() []Diagnostic {
+	diags := []Diagnostic{}
+	if d.err != nil {
+		rng := Range{}
+		if m := yamlErrorLine.FindStringSubmatch(d.err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			if line > 0 {
+				rng = Range{Start: Position{Line: line - 1}, End: Position{Line: line - 1, Character: utf16Len(d.line(line - 1))}}
+			}
+		}
+		return append(diags, Diagnostic{Range: rng, Severity: severityError, Source: "swagger", Message: d.err.Error()})
+	}
+
+	raw, err := json.Marshal(d.parsed)
+	if err != nil {
+		return append(diags, Diagnostic{Severity: severityError, Source: "swagger", Message: err.Error()})
+	}
+	specDoc, err := loads.Analyzed(raw, "")
+	if err != nil {
+		return append(diags, Diagnostic{Severity: severityError, Source: "swagger", Message: err.Error()})
+	}
+
+	errs, warnings := validateDocument(specDoc)
+	for _, e := range errs {
+		diags = append(diags, d.diagnostic(severityError, e))
+	}
+	for _, w := range warnings {
+		diags = append(diags, d.diagnostic(severityWarning, w))
+	}
+	return diags
+}
+
+func validateDocument(specDoc *loads.Document) (errs []error, warnings []error) {
+	// a document in the middle of an edit must not bring the server down
+	defer func() {
+		if r := recover(); r != nil {
+			errs = []error{fmt.Errorf("validation failed: %v", r)}
+			warnings = nil
+		}
+	}()
+
+	res, warns := validate.NewSpecValidator(specDoc.Schema(), strfmt.Default).Validate(specDoc)
+	if res != nil {
+		errs = flattenErrors(res.Errors)
+	}
+	if warns != nil {
+		warnings = flattenErrors(warns.Errors)
+	}
+	return
+}
+
+func flattenErrors(errs []error) []error {
+	var res []error
+	for _, e := range errs {
+		if composite, ok := e.(*swaggererrors.CompositeError); ok {
+			res = append(res, flattenErrors(composite.Errors)...)
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func (d *document) diagnostic(severity int, err error) Diagnostic {
+	diag := Diagnostic{Severity: severity, Source: "swagger", Message: err.Error()}
+	if v, ok := err.(*swaggererrors.Validation); ok && v.Name != "" {
+		// the validator names the faulty item with a dotted path, like paths./pets.get.parameters
+		if rng, found := d.locate(d.pointer(v.Name)); found > 0 {
+			diag.Range = rng
+		}
+	}
+	return diag
+}
+
+var bracketIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+// pointer splits the dotted path of a validation error, like paths./v1.0/pets.get.parameters,
+// into the tokens of a json pointer: the keys of the document may have dots of their own,
+// so each token is the longest key of the parsed document the path goes on with.
+func (d *document) pointer(name string) []string {
+	// the validator writes the array indexes either as keys or in brackets
+	name = strings.TrimPrefix(bracketIndex.ReplaceAllString(name, ".$1"), ".")
+	var tokens []string
+	value := d.parsed
+	for name != "" {
+		token := name
+		if i := strings.Index(name, "."); i >= 0 {
+			token = name[:i]
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key := range v {
+				if len(key) > len(token) && (name == key || strings.HasPrefix(name, key+".")) {
+					token = key
+				}
+			}
+			value = v[token]
+		case []interface{}:
+			value = nil
+			if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(v) {
+				value = v[i]
+			}
+		default:
+			// past what the document has, the path is split on its dots
+			value = nil
+		}
+		tokens = append(tokens, token)
+		name = strings.TrimPrefix(name[len(token):], ".")
+	}
+	return tokens
+}
+
+// locate finds the range of the key designated by the tokens of a json pointer in the document.
+//
+// Keys are searched for in order, each one after the previous one: when the full path can't be
+// found, the range of the deepest key found is returned along with the number of tokens found.
+func (d *document) locate(tokens []string) (Range, int) {
+	var rng Range
+	var found, offset int
+	value := d.parsed
+	for _, token := range tokens {
+		if items, ok := value.([]interface{}); ok {
+			// array indexes are not written in the document
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(items) {
+				break
+			}
+			value = items[i]
+			found++
+			continue
+		}
+		if obj, ok := value.(map[string]interface{}); ok {
+			value = obj[token]
+		} else {
+			value = nil
+		}
+		loc := keyPattern(token).FindStringSubmatchIndex(d.text[offset:])
+		if loc == nil {
+			break
+		}
+		start, end := offset+loc[2], offset+loc[3]
+		rng = Range{Start: d.position(start), End: d.position(end)}
+		offset = end
+		found++
+	}
+	return rng, found
+}
+
+func keyPattern(key string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)(?:^[ \t]*(?:-[ \t]+)?|[{,][ \t\r\n]*)["']?(` + regexp.QuoteMeta(key) + `)["']?[ \t]*:`)
+}
+
+// position converts a byte offset in the text to a position
+func (d *document) position(offset int) Position {
+	before := d.text[:offset]
+	line := strings.Count(before, "\n")
+	lineStart := strings.LastIndex(before, "\n") + 1
+	return Position{Line: line, Character: utf16Len(before[lineStart:])}
+}
+
+// utf16Len is the length of a string in UTF-16 code units, the unit of the characters of the positions:
+// the characters out of the basic multilingual plane, like emojis, count as two
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n++
+		if r >= 0x10000 {
+			n++
+		}
+	}
+	return n
+}
+
+// byteOffset converts the character of a position, in UTF-16 code units, to a byte offset in its line
+func byteOffset(line string, character int) int {
+	n := 0
+	for i, r := range line {
+		if n >= character {
+			return i
+		}
+		n++
+		if r >= 0x10000 {
+			n++
+		}
+	}
+	return len(line)
+}
+
+// line returns a line of the text, without its line terminator
+func (d *document) line(n int) string {
+	lines := strings.Split(d.text, "\n")
+	if n < 0 || n >= len(lines) {
+		return ""
+	}
+	return strings.TrimRight(lines[n], "\r")
+}
+
+var refPattern = regexp.MustCompile(`["']?\$ref["']?[ \t]*:[ \t]*["']?([^"'\s,}]+)`)
+
+// refAt returns the $ref written on the line of a position
+func (d *document) refAt(pos Position) (string, Range, bool) {
+	line := d.line(pos.Line)
+	loc := refPattern.FindStringSubmatchIndex(line)
+	if loc == nil {
+		return "", Range{}, false
+	}
+	rng := Range{
+		Start: Position{Line: pos.Line, Character: utf16Len(line[:loc[2]])},
+		End:   Position{Line: pos.Line, Character: utf16Len(line[:loc[3]])},
+	}
+	return line[loc[2]:loc[3]], rng, true
+}
+
+var keyAtPattern = regexp.MustCompile(`^[ \t]*(?:-[ \t]+)?["']?([$\w-]+)["']?[ \t]*:`)
+
+// keyAt returns the key written on the line of a position, when the position is on that key
+func (d *document) keyAt(pos Position) (string, Range, bool) {
+	line := d.line(pos.Line)
+	loc := keyAtPattern.FindStringSubmatchIndex(line)
+	if loc == nil {
+		return "", Range{}, false
+	}
+	start, end := utf16Len(line[:loc[2]]), utf16Len(line[:loc[3]])
+	if pos.Character < start || pos.Character > end {
+		return "", Range{}, false
+	}
+	return line[loc[2]:loc[3]], Range{Start: Position{Line: pos.Line, Character: start}, End: Position{Line: pos.Line, Character: end}}, true
+}
+
+// pointerTokens returns the tokens of the json pointer in the fragment of a $ref
+func pointerTokens(fragment string) ([]string, error) {
+	ptr, err := jsonpointer.New(fragment)
+	if err != nil {
+		return nil, err
+	}
+	return ptr.DecodedTokens(), nil
+}
+
+// lookup returns the value designated by a json pointer in the last parsed version of the document
+func (d *document) lookup(fragment string) (interface{}, bool) {
+	if d.parsed == nil {
+		return nil, false
+	}
+	ptr, err := jsonpointer.New(fragment)
+	if err != nil {
+		return nil, false
+	}
+	value, _, err := ptr.Get(d.parsed)
+	return value, err == nil
+}
+
+// refTargets returns the local refs that can be used in the document
+func (d *document) refTargets() []completionItem {
+	root, ok := d.parsed.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var items []completionItem
+	for _, section := range []string{"definitions", "parameters", "responses"} {
+		entries, ok := root[section].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		names := make([]string, 0, len(entries))
+		for name := range entries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			ref := "#/" + section + "/" + jsonpointer.Escape(name)
+			items = append(items, completionItem{
+				Label:      ref,
+				Kind:       completionKindReference,
+				Detail:     summary(entries[name]),
+				InsertText: ref,
+			})
+		}
+	}
+	return items
+}
+
+// summary describes a schema, parameter or response in a single line
+func summary(value interface{}) string {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"title", "description"} {
+		if str, ok := obj[key].(string); ok && str != "" {
+			return strings.SplitN(str, "\n", 2)[0]
+		}
+	}
+	if tpe, ok := obj["type"].(string); ok {
+		return tpe
+	}
+	return ""
+}
+
+// describe renders the documentation of the target of a $ref as markdown
+func describe(ref string, value interface{}) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "**%s**", ref)
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return b.String()
+	}
+	if title, ok := obj["title"].(string); ok && title != "" {
+		fmt.Fprintf(&b, "\n\n%s", title)
+	}
+	if desc, ok := obj["description"].(string); ok && desc != "" {
+		fmt.Fprintf(&b, "\n\n%s", desc)
+	}
+	if tpe, ok := obj["type"].(string); ok {
+		fmt.Fprintf(&b, "\n\ntype: `%s`", tpe)
+	}
+	if props, ok := obj["properties"].(map[string]interface{}); ok && len(props) > 0 {
+		names := make([]string, 0, len(props))
+		for name := range props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&b, "\n\nproperties: `%s`", strings.Join(names, "`, `"))
+	}
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package lsp
+
+// keywords documents the keywords of swagger 2.0 and of the json schema subset it uses, for hovers
+var keywords = map[string]string{
+	"swagger":              "The version of the swagger specification used by the document, must be `\"2.0\"`.",
+	"info":                 "Metadata about the API: title, description, version, contact and license.",
+	"host":                 "The host (name or ip) serving the API, optionally with a port. Defaults to the host serving the documentation.",
+	"basePath":             "The base path on which the API is served, relative to the host. It must start with a `/`.",
+	"schemes":              "The transfer protocols of the API: `http`, `https`, `ws` or `wss`.",
+	"consumes":             "The mime types the operations can consume. Operations can override this list.",
+	"produces":             "The mime types the operations can produce. Operations can override this list.",
+	"paths":                "The available paths and operations of the API, relative to the base path.",
+	"definitions":          "The data types produced and consumed by the operations, as schemas that can be referenced with `#/definitions/{name}`.",
+	"parameters":           "A list of parameters. At the root of the document, parameters that can be referenced with `#/parameters/{name}`.",
+	"responses":            "The responses of an operation, by http status code or `default`. At the root of the document, responses that can be referenced with `#/responses/{name}`.",
+	"securityDefinitions":  "The security schemes available to the operations: `basic`, `apiKey` or `oauth2`.",
+	"security":             "The security requirements: a list of alternatives, each one naming the security schemes that must all be satisfied, with the required oauth2 scopes.",
+	"tags":                 "Tags grouping operations, the generator uses the first tag of an operation as its package.",
+	"externalDocs":         "A link to additional external documentation.",
+	"operationId":          "The unique name of the operation, the generator uses it to name the operation.",
+	"summary":              "A short summary of what the operation does.",
+	"deprecated":           "Declares the operation as deprecated.",
+	"name":                 "The name of the parameter. Path parameters must match a `{name}` of the path.",
+	"in":                   "The location of the parameter: `query`, `header`, `path`, `formData` or `body`.",
+	"schema":               "The schema of a body parameter or of the payload of a response.",
+	"headers":              "The headers sent with a response.",
+	"collectionFormat":     "How an array is serialized: `csv`, `ssv`, `tsv`, `pipes` or `multi` (query and form parameters only).",
+	"allowEmptyValue":      "Allows a query or form parameter to be sent with an empty value.",
+	"$ref":                 "A json reference to a definition, parameter or response, in this document (`#/definitions/{name}`) or in another one (`file.yaml#/definitions/{name}`).",
+	"title":                "A short title of the schema.",
+	"description":          "A description, github flavored markdown can be used for rich text.",
+	"type":                 "The type of the value: `string`, `number`, `integer`, `boolean`, `array`, `object`, or `file` for form parameters.",
+	"format":               "Refines the type, for example `int32`, `int64`, `float`, `double`, `byte`, `binary`, `date`, `date-time`, `password`, or a custom format like `uuid` or `email`.",
+	"default":              "The value the server uses when none is provided. It must be valid against the schema.",
+	"example":              "An example of a value for the schema.",
+	"enum":                 "The list of the allowed values.",
+	"required":             "For a parameter, whether it is mandatory. For a schema, the list of the properties that must be present.",
+	"properties":           "The properties of an object, by name.",
+	"additionalProperties": "The schema of the properties of an object that aren't declared in `properties`, which makes it a map.",
+	"items":                "The schema of the elements of an array.",
+	"allOf":                "A list of schemas the value must all be valid against, used for composition.",
+	"discriminator":        "The name of the property that tells which subtype of a polymorphic schema a value is.",
+	"readOnly":             "Declares a property as only sent in responses, never in requests.",
+	"maximum":              "The maximum value of a number.",
+	"exclusiveMaximum":     "When true, the value must be strictly lower than `maximum`.",
+	"minimum":              "The minimum value of a number.",
+	"exclusiveMinimum":     "When true, the value must be strictly greater than `minimum`.",
+	"multipleOf":           "The value must be a multiple of this number.",
+	"maxLength":            "The maximum length of a string.",
+	"minLength":            "The minimum length of a string.",
+	"pattern":              "A regular expression the string must match.",
+	"maxItems":             "The maximum number of elements of an array.",
+	"minItems":             "The minimum number of elements of an array.",
+	"uniqueItems":          "When true, the elements of an array must all be different.",
+	"maxProperties":        "The maximum number of properties of an object.",
+	"minProperties":        "The minimum number of properties of an object.",
+	"x-nullable":           "go-swagger extension: declares that the value can be null, the generator uses a pointer for it.",
+	"x-omitempty":          "go-swagger extension: controls the `omitempty` flag of the json tag of a property.",
+	"x-go-name":            "go-swagger extension: the name to use for the generated go identifier.",
+	"x-go-type":            "go-swagger extension: an existing go type to use instead of generating one.",
+	"x-isnullable":         "go-swagger extension: same as `x-nullable`.",
+}
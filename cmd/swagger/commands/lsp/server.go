@@ -0,0 +1,305 @@
+// Package lsp implements a language server for swagger specs.
+//
+// It talks the language server protocol over stdio, and provides:
+//   - diagnostics from the spec validator, as the document is edited
+//   - go to definition for $refs, in the same document or in another file
+//   - hovers documenting the keywords and the targets of $refs
+//   - completion of the definitions, parameters and responses that can be referenced
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Server is a language server for swagger specs
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	writeLock sync.Mutex
+	docs      map[string]*document
+	shutdown  bool
+}
+
+// NewServer creates a language server reading requests from in and writing responses to out
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		docs: make(map[string]*document),
+	}
+}
+
+// Run serves requests until the client asks the server to exit or closes the input
+func (s *Server) Run() error {
+	for {
+		msg, err := s.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg == nil {
+			s.reply(nil, nil, &responseError{Code: codeParseError, Message: "invalid message"})
+			continue
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit requested before shutdown")
+			}
+			return nil
+		}
+		s.handle(msg)
+	}
+}
+
+// read reads a message, framed by a Content-Length header. A nil message means the content isn't valid json.
+func (s *Server) read() (*message, error) {
+	var length int
+	for {
+		line, err := s.in.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(strings.ToLower(line), "content-length:") {
+			length, err = strconv.Atoi(strings.TrimSpace(line[len("content-length:"):]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid header %q: %v", line, err)
+			}
+		}
+	}
+	if length <= 0 {
+		return nil, fmt.Errorf("missing Content-Length header")
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(s.in, body); err != nil {
+		return nil, err
+	}
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, nil
+	}
+	return &msg, nil
+}
+
+func (s *Server) write(msg *message) {
+	msg.JSONRPC = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		log.Println("encoding a message failed:", err)
+		return
+	}
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	if _, err := fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body); err != nil {
+		log.Println("writing a message failed:", err)
+	}
+}
+
+func (s *Server) reply(id *json.RawMessage, result interface{}, err *responseError) {
+	if id == nil {
+		// notifications don't get any response
+		return
+	}
+	if result == nil && err == nil {
+		// the result must be present in a successful response, even when there is nothing to return
+		result = json.RawMessage("null")
+	}
+	s.write(&message{ID: id, Result: result, Error: err})
+}
+
+func (s *Server) notify(method string, params interface{}) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		log.Println("encoding a notification failed:", err)
+		return
+	}
+	s.write(&message{Method: method, Params: raw})
+}
+
+func (s *Server) handle(msg *message) {
+	switch msg.Method {
+	case "initialize":
+		s.reply(msg.ID, initializeResult{Capabilities: serverCapabilities{
+			TextDocumentSync:   syncFull,
+			HoverProvider:      true,
+			DefinitionProvider: true,
+			CompletionProvider: &completionOptions{TriggerCharacters: []string{"#", "/", "'", "\""}},
+		}}, nil)
+	case "initialized":
+	case "shutdown":
+		s.shutdown = true
+		s.reply(msg.ID, nil, nil)
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if s.decode(msg, &params) {
+			doc := newDocument(params.TextDocument.URI, params.TextDocument.Text)
+			s.docs[doc.uri] = doc
+			s.publish(doc)
+		}
+	case "textDocument/didChange":
+		var params didChangeParams
+		if s.decode(msg, &params) && len(params.ContentChanges) > 0 {
+			// the server asks for full synchronization, so the last change holds the whole text
+			text := params.ContentChanges[len(params.ContentChanges)-1].Text
+			doc, ok := s.docs[params.TextDocument.URI]
+			if !ok {
+				doc = newDocument(params.TextDocument.URI, text)
+				s.docs[doc.uri] = doc
+			} else {
+				doc.update(text)
+			}
+			s.publish(doc)
+		}
+	case "textDocument/didClose":
+		var params didCloseParams
+		if s.decode(msg, &params) {
+			delete(s.docs, params.TextDocument.URI)
+			s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}})
+		}
+
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if s.decode(msg, &params) {
+			s.reply(msg.ID, s.definition(params), nil)
+		}
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if s.decode(msg, &params) {
+			s.reply(msg.ID, s.hover(params), nil)
+		}
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if s.decode(msg, &params) {
+			s.reply(msg.ID, s.completion(params), nil)
+		}
+
+	default:
+		s.reply(msg.ID, nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q is not supported", msg.Method)})
+	}
+}
+
+func (s *Server) decode(msg *message, params interface{}) bool {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		s.reply(msg.ID, nil, &responseError{Code: codeInvalidParams, Message: err.Error()})
+		return false
+	}
+	return true
+}
+
+func (s *Server) publish(doc *document) {
+	s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: doc.uri, Diagnostics: doc.diagnostics()})
+}
+
+// resolve returns the document a $ref points to, along with the json pointer of the fragment
+func (s *Server) resolve(from *document, ref string) (*document, string, bool) {
+	parts := strings.SplitN(ref, "#", 2)
+	var fragment string
+	if len(parts) == 2 {
+		fragment = parts[1]
+	}
+	if parts[0] == "" {
+		return from, fragment, true
+	}
+
+	base, err := url.Parse(from.uri)
+	if err != nil {
+		return nil, "", false
+	}
+	target, err := base.Parse(parts[0])
+	if err != nil {
+		return nil, "", false
+	}
+	uri := target.String()
+	if doc, ok := s.docs[uri]; ok {
+		return doc, fragment, true
+	}
+	if target.Scheme != "file" {
+		return nil, "", false
+	}
+	b, err := ioutil.ReadFile(filepath.FromSlash(target.Path))
+	if err != nil {
+		return nil, "", false
+	}
+	return newDocument(uri, string(b)), fragment, true
+}
+
+func (s *Server) definition(params textDocumentPositionParams) []Location {
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return []Location{}
+	}
+	ref, _, ok := doc.refAt(params.Position)
+	if !ok {
+		return []Location{}
+	}
+	target, fragment, ok := s.resolve(doc, ref)
+	if !ok {
+		return []Location{}
+	}
+	tokens, err := pointerTokens(fragment)
+	if err != nil {
+		return []Location{}
+	}
+	rng, found := target.locate(tokens)
+	if found < len(tokens) {
+		return []Location{}
+	}
+	return []Location{{URI: target.uri, Range: rng}}
+}
+
+func (s *Server) hover(params textDocumentPositionParams) *hover {
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+
+	if ref, rng, ok := doc.refAt(params.Position); ok && params.Position.Character >= rng.Start.Character {
+		if target, fragment, ok := s.resolve(doc, ref); ok {
+			if value, ok := target.lookup(fragment); ok {
+				return &hover{Contents: markupContent{Kind: markupKindMarkdown, Value: describe(ref, value)}, Range: &rng}
+			}
+		}
+	}
+
+	if key, rng, ok := doc.keyAt(params.Position); ok {
+		if docs, ok := keywords[key]; ok {
+			return &hover{Contents: markupContent{Kind: markupKindMarkdown, Value: fmt.Sprintf("**%s**\n\n%s", key, docs)}, Range: &rng}
+		}
+	}
+	return nil
+}
+
+func (s *Server) completion(params textDocumentPositionParams) []completionItem {
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return []completionItem{}
+	}
+	line := doc.line(params.Position.Line)
+	line = line[:byteOffset(line, params.Position.Character)]
+	if !strings.Contains(line, "$ref") {
+		return []completionItem{}
+	}
+	items := doc.refTargets()
+	if items == nil {
+		return []completionItem{}
+	}
+	return items
+}
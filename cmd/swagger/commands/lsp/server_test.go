@@ -0,0 +1,218 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	swaggererrors "github.com/go-openapi/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petstore = `swagger: "2.0"
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        200:
+          description: the pets
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Pet"
+definitions:
+  Pet:
+    description: A pet of the store
+    type: object
+    properties:
+      name:
+        type: string
+  Category:
+    type: object
+`
+
+func TestDocument_Locate(t *testing.T) {
+	doc := newDocument("file:///petstore.yml", petstore)
+
+	rng, found := doc.locate([]string{"definitions", "Pet"})
+	assert.Equal(t, 2, found)
+	assert.Equal(t, Range{Start: Position{Line: 16, Character: 2}, End: Position{Line: 16, Character: 5}}, rng)
+
+	_, found = doc.locate([]string{"definitions", "Order"})
+	assert.Equal(t, 1, found)
+}
+
+func TestDocument_RefAt(t *testing.T) {
+	doc := newDocument("file:///petstore.yml", petstore)
+
+	ref, rng, ok := doc.refAt(Position{Line: 14, Character: 20})
+	require.True(t, ok)
+	assert.Equal(t, "#/definitions/Pet", ref)
+	assert.Equal(t, 21, rng.Start.Character)
+
+	_, _, ok = doc.refAt(Position{Line: 13, Character: 4})
+	assert.False(t, ok)
+}
+
+func TestDocument_RefTargets(t *testing.T) {
+	doc := newDocument("file:///petstore.yml", petstore)
+
+	items := doc.refTargets()
+	require.Len(t, items, 2)
+	assert.Equal(t, "#/definitions/Category", items[0].Label)
+	assert.Equal(t, "#/definitions/Pet", items[1].Label)
+	assert.Equal(t, "A pet of the store", items[1].Detail)
+}
+
+func TestDocument_Diagnostics(t *testing.T) {
+	doc := newDocument("file:///petstore.yml", "swagger: \"2.0\"\ninfo: [\n")
+	diags := doc.diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, severityError, diags[0].Severity)
+}
+
+func frame(t *testing.T, id int, method string, params interface{}) string {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id > 0 {
+		msg["id"] = id
+	}
+	b, err := json.Marshal(msg)
+	require.NoError(t, err)
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(b), b)
+}
+
+func readMessages(t *testing.T, out io.Reader) []message {
+	var msgs []message
+	r := bufio.NewReader(out)
+	for {
+		header, err := r.ReadString('\n')
+		if err == io.EOF {
+			return msgs
+		}
+		require.NoError(t, err)
+		length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "Content-Length:")))
+		require.NoError(t, err)
+		_, err = r.ReadString('\n')
+		require.NoError(t, err)
+		body := make([]byte, length)
+		_, err = io.ReadFull(r, body)
+		require.NoError(t, err)
+		var msg message
+		require.NoError(t, json.Unmarshal(body, &msg))
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	uri := "file:///petstore.yml"
+	doc := map[string]interface{}{"uri": uri}
+	in := strings.Join([]string{
+		frame(t, 1, "initialize", map[string]interface{}{}),
+		frame(t, 0, "initialized", map[string]interface{}{}),
+		frame(t, 0, "textDocument/didOpen", map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri, "text": petstore}}),
+		frame(t, 2, "textDocument/definition", map[string]interface{}{"textDocument": doc, "position": Position{Line: 14, Character: 30}}),
+		frame(t, 3, "textDocument/hover", map[string]interface{}{"textDocument": doc, "position": Position{Line: 7, Character: 8}}),
+		frame(t, 4, "textDocument/completion", map[string]interface{}{"textDocument": doc, "position": Position{Line: 14, Character: 21}}),
+		frame(t, 5, "workspace/symbol", map[string]interface{}{}),
+		frame(t, 6, "shutdown", nil),
+		frame(t, 0, "exit", nil),
+	}, "")
+
+	var out bytes.Buffer
+	require.NoError(t, NewServer(strings.NewReader(in), &out).Run())
+
+	msgs := readMessages(t, &out)
+	require.Len(t, msgs, 7)
+
+	results := make(map[string]json.RawMessage)
+	for _, msg := range msgs {
+		b, err := json.Marshal(msg.Result)
+		require.NoError(t, err)
+		switch {
+		case msg.Method != "":
+			results[msg.Method] = msg.Params
+		case msg.Error != nil:
+			results[string(*msg.ID)] = json.RawMessage(strconv.Itoa(msg.Error.Code))
+		default:
+			results[string(*msg.ID)] = b
+		}
+	}
+
+	var diags publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(results["textDocument/publishDiagnostics"], &diags))
+	assert.Equal(t, uri, diags.URI)
+
+	var locations []Location
+	require.NoError(t, json.Unmarshal(results["2"], &locations))
+	require.Len(t, locations, 1)
+	assert.Equal(t, 16, locations[0].Range.Start.Line)
+
+	var hovered hover
+	require.NoError(t, json.Unmarshal(results["3"], &hovered))
+	assert.Contains(t, hovered.Contents.Value, "**operationId**")
+
+	var items []completionItem
+	require.NoError(t, json.Unmarshal(results["4"], &items))
+	assert.Len(t, items, 2)
+
+	assert.Equal(t, strconv.Itoa(codeMethodNotFound), string(results["5"]))
+	assert.Equal(t, "null", string(results["6"]))
+}
+
+const versioned = `{
+  "swagger": "2.0",
+  "info": {"title": "🐶 Petstore", "version": "1.0.0"},
+  "paths": {
+    "/v1": {"get": {"responses": {"200": {"description": "the version"}}}},
+    "/v1.0/pets": {"get": {"description": "🐶 lists the dogs", "parameters": [{"name": "limit", "in": "query", "type": "integer"}]}}
+  }
+}`
+
+func TestDocument_Pointer(t *testing.T) {
+	doc := newDocument("file:///versioned.json", versioned)
+
+	for name, tokens := range map[string][]string{
+		"paths./v1.0/pets.get.parameters":         {"paths", "/v1.0/pets", "get", "parameters"},
+		"paths./v1.0/pets.get.parameters[0].type": {"paths", "/v1.0/pets", "get", "parameters", "0", "type"},
+		"paths./v1.0/pets.get.parameters.0.type":  {"paths", "/v1.0/pets", "get", "parameters", "0", "type"},
+		"paths./v1.get.responses.200.description": {"paths", "/v1", "get", "responses", "200", "description"},
+		"definitions.Pet.properties.name":         {"definitions", "Pet", "properties", "name"},
+	} {
+		assert.Equal(t, tokens, doc.pointer(name), name)
+	}
+}
+
+func TestDocument_UTF16(t *testing.T) {
+	doc := newDocument("file:///versioned.json", versioned)
+
+	// the dog out of the basic multilingual plane is two UTF-16 code units
+	rng, found := doc.locate([]string{"paths", "/v1.0/pets", "get", "parameters"})
+	assert.Equal(t, 4, found)
+	assert.Equal(t, Range{Start: Position{Line: 5, Character: 64}, End: Position{Line: 5, Character: 74}}, rng)
+
+	diag := doc.diagnostic(severityError, swaggererrors.InvalidType("paths./v1.0/pets.get", "", "object", nil))
+	assert.Equal(t, Range{Start: Position{Line: 5, Character: 20}, End: Position{Line: 5, Character: 23}}, diag.Range)
+
+	// the array indexes aren't written in the document, the numeric keys are
+	rng, found = doc.locate([]string{"paths", "/v1.0/pets", "get", "parameters", "0", "type"})
+	assert.Equal(t, 6, found)
+	assert.Equal(t, Position{Line: 5, Character: 112}, rng.Start)
+	rng, found = doc.locate([]string{"paths", "/v1", "get", "responses", "200"})
+	assert.Equal(t, 5, found)
+	assert.Equal(t, Position{Line: 4, Character: 35}, rng.Start)
+
+	assert.Equal(t, 3, utf16Len("a🐶"))
+	assert.Equal(t, len("a🐶"), byteOffset("a🐶b", 3))
+	assert.Equal(t, 1, byteOffset("a🐶b", 1))
+	assert.Equal(t, len("a🐶b"), byteOffset("a🐶b", 10))
+}
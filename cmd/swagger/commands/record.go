@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/go-openapi/loads"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/record"
+)
+
+// RecordCmd is a command that proxies a service, to infer a spec from its traffic or to verify the traffic against a spec
+type RecordCmd struct {
+	Target   string         `long:"target" short:"t" required:"true" description:"the url of the service to proxy"`
+	Listen   string         `long:"listen" short:"l" default:"localhost:8000" description:"the address the proxy listens on"`
+	Spec     flags.Filename `long:"spec" short:"f" description:"the spec the traffic is verified against, the requests and responses which don't match it are logged"`
+	BasePath string         `long:"base-path" description:"the base path of the inferred spec, the requests outside of it are skipped"`
+	Output   flags.Filename `long:"output" short:"o" description:"the file the inferred spec is written to after each request, as yaml when it ends with .yml or .yaml and as json otherwise"`
+	Coverage flags.Filename `long:"coverage" description:"the file the coverage profile of the spec is written to after each request, for swagger coverage"`
+}
+
+// Execute proxies the service until interrupted
+func (c *RecordCmd) Execute(args []string) error {
+	if c.Spec == "" && c.Output == "" {
+		return errors.New("The record command requires a spec to verify the traffic against (--spec) or a file to write the inferred spec to (--output)")
+	}
+	if c.Coverage != "" && c.Spec == "" {
+		return errors.New("The coverage of the traffic requires the spec it is reported for (--spec)")
+	}
+	target, err := url.Parse(c.Target)
+	if err != nil || target.Host == "" {
+		return fmt.Errorf("the target %q isn't the url of a service", c.Target)
+	}
+
+	var verifier *record.Verifier
+	var coverage *record.Coverage
+	if c.Spec != "" {
+		specDoc, err := loads.Spec(string(c.Spec))
+		if err != nil {
+			return err
+		}
+		if verifier, err = record.NewVerifier(specDoc); err != nil {
+			return err
+		}
+		if c.Coverage != "" {
+			if coverage, err = record.NewCoverage(specDoc); err != nil {
+				return err
+			}
+		}
+	}
+	inference := NewInference(HARFilter{Host: target.Host, BasePath: c.BasePath})
+
+	var mu sync.Mutex
+	handler := record.NewProxy(target, func(exchange *record.Exchange) {
+		mu.Lock()
+		defer mu.Unlock()
+		if verifier != nil {
+			for _, mismatch := range verifier.Verify(exchange) {
+				log.Printf("mismatch: %s %s: %s", exchange.Method, exchange.URL.RequestURI(), mismatch)
+			}
+		}
+		if coverage != nil {
+			coverage.Observe(exchange)
+			if err := coverage.WriteProfile(string(c.Coverage)); err != nil {
+				log.Printf("the coverage profile can't be written: %v", err)
+			}
+		}
+		if c.Output == "" || !inference.Observe(harEntry(exchange)) {
+			return
+		}
+		inferred, err := inference.Spec()
+		if err == nil {
+			err = writeInferred(inferred, string(c.Output), false)
+		}
+		if err != nil {
+			log.Printf("the inferred spec can't be written: %v", err)
+		}
+	})
+
+	log.Printf("recording the traffic to %s on http://%s", target, c.Listen)
+	return http.ListenAndServe(c.Listen, handler)
+}
+
+// harEntry turns an exchange into the HAR entry the inference reads
+func harEntry(exchange *record.Exchange) HAREntry {
+	var entry HAREntry
+	entry.Request.Method = exchange.Method
+	entry.Request.URL = exchange.URL.String()
+	if len(exchange.RequestBody) > 0 && !exchange.Truncated {
+		entry.Request.PostData = &harPostData{
+			MimeType: exchange.RequestHeader.Get("Content-Type"),
+			Text:     string(exchange.RequestBody),
+		}
+	}
+	entry.Response.Status = exchange.Status
+	entry.Response.Content.MimeType = exchange.ResponseHeader.Get("Content-Type")
+	if !exchange.Truncated {
+		entry.Response.Content.Text = string(exchange.ResponseBody)
+	}
+	return entry
+}
@@ -0,0 +1,322 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/loads"
+)
+
+// MaxUnmatched is the number of distinct requests matching no operation a coverage keeps, the others are only counted
+var MaxUnmatched = 100
+
+// Coverage counts the calls to the operations of a spec by status, it is safe for concurrent use
+type Coverage struct {
+	verifier *Verifier
+	mu       sync.Mutex
+	calls    map[CoveredCall]int
+	// unmatched counts the distinct calls kept which match no operation
+	unmatched int
+	dropped   int
+}
+
+// CoverageProfile is the json document a coverage is saved to, and read from by the report
+type CoverageProfile struct {
+	Calls []CoveredCall `json:"calls"`
+	// Dropped counts the requests matching no operation which weren't kept, past MaxUnmatched
+	Dropped int `json:"dropped,omitempty"`
+}
+
+// CoveredCall counts the calls to an operation, identified by its method and the path of the spec, which got a status.
+// The path of a call matching no operation is the one of the request.
+type CoveredCall struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status"`
+	Unmatched bool   `json:"unmatched,omitempty"`
+	Count     int    `json:"count"`
+}
+
+// NewCoverage creates an empty coverage of the operations of a spec
+func NewCoverage(doc *loads.Document) (*Coverage, error) {
+	verifier, err := NewVerifier(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &Coverage{verifier: verifier, calls: make(map[CoveredCall]int)}, nil
+}
+
+// Record counts a request to a path, with the status of its response
+func (c *Coverage) Record(method, pth string, status int) {
+	key := CoveredCall{Method: strings.ToUpper(method), Path: pth, Status: status}
+	if matched := c.verifier.lookup(key.Method, pth); matched != nil {
+		key.Path = matched.path
+	} else {
+		key.Unmatched = true
+	}
+	c.add(key, 1)
+}
+
+// Observe counts an exchange, recorded by the middleware or the proxy of this package
+func (c *Coverage) Observe(exchange *Exchange) {
+	c.Record(exchange.Method, exchange.URL.Path, exchange.Status)
+}
+
+func (c *Coverage) add(key CoveredCall, count int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, known := c.calls[key]; !known && key.Unmatched {
+		if c.unmatched >= MaxUnmatched {
+			c.dropped += count
+			return
+		}
+		c.unmatched++
+	}
+	c.calls[key] += count
+}
+
+// Middleware counts the requests handled by next. Unlike the recording middleware, it doesn't copy the bodies,
+// so it can stay in front of a server in production.
+func (c *Coverage) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: rw}
+		next.ServeHTTP(recorder, r)
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+		c.Record(r.Method, r.URL.Path, recorder.status)
+	})
+}
+
+// Profile is the content of the coverage, sorted by path, method and status
+func (c *Coverage) Profile() *CoverageProfile {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	profile := &CoverageProfile{Calls: make([]CoveredCall, 0, len(c.calls)), Dropped: c.dropped}
+	for key, count := range c.calls {
+		key.Count = count
+		profile.Calls = append(profile.Calls, key)
+	}
+	sort.Slice(profile.Calls, func(i, j int) bool {
+		a, b := profile.Calls[i], profile.Calls[j]
+		if a.Unmatched != b.Unmatched {
+			return !a.Unmatched
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		return a.Status < b.Status
+	})
+	return profile
+}
+
+// Add adds the calls of a profile to the coverage. A call to an operation the spec doesn't have anymore is unmatched.
+func (c *Coverage) Add(profile *CoverageProfile) {
+	for _, call := range profile.Calls {
+		count := call.Count
+		call.Count = 0
+		if !call.Unmatched && c.verifier.route(call.Method, call.Path) == nil {
+			call.Unmatched = true
+		}
+		c.add(call, count)
+	}
+	c.mu.Lock()
+	c.dropped += profile.Dropped
+	c.mu.Unlock()
+}
+
+// WriteProfile saves the coverage to a file, for the coverage command
+func (c *Coverage) WriteProfile(path string) error {
+	b, err := json.MarshalIndent(c.Profile(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// ReadCoverageProfile reads a coverage saved by WriteProfile
+func ReadCoverageProfile(path string) (*CoverageProfile, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var profile CoverageProfile
+	if err := json.Unmarshal(b, &profile); err != nil {
+		return nil, fmt.Errorf("%s is not a coverage profile: %v", path, err)
+	}
+	return &profile, nil
+}
+
+// CoverageReport tells which operations and responses of a spec were called
+type CoverageReport struct {
+	Operations []OperationCoverage `json:"operations"`
+	// Unmatched are the calls matching no operation of the spec
+	Unmatched []CoveredCall `json:"unmatched,omitempty"`
+	Dropped   int           `json:"dropped,omitempty"`
+}
+
+// OperationCoverage tells how an operation was called
+type OperationCoverage struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	ID     string `json:"id,omitempty"`
+	Calls  int    `json:"calls"`
+	// Responses are the responses of the spec, with their calls: the default response counts the statuses the others don't declare
+	Responses []ResponseCoverage `json:"responses"`
+	// Undocumented are the statuses of the calls which aren't responses of the operation
+	Undocumented []ResponseCoverage `json:"undocumented,omitempty"`
+}
+
+// ResponseCoverage counts the calls getting a response, its status is a code or "default"
+type ResponseCoverage struct {
+	Status string `json:"status"`
+	Calls  int    `json:"calls"`
+}
+
+// String is the operation as METHOD /path (id)
+func (o OperationCoverage) String() string {
+	if o.ID == "" {
+		return o.Method + " " + o.Path
+	}
+	return fmt.Sprintf("%s %s (%s)", o.Method, o.Path, o.ID)
+}
+
+// Report matches the coverage with the operations of the spec, sorted by path and method
+func (c *Coverage) Report() *CoverageReport {
+	profile := c.Profile()
+	report := &CoverageReport{Dropped: profile.Dropped}
+	statuses := make(map[string]map[int]int)
+	for _, call := range profile.Calls {
+		if call.Unmatched {
+			report.Unmatched = append(report.Unmatched, call)
+			continue
+		}
+		key := call.Method + " " + call.Path
+		if statuses[key] == nil {
+			statuses[key] = make(map[int]int)
+		}
+		statuses[key][call.Status] += call.Count
+	}
+
+	routes := append([]*route(nil), c.verifier.routes...)
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].path != routes[j].path {
+			return routes[i].path < routes[j].path
+		}
+		return routes[i].method < routes[j].method
+	})
+	for _, r := range routes {
+		operation := OperationCoverage{Method: r.method, Path: r.path, ID: r.op.ID}
+		calls := statuses[r.method+" "+r.path]
+		declared := make(map[int]int)
+		var defaultCalls int
+		hasDefault := false
+		if r.op.Responses != nil {
+			for code := range r.op.Responses.StatusCodeResponses {
+				declared[code] = 0
+			}
+			hasDefault = r.op.Responses.Default != nil
+		}
+		for _, code := range sortedStatuses(declared) {
+			operation.Responses = append(operation.Responses, ResponseCoverage{Status: strconv.Itoa(code), Calls: calls[code]})
+		}
+		for _, code := range sortedStatuses(calls) {
+			operation.Calls += calls[code]
+			if _, ok := declared[code]; ok {
+				continue
+			}
+			if hasDefault {
+				defaultCalls += calls[code]
+			} else {
+				operation.Undocumented = append(operation.Undocumented, ResponseCoverage{Status: strconv.Itoa(code), Calls: calls[code]})
+			}
+		}
+		if hasDefault {
+			operation.Responses = append(operation.Responses, ResponseCoverage{Status: "default", Calls: defaultCalls})
+		}
+		report.Operations = append(report.Operations, operation)
+	}
+	return report
+}
+
+// TestedOperations counts the operations which were called
+func (r *CoverageReport) TestedOperations() (tested, total int) {
+	for _, operation := range r.Operations {
+		if operation.Calls > 0 {
+			tested++
+		}
+	}
+	return tested, len(r.Operations)
+}
+
+// TestedResponses counts the responses of the operations which were returned
+func (r *CoverageReport) TestedResponses() (tested, total int) {
+	for _, operation := range r.Operations {
+		for _, response := range operation.Responses {
+			if response.Calls > 0 {
+				tested++
+			}
+		}
+		total += len(operation.Responses)
+	}
+	return tested, total
+}
+
+func sortedStatuses(statuses map[int]int) []int {
+	codes := make([]int, 0, len(statuses))
+	for code := range statuses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// statusRecorder keeps the status of a response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush lets the streamed responses through
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
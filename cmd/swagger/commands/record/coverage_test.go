@@ -0,0 +1,117 @@
+package record
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCoverage(t *testing.T) *Coverage {
+	dir, err := ioutil.TempDir("", "coverage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pth := filepath.Join(dir, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(pth, []byte(verifiedSpec), 0644))
+	doc, err := loads.Spec(pth)
+	require.NoError(t, err)
+	coverage, err := NewCoverage(doc)
+	require.NoError(t, err)
+	return coverage
+}
+
+func TestCoverage_Middleware(t *testing.T) {
+	coverage := newCoverage(t)
+	handler := coverage.Middleware(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/pets/12":
+			rw.WriteHeader(http.StatusNoContent)
+		case "/api/pets/13":
+			rw.WriteHeader(http.StatusInternalServerError)
+		case "/api/pets":
+			rw.Write([]byte(`[]`))
+		default:
+			http.NotFound(rw, r)
+		}
+	}))
+	for _, req := range []*http.Request{
+		httptest.NewRequest("GET", "/api/pets?limit=1", nil),
+		httptest.NewRequest("GET", "/api/pets?limit=2", nil),
+		httptest.NewRequest("DELETE", "/api/pets/12", nil),
+		httptest.NewRequest("DELETE", "/api/pets/13", nil),
+		httptest.NewRequest("GET", "/api/owners", nil),
+		httptest.NewRequest("GET", "/pets", nil),
+	} {
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	assert.Equal(t, []CoveredCall{
+		{Method: "GET", Path: "/pets", Status: 200, Count: 2},
+		{Method: "DELETE", Path: "/pets/{id}", Status: 204, Count: 1},
+		{Method: "DELETE", Path: "/pets/{id}", Status: 500, Count: 1},
+		{Method: "GET", Path: "/api/owners", Status: 404, Unmatched: true, Count: 1},
+		// outside of the base path
+		{Method: "GET", Path: "/pets", Status: 404, Unmatched: true, Count: 1},
+	}, coverage.Profile().Calls)
+
+	report := coverage.Report()
+	require.Len(t, report.Operations, 3)
+	assert.Equal(t, OperationCoverage{
+		Method:    "GET",
+		Path:      "/pets",
+		Calls:     2,
+		Responses: []ResponseCoverage{{Status: "200", Calls: 2}},
+	}, report.Operations[0])
+	assert.Equal(t, 0, report.Operations[1].Calls)
+	assert.Equal(t, "GET /pets/mine", report.Operations[1].String())
+	assert.Equal(t, []ResponseCoverage{{Status: "500", Calls: 1}}, report.Operations[2].Undocumented)
+	assert.Len(t, report.Unmatched, 2)
+
+	tested, total := report.TestedOperations()
+	assert.Equal(t, 2, tested)
+	assert.Equal(t, 3, total)
+	tested, total = report.TestedResponses()
+	assert.Equal(t, 2, tested)
+	assert.Equal(t, 3, total)
+}
+
+func TestCoverage_Profile(t *testing.T) {
+	defer func(max int) { MaxUnmatched = max }(MaxUnmatched)
+	MaxUnmatched = 1
+
+	coverage := newCoverage(t)
+	coverage.Record("get", "/api/pets/mine", 200)
+	coverage.Record("GET", "/api/owners", 200)
+	coverage.Record("GET", "/api/owners", 200)
+	coverage.Record("GET", "/api/shops", 200)
+
+	dir, err := ioutil.TempDir("", "coverage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pth := filepath.Join(dir, "coverage.json")
+	require.NoError(t, coverage.WriteProfile(pth))
+	profile, err := ReadCoverageProfile(pth)
+	require.NoError(t, err)
+	assert.Equal(t, 1, profile.Dropped)
+	require.Len(t, profile.Calls, 2)
+	assert.Equal(t, CoveredCall{Method: "GET", Path: "/pets/mine", Status: 200, Count: 1}, profile.Calls[0])
+
+	// the profiles add up, and the operations the spec doesn't have anymore are unmatched
+	profile.Calls = append(profile.Calls, CoveredCall{Method: "GET", Path: "/shops", Status: 200, Count: 3})
+	merged := newCoverage(t)
+	MaxUnmatched = 10
+	merged.Add(profile)
+	merged.Add(profile)
+	assert.Equal(t, []CoveredCall{
+		{Method: "GET", Path: "/pets/mine", Status: 200, Count: 2},
+		{Method: "GET", Path: "/api/owners", Status: 200, Unmatched: true, Count: 4},
+		{Method: "GET", Path: "/shops", Status: 200, Unmatched: true, Count: 6},
+	}, merged.Profile().Calls)
+	assert.Equal(t, 2, merged.Profile().Dropped)
+}
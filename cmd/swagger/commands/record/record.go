@@ -0,0 +1,131 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package record records the requests handled by a service, and checks them against a spec
+package record
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+)
+
+// MaxBodySize is the size of the bodies recorded, the rest of a larger body is only forwarded
+var MaxBodySize = 1 << 20
+
+// Exchange is a request handled by the service, with its response
+type Exchange struct {
+	Method         string
+	URL            *url.URL
+	RequestHeader  http.Header
+	RequestBody    []byte
+	Status         int
+	ResponseHeader http.Header
+	ResponseBody   []byte
+	// Truncated is true when a body was larger than MaxBodySize
+	Truncated bool
+}
+
+// Middleware records the exchanges handled by next, record is called once the response is written
+func Middleware(next http.Handler, record func(*Exchange)) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		exchange := &Exchange{
+			Method:        r.Method,
+			URL:           requestURL(r),
+			RequestHeader: r.Header,
+		}
+		if r.Body != nil {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+			r.Body.Close()
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			exchange.RequestBody = body
+			if len(body) > MaxBodySize {
+				exchange.RequestBody, exchange.Truncated = body[:MaxBodySize], true
+			}
+		}
+
+		recorder := &responseRecorder{ResponseWriter: rw, exchange: exchange}
+		next.ServeHTTP(recorder, r)
+		if exchange.Status == 0 {
+			exchange.Status = http.StatusOK
+		}
+		exchange.ResponseHeader = rw.Header()
+		record(exchange)
+	})
+}
+
+// NewProxy creates a reverse proxy to target which records the exchanges, their url is the one of the target
+func NewProxy(target *url.URL, record func(*Exchange)) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	return Middleware(proxy, func(exchange *Exchange) {
+		exchange.URL.Scheme = target.Scheme
+		exchange.URL.Host = target.Host
+		record(exchange)
+	})
+}
+
+func requestURL(r *http.Request) *url.URL {
+	u := *r.URL
+	if u.Host == "" {
+		u.Host = r.Host
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+		if r.TLS != nil {
+			u.Scheme = "https"
+		}
+	}
+	return &u
+}
+
+// responseRecorder copies the status and the body of a response as it is written
+type responseRecorder struct {
+	http.ResponseWriter
+	exchange *Exchange
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.exchange.Status == 0 {
+		r.exchange.Status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.exchange.Status == 0 {
+		r.exchange.Status = http.StatusOK
+	}
+	if room := MaxBodySize - len(r.exchange.ResponseBody); room < len(b) {
+		if room > 0 {
+			r.exchange.ResponseBody = append(r.exchange.ResponseBody, b[:room]...)
+		}
+		r.exchange.Truncated = true
+	} else {
+		r.exchange.ResponseBody = append(r.exchange.ResponseBody, b...)
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush lets the streamed responses through
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,174 @@
+package record
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const verifiedSpec = `swagger: "2.0"
+info:
+  title: verified
+  version: "1.0"
+basePath: /api
+produces:
+  - application/json
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          type: integer
+          required: true
+      responses:
+        200:
+          description: the pets
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Pet"
+  /pets/mine:
+    get:
+      responses:
+        200:
+          description: my pets
+  /pets/{id}:
+    parameters:
+      - name: id
+        in: path
+        type: integer
+        required: true
+    delete:
+      responses:
+        204:
+          description: deleted
+definitions:
+  Pet:
+    type: object
+    required: [name]
+    properties:
+      name:
+        type: string
+`
+
+func newVerifier(t *testing.T) *Verifier {
+	dir, err := ioutil.TempDir("", "record")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pth := filepath.Join(dir, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(pth, []byte(verifiedSpec), 0644))
+	doc, err := loads.Spec(pth)
+	require.NoError(t, err)
+	verifier, err := NewVerifier(doc)
+	require.NoError(t, err)
+	return verifier
+}
+
+func exchange(method, rawurl string, status int, body string) *Exchange {
+	u, _ := url.Parse(rawurl)
+	return &Exchange{
+		Method:         method,
+		URL:            u,
+		RequestHeader:  http.Header{},
+		Status:         status,
+		ResponseHeader: http.Header{"Content-Type": []string{"application/json"}},
+		ResponseBody:   []byte(body),
+	}
+}
+
+func TestVerify(t *testing.T) {
+	verifier := newVerifier(t)
+
+	assert.Empty(t, verifier.Verify(exchange("GET", "http://localhost/api/pets?limit=2", 200, `[{"name": "rex"}]`)))
+	assert.Empty(t, verifier.Verify(exchange("GET", "http://localhost/api/pets/mine", 200, ``)))
+	assert.Empty(t, verifier.Verify(exchange("DELETE", "http://localhost/api/pets/12", 204, ``)))
+
+	assert.Equal(t, []string{
+		`the query parameter limit: "two" isn't of type integer`,
+		"the query parameter sort isn't in the spec",
+	}, verifier.Verify(exchange("GET", "http://localhost/api/pets?limit=two&sort=name", 200, `[]`)))
+	assert.Equal(t, []string{"the required query parameter limit is missing"},
+		verifier.Verify(exchange("GET", "http://localhost/api/pets", 200, `[]`)))
+	mismatches := verifier.Verify(exchange("GET", "http://localhost/api/pets?limit=1", 200, `[{"age": 3}]`))
+	require.Len(t, mismatches, 1)
+	assert.Contains(t, mismatches[0], "the 200 response doesn't match its schema")
+
+	assert.Equal(t, []string{`the path parameter id: "rex" isn't of type integer`},
+		verifier.Verify(exchange("DELETE", "http://localhost/api/pets/rex", 204, ``)))
+	assert.Equal(t, []string{"the 500 response isn't in the spec"},
+		verifier.Verify(exchange("DELETE", "http://localhost/api/pets/12", 500, ``)))
+	assert.Equal(t, []string{"the spec has no PUT operation for the path, only DELETE"},
+		verifier.Verify(exchange("PUT", "http://localhost/api/pets/12", 200, ``)))
+	assert.Equal(t, []string{"no path of the spec matches the request"},
+		verifier.Verify(exchange("GET", "http://localhost/api/owners", 200, ``)))
+	assert.Equal(t, []string{"the path isn't under the base path /api"},
+		verifier.Verify(exchange("GET", "http://localhost/pets", 200, ``)))
+
+	plain := exchange("GET", "http://localhost/api/pets/mine", 200, `mine`)
+	plain.ResponseHeader.Set("Content-Type", "text/plain")
+	assert.Equal(t, []string{"the operation doesn't produce text/plain"}, verifier.Verify(plain))
+}
+
+func TestNewProxy(t *testing.T) {
+	service := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(`{"received": "` + string(body) + `"}`))
+	}))
+	defer service.Close()
+	target, err := url.Parse(service.URL)
+	require.NoError(t, err)
+
+	var recorded []*Exchange
+	proxy := httptest.NewServer(NewProxy(target, func(exchange *Exchange) {
+		recorded = append(recorded, exchange)
+	}))
+	defer proxy.Close()
+
+	resp, err := http.Post(proxy.URL+"/pets?limit=1", "text/plain", strings.NewReader("rex"))
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	// the service gets the body the proxy recorded
+	assert.Equal(t, `{"received": "rex"}`, string(body))
+
+	require.Len(t, recorded, 1)
+	assert.Equal(t, "POST", recorded[0].Method)
+	assert.Equal(t, target.Host, recorded[0].URL.Host)
+	assert.Equal(t, "/pets", recorded[0].URL.Path)
+	assert.Equal(t, "rex", string(recorded[0].RequestBody))
+	assert.Equal(t, http.StatusCreated, recorded[0].Status)
+	assert.Equal(t, `{"received": "rex"}`, string(recorded[0].ResponseBody))
+	assert.Equal(t, "application/json", recorded[0].ResponseHeader.Get("Content-Type"))
+}
+
+func TestMiddleware_Truncated(t *testing.T) {
+	defer func(size int) { MaxBodySize = size }(MaxBodySize)
+	MaxBodySize = 4
+
+	var recorded *Exchange
+	handler := Middleware(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("abc"))
+		rw.Write([]byte("def"))
+	}), func(exchange *Exchange) { recorded = exchange })
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest("GET", "/", nil))
+
+	assert.Equal(t, "abcdef", rw.Body.String())
+	require.NotNil(t, recorded)
+	assert.Equal(t, http.StatusOK, recorded.Status)
+	assert.Equal(t, "abcd", string(recorded.ResponseBody))
+	assert.True(t, recorded.Truncated)
+}
@@ -0,0 +1,284 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+// the depth of the nested objects of a sample body
+const maxSampleDepth = 5
+
+// SafeMethods are the methods of the operations sampled by default, which shouldn't change the state of a server
+var SafeMethods = []string{"GET", "HEAD", "OPTIONS"}
+
+// Replay sends a request and records the exchange
+func Replay(client *http.Client, req *http.Request) (*Exchange, error) {
+	exchange := &Exchange{
+		Method:        req.Method,
+		URL:           req.URL,
+		RequestHeader: req.Header,
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		if exchange.RequestBody, err = ioutil.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	exchange.Status = resp.StatusCode
+	exchange.ResponseHeader = resp.Header
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, int64(MaxBodySize)+1))
+	if err != nil {
+		return nil, err
+	}
+	exchange.ResponseBody = body
+	if len(body) > MaxBodySize {
+		exchange.ResponseBody, exchange.Truncated = body[:MaxBodySize], true
+	}
+	return exchange, nil
+}
+
+// SampleRequests builds a request to the target for each operation of a spec with one of the methods,
+// with sample values for its required parameters and its body.
+//
+// The values are the x-example, the default or the first value of the enum of the parameters,
+// and a value of their type otherwise. The target is the root of the server, the base path of the spec is added to it.
+func SampleRequests(doc *loads.Document, target *url.URL, methods []string) ([]*http.Request, error) {
+	expanded, err := doc.Expanded()
+	if err != nil {
+		return nil, err
+	}
+	sw := expanded.Spec()
+	if sw.Paths == nil {
+		return nil, nil
+	}
+
+	paths := make([]string, 0, len(sw.Paths.Paths))
+	for pth := range sw.Paths.Paths {
+		paths = append(paths, pth)
+	}
+	sort.Strings(paths)
+
+	var requests []*http.Request
+	for _, pth := range paths {
+		item := sw.Paths.Paths[pth]
+		for _, method := range methods {
+			op := operationOf(item, method)
+			if op == nil {
+				continue
+			}
+			req, err := sampleRequest(sw, target, method, pth, op, mergeParameters(item.Parameters, op.Parameters))
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %v", method, pth, err)
+			}
+			requests = append(requests, req)
+		}
+	}
+	return requests, nil
+}
+
+func operationOf(item spec.PathItem, method string) *spec.Operation {
+	switch method {
+	case "GET":
+		return item.Get
+	case "PUT":
+		return item.Put
+	case "POST":
+		return item.Post
+	case "DELETE":
+		return item.Delete
+	case "OPTIONS":
+		return item.Options
+	case "HEAD":
+		return item.Head
+	case "PATCH":
+		return item.Patch
+	}
+	return nil
+}
+
+func sampleRequest(sw *spec.Swagger, target *url.URL, method, pth string, op *spec.Operation, params []spec.Parameter) (*http.Request, error) {
+	query := url.Values{}
+	form := url.Values{}
+	header := http.Header{}
+	var body []byte
+	for _, param := range params {
+		if param.In == "body" {
+			if param.Schema == nil || !param.Required {
+				continue
+			}
+			b, err := json.Marshal(sampleValue(param.Schema, 0))
+			if err != nil {
+				return nil, err
+			}
+			body = b
+			header.Set("Content-Type", "application/json")
+			continue
+		}
+		if !param.Required && param.In != "path" {
+			continue
+		}
+		value := sampleParameter(&param)
+		switch param.In {
+		case "path":
+			pth = strings.Replace(pth, "{"+param.Name+"}", url.PathEscape(value), -1)
+		case "query":
+			query.Set(param.Name, value)
+		case "header":
+			header.Set(param.Name, value)
+		case "formData":
+			form.Set(param.Name, value)
+		}
+	}
+	if len(form) > 0 {
+		body = []byte(form.Encode())
+		header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if produces := op.Produces; len(produces) > 0 {
+		header.Set("Accept", strings.Join(produces, ", "))
+	} else if len(sw.Produces) > 0 {
+		header.Set("Accept", strings.Join(sw.Produces, ", "))
+	}
+
+	u := *target
+	u.Path = path.Join("/", target.Path, sw.BasePath, pth)
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if body == nil {
+		req.Body, req.GetBody, req.ContentLength = nil, nil, 0
+	}
+	return req, nil
+}
+
+// sampleParameter is a value of a simple parameter
+func sampleParameter(param *spec.Parameter) string {
+	if example, ok := param.Extensions["x-example"]; ok {
+		return fmt.Sprintf("%v", example)
+	}
+	if param.Default != nil {
+		return fmt.Sprintf("%v", param.Default)
+	}
+	if len(param.Enum) > 0 {
+		return fmt.Sprintf("%v", param.Enum[0])
+	}
+	if param.Type == "array" && param.Items != nil {
+		return sampleSimple(param.Items.Type, param.Items.Format)
+	}
+	return sampleSimple(param.Type, param.Format)
+}
+
+func sampleSimple(tpe, format string) string {
+	switch tpe {
+	case "integer", "number":
+		return "1"
+	case "boolean":
+		return "true"
+	}
+	switch format {
+	case "date":
+		return "2017-01-01"
+	case "date-time":
+		return "2017-01-01T00:00:00Z"
+	case "uuid":
+		return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case "email":
+		return "user@example.com"
+	}
+	return "sample"
+}
+
+// sampleValue is a value of a schema, with its required properties
+func sampleValue(schema *spec.Schema, depth int) interface{} {
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if schema.Default != nil {
+		return schema.Default
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+	if len(schema.AllOf) > 0 {
+		merged := make(map[string]interface{})
+		for i := range schema.AllOf {
+			if object, ok := sampleValue(&schema.AllOf[i], depth).(map[string]interface{}); ok {
+				for key, value := range object {
+					merged[key] = value
+				}
+			}
+		}
+		return merged
+	}
+
+	tpe := ""
+	if len(schema.Type) > 0 {
+		tpe = schema.Type[0]
+	}
+	switch {
+	case tpe == "array":
+		if schema.Items == nil || schema.Items.Schema == nil || depth >= maxSampleDepth {
+			return []interface{}{}
+		}
+		return []interface{}{sampleValue(schema.Items.Schema, depth+1)}
+	case tpe == "object" || tpe == "" && len(schema.Properties) > 0:
+		object := make(map[string]interface{})
+		if depth >= maxSampleDepth {
+			return object
+		}
+		for _, name := range schema.Required {
+			if property, ok := schema.Properties[name]; ok {
+				object[name] = sampleValue(&property, depth+1)
+			}
+		}
+		return object
+	case tpe == "integer", tpe == "number":
+		if schema.Minimum != nil {
+			return *schema.Minimum
+		}
+		return 1
+	case tpe == "boolean":
+		return true
+	case tpe == "string":
+		return sampleSimple(tpe, schema.Format)
+	}
+	return nil
+}
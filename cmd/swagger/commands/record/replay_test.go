@@ -0,0 +1,85 @@
+package record
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampleRequests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pth := filepath.Join(dir, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(pth, []byte(verifiedSpec), 0644))
+	doc, err := loads.Spec(pth)
+	require.NoError(t, err)
+	target, err := url.Parse("http://localhost:8080/v1")
+	require.NoError(t, err)
+
+	requests, err := SampleRequests(doc, target, SafeMethods)
+	require.NoError(t, err)
+	var urls []string
+	for _, req := range requests {
+		urls = append(urls, req.Method+" "+req.URL.String())
+		assert.Equal(t, "application/json", req.Header.Get("Accept"))
+	}
+	assert.Equal(t, []string{
+		"GET http://localhost:8080/v1/api/pets?limit=1",
+		"GET http://localhost:8080/v1/api/pets/mine",
+	}, urls)
+
+	requests, err = SampleRequests(doc, target, []string{"DELETE"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "http://localhost:8080/v1/api/pets/1", requests[0].URL.String())
+	assert.Nil(t, requests[0].Body)
+}
+
+func TestSampleValue(t *testing.T) {
+	schema := new(spec.Schema).
+		Typed("object", "").
+		SetProperty("name", *spec.StringProperty()).
+		SetProperty("tags", *spec.ArrayProperty(spec.StringProperty())).
+		SetProperty("status", *spec.StringProperty().WithEnum("available", "sold")).
+		SetProperty("born", *spec.DateTimeProperty())
+	schema.Required = []string{"name", "tags", "status", "born"}
+
+	assert.Equal(t, map[string]interface{}{
+		"name":   "sample",
+		"tags":   []interface{}{"sample"},
+		"status": "available",
+		"born":   "2017-01-01T00:00:00Z",
+	}, sampleValue(schema, 0))
+}
+
+func TestReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write(body)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest("POST", server.URL+"/api/pets", strings.NewReader(`{"name": "rex"}`))
+	require.NoError(t, err)
+	exchange, err := Replay(http.DefaultClient, req)
+	require.NoError(t, err)
+	assert.Equal(t, "POST", exchange.Method)
+	assert.Equal(t, `{"name": "rex"}`, string(exchange.RequestBody))
+	assert.Equal(t, http.StatusCreated, exchange.Status)
+	assert.Equal(t, `{"name": "rex"}`, string(exchange.ResponseBody))
+	assert.Equal(t, "application/json", exchange.ResponseHeader.Get("Content-Type"))
+	assert.False(t, exchange.Truncated)
+}
@@ -0,0 +1,376 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+var pathParam = regexp.MustCompile(`{[^}]+}`)
+
+// Verifier checks the exchanges against the operations of a spec
+type Verifier struct {
+	basePath string
+	consumes []string
+	produces []string
+	routes   []*route
+}
+
+// route is an operation of the spec, with the expression matching its path
+type route struct {
+	method   string
+	path     string
+	matcher  *regexp.Regexp
+	names    []string
+	literals int
+	op       *spec.Operation
+	params   []spec.Parameter
+}
+
+// NewVerifier creates a verifier for the operations of a spec
+func NewVerifier(doc *loads.Document) (*Verifier, error) {
+	expanded, err := doc.Expanded()
+	if err != nil {
+		return nil, err
+	}
+	sw := expanded.Spec()
+	v := &Verifier{
+		basePath: strings.TrimSuffix(sw.BasePath, "/"),
+		consumes: sw.Consumes,
+		produces: sw.Produces,
+	}
+	if sw.Paths == nil {
+		return v, nil
+	}
+	for pth, item := range sw.Paths.Paths {
+		literals := pathParam.Split(pth, -1)
+		for i, literal := range literals {
+			literals[i] = regexp.QuoteMeta(literal)
+		}
+		matcher, err := regexp.Compile("^" + strings.Join(literals, "([^/]+)") + "/?$")
+		if err != nil {
+			return nil, fmt.Errorf("path %s: %v", pth, err)
+		}
+		var names []string
+		for _, param := range pathParam.FindAllString(pth, -1) {
+			names = append(names, strings.Trim(param, "{}"))
+		}
+		operations := map[string]*spec.Operation{
+			"GET": item.Get, "PUT": item.Put, "POST": item.Post, "DELETE": item.Delete,
+			"OPTIONS": item.Options, "HEAD": item.Head, "PATCH": item.Patch,
+		}
+		for method, op := range operations {
+			if op == nil {
+				continue
+			}
+			v.routes = append(v.routes, &route{
+				method:   method,
+				path:     pth,
+				matcher:  matcher,
+				names:    names,
+				literals: len(pathParam.ReplaceAllString(pth, "")),
+				op:       op,
+				params:   mergeParameters(item.Parameters, op.Parameters),
+			})
+		}
+	}
+	// the most specific paths are tried first: /pets/mine before /pets/{id}
+	sort.Slice(v.routes, func(i, j int) bool {
+		if v.routes[i].literals != v.routes[j].literals {
+			return v.routes[i].literals > v.routes[j].literals
+		}
+		return v.routes[i].path < v.routes[j].path
+	})
+	return v, nil
+}
+
+// mergeParameters adds the parameters of an operation to the ones of its path, which it overrides
+func mergeParameters(pathParams, opParams []spec.Parameter) []spec.Parameter {
+	var result []spec.Parameter
+	for _, param := range pathParams {
+		overridden := false
+		for _, other := range opParams {
+			if other.Name == param.Name && other.In == param.In {
+				overridden = true
+			}
+		}
+		if !overridden {
+			result = append(result, param)
+		}
+	}
+	return append(result, opParams...)
+}
+
+// match finds the operation of a request, with the values of its path parameters,
+// or the methods of the operations of its path when it has none for the method
+func (v *Verifier) match(method, pth string) (*route, map[string]string, []string) {
+	var methods []string
+	for _, r := range v.routes {
+		match := r.matcher.FindStringSubmatch(pth)
+		if match == nil {
+			continue
+		}
+		if r.method != method {
+			methods = append(methods, r.method)
+			continue
+		}
+		values := make(map[string]string, len(r.names))
+		for i, name := range r.names {
+			values[name] = match[i+1]
+		}
+		return r, values, nil
+	}
+	return nil, nil, methods
+}
+
+// Operation tells the operation of the spec an exchange is a call to, as METHOD /path, or "" when it matches none
+func (v *Verifier) Operation(exchange *Exchange) string {
+	pth := strings.TrimPrefix(exchange.URL.Path, v.basePath)
+	if matched, _, _ := v.match(exchange.Method, pth); matched != nil {
+		return matched.method + " " + matched.path
+	}
+	return ""
+}
+
+// lookup finds the operation of a request to a path under the base path
+func (v *Verifier) lookup(method, pth string) *route {
+	if v.basePath != "" {
+		if pth != v.basePath && !strings.HasPrefix(pth, v.basePath+"/") {
+			return nil
+		}
+		pth = strings.TrimPrefix(pth, v.basePath)
+	}
+	matched, _, _ := v.match(method, pth)
+	return matched
+}
+
+// route finds the operation of a method and a path of the spec
+func (v *Verifier) route(method, pth string) *route {
+	for _, r := range v.routes {
+		if r.method == method && r.path == pth {
+			return r
+		}
+	}
+	return nil
+}
+
+// Verify tells how an exchange doesn't match the spec, it matches when the result is empty
+func (v *Verifier) Verify(exchange *Exchange) []string {
+	pth := exchange.URL.Path
+	if v.basePath != "" {
+		if pth != v.basePath && !strings.HasPrefix(pth, v.basePath+"/") {
+			return []string{fmt.Sprintf("the path isn't under the base path %s", v.basePath)}
+		}
+		pth = strings.TrimPrefix(pth, v.basePath)
+	}
+
+	matched, pathValues, methods := v.match(exchange.Method, pth)
+	if matched == nil {
+		if len(methods) == 0 {
+			return []string{"no path of the spec matches the request"}
+		}
+		sort.Strings(methods)
+		return []string{fmt.Sprintf("the spec has no %s operation for the path, only %s", exchange.Method, strings.Join(methods, ", "))}
+	}
+
+	var mismatches []string
+	mismatch := func(format string, args ...interface{}) {
+		mismatches = append(mismatches, fmt.Sprintf(format, args...))
+	}
+
+	query := exchange.URL.Query()
+	form := url.Values{}
+	requestType := mediaType(exchange.RequestHeader.Get("Content-Type"))
+	if requestType == "application/x-www-form-urlencoded" {
+		form, _ = url.ParseQuery(string(exchange.RequestBody))
+	}
+	declared := make(map[string]bool)
+	for _, param := range matched.params {
+		var values []string
+		present := false
+		switch param.In {
+		case "path":
+			var value string
+			value, present = pathValues[param.Name]
+			values = []string{value}
+		case "query":
+			declared[param.Name] = true
+			values, present = query[param.Name]
+		case "header":
+			values, present = exchange.RequestHeader[http.CanonicalHeaderKey(param.Name)]
+		case "formData":
+			values, present = form[param.Name]
+		case "body":
+			if param.Required && len(exchange.RequestBody) == 0 {
+				mismatch("the body is required")
+			}
+			if param.Schema != nil && len(exchange.RequestBody) > 0 && isJSON(requestType) && !exchange.Truncated {
+				for _, err := range validateBody(param.Schema, exchange.RequestBody) {
+					mismatch("the body doesn't match its schema: %v", err)
+				}
+			}
+			continue
+		default:
+			continue
+		}
+		if !present {
+			if param.Required {
+				mismatch("the required %s parameter %s is missing", param.In, param.Name)
+			}
+			continue
+		}
+		for _, value := range values {
+			if err := checkValue(param.Type, param.Enum, value); err != nil {
+				mismatch("the %s parameter %s: %v", param.In, param.Name, err)
+			}
+		}
+	}
+	var undeclared []string
+	for name := range query {
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+	for _, name := range undeclared {
+		mismatch("the query parameter %s isn't in the spec", name)
+	}
+
+	consumes := matched.op.Consumes
+	if len(consumes) == 0 {
+		consumes = v.consumes
+	}
+	if requestType != "" && len(exchange.RequestBody) > 0 && len(consumes) > 0 && !containsMediaType(consumes, requestType) {
+		mismatch("the operation doesn't consume %s", requestType)
+	}
+
+	if matched.op.Responses == nil {
+		mismatch("the %d response isn't in the spec", exchange.Status)
+		return mismatches
+	}
+	response, ok := matched.op.Responses.StatusCodeResponses[exchange.Status]
+	if !ok {
+		if matched.op.Responses.Default == nil {
+			mismatch("the %d response isn't in the spec", exchange.Status)
+			return mismatches
+		}
+		response = *matched.op.Responses.Default
+	}
+	for _, name := range sortedHeaders(response.Headers) {
+		header := response.Headers[name]
+		for _, value := range exchange.ResponseHeader[http.CanonicalHeaderKey(name)] {
+			if err := checkValue(header.Type, header.Enum, value); err != nil {
+				mismatch("the %s header of the %d response: %v", name, exchange.Status, err)
+			}
+		}
+	}
+	responseType := mediaType(exchange.ResponseHeader.Get("Content-Type"))
+	produces := matched.op.Produces
+	if len(produces) == 0 {
+		produces = v.produces
+	}
+	if responseType != "" && len(exchange.ResponseBody) > 0 && len(produces) > 0 && !containsMediaType(produces, responseType) {
+		mismatch("the operation doesn't produce %s", responseType)
+	}
+	if response.Schema != nil && len(exchange.ResponseBody) > 0 && isJSON(responseType) && !exchange.Truncated {
+		for _, err := range validateBody(response.Schema, exchange.ResponseBody) {
+			mismatch("the %d response doesn't match its schema: %v", exchange.Status, err)
+		}
+	}
+	return mismatches
+}
+
+func sortedHeaders(headers map[string]spec.Header) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkValue checks the value of a simple parameter or header against its type and enum
+func checkValue(tpe string, enum []interface{}, value string) error {
+	var err error
+	switch tpe {
+	case "integer":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "number":
+		_, err = strconv.ParseFloat(value, 64)
+	case "boolean":
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%q isn't of type %s", value, tpe)
+	}
+	if len(enum) > 0 {
+		for _, allowed := range enum {
+			if fmt.Sprintf("%v", allowed) == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q isn't one of the allowed values", value)
+	}
+	return nil
+}
+
+func validateBody(schema *spec.Schema, body []byte) []error {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return []error{err}
+	}
+	err := validate.AgainstSchema(schema, data, strfmt.Default)
+	if err == nil {
+		return nil
+	}
+	if composite, ok := err.(*errors.CompositeError); ok {
+		return composite.Errors
+	}
+	return []error{err}
+}
+
+func mediaType(contentType string) string {
+	if parsed, _, err := mime.ParseMediaType(contentType); err == nil {
+		return parsed
+	}
+	return ""
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func containsMediaType(declared []string, actual string) bool {
+	for _, candidate := range declared {
+		if mediaType(candidate) == actual || candidate == "*/*" {
+			return true
+		}
+	}
+	return false
+}
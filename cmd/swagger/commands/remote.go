@@ -0,0 +1,328 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/importcmd"
+)
+
+// RemoteOptions are the options of all the commands for the specs, and the documents they refer to,
+// which are loaded from http urls: like the specs of a registry, which a CI pipeline validates and generates from
+type RemoteOptions struct {
+	BearerToken string           `long:"bearer-token" env:"SWAGGER_BEARER_TOKEN" description:"the token sent as a bearer Authorization header with the requests for the remote documents"`
+	BasicAuth   string           `long:"basic-auth" env:"SWAGGER_BASIC_AUTH" description:"the user:password sent as a basic Authorization header with the requests for the remote documents"`
+	AuthHosts   []string         `long:"auth-host" description:"the hosts the credentials are sent to, the host of the first remote document loaded by default"`
+	CACerts     []flags.Filename `long:"ca-cert" description:"a PEM bundle of the certificate authorities trusted for the remote documents, besides the ones of the system"`
+	CacheDir    flags.Filename   `long:"remote-cache-dir" env:"SWAGGER_REMOTE_CACHE" description:"the directory where the remote documents are cached: they are revalidated on each load, and used as they are when their server is unreachable"`
+}
+
+// Install makes the commands load the remote documents with a client applying the options.
+// The other http clients of the commands, like the ones of verify and record, keep the default transport.
+func (o *RemoteOptions) Install() error {
+	read, err := o.Reader(importcmd.ReadDocument)
+	if err != nil {
+		return err
+	}
+	// the last loader added loads all the documents, the ones of the specs and the ones they refer to
+	loads.AddLoader(func(string) bool { return true }, importcmd.DocumentLoader(read))
+	return nil
+}
+
+// Reader wraps a document reader: the remote documents are fetched with a client applying the options,
+// the local ones are read by next
+func (o *RemoteOptions) Reader(next func(string) (json.RawMessage, error)) (func(string) (json.RawMessage, error), error) {
+	transport, err := o.Transport(http.DefaultTransport)
+	if err != nil {
+		return nil, err
+	}
+	if transport == http.DefaultTransport {
+		return next, nil
+	}
+	client := &http.Client{Transport: transport, Timeout: swag.LoadHTTPTimeout}
+	return func(pth string) (json.RawMessage, error) {
+		if !isRemote(pth) {
+			return next(pth)
+		}
+		return fetchDocument(client, pth)
+	}, nil
+}
+
+// fetchDocument fetches a json or a yaml document, as json
+func fetchDocument(client *http.Client, url string) (json.RawMessage, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not access document at %q [%s]", url, resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// a registry may well serve its documents without an extension
+	if json.Valid(b) {
+		return json.RawMessage(b), nil
+	}
+	doc, err := swag.BytesToYAMLDoc(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither a json nor a yaml document: %v", url, err)
+	}
+	return swag.YAMLToJSON(doc)
+}
+
+// Transport wraps a transport with the credentials, the certificate authorities and the cache of the options
+func (o *RemoteOptions) Transport(next http.RoundTripper) (http.RoundTripper, error) {
+	if o.BearerToken != "" && o.BasicAuth != "" {
+		return nil, errors.New("--bearer-token and --basic-auth can't be used together")
+	}
+	if o.BasicAuth != "" && !strings.Contains(o.BasicAuth, ":") {
+		return nil, errors.New("--basic-auth expects user:password")
+	}
+	if len(o.CACerts) > 0 {
+		var err error
+		if next, err = withCACerts(next, o.CACerts); err != nil {
+			return nil, err
+		}
+	}
+	if o.BearerToken == "" && o.BasicAuth == "" && o.CacheDir == "" {
+		return next, nil
+	}
+
+	transport := &remoteTransport{
+		next:     next,
+		token:    o.BearerToken,
+		cacheDir: string(o.CacheDir),
+		hosts:    make(map[string]bool, len(o.AuthHosts)),
+	}
+	if o.BasicAuth != "" {
+		transport.user, transport.password = splitCredentials(o.BasicAuth)
+	}
+	for _, host := range o.AuthHosts {
+		transport.hosts[strings.ToLower(host)] = true
+	}
+	return transport, nil
+}
+
+func splitCredentials(userPassword string) (string, string) {
+	parts := strings.SplitN(userPassword, ":", 2)
+	return parts[0], parts[1]
+}
+
+// withCACerts trusts the certificate authorities of PEM bundles, besides the ones of the system
+func withCACerts(next http.RoundTripper, bundles []flags.Filename) (http.RoundTripper, error) {
+	base, ok := next.(*http.Transport)
+	if !ok {
+		return nil, errors.New("--ca-cert can't be applied to a custom transport")
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	for _, bundle := range bundles {
+		b, err := ioutil.ReadFile(string(bundle))
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("%s has no PEM certificate", bundle)
+		}
+	}
+	transport := base.Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.RootCAs = pool
+	return transport, nil
+}
+
+// remoteTransport authenticates the requests for the remote documents, and caches their responses
+type remoteTransport struct {
+	next           http.RoundTripper
+	token          string
+	user, password string
+	cacheDir       string
+
+	// hosts are the hosts the credentials are sent to, the one of the first request when none is given
+	mu    sync.Mutex
+	hosts map[string]bool
+}
+
+func (t *remoteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.authenticates(req) {
+		if t.token != "" {
+			req.Header.Set("Authorization", "Bearer "+t.token)
+		} else {
+			req.SetBasicAuth(t.user, t.password)
+		}
+	}
+	if t.cacheDir == "" || req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+
+	cached, ok := t.cached(req)
+	if ok {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+	resp, err := t.next.RoundTrip(req)
+	switch {
+	case err != nil && ok:
+		log.Printf("%s is unreachable, its cached copy is used: %v", req.URL.Redacted(), err)
+		return cached.response(req), nil
+	case err != nil:
+		return nil, err
+	case resp.StatusCode == http.StatusNotModified && ok:
+		_ = resp.Body.Close()
+		return cached.response(req), nil
+	case resp.StatusCode != http.StatusOK:
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	entry := &cachedDocument{
+		URL:          req.URL.Redacted(),
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Body:         body,
+	}
+	if err := t.cache(req, entry); err != nil {
+		log.Printf("caching %s failed: %v", req.URL.Redacted(), err)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// authenticates tells whether the credentials are sent with a request: only over https, and only to the auth hosts
+func (t *remoteTransport) authenticates(req *http.Request) bool {
+	if t.token == "" && t.user == "" {
+		return false
+	}
+	host := strings.ToLower(req.URL.Host)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.hosts) == 0 {
+		t.hosts[host] = true
+	}
+	if !t.hosts[host] && !t.hosts[strings.ToLower(req.URL.Hostname())] {
+		return false
+	}
+	if req.URL.Scheme != "https" {
+		log.Printf("the credentials aren't sent to %s over plain http", req.URL.Redacted())
+		return false
+	}
+	return true
+}
+
+// cachedDocument is a remote document kept in the cache, with what revalidates it
+type cachedDocument struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Body         []byte `json:"body"`
+}
+
+func (d *cachedDocument) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(bytes.NewReader(d.Body)),
+		ContentLength: int64(len(d.Body)),
+		Request:       req,
+	}
+}
+
+// cacheFile is the file of a document in the cache, by the hash of its url and of the credentials sent for it:
+// the copy fetched with some credentials is never served to a request with other credentials, or without any
+func (t *remoteTransport) cacheFile(req *http.Request) string {
+	key := req.URL.String()
+	if authorization := req.Header.Get("Authorization"); authorization != "" {
+		key += "\n" + authorization
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(t.cacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (t *remoteTransport) cached(req *http.Request) (*cachedDocument, bool) {
+	b, err := ioutil.ReadFile(t.cacheFile(req))
+	if err != nil {
+		return nil, false
+	}
+	var document cachedDocument
+	if err := json.Unmarshal(b, &document); err != nil {
+		return nil, false
+	}
+	return &document, true
+}
+
+func (t *remoteTransport) cache(req *http.Request, document *cachedDocument) error {
+	// the documents may be private, like the ones fetched with credentials
+	if err := os.MkdirAll(t.cacheDir, 0700); err != nil {
+		return err
+	}
+	b, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	// several commands may run at once, a document is never read half written
+	file := t.cacheFile(req)
+	tmp, err := ioutil.TempFile(t.cacheDir, filepath.Base(file))
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
@@ -0,0 +1,230 @@
+package commands
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/importcmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const remoteSpec = `{"swagger": "2.0", "info": {"title": "remote", "version": "1.0"}, "paths": {}}`
+
+func get(t *testing.T, transport http.RoundTripper, url string) (int, string) {
+	resp, err := (&http.Client{Transport: transport}).Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(b)
+}
+
+func TestRemoteOptions(t *testing.T) {
+	var authorizations []string
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(remoteSpec))
+	}))
+	defer registry.Close()
+
+	dir, err := ioutil.TempDir("", "remote")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bundle := filepath.Join(dir, "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: registry.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(bundle, cert, 0644))
+
+	// the certificate of the registry isn't trusted without its bundle
+	_, err = (&http.Client{Transport: http.DefaultTransport}).Get(registry.URL + "/swagger.json")
+	assert.Error(t, err)
+
+	opts := &RemoteOptions{BearerToken: "secret", CACerts: []flags.Filename{flags.Filename(bundle)}}
+	transport, err := opts.Transport(http.DefaultTransport)
+	require.NoError(t, err)
+	status, body := get(t, transport, registry.URL+"/swagger.json")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, remoteSpec, body)
+
+	// the credentials only go to the host of the first document, or to the auth hosts
+	other := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer other.Close()
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: other.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "other.pem"), cert, 0644))
+	opts.CACerts = append(opts.CACerts, flags.Filename(filepath.Join(dir, "other.pem")))
+	transport, err = opts.Transport(http.DefaultTransport)
+	require.NoError(t, err)
+	get(t, transport, registry.URL+"/swagger.json")
+	get(t, transport, other.URL+"/definitions.json")
+	assert.Equal(t, []string{"Bearer secret", "Bearer secret", ""}, authorizations)
+
+	_, err = (&RemoteOptions{BearerToken: "secret", BasicAuth: "user:password"}).Transport(http.DefaultTransport)
+	assert.Error(t, err)
+	_, err = (&RemoteOptions{BasicAuth: "user"}).Transport(http.DefaultTransport)
+	assert.Error(t, err)
+	_, err = (&RemoteOptions{CACerts: []flags.Filename{flags.Filename(filepath.Join(dir, "missing.pem"))}}).Transport(http.DefaultTransport)
+	assert.Error(t, err)
+}
+
+func TestRemoteOptions_BasicAuth(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			w.Write([]byte(remoteSpec))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer registry.Close()
+
+	// never over plain http
+	transport, err := (&RemoteOptions{BasicAuth: "user:password"}).Transport(http.DefaultTransport)
+	require.NoError(t, err)
+	status, _ := get(t, transport, registry.URL+"/swagger.json")
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestRemoteOptions_Cache(t *testing.T) {
+	var requests, revalidations int
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(remoteSpec))
+	}))
+
+	dir, err := ioutil.TempDir("", "remote-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	transport, err := (&RemoteOptions{CacheDir: flags.Filename(dir)}).Transport(http.DefaultTransport)
+	require.NoError(t, err)
+
+	url := registry.URL + "/swagger.json"
+	for i := 0; i < 2; i++ {
+		status, body := get(t, transport, url)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, remoteSpec, body)
+	}
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, revalidations)
+
+	// the cached copy is used when the registry is unreachable
+	registry.Close()
+	status, body := get(t, transport, url)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, remoteSpec, body)
+
+	_, err = (&http.Client{Transport: transport}).Get(registry.URL + "/other.json")
+	assert.Error(t, err)
+}
+
+func TestRemoteOptions_CacheCredentials(t *testing.T) {
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(remoteSpec))
+	}))
+
+	dir, err := ioutil.TempDir("", "remote-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bundle := filepath.Join(dir, "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: registry.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(bundle, cert, 0644))
+	cache := filepath.Join(dir, "cache")
+	transport := func(token string) http.RoundTripper {
+		opts := &RemoteOptions{BearerToken: token, CACerts: []flags.Filename{flags.Filename(bundle)}, CacheDir: flags.Filename(cache)}
+		transport, err := opts.Transport(http.DefaultTransport)
+		require.NoError(t, err)
+		return transport
+	}
+
+	url := registry.URL + "/swagger.json"
+	status, _ := get(t, transport("secret"), url)
+	assert.Equal(t, http.StatusOK, status)
+	info, err := os.Stat(cache)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+
+	// the copy fetched with the token is only served with the same token
+	registry.Close()
+	status, body := get(t, transport("secret"), url)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, remoteSpec, body)
+	for _, token := range []string{"", "other"} {
+		_, err = (&http.Client{Transport: transport(token)}).Get(url)
+		assert.Error(t, err, "token %q", token)
+	}
+}
+
+func TestRemoteOptions_Install(t *testing.T) {
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(verifiedSpec))
+	}))
+	defer registry.Close()
+	var authorizations []string
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/pets":
+			w.Write([]byte(`["rex"]`))
+		case "/api/pets/1":
+			w.Write([]byte(`"rex"`))
+		}
+	}))
+
+	dir, err := ioutil.TempDir("", "remote-install")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bundle := filepath.Join(dir, "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: registry.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(bundle, cert, 0644))
+
+	transport := http.DefaultTransport
+	opts := &RemoteOptions{
+		BearerToken: "secret",
+		CACerts:     []flags.Filename{flags.Filename(bundle)},
+		CacheDir:    flags.Filename(filepath.Join(dir, "cache")),
+	}
+	require.NoError(t, opts.Install())
+	defer loads.AddLoader(func(string) bool { return true }, importcmd.LoadDocument)
+	assert.True(t, transport == http.DefaultTransport)
+
+	// the spec is loaded from the registry, the yaml of which is converted
+	specDoc, err := loads.Spec(registry.URL + "/pets")
+	require.NoError(t, err)
+	assert.Equal(t, "verified", specDoc.Spec().Info.Title)
+
+	cmd := &VerifyCmd{Spec: flags.Filename(registry.URL + "/pets"), Target: target.URL}
+	require.NoError(t, cmd.Execute(nil))
+	assert.Equal(t, []string{"", ""}, authorizations)
+
+	// the requests of verify are never served from the cache of the remote documents
+	target.Close()
+	err = cmd.Execute(nil)
+	require.Error(t, err)
+	assert.IsType(t, &ContractError{}, err)
+}
@@ -1,20 +1,28 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"net/url"
 	"path"
+	"path/filepath"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
+	swaggererrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 	"github.com/gorilla/handlers"
 	"github.com/toqueteos/webbrowser"
 	"github.com/tylerb/graceful"
@@ -29,6 +37,7 @@ type ServeCmd struct {
 	NoUI     bool   `long:"no-ui" description:"when present, only the swagger spec will be served"`
 	Port     int    `long:"port" short:"p" description:"the port to serve this site" env:"PORT"`
 	Host     string `long:"host" description:"the interface to serve this site, defaults to 0.0.0.0" env:"HOST"`
+	NoWatch  bool   `long:"no-watch" description:"when present, changes to the spec file won't be reloaded"`
 }
 
 // Execute the serve command
@@ -37,12 +46,8 @@ func (s *ServeCmd) Execute(args []string) error {
 		return errors.New("specify the spec to serve as argument to the serve command")
 	}
 
-	specDoc, err := loads.Spec(args[0])
-	if err != nil {
-		return err
-	}
-	b, err := json.MarshalIndent(specDoc.Spec(), "", "  ")
-	if err != nil {
+	spec := &servedSpec{}
+	if err := spec.load(args[0]); err != nil {
 		return err
 	}
 
@@ -63,6 +68,16 @@ func (s *ServeCmd) Execute(args []string) error {
 		sh = "localhost"
 	}
 
+	var events *reloadEvents
+	if !s.NoWatch && !isRemote(args[0]) {
+		events = newReloadEvents()
+		watcher, err := watchSpec(args[0], spec, events)
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+	}
+
 	visit := s.DocURL
 	handler := http.NotFoundHandler()
 	if !s.NoUI {
@@ -72,6 +87,9 @@ func (s *ServeCmd) Execute(args []string) error {
 				SpecURL:  path.Join(basePath, "swagger.json"),
 				Path:     "docs",
 			}, handler)
+			if events != nil {
+				handler = events.inject(path.Join(basePath, "docs"), path.Join(basePath, "reload"), handler)
+			}
 			visit = fmt.Sprintf("http://%s:%d%s", sh, sp, path.Join(basePath, "docs"))
 		} else if visit != "" || s.Flavor == "swagger" {
 			if visit == "" {
@@ -88,7 +106,7 @@ func (s *ServeCmd) Execute(args []string) error {
 		}
 	}
 
-	handler = handlers.CORS()(middleware.Spec(basePath, b, handler))
+	handler = handlers.CORS()(spec.handler(path.Join(basePath, "swagger.json"), handler))
 	errFuture := make(chan error)
 	go func() {
 		docServer := &graceful.Server{Server: new(http.Server)}
@@ -108,3 +126,214 @@ func (s *ServeCmd) Execute(args []string) error {
 	log.Println("serving docs at", visit)
 	return <-errFuture
 }
+
+// servedSpec holds the json document served for a spec, which is swapped when the spec file changes
+type servedSpec struct {
+	lock sync.RWMutex
+	doc  []byte
+}
+
+// load reads and validates the spec. Validation errors are reported but don't prevent the spec from being served,
+// so that the documentation remains available while the spec is being edited.
+func (s *servedSpec) load(location string) error {
+	specDoc, err := loads.Spec(location)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(specDoc.Spec(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := validateServedSpec(specDoc); err != nil {
+		log.Printf("the swagger spec at %q is invalid against swagger specification %s:\n%v", location, specDoc.Version(), err)
+	} else {
+		log.Printf("the swagger spec at %q is valid against swagger specification %s", location, specDoc.Version())
+	}
+
+	s.lock.Lock()
+	s.doc = b
+	s.lock.Unlock()
+	return nil
+}
+
+func validateServedSpec(specDoc *loads.Document) (err error) {
+	// a spec in the middle of an edit must not bring the server down
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("validation failed: %v", r)
+		}
+	}()
+
+	result := validate.Spec(specDoc, strfmt.Default)
+	if result == nil {
+		return nil
+	}
+	composite, ok := result.(*swaggererrors.CompositeError)
+	if !ok {
+		return result
+	}
+	var str string
+	for _, desc := range composite.Errors {
+		str += fmt.Sprintf("- %s\n", desc)
+	}
+	return errors.New(str)
+}
+
+func (s *servedSpec) handler(pth string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != pth {
+			next.ServeHTTP(rw, r)
+			return
+		}
+		s.lock.RLock()
+		b := s.doc
+		s.lock.RUnlock()
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write(b)
+	})
+}
+
+// watchSpec reloads the spec whenever its file changes, until the watcher it returns is closed.
+//
+// The directory of the spec is watched rather than the file itself, because a lot of editors
+// save a file by replacing it.
+func watchSpec(location string, spec *servedSpec, events *reloadEvents) (io.Closer, error) {
+	abs, err := filepath.Abs(location)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(filepath.Dir(abs)); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	go func() {
+		// editors usually emit several events for a single save
+		var debounce <-chan time.Time
+		for {
+			select {
+			case evt, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(evt.Name) == abs && evt.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+					debounce = time.After(100 * time.Millisecond)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Println("watching the spec failed:", err)
+			case <-debounce:
+				debounce = nil
+				if err := spec.load(location); err != nil {
+					log.Printf("reloading the swagger spec at %q failed: %v", location, err)
+					continue
+				}
+				events.notify()
+			}
+		}
+	}()
+	return watcher, nil
+}
+
+// reloadEvents tells the browsers showing the docs ui that the spec changed, with server-sent events
+type reloadEvents struct {
+	lock    sync.Mutex
+	clients map[chan struct{}]struct{}
+}
+
+func newReloadEvents() *reloadEvents {
+	return &reloadEvents{clients: make(map[chan struct{}]struct{})}
+}
+
+func (e *reloadEvents) notify() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for client := range e.clients {
+		select {
+		case client <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (e *reloadEvents) subscribe() chan struct{} {
+	client := make(chan struct{}, 1)
+	e.lock.Lock()
+	e.clients[client] = struct{}{}
+	e.lock.Unlock()
+	return client
+}
+
+func (e *reloadEvents) unsubscribe(client chan struct{}) {
+	e.lock.Lock()
+	delete(e.clients, client)
+	e.lock.Unlock()
+}
+
+func (e *reloadEvents) serveEvents(rw http.ResponseWriter, r *http.Request) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	client := e.subscribe()
+	defer e.unsubscribe(client)
+
+	for {
+		select {
+		case <-client:
+			fmt.Fprint(rw, "event: reload\ndata: {}\n\n")
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// inject serves the reload events and adds a script reloading the docs ui page when they fire
+func (e *reloadEvents) inject(docsPath, eventsPath string, next http.Handler) http.Handler {
+	script := fmt.Sprintf(`<script>new EventSource(%q).addEventListener("reload", function() { window.location.reload(); });</script>`, eventsPath)
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case eventsPath:
+			e.serveEvents(rw, r)
+		case docsPath:
+			rec := &bufferedResponse{header: make(http.Header), code: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			body := bytes.Replace(rec.body.Bytes(), []byte("</body>"), []byte(script+"</body>"), 1)
+			for k, v := range rec.header {
+				rw.Header()[k] = v
+			}
+			rw.Header().Del("Content-Length")
+			rw.WriteHeader(rec.code)
+			_, _ = rw.Write(body)
+		default:
+			next.ServeHTTP(rw, r)
+		}
+	})
+}
+
+type bufferedResponse struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header         { return b.header }
+func (b *bufferedResponse) WriteHeader(code int)        { b.code = code }
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
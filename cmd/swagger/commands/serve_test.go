@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const servedSpecTemplate = `{"swagger": "2.0", "info": {"title": "%s", "version": "1.0"}, "paths": {}}`
+
+// docsHandler is the handler of the serve command, the docs ui reloading on the events
+func docsHandler(spec *servedSpec, events *reloadEvents) http.Handler {
+	handler := middleware.Redoc(middleware.RedocOpts{BasePath: "/", SpecURL: "/swagger.json", Path: "docs"}, http.NotFoundHandler())
+	handler = events.inject("/docs", "/reload", handler)
+	return spec.handler("/swagger.json", handler)
+}
+
+func getBody(t *testing.T, url string) (int, string) {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(b)
+}
+
+func TestServe_WatchSpec(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	dir, err := ioutil.TempDir("", "serve")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	location := filepath.Join(dir, "swagger.json")
+	require.NoError(t, ioutil.WriteFile(location, []byte(fmt.Sprintf(servedSpecTemplate, "before")), 0644))
+
+	spec := &servedSpec{}
+	require.NoError(t, spec.load(location))
+	events := newReloadEvents()
+	watcher, err := watchSpec(location, spec, events)
+	require.NoError(t, err)
+	server := httptest.NewServer(docsHandler(spec, events))
+	defer server.Close()
+
+	_, body := getBody(t, server.URL+"/swagger.json")
+	assert.Contains(t, body, `"title": "before"`)
+
+	resp, err := http.Get(server.URL + "/reload")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	received := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if line := scanner.Text(); strings.HasPrefix(line, "event:") {
+				received <- line
+			}
+		}
+	}()
+	// the browser subscribes once the stream is open
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		events.lock.Lock()
+		subscribed := len(events.clients) == 1
+		events.lock.Unlock()
+		if subscribed {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "the events stream was never subscribed")
+	}
+
+	require.NoError(t, ioutil.WriteFile(location, []byte(fmt.Sprintf(servedSpecTemplate, "after")), 0644))
+	select {
+	case event := <-received:
+		assert.Equal(t, "event: reload", event)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reload event was sent after the spec changed")
+	}
+	_, body = getBody(t, server.URL+"/swagger.json")
+	assert.Contains(t, body, `"title": "after"`)
+
+	// once the watcher is closed, as the server shuts down, the spec isn't reloaded anymore
+	require.NoError(t, watcher.Close())
+	require.NoError(t, ioutil.WriteFile(location, []byte(fmt.Sprintf(servedSpecTemplate, "closed")), 0644))
+	select {
+	case event := <-received:
+		t.Fatalf("%s was sent after the watcher was closed", event)
+	case <-time.After(300 * time.Millisecond):
+	}
+	_, body = getBody(t, server.URL+"/swagger.json")
+	assert.Contains(t, body, `"title": "after"`)
+}
+
+func TestReloadEvents_Inject(t *testing.T) {
+	spec := &servedSpec{doc: []byte(fmt.Sprintf(servedSpecTemplate, "injected"))}
+	server := httptest.NewServer(docsHandler(spec, newReloadEvents()))
+	defer server.Close()
+	script := `<script>new EventSource("/reload").addEventListener("reload", function() { window.location.reload(); });</script></body>`
+
+	status, body := getBody(t, server.URL+"/docs")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, script)
+	assert.Equal(t, 1, strings.Count(body, "EventSource"))
+
+	status, body = getBody(t, server.URL+"/swagger.json")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, string(spec.doc), body)
+
+	status, body = getBody(t, server.URL+"/other")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.NotContains(t, body, "EventSource")
+}
@@ -0,0 +1,344 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+// StatsSpec is a command that reports statistics about the size and the complexity of a swagger document
+type StatsSpec struct {
+	Format string `long:"format" description:"the format of the report" default:"text" choice:"text" choice:"json"`
+}
+
+// SpecStats are the statistics reported for a spec
+type SpecStats struct {
+	Paths              int            `json:"paths"`
+	Operations         int            `json:"operations"`
+	OperationsByMethod map[string]int `json:"operationsByMethod"`
+	Models             int            `json:"models"`
+	UnusedModels       []string       `json:"unusedModels"`
+	Params             ParamStats     `json:"parameters"`
+	MaxSchemaDepth     int            `json:"maxSchemaDepth"`
+	DeepestModel       string         `json:"deepestModel,omitempty"`
+	EstimatedFiles     int            `json:"estimatedFiles"`
+	EstimatedLines     int            `json:"estimatedLines"`
+}
+
+// ParamStats are the statistics about the parameters of the operations in a spec
+type ParamStats struct {
+	Total             int     `json:"total"`
+	Average           float64 `json:"average"`
+	Max               int     `json:"max"`
+	MaxOperation      string  `json:"maxOperation,omitempty"`
+	OperationsWithout int     `json:"operationsWithout"`
+}
+
+// rough number of lines generated for the server and the client, based on the output for the examples
+const (
+	linesPerModel     = 60
+	linesPerProperty  = 25
+	linesPerOperation = 350
+	linesPerParam     = 45
+	linesPerResponse  = 70
+	filesPerOperation = 6
+	supportFiles      = 8
+)
+
+// Execute reports the statistics of the spec
+func (c *StatsSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The stats command requires the swagger document url to be specified")
+	}
+
+	specDoc, err := loads.Spec(args[0])
+	if err != nil {
+		return err
+	}
+
+	stats, err := computeStats(specDoc.Spec())
+	if err != nil {
+		return err
+	}
+	if c.Format == "json" {
+		b, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+	return writeStats(os.Stdout, args[0], stats)
+}
+
+// computeStats computes the statistics of a spec which isn't validated first:
+// its parameter refs are checked before the analysis, which can't gather the parameters of a dangling one
+func computeStats(sw *spec.Swagger) (SpecStats, error) {
+	if err := checkParamRefs(sw); err != nil {
+		return SpecStats{}, err
+	}
+	stats := SpecStats{
+		OperationsByMethod: make(map[string]int),
+		Models:             len(sw.Definitions),
+		EstimatedFiles:     supportFiles,
+	}
+	if sw.Paths != nil {
+		stats.Paths = len(sw.Paths.Paths)
+	}
+
+	used := make(map[string]bool)
+	var pending []string
+	markRefs := func(schema *spec.Schema) {
+		for _, name := range schemaRefs(schema) {
+			if !used[name] {
+				used[name] = true
+				pending = append(pending, name)
+			}
+		}
+	}
+
+	analyzed := analysis.New(sw)
+	for method, ops := range analyzed.Operations() {
+		for pth, op := range ops {
+			stats.Operations++
+			stats.OperationsByMethod[strings.ToUpper(method)]++
+
+			params := analyzed.ParamsFor(method, pth)
+			stats.Params.Total += len(params)
+			if len(params) == 0 {
+				stats.Params.OperationsWithout++
+			}
+			label := strings.ToUpper(method) + " " + pth
+			if len(params) > stats.Params.Max || (len(params) == stats.Params.Max && len(params) > 0 && label < stats.Params.MaxOperation) {
+				stats.Params.Max = len(params)
+				stats.Params.MaxOperation = label
+			}
+			for _, param := range params {
+				markRefs(param.Schema)
+			}
+
+			var responses int
+			if op.Responses != nil {
+				for _, resp := range op.Responses.StatusCodeResponses {
+					responses++
+					markRefs(resolveStatsResponse(sw, resp).Schema)
+				}
+				if op.Responses.Default != nil {
+					responses++
+					markRefs(resolveStatsResponse(sw, *op.Responses.Default).Schema)
+				}
+			}
+
+			stats.EstimatedFiles += filesPerOperation
+			stats.EstimatedLines += linesPerOperation + len(params)*linesPerParam + responses*linesPerResponse
+		}
+	}
+	if stats.Operations > 0 {
+		stats.Params.Average = float64(stats.Params.Total) / float64(stats.Operations)
+	}
+
+	// models used by other models are used as well
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if def, ok := sw.Definitions[name]; ok {
+			markRefs(&def)
+		}
+	}
+
+	stats.UnusedModels = []string{}
+	for name, def := range sw.Definitions {
+		if !used[name] {
+			stats.UnusedModels = append(stats.UnusedModels, name)
+		}
+		def := def
+		if depth := schemaDepth(sw, &def, map[string]bool{name: true}); depth > stats.MaxSchemaDepth || (depth == stats.MaxSchemaDepth && name < stats.DeepestModel) {
+			stats.MaxSchemaDepth = depth
+			stats.DeepestModel = name
+		}
+		stats.EstimatedFiles++
+		stats.EstimatedLines += linesPerModel + len(def.Properties)*linesPerProperty
+	}
+	sort.Strings(stats.UnusedModels)
+	return stats, nil
+}
+
+// checkParamRefs tells which parameter $ref of the path items and of the operations doesn't point to a parameter
+func checkParamRefs(sw *spec.Swagger) error {
+	if sw.Paths == nil {
+		return nil
+	}
+	check := func(where string, params []spec.Parameter) error {
+		for _, param := range params {
+			if param.Ref.String() == "" {
+				continue
+			}
+			target, _, err := param.Ref.GetPointer().Get(sw)
+			if err != nil {
+				return fmt.Errorf("%s: the parameter $ref %q can't be resolved: %v", where, param.Ref.String(), err)
+			}
+			if _, ok := target.(spec.Parameter); !ok {
+				return fmt.Errorf("%s: the $ref %q isn't a parameter", where, param.Ref.String())
+			}
+		}
+		return nil
+	}
+
+	var paths []string
+	for pth := range sw.Paths.Paths {
+		paths = append(paths, pth)
+	}
+	sort.Strings(paths)
+	for _, pth := range paths {
+		item := sw.Paths.Paths[pth]
+		if err := check(pth, item.Parameters); err != nil {
+			return err
+		}
+		methods := []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"}
+		for i, op := range []*spec.Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch} {
+			if op == nil {
+				continue
+			}
+			if err := check(methods[i]+" "+pth, op.Parameters); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resolveStatsResponse(sw *spec.Swagger, resp spec.Response) spec.Response {
+	if ref := resp.Ref.String(); ref != "" {
+		if resolved, ok := sw.Responses[path.Base(ref)]; ok {
+			return resolved
+		}
+	}
+	return resp
+}
+
+// schemaRefs returns the names of the definitions a schema refers to
+func schemaRefs(schema *spec.Schema) []string {
+	if schema == nil {
+		return nil
+	}
+	if ref := schema.Ref.String(); ref != "" {
+		if strings.Contains(ref, "#/definitions/") {
+			return []string{path.Base(ref)}
+		}
+		return nil
+	}
+
+	var refs []string
+	for _, child := range childSchemas(schema) {
+		refs = append(refs, schemaRefs(child)...)
+	}
+	return refs
+}
+
+// schemaDepth returns the number of nested levels of a schema, following the refs to other definitions
+func schemaDepth(sw *spec.Swagger, schema *spec.Schema, visiting map[string]bool) int {
+	if schema == nil {
+		return 0
+	}
+	if ref := schema.Ref.String(); ref != "" {
+		name := path.Base(ref)
+		def, ok := sw.Definitions[name]
+		if !ok || visiting[name] {
+			// recursive models don't add depth
+			return 0
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+		return schemaDepth(sw, &def, visiting)
+	}
+
+	var depth int
+	for _, child := range childSchemas(schema) {
+		if d := schemaDepth(sw, child, visiting); d > depth {
+			depth = d
+		}
+	}
+	if len(schema.Properties) > 0 || schema.Items != nil || schema.AdditionalProperties != nil {
+		depth++
+	}
+	return depth
+}
+
+func childSchemas(schema *spec.Schema) []*spec.Schema {
+	var children []*spec.Schema
+	for k := range schema.Properties {
+		prop := schema.Properties[k]
+		children = append(children, &prop)
+	}
+	if schema.Items != nil {
+		if schema.Items.Schema != nil {
+			children = append(children, schema.Items.Schema)
+		}
+		for i := range schema.Items.Schemas {
+			children = append(children, &schema.Items.Schemas[i])
+		}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		children = append(children, schema.AdditionalProperties.Schema)
+	}
+	if schema.AdditionalItems != nil && schema.AdditionalItems.Schema != nil {
+		children = append(children, schema.AdditionalItems.Schema)
+	}
+	for _, composed := range [][]spec.Schema{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for i := range composed {
+			children = append(children, &composed[i])
+		}
+	}
+	if schema.Not != nil {
+		children = append(children, schema.Not)
+	}
+	return children
+}
+
+func writeStats(out io.Writer, location string, stats SpecStats) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "Statistics for %s\n\n", location)
+	fmt.Fprintf(w, "Paths:\t%d\n", stats.Paths)
+	fmt.Fprintf(w, "Operations:\t%d\n", stats.Operations)
+	methods := make([]string, 0, len(stats.OperationsByMethod))
+	for method := range stats.OperationsByMethod {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	for _, method := range methods {
+		fmt.Fprintf(w, "  %s:\t%d\n", method, stats.OperationsByMethod[method])
+	}
+	fmt.Fprintf(w, "Parameters:\t%d\n", stats.Params.Total)
+	fmt.Fprintf(w, "  per operation (average):\t%.1f\n", stats.Params.Average)
+	if stats.Params.MaxOperation != "" {
+		fmt.Fprintf(w, "  per operation (max):\t%d (%s)\n", stats.Params.Max, stats.Params.MaxOperation)
+	}
+	fmt.Fprintf(w, "  operations without parameters:\t%d\n", stats.Params.OperationsWithout)
+	fmt.Fprintf(w, "Models:\t%d\n", stats.Models)
+	fmt.Fprintf(w, "  unused:\t%d\n", len(stats.UnusedModels))
+	if stats.DeepestModel != "" {
+		fmt.Fprintf(w, "Max schema depth:\t%d (%s)\n", stats.MaxSchemaDepth, stats.DeepestModel)
+	}
+	fmt.Fprintf(w, "Estimated generated code:\t%d files, %d lines\n", stats.EstimatedFiles, stats.EstimatedLines)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(stats.UnusedModels) > 0 {
+		fmt.Fprintln(out, "\nUnused models:")
+		for _, name := range stats.UnusedModels {
+			fmt.Fprintf(out, "  - %s\n", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const statsSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "stats", "version": "1.0"},
+  "parameters": {
+    "limit": {"name": "limit", "in": "query", "type": "integer"}
+  },
+  "responses": {
+    "NotFound": {"description": "not found", "schema": {"$ref": "#/definitions/Error"}}
+  },
+  "paths": {
+    "/pets": {
+      "parameters": [{"$ref": "#/parameters/limit"}],
+      "get": {
+        "parameters": [{"name": "tag", "in": "query", "type": "string"}],
+        "responses": {
+          "200": {"description": "pets", "schema": {"type": "array", "items": {"$ref": "#/definitions/Pet"}}},
+          "default": {"$ref": "#/responses/NotFound"}
+        }
+      },
+      "post": {
+        "parameters": [{"name": "pet", "in": "body", "schema": {"$ref": "#/definitions/Pet"}}],
+        "responses": {"201": {"description": "added"}}
+      }
+    },
+    "/health": {
+      "get": {"responses": {"200": {"description": "healthy"}}}
+    }
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "properties": {
+        "name": {"type": "string"},
+        "owner": {"$ref": "#/definitions/Owner"}
+      }
+    },
+    "Owner": {
+      "type": "object",
+      "properties": {
+        "address": {"type": "object", "properties": {"city": {"type": "string"}}},
+        "pets": {"type": "array", "items": {"$ref": "#/definitions/Pet"}}
+      }
+    },
+    "Error": {"type": "object", "properties": {"message": {"type": "string"}}},
+    "Orphan": {"type": "object", "properties": {"friend": {"$ref": "#/definitions/Lonely"}}},
+    "Lonely": {"type": "string"}
+  }
+}`
+
+func loadStatsSpec(t *testing.T, doc string) *spec.Swagger {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(doc), &sw))
+	return &sw
+}
+
+func TestComputeStats(t *testing.T) {
+	stats, err := computeStats(loadStatsSpec(t, statsSpec))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stats.Paths)
+	assert.Equal(t, 3, stats.Operations)
+	assert.Equal(t, map[string]int{"GET": 2, "POST": 1}, stats.OperationsByMethod)
+	assert.Equal(t, 5, stats.Models)
+	// the parameters of the path item count for each of its operations
+	assert.Equal(t, ParamStats{Total: 4, Average: 4.0 / 3, Max: 2, MaxOperation: "GET /pets", OperationsWithout: 1}, stats.Params)
+	// Orphan refers to Lonely, but nothing refers to Orphan
+	assert.Equal(t, []string{"Lonely", "Orphan"}, stats.UnusedModels)
+	// Pet > owner > address, the recursion through pets doesn't count
+	assert.Equal(t, 3, stats.MaxSchemaDepth)
+	assert.Equal(t, "Owner", stats.DeepestModel)
+}
+
+func TestComputeStats_DanglingParameter(t *testing.T) {
+	sw := loadStatsSpec(t, statsSpec)
+	pets := sw.Paths.Paths["/pets"]
+	pets.Post.Parameters = append(pets.Post.Parameters, spec.Parameter{Refable: spec.Refable{Ref: spec.MustCreateRef("#/parameters/missing")}})
+
+	_, err := computeStats(sw)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `POST /pets: the parameter $ref "#/parameters/missing" can't be resolved`)
+
+	pets.Post.Parameters[1] = spec.Parameter{Refable: spec.Refable{Ref: spec.MustCreateRef("#/definitions/Pet")}}
+	_, err = computeStats(sw)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `POST /pets: the $ref "#/definitions/Pet" isn't a parameter`)
+}
+
+func TestSchemaDepth(t *testing.T) {
+	sw := loadStatsSpec(t, statsSpec)
+	for name, expected := range map[string]int{
+		"Pet":    3,
+		"Owner":  3,
+		"Error":  1,
+		"Orphan": 1,
+		"Lonely": 0,
+	} {
+		def := sw.Definitions[name]
+		assert.Equal(t, expected, schemaDepth(sw, &def, map[string]bool{name: true}), name)
+	}
+
+	// a ref to a missing definition adds no depth
+	missing := spec.RefSchema("#/definitions/Missing")
+	assert.Equal(t, 0, schemaDepth(sw, missing, map[string]bool{}))
+}
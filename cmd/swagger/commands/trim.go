@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/go-openapi/loads"
+	"github.com/sidewalklabs/go-swagger/generator"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// TrimSpec is a command that keeps the selected operations of a spec, with the definitions they use
+type TrimSpec struct {
+	Operations []string       `long:"operation" short:"O" description:"specify an operation to keep, repeat for multiple"`
+	Tags       []string       `long:"tags" description:"the tags of the operations to keep"`
+	Include    []string       `long:"include" description:"a regular expression matched against the id and the 'METHOD /path' of the operations to keep, repeat for multiple"`
+	Exclude    []string       `long:"exclude" description:"a regular expression matched against the id and the 'METHOD /path' of the operations to drop, repeat for multiple"`
+	Compact    bool           `long:"compact" description:"when present, doesn't prettify the json"`
+	Output     flags.Filename `long:"output" short:"o" description:"the file to write to"`
+}
+
+// Execute trims the spec
+func (c *TrimSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The trim command requires the swagger document url to be specified")
+	}
+
+	specDoc, err := loads.Spec(args[0])
+	if err != nil {
+		return err
+	}
+
+	filter := generator.OperationFilter{
+		Operations: c.Operations,
+		Tags:       c.Tags,
+		Include:    c.Include,
+		Exclude:    c.Exclude,
+	}
+	if err := generator.TrimSpec(specDoc.Spec(), filter); err != nil {
+		return err
+	}
+
+	return writeToFile(specDoc.Spec(), !c.Compact, string(c.Output))
+}
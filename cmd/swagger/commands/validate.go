@@ -15,20 +15,62 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/go-openapi/analysis"
 	swaggererrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
+	"github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/watch"
+	"github.com/sidewalklabs/go-swagger/generator"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 // ValidateSpec is a command that validates a swagger document
 // against the swagger json schema
 type ValidateSpec struct {
 	// SchemaURL string `long:"schema" description:"The schema url to use" default:"http://swagger.io/v2/schema.json"`
+	Offline  bool           `long:"offline" description:"fail on remote documents instead of fetching them"`
+	Watch    bool           `long:"watch" description:"validate the spec again each time it, or a document it refers to, changes"`
+	Format   string         `long:"format" description:"the format of the report" default:"text" choice:"text" choice:"json"`
+	CacheDir flags.Filename `long:"cache-dir" env:"SWAGGER_VALIDATION_CACHE" description:"the directory where the validation reports are cached, by the content of the spec, of the documents it refers to and the version of the validator"`
+	// FailOnDeprecated makes the deprecated parameters the operations still use problems, rather than warnings
+	FailOnDeprecated bool `long:"fail-on-deprecated" description:"fail when operations still use parameters marked with x-deprecated"`
+	// StrictSlash and CaseSensitivePaths tell how the router of the api matches the paths, to tell which ones overlap
+	StrictSlash        bool `long:"strict-slash" description:"the paths which only differ by a trailing slash don't overlap, the router of the api matching them strictly"`
+	CaseSensitivePaths bool `long:"case-sensitive-paths" description:"the paths which only differ by the case of their letters don't overlap, the router of the api matching them case-sensitively"`
+}
+
+func isRemote(pth string) bool {
+	return strings.HasPrefix(pth, "http://") || strings.HasPrefix(pth, "https://")
+}
+
+// offlineLoader refuses the remote documents. The meta-schemas of the validation never need network access:
+// they are embedded in the spec package, which doesn't load them.
+func offlineLoader(next func(string) (json.RawMessage, error)) func(string) (json.RawMessage, error) {
+	return func(pth string) (json.RawMessage, error) {
+		if isRemote(pth) {
+			return nil, fmt.Errorf("can't load %q: remote documents are not fetched in offline mode", pth)
+		}
+		return next(pth)
+	}
 }
 
 // Execute validates the spec
@@ -38,20 +80,905 @@ func (c *ValidateSpec) Execute(args []string) error {
 	}
 
 	swaggerDoc := args[0]
-	specDoc, err := loads.Spec(swaggerDoc)
+	if c.Watch {
+		return watchValidation(swaggerDoc)
+	}
+	if c.Offline && isRemote(swaggerDoc) {
+		return fmt.Errorf("can't validate %q: remote documents are not fetched in offline mode", swaggerDoc)
+	}
+	if c.Offline {
+		spec.PathLoader = offlineLoader(spec.PathLoader)
+	}
+
+	var cache *validationCache
+	if c.CacheDir != "" && !isRemote(swaggerDoc) {
+		var err error
+		if cache, err = newValidationCache(string(c.CacheDir), swaggerDoc, c.Offline); err != nil {
+			log.Printf("the validation of %q isn't cached: %v", swaggerDoc, err)
+		} else if report, ok := cache.get(); ok {
+			report.Spec = swaggerDoc
+			return c.report(report)
+		}
+	}
+
+	specDoc, err := generator.LoadSpec(swaggerDoc)
 	if err != nil {
-		log.Fatalln(err)
+		return err
 	}
 
-	result := validate.Spec(specDoc, strfmt.Default)
-	if result == nil {
-		fmt.Printf("The swagger spec at %q is valid against swagger specification %s\n", swaggerDoc, specDoc.Version())
+	report := &ValidationReport{Spec: swaggerDoc, Version: specDoc.Version(), Valid: true}
+	if err := generator.CheckSpecVersion(swaggerDoc, specDoc); err != nil {
+		report = unsupportedReport(swaggerDoc, specDoc, err)
 	} else {
-		str := fmt.Sprintf("The swagger spec at %q is invalid against swagger specification %s. see errors :\n", swaggerDoc, specDoc.Version())
-		for _, desc := range result.(*swaggererrors.CompositeError).Errors {
-			str += fmt.Sprintf("- %s\n", desc)
+		if result := validate.Spec(specDoc, strfmt.Default); result != nil {
+			report.Valid = false
+			report.Problems = groupProblems(specDoc.Spec(), result)
+		}
+		matching := pathMatching{strictSlash: c.StrictSlash, caseSensitive: c.CaseSensitivePaths}
+		problems := append(pathProblems(specDoc.Spec(), matching), parameterProblems(specDoc.Spec())...)
+		problems = append(problems, constraintProblems(specDoc.Spec())...)
+		problems = append(problems, securityProblems(specDoc.Spec())...)
+		problems = append(problems, consumesProblems(specDoc.Spec())...)
+		if problems = append(problems, dependencyProblems(specDoc.Spec())...); len(problems) > 0 {
+			report.Valid = false
+			report.Problems = append(report.Problems, problems...)
+		}
+		report.Warnings = append(operationIDWarnings(specDoc.Spec()), definitionNameWarnings(specDoc.Spec())...)
+		report.Warnings = append(report.Warnings, duplicateSchemaWarnings(specDoc.Spec())...)
+		report.Deprecations = deprecatedParameters(specDoc.Spec())
+	}
+	if cache != nil {
+		if err := cache.put(report); err != nil {
+			log.Printf("caching the validation of %q failed: %v", swaggerDoc, err)
 		}
-		return errors.New(str)
+	}
+	return c.report(report)
+}
+
+// unsupportedReport is the report of a spec of another version than swagger 2.0, with the version it has:
+// a single problem rather than one for each property the swagger 2.0 schema doesn't know
+func unsupportedReport(swaggerDoc string, specDoc *loads.Document, err error) *ValidationReport {
+	report := &ValidationReport{Spec: swaggerDoc, Version: specDoc.Version(), Problems: []Problem{{Group: specGroup, Message: err.Error()}}}
+	if unsupported, ok := err.(*generator.UnsupportedVersionError); ok && unsupported.Version != "" {
+		report.Version = unsupported.Version
+	}
+	return report
+}
+
+// report prints the report of a validation, and fails when the spec is invalid
+func (c *ValidateSpec) report(report *ValidationReport) error {
+	if c.FailOnDeprecated && len(report.Deprecations) > 0 {
+		report.Valid = false
+		report.Problems = append(report.Problems, report.Deprecations...)
+		report.Deprecations = nil
+	}
+	if c.Format == "json" {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else {
+		report.print(os.Stdout)
+	}
+	if !report.Valid {
+		return &InvalidSpecError{Spec: report.Spec}
 	}
 	return nil
 }
+
+// InvalidSpecError is returned by a command which found a spec invalid, once the problems are reported.
+// The swagger command exits with 1 for it, and with 2 for the other errors.
+type InvalidSpecError struct {
+	Spec string
+}
+
+func (e *InvalidSpecError) Error() string {
+	return fmt.Sprintf("the swagger spec at %q is invalid", e.Spec)
+}
+
+// ValidationReport is the result of the validation of a spec
+type ValidationReport struct {
+	Spec     string    `json:"spec"`
+	Version  string    `json:"version"`
+	Valid    bool      `json:"valid"`
+	Problems []Problem `json:"problems,omitempty"`
+	// Warnings are the problems of a spec for the generation of code, which don't make it invalid
+	Warnings []Problem `json:"warnings,omitempty"`
+	// Deprecations are the deprecated parameters the operations still use, problems with --fail-on-deprecated
+	Deprecations []Problem `json:"deprecations,omitempty"`
+}
+
+// Problem is an error found in a spec, with the operation, path or definition it's about.
+// The problems which aren't about one of them are in the spec group.
+type Problem struct {
+	Group   string `json:"group"`
+	Message string `json:"message"`
+}
+
+const specGroup = "spec"
+
+const (
+	colorBold   = "\x1b[1m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorReset  = "\x1b[0m"
+)
+
+func (r *ValidationReport) print(w io.Writer) {
+	if r.Valid {
+		fmt.Fprintln(w, colorize(w, colorGreen, fmt.Sprintf("The swagger spec at %q is valid against swagger specification %s", r.Spec, r.Version)))
+	} else {
+		fmt.Fprintf(w, "The swagger spec at %q is invalid against swagger specification %s. see errors :\n", r.Spec, r.Version)
+		printProblems(w, r.Problems, colorRed)
+	}
+	if len(r.Warnings) > 0 {
+		fmt.Fprintln(w, "The code generated from it is affected by these warnings :")
+		printProblems(w, r.Warnings, colorYellow)
+	}
+	if len(r.Deprecations) > 0 {
+		fmt.Fprintln(w, "Its operations still use these deprecated parameters :")
+		printProblems(w, r.Deprecations, colorYellow)
+	}
+}
+
+// printProblems prints problems by group, the problems about the whole spec first
+func printProblems(w io.Writer, problems []Problem, color string) {
+	var groups []string
+	byGroup := make(map[string][]string)
+	for _, problem := range problems {
+		if _, ok := byGroup[problem.Group]; !ok {
+			groups = append(groups, problem.Group)
+		}
+		byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+	}
+	// the problems about the whole spec come first
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i] == specGroup || groups[j] == specGroup {
+			return groups[i] == specGroup && groups[j] != specGroup
+		}
+		return groups[i] < groups[j]
+	})
+	for _, group := range groups {
+		fmt.Fprintln(w, colorize(w, colorBold, group))
+		for _, message := range byGroup[group] {
+			fmt.Fprintln(w, colorize(w, color, "  - "+message))
+		}
+	}
+}
+
+// colorize colors a line when it's written to a terminal, unless NO_COLOR is set
+func colorize(w io.Writer, color, line string) string {
+	f, ok := w.(*os.File)
+	if !ok || !terminal.IsTerminal(int(f.Fd())) || os.Getenv("NO_COLOR") != "" {
+		return line
+	}
+	return color + line + colorReset
+}
+
+var quoted = regexp.MustCompile(`"([^"]*)"`)
+
+// groupProblems tells what each error of a validation is about: the schema validation names the location of its errors,
+// the other ones quote the operation id, the path or the definition in their message
+func groupProblems(sw *spec.Swagger, result error) []Problem {
+	errs := []error{result}
+	if composite, ok := result.(*swaggererrors.CompositeError); ok {
+		errs = composite.Errors
+	}
+
+	operations := make(map[string]string)
+	paths := make(map[string]map[string]string)
+	for method, ops := range analysis.New(sw).Operations() {
+		for pth, op := range ops {
+			group := strings.ToUpper(method) + " " + pth
+			if op.ID != "" {
+				group += " (" + op.ID + ")"
+				operations[op.ID] = group
+			}
+			if paths[pth] == nil {
+				paths[pth] = make(map[string]string)
+			}
+			paths[pth][strings.ToLower(method)] = group
+		}
+	}
+
+	pathGroup := func(pth, rest string) string {
+		if group, ok := paths[pth][strings.SplitN(rest, ".", 2)[0]]; ok {
+			return group
+		}
+		return pth
+	}
+	group := func(err error) string {
+		if v, ok := err.(*swaggererrors.Validation); ok {
+			switch {
+			case strings.HasPrefix(v.Name, "paths."):
+				name := strings.TrimPrefix(v.Name, "paths.")
+				for pth := range paths {
+					if name == pth || strings.HasPrefix(name, pth+".") {
+						return pathGroup(pth, strings.TrimPrefix(name, pth+"."))
+					}
+				}
+			case strings.HasPrefix(v.Name, "definitions."):
+				return "definition " + strings.SplitN(strings.TrimPrefix(v.Name, "definitions."), ".", 2)[0]
+			}
+		}
+
+		var names []string
+		for _, match := range quoted.FindAllStringSubmatch(err.Error(), -1) {
+			names = append(names, match[1])
+		}
+		for _, name := range names {
+			if group, ok := operations[name]; ok {
+				return group
+			}
+		}
+		for _, name := range names {
+			if _, ok := paths[name]; ok {
+				return name
+			}
+		}
+		for _, name := range names {
+			if _, ok := sw.Definitions[name]; ok {
+				return "definition " + name
+			}
+		}
+		return specGroup
+	}
+
+	problems := make([]Problem, 0, len(errs))
+	for _, err := range errs {
+		problems = append(problems, Problem{Group: group(err), Message: err.Error()})
+	}
+	return problems
+}
+
+// operationIDWarnings are the problems of the operation ids for the generation of code, grouped by operation
+func operationIDWarnings(sw *spec.Swagger) []Problem {
+	var warnings []Problem
+	for _, warning := range generator.LintOperationIDs(sw) {
+		group := warning.Method + " " + warning.Path
+		if warning.ID != "" {
+			group += " (" + warning.ID + ")"
+		}
+		warnings = append(warnings, Problem{Group: group, Message: warning.Message})
+	}
+	return warnings
+}
+
+// definitionNameWarnings are the definitions whose generated code wouldn't compile, grouped by definition
+func definitionNameWarnings(sw *spec.Swagger) []Problem {
+	var warnings []Problem
+	for _, problem := range generator.CheckDefinitionNames(sw) {
+		warnings = append(warnings, Problem{Group: "definition " + problem.Name, Message: problem.Message})
+	}
+	return warnings
+}
+
+// duplicateSchemaWarnings are the inline schemas repeated across the spec, grouped by the definition they could share
+func duplicateSchemaWarnings(sw *spec.Swagger) []Problem {
+	var warnings []Problem
+	for _, duplicate := range generator.LintDuplicateSchemas(sw) {
+		warnings = append(warnings, Problem{Group: "definition " + duplicate.Definition, Message: duplicate.String()})
+	}
+	return warnings
+}
+
+// parameterProblems are the structurally wrong parameters of the operations, grouped by operation
+func parameterProblems(sw *spec.Swagger) []Problem {
+	return groupOperationProblems(generator.CheckParameters(sw))
+}
+
+// consumesProblems are the formData parameters the operations can't read from the media types they consume, grouped by operation
+func consumesProblems(sw *spec.Swagger) []Problem {
+	return groupOperationProblems(generator.CheckConsumes(sw))
+}
+
+// dependencyProblems are the x-requires and x-mutually-exclusive extensions the binders can't enforce, grouped by operation
+func dependencyProblems(sw *spec.Swagger) []Problem {
+	return groupOperationProblems(generator.CheckParamDependencies(sw))
+}
+
+func groupOperationProblems(found []generator.OperationProblem) []Problem {
+	var problems []Problem
+	for _, problem := range found {
+		group := problem.Method + " " + problem.Path
+		if problem.ID != "" {
+			group += " (" + problem.ID + ")"
+		}
+		problems = append(problems, Problem{Group: group, Message: problem.Message})
+	}
+	return problems
+}
+
+// constraintProblems are the constraints of the schemas, of the parameters and of the headers no value can satisfy,
+// grouped by definition or by operation
+func constraintProblems(sw *spec.Swagger) []Problem {
+	var problems []Problem
+	in := func(group string) func(string, string) {
+		return func(where, message string) {
+			if where != "" {
+				message = where + ": " + message
+			}
+			problems = append(problems, Problem{Group: group, Message: message})
+		}
+	}
+
+	var names []string
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schemaConstraints(sw.Definitions[name], "", in("definition "+name))
+	}
+	names = names[:0]
+	for name := range sw.Parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parameterConstraints(sw.Parameters[name], in("#/parameters/"+name))
+	}
+	names = names[:0]
+	for name := range sw.Responses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		responseConstraints(sw.Responses[name], "response", in("#/responses/"+name))
+	}
+	if sw.Paths == nil {
+		return problems
+	}
+	operations := analysis.New(sw).Operations()
+	names = names[:0]
+	for pth := range sw.Paths.Paths {
+		names = append(names, pth)
+	}
+	sort.Strings(names)
+	for _, pth := range names {
+		for _, param := range sw.Paths.Paths[pth].Parameters {
+			parameterConstraints(param, in(pth))
+		}
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			operation, ok := operations[method][pth]
+			if !ok {
+				continue
+			}
+			group := method + " " + pth
+			if operation.ID != "" {
+				group += " (" + operation.ID + ")"
+			}
+			for _, param := range operation.Parameters {
+				parameterConstraints(param, in(group))
+			}
+			if operation.Responses == nil {
+				continue
+			}
+			if operation.Responses.Default != nil {
+				responseConstraints(*operation.Responses.Default, "response default", in(group))
+			}
+			var codes []int
+			for code := range operation.Responses.StatusCodeResponses {
+				codes = append(codes, code)
+			}
+			sort.Ints(codes)
+			for _, code := range codes {
+				responseConstraints(operation.Responses.StatusCodeResponses[code], "response "+strconv.Itoa(code), in(group))
+			}
+		}
+	}
+	return problems
+}
+
+func parameterConstraints(param spec.Parameter, report func(string, string)) {
+	where := "parameter " + param.Name
+	if param.Schema != nil {
+		schemaConstraints(*param.Schema, where, report)
+		return
+	}
+	validationConstraints(param.CommonValidations, param.Type, param.Format, where, report)
+	itemsConstraints(param.Items, where, report)
+}
+
+func responseConstraints(response spec.Response, where string, report func(string, string)) {
+	if response.Schema != nil {
+		schemaConstraints(*response.Schema, where, report)
+	}
+	var names []string
+	for name := range response.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		header := response.Headers[name]
+		validationConstraints(header.CommonValidations, header.Type, header.Format, where+" header "+name, report)
+		itemsConstraints(header.Items, where+" header "+name, report)
+	}
+}
+
+func itemsConstraints(items *spec.Items, where string, report func(string, string)) {
+	itemsValues(items, where, report)
+	for items != nil {
+		where += " items"
+		validationConstraints(items.CommonValidations, items.Type, items.Format, where, report)
+		items = items.Items
+	}
+}
+
+// itemsValues reports the defaults, the examples and the enum values of the items which aren't valid items,
+// at every depth, with the path of the value in the parameter or in the header, like items.items.default
+func itemsValues(items *spec.Items, where string, report func(string, string)) {
+	for pth := "items"; items != nil; items, pth = items.Items, pth+".items" {
+		if items.Default != nil {
+			itemsValue(items.Default, items, true, pth+".default", where, report)
+		}
+		if items.Example != nil {
+			itemsValue(items.Example, items, true, pth+".example", where, report)
+		}
+		for i, value := range items.Enum {
+			// an enum value of another type is reported with the enum, and isn't checked against the enum itself
+			if enumValueFits(value, items.Type, items.Format) {
+				itemsValue(value, items, false, pth+".enum."+strconv.Itoa(i), where, report)
+			}
+		}
+	}
+}
+
+// itemsValue reports what makes a value invalid for items: its type, its enum and its bounds,
+// and for an array the problems of its elements. The arrays written as strings are split by their collection format.
+func itemsValue(value interface{}, items *spec.Items, inEnum bool, pth, where string, report func(string, string)) {
+	invalid := func(message string, args ...interface{}) {
+		b, _ := json.Marshal(value)
+		report(where, fmt.Sprintf("%s %s %s", pth, b, fmt.Sprintf(message, args...)))
+	}
+	if str, ok := value.(string); ok && items.Type == "array" {
+		var elements []interface{}
+		for _, element := range swag.SplitByFormat(str, items.CollectionFormat) {
+			var v interface{} = element
+			if items.Items != nil && items.Items.Type != "string" {
+				// the elements which aren't strings are written as json
+				_ = json.Unmarshal([]byte(element), &v)
+			}
+			elements = append(elements, v)
+		}
+		value = elements
+	}
+	if !enumValueFits(value, items.Type, items.Format) {
+		if _, ok := value.(string); ok && items.Type == "string" {
+			invalid("isn't a valid %s", items.Format)
+		} else {
+			invalid("isn't of type %s", items.Type)
+		}
+		return
+	}
+	if inEnum && len(items.Enum) > 0 {
+		found := false
+		for _, e := range items.Enum {
+			found = found || reflect.DeepEqual(e, value)
+		}
+		if !found {
+			invalid("isn't one of the values of the enum")
+		}
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if items.Maximum != nil && (v > *items.Maximum || items.ExclusiveMaximum && v == *items.Maximum) {
+			invalid("is greater than the maximum %v", *items.Maximum)
+		}
+		if items.Minimum != nil && (v < *items.Minimum || items.ExclusiveMinimum && v == *items.Minimum) {
+			invalid("is less than the minimum %v", *items.Minimum)
+		}
+		if items.MultipleOf != nil && *items.MultipleOf != 0 && math.Mod(v, *items.MultipleOf) != 0 {
+			invalid("isn't a multiple of %v", *items.MultipleOf)
+		}
+	case string:
+		if items.MaxLength != nil && int64(utf8.RuneCountInString(v)) > *items.MaxLength {
+			invalid("is longer than the maxLength %d", *items.MaxLength)
+		}
+		if items.MinLength != nil && int64(utf8.RuneCountInString(v)) < *items.MinLength {
+			invalid("is shorter than the minLength %d", *items.MinLength)
+		}
+		if items.Pattern != "" {
+			if re, err := regexp.Compile(items.Pattern); err == nil && !re.MatchString(v) {
+				invalid("doesn't match the pattern %s", items.Pattern)
+			}
+		}
+	case []interface{}:
+		if items.MaxItems != nil && int64(len(v)) > *items.MaxItems {
+			invalid("has more than the maxItems %d", *items.MaxItems)
+		}
+		if items.MinItems != nil && int64(len(v)) < *items.MinItems {
+			invalid("has less than the minItems %d", *items.MinItems)
+		}
+		if items.UniqueItems && hasDuplicates(v) {
+			invalid("has the same item twice, and its items must be unique")
+		}
+		if items.Items != nil {
+			for i, element := range v {
+				itemsValue(element, items.Items, true, pth+"."+strconv.Itoa(i), where, report)
+			}
+		}
+	}
+}
+
+func hasDuplicates(values []interface{}) bool {
+	for i := range values {
+		for j := 0; j < i; j++ {
+			if reflect.DeepEqual(values[i], values[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// schemaConstraints reports the constraints of a schema and of the schemas it is made of, the ones it refers to aside
+func schemaConstraints(schema spec.Schema, where string, report func(string, string)) {
+	if schema.Ref.String() != "" {
+		return
+	}
+	var typ string
+	if len(schema.Type) == 1 {
+		typ = schema.Type[0]
+	}
+	validationConstraints(spec.CommonValidations{
+		Maximum:          schema.Maximum,
+		ExclusiveMaximum: schema.ExclusiveMaximum,
+		Minimum:          schema.Minimum,
+		ExclusiveMinimum: schema.ExclusiveMinimum,
+		MaxLength:        schema.MaxLength,
+		MinLength:        schema.MinLength,
+		MaxItems:         schema.MaxItems,
+		MinItems:         schema.MinItems,
+		Enum:             schema.Enum,
+	}, typ, schema.Format, where, report)
+
+	// a required property can't be given when no other property than the declared ones is allowed
+	if schema.AdditionalProperties != nil && !schema.AdditionalProperties.Allows && len(schema.AllOf) == 0 {
+		for _, name := range schema.Required {
+			if _, ok := schema.Properties[name]; !ok {
+				report(where, fmt.Sprintf("the required property %q isn't a property, and additionalProperties is false", name))
+			}
+		}
+	}
+
+	nested := func(name string) string {
+		if where == "" {
+			return name
+		}
+		return where + "." + name
+	}
+	var names []string
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schemaConstraints(schema.Properties[name], nested("properties."+name), report)
+	}
+	if schema.Items != nil {
+		if schema.Items.Schema != nil {
+			schemaConstraints(*schema.Items.Schema, nested("items"), report)
+		}
+		for i, item := range schema.Items.Schemas {
+			schemaConstraints(item, nested("items."+strconv.Itoa(i)), report)
+		}
+	}
+	for i, member := range schema.AllOf {
+		schemaConstraints(member, nested("allOf."+strconv.Itoa(i)), report)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		schemaConstraints(*schema.AdditionalProperties.Schema, nested("additionalProperties"), report)
+	}
+}
+
+// validationConstraints reports the formats of another type than the declared one, the bounds which exclude every value,
+// and the enums which are empty or have values of another type or format than the declared ones
+func validationConstraints(v spec.CommonValidations, typ, format, where string, report func(string, string)) {
+	if message := formatProblem(typ, format); message != "" {
+		report(where, message)
+	}
+	if v.Minimum != nil && v.Maximum != nil {
+		if *v.Minimum > *v.Maximum {
+			report(where, fmt.Sprintf("minimum %v is greater than maximum %v", *v.Minimum, *v.Maximum))
+		} else if *v.Minimum == *v.Maximum && (v.ExclusiveMinimum || v.ExclusiveMaximum) {
+			report(where, fmt.Sprintf("minimum and maximum are both %v, one of them exclusive", *v.Minimum))
+		}
+	}
+	if v.MinLength != nil && v.MaxLength != nil && *v.MinLength > *v.MaxLength {
+		report(where, fmt.Sprintf("minLength %d is greater than maxLength %d", *v.MinLength, *v.MaxLength))
+	}
+	if v.MinItems != nil && v.MaxItems != nil && *v.MinItems > *v.MaxItems {
+		report(where, fmt.Sprintf("minItems %d is greater than maxItems %d", *v.MinItems, *v.MaxItems))
+	}
+	if v.Enum != nil && len(v.Enum) == 0 {
+		report(where, "the enum is empty")
+	}
+	for _, value := range v.Enum {
+		if !enumValueFits(value, typ, format) {
+			b, _ := json.Marshal(value)
+			if _, ok := value.(string); ok && typ == "string" {
+				report(where, fmt.Sprintf("the enum value %s isn't a valid %s", b, format))
+			} else {
+				report(where, fmt.Sprintf("the enum value %s isn't of type %s", b, typ))
+			}
+		}
+	}
+}
+
+// integerFormats are the formats of the integers, the ones of the swagger spec and the ones of the sized Go integers
+var integerFormats = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// formatProblem tells why a format doesn't go with a type: the formats of the integers, of the numbers and of the strings
+// only go with their own type, while the formats nothing is known of go with any
+func formatProblem(typ, format string) string {
+	if typ == "" || format == "" {
+		return ""
+	}
+	var formatType string
+	switch {
+	case integerFormats[format]:
+		formatType = "integer"
+	case format == "float" || format == "double":
+		formatType = "number"
+	case format == "binary" || strfmt.Default.ContainsName(format):
+		formatType = "string"
+	default:
+		return ""
+	}
+	if typ == formatType {
+		return ""
+	}
+	return fmt.Sprintf("the format %s is a format of the %s type, not of %s", format, formatType, typ)
+}
+
+// enumValueFits tells whether an enum value is of a type, and in a format of strings the validation knows
+func enumValueFits(value interface{}, typ, format string) bool {
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		return ok && (format == "" || !strfmt.Default.ContainsName(format) || strfmt.Default.Validates(format, s))
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return true
+}
+
+// securityProblems are the security definitions missing what their type needs, grouped by definition,
+// and the security requirements naming no definition or scopes of another type than oauth2, grouped by operation
+func securityProblems(sw *spec.Swagger) []Problem {
+	var problems []Problem
+	var names []string
+	for name := range sw.SecurityDefinitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		scheme := sw.SecurityDefinitions[name]
+		if scheme == nil {
+			continue
+		}
+		problem := func(message string) {
+			problems = append(problems, Problem{Group: "security definition " + name, Message: message})
+		}
+		switch scheme.Type {
+		case "apiKey":
+			if scheme.Name == "" {
+				problem("the apiKey scheme has no name")
+			}
+			if scheme.In != "query" && scheme.In != "header" {
+				problem("the apiKey scheme must be in a query or in a header")
+			}
+		case "oauth2":
+			if (scheme.Flow == "implicit" || scheme.Flow == "accessCode") && scheme.AuthorizationURL == "" {
+				problem(fmt.Sprintf("the oauth2 %s flow has no authorizationUrl", scheme.Flow))
+			}
+			if (scheme.Flow == "password" || scheme.Flow == "application" || scheme.Flow == "accessCode") && scheme.TokenURL == "" {
+				problem(fmt.Sprintf("the oauth2 %s flow has no tokenUrl", scheme.Flow))
+			}
+		}
+	}
+
+	requirements := func(group string, security []map[string][]string) {
+		for _, requirement := range security {
+			var schemes []string
+			for name := range requirement {
+				schemes = append(schemes, name)
+			}
+			sort.Strings(schemes)
+			for _, name := range schemes {
+				scheme, ok := sw.SecurityDefinitions[name]
+				switch {
+				case !ok || scheme == nil:
+					problems = append(problems, Problem{Group: group, Message: fmt.Sprintf("the security requirement %q isn't a security definition", name)})
+				case scheme.Type != "oauth2" && len(requirement[name]) > 0:
+					problems = append(problems, Problem{Group: group, Message: fmt.Sprintf("the security requirement %q has scopes, which only oauth2 schemes have", name)})
+				}
+			}
+		}
+	}
+	requirements(specGroup, sw.Security)
+	if sw.Paths == nil {
+		return problems
+	}
+	operations := analysis.New(sw).Operations()
+	names = names[:0]
+	for pth := range sw.Paths.Paths {
+		names = append(names, pth)
+	}
+	sort.Strings(names)
+	for _, pth := range names {
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			if operation, ok := operations[method][pth]; ok {
+				group := method + " " + pth
+				if operation.ID != "" {
+					group += " (" + operation.ID + ")"
+				}
+				requirements(group, operation.Security)
+			}
+		}
+	}
+	return problems
+}
+
+// pathMatching is how the router of the api matches the paths
+type pathMatching struct {
+	strictSlash   bool
+	caseSensitive bool
+}
+
+var pathParameter = regexp.MustCompile(`\{[^{}/]*\}`)
+
+// route is what the router matches of a path: its parameters whatever their names, and unless the matching is strict,
+// without a trailing slash and whatever the case of its letters
+func (m pathMatching) route(pth string) string {
+	route := pathParameter.ReplaceAllString(pth, "{}")
+	if !m.strictSlash && len(route) > 1 {
+		route = strings.TrimSuffix(route, "/")
+	}
+	if !m.caseSensitive {
+		route = strings.ToLower(route)
+	}
+	return route
+}
+
+// pathProblems are the path keys which aren't templates of a path, and the ones with an operation the router
+// can't tell from the one of an equivalent path, grouped by path
+func pathProblems(sw *spec.Swagger, matching pathMatching) []Problem {
+	if sw.Paths == nil {
+		return nil
+	}
+	var paths []string
+	for pth := range sw.Paths.Paths {
+		paths = append(paths, pth)
+	}
+	sort.Strings(paths)
+
+	var problems []Problem
+	for _, pth := range paths {
+		for _, message := range checkPathKey(pth) {
+			problems = append(problems, Problem{Group: pth, Message: message})
+		}
+	}
+
+	operations := analysis.New(sw).Operations()
+	routes := make(map[string][]string)
+	for _, pth := range paths {
+		route := matching.route(pth)
+		for _, other := range routes[route] {
+			var methods []string
+			for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+				_, ok := operations[method][pth]
+				if _, overlaps := operations[method][other]; ok && overlaps {
+					methods = append(methods, method)
+				}
+			}
+			if len(methods) > 0 {
+				problems = append(problems, Problem{Group: pth, Message: fmt.Sprintf("the path overlaps with %s for %s: the router can't tell them apart", other, strings.Join(methods, ", "))})
+			}
+		}
+		routes[route] = append(routes[route], pth)
+	}
+	return problems
+}
+
+// checkPathKey tells what's wrong with the syntax of a path key
+func checkPathKey(pth string) []string {
+	var messages []string
+	if !strings.HasPrefix(pth, "/") {
+		messages = append(messages, "the path doesn't start with /")
+	}
+	if i := strings.IndexAny(pth, "?#"); i >= 0 {
+		messages = append(messages, fmt.Sprintf("the path has a %q, the query strings and the fragments aren't part of a path", pth[i]))
+	}
+
+	seen := make(map[string]bool)
+	open := -1
+	for i, c := range pth {
+		switch c {
+		case '{':
+			if open >= 0 {
+				return append(messages, fmt.Sprintf("the brace at %d opens a parameter in the one opened at %d", i, open))
+			}
+			open = i
+		case '}':
+			if open < 0 {
+				return append(messages, fmt.Sprintf("the brace at %d closes no parameter", i))
+			}
+			name := pth[open+1 : i]
+			switch {
+			case name == "":
+				messages = append(messages, "the path has a parameter without a name: {}")
+			case seen[name]:
+				messages = append(messages, fmt.Sprintf("the parameter %q appears more than once in the path", name))
+			}
+			seen[name] = true
+			open = -1
+		}
+	}
+	if open >= 0 {
+		messages = append(messages, fmt.Sprintf("the brace at %d opens a parameter which is never closed", open))
+	}
+	return messages
+}
+
+// deprecatedParameters are the deprecated parameters the operations still use, grouped by operation
+func deprecatedParameters(sw *spec.Swagger) []Problem {
+	var deprecations []Problem
+	for _, param := range generator.LintDeprecatedParameters(sw) {
+		group := param.Method + " " + param.Path
+		if param.ID != "" {
+			group += " (" + param.ID + ")"
+		}
+		deprecations = append(deprecations, Problem{Group: group, Message: param.Message})
+	}
+	return deprecations
+}
+
+// watchValidation validates the spec again each time it changes, and prints the problems which appeared (+)
+// or were fixed (-) since the previous validation
+func watchValidation(swaggerDoc string) error {
+	var previous []string
+	return watch.Run(swaggerDoc, func() error {
+		var out bytes.Buffer
+		err := watch.Command(&out, &out, "--format=json")
+
+		var report ValidationReport
+		if jerr := json.Unmarshal(out.Bytes(), &report); jerr != nil {
+			// the spec couldn't even be loaded
+			os.Stdout.Write(out.Bytes())
+			return err
+		}
+		var problems []string
+		for _, problem := range report.Problems {
+			problems = append(problems, problem.Group+": "+problem.Message)
+		}
+		watch.PrintDiff(os.Stdout, previous, problems)
+		previous = problems
+		if report.Valid {
+			fmt.Printf("The swagger spec at %q is valid\n", swaggerDoc)
+		} else {
+			fmt.Printf("The swagger spec at %q has %d problems\n", swaggerDoc, len(problems))
+		}
+		return nil
+	})
+}
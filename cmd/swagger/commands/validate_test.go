@@ -0,0 +1,675 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	swaggererrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const groupedSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "grouped", "version": "1.0"},
+  "paths": {
+    "/pets/{id}": {
+      "get": {"operationId": "getPet", "responses": {"200": {"description": "a pet"}}},
+      "delete": {"responses": {"204": {"description": "deleted"}}}
+    }
+  },
+  "definitions": {
+    "Pet": {"type": "object"}
+  }
+}`
+
+func TestGroupProblems(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(groupedSpec), &sw))
+
+	result := swaggererrors.CompositeValidationError(
+		swaggererrors.New(422, "duplicate parameter name %q for %q in operation %q", "id", "path", "getPet"),
+		swaggererrors.Required("paths./pets/{id}.delete.responses", ""),
+		swaggererrors.Required("paths./pets/{id}.parameters", ""),
+		swaggererrors.New(422, "%q is present in required but not defined as property in definition %q", "name", "Pet"),
+		swaggererrors.Required("definitions.Pet.properties", ""),
+		swaggererrors.New(422, "path /pets/{id} overlaps with /pets/{name}"),
+	)
+	problems := groupProblems(&sw, result)
+	require.Len(t, problems, 6)
+	assert.Equal(t, "GET /pets/{id} (getPet)", problems[0].Group)
+	assert.Equal(t, "DELETE /pets/{id}", problems[1].Group)
+	assert.Equal(t, "/pets/{id}", problems[2].Group)
+	assert.Equal(t, "definition Pet", problems[3].Group)
+	assert.Equal(t, "definition Pet", problems[4].Group)
+	assert.Equal(t, specGroup, problems[5].Group)
+}
+
+func TestValidationReport(t *testing.T) {
+	report := ValidationReport{
+		Spec:    "swagger.yml",
+		Version: "2.0",
+		Problems: []Problem{
+			{Group: "definition Pet", Message: "first"},
+			{Group: specGroup, Message: "second"},
+			{Group: "definition Pet", Message: "third"},
+		},
+	}
+	var buf bytes.Buffer
+	report.print(&buf)
+	assert.Equal(t, `The swagger spec at "swagger.yml" is invalid against swagger specification 2.0. see errors :
+spec
+  - second
+definition Pet
+  - first
+  - third
+`, buf.String())
+
+	buf.Reset()
+	report = ValidationReport{Spec: "swagger.yml", Version: "2.0", Valid: true}
+	report.print(&buf)
+	assert.Equal(t, "The swagger spec at \"swagger.yml\" is valid against swagger specification 2.0\n", buf.String())
+
+	buf.Reset()
+	report.Warnings = []Problem{{Group: "GET /pets", Message: "no operationId"}}
+	report.print(&buf)
+	assert.Equal(t, `The swagger spec at "swagger.yml" is valid against swagger specification 2.0
+The code generated from it is affected by these warnings :
+GET /pets
+  - no operationId
+`, buf.String())
+}
+
+func TestOperationIDWarnings(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(groupedSpec), &sw))
+
+	warnings := operationIDWarnings(&sw)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "DELETE /pets/{id}", warnings[0].Group)
+	assert.Contains(t, warnings[0].Message, "has no operationId")
+}
+
+func TestDefinitionNameWarnings(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "names", "version": "1.0"},
+  "paths": {},
+  "definitions": {
+    "user_profile": {"type": "object"},
+    "UserProfile": {"type": "object"}
+  }
+}`), &sw))
+
+	warnings := definitionNameWarnings(&sw)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "definition user_profile", warnings[0].Group)
+	assert.Equal(t, `the definitions "UserProfile" and "user_profile" are both generated as the type UserProfile`, warnings[0].Message)
+}
+
+func TestDeprecatedParameters(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "deprecations", "version": "1.0"},
+  "parameters": {
+    "limit": {"name": "limit", "in": "query", "type": "integer", "x-deprecated": "use pageSize instead"}
+  },
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "listPets",
+        "parameters": [{"$ref": "#/parameters/limit"}, {"name": "pageSize", "in": "query", "type": "integer"}],
+        "responses": {"200": {"description": "the pets"}}
+      }
+    }
+  }
+}`), &sw))
+
+	deprecations := deprecatedParameters(&sw)
+	require.Len(t, deprecations, 1)
+	assert.Equal(t, "GET /pets (listPets)", deprecations[0].Group)
+	assert.Equal(t, `the query parameter "limit" is deprecated: Use pageSize instead.`, deprecations[0].Message)
+
+	// the deprecations only make the spec invalid when asked
+	report := &ValidationReport{Spec: "swagger.json", Version: "2.0", Valid: true, Deprecations: deprecations}
+	assert.NoError(t, (&ValidateSpec{Format: "json"}).report(report))
+	err := (&ValidateSpec{Format: "json", FailOnDeprecated: true}).report(report)
+	assert.IsType(t, &InvalidSpecError{}, err)
+	assert.False(t, report.Valid)
+	assert.Equal(t, deprecations, report.Problems)
+}
+
+func writeCachedSpec(t *testing.T, dir, owner string) string {
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "swagger.json"), []byte(`{
+  "swagger": "2.0",
+  "info": {"title": "cached", "version": "1.0"},
+  "paths": {},
+  "definitions": {"Owner": {"$ref": "owner.json"}}
+}`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "owner.json"), []byte(owner), 0644))
+	return filepath.Join(dir, "swagger.json")
+}
+
+func TestValidationCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "validation-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "b"), 0755))
+
+	first, err := validationKey(writeCachedSpec(t, filepath.Join(dir, "a"), `{"type": "string"}`), false)
+	require.NoError(t, err)
+	// the same documents somewhere else
+	second, err := validationKey(writeCachedSpec(t, filepath.Join(dir, "b"), `{"type": "string"}`), false)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	offline, err := validationKey(filepath.Join(dir, "b", "swagger.json"), true)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, offline)
+
+	// a change in a document the spec refers to
+	changed, err := validationKey(writeCachedSpec(t, filepath.Join(dir, "b"), `{"type": "integer"}`), false)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, changed)
+
+	// remote documents may change without notice
+	writeCachedSpec(t, filepath.Join(dir, "b"), `{"$ref": "http://example.com/owner.json"}`)
+	_, err = newValidationCache(filepath.Join(dir, "cache"), filepath.Join(dir, "b", "swagger.json"), false)
+	assert.Error(t, err)
+
+	cache, err := newValidationCache(filepath.Join(dir, "cache"), filepath.Join(dir, "a", "swagger.json"), false)
+	require.NoError(t, err)
+	_, ok := cache.get()
+	assert.False(t, ok)
+	report := &ValidationReport{Spec: "swagger.json", Version: "2.0", Problems: []Problem{{Group: specGroup, Message: "cached"}}}
+	require.NoError(t, cache.put(report))
+	cached, ok := cache.get()
+	require.True(t, ok)
+	assert.Equal(t, report, cached)
+
+	// a cached report spares the validation
+	cmd := &ValidateSpec{CacheDir: flags.Filename(filepath.Join(dir, "cache")), Format: "json"}
+	err = cmd.Execute([]string{filepath.Join(dir, "a", "swagger.json")})
+	require.Error(t, err)
+	assert.IsType(t, &InvalidSpecError{}, err)
+}
+
+func TestUnsupportedReport(t *testing.T) {
+	for doc, expected := range map[string]string{
+		`{"openapi": "3.0.1", "info": {"title": "openapi", "version": "1.0"}, "paths": {}}`: "3.0.1 (OpenAPI)",
+		`{"swagger": "1.2", "info": {"title": "old", "version": "1.0"}, "paths": {}}`:       "1.2",
+		`{"swaggerVersion": "1.1", "apis": []}`:                                             "1.1",
+	} {
+		specDoc, err := loads.Analyzed(json.RawMessage(doc), "")
+		require.NoError(t, err)
+		err = generator.CheckSpecVersion("swagger.json", specDoc)
+		require.Error(t, err)
+
+		report := unsupportedReport("swagger.json", specDoc, err)
+		assert.Equal(t, expected, report.Version)
+		assert.False(t, report.Valid)
+		assert.Equal(t, []Problem{{Group: specGroup, Message: err.Error()}}, report.Problems)
+	}
+}
+
+func TestOfflineLoader(t *testing.T) {
+	var loaded []string
+	load := offlineLoader(func(pth string) (json.RawMessage, error) {
+		loaded = append(loaded, pth)
+		return json.RawMessage(`{}`), nil
+	})
+
+	_, err := load("https://example.com/definitions.json")
+	assert.EqualError(t, err, `can't load "https://example.com/definitions.json": remote documents are not fetched in offline mode`)
+	_, err = load("definitions.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"definitions.json"}, loaded)
+}
+
+func TestParameterProblems(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "parameters", "version": "1.0"},
+  "paths": {
+    "/pets/{id}": {
+      "parameters": [{"name": "id", "in": "path", "type": "integer"}],
+      "post": {
+        "operationId": "updatePet",
+        "parameters": [
+          {"name": "pet", "in": "body"},
+          {"name": "filter", "in": "query", "schema": {"type": "string"}},
+          {"name": "X-Trace", "in": "header"},
+          {"name": "photo", "in": "query", "type": "file"}
+        ],
+        "responses": {"200": {"description": "updated"}}
+      },
+      "get": {
+        "operationId": "getPet",
+        "parameters": [{"name": "id", "in": "path", "type": "integer", "required": true}],
+        "responses": {"200": {"description": "a pet"}}
+      }
+    }
+  }
+}`), &sw))
+
+	problems := parameterProblems(&sw)
+	var messages []string
+	for _, problem := range problems {
+		assert.Equal(t, "POST /pets/{id} (updatePet)", problem.Group)
+		messages = append(messages, problem.Message)
+	}
+	assert.Equal(t, []string{
+		`body parameter "pet" has no schema`,
+		`header parameter "X-Trace" has no type`,
+		`path parameter "id" isn't required, path parameters always are: add required: true`,
+		`query parameter "filter" has a schema, only body parameters have one: use a type`,
+		`query parameter "photo" has the file type, which only formData parameters may have`,
+	}, messages)
+}
+
+func TestConstraintProblems(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "constraints", "version": "1.0"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "findPets",
+        "parameters": [
+          {"name": "limit", "in": "query", "type": "integer", "minimum": 10, "maximum": 5},
+          {"name": "tags", "in": "query", "type": "array", "minItems": 3, "maxItems": 1,
+           "items": {"type": "string", "minLength": 4, "maxLength": 2}}
+        ],
+        "responses": {
+          "200": {
+            "description": "found",
+            "headers": {"X-Rate": {"type": "number", "minimum": 1, "maximum": 1, "exclusiveMaximum": true}}
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "required": ["name", "owner"],
+      "additionalProperties": false,
+      "properties": {
+        "name": {"type": "string", "enum": []},
+        "kind": {"type": "integer", "enum": [1, 2.5, "cat"]},
+        "born": {"type": "string", "format": "date", "enum": ["2020-01-01", "yesterday"]},
+        "toys": {"type": "array", "items": {"type": "string", "minLength": 5, "maxLength": 1}}
+      }
+    },
+    "Valid": {
+      "type": "object",
+      "required": ["name"],
+      "properties": {"name": {"type": "string", "minLength": 1, "maxLength": 1, "enum": ["a"]}}
+    }
+  }
+}`), &sw))
+
+	byGroup := make(map[string][]string)
+	for _, problem := range constraintProblems(&sw) {
+		byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+	}
+	assert.Equal(t, map[string][]string{
+		"definition Pet": {
+			`the required property "owner" isn't a property, and additionalProperties is false`,
+			`properties.born: the enum value "yesterday" isn't a valid date`,
+			`properties.kind: the enum value 2.5 isn't of type integer`,
+			`properties.kind: the enum value "cat" isn't of type integer`,
+			`properties.name: the enum is empty`,
+			`properties.toys.items: minLength 5 is greater than maxLength 1`,
+		},
+		"GET /pets (findPets)": {
+			`parameter limit: minimum 10 is greater than maximum 5`,
+			`parameter tags: minItems 3 is greater than maxItems 1`,
+			`parameter tags items: minLength 4 is greater than maxLength 2`,
+			`response 200 header X-Rate: minimum and maximum are both 1, one of them exclusive`,
+		},
+	}, byGroup)
+}
+
+func TestFormatProblem(t *testing.T) {
+	for _, check := range []struct {
+		typ, format, message string
+	}{
+		{"integer", "int32", ""},
+		{"integer", "uint64", ""},
+		{"number", "double", ""},
+		{"string", "date-time", ""},
+		{"string", "binary", ""},
+		{"string", "uuid", ""},
+		{"string", "my-custom-format", ""},
+		{"integer", "my-custom-format", ""},
+		{"", "int32", ""},
+		{"integer", "", ""},
+		{"string", "int32", "the format int32 is a format of the integer type, not of string"},
+		{"number", "int64", "the format int64 is a format of the integer type, not of number"},
+		{"integer", "float", "the format float is a format of the number type, not of integer"},
+		{"integer", "date-time", "the format date-time is a format of the string type, not of integer"},
+		{"boolean", "date", "the format date is a format of the string type, not of boolean"},
+		{"array", "email", "the format email is a format of the string type, not of array"},
+	} {
+		assert.Equal(t, check.message, formatProblem(check.typ, check.format), "%s %s", check.typ, check.format)
+	}
+}
+
+func TestConstraintProblems_Formats(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "formats", "version": "1.0"},
+  "parameters": {
+    "since": {"name": "since", "in": "query", "type": "integer", "format": "date-time"}
+  },
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "findPets",
+        "parameters": [
+          {"name": "limit", "in": "query", "type": "string", "format": "int32"},
+          {"name": "ids", "in": "query", "type": "array", "items": {"type": "string", "format": "int64"}},
+          {"name": "filter", "in": "body", "schema": {"type": "object", "properties": {"weight": {"type": "integer", "format": "double"}}}}
+        ],
+        "responses": {
+          "200": {
+            "description": "found",
+            "headers": {"X-Expires": {"type": "number", "format": "date"}}
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "string", "format": "int64"},
+        "born": {"type": "string", "format": "date-time"},
+        "tags": {"type": "array", "items": {"type": "integer", "format": "uuid"}}
+      }
+    },
+    "Dog": {
+      "allOf": [
+        {"$ref": "#/definitions/Pet"},
+        {"type": "object", "properties": {"barks": {"type": "boolean", "format": "int32"}}}
+      ]
+    }
+  }
+}`), &sw))
+
+	byGroup := make(map[string][]string)
+	for _, problem := range constraintProblems(&sw) {
+		byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+	}
+	assert.Equal(t, map[string][]string{
+		"definition Dog": {
+			"allOf.1.properties.barks: the format int32 is a format of the integer type, not of boolean",
+		},
+		"definition Pet": {
+			"properties.id: the format int64 is a format of the integer type, not of string",
+			"properties.tags.items: the format uuid is a format of the string type, not of integer",
+		},
+		"#/parameters/since": {
+			"parameter since: the format date-time is a format of the string type, not of integer",
+		},
+		"GET /pets (findPets)": {
+			"parameter limit: the format int32 is a format of the integer type, not of string",
+			"parameter ids items: the format int64 is a format of the integer type, not of string",
+			"parameter filter.properties.weight: the format double is a format of the number type, not of integer",
+			"response 200 header X-Expires: the format date is a format of the string type, not of number",
+		},
+	}, byGroup)
+}
+
+func TestConstraintProblems_ItemsValues(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "items", "version": "1.0"},
+  "paths": {
+    "/grids": {
+      "get": {
+        "operationId": "findGrids",
+        "parameters": [
+          {
+            "name": "cells", "in": "query", "type": "array", "collectionFormat": "pipes",
+            "items": {
+              "type": "array", "maxItems": 2, "default": [[1], [1], [1]],
+              "items": {
+                "type": "array", "minItems": 1, "example": [],
+                "items": {"type": "integer", "maximum": 9, "default": 10, "enum": [1, 10, 12]}
+              }
+            }
+          },
+          {
+            "name": "valid", "in": "query", "type": "array",
+            "items": {
+              "type": "array", "collectionFormat": "ssv", "default": "1 2", "example": "2",
+              "items": {"type": "integer", "default": 1, "example": 2, "enum": [1, 2]}
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "found",
+            "headers": {
+              "X-Tags": {
+                "type": "array",
+                "items": {"type": "array", "items": {"type": "array", "items": {"type": "string", "enum": ["a"], "default": "b"}}}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`), &sw))
+
+	byGroup := make(map[string][]string)
+	for _, problem := range constraintProblems(&sw) {
+		byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+	}
+	assert.Equal(t, map[string][]string{
+		"GET /grids (findGrids)": {
+			"parameter cells: items.default [[1],[1],[1]] has more than the maxItems 2",
+			"parameter cells: items.items.example [] has less than the minItems 1",
+			"parameter cells: items.items.items.default 10 is greater than the maximum 9",
+			"parameter cells: items.items.items.enum.1 10 is greater than the maximum 9",
+			"parameter cells: items.items.items.enum.2 12 is greater than the maximum 9",
+			`response 200 header X-Tags: items.items.items.default "b" isn't one of the values of the enum`,
+		},
+	}, byGroup)
+}
+
+func TestSecurityProblems(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "security", "version": "1.0"},
+  "security": [{"token": ["read"]}],
+  "securityDefinitions": {
+    "key": {"type": "apiKey"},
+    "token": {"type": "apiKey", "name": "X-Token", "in": "header"},
+    "implicit": {"type": "oauth2", "flow": "implicit", "scopes": {"read": "reads"}},
+    "code": {"type": "oauth2", "flow": "accessCode", "authorizationUrl": "https://example.com/authorize", "scopes": {}},
+    "client": {"type": "oauth2", "flow": "application", "tokenUrl": "https://example.com/token", "scopes": {"read": "reads"}}
+  },
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "findPets",
+        "security": [{"client": ["read"]}, {"basic": []}],
+        "responses": {"200": {"description": "found"}}
+      }
+    }
+  }
+}`), &sw))
+
+	byGroup := make(map[string][]string)
+	for _, problem := range securityProblems(&sw) {
+		byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+	}
+	assert.Equal(t, map[string][]string{
+		"security definition code":     {"the oauth2 accessCode flow has no tokenUrl"},
+		"security definition implicit": {"the oauth2 implicit flow has no authorizationUrl"},
+		"security definition key":      {"the apiKey scheme has no name", "the apiKey scheme must be in a query or in a header"},
+		"spec":                         {`the security requirement "token" has scopes, which only oauth2 schemes have`},
+		"GET /pets (findPets)":         {`the security requirement "basic" isn't a security definition`},
+	}, byGroup)
+}
+
+func TestPathProblems(t *testing.T) {
+	sw := spec.Swagger{SwaggerProps: spec.SwaggerProps{Paths: &spec.Paths{Paths: map[string]spec.PathItem{
+		"/pets/{id}":            {},
+		"/pets/{id":             {},
+		"/pets/id}":             {},
+		"/pets/{{id}}":          {},
+		"/pets/{}":              {},
+		"/pets/{id}/toys/{id}":  {},
+		"/pets?sort=name":       {},
+		"/pets#top":             {},
+		"pets/{id}":             {},
+		"/owners/{owner}.{ext}": {},
+	}}}}
+
+	byGroup := make(map[string][]string)
+	for _, problem := range pathProblems(&sw, pathMatching{}) {
+		byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+	}
+	assert.Equal(t, map[string][]string{
+		"/pets/{id":            {"the brace at 6 opens a parameter which is never closed"},
+		"/pets/id}":            {"the brace at 8 closes no parameter"},
+		"/pets/{{id}}":         {"the brace at 7 opens a parameter in the one opened at 6"},
+		"/pets/{}":             {"the path has a parameter without a name: {}"},
+		"/pets/{id}/toys/{id}": {`the parameter "id" appears more than once in the path`},
+		"/pets?sort=name":      {`the path has a '?', the query strings and the fragments aren't part of a path`},
+		"/pets#top":            {`the path has a '#', the query strings and the fragments aren't part of a path`},
+		"pets/{id}":            {"the path doesn't start with /"},
+	}, byGroup)
+}
+
+func TestPathProblems_Overlaps(t *testing.T) {
+	get := spec.PathItem{PathItemProps: spec.PathItemProps{Get: &spec.Operation{}}}
+	getPost := spec.PathItem{PathItemProps: spec.PathItemProps{Get: &spec.Operation{}, Post: &spec.Operation{}}}
+	post := spec.PathItem{PathItemProps: spec.PathItemProps{Post: &spec.Operation{}}}
+	sw := spec.Swagger{SwaggerProps: spec.SwaggerProps{Paths: &spec.Paths{Paths: map[string]spec.PathItem{
+		"/":                    get,
+		"/pets":                getPost,
+		"/pets/":               get,
+		"/pets/{id}":           get,
+		"/pets/{petId}/":       getPost,
+		"/Pets/{name}/toys":    get,
+		"/pets/{id}/toys":      post,
+		"/owners/{id}":         get,
+		"/owners/{ownerId}/":   post,
+		"/stores/{id}/orders":  get,
+		"/stores/{id}/Orders":  get,
+		"/stores/{id}/orders/": get,
+	}}}}
+
+	for _, check := range []struct {
+		matching pathMatching
+		problems map[string][]string
+	}{
+		{pathMatching{}, map[string][]string{
+			"/pets/":              {"the path overlaps with /pets for GET: the router can't tell them apart"},
+			"/pets/{petId}/":      {"the path overlaps with /pets/{id} for GET: the router can't tell them apart"},
+			"/stores/{id}/orders": {"the path overlaps with /stores/{id}/Orders for GET: the router can't tell them apart"},
+			"/stores/{id}/orders/": {
+				"the path overlaps with /stores/{id}/Orders for GET: the router can't tell them apart",
+				"the path overlaps with /stores/{id}/orders for GET: the router can't tell them apart",
+			},
+		}},
+		{pathMatching{strictSlash: true}, map[string][]string{
+			"/stores/{id}/orders": {"the path overlaps with /stores/{id}/Orders for GET: the router can't tell them apart"},
+		}},
+		{pathMatching{caseSensitive: true}, map[string][]string{
+			"/pets/":               {"the path overlaps with /pets for GET: the router can't tell them apart"},
+			"/pets/{petId}/":       {"the path overlaps with /pets/{id} for GET: the router can't tell them apart"},
+			"/stores/{id}/orders/": {"the path overlaps with /stores/{id}/orders for GET: the router can't tell them apart"},
+		}},
+		{pathMatching{strictSlash: true, caseSensitive: true}, map[string][]string{}},
+	} {
+		byGroup := make(map[string][]string)
+		for _, problem := range pathProblems(&sw, check.matching) {
+			byGroup[problem.Group] = append(byGroup[problem.Group], problem.Message)
+		}
+		assert.Equal(t, check.problems, byGroup, "%+v", check.matching)
+	}
+
+	// the paths which only differ by the names of their parameters overlap whatever the matching
+	sw.Paths.Paths = map[string]spec.PathItem{"/pets/{id}": getPost, "/pets/{name}": post}
+	problems := pathProblems(&sw, pathMatching{strictSlash: true, caseSensitive: true})
+	assert.Equal(t, []Problem{{Group: "/pets/{name}", Message: "the path overlaps with /pets/{id} for POST: the router can't tell them apart"}}, problems)
+}
+
+func TestConsumesProblems(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "consumes", "version": "1.0"},
+  "paths": {
+    "/pets": {
+      "post": {
+        "operationId": "addPet",
+        "parameters": [{"name": "name", "in": "formData", "type": "string"}],
+        "responses": {"201": {"description": "added"}}
+      }
+    }
+  }
+}`), &sw))
+
+	problems := consumesProblems(&sw)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "POST /pets (addPet)", problems[0].Group)
+	assert.Equal(t, "the operation has formData parameters (name), but consumes neither application/x-www-form-urlencoded nor multipart/form-data", problems[0].Message)
+}
+
+func TestDependencyProblems(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "dependencies", "version": "1.0"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "findPets",
+        "x-requires": ["id", "name"],
+        "parameters": [{"name": "id", "in": "query", "type": "integer"}],
+        "responses": {"200": {"description": "found"}}
+      }
+    }
+  }
+}`), &sw))
+
+	problems := dependencyProblems(&sw)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "GET /pets (findPets)", problems[0].Group)
+	assert.Equal(t, `x-requires names the parameter "name", which the operation doesn't have`, problems[0].Message)
+}
+
+func TestDuplicateSchemaWarnings(t *testing.T) {
+	specDoc, err := loads.Spec("../../../fixtures/codegen/duplicates.yml")
+	require.NoError(t, err)
+
+	warnings := duplicateSchemaWarnings(specDoc.Spec())
+	require.Len(t, warnings, 3)
+	assert.Equal(t, "definition NewPet", warnings[2].Group)
+	assert.Equal(t, `the inline schemas of POST /pets (addPet) body are identical to the definition "NewPet": use a $ref to it`, warnings[2].Message)
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/watch"
+)
+
+// validationCache keeps the validation reports in a directory, by the hash of what decides them:
+// the content of the spec and of the local documents it refers to, and the validator
+type validationCache struct {
+	dir string
+	key string
+}
+
+// newValidationCache finds the entry of the cache for the validation of a spec.
+// A spec which refers to remote documents isn't cached, since they may change without notice.
+func newValidationCache(dir, location string, offline bool) (*validationCache, error) {
+	key, err := validationKey(location, offline)
+	if err != nil {
+		return nil, err
+	}
+	return &validationCache{dir: dir, key: key}, nil
+}
+
+func validationKey(location string, offline bool) (string, error) {
+	documents, remote, err := watch.Documents(location)
+	if err != nil {
+		return "", err
+	}
+	if remote {
+		return "", errors.New("the spec refers to remote documents")
+	}
+	version, err := validatorVersion()
+	if err != nil {
+		return "", err
+	}
+
+	root, err := filepath.Abs(location)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "validator %s\noffline %t\n", version, offline)
+	for _, document := range documents {
+		b, err := ioutil.ReadFile(document)
+		if err != nil {
+			return "", err
+		}
+		// the documents are known by their path relative to the spec, so the key doesn't depend on the checkout
+		rel, err := filepath.Rel(filepath.Dir(root), document)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(hash, "%s %d\n", filepath.ToSlash(rel), len(b))
+		hash.Write(b)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (c *validationCache) get() (*ValidationReport, bool) {
+	b, err := ioutil.ReadFile(filepath.Join(c.dir, c.key+".json"))
+	if err != nil {
+		return nil, false
+	}
+	var report ValidationReport
+	if err := json.Unmarshal(b, &report); err != nil {
+		return nil, false
+	}
+	return &report, true
+}
+
+func (c *validationCache) put(report *ValidationReport) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	b, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	// several validations may run at once, a report is never read half written
+	tmp, err := ioutil.TempFile(c.dir, c.key)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(c.dir, c.key+".json"))
+}
+
+// validatorVersion identifies the validator: the version of a released swagger command,
+// or the hash of the binary for a development build
+func validatorVersion() (string, error) {
+	if Commit != "" {
+		return Version + "+" + Commit, nil
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(executable)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return "dev+" + hex.EncodeToString(hash.Sum(nil)), nil
+}
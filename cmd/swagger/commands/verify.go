@@ -0,0 +1,278 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/loads"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/record"
+)
+
+// VerifyCmd is a command that replays requests against a server, and verifies its responses against a spec
+type VerifyCmd struct {
+	Spec    flags.Filename `long:"spec" short:"f" required:"true" description:"the spec the server implements"`
+	Target  string         `long:"target" short:"t" required:"true" description:"the url of the server, the base path of the spec is added to it"`
+	HAR     flags.Filename `long:"har" description:"replay the requests of a HAR file, instead of a request generated for each operation of the spec"`
+	HARHost string         `long:"har-host" description:"the host of the requests of the HAR file to replay (default: the host with the most requests)"`
+	Methods []string       `long:"method" description:"the method of the operations to generate requests for, repeat for multiple (default: GET, HEAD and OPTIONS)"`
+	Headers []string       `long:"header" short:"H" description:"a header added to the requests, like 'Authorization: Bearer token', repeat for multiple"`
+	Timeout time.Duration  `long:"timeout" default:"30s" description:"the timeout of a request"`
+	JUnit   flags.Filename `long:"junit" description:"the file to write a junit report of the requests to"`
+}
+
+// ContractError is returned when the responses of a server don't match its spec, once they are reported.
+// The swagger command exits with 1 for it.
+type ContractError struct {
+	Target   string
+	Failures int
+	Requests int
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("%d of the %d requests to %s don't match the spec", e.Failures, e.Requests, e.Target)
+}
+
+// Execute replays the requests
+func (c *VerifyCmd) Execute(args []string) error {
+	target, err := url.Parse(c.Target)
+	if err != nil || target.Host == "" {
+		return fmt.Errorf("the target %q isn't the url of a server", c.Target)
+	}
+	specDoc, err := loads.Spec(string(c.Spec))
+	if err != nil {
+		return err
+	}
+	verifier, err := record.NewVerifier(specDoc)
+	if err != nil {
+		return err
+	}
+
+	var requests []*http.Request
+	if c.HAR != "" {
+		requests, err = harRequests(string(c.HAR), c.HARHost, target)
+	} else {
+		methods := c.Methods
+		if len(methods) == 0 {
+			methods = record.SafeMethods
+		}
+		for i := range methods {
+			methods[i] = strings.ToUpper(methods[i])
+		}
+		requests, err = record.SampleRequests(specDoc, target, methods)
+	}
+	if err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		return errors.New("there is no request to replay")
+	}
+	for _, header := range c.Headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("the header %q isn't a 'Name: value' header", header)
+		}
+		for _, req := range requests {
+			req.Header.Set(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+		}
+	}
+
+	client := &http.Client{Timeout: c.Timeout}
+	report := verifyRequests(client, verifier, requests)
+	report.print(os.Stdout)
+	if c.JUnit != "" {
+		if err := report.writeJUnit(string(c.JUnit)); err != nil {
+			return err
+		}
+	}
+	if failures := report.failures(); failures > 0 {
+		return &ContractError{Target: c.Target, Failures: failures, Requests: len(report.Results)}
+	}
+	return nil
+}
+
+// the headers a replayed request doesn't keep, the client sets them
+var skippedHeaders = map[string]bool{
+	"Host":              true,
+	"Content-Length":    true,
+	"Connection":        true,
+	"Accept-Encoding":   true,
+	"Transfer-Encoding": true,
+}
+
+// harRequests builds the requests of a HAR file to a host as requests to the target,
+// the ones for pages and their assets are skipped
+func harRequests(path, host string, target *url.URL) ([]*http.Request, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var har harLog
+	if err := json.Unmarshal(b, &har); err != nil {
+		return nil, fmt.Errorf("%s is not a HAR file: %v", path, err)
+	}
+
+	if host == "" {
+		host = mostRequestedHost(har.Log.Entries)
+	}
+	var requests []*http.Request
+	for _, entry := range har.Log.Entries {
+		u, err := url.Parse(entry.Request.URL)
+		if err != nil {
+			return nil, err
+		}
+		if u.Host != host || harAssets.MatchString(mediaType(entry.Response.Content.MimeType)) {
+			continue
+		}
+		u.Scheme, u.Host = target.Scheme, target.Host
+
+		var body io.Reader
+		if data := entry.Request.PostData; data != nil && data.Text != "" {
+			body = bytes.NewReader([]byte(data.Text))
+		}
+		req, err := http.NewRequest(entry.Request.Method, u.String(), body)
+		if err != nil {
+			return nil, err
+		}
+		for _, header := range entry.Request.Headers {
+			if !skippedHeaders[http.CanonicalHeaderKey(header.Name)] && !strings.HasPrefix(header.Name, ":") {
+				req.Header.Add(header.Name, header.Value)
+			}
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+// VerifyReport is the result of the requests replayed against a server
+type VerifyReport struct {
+	Results []VerifyResult
+	Time    time.Duration
+}
+
+// VerifyResult is a replayed request, with the operation it is a call to and how its response doesn't match the spec
+type VerifyResult struct {
+	Request    string
+	Operation  string
+	Time       time.Duration
+	Mismatches []string
+	Err        error
+}
+
+func verifyRequests(client *http.Client, verifier *record.Verifier, requests []*http.Request) *VerifyReport {
+	report := &VerifyReport{}
+	start := time.Now()
+	for _, req := range requests {
+		result := VerifyResult{Request: req.Method + " " + req.URL.RequestURI()}
+		sent := time.Now()
+		exchange, err := record.Replay(client, req)
+		result.Time = time.Since(sent)
+		if err != nil {
+			result.Err = err
+		} else {
+			result.Operation = verifier.Operation(exchange)
+			result.Mismatches = verifier.Verify(exchange)
+		}
+		report.Results = append(report.Results, result)
+	}
+	report.Time = time.Since(start)
+	return report
+}
+
+func (r *VerifyReport) failures() int {
+	failures := 0
+	for _, result := range r.Results {
+		if result.Err != nil || len(result.Mismatches) > 0 {
+			failures++
+		}
+	}
+	return failures
+}
+
+func (r *VerifyReport) print(w io.Writer) {
+	for _, result := range r.Results {
+		status := "ok  "
+		if result.Err != nil || len(result.Mismatches) > 0 {
+			status = "FAIL"
+		}
+		if result.Operation != "" {
+			fmt.Fprintf(w, "%s %s (%s)\n", status, result.Request, result.Operation)
+		} else {
+			fmt.Fprintf(w, "%s %s\n", status, result.Request)
+		}
+		if result.Err != nil {
+			fmt.Fprintf(w, "  - %v\n", result.Err)
+		}
+		for _, mismatch := range result.Mismatches {
+			fmt.Fprintf(w, "  - %s\n", mismatch)
+		}
+	}
+	fmt.Fprintf(w, "%d of the %d requests don't match the spec\n", r.failures(), len(r.Results))
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit writes the report as a junit test suite, with a test case for each request named after its operation
+func (r *VerifyReport) writeJUnit(path string) error {
+	suite := junitSuite{Name: "swagger verify", Tests: len(r.Results), Time: seconds(r.Time)}
+	for _, result := range r.Results {
+		testCase := junitCase{Name: result.Request, Classname: result.Operation, Time: seconds(result.Time)}
+		if testCase.Classname == "" {
+			testCase.Classname = "unmatched"
+		}
+		switch {
+		case result.Err != nil:
+			suite.Errors++
+			testCase.Error = &junitFailure{Message: result.Err.Error()}
+		case len(result.Mismatches) > 0:
+			suite.Failures++
+			testCase.Failure = &junitFailure{Message: result.Mismatches[0], Text: strings.Join(result.Mismatches, "\n")}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	b, err := xml.MarshalIndent(junitSuites{Suites: []junitSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append([]byte(xml.Header), b...), 0644)
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const verifiedSpec = `swagger: "2.0"
+info:
+  title: verified
+  version: "1.0"
+basePath: /api
+produces:
+  - application/json
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: the pets
+          schema:
+            type: array
+            items:
+              type: string
+  /pets/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          type: integer
+          required: true
+      responses:
+        200:
+          description: a pet
+          schema:
+            type: string
+`
+
+func TestVerifyCmd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/pets":
+			rw.Write([]byte(`["rex"]`))
+		case "/api/pets/1":
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	specPath := filepath.Join(dir, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(verifiedSpec), 0644))
+	junitPath := filepath.Join(dir, "junit.xml")
+
+	cmd := &VerifyCmd{
+		Spec:   flags.Filename(specPath),
+		Target: server.URL,
+		JUnit:  flags.Filename(junitPath),
+	}
+	err = cmd.Execute(nil)
+	require.Error(t, err)
+	contractErr, ok := err.(*ContractError)
+	require.True(t, ok)
+	assert.Equal(t, 1, contractErr.Failures)
+	assert.Equal(t, 2, contractErr.Requests)
+
+	b, err := ioutil.ReadFile(junitPath)
+	require.NoError(t, err)
+	var report junitSuites
+	require.NoError(t, xml.Unmarshal(b, &report))
+	require.Len(t, report.Suites, 1)
+	suite := report.Suites[0]
+	assert.Equal(t, 2, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	require.Len(t, suite.Cases, 2)
+	assert.Equal(t, "GET /pets", suite.Cases[0].Classname)
+	assert.Nil(t, suite.Cases[0].Failure)
+	assert.Equal(t, "GET /pets/{id}", suite.Cases[1].Classname)
+	assert.Equal(t, "GET /api/pets/1", suite.Cases[1].Name)
+	require.NotNil(t, suite.Cases[1].Failure)
+	assert.Equal(t, "the 404 response isn't in the spec", suite.Cases[1].Failure.Message)
+}
+
+func TestHARRequests(t *testing.T) {
+	target, err := url.Parse("http://localhost:8080")
+	require.NoError(t, err)
+	requests, err := harRequests("../../../fixtures/infer/petstore.har", "", target)
+	require.NoError(t, err)
+	// the page of the host, its script and the analytics aren't calls to the api
+	require.Len(t, requests, 8)
+	assert.Equal(t, "http://localhost:8080/api/pets?limit=10&status=available", requests[0].URL.String())
+	assert.Equal(t, "POST", requests[5].Method)
+	assert.NotNil(t, requests[5].Body)
+}
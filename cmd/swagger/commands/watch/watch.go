@@ -0,0 +1,379 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch runs a command again each time a spec, or one of the documents it refers to, changes
+package watch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-openapi/swag"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Debounce is how long to wait for the changes to settle before running again:
+// editors usually emit several events for a single save
+var Debounce = 200 * time.Millisecond
+
+const (
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorReset  = "\x1b[0m"
+)
+
+// Run runs a command, then runs it again each time the spec at location, or one of the local documents it refers to,
+// changes. It stops when the process is interrupted.
+func Run(location string, run func() error) error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	stop := make(chan struct{})
+	go func() {
+		<-interrupt
+		close(stop)
+	}()
+	return watch(location, run, stop)
+}
+
+func watch(location string, run func() error, stop <-chan struct{}) error {
+	if location == "" {
+		for _, name := range []string{"swagger.json", "swagger.yml", "swagger.yaml"} {
+			if _, err := os.Stat(name); err == nil {
+				location = name
+				break
+			}
+		}
+		if location == "" {
+			return errors.New("couldn't find a swagger spec to watch")
+		}
+	}
+	if isRemote(location) {
+		return fmt.Errorf("can't watch %q: only local documents can be watched", location)
+	}
+	root, err := filepath.Abs(location)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	// the directories are watched rather than the files, because a lot of editors save a file by replacing it
+	dirs := make(map[string]bool)
+	var files map[string]bool
+	runOnce := func() {
+		if err := safeRun(run); err != nil {
+			log.Println(err)
+		}
+		// the refs may have changed with the spec
+		found, err := referencedFiles(root)
+		if err != nil {
+			log.Printf("following the refs of %q failed: %v", location, err)
+			// the documents which can't be read are still watched, until they are fixed
+			for file := range files {
+				found[file] = true
+			}
+		}
+		found[root] = true
+		files = found
+		for file := range files {
+			dir := filepath.Dir(file)
+			if dirs[dir] {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				log.Printf("watching %s failed: %v", dir, err)
+				continue
+			}
+			dirs[dir] = true
+		}
+		log.Printf("watching %d documents for changes", len(files))
+	}
+
+	runOnce()
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return nil
+		case evt, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if files[filepath.Clean(evt.Name)] && evt.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				debounce = time.After(Debounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Println("watching the spec failed:", err)
+		case <-debounce:
+			debounce = nil
+			runOnce()
+		}
+	}
+}
+
+// safeRun runs a command, turning its panics into errors: a spec in the middle of an edit must not stop the watch
+func safeRun(run func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return run()
+}
+
+// Command runs the current command line again in a new process, without --watch and with some extra arguments.
+//
+// Each run gets a fresh process, so that the documents cached by a previous run are read again
+// and a fatal error doesn't end the watch.
+func Command(stdout, stderr io.Writer, extra ...string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	var args []string
+	for _, arg := range os.Args[1:] {
+		if arg == "--watch" {
+			continue
+		}
+		args = append(args, arg)
+	}
+	args = append(args, extra...)
+	cmd := exec.Command(executable, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
+
+func isRemote(pth string) bool {
+	return strings.HasPrefix(pth, "http://") || strings.HasPrefix(pth, "https://")
+}
+
+// referencedFiles returns the absolute paths of a document and of the local documents it refers to, directly or not
+func referencedFiles(root string) (map[string]bool, error) {
+	files := make(map[string]bool)
+	_, err := walkDocuments(root, files)
+	return files, err
+}
+
+// Documents returns the absolute paths of a local document and of the local documents it refers to, directly or not,
+// and tells if one of them refers to a remote document
+func Documents(location string) ([]string, bool, error) {
+	root, err := filepath.Abs(location)
+	if err != nil {
+		return nil, false, err
+	}
+	files := make(map[string]bool)
+	remote, err := walkDocuments(root, files)
+	if err != nil {
+		return nil, false, err
+	}
+	documents := make([]string, 0, len(files))
+	for file := range files {
+		documents = append(documents, file)
+	}
+	sort.Strings(documents)
+	return documents, remote, nil
+}
+
+func walkDocuments(root string, files map[string]bool) (bool, error) {
+	var remote bool
+	pending := []string{root}
+	for len(pending) > 0 {
+		file := pending[0]
+		pending = pending[1:]
+		if files[file] {
+			continue
+		}
+		files[file] = true
+
+		doc, err := loadDocument(file)
+		if err != nil {
+			return remote, err
+		}
+		for _, ref := range collectRefs(doc) {
+			pth := strings.SplitN(ref, "#", 2)[0]
+			if pth == "" {
+				continue
+			}
+			if isRemote(pth) {
+				remote = true
+				continue
+			}
+			pth = filepath.FromSlash(strings.TrimPrefix(pth, "file://"))
+			if !filepath.IsAbs(pth) {
+				pth = filepath.Join(filepath.Dir(file), pth)
+			}
+			pending = append(pending, filepath.Clean(pth))
+		}
+	}
+	return remote, nil
+}
+
+func loadDocument(file string) (interface{}, error) {
+	var b []byte
+	var err error
+	if swag.YAMLMatcher(file) {
+		b, err = swag.YAMLDoc(file)
+	} else {
+		b, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	return doc, nil
+}
+
+func collectRefs(node interface{}) []string {
+	var refs []string
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if ref, ok := v.(string); ok && k == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, collectRefs(v)...)
+		}
+	case []interface{}:
+		for _, v := range n {
+			refs = append(refs, collectRefs(v)...)
+		}
+	}
+	return refs
+}
+
+// Snapshot hashes the go files under a directory, by their slash separated path relative to it.
+// The vendor and hidden directories are skipped.
+func Snapshot(dir string) (map[string]string, error) {
+	snapshot := make(map[string]string)
+	err := filepath.Walk(dir, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			if pth != dir && (info.Name() == "vendor" || strings.HasPrefix(info.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(pth) != ".go" {
+			return nil
+		}
+		b, err := ioutil.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, pth)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(b)
+		snapshot[filepath.ToSlash(rel)] = hex.EncodeToString(sum[:])
+		return nil
+	})
+	return snapshot, err
+}
+
+// PrintChanges prints the files which were created (+), modified (~) or removed (-) between two snapshots
+func PrintChanges(w io.Writer, before, after map[string]string) {
+	changes := make(map[string]string)
+	for file, sum := range after {
+		previous, ok := before[file]
+		switch {
+		case !ok:
+			changes[file] = colorize(w, colorGreen, "+ "+file)
+		case previous != sum:
+			changes[file] = colorize(w, colorYellow, "~ "+file)
+		}
+	}
+	for file := range before {
+		if _, ok := after[file]; !ok {
+			changes[file] = colorize(w, colorRed, "- "+file)
+		}
+	}
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "no generated file changed")
+		return
+	}
+
+	files := make([]string, 0, len(changes))
+	for file := range changes {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		fmt.Fprintln(w, changes[file])
+	}
+}
+
+// PrintDiff prints the lines which appeared (+) and the ones which disappeared (-) between two runs
+func PrintDiff(w io.Writer, before, after []string) {
+	seen := make(map[string]bool, len(before))
+	for _, line := range before {
+		seen[line] = true
+	}
+	kept := make(map[string]bool, len(after))
+	for _, line := range after {
+		kept[line] = true
+		if !seen[line] {
+			fmt.Fprintln(w, colorize(w, colorRed, "+ "+line))
+		}
+	}
+	for _, line := range before {
+		if !kept[line] {
+			fmt.Fprintln(w, colorize(w, colorGreen, "- "+line))
+		}
+	}
+}
+
+// colorize colors a line when it's written to a terminal, unless NO_COLOR is set
+func colorize(w io.Writer, color, line string) string {
+	f, ok := w.(*os.File)
+	if !ok || !terminal.IsTerminal(int(f.Fd())) || os.Getenv("NO_COLOR") != "" {
+		return line
+	}
+	return color + line + colorReset
+}
@@ -0,0 +1,159 @@
+package watch
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watchedSpec = `swagger: "2.0"
+info:
+  title: watched
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: pets
+          schema:
+            $ref: "./definitions/pet.yml#/Pet"
+definitions:
+  Error:
+    $ref: "#/definitions/Other"
+  Remote:
+    $ref: "http://example.com/schema.json"
+`
+
+func writeWatchedSpec(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "watch")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "definitions"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "swagger.yml"), []byte(watchedSpec), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "definitions", "pet.yml"), []byte(`Pet:
+  type: object
+  properties:
+    owner:
+      $ref: "owner.json"
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "definitions", "owner.json"), []byte(`{"type": "string"}`), 0644))
+	return dir
+}
+
+func TestReferencedFiles(t *testing.T) {
+	dir := writeWatchedSpec(t)
+	defer os.RemoveAll(dir)
+
+	files, err := referencedFiles(filepath.Join(dir, "swagger.yml"))
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+	assert.True(t, files[filepath.Join(dir, "swagger.yml")])
+	assert.True(t, files[filepath.Join(dir, "definitions", "pet.yml")])
+	assert.True(t, files[filepath.Join(dir, "definitions", "owner.json")])
+
+	// a broken ref still reports what was found so far
+	require.NoError(t, os.Remove(filepath.Join(dir, "definitions", "owner.json")))
+	files, err = referencedFiles(filepath.Join(dir, "swagger.yml"))
+	assert.Error(t, err)
+	assert.True(t, files[filepath.Join(dir, "definitions", "pet.yml")])
+}
+
+func TestWatch(t *testing.T) {
+	dir := writeWatchedSpec(t)
+	defer os.RemoveAll(dir)
+	Debounce = 10 * time.Millisecond
+
+	var runs int32
+	ran := make(chan struct{}, 10)
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- watch(filepath.Join(dir, "swagger.yml"), func() error {
+			atomic.AddInt32(&runs, 1)
+			ran <- struct{}{}
+			panic("a spec in the middle of an edit")
+		}, stop)
+	}()
+
+	waitRun := func() {
+		select {
+		case <-ran:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the command wasn't run")
+		}
+	}
+	waitRun()
+
+	// let the watch start before changing a referenced document
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "definitions", "owner.json"), []byte(`{"type": "integer"}`), 0644))
+	waitRun()
+
+	// documents which aren't referenced don't trigger a run
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "definitions", "unrelated.json"), []byte(`{}`), 0644))
+	time.Sleep(100 * time.Millisecond)
+
+	close(stop)
+	require.NoError(t, <-done)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&runs))
+}
+
+func TestPrintChanges(t *testing.T) {
+	var buf bytes.Buffer
+	PrintChanges(&buf,
+		map[string]string{"models/pet.go": "1", "restapi/get_pet.go": "2", "restapi/server.go": "3"},
+		map[string]string{"models/pet.go": "1", "restapi/fetch_pet.go": "2", "restapi/server.go": "4"},
+	)
+	assert.Equal(t, "+ restapi/fetch_pet.go\n- restapi/get_pet.go\n~ restapi/server.go\n", buf.String())
+
+	buf.Reset()
+	PrintChanges(&buf, map[string]string{"models/pet.go": "1"}, map[string]string{"models/pet.go": "1"})
+	assert.Equal(t, "no generated file changed\n", buf.String())
+}
+
+func TestPrintDiff(t *testing.T) {
+	var buf bytes.Buffer
+	PrintDiff(&buf, []string{"fixed", "kept"}, []string{"kept", "new"})
+	assert.Equal(t, "+ new\n- fixed\n", buf.String())
+}
+
+func TestSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "models"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "vendor", "lib"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "models", "pet.go"), []byte("package models\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "vendor", "lib", "lib.go"), []byte("package lib\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "swagger.yml"), []byte(watchedSpec), 0644))
+
+	snapshot, err := Snapshot(dir)
+	require.NoError(t, err)
+	assert.Len(t, snapshot, 1)
+	assert.Contains(t, snapshot, "models/pet.go")
+
+	snapshot, err = Snapshot(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, snapshot)
+}
+
+func TestReferencedFiles_MultiFile(t *testing.T) {
+	root, err := filepath.Abs("../../../../fixtures/codegen/multi-file/swagger.yml")
+	require.NoError(t, err)
+
+	// the refs of the included documents are relative to them
+	files, err := referencedFiles(root)
+	require.NoError(t, err)
+	assert.Len(t, files, 9)
+	assert.True(t, files[filepath.Join(filepath.Dir(root), "responses", "not-found.yml")])
+	assert.True(t, files[filepath.Join(filepath.Dir(root), "definitions", "vaccine.yml")])
+}
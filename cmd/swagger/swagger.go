@@ -15,25 +15,47 @@
 package main
 
 import (
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/loads/fmts"
 	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands"
+	"github.com/sidewalklabs/go-swagger/cmd/swagger/commands/importcmd"
+	"github.com/sidewalklabs/go-swagger/generator"
 	"github.com/jessevdk/go-flags"
 )
 
 func init() {
 	loads.AddLoader(fmts.YAMLMatcher, fmts.YAMLDoc)
+	// OpenAPI 3.0 specs are converted to swagger 2.0 as they are loaded, for all the commands
+	loads.AddLoader(func(string) bool { return true }, importcmd.LoadDocument)
 }
 
 var opts struct {
 	// Version bool `long:"version" short:"v" description:"print the version of the command"`
+	Quiet bool `long:"quiet" short:"q" description:"print nothing, only exit with a status: 1 when the spec is invalid or not canonical, a server doesn't match it or doesn't cover enough of it, 2 when the command failed"`
+
+	Remote commands.RemoteOptions `group:"Remote documents"`
 }
 
 func main() {
-	parser := flags.NewParser(&opts, flags.Default)
+	// the errors are printed here, so they can be silenced
+	parser := flags.NewParser(&opts, flags.HelpFlag|flags.PassDoubleDash)
+	parser.CommandHandler = func(cmd flags.Commander, args []string) error {
+		if opts.Quiet {
+			quiet()
+		}
+		if err := opts.Remote.Install(); err != nil {
+			return err
+		}
+		if cmd == nil {
+			return nil
+		}
+		return cmd.Execute(args)
+	}
 	parser.ShortDescription = "helps you keep your API well described"
 	parser.LongDescription = `
 Swagger tries to support you as best as possible when building APIs.
@@ -50,6 +72,27 @@ It aims to represent the contract of your API with a language agnostic descripti
 		log.Fatal(err)
 	}
 
+	imppar, err := parser.AddCommand("import", "import other formats into a spec document", "import other formats, like json schemas, into a swagger spec document", &commands.ImportCmd{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, cmd := range imppar.Commands() {
+		switch cmd.Name {
+		case "schema":
+			cmd.ShortDescription = "import a directory of json schemas as the definitions of a new spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "openapi3":
+			cmd.ShortDescription = "convert an OpenAPI 3.0 spec to a swagger 2.0 spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "raml":
+			cmd.ShortDescription = "import a RAML 0.8 or 1.0 api as a new spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "apib":
+			cmd.ShortDescription = "import an API Blueprint document as a new spec"
+			cmd.LongDescription = cmd.ShortDescription
+		}
+	}
+
 	_, err = parser.AddCommand("version", "print the version", "print the version of the swagger command", &commands.PrintVersion{})
 	if err != nil {
 		log.Fatal(err)
@@ -70,11 +113,56 @@ It aims to represent the contract of your API with a language agnostic descripti
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("trim", "trims a swagger document to some operations", "keep the selected operations of a spec, with the definitions, parameters and responses they use", &commands.TrimSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("canonicalize", "rewrite a swagger document in its canonical form", "rewrite a swagger spec with its keys sorted, the parameters and responses used once inlined and its numbers written the same way, so that its diffs only show the changes of the api", &commands.CanonicalizeSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("convert", "convert a swagger document to another specification", "convert a swagger spec to an OpenAPI 3.0 spec, with its components, request bodies and content maps", &commands.ConvertSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("infer", "infer a swagger document from recorded http traffic", "infer the paths, parameters and response schemas of an api from the requests of a HAR file, as a starting spec to refine", &commands.InferSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("record", "record the traffic of a service", "proxy a service to infer a swagger document from its traffic, or to verify its traffic against a swagger document", &commands.RecordCmd{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("verify", "verify a server against its swagger document", "replay requests generated from a swagger document, or recorded in a HAR file, against a server and verify its responses against the document", &commands.VerifyCmd{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("coverage", "report the coverage of a swagger document by the traffic of a server", "report the operations and responses of a swagger document which weren't exercised, and the status codes it doesn't document, from coverage profiles or HAR files", &commands.CoverageCmd{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	_, err = parser.AddCommand("mixin", "merge swagger documents", "merge additional specs into first/primary spec by copying their paths and definitions", &commands.MixinSpec{})
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("stats", "report statistics about a swagger document", "report the size and the complexity of a swagger document: paths, operations, parameters, models and an estimate of the generated code", &commands.StatsSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("lsp", "run a language server for swagger documents", "run a language server over stdio, providing diagnostics, go to definition for $refs, hovers and completion to editors", &commands.LSPCmd{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	genpar, err := parser.AddCommand("generate", "genererate go code", "generate go code for the swagger spec file", &commands.Generate{})
 	if err != nil {
 		log.Fatalln(err)
@@ -87,6 +175,24 @@ It aims to represent the contract of your API with a language agnostic descripti
 		case "client":
 			cmd.ShortDescription = "generate all the files for a client library"
 			cmd.LongDescription = cmd.ShortDescription
+		case "cli":
+			cmd.ShortDescription = "generate a command line tool and the client library it uses"
+			cmd.LongDescription = cmd.ShortDescription
+		case "markdown":
+			cmd.ShortDescription = "generate the reference documentation of the swagger spec as markdown"
+			cmd.LongDescription = cmd.ShortDescription
+		case "html":
+			cmd.ShortDescription = "generate the reference documentation of the swagger spec as an HTML page"
+			cmd.LongDescription = cmd.ShortDescription
+		case "schema":
+			cmd.ShortDescription = "generate standalone json schemas for the definitions of the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "proto":
+			cmd.ShortDescription = "generate the proto3 messages and gRPC service of the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "examples":
+			cmd.ShortDescription = "fill the missing examples of the schemas and responses of the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
 		case "server":
 			cmd.ShortDescription = "generate all the files for a server application"
 			cmd.LongDescription = cmd.ShortDescription
@@ -103,6 +209,32 @@ It aims to represent the contract of your API with a language agnostic descripti
 	}
 
 	if _, err := parser.Parse(); err != nil {
-		os.Exit(1)
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			fmt.Println(err)
+			return
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode tells an invalid spec or a server not matching its spec (1) from a failure of the command (2), for the scripts running it
+func exitCode(err error) int {
+	switch err.(type) {
+	case *commands.InvalidSpecError, *commands.ContractError, *commands.CoverageError, *commands.NotCanonicalError, *generator.SpecValidationError, *generator.DefinitionNamesError, *generator.CompileCheckError, *generator.UnsupportedVersionError:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// quiet discards everything the command prints
+func quiet() {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return
 	}
+	os.Stdout = devNull
+	os.Stderr = devNull
+	log.SetOutput(ioutil.Discard)
 }
@@ -1,27 +1,39 @@
 // Code generated by go-bindata.
 // sources:
 // templates/additionalpropertiesserializer.gotmpl
+// templates/cli/main.gotmpl
+// templates/client/cache.gotmpl
 // templates/client/client.gotmpl
 // templates/client/facade.gotmpl
+// templates/client/mock.gotmpl
 // templates/client/parameter.gotmpl
 // templates/client/response.gotmpl
+// templates/client/signing.gotmpl
+// templates/docs/html.gotmpl
+// templates/docs/markdown.gotmpl
 // templates/docstring.gotmpl
 // templates/header.gotmpl
 // templates/model.gotmpl
 // templates/modelvalidator.gotmpl
+// templates/oneof.gotmpl
 // templates/schema.gotmpl
 // templates/schemabody.gotmpl
 // templates/schematype.gotmpl
 // templates/schemavalidator.gotmpl
 // templates/server/builder.gotmpl
 // templates/server/configureapi.gotmpl
+// templates/server/configureversions.gotmpl
 // templates/server/doc.gotmpl
+// templates/server/jwt.gotmpl
 // templates/server/main.gotmpl
 // templates/server/operation.gotmpl
+// templates/server/operation_test.gotmpl
 // templates/server/parameter.gotmpl
 // templates/server/responses.gotmpl
 // templates/server/server.gotmpl
 // templates/server/urlbuilder.gotmpl
+// templates/server/versions.gotmpl
+// templates/server/versionsmain.gotmpl
 // templates/structfield.gotmpl
 // templates/swagger_json_embed.gotmpl
 // templates/tuplefield.gotmpl
@@ -96,7 +108,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesAdditionalpropertiesserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\x4d\x8f\xe3\x44\x14\xbc\xfb\x57\x14\x91\xd8\xb5\x91\x71\x48\xf6\xb6\x10\xa4\x45\xe2\x00\xd2\x0e\x68\x07\xb8\x44\x39\x74\xec\xe7\xa4\x67\xec\x6e\xd3\xdd\xf1\x30\x58\xfe\xef\xa8\x3f\x26\x76\x06\x3b\x9a\x8f\xd3\xd8\xee\xae\x57\x55\xef\xbd\x4a\xd7\xa1\xa0\x92\x0b\xc2\x82\x15\x05\x37\x5c\x0a\x56\xfd\xae\x64\x43\xca\x70\xd2\xb7\xa4\x38\xab\xf8\xbf\xa4\x16\xe8\xfb\x68\xb9\xc4\x9f\xa2\x66\x4a\x1f\x59\xf5\xeb\xed\x6f\x37\x38\x3d\x3d\x69\x98\x23\xd7\x90\xfb\x3b\xca\x0d\x1e\xb8\x39\x62\xc0\x43\x73\x06\x44\xa9\x64\x0d\x7b\x37\x2a\x4f\x22\x47\xdc\x75\xd9\x17\xca\x89\xb7\xa4\x6e\x58\x4d\x7d\x8f\x6f\xba\x0e\x0d\xd3\xb9\xab\x8b\xcc\xbe\x45\xdf\x27\x97\x95\xe3\x82\x19\x86\xed\x6e\xff\x68\x28\x01\x29\x25\x15\xba\x08\x58\x2e\xa1\x0d\x3b\x10\x56\x29\xf6\x5c\x14\x30\x47\x1a\x95\x8f\x80\x96\x29\x7f\x64\x85\xae\x83\xa1\xba\xa9\x98\x21\x2c\x2c\x67\x79\x32\x9f\xce\xac\x7f\x92\xc5\xe3\x02\x99\xd5\x0d\xf0\xd2\x16\xc1\xc7\x0d\xee\xb4\x14\xd9\x99\x8b\xe3\x91\xe2\x9d\x47\x4c\xbe\x77\xa7\xbe\xda\x40\xf0\xca\xf1\x01\x14\x99\x93\x12\xf6\x7d\x04\xf4\x81\x80\xca\x5b\x4c\xca\x8c\x60\xdf\x2b\x26\x0e\x84\x6c\xe8\x83\xff\xa2\xf2\x36\x9b\xbc\x85\x4d\x90\x34\xfd\xd9\x83\x92\x28\xfc\xff\xd6\xe0\x0b\xd3\x3d\x82\xca\xdb\x68\xec\xe0\x3a\x85\xa2\x5a\xb6\x63\xff\xc0\x44\x61\x1b\x0b\x23\x51\xb3\x26\x82\x3f\xbb\xb6\xc6\xd4\xec\x9e\xe2\x9a\x35\x5b\x6d\x14\x17\x87\x5d\xd7\x59\xd7\xb2\x4f\x13\x63\x85\xbe\x77\x36\x7e\x61\x0f\x9f\x49\x6b\x76\x20\xcb\xaf\xd2\x96\x09\x17\x86\x54\xc9\x72\xea\xfa\x33\xe9\xe4\xa5\x1d\x58\xbf\xa0\x03\x57\x2d\x2e\xa8\x22\x43\xb1\x07\x4b\x5d\x8f\x14\x17\xa6\xc4\xe2\xeb\xbf\x17\xc3\x30\x5e\x38\xea\x1f\xae\x68\x1d\xbb\xfa\x21\x75\xfe\x4d\x2f\x47\xcb\xaa\x93\x1b\x52\x5e\xa2\x22\x11\x68\x24\xf8\x11\xdf\x9d\xb5\xe8\x53\x65\x66\xec\x1e\x86\x59\xe7\x47\xaa\xd9\x1f\x8f\x0d\x2d\x66\x59\x25\x0e\xb0\x94\x0a\xf7\x29\x5a\x0b\xe9\x3d\x09\x1d\xf5\xf5\xfc\xb8\x1a\x69\x39\xbf\xba\x42\x40\x98\xed\x5c\x6b\x0d\xe6\x25\x84\x34\xd3\x18\xd9\x2f\xfa\xe6\x54\x55\x6c\x5f\x59\xd7\xdf\x9d\x2d\x77\x7c\xa6\x5a\xfd\xbf\x76\xdb\xbf\x27\x1e\xde\xbb\xed\xfd\x0e\x1b\xaf\x28\x1a\xbe\xda\x95\xf8\xcb\xba\xff\xf3\x3f\x8d\x22\xad\xb9\x14\x61\x2b\xdc\xa5\xb0\xba\xc3\x94\x46\x57\xae\x78\x03\x9f\x8f\x48\xa0\x25\x78\x15\xf5\x91\x4d\xd2\xcf\xa3\x1c\x7d\x6d\x8a\x72\x61\x24\x98\xcb\xd1\x70\x7a\x36\x4e\x67\xd2\x74\x54\x3d\x4e\x10\xfb\x24\x4d\x7d\x92\x26\xce\xcd\xb7\x05\xe5\xfc\x6a\x5d\x4b\x28\x6c\x66\xec\x7c\xee\xe2\x72\xe9\x06\x7f\xac\xdc\x8f\xb0\x90\x0f\xe2\x32\xe8\xed\x83\x4e\x2f\x46\x2f\x88\x8e\x43\x5a\x0f\xa9\x32\x95\x17\x82\x57\xe9\x38\x34\xc2\x4e\x4e\xf3\x4c\xb0\xd9\x8c\x96\xd4\x01\x04\x02\xb6\xe3\x01\x62\x8e\xbd\xfd\x91\x9a\x6c\x74\x84\xd1\xfb\x69\x2d\x33\x7c\xde\xa4\xcd\x31\x4e\xf0\x03\x3e\x5c\x1e\x1f\x73\xb8\x94\x93\x4b\x91\x33\x43\xc2\x4e\x86\x55\xb1\x0e\xb2\xce\x0d\xd8\x0e\xb8\xdf\xae\xec\xe6\xbd\x4f\xdf\x0f\xdb\xc0\x9a\x86\x44\x11\x07\xa7\x86\x32\xdb\xd5\xc7\x5d\x96\x65\x49\x1a\xf6\x65\x98\x81\xff\x02\x00\x00\xff\xff\xca\xac\x90\xfb\xb1\x08\x00\x00")
+var _templatesAdditionalpropertiesserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\xbc\x1a\x68\x22\x15\xaa\xdc\x75\x6e\x69\x5d\x20\x05\x7a\x68\x81\x6c\x8b\xa4\xed\xc5\xf0\x81\x96\x46\x36\xb3\x12\xa9\x92\xb4\xb6\x5b\x42\xff\xbd\xa0\xc8\x4a\x72\x22\x1b\xc9\x9e\x16\xa2\xc9\x99\xf7\x31\xf3\xd6\x39\x94\x54\x09\x49\x58\xf1\xb2\x14\x56\x28\xc9\xeb\xdf\xb5\x6a\x49\x5b\x41\xe6\x3d\x69\xc1\x6b\xf1\x2f\xe9\x15\xfa\x9e\xad\xd7\xf8\x53\x36\x5c\x9b\x13\xaf\x7f\x7d\xff\xdb\x3d\xce\xff\x7f\x19\xd8\x93\x30\x50\x87\x0f\x54\x58\x3c\x0a\x7b\xc2\x54\x0f\xed\x58\x10\x95\x56\x0d\xfc\x5b\x56\x9d\x65\x81\xc4\xb9\xfc\x1d\x15\x24\x3a\xd2\xf7\xbc\xa1\xbe\xc7\x37\xce\xa1\xe5\xa6\x18\xfa\x22\xf7\xa7\xe8\xfb\xf4\xb2\x73\x52\x72\xcb\xb1\xdb\x1f\x9e\x2c\xa5\x20\xad\x95\x86\x63\xc0\x7a\x0d\x63\xf9\x91\x70\x97\xe1\x20\x64\x09\x7b\xa2\x59\x7b\x06\x74\x5c\x87\x2b\x77\x70\x0e\x96\x9a\xb6\xe6\x96\xb0\xf2\x98\xd5\xd9\xbe\x19\x51\xff\xa4\xca\xa7\x15\x72\xcf\x1b\x10\x95\x6f\x82\xd7\x5b\x7c\x30\x4a\xe6\x23\x96\x01\x47\x86\x17\xa1\x62\xfa\xfd\x70\xeb\xab\x2d\xa4\xa8\x07\x3c\x80\x26\x7b\xd6\xd2\x9f\x33\xa0\x8f\x00\x74\xd1\x61\x91\x26\x83\x3f\xd7\x5c\x1e\x09\xf9\xe4\x43\x00\xa1\x8b\x2e\x5f\x7c\x85\x6d\xa4\xb4\xfc\x73\x28\x4a\xb2\x0c\x65\xbc\xc0\x17\xa2\x87\x0a\xba\xe8\xd8\x5c\xc1\x4d\x06\x4d\x8d\xea\xe6\xfa\x81\xcb\xd2\x1b\x0b\xab\xd0\xf0\x96\x21\xf4\xdd\x78\x61\x1a\xfe\x40\x49\xc3\xdb\x9d\xb1\x5a\xc8\xe3\xde\x39\xaf\x5a\xfe\x66\x61\xac\xd0\xf7\x83\x8c\xef\xf8\xe3\x5b\x32\x86\x1f\xc9\x39\x50\x6d\x3c\x58\x21\x2d\xe9\x8a\x17\xe4\xfa\x11\x74\xfa\xb9\x0e\x6c\x3e\xc3\x81\x9b\x12\x97\x54\x93\xa5\x24\xb0\xca\xfc\xc5\x56\x0b\x69\x2b\xac\xbe\xfe\x7b\x35\x0a\x9a\x5e\x28\x1a\x3e\x6e\x70\x9d\xab\xfa\x2a\x1b\xf4\x5b\x5e\x8e\x8e\xd7\x67\x32\x81\x6c\x4d\x32\xc2\x48\xf1\x23\xbe\x1b\xb9\x98\x73\x6d\xaf\xc8\x3d\x0d\xb3\x29\x4e\xd4\xf0\x3f\x9e\x5a\x5a\x5d\x45\xe5\x49\x00\x95\xd2\x78\xc8\xd0\xf9\x92\x41\x93\xe8\x68\xe8\x17\xc6\xd5\x2a\x8f\xf9\x8b\x3b\xc4\x0a\x57\x9d\xeb\xbc\xc0\xa2\x82\x54\x76\xb9\x46\xfe\x8b\xb9\x3f\xd7\x35\x3f\xd4\x7e\x32\x5e\x8c\x92\x0f\x78\x96\xac\xfe\xc4\x6e\x7f\xd0\xc7\xbf\x41\xbb\xdd\xc3\x1e\x5b\x0c\x15\xd8\xf4\xab\x5f\x89\xbf\xbc\xfa\x3f\xff\xd3\x6a\x32\x46\x28\x19\xb7\x62\x78\x14\x57\x77\x9a\x52\x76\xe3\x49\x10\xf0\xe3\x11\x89\xb0\xa4\xa8\x59\xcf\x7c\x92\xbe\x9d\xe5\xe8\x97\xa6\xa8\x90\x56\x81\x0f\x39\x1a\x6f\x5f\x8d\xd3\xc5\x44\x48\xe7\xdd\x93\x14\x49\x48\xd2\x2c\x24\x69\x0a\xf7\xec\xa0\xbc\xbe\x5a\xb7\x12\x0a\xdb\x2b\x72\x7e\xac\xe2\x7a\x3d\x0c\xfe\x9c\x79\x18\x61\xa9\x1e\xe5\x4c\x21\x86\xe1\xc3\x64\x17\xa3\x17\x49\x27\x31\xad\xa7\x54\x59\xca\x0b\x29\xea\x6c\x1e\x1a\x71\x27\x97\x71\xa6\xd8\x6e\x67\x4b\x3a\x58\x1d\x01\x78\xc7\x81\x9b\xe8\xfd\x3f\xa9\x45\xa3\x19\x66\xe7\xcb\x5c\xae\xe0\x79\x16\xb7\x01\x71\x8a\x1f\xf0\xea\xf2\xfa\x1c\xc3\x25\x9d\x42\xc9\x82\x5b\x92\x7e\x32\x3c\x8b\x4d\xa4\x35\x1a\xb0\x9b\xea\x7e\x7b\xe7\x37\xef\x65\xf6\x72\xda\x06\xde\xb6\x24\xcb\x24\x2a\x35\xb5\xd9\xdd\xbd\xde\xe7\x79\x9e\x86\x76\x3d\x73\x0e\x24\x4b\xf4\x3d\xfb\x6f\x00\xca\xac\x90\xfb\xb1\x08\x00\x00")
 
 func templatesAdditionalpropertiesserializerGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -116,7 +128,47 @@ func templatesAdditionalpropertiesserializerGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcd\x72\xdb\x36\x10\xbe\xf3\x29\xb6\xaa\x9b\x90\x1e\x99\x6c\xae\xea\xf8\x90\xb1\xd3\x89\x0f\x89\x3d\xb6\xa6\xb9\x74\x26\x03\x91\x2b\x12\x0d\x09\x30\x8b\xa5\x5c\x85\xc3\x77\xef\x00\x04\x29\x51\x3f\x76\x8e\xbd\xd8\x04\xf6\x07\xbb\xdf\x7e\xbb\x80\x92\x04\x6e\x74\x86\x90\xa3\x42\x12\x8c\x19\xac\xb6\x90\xeb\x2b\xf3\x2c\xf2\x1c\xe9\x0f\xb8\xbd\x87\xcf\xf7\x4b\xf8\x70\x7b\xb7\x8c\x83\x20\x68\x5b\x90\x6b\x88\x6f\x74\xbd\x25\x99\x17\x0c\x57\x5d\x97\x24\xd0\xb6\x90\xea\xaa\x42\xc5\x07\xb2\xb6\x05\x54\x19\x74\x5d\x10\x04\xb5\x48\xbf\x89\x1c\xad\x72\xfc\x59\x54\xe8\x76\x93\x04\x96\x85\x34\xb0\x96\x25\xc2\xb3\x30\xd3\x48\xb8\x40\xf0\xa1\x00\x6b\x5d\xc6\x56\xff\x43\x26\x59\xaa\x1c\x78\xb4\xab\xdc\x71\x35\xe9\x0d\xc2\xba\x61\xe7\xaa\x40\x05\x5b\xdd\x00\xe1\x15\x35\x6a\xe2\x69\x38\xc2\xc5\x2c\x54\x16\x04\xb2\xaa\x35\x31\x84\x01\xc0\x0c\x55\xaa\x33\xa9\xf2\xe4\x1f\xa3\xd5\xcc\xee\x28\xe4\xa4\x60\xae\xdd\xc2\x30\x49\x95\x1b\xf7\x9d\x4b\x2e\x9a\x55\x9c\xea\x2a\xc9\xf5\x95\xae\x51\x89\x5a\x26\x48\xa4\xe9\x25\x05\x1b\xc7\x0b\x62\x6a\x14\xcb\x0a\x5f\xd0\xd8\x88\x52\x66\x82\x71\x16\x04\x00\x86\x69\x5d\xf1\xd9\xb3\x9c\xd4\x29\xb6\x2d\x90\x50\x39\x42\x7c\x8b\x6b\xd1\x94\x7c\xe7\xb2\x36\xe0\xca\x54\x93\x54\xbc\x86\xd9\x6f\xdf\x67\x10\x77\x5d\xaf\xef\x6b\xb7\x67\x7b\xf1\x0d\xb7\x73\xb8\xd8\x88\xb2\x41\x58\x5c\x43\x3c\x71\x62\xa5\xd0\x75\x70\xe0\xcf\xab\x1f\x78\x8d\x5c\xf5\x3f\xe3\x33\xa4\x84\x82\xd1\x80\x00\x85\xcf\x56\xa3\x68\x2a\xa1\xe4\x0f\x1c\x89\x02\xef\x1f\xee\x20\x2d\x25\x2a\x8e\x83\x75\xa3\x52\x6b\x17\x32\x09\x65\x5c\xe5\x3c\x66\xf1\x8d\x53\x59\x0e\xfb\x73\x58\x6b\xaa\x04\x1b\x8f\x52\xfc\x88\xb9\x34\x4c\xdb\x08\x2e\x7b\x55\x68\x03\x00\x42\x6e\x48\xc1\x9b\x7e\xab\x1d\xdd\x2e\x80\x8f\x3c\x2d\x86\x8f\x2e\xe8\xe9\x5b\x13\x32\x6f\x1f\x6c\xba\x20\x6d\x0e\x05\x96\x35\x12\xd8\x28\x59\x6a\x4b\x3d\xc1\xfe\x08\x2b\x36\x4c\x4d\xca\x20\x15\x10\x8a\x4c\xac\x4a\x84\x9e\x53\xde\x71\x0c\x77\xfc\xd6\x40\x63\x30\xb3\x3b\xfd\x11\x52\x39\xca\x3b\x6a\x41\x85\xc6\x88\x1c\x0d\xe8\xc6\xf9\x31\x48\x1b\x24\x20\x34\xb5\x56\x06\x8d\x47\x68\x2f\xb0\x70\x03\x52\x31\xd2\x5a\xa4\xd8\x76\xd1\x70\xa0\xcd\x7d\x35\x87\xaf\xb6\x90\x96\xed\xf1\x27\x41\xa6\x10\x65\xb8\x89\x76\xa8\x78\xc2\xc7\x8f\x58\x97\x22\xc5\xb0\x5f\x87\xab\x68\x0e\xb3\xbf\x67\xb3\x39\xcc\xde\xce\xe6\x70\xf5\x2e\x72\x78\x5c\x06\x03\xae\xfd\xa4\x78\x6a\xaa\x4a\xd0\xb6\xa7\xc7\x74\x65\xc5\xb7\x68\x52\x92\xb5\xc3\xc9\x8e\x83\xb6\x85\x55\xa9\xd3\x6f\xe3\x34\x99\x2a\x8c\xe4\xb1\x1f\xa5\xc1\x43\x1f\x4e\xf0\x9a\x03\x6b\xd7\x75\x6b\x4d\x67\x99\xb6\xe3\xe8\x65\x12\xf0\xb6\x46\xf0\x49\xf9\xda\x59\xdc\x5e\xe5\x5e\x00\xe7\xc8\x17\xf4\x89\xfa\x66\xbc\xaf\xed\x30\x92\x5a\xd9\x1e\x4a\x2e\xad\xa4\x16\x26\x15\xe5\x24\xaa\x53\x70\xd6\x65\x43\x4e\xed\x4f\x49\x86\xbf\x68\xca\x20\xdc\xe5\xe3\x55\xa3\xff\x03\xd8\x3f\x05\xb4\x23\x6d\x28\x86\xce\x8c\xe0\x24\x12\x61\x2d\x48\x54\x06\x2e\x4f\x4a\x1f\x9c\xd0\xe7\xfb\xbe\xe1\x42\x93\xfc\x81\xf6\x84\x39\x88\x86\x8b\x3b\xb5\xd6\x07\x05\x7b\xef\xb7\xbf\x90\x64\xa4\xb6\x45\x95\x8d\x88\x7d\x14\xe6\x89\x09\x45\x25\x55\xfe\xe8\xdb\xcb\xf9\x7a\x76\xca\x20\x75\x3c\x98\xf9\x44\xa2\x5d\xa5\xd2\x14\x8d\xd9\xb3\x0a\x77\x35\x3f\x10\xda\xca\x9f\xce\x67\x0e\x93\x2a\xb8\x0f\x37\x04\xce\x9e\x12\x8d\x7a\x8e\xa6\xf6\x7e\xbd\xbf\xbd\x5f\xc0\x5f\xfe\xce\x70\x37\xa1\xc7\x70\x85\x6b\x4d\x08\x06\x95\xbd\xf0\x02\xb0\x2e\xbd\xe8\xfa\x1a\x94\x2c\x9d\x0b\x18\xf7\xec\xd0\x7d\x01\xf6\xd0\x4e\x0d\x3f\xe3\x2f\x4a\x54\x39\x17\x76\xb2\x94\xa8\x4e\x66\xdc\x2b\x9e\xce\x82\xd0\x34\x25\xb7\xad\xe5\x4f\xd7\x7d\x1d\x73\x9a\xdb\x11\x68\x9d\x8a\x78\x6c\xc0\xf8\xa9\x59\x55\x92\xc3\x37\xd3\xba\x8e\x7d\xd5\xe7\x70\x77\xbb\x38\xbc\x96\x46\x90\x9d\xc2\x27\xe4\x42\x67\xc7\x4a\xfd\xfe\xa8\xf6\x20\xb8\x78\x10\xcc\x48\xea\x58\xd7\x0a\x77\x9a\xa4\xb3\x26\x45\xf3\x09\x33\x29\x96\xdb\x1a\xcd\xd4\xe0\xd7\x8d\xb5\x38\x52\x1a\xed\x6f\xb4\x32\x4d\xf5\x8a\xfd\xb1\xd2\x68\xff\x94\x16\x58\x9d\x34\xf2\x92\xbd\x9c\x6c\xf9\x16\xbe\xce\xfd\xde\x23\x8a\x0c\x69\x01\x6f\x4e\x16\xbc\x97\xb6\xe3\x9d\x28\x62\xff\xf9\x73\x8d\xb3\xf0\xff\xc7\xba\x76\xf3\x53\x3d\xeb\x02\x19\xfa\x73\x31\x36\xf0\xbc\x37\xf3\xf2\x1b\xad\x18\xff\xe5\x21\xfa\xd8\xaf\x3d\x86\x8e\x0a\xa3\xec\xe3\x72\xf9\xd0\x6f\x59\x71\x17\xf5\x94\xb7\x94\xfa\x65\x9f\xef\xfe\xe6\x3b\xcb\x4e\x07\x49\xf6\xd4\x10\xe9\x46\x65\x30\x53\xb2\x9c\xf9\xbf\xbf\x8f\xcc\x9f\x34\x2f\x12\xed\x7a\xe3\xac\x53\x1b\xcb\xf7\xd1\xc1\x3b\xd7\x07\x2e\x92\xbe\x1d\xe2\xf0\x60\x48\x1c\x38\x19\x8a\x13\xcd\x6d\x2e\xbb\xe9\x6b\x9e\x25\xa7\x05\x8c\x6f\xb6\xc1\x9b\xbd\xd9\x22\x68\xf7\x1e\x77\xd2\x3e\xed\xdc\xb3\xee\x74\xbf\x02\xa4\xc2\xe0\xc1\xec\xbd\xd8\x0c\x07\x2f\x9c\xca\x3e\x7e\x13\x98\x5c\x00\x03\x50\x17\x72\x82\x94\x0f\xb8\x1f\x5c\x5d\x70\xd6\xc7\x59\xa8\xf7\x1d\xf8\x67\x66\xe9\x47\x89\x73\x34\x91\x07\x5d\xb0\xb7\x48\x12\x78\xc2\xdd\xc5\x0d\x69\x61\xd1\x30\x6e\x52\xee\xae\x79\xdd\xff\x88\xe8\x1f\xa1\xc7\x97\xd5\xbe\x87\xd7\x1f\xa6\x91\x63\xda\xde\x10\x83\xeb\xdd\x51\x41\x17\xfc\x17\x00\x00\xff\xff\x69\xae\x37\xca\x98\x0d\x00\x00")
+var _templatesCliMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5d\x73\xdb\xb6\xd2\xbe\xd7\xaf\xd8\x72\xfc\x7a\x48\x8f\x44\xf5\xed\xa5\x33\xba\x70\xed\xa4\xf1\x39\xa7\xb1\x27\x76\xa7\x17\x99\x4c\x02\x91\x4b\x09\x0d\x09\x30\x00\x68\x47\x87\xc3\xff\x7e\x66\x01\xf0\x43\x32\x25\x2b\x4e\x7b\x65\x13\x58\xec\xf7\x2e\x9e\x85\xe6\x73\xb8\x94\x29\xc2\x0a\x05\x2a\x66\x30\x85\xe5\x06\x56\x72\xa6\x1f\xd9\x6a\x85\xea\x15\x5c\xdd\xc0\xbb\x9b\x7b\x78\x7d\x75\x7d\x1f\x4f\x26\x93\xba\x06\x9e\x41\x7c\x29\xcb\x8d\xe2\xab\xb5\x81\x59\xd3\xcc\xe7\x50\xd7\x90\xc8\xa2\x40\x61\x76\xf6\xea\x1a\x50\xa4\xd0\x34\x93\xc9\xa4\x64\xc9\x17\xb6\x42\x28\x18\x17\x93\xc9\x7c\x0e\xf7\x6b\xae\x21\xe3\x39\xc2\x23\xd3\xdb\x2a\x98\x35\x82\xd7\x01\x8c\x94\x79\x4c\xf4\xaf\x53\x6e\xb8\x58\x81\xe9\xce\x15\x56\x87\x52\xc9\x07\x84\xac\x32\x96\xd5\x1a\x05\x6c\x64\x05\x0a\x67\xaa\x12\x5b\x9c\x5a\x11\x56\x59\x26\xd2\xc9\x84\x17\xa5\x54\x06\xc2\x09\x40\x80\x22\x91\x29\x17\xab\xf9\x5f\x5a\x8a\x80\x56\xb2\xc2\xd8\xbf\x5c\xce\xb9\x24\xf6\xf6\x2b\x97\x2b\xfb\x57\xea\x60\x42\x7f\x57\xdc\xac\xab\x65\x9c\xc8\x62\xbe\x92\x33\x59\xa2\x60\x25\x9f\xab\x4a\x18\x5e\x20\x51\xae\x8d\x29\x8d\x62\x42\x5b\x59\x87\xe9\xe7\x49\xce\x51\x98\x60\x3f\x63\x72\x0b\x6d\x67\x39\x5b\xe9\x2d\xa2\xbf\x50\x6b\x7c\x48\xbf\x10\xb5\xdd\xb5\xfa\x69\xa3\xb2\x62\xaf\x58\xb7\x6b\x09\xeb\x1a\x14\x13\x2b\x84\xf8\x0a\x33\x56\xe5\xe6\xda\x3a\x47\x43\xd3\xd4\x35\x94\x8a\x0b\x93\x41\xf0\x7f\x5f\x03\x88\x9b\xc6\xd1\xfb\xd8\x0e\xce\x9e\x7c\xc1\xcd\x14\x4e\x1e\x58\x5e\x21\x9c\x2f\x20\xde\x62\x42\xbb\xd0\x34\xb0\xc3\xcf\x93\xef\x70\x8d\x6c\x92\xc8\xd2\x70\x29\x34\xe8\x35\x53\x2e\x37\x58\x9e\xdb\xa8\xfa\x20\x6a\x90\x99\xfd\xae\x6b\x48\x99\x5e\xa3\xe2\xff\x45\x88\xdf\xb1\x02\xa1\x69\x66\x49\xce\x6d\x06\x4d\x1e\x98\x02\x59\x1a\x0d\xda\xa8\x2a\x31\x50\x4f\x00\xee\x92\x35\x16\x08\x40\x6b\x94\x59\x9f\x73\x29\x56\xe7\x81\xb6\xcb\x01\xa4\xa8\x13\xc5\xad\x02\xe7\x01\x89\x70\x1b\x50\x69\x4c\xc1\x48\x50\xc8\x92\xb5\x95\x7d\x71\x7b\x4d\xe4\xd6\x6d\xe7\x81\xaf\x12\xc7\xdd\x9b\xce\x45\x8a\xdf\xfa\xb5\x9f\xdd\x2a\xe6\x9a\xb4\xa4\x0c\xe9\x0c\x0f\x3e\x4f\x00\xde\x4a\x6d\x00\x9e\x68\xb6\x96\xda\x8c\xe8\x45\xcb\x10\x32\x91\x02\x39\x3b\x02\x8d\xea\x81\xec\x19\x55\x2d\xb6\xbc\xbd\x9c\x5f\x99\xc6\x5b\x66\xd6\x3b\x72\x96\x4c\xe3\xac\x64\x66\x3d\x22\x8c\xf6\x80\xf6\x5a\xc7\x3f\x95\xd0\x71\xf5\x52\xae\x70\x59\xad\xc8\x9a\xa5\x94\x39\x40\x2b\x25\xa5\xe5\x1d\x09\x69\x55\x94\xda\xfa\x54\xe1\xd7\x0a\xb5\xd1\x40\x76\x29\xd4\xa5\x14\x1a\x35\xf9\x5d\x9b\x14\x95\x0a\x3e\x0f\x13\x2f\xbe\xc3\xa4\x52\xdc\x6c\xae\x30\xe3\x82\x53\xcc\x5a\xcf\x67\x10\x5f\xeb\x5f\x99\xe6\xc9\x45\x65\x75\x72\xe7\x4a\xa6\x13\x96\xdb\x64\xb9\xbe\x82\xa6\xf9\x43\xa3\x12\x94\x35\xdb\xae\xd8\x4e\x2b\x4b\x39\xab\x3c\xe9\x88\x77\xda\x2d\xc8\xa4\x6a\xd3\xd2\x9d\x82\x25\xa9\x00\xac\x32\x6b\x14\x86\x27\x8c\x74\x0c\x3e\x8f\x2b\x73\xcb\xb4\x7e\x94\x2a\x3d\x42\x99\xd2\x93\x8e\x28\xd3\x6e\x7d\xa7\x32\x36\x2b\xa9\xcf\x5f\xeb\x8b\xdb\xeb\x7f\xe3\xe6\xa0\xdf\x9e\x57\x71\x44\xb3\xba\xee\x4a\xd4\x2a\x75\x27\x2b\x95\x50\x29\x40\xc9\x14\x2b\xd0\xa0\x1a\x51\x9a\x95\x1c\xa8\x85\x3c\xab\xf6\x0d\x69\xfc\xcb\x5e\x95\xef\xe5\x17\x14\x47\xb8\xd6\x10\xdd\x88\xf6\x4b\x64\x0a\x15\xd8\xed\x11\x35\x25\xe9\xf7\xcb\x3e\x2d\x6d\x91\x77\xff\x4c\x9a\xc9\x24\xab\x44\x62\xaf\xc5\x30\xb2\x6d\xa9\x64\x4a\xa3\xa2\xf6\x69\xdb\x78\xfc\x0e\x1f\x6f\xed\x52\x78\x4a\x1d\x6c\xea\x97\x7d\x9b\x8e\xba\x13\xf1\xdd\x5a\x2a\x73\xd5\x6b\x0b\x0b\x68\x0b\x40\x64\x72\xa4\x91\x5f\x8b\x4c\xc6\xf7\xdc\xe4\xb8\xd5\x8f\x76\xc8\xc2\x75\x55\x30\xd1\xb5\xd5\x68\x4b\xff\x4e\xf8\x7f\xa4\x58\x7d\xb7\xec\xe1\x81\xa1\x06\x41\x30\x7a\xbd\xc4\x37\x25\x81\x04\x2e\xc5\x6f\x4a\x56\xa5\xee\xb6\x13\x56\x60\x3e\x6c\xfc\x97\x45\x3a\x05\x54\xd6\x8b\x5e\xc1\x8b\x34\xbd\x74\x77\x46\xb8\x6b\xe0\x20\xf2\xad\x85\x53\x78\xde\x0b\x4f\x68\x76\xec\x99\xc2\xa9\xbb\x6d\xea\xa6\x6e\x28\x4e\x3c\xb3\x3a\xfd\xb4\x00\xc1\x73\x1b\x6b\x80\x5c\xae\xe2\x37\xcc\xb0\x3c\x17\x21\x2a\x45\x64\xde\xa8\x93\x15\x19\x49\x16\xc4\xce\xe5\xbb\x4e\xf0\xf6\xf3\x0c\x3e\x75\xc6\x0e\x9d\xe1\x18\x0c\x5c\xf2\xa3\x3e\x88\xef\xaa\xa2\x60\x6a\x73\x9c\xed\x5b\x95\xb7\xad\xcb\x76\x51\xb6\x0a\x3a\xcd\xea\x26\x7a\xf5\x3d\x6e\xda\xad\xa8\x5d\x8f\xf8\xf0\xdb\x12\x0a\xc7\x58\x27\x04\x81\xcf\x17\xf0\xff\x36\x1e\x3c\x83\x0c\xa7\x20\xbf\xd0\x59\x54\x2a\x0e\xcf\x5c\xbd\xbd\x56\x4a\xaa\xe8\x15\xed\x9c\x9e\x42\x86\xf1\xfd\xa6\x44\x58\xb4\x55\xfa\x5a\xa9\xb7\x98\x97\x9e\xa7\xe7\xba\x80\x9f\xed\x27\x45\x14\x40\xea\xf8\xf5\x37\x6e\x42\xda\x72\x71\x6e\x2c\xc8\x11\xf8\x78\x69\x71\x1f\x24\x0a\x99\xa1\x5b\xce\xdd\xdc\xe0\xe0\x20\x3c\x72\xe3\x80\x46\x8f\x23\x13\x29\x32\xbe\xaa\x08\x14\x65\x4a\x16\x43\x48\x04\x39\x17\xe8\xba\x4a\xc7\x39\x8c\xe0\x8c\x1a\xed\xad\x47\xe1\x4d\x13\x8f\xc6\xc0\xaa\xdf\x4b\x39\x5f\x6c\xc3\x57\xea\x45\x21\x35\x21\x8b\x22\xa6\x16\x51\x75\xd7\xfd\x14\x3e\x7c\x74\x4d\xb5\xb6\xeb\x0e\xec\x34\xd1\x90\x63\xec\xb0\xc0\xc2\x9d\xb4\x1f\x13\x00\x85\xa6\x52\x02\x76\x14\x24\x59\xdd\xc1\x29\xf5\xeb\xac\x30\x2d\x3a\x8d\x26\x4d\x3b\x8e\x8c\x5f\xfd\xe4\x58\x6a\xc1\xd4\x67\xe0\x51\xf1\xd6\xad\x89\xc2\x94\x2e\x0f\x96\x6b\x3b\x3a\xf0\x14\x53\x90\xe2\x89\x03\x09\x69\x0c\x70\x88\xf3\x67\xcb\x30\x8c\xc0\x23\xf6\xd8\x45\xee\xc2\x6f\xfc\x49\x82\x94\xf5\x22\x21\x4e\x2b\x57\x69\xf8\xf0\xf1\x10\xf9\x0f\x00\x19\x9e\x39\x4f\x1e\x02\x34\x3f\x2d\x20\x08\x7c\x5e\xb6\x0a\x2d\x80\x95\x25\x8a\x34\xf4\x0b\xd3\x9d\x38\x77\x62\xc2\xe7\xd8\x4f\x61\x1f\x45\x0b\x62\xa2\x61\xb1\xee\xc7\x16\x07\x4c\x79\x91\x09\x3d\xff\xdd\x5e\xd7\x26\xfb\x48\x7f\x6b\x71\xc8\x7e\xab\xf6\x58\x33\x84\x1c\x07\x2c\x71\xd0\xe3\x45\x11\xb1\xa0\xc3\x9e\xdf\x1b\x13\xbb\x1b\x1d\xe8\x8d\x5d\xa9\x1d\x4a\xc7\x37\x95\x48\x42\x4a\xf7\x50\xe1\xd7\x9d\x3c\x7f\xef\x50\xf9\x14\x14\xae\xda\x8a\x7c\x8f\x2b\xae\x8d\xda\x44\xd4\x5a\xa5\xf2\x76\x11\x2e\xfa\x34\xf5\xe6\x51\x2f\x75\xf9\xdd\x9a\xdb\xb6\x49\x7f\x25\x9e\x2f\xfc\x4e\x7c\xd1\xc3\x26\xf4\xd2\x48\x0f\x2b\x71\xac\x7b\x03\x74\x46\xa1\x52\x7e\xa9\x19\x74\x5d\xbf\x29\x78\x4e\x7e\xf1\x7d\xc3\xfb\x63\x3e\xa7\x29\x2e\xbd\x65\x9b\x5c\x32\x1a\x33\x58\xaa\x81\xb5\x35\x0f\xa5\x5f\xb7\x1d\x96\xd9\xc7\x8a\x29\x48\xe5\xbe\xb5\x49\xb9\x70\x0f\x0e\xd4\x27\x68\x13\x2c\xf4\xe7\x1a\x66\xae\x5d\x0c\x78\x87\x83\xd9\x22\x82\xf0\xc3\xc7\xe5\xc6\xe0\x94\xcc\x91\xca\xc1\x3e\x9e\xb9\xe3\x8b\x05\x04\xb3\x36\x3b\xbc\xf2\xee\xfd\x21\x7e\x8f\x2c\xbd\xc8\xf3\x50\xea\xf8\x8e\xa4\xb7\x91\x7e\x4a\xf5\x86\xe7\x68\x45\x46\xfe\x8e\xb1\xf9\xdf\xda\x59\x2a\x34\x66\xe3\x52\xdf\xd9\xeb\xc6\xab\xce\x60\x37\x65\xc9\xca\x38\x3b\x86\x87\xc3\x96\x86\x0b\x83\x2a\x63\x09\xd6\xcd\x30\xf2\xcb\xee\xe2\xa5\x87\x94\xf8\x77\xa6\xf4\x9a\xe5\xd7\x82\x9a\x6e\x7b\x76\x0a\x41\x30\x85\x00\x20\xd8\x87\x8a\xbc\x45\x2e\xa2\x64\x22\x25\xda\x2d\xa9\x91\x8b\xd0\x5d\x32\xe1\x32\x8a\x7a\xdb\x29\xbc\xcd\xa4\x6f\xa3\x4f\x91\xe2\xd1\x88\xca\xbd\x68\xbd\x04\xba\xb4\x90\xb7\x87\x49\x44\x9d\x57\xca\x32\x7a\xc3\x95\x36\x7f\xd2\x54\x37\xc0\x92\x9e\xb4\x05\xd5\xd4\x1e\x9b\x26\x61\x79\xae\xdb\xa9\x62\x1b\x77\x52\x37\x94\xad\xba\x7d\x22\x1b\xc2\x22\x2f\xd5\x7a\xf0\x24\xd2\xfb\xe4\x96\x06\xb1\xed\xab\x47\xa6\x1b\xbb\xda\xc1\xee\xc3\xb3\xe0\x11\x08\xf3\xe9\x6c\x65\xcb\x68\x2d\xf3\xb4\x7d\xc0\xf8\xd7\xdd\xcd\xbb\xae\x20\x97\x32\xdd\x4c\x61\xe6\x0b\x95\x9b\x41\x25\xb6\xcf\x2e\xd4\x33\x38\xc1\xa2\xa6\xb1\xc7\xe8\xff\xf3\xc0\xa8\x0a\xfb\x99\xe2\xe9\xc4\x48\xf5\xf2\x8c\x6d\x67\x7f\x93\x71\x46\x42\x55\x52\x15\x00\xd3\xc3\x41\xf8\xc7\x0c\xb8\x50\x8a\xf9\x84\xa3\x46\x22\xe9\x29\x76\xcd\xf3\x74\xb0\xb5\xc7\xae\x0f\x1f\x7f\xc8\xb0\xba\xb6\x00\x78\x88\xfe\x77\x46\x81\x17\xd8\xd5\x5d\x5b\x64\xe0\x3f\x14\x91\x7f\x56\xf1\x6e\xba\xa7\x97\xeb\x6f\x98\x54\xf4\xee\x7c\x64\x59\xf7\x78\xbf\x7b\x0d\x39\x8c\x55\x5d\x97\x0e\x13\x38\xdb\x72\xd3\xf1\x2d\x20\x6a\x75\x0c\x99\x5a\xe9\x0e\xc7\x0f\xfb\xba\x55\x45\xfb\x11\x73\x9b\x33\x4d\x05\xa3\xdc\x6d\x45\xe9\x30\xfa\xbe\xc6\xc2\x33\x48\x9e\x45\x81\x29\x33\xac\xbb\x69\x86\xf7\xec\xe8\xd1\xa8\x1d\xed\x46\xe0\xc3\xd6\x55\xd3\x82\x06\x02\xef\xd4\x6c\x28\x52\xf1\x6f\xd2\x8e\x7a\x4d\x33\xe4\xd2\x5e\x70\x7f\x88\xc2\x5d\x71\xa1\x53\xe9\x94\x8e\x45\xaf\x8e\x96\xe4\xfc\x3a\x6a\x6f\xfb\x84\x42\x61\x0e\x85\x34\x5d\x9d\x47\xdd\xe7\xef\xac\x6c\x3f\xde\x32\x7d\xc5\x29\x7d\x0b\x2e\x98\x91\xaa\x27\xba\x6e\x6f\xea\x7e\xe9\xce\x28\x64\x45\x44\xff\xbe\xab\xf2\x9c\x2d\xed\x13\xd0\x69\x97\xb9\x64\x84\xbf\x79\x0f\x36\xca\x43\xb1\xea\x4d\xcf\xba\x48\x49\x1d\xdf\x94\x28\xc2\xb3\xbf\x27\x4c\x29\x66\xf4\x52\x18\x5f\xe6\x52\x63\x18\x1d\xeb\x50\xef\x84\x81\xe5\x67\x9d\xe5\xd9\xa8\xd9\xc7\xb5\x57\x8f\x79\x15\x7b\xec\x01\xef\xb8\x7b\x9c\x5f\xea\x1a\x0c\x16\x65\xce\x0c\x42\x90\xe4\x3c\x91\xe2\x01\x95\x09\x3c\x73\x68\x9a\xe7\x2d\xf2\x53\xc3\x7e\x9a\x29\xd8\xdf\x57\x46\xa7\x82\xae\xbd\x1e\x17\x47\x6f\xd8\x78\xf0\x9e\xb1\xe8\x28\x63\xba\x96\x30\x9a\x94\xd6\x8e\x83\x0f\x3f\xfe\xfc\x5d\x95\x24\xa8\xf5\xfb\x16\xd6\x0e\xb0\xde\x09\x9f\xc2\x89\x4d\xc5\x5d\xaa\xbe\x25\x9d\xf0\x76\x38\xec\x44\xf8\x2a\x74\x3f\xe0\x30\x5f\x46\xee\x63\x50\x4d\x4d\x33\x7c\x7e\x6b\xbb\x60\xef\xe8\x4f\x43\x96\x8e\xf7\x40\x8c\x2f\x91\xc1\x9b\x4d\x7c\xa0\x9d\xef\x78\xd0\xaf\x86\xce\xbd\xde\x0f\x34\x4b\x49\xba\x02\x9d\xa4\xfe\xf5\xa2\x93\xe9\x09\xdf\x32\x6f\x03\x17\xab\x81\xd7\xa6\xf4\x64\x75\x67\x07\x81\xee\xc4\xd1\x88\xbd\xef\xf8\x7b\x1d\x7d\x94\x4b\x9d\xbc\x31\xc7\x8e\x2a\xb0\x35\xab\x8c\x9d\x8a\xfd\xe6\x31\x63\x72\x37\x55\xec\x52\xd0\xa4\x91\xd2\x0b\xcd\x4e\x9a\x77\x65\x30\xf3\xbf\x94\xdb\x75\x54\x6d\xf6\x76\x8d\xb0\xae\xb7\x77\x43\xc5\x1e\xbf\xbb\xfd\xd5\xf5\x6c\xd8\xa4\x2e\x2b\x6d\x64\xf1\x46\xaa\x82\x19\xc7\xd5\xbe\x7d\xf6\x2f\xe1\xdb\x4f\x68\xfe\x49\x74\x07\x37\xc5\x77\xee\xe7\x72\xc7\xc6\x26\xc1\x4b\x54\xeb\x7e\x02\x3e\xa3\x94\xd4\x98\xc6\xe1\xd9\xd6\x5d\x1a\x45\xdb\x26\x78\x07\xb5\xbe\xe9\xe8\x7a\xc7\x58\x6b\x9d\xfb\xeb\x7a\x06\x28\x52\x68\x9a\xc9\xff\x06\x00\x78\xb2\x53\xd2\xc4\x20\x00\x00")
+
+func templatesCliMainGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliMainGotmpl,
+		"templates/cli/main.gotmpl",
+	)
+}
+
+func templatesCliMainGotmpl() (*asset, error) {
+	bytes, err := templatesCliMainGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/main.gotmpl", size: 8388, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientCacheGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x3a\x5d\x6f\xdc\x38\x92\xef\xfa\x15\x95\x06\xd6\x27\x79\x64\xb5\x13\xec\xce\x43\x27\xbd\x40\x26\xf1\xcd\x0e\xd6\x76\x82\xb8\x67\xef\xc1\x30\x6e\x69\xa9\xd4\xcd\xb3\x44\xf6\x90\x94\xed\x1e\xa7\xff\xfb\xa1\xf8\x21\x51\xfd\x91\x04\x07\xdc\x2e\x10\xb7\xc8\xfa\xae\x62\xb1\xaa\x38\xd3\x29\x7c\x90\x15\xc2\x12\x05\x2a\x66\xb0\x82\xfb\x0d\x2c\xe5\x99\x7e\x62\xcb\x25\xaa\xb7\xf0\xf1\x13\x5c\x7f\x5a\xc0\xc5\xc7\xdf\x16\x45\x92\x24\x2f\x2f\xc0\x6b\x28\x3e\xc8\xf5\x46\xf1\xe5\xca\xc0\xd9\x76\x3b\x9d\xc2\xcb\x0b\x94\xb2\x6d\x51\x98\x9d\xbd\x97\x17\x40\x51\xc1\x76\x9b\x24\xc9\x9a\x95\x0f\x6c\x89\x04\x5c\x7c\xf6\xbf\x69\x63\x3a\x85\xc5\x8a\x6b\xa8\x79\x83\xf0\xc4\xf4\x58\x18\xb3\x42\xf0\xd2\x80\x91\xb2\x29\x92\xe9\x14\x2e\x2a\x6e\xb8\x58\x82\xe9\xf1\x5a\x2b\xcd\x5a\xc9\x47\x84\xba\x33\x96\xd4\x0a\x05\x6c\x64\x07\x0a\xcf\x54\x27\x46\x94\x02\x0b\x2b\x36\x13\x55\x92\xf0\x76\x2d\x95\x81\x34\x01\x98\xdc\x6f\x0c\xea\x09\xfd\x2a\xa5\x30\x8c\x0b\x54\xd3\x86\x6b\xe3\x96\xd4\x66\x6d\xe4\x54\xaf\xd8\x9b\xbf\xfd\x6c\x57\x50\x94\xb2\xe2\x62\x39\x5d\xe1\xb3\x5d\xa8\x5b\x07\xca\xa5\xff\x33\xe5\x92\x64\xb2\x5f\x02\xcd\x74\x65\xcc\xda\x7e\x68\xa3\x4a\x29\x1e\xc3\x6f\x2e\x96\x8e\xaf\xde\x88\xd2\xfe\x30\xbc\xc5\x49\x92\x59\x2b\x91\x02\x0a\xf5\x5a\x0a\x8d\x1a\x9e\xb8\x59\x01\x83\x86\x29\x32\xcd\xbd\xac\x36\xc0\x14\x82\x90\x06\x4a\x56\xae\xb0\x4a\x4a\x29\xb4\x81\x96\x3d\x7f\xb0\xdf\xbf\xc8\x6a\x73\xc3\xff\x44\x98\xc3\x6b\x78\xf7\x0e\xde\x9c\x5b\xaa\x76\xf3\xc6\x48\x85\xa0\xe9\x5f\xbd\xc3\xc7\x11\x0b\xae\x28\x1b\x8e\xc2\xe4\xc0\x0d\xb4\x9d\x36\x70\x8f\xa0\x59\x8d\x50\x4b\x05\xa5\x14\x65\xa7\x14\x45\x41\xa7\x31\x31\x9b\x35\xc6\xd4\xb9\x30\xa8\x6a\x56\x22\xbc\x24\x00\xbf\xa2\x49\x1f\x70\x03\x4e\xeb\x0c\xd2\x53\x0b\x5a\x7d\xf1\x8c\x73\xb8\x97\xb2\xc9\x12\x80\x9b\x11\x64\xde\x8b\x06\x3b\x18\x04\xfb\x11\x1b\x34\x18\x13\x4e\xb6\x83\x96\x3d\x28\x70\x0d\x6c\x20\x64\x24\x30\xf8\xf5\x62\x01\x0a\xff\xe8\x50\x1b\x67\x88\x0a\xb8\x00\x16\x69\x10\x69\x34\x50\xd2\x46\x75\xa5\xb1\x2a\xdd\x18\x66\x3a\x6d\xcf\x13\x17\x26\x01\xf8\x07\xb2\x0a\x15\xd0\xff\xc8\xe3\x85\xfb\x4e\x00\xc8\x15\xb4\x0a\x00\xb7\x77\x14\x6d\x09\xc0\x74\x0a\xff\x62\xca\x39\x91\x2c\xfd\xc8\x9a\x0e\x35\xc8\xda\xfb\xc3\x49\xb6\xb2\x24\x34\x08\xd6\x0e\x4e\xb1\x78\x6e\x67\x80\xf7\x66\x74\x94\x59\x4f\xc0\x86\x8d\x34\x2b\x54\x81\x43\x25\x51\x8b\xff\xb0\x3e\x1b\xa1\x26\xe0\x04\x6a\xd9\xfa\xd6\x79\xe9\xce\xfd\x71\x24\x2f\x9e\xd7\x9c\xa2\x85\xbb\x80\xa1\x40\x85\x4e\x18\xde\xc0\xd3\x8a\x97\xab\x11\x29\xb2\x77\xad\x50\xaf\x72\x60\xb5\x41\x45\xe1\xb3\xbb\xaf\xf0\x91\x35\xbc\xa2\x73\x9f\x40\x4f\x9d\xc8\x16\x0b\xde\x62\xe4\x47\x3a\xf9\xe4\x40\xe1\x42\xaa\xc4\xb5\xa1\xe8\xf3\x3b\x31\x5d\x0d\x46\xc6\x8e\xd5\x39\xd4\xb2\x69\xe4\x93\x07\xe4\xca\x12\xc4\xb3\x0f\x52\x18\x25\x9b\xbc\xe7\xcb\x44\x15\x9b\x55\xdb\xb4\xf3\xde\x29\xb1\x23\xb6\xe9\x94\xc0\xca\x9e\x47\xd9\x19\xd0\x28\xaa\x41\x0e\x6b\xf4\x1c\x18\x68\xc3\x1a\x04\x29\x76\x75\xb5\x78\xc0\x8d\x86\x8b\x05\x5b\x82\x24\xdb\x68\xb8\x64\xda\x9c\x5d\xc9\x8a\xd7\x1c\x2b\x20\xa3\x58\x01\x16\x03\xcd\x90\x00\x84\xf3\x65\x8b\x66\x25\x2b\x50\xd8\x52\x06\x1c\xd9\xd6\x48\xaf\xea\xef\x5f\x2e\x89\xca\x40\x28\x18\x89\x42\x6e\x38\xe6\xbf\x7f\xb9\xb4\xea\xbf\xef\xcc\x4a\x2a\xfe\x27\x33\x5c\x0a\x6f\x87\x1c\x34\x9d\x15\x7b\x3e\x40\xaf\x64\xd7\x54\x20\x45\xb3\xb1\x79\x60\xc5\xe8\xd0\xdc\x6f\x7c\x92\xd0\xc4\xc8\x87\xa3\x66\x2d\x82\x46\xf5\x88\x6a\x06\x0b\xc5\x84\xa6\x6c\xfb\x41\x8a\x9a\x2f\x8b\xff\xe2\x66\x65\xdd\x10\x62\xe9\x89\x6d\xc8\x71\x56\xa4\x91\x2e\xf6\x38\x30\x4f\xbf\x48\xea\x4e\x94\x21\x22\x52\x27\xd3\x70\x5a\x33\xf8\x2d\x8a\x0e\x3a\x9f\xce\x55\x40\x58\xa9\xc0\x67\xe3\x0e\xe5\x17\xd9\x89\x6a\xa1\xf8\x7a\x8d\x2a\xdb\x5f\xb2\x27\xbb\xc7\x3d\xf1\x51\xd6\xab\xf0\x42\x84\x66\x40\xff\xe6\x2e\x6d\xcc\xdc\x9f\x6d\x02\xb0\xa5\xa0\xb5\x49\x63\x17\x2d\x4e\x1b\x84\x0b\xfb\x8c\x13\x80\x5d\x95\x88\x1c\x49\x0f\x69\x09\xa7\xbb\x24\x33\xe8\xb1\x53\x85\x7f\xc0\xa9\xa3\xe8\xa2\x85\xf2\xab\xff\x0e\xe9\x15\x95\x92\x2a\xb3\xea\x51\xba\x9c\xcd\xed\x09\xc2\x7f\xe2\x86\xd0\x29\x99\xea\x27\x6e\xca\x15\x05\x5c\x71\xe5\xc2\x8b\x80\x4b\xa6\xd1\x49\xeb\x16\x7f\x45\x33\x3b\xb0\x4c\xb9\x2e\x8f\x17\x3e\xad\x29\x8e\xf4\x68\x6d\xa1\x58\x89\xb3\xd8\xc0\x65\x41\xe6\x18\x0c\x11\x64\xa9\xb0\x66\x5d\x63\x02\xac\x5e\x5b\x05\x60\x36\x3f\x8a\x01\x54\xa9\x10\xcc\x7c\x0e\x82\x37\x70\x72\x62\xf1\x8a\x28\x4b\xbf\x83\xbf\x9e\x9f\x7b\x07\x03\x94\x85\xb5\x77\x31\xdc\x21\xc4\x98\xbc\x18\xc9\xd7\xb3\xb6\xee\x4d\x00\x2a\xae\xb0\x34\xfc\x11\x75\x6f\x42\x9f\x4c\x48\x74\x9f\xf2\x89\xd0\x7f\xe7\x20\xa4\x75\x23\x01\x0e\x68\xb7\x13\x21\xcf\x2c\xe7\xc9\x5d\x00\xb3\x51\x7c\x00\xcc\x92\xb7\x60\xf6\x57\x45\xe9\xac\x13\x15\x41\x06\xe9\xfd\xbd\x4a\x1c\x79\xed\xb7\x4f\x4e\xe0\x95\x43\x28\x5a\x66\xca\x15\x6a\x92\x2d\xf3\x9a\x8f\x49\x59\x63\xe5\x50\xb3\x46\xa3\xd5\x71\x87\x4e\x10\xee\xe4\xc4\xe5\xe6\x6b\xf9\x94\x66\xc5\x2f\x58\x4b\x85\xa9\x67\xe2\x93\x68\x60\x10\x5c\xeb\x36\xc3\x59\xb6\x22\xe4\xc4\x2d\x98\x52\x53\xe5\x30\x9b\x53\xc0\xc5\xd2\x3b\x22\x7d\xfd\x63\xe3\xd9\x15\x1f\x54\xeb\xdc\x23\xb4\x3e\x4f\xe6\xe1\xde\xa4\xcc\x2a\x95\x86\xa5\x04\x49\x57\x78\x29\xd7\x1b\x4b\xc4\x72\x98\x83\xc0\xa7\x74\x74\x3a\xec\xe6\xa9\xdf\x3d\x75\xfc\x9d\x3c\xde\x81\x30\x87\x96\x3d\x60\x1a\x5d\xe3\x39\x34\x28\x62\x1f\xff\xf4\x86\x8c\x0e\xb6\x14\xa2\xeb\x39\x0f\x17\x2c\xa9\xc4\xc4\x12\x61\x00\xf6\x4a\x8d\x78\xdc\x12\xd2\x1d\xcc\x3d\x5a\x14\x7b\x14\xc8\x86\x2d\xfb\x00\xab\x3c\x86\x75\xf6\x84\xee\x8d\x49\xf6\xd6\x81\xbc\x9a\xc3\x64\x72\x88\x7a\x41\x65\xd4\xe4\xb7\xfa\xec\x5a\x0a\x3c\xbb\xa2\x30\x98\xe4\x16\x27\x8e\x72\x5e\xf7\xd6\x3c\xc2\x6d\x74\x33\x11\xdb\x1e\xfe\xfb\xac\x03\xda\xd9\x0d\x17\x25\x4e\xf2\x1e\x77\x10\x61\x9b\x7c\xef\x78\x13\x61\x1f\xdd\x04\xf2\xca\x06\xec\x38\xd2\x6c\x04\x87\x33\x3a\x8e\xdf\xdd\x14\x30\x9f\xbb\x7c\xe4\xb2\xc2\xb5\x34\x41\xc6\x9e\xa2\x5e\x17\x54\xae\x15\x1f\x1a\xa9\x31\xcd\xe2\x60\xf4\x77\x66\x88\x68\x68\x99\xbd\x08\xb9\xa0\x5a\xca\x45\xa3\xc0\xa7\x50\x42\xec\x87\x63\xb7\xa6\x9b\xdd\x1e\xdf\x53\x5f\xb3\x47\xcb\xdf\x0b\xbd\x91\x73\xb2\xef\xc5\xde\x08\xda\x2b\xb7\xcb\xea\x78\x04\x7e\x2b\xa6\xf5\xfa\xff\x4a\x35\xc0\x85\xa2\x6b\x0e\xe8\x7e\xa5\x63\x0a\x59\x12\xe7\x67\xdf\x0f\xe4\x70\xe2\xa1\xb2\xd8\xf7\x01\xf3\x48\x9a\xb1\xd1\x10\xf2\xf0\xd7\xaf\xf0\x8a\x68\xb2\xfb\x06\x53\x42\xd8\xc9\x58\xb4\x34\xca\x50\xd4\x65\xf5\x91\xe9\x7a\xba\xe2\x0b\xb2\xea\x7d\xd3\xa4\x5c\x16\x97\xbc\xe5\x86\xbe\x51\xa5\x7d\xe0\xe4\xfb\x1d\xd8\x4f\xaf\xb3\xe3\x11\x7c\x28\xde\x8e\xc6\x35\xc5\x01\x09\x95\xc1\xdf\x0f\x34\x7a\x3b\x24\x61\x1e\x97\x1d\xf4\x7f\x2e\xad\xf8\xa8\x86\x6f\xcb\xd6\x7d\x6f\x5f\xb8\x2c\xae\xba\xc6\x70\xaf\x13\x75\x2a\xba\xb8\xc6\xa7\xf0\x4d\x9c\xf3\x81\x41\xfc\x7b\x7b\xdc\x90\x87\xb5\xec\xd7\xa0\x37\xed\xb5\x5c\xdb\xfd\x23\x9c\x33\xba\x34\x1e\xa9\x48\x9f\xf9\x33\xb2\xd7\xaa\x10\x61\x8a\x5d\xba\x7c\xa9\x04\xed\xc3\xd6\x37\xdb\xc5\xcd\xba\xe1\x26\x8d\x82\xd8\xe5\x54\x2a\xfd\x27\x59\x0e\x93\x7c\x12\x62\x82\xe2\x86\x48\xcc\x7d\x67\xa9\x8b\x85\xe2\xed\xcd\x9a\x95\x98\x52\x84\x67\x6f\xdd\xfe\x38\x0d\x92\x78\xb7\x36\xc3\x7c\x60\x42\x0a\x5e\xb2\xc6\xf1\xa1\x3a\x8b\xe0\x33\x3a\x19\xc3\xc5\x60\xd9\xdb\xf5\xfe\x9c\x6c\x93\x43\xb1\x3f\x6e\x43\x1d\xbb\x21\xaf\xcd\x76\x13\x5d\x6e\x01\x1c\x8f\x19\xf4\x61\xe1\xf3\x89\xdd\x24\xaf\xcd\x7c\x57\x4a\xf6\x75\x28\x64\x89\xb0\x4a\xca\xb8\x55\x7f\x62\xed\x46\x38\xb3\x11\xc1\x8c\xa0\xb6\x59\x72\x20\x00\x5c\x1b\x17\x4a\xcd\x50\xf1\x53\xcf\x21\xeb\x71\xcf\xe4\x07\x1c\x2b\xa6\x57\x54\xf6\x53\x5b\x54\x2a\xac\x50\x18\xce\x1a\x4d\x9d\x12\x61\xf2\x2a\x6c\x6a\xbe\x14\xa8\x5c\x53\x10\x97\xb2\xbb\x95\xb0\x73\x5f\x5c\xf8\x92\xf9\xa9\x39\xba\xb1\x3b\xa9\x3f\x9b\xac\x33\x2b\x98\xed\x39\x67\x32\xea\x8b\xe8\x06\xb4\x80\xb1\xdb\x75\xd7\x12\xa2\x1b\x11\x15\x37\x5d\xfb\xe6\x6f\x3f\xa7\xae\xd1\x4f\x09\xd8\x27\x6b\x2a\xbb\x7f\x9a\xc3\x04\x26\xf0\x13\xac\xf0\xb9\xb8\xa0\x41\x12\x2e\xa4\x97\x43\x77\xed\xed\xec\x2e\xf3\x21\xc0\x6b\xaf\xe1\x01\x99\xdc\x86\xb7\xfd\xdb\x00\x17\x8b\xd4\xf3\xf2\x7b\xc4\xd2\x1b\x2c\x1c\x49\xeb\xa8\x07\xdc\xf8\x4e\x3b\x2e\x65\x61\xcd\x94\xf6\x93\xa1\xa1\x24\x0d\x1e\x1b\xb5\xd0\xfe\xb6\x8b\xdc\xe0\x37\x52\x3f\xa0\x88\xee\xb1\x6c\x7f\xb6\x60\xdd\x12\xb1\xf8\x91\x93\xdd\x83\x1f\x3b\xde\xab\xc8\x7b\x23\x61\x77\x8e\xf8\x9a\x29\x63\xcb\xb5\x11\xfa\x75\xba\x7f\xe0\x7b\x96\x44\x60\x3e\xc9\xc1\xd7\x7e\xbc\x26\x53\x19\x7d\x7b\x7e\x07\xf3\xc8\xfc\x40\xc3\x31\xc3\x45\x87\xd1\xf5\x67\xef\x43\x62\x37\x99\x04\x64\xca\xe7\x96\x40\x46\xe8\x6f\x7a\x6c\x07\x3a\x4e\x3d\x0e\xf0\xf6\xf5\x5d\x0e\xff\x9e\xfc\x3b\xae\xe2\x7a\xe9\xb4\xb7\x99\x2e\x16\xf2\x52\x3e\xa1\xf2\x38\xe7\x77\x59\x7f\x21\x8f\xfd\x3f\xa0\xfa\x30\x08\xf7\x23\x18\x6c\x1a\x6d\xcf\x45\xdf\x8e\x43\xc9\x04\x15\x3c\xd6\xcd\xd5\x0c\x18\xbc\x39\x3f\x1f\x76\xc3\x44\x24\xf4\x37\x39\x9d\x7c\x37\x1c\x0a\xf3\x20\xeb\x42\x46\x13\x23\x1a\x8e\xa8\x40\x30\x9e\x05\xd9\x40\x1a\xdd\xd2\xfd\x81\xf6\x73\x3f\x3b\x29\xb4\xa6\xe2\xf5\x5e\x81\xf7\x6a\x54\xe0\x7d\xfa\x27\x7c\xfd\x3a\xb2\xa2\xf3\xe7\x91\x0b\xc0\x7a\x61\x72\x1a\xbc\xe8\x6d\xb4\xd3\x1b\x8d\x1b\xbb\x51\xc8\xc7\x29\x31\xee\xf3\xde\xf6\x18\x47\x09\xfb\xa5\xfd\x1e\xeb\x50\xba\xcd\x48\xab\x68\x21\x6e\x0c\x7c\x1a\x38\x04\xb0\x53\xcb\x3b\x48\xef\x77\xcf\xe6\xe8\x78\x2f\x1a\x9f\x2a\x2c\x91\x3f\x62\x05\x42\x3e\x45\x93\xbe\x5a\xc9\xd6\x0e\xb3\x5a\xf6\x7c\x46\x83\x7f\x3f\xdb\x0a\xd5\x5e\x9c\x28\x82\x52\x87\x72\x44\x3f\x02\x3c\x90\x1b\x0e\x24\x18\x9f\xbd\x7f\xa4\x8f\x7e\xdb\x83\x8c\xdc\xd0\x33\x7c\x09\xd7\x2f\xb5\x45\xec\xf9\xfd\x12\x73\x90\x0f\xbb\xf4\xbc\x7a\x44\x4e\x3e\x78\x4a\x1a\x4b\x29\x2a\xdd\xd7\x8a\x7e\xce\x5f\xbc\x37\x92\xa7\x8e\xd4\x68\x46\xe1\x6b\x40\x8a\x4d\x87\x09\xef\xe6\x30\x8c\x26\x0e\x0a\x16\x4e\x7b\xbc\xe9\xba\xf1\xf7\x55\x95\x5a\x25\x3e\x76\xca\xde\x52\xa9\xa7\x9a\xc1\xa9\x83\xbb\xb1\xdf\xd1\xd5\xd2\xe7\xa2\x38\x57\x7a\x4f\xd1\x1d\xe7\xf6\xe3\x1b\x85\xd7\x60\x7a\xfd\xec\x11\xfb\x4c\x97\x04\x89\x97\x5a\xe8\xec\x6d\x3c\x7e\x09\xaa\xf0\xda\x8e\x33\x8f\x61\xc6\xec\x3f\x32\x83\x93\xec\x30\x99\xbe\x07\x6b\x78\x8d\xa4\x12\x05\x9e\xc2\x86\x51\x68\xd0\xf0\x90\x82\xb6\x6c\x64\xf9\x10\x6e\x29\x37\x7d\xec\xd1\x8f\x58\xad\xb8\xe9\xee\x53\x12\xd0\xdf\xd1\xc1\xc8\x03\x42\x6f\xf9\x6d\x72\xd0\x31\xf1\x8c\x6e\x5c\xa4\x65\x10\x4d\x5f\x76\xab\x92\x3e\x87\xed\xb4\x5a\xc3\x9d\x56\x16\x54\x8b\x79\x0b\xf0\x7a\xb7\x06\x20\x1c\x7b\x84\x1d\xda\xcb\x58\xec\x90\x5a\xf6\x45\x57\x1d\x7e\x53\xe6\x70\xcc\x0f\x09\x1d\x3e\x7d\x22\x20\x9e\xfe\x04\x87\x7b\x7b\xbf\x6b\xf5\x5f\x59\xb6\xaf\x6b\xdc\xae\x7a\x30\xaf\xc7\xea\x48\x27\x19\x29\x72\x32\x92\x25\x2e\x88\x43\xf9\x0a\x75\x6b\x8a\x9b\xb5\xe2\xc2\xd4\xe9\xe4\x2f\x15\xfc\x45\x4f\x72\x28\xa3\xdb\xc2\x0f\x27\xdd\xc2\x02\x9f\x4d\x1a\xef\x66\x59\x1e\x51\xa5\x15\x4b\x79\x44\xc0\x02\x7c\x56\xd2\xc8\x9e\x2b\x4c\xfe\xb1\x58\x7c\x9e\xbe\x2e\x5e\x4f\xa2\xed\x2b\xf6\x3f\x52\x59\x98\xd7\xf1\x2a\x17\xe3\xd5\xb8\x62\xef\xed\xb0\x5f\xaf\x03\x7c\xbf\x67\x2a\x6d\x77\x15\x94\xa0\x0a\x08\x85\xb9\x44\xb1\x34\xab\x19\xbd\xa4\xfc\xfc\xd7\x94\xca\x8f\x31\x98\x77\x75\xe0\xa3\xf0\x8f\x3c\x0c\xb6\xa7\x53\xb8\xc6\xa7\x2b\x6c\xa5\xda\xd8\xa0\x81\x52\x21\x33\xf4\x78\x12\xcd\xac\xe1\x01\x71\x4d\xef\x21\xcc\x40\x2b\xb5\xa1\x44\x7a\x21\x8c\xe2\xa8\xfb\xd0\xd2\xf4\xd2\xd6\x5a\x42\x79\x78\xe8\x6c\x90\x69\x63\xaf\x16\x61\x9a\x0d\x0d\x54\xe8\xa5\xc1\x3f\x56\xb8\x67\x8e\x0a\x6a\xae\xb4\x71\xa1\x3b\x16\x25\x8d\xb8\x70\x61\xb2\x58\xa0\xe8\x29\xe0\xa4\x1d\x50\x5e\x06\x94\x59\x24\x64\x0e\x38\x2c\x8e\x0b\xd1\x53\x7a\x13\x2e\x2e\x1a\xa4\x87\xef\x2c\x07\xa9\xac\xab\xec\xea\x35\x3e\xa5\xd9\x30\xff\x8f\xf8\xc4\x3d\x78\xdb\x79\xbb\x02\xd0\x8b\x6f\x71\xd5\x19\x7c\x4e\x20\xb6\x91\x7b\x49\xf4\x32\x10\xe4\x31\x01\x12\x70\x02\xb8\x11\x8c\x93\xed\x92\x6b\xb3\x23\x03\xd1\xdd\xc4\x32\x50\x67\x40\x18\xbe\x2a\x4a\xe0\xe8\x03\xeb\x90\x26\x5a\x38\x8d\x34\xca\x7e\xf4\x31\xd7\xf2\x6b\x8b\xb6\x2b\x2e\x65\xf9\x60\xfb\xac\x0a\x6b\x54\x6e\xed\x77\xd1\x84\x55\x74\x2a\x85\xdb\xb6\x2d\xbc\xfe\xb7\x0f\xb8\xa1\xe9\x37\xaf\xe1\x55\x7f\xd7\x7a\x5f\xee\x8d\xad\xdb\xc2\xda\xa3\xb8\x92\x8f\xb8\x90\xff\xa9\xa4\x30\xa9\x27\x1c\x75\xa6\x7e\xa5\xf8\x17\xa5\x94\x22\xf5\x7a\x59\x2b\x65\xfd\x10\x29\xdf\x49\x93\xbb\xfa\xdf\x8c\xf4\xff\xc6\x13\xf5\x0f\x5b\x80\xd7\xdf\x36\x42\x54\x6b\xfc\x90\x06\x30\x8f\xdf\x75\xbf\x6b\x9c\x60\x9e\xde\x94\x31\x6f\x98\xf7\xe8\x9f\x3b\xbd\x72\xc8\xfe\x28\x59\xb6\x2f\x0f\xb8\x99\x51\x73\x3d\x58\x62\xd6\xff\xda\x86\xc4\xdf\x16\x51\x98\xff\x1d\xce\x69\x3c\x1b\xe8\x5e\xa2\x48\xed\x4c\x2b\x86\x21\xdb\x01\xc8\xa6\xa2\x07\x80\xd9\x20\xc4\x2f\xcc\x1b\x6d\x50\xeb\x8b\x4d\x10\xa9\x83\x25\x86\xf4\x82\x64\x9f\x75\x7a\x55\x72\x4f\xe9\xb0\xdd\xfc\x13\xca\xf6\xb8\xcf\xf7\xff\x53\x83\xff\x0f\xe7\xee\x28\x34\x72\xd1\xbe\x46\x0f\xb8\xc9\x12\x80\x6d\xb2\x4d\xfe\x77\x00\xae\x3d\x24\xfc\xda\x23\x00\x00")
+
+func templatesClientCacheGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientCacheGotmpl,
+		"templates/client/cache.gotmpl",
+	)
+}
+
+func templatesClientCacheGotmpl() (*asset, error) {
+	bytes, err := templatesClientCacheGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/cache.gotmpl", size: 9178, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7b\x5f\x73\x23\x37\x8e\xf8\xbb\x3e\x05\xa2\xdf\xfc\x9c\x96\xb7\xdd\x4a\x5e\x35\xd1\x56\xf9\xc6\xb3\x9b\x49\x25\x33\x53\xb6\x73\x79\xc8\xa5\x52\x74\x0b\x92\xb8\x6e\x35\x7b\x48\xb6\x6d\xad\x56\xdf\xfd\x0a\x24\xc8\x66\x4b\xed\x3f\x93\xc9\xdd\xed\xcb\x4c\x8b\x04\x41\x00\x04\x40\x00\x84\xa7\x53\x78\xa3\x16\x08\x2b\xac\x51\x0b\x8b\x0b\xb8\xd9\xc2\x4a\x9d\x99\x7b\xb1\x5a\xa1\x7e\x0d\x17\x1f\xe0\xfd\x87\x6b\x78\x7b\xf1\xee\xba\x18\x8d\x46\xbb\x1d\xc8\x25\x14\x6f\x54\xb3\xd5\x72\xb5\xb6\x70\xb6\xdf\x4f\xa7\xb0\xdb\x41\xa9\x36\x1b\xac\xed\xc1\xdc\x6e\x07\x58\x2f\x60\xbf\x1f\x8d\x46\x8d\x28\x6f\xc5\x0a\x09\xb8\x78\x2f\x36\xe8\x46\xa7\x53\xb8\x5e\x4b\x03\x4b\x59\x21\xdc\x0b\xd3\xa7\xc4\xae\x11\x98\x14\xb0\x4a\x55\xc5\x68\x3a\x85\xb7\x0b\x69\x65\xbd\x02\x1b\xd7\x6d\x1c\x29\x8d\x56\x77\x08\xcb\xd6\x3a\x54\x6b\xac\x61\xab\x5a\xd0\x78\xa6\xdb\xba\x87\x29\x6c\xe1\x68\x16\xf5\x62\x34\x92\x9b\x46\x69\x0b\xd9\x08\x60\x8c\x75\xa9\x16\xb2\x5e\x4d\xff\x61\x54\x3d\xa6\x91\xe5\xc6\xba\xff\x6b\xb4\xd3\xb5\xb5\x4d\xfc\xd1\xea\xca\x7d\x37\xc2\xae\xdd\x87\xb1\xba\x54\xf5\x5d\xf8\x96\xf5\xca\xb8\x6f\x2b\x37\x38\x1e\xd1\xd7\x4a\x55\xa2\x5e\x15\x4a\xaf\xa6\x0f\x53\x42\x58\xaa\xda\xe2\x83\xdf\x60\x25\xed\xba\xbd\x29\x4a\xb5\x99\xae\xd4\x99\x6a\xb0\x16\x8d\x9c\xa2\xd6\x4a\x9b\x27\x00\x48\x42\x4f\x4c\xeb\xb6\xf6\xfb\x03\x10\xf5\x56\x8b\xda\x38\x76\x9f\x86\x9f\x96\x95\xc4\xfa\x29\xc2\xee\x44\x25\x17\xc2\x7a\xce\x8c\xd5\xcb\xcd\xa3\x38\xfd\xac\x03\xdc\xed\x40\x8b\x7a\x85\x50\x5c\xe0\x52\xb4\x95\x7d\xe7\x84\x6f\x60\xbf\xdf\xed\xa0\xd1\xb2\xb6\x4b\x18\xff\xff\x4f\x63\x28\xf6\x7b\x0f\xcf\x2a\x94\xac\x7d\x75\x8b\xdb\x1c\x5e\xdd\x89\xaa\x45\x98\xcd\xa1\xe8\x21\xa1\x59\xd8\xef\xe1\x00\x1f\x83\x1f\x60\x9d\x8c\x48\xa9\xde\xe3\x3d\x94\x1a\x85\x45\x03\x02\x6a\xbc\x27\x88\x75\xbb\x11\xb5\xfc\x27\x46\x7d\x85\xf3\x8f\xef\xc0\xcb\xa5\x18\x2d\xdb\xba\x84\xf7\x78\x9f\x75\x12\x65\xd1\x15\x6f\x1c\xc8\x75\x18\xcf\x61\xa9\xf4\x46\x58\xc3\x52\x2a\x2e\x71\x25\x8d\xd5\xdb\x09\x9c\x7a\x50\xd8\x8d\x00\x34\xda\x56\xd7\x70\xe2\x87\x76\x11\xed\x0c\xec\x11\xa6\x59\xf8\xd8\x8f\xbc\x15\x35\x1a\xad\xdd\x7e\x24\xf1\x81\x24\x1e\xd6\x58\x35\xa8\x81\xa8\xb4\x52\x91\x05\x08\xcb\x5b\xd0\xb4\xb1\xba\x2d\x2d\xc8\x1a\x34\x8a\x85\xb8\xa9\x90\x88\x23\xbb\xf2\x88\x0b\x78\x67\xbf\x36\xd0\x1a\x5c\xd0\x56\x7e\x0b\x59\x3b\xcb\x73\x1a\x09\x1b\x34\x46\xac\xd0\x80\x6a\x1d\x1e\x83\xfa\x0e\x35\x68\x34\x8d\xaa\x0d\x1a\x96\x50\x42\x58\x76\x07\xb2\xb6\xa8\x97\xa2\xc4\xdd\x7e\x12\x36\x24\xde\x6f\x72\xf8\x9d\x0e\x92\x8c\xae\xf8\x49\x68\xb3\x16\x55\x76\x37\xe9\xa4\xc2\xe6\x54\x5c\x62\x53\x89\x12\x33\xff\x3b\xbb\x99\xe4\x30\xfe\xaf\xf1\x38\x87\xf1\xd7\xe3\x1c\xce\xbe\x9d\xb0\x3c\xbc\x10\x3f\x34\x8e\xf7\x8d\xd8\xc2\x0d\x7a\x66\xac\x82\xb2\x35\x56\x6d\xe8\x60\x05\x18\x59\xaf\x2a\x84\x52\x54\x15\x6c\xc4\x02\x83\xdb\xf1\xeb\x47\x76\xdb\x60\x1f\x17\x31\x95\x9d\xf6\x4f\xfa\x43\x43\x3e\x4b\xaa\xda\x2b\xd3\x2f\xd2\xae\xdf\xd6\x8b\x46\xd1\x61\xa8\x3b\xd4\x5a\x2e\xd0\x78\x1f\x54\xae\x71\x83\x39\xac\x95\xb1\x20\xea\x05\xdc\x08\x83\x40\x0e\xc4\x53\x77\xb3\xed\xd3\xe4\x3d\xde\xa6\xb1\x5b\x70\xda\x6b\xe0\x16\xb1\xf1\xa8\xd0\xd2\x69\x18\x50\x4b\xf7\x3b\x2a\x49\x42\xbf\x73\xa9\x5e\xaf\x17\x70\x2f\xed\xba\x08\xf4\xd1\xa1\x89\xda\x79\x04\xd6\x68\x42\x08\x4e\x51\x88\x20\x2d\x36\x26\x77\xdf\x44\x06\xa9\x94\x21\x18\xc2\xe1\x46\xb5\x6a\xeb\x05\x58\x2d\x1b\xd2\x32\xe2\x84\x46\x4b\xa5\x6e\x25\xc2\x3f\x84\xf6\x54\x09\xdb\xe9\x2e\xeb\x43\x2a\x9c\x2c\x15\x47\xee\x64\xf1\x91\x44\xe1\x0f\x77\xd2\x17\x7c\x62\x22\x84\x28\x53\x0d\x3c\x7a\x0c\xce\x9e\x20\x30\x36\x9b\x93\x45\x67\xc4\x2b\x43\x92\x62\x01\x5d\x65\x2a\x8c\xc0\x57\x73\xa8\x65\xc5\x0b\x01\x4e\x79\xed\x1c\x4e\x23\x8c\x9b\xda\x27\x98\x8b\x68\xe2\x30\x87\x13\x64\xae\xe2\x60\xc0\x55\xe3\x83\x9d\xc1\xd0\xb2\x9c\x21\xbc\x1c\x66\xf1\x2b\x8c\x93\x5c\x66\xf1\x2b\x8c\x06\x39\xcd\xe2\x57\x98\x31\xb8\xa2\x5b\xd8\xcc\x9c\x4a\x5d\xf1\xaf\x4c\x35\x05\xc1\x7f\x14\xd6\xa2\xae\x27\x79\xc2\x48\x27\x80\x39\x53\x37\xa2\xa9\x7d\x54\xe4\x77\x64\xb1\x25\x36\x56\x69\x03\xf7\x5a\x34\xe6\x40\xdb\xd4\xf2\xc0\x8c\x9c\x8e\xc8\x64\x59\x0e\xf7\x6b\x59\xae\x9d\x19\x6e\xd4\x42\x2e\xb7\x20\xad\x01\x8d\x9f\x5a\x64\x33\xf0\xbf\xbd\xe7\x70\x3a\x7a\xbd\x46\x58\x4a\x6d\x6c\x8a\x09\x0c\xb2\x1d\x85\xb5\x0e\xa4\x78\xb9\x46\x13\x6a\xbb\xc6\xad\xe3\x64\x40\x91\x3f\xcf\x96\xa2\x42\xa7\x42\xca\x52\xd6\xa1\x28\x0a\x82\xf2\xca\x77\x49\x7b\x5d\xfb\xad\x26\x30\x30\xf4\xef\xab\xf0\xa4\xc3\x84\xf7\x50\x83\x03\x5e\x37\x3f\xef\xa3\xf4\x63\x9e\x4f\xbe\xec\xfb\xeb\x3c\xea\xa5\xd2\x20\x09\x77\x85\x75\x4f\x78\x13\x38\x83\x6f\x5f\x83\x84\xbf\xce\xe1\x9b\xd7\x20\xcf\xce\x0e\x51\xa7\xd0\xbf\xca\xdf\x32\xda\x71\x92\xa0\x3e\xa4\x16\x48\x30\x0f\xf6\x79\xcd\x3f\xb2\x65\xd0\x78\xaf\xa5\x65\xf5\xfb\xf9\xf2\x47\xb8\x69\x65\x65\xc3\x75\xd1\x29\xcc\x0d\x2e\x95\xc6\x9e\x92\xae\x94\xbf\x25\xfd\x6d\x72\x8c\x9a\xef\x62\xe2\x8d\xa8\x23\xe2\x8e\x95\x63\x14\x7c\x03\x39\x09\xe7\x1f\x47\xde\x2b\x00\xa4\x23\xc1\x23\x74\x23\xc1\x27\x90\x21\x11\x77\xa4\x4b\x90\x21\x9c\x1e\x11\x32\x81\xb8\x61\xa6\xf1\x13\x9c\x7a\x22\x3c\x17\x13\xc8\xc2\x6f\x6f\xa6\xb9\x8f\x03\xbc\xea\x4d\xa7\x20\x0e\x4c\x69\xd3\x1a\x0b\xb5\xb2\xc1\xe4\x53\x89\x48\x6f\x4d\x2b\x79\x87\x35\x69\x79\x4f\x63\xc3\x86\x23\x80\x53\x4d\xfa\xa8\xf1\xd3\x08\xa0\x25\xa0\x53\x8d\x9f\x8a\x9f\x2f\x7f\x1c\x39\xa5\xc3\x82\x45\xf2\xd5\x1c\xc6\x63\x56\x8e\xb6\xb8\xf2\x83\xf3\x38\xef\x0e\x96\x57\x38\x91\xf5\xe1\xbf\xa7\xa1\x39\xcf\x39\x1c\xfa\x68\x2c\xae\x8f\x02\x4e\x71\x4c\xa7\x3d\xf6\xc8\xf9\xd2\x95\x79\xa0\x19\xf1\xaa\x5f\xaa\xaa\x52\xf7\x5d\x8e\x83\x0f\x8d\xa8\x17\xb8\x70\x5e\x9b\xfe\x21\x37\x3d\x73\x4e\x32\x9e\x9e\xd0\x74\xbd\xb6\xb5\xc5\x45\xd8\x52\xfa\xcb\x1a\x4d\x29\x1a\x5e\x9c\xc3\xfd\x1a\x35\xf6\xc6\x4d\x25\xcc\x1a\x63\x9c\xc0\x7b\x68\xb1\x41\x8b\xda\x80\xd0\x58\x7f\x4d\xbe\x92\x2e\x7d\x32\x24\x87\x3e\x2c\x9e\xcd\x81\x45\x5e\xbc\xf5\x43\xc4\x7c\xe6\x8d\xac\x11\x14\x73\xcf\xe6\xac\x6c\xa6\xb8\x6a\x2a\x69\x39\x36\x33\xc5\xb5\x96\x9b\x8c\x11\xe5\x30\x9e\x8e\x29\x58\x9b\x8e\xa3\x2f\x22\x7b\x77\x28\x26\xf0\x57\x3a\xab\xc0\x6a\x30\x72\x37\x07\x73\x22\xd6\x9a\x5f\x3b\xe8\xb3\x0e\x76\xf6\x5b\x62\xed\x5a\xdc\x13\x71\xa4\x27\xc4\x64\xf1\x83\x92\x75\x36\x9e\x8e\x73\x16\x05\x4d\x66\xdd\x09\x4e\xf2\x48\xb7\x83\x74\xc8\x3d\x85\x91\xc4\x00\xf0\xbd\x30\x57\xed\x72\x29\x1f\xfa\xfc\xc0\xc9\x09\x7c\x75\x0c\xc3\x84\x30\x4c\x60\x27\x90\xf7\x97\x39\x8d\x27\x74\xb7\x75\x44\x8a\x5a\x13\xf9\xad\xae\xdc\x8d\xfd\x33\xcf\x04\x8c\x91\x2e\x82\x3b\x70\xe0\x7c\x55\xd4\xb2\x72\x96\x99\xe2\x77\xb8\x72\x68\x8b\x4b\x26\x61\x9e\xee\xc9\xb8\x59\xc9\x35\x9d\x35\x85\x33\xed\x28\xf8\xa3\x19\xd9\x01\xfb\xcd\x41\x57\xff\x8c\xa3\xdf\x77\x17\x19\x41\x76\x6e\x2d\xd3\x21\x5c\xff\x45\x8b\xe6\x3d\x61\x19\x8a\x30\x0c\xd6\x94\x91\xb3\x89\x91\x1b\xb6\x58\x07\x63\x7b\xd2\x9f\x05\xb4\x19\xa1\x85\xcf\xb8\x88\x89\x2f\xb9\x74\x51\x42\x83\x3a\x07\x75\xdb\x49\xa1\xc8\xba\x2c\x26\x12\x9e\x7d\x06\xf2\xfd\xe4\x35\x21\xa4\x3d\x20\x6c\x51\xf4\x48\xf5\xe7\xec\x65\xc6\x02\xf4\x7b\xc3\xdc\x2d\xc8\xfc\xaf\x20\xbd\x4e\xbd\xf9\xd3\x00\x8a\x72\x1d\x5c\x3f\x59\xbe\x70\xee\xc1\x0b\x2b\xb1\x86\x86\x4d\xa0\x97\x8c\x05\xe3\x3a\xb6\xec\x26\x1a\xb0\xbb\xb4\xf3\xb8\x03\xb9\x09\x97\x99\xc7\xb5\x9e\xbb\xf0\xf3\x57\xf9\x1b\x74\x6a\xed\x3d\x49\xc6\x93\x93\xbe\x8a\x84\x1d\x9d\x4d\x86\xf5\xbc\xaf\x67\x37\x6a\x06\xdf\xdf\xec\x69\xd3\xc8\x8f\x7d\x1d\xc5\xa3\xce\xf1\x89\x6e\xd1\xec\xc5\xf9\x8b\x08\x09\x7d\x4e\xbb\x1a\xc5\x09\x0d\x45\x93\xa8\x7d\xca\xa8\x5c\x6e\xe2\x03\xe3\x26\xea\x68\x0f\xb7\x43\xe4\x80\x5d\xea\x46\x4e\xfd\xfa\xc7\xab\x1c\x1a\xad\x1e\xb6\x6e\x67\xde\x35\x24\x74\xfe\x90\x0e\x98\x7c\xbe\xca\x30\xe1\xfb\x9a\x85\x42\xf2\x37\xf7\xd2\x96\x6b\x70\x19\x50\x5c\x5f\x64\x14\x86\x78\x15\x2f\xe9\x4a\x4a\xb4\xf9\xfb\xeb\xeb\x8f\xbc\xdf\x01\xba\xfd\x2c\x51\x49\xb0\x45\x0a\x19\x10\x9d\xf6\xea\x4b\xc5\xa5\xa7\xb3\xb7\xf0\x24\xc1\xb9\x8b\xa4\xcf\x20\x89\xd1\x72\xf8\x41\x68\x1a\xf9\x41\xe8\x7d\x5f\x35\x8e\x82\x5a\xaf\x0f\x69\xae\xe3\xef\x48\x4e\xb6\xc3\x58\xd4\xff\x70\xb7\x72\x69\x22\x59\x97\xf1\x0c\x2b\xfc\x84\xa2\x25\x27\x22\xab\xe5\x66\x83\x8b\xd4\x16\xdc\xbd\xc6\xf0\xd1\x20\xe4\x32\x82\xce\x93\xc8\x80\x29\xff\x66\x58\xc7\xdf\x10\xb1\x19\xaf\xe3\xeb\xe2\x2f\xf0\x2d\x99\xf5\x6e\x07\x0b\x5c\xca\x1a\x61\x5c\xf6\x83\xfd\x73\xbd\x32\x63\xd8\xef\x33\xba\xb0\x37\x06\x4e\xa9\xce\x25\x4c\x29\xaa\xb4\x56\xf5\xd1\x4d\x72\xc1\xf6\xbc\xb5\x6b\xa5\xe5\x3f\x91\x4a\x5e\x39\x88\x96\xf2\xba\xa5\x3a\x50\xa5\x73\x1e\xfe\x85\x02\x5d\xbd\xdb\x61\xbd\x70\xf5\x34\x2a\xf9\xd2\xe5\x66\x35\x8a\x8d\xac\x57\x21\x02\x74\xb8\xc8\x1d\xa3\x06\xa9\x8a\xb0\x8c\x2b\x6b\x39\xa8\xc6\xba\x04\x28\xcd\x6a\x26\x5d\xe5\xed\x71\x0e\x7f\x11\xd2\xfe\xef\x72\x99\x03\x41\x50\x51\x84\xfe\x2f\x2e\x5a\x4f\xc8\x17\xf0\x70\x89\xa6\xad\xac\x3b\x28\x26\xef\xaa\x2d\x4b\x34\x26\x91\x5e\xd6\x15\x43\x0f\x26\xa9\x92\x39\xcc\x71\xde\xd5\x2e\xe3\x87\x0b\xc4\x1f\xdd\x65\x72\xbc\xa0\xab\x90\x5d\xa1\xbe\x93\x25\x86\x68\x35\xfa\x82\xe0\x41\x9f\x29\x83\xe6\xae\xaa\x04\x02\x36\x68\xd7\xca\x95\x0a\xfd\xd5\xa3\x82\x1c\x5c\x36\x7f\x81\x0d\x51\xa0\x6a\x90\x16\xb4\xb0\x6b\xd4\xe4\x4c\xeb\x90\x9d\xb3\x93\xb1\x0a\xb4\xaf\xef\x39\xfb\x65\x5f\x4e\x61\x2e\x1a\xba\x03\x1c\xf6\x07\xb1\x69\xaa\x58\xa7\xfb\x49\x95\xb7\xbc\xba\x7b\x3a\x70\x34\x9d\x9d\xd1\x7f\x67\x1b\x55\xde\x9a\x22\x2d\xe4\x45\x96\x23\xaf\xbb\x5e\x5d\x3a\x1e\x21\x97\x93\x8f\xcf\x60\xb7\x03\x8b\x9b\xa6\x12\xf6\xf8\xdc\xbd\xde\x16\x5c\x7f\x7e\x14\x2c\xaa\x07\x41\x72\x5d\xfc\x78\xa3\x73\xb3\xad\xcb\x17\xee\xf6\xdd\x59\x49\x12\x1d\xc4\xe3\x77\xe3\x6d\xc8\x9c\x3f\xaa\xaa\xa2\xeb\xea\x11\x06\xcf\xeb\x05\xd9\xe0\x53\x3b\x77\x36\xfa\x87\x78\x25\x75\xd8\xef\x93\xcf\x2b\xec\xae\xb2\x17\x5c\x76\xa3\xfd\xe8\x91\x13\x23\x7d\x7b\x42\x08\x41\xd3\xb5\xff\x45\x57\x43\x0d\x82\xe4\xbc\xd6\xaa\x56\xad\x19\x5e\xec\xae\x7b\xaf\x45\x4f\x21\x4f\x52\xf9\x27\x6d\x7b\x78\x8f\x61\x8b\x4f\x05\xf6\x56\x6b\x0a\xee\x95\xf6\xfc\xb3\x35\x8f\xa6\xa7\x23\x36\x82\xe8\x05\x36\x1b\xa1\xb7\x7e\xa7\xfe\x2f\x3a\xfe\x0b\x34\xa5\x96\x2e\x76\x21\x89\x11\xaa\x9b\x4a\x95\xb7\xf1\x15\xaf\x0f\x10\x77\xa2\x8f\xca\xe0\x21\x8e\xfd\xfe\x05\x08\x68\xdd\x7e\x4f\x26\xfc\x98\x4f\xe9\x18\x3a\x9d\xa6\x06\x9b\x4a\xf5\x59\xcd\x18\xc1\x63\xaf\x2d\x7c\xab\x0e\xe9\xcc\xf4\x74\x34\x7c\x22\x43\xe2\x6c\xaa\x56\x3b\xb0\xbf\x51\xf1\xf1\x17\xa5\x17\x90\x75\xfc\x30\xe8\xe4\xdf\x41\xd8\xcf\x0a\x3a\x52\xd8\x68\x2c\x45\xa4\x30\xfc\xc6\xc5\x0c\x06\x36\x8b\xc0\xc5\x7b\x65\xc9\x93\x26\x44\x8f\x4e\xa7\x9c\x91\x89\xf0\xb8\x35\x19\xb6\x98\x17\xba\xb5\x17\x3b\x96\x50\x87\xba\xfe\x70\xf1\x61\x06\xff\xc9\x8f\x93\x49\xf1\x37\x94\xe6\x38\x9d\xf4\x31\x1b\x4f\xf5\xd2\xd9\x30\x46\xaf\x7b\x83\xa4\xfb\xa8\x23\x0b\x89\x0b\x3d\x39\x56\x58\xaf\x7c\xe9\xa1\xc2\x7a\xd0\xe4\x47\x54\x72\x24\x80\x93\xbe\xe6\x46\x6e\x88\x7e\x80\x77\x17\xb3\xc3\x87\xcb\xb0\xad\xaf\xdb\xff\xe4\x6e\xda\x63\x20\x3f\x1e\xc1\x92\x82\xff\x31\x2c\x4d\x76\x90\x5a\x2d\xda\x12\xcd\x4f\xb8\x90\xe2\x7a\xdb\xa0\xe9\x2f\xf8\x7f\x77\x63\x28\x8e\x81\xe2\xfa\x37\xaa\x36\xed\xe6\x99\xf5\xc7\x40\x71\xbd\x2f\xd7\x0d\x2d\xe2\x99\x08\xe9\xe5\x3e\xe3\x43\xf3\xe2\xb8\x44\xb1\x40\x3d\x83\x93\xc1\x93\xf2\xb3\x3b\xf6\x08\x33\x10\x05\x7f\xbe\x2c\xac\x9d\xf1\xff\x51\xbd\xf7\xf9\x50\xac\xe9\x08\x09\xd1\xf3\x2c\x06\x9e\x04\xeb\x62\xe8\x20\x26\x7a\xe9\x0f\xd4\x17\xfc\x9b\x65\xe8\x14\x3b\xce\x75\xd9\x55\xce\x3a\xc6\xe5\x32\x0a\x47\xa9\x5a\xf6\x0d\x9c\x9c\xa4\x15\xec\x54\x7d\xb9\x18\x99\xa6\xa6\xc3\x69\x69\xbf\xca\xc2\x03\x49\xe5\x3f\xad\x90\x47\x38\x4e\xfa\x44\x11\x47\x82\x19\x90\x6b\xff\xdd\x45\xcc\x5d\x39\x80\xe8\x65\xb2\x54\x63\x33\xd5\x78\xe0\x2e\x10\x39\x30\x14\xd8\xef\xfd\xc5\xbc\xdb\x61\x65\x70\xbf\xff\x3d\x8a\x3e\x16\xc7\x92\xad\x8b\xab\xf6\x66\x23\x03\xde\xa1\xba\x18\x27\x5c\x8f\xee\xe6\xb4\x66\x71\xd5\x6a\x5f\xbc\x1e\xd7\xb2\x1a\xf3\xbf\xdf\x44\xab\xee\x45\xdd\xbe\xb6\xb6\x7f\x8a\x05\x3f\x81\x9f\x22\x82\x6f\x1d\x5f\x8e\x12\xcf\x5e\x91\x1d\xdc\xf5\x07\x48\x82\xfe\x4e\x72\x3a\xd8\xce\xa3\x73\x46\x1f\x1b\x1f\x02\x36\x4e\xe9\x93\x0e\x09\x49\xfd\x11\x74\x10\x8f\xf8\xa2\x90\xb3\xf7\xc8\x78\x75\x17\x36\x9e\xf5\xab\x8a\x87\x62\x72\x04\x04\x41\xbd\x92\x3d\x49\x31\xc1\x4e\x58\x69\xd2\xfb\x62\x51\xa7\x08\x38\xf8\xa9\x58\x35\x42\x89\xb3\x9b\xe7\x12\x58\x5f\x9a\xcc\x84\x0b\x9f\x5d\xe4\xf6\x58\x54\x27\x6b\x10\xb0\x52\x5a\xb5\x56\xd6\xc8\x0f\xde\x6b\x51\xd7\x58\x81\xc6\x12\xe5\x1d\x1a\x7e\xac\x24\x41\xbb\x72\x8d\x34\x50\x56\xca\xe0\xe2\x85\x17\xdd\x9f\x1b\xc4\xf3\xb3\xa0\xfb\x9c\xcd\x61\x23\x6e\x31\x7b\x6e\x4d\x0e\xdf\x92\x7d\xac\x94\x2f\x81\x86\x92\xf4\x02\x97\x54\xc7\x22\x5e\x32\xaf\x48\x04\x05\x70\x27\x34\xe8\xa7\xf0\x39\xa8\x2e\x94\x1a\x50\x30\x5d\x3c\x97\xbc\xea\x82\x62\xd9\x39\x88\x61\x48\x4e\xfb\x87\xbc\x6d\x97\xd9\x47\x64\x2f\xf3\xe6\x11\xdc\x39\x29\x53\x14\x45\xa8\xb3\x12\xef\xf0\xdd\x19\x38\xcb\xce\x92\xb6\x13\x3f\xe5\x83\xed\xa3\xbc\xe9\x95\x6a\x9c\x81\xf1\xaf\x17\x84\x00\x8f\xc5\x99\x9c\x6f\x3d\xa5\xaa\xb9\xd3\x3d\xd7\xd7\x46\x4a\xca\xdd\x36\xa2\xa4\xb7\x52\xd3\x35\x6a\x70\x3a\xde\x47\xc1\x54\xc7\xa7\x3b\xd2\xb4\xf0\xda\x9e\x8f\x1a\x55\x55\xdd\xb3\xa6\x5a\x3a\x7d\xdf\xed\xba\x65\x3f\xaa\x18\x1b\xc2\xda\x5d\xa8\x40\x11\x4c\x95\x12\xa2\xb1\xa9\x24\x1a\x2e\x07\xd4\xca\xa5\xf8\xc6\x0a\xdb\x9a\x62\x44\x8f\xf9\x7e\x97\x7b\xe2\x92\x2e\x0a\x5a\xba\xc0\x4a\x6c\xc3\xbd\x73\x89\x56\x6f\xcf\xce\x97\x16\x75\xd8\x84\x67\x2a\x61\x6c\x47\x2e\x35\x1b\x60\xa9\x48\x16\xfc\xe8\xaf\x6a\x2c\x46\xe7\xd0\x28\x23\xad\xbc\xc3\x58\xc9\xb9\x21\x37\xe3\x19\xbb\x5f\x2b\xee\x4e\xc8\x41\xb0\xb4\xfc\xf5\x1b\x36\xe1\x98\x6f\xa1\xa8\x71\xe9\xc5\x31\xec\xff\x60\x9e\xfc\xa2\x1c\xf2\x4e\xe8\x5a\x6c\x3a\x7a\xfa\xd7\x14\xcc\xfe\x24\xf3\xea\xd9\x4b\xff\x96\xfd\xd7\xbf\x20\xa5\x63\x48\xd3\xe6\x2f\x85\x3c\x20\x75\x48\xfd\x06\x4a\xab\x5f\x26\xa3\x10\x8e\x94\xf6\x81\xc4\xd5\x8f\xcd\x3c\xb7\x34\xd5\x0b\xb0\xdc\x40\x50\xa0\xe2\x3f\x44\x79\xbb\x72\xd1\x43\x4c\x08\xe4\x32\x2a\x21\x85\x6a\xbb\xe8\x55\x4b\x51\x97\x58\xc5\xa5\x6f\xdc\xcf\xbf\xb5\x75\x19\xf0\xe6\x01\x64\x1e\x81\xa8\xb7\xe5\xda\x63\xcb\x1c\x04\xa3\x9e\xa4\x3e\xdc\x2d\x8a\xfb\x57\x41\x62\xb1\x68\x9d\x7d\xb1\xe8\x09\xb7\x37\x58\x12\x93\xaa\xaa\x0b\xfa\x91\xf5\xfd\x62\xe1\xac\xd8\x1b\xf1\x7e\x4f\xc9\xf7\x95\x8b\xe8\xff\xe0\xf6\x3d\x6c\x93\x2e\x0e\x18\x8f\xe3\x11\x86\xb7\xa8\xf0\xdc\x54\x21\x17\x0a\x38\xbe\xf9\xee\xac\xb4\x0f\xc5\x85\xaa\x31\x9b\x3c\x13\xd3\x3c\x1a\x8f\x10\x86\xb7\x5a\x67\x93\x14\x2d\x9d\x42\xe1\x38\xcd\x9c\x58\x18\xbb\x8b\x6d\xc1\x09\x88\xf4\xe9\x84\x3e\x62\x6d\x62\x17\x0e\x66\x06\xe1\x2b\xed\x53\x81\xd9\xb3\x71\x76\x92\xb3\x26\x4f\x30\x4f\xf4\xf2\x1c\xc1\x26\xef\xc2\x44\x5b\x78\x55\x1c\xec\xeb\x19\x68\x9f\xa1\x35\x1c\xce\x3f\x9f\xcb\xbe\x20\x9b\xed\xf2\xd9\xf1\xdf\xdf\x5e\x8f\xc3\x60\x2f\x7b\x1d\x4f\xbb\xf1\x2f\xcc\x55\xbf\x3c\x5b\xfd\x9c\x7c\xb5\xcb\x58\xfb\x62\xe2\x0e\x17\xff\x60\x42\xe6\xef\x5f\x8f\x07\x81\xf2\xe3\xa6\x61\x57\x00\x84\x5d\xd0\x63\x3a\xf9\x3d\x37\xf5\xfd\xe1\x7c\xf8\x89\xc4\xf6\x89\xd4\xb6\x03\x61\x8f\x39\x73\x4e\x2c\x8c\x71\x52\xcb\x4f\x05\x89\xe6\x3d\x93\x2a\xa6\xc9\x62\x34\x29\xcd\xa1\xec\x73\x59\xe0\x70\x1e\xf8\xf9\x56\xdf\xef\xa1\xf8\x93\x12\xaf\x2e\x33\xe4\x4c\xeb\x95\x6a\x12\x77\x17\x1d\xe2\xcb\x13\xb4\xe0\x48\x72\xf6\xce\xde\x39\x17\xdd\x70\xe7\xab\xe9\xab\xd0\xd1\xa7\x26\x0e\xf5\xe5\xdb\x7d\x61\x3e\xd8\x57\x9b\x3d\x77\xdc\x0d\x4c\xb3\x36\x7e\x2f\x0c\x4b\x87\x2b\xfb\x3d\x7f\xea\x4a\x79\x86\xde\x76\xc9\xdd\x72\x29\xdf\x45\xc4\xb8\xe8\x5e\xa0\xc0\x2a\x17\xd2\x76\x22\xa1\x48\x5a\x63\xa9\x34\x87\x87\x3e\xde\x8c\x1d\x52\x21\xd6\xf4\x35\xe8\x83\x1d\x07\x7a\xf5\x86\xbb\xf5\xc2\x76\x69\x77\x5e\x27\xfc\x6e\xcc\xf5\x55\x73\x63\xaf\x52\x55\xd7\xa2\xd7\xc0\x69\x6f\xef\x2f\x68\xcf\xd3\xdc\xc7\x35\x4a\x5a\xd5\x16\x52\x63\x69\xcd\x51\x33\x1a\xed\x49\x4d\x61\x24\x5c\xcb\xd1\xf2\x96\x06\x7c\x60\xf3\x55\x53\x98\xd0\x5f\x00\xd0\x46\x7b\x0c\x5d\x62\x1f\x85\x36\x98\x35\x51\x01\xff\x58\xcb\x92\x86\xe1\x86\xc0\x7e\x4b\x60\xd7\xad\xd4\xf6\xdb\xf6\x7c\x4f\x9f\x1b\xeb\x28\xa1\x06\x94\xe2\xca\x89\x3d\xf4\xaf\x79\x56\xe8\xd6\x6d\x31\x44\x82\xa1\xe5\xa9\xf9\xf2\x96\x27\xd3\x44\xf1\x1c\x37\x3e\x0d\x0b\xe5\x58\x24\x84\xaa\x49\xcc\x16\x9c\xf3\x69\x8a\xef\x9d\xd2\x16\x7f\x47\x9b\x8d\x93\xe4\x29\x34\x23\x44\xae\x67\x03\xf0\x21\xae\x1b\x4f\x5e\x77\x80\xbe\xa1\xf1\xe4\xc4\x83\x5f\xb9\xdc\xcd\xfd\x75\xd8\x9c\xf9\xf4\x43\xe7\xc1\xc0\x76\xe1\x64\x13\x25\x48\x54\x20\xe0\x9d\xbc\x76\xb3\x3d\xe9\x3d\x7d\x2c\x07\x5d\x1f\x44\x8e\x2b\x83\x71\xa9\xe7\xc5\xbd\x62\xa4\xc9\x8f\xda\xd2\x9f\xdc\x1a\xd6\xfc\x1f\xb6\x86\x35\xbd\xd6\xb0\xa6\xd7\x1a\x16\xbd\x7f\x78\xf7\xf4\xb7\x04\x3f\x85\x78\xcf\x99\xc3\x52\xab\x0d\x88\xa1\x1c\xdc\xfd\x61\x4f\xa9\xc8\xcd\x2a\x4d\xde\x40\x00\xfd\xcd\x57\x92\x86\xdf\x6c\xa9\x8d\x83\x0c\x80\xbb\x6a\xe2\x7d\x93\xe8\x6c\xe8\xaa\xe9\x75\x57\x04\x51\xf2\x06\x51\x8b\xf8\xcf\xe8\x8a\x73\xab\x64\xaf\x99\xf4\xaa\xa1\x3f\xfd\xe9\xd0\x4e\xfa\xba\x75\x72\x12\x50\x25\x69\x17\x2b\x51\x6f\xe3\x8c\xc1\x26\x70\xea\x12\xb5\xe2\xca\xfd\x66\x71\xca\x25\xb3\xc8\xe4\xb8\x63\x72\x6e\x8d\xd2\xb0\x74\xfb\x01\xcd\x96\xcb\x2e\x4b\x22\x2c\x14\x9b\x64\x6e\x8f\xf7\xea\x3e\x23\x82\xfd\x01\x74\x04\x46\x12\xdd\x44\xea\x03\x07\x5b\x87\x52\x7a\xfb\x6f\xc0\x53\x48\xdf\xd0\x81\x8a\x82\x2b\x3c\xb4\x0f\x6e\xb8\xe0\x86\xf7\xa3\x2a\xc7\x67\xbe\xc2\x3b\xa6\x93\x48\x2c\x7d\x28\x18\xed\x47\xff\x3d\x00\x31\x25\x1e\xe8\x5f\x3a\x00\x00")
 
 func templatesClientClientGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,12 +183,12 @@ func templatesClientClientGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 3480, mode: os.FileMode(420), modTime: time.Unix(1518033931, 0)}
+	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 14943, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\xdb\x36\x13\xbe\xeb\x57\x0c\xf6\xcd\x5b\xc8\x81\x57\xba\x6f\xe1\x4b\x77\x83\x26\x87\x6e\x82\xc6\x40\x0f\x45\x0f\x34\x3d\x92\x88\x95\x48\x95\xa4\x6c\x38\x86\xfe\x7b\x31\x14\xa9\xaf\x95\xbd\x4e\x9b\x3d\xad\x39\x1f\x7c\x9e\x99\xe1\x33\x4a\x53\x78\x54\x7b\x84\x1c\x25\x6a\x66\x71\x0f\xbb\x13\xe4\xea\xde\x1c\x59\x9e\xa3\xfe\x19\x9e\x3e\xc3\xf3\xe7\x2d\x7c\x78\xfa\xb4\x4d\xa2\x28\x3a\x9f\x41\x64\x90\x3c\xaa\xfa\xa4\x45\x5e\x58\xb8\x6f\xdb\x34\x85\xf3\x19\xb8\xaa\x2a\x94\x76\x66\x3b\x9f\x01\xe5\x1e\xda\x36\x8a\xa2\x9a\xf1\x17\x96\x23\x39\x27\x5f\xfc\xff\x64\x48\x53\xd8\x16\xc2\x40\x26\x4a\x84\x23\x33\x53\x30\xb6\x40\xf0\x68\xc0\x2a\x55\x26\xe4\xff\x61\x2f\xac\x90\x39\xd8\x3e\xae\x72\x37\xd6\x5a\x1d\x10\xb2\xc6\xba\x54\x05\x4a\x38\xa9\x06\x34\xde\xeb\x46\x4e\x32\x85\x2b\x1c\x6c\x26\xf7\x51\x14\x89\xaa\x56\xda\x42\x1c\x01\xdc\x49\xb4\x69\x61\x6d\x7d\x47\x3f\x72\x61\x8b\x66\x97\x70\x55\xa5\xb9\xba\x57\x35\x4a\x56\x8b\x54\x37\xd2\x8a\x0a\xc9\x83\x3c\xad\x66\xd2\xb8\x04\xd7\xfd\x53\x5e\x0a\x94\xf6\x4a\x62\x82\x78\xcd\x5c\x23\xbf\x62\x46\xad\x95\x36\xb7\xe0\x8e\x00\x8c\xd5\x59\x75\x11\x71\x67\x75\x8e\xe7\x33\x68\x26\x73\x84\xe4\x09\x33\xd6\x94\xf6\x93\x2b\x96\x01\xd7\xe1\x5a\x0b\x69\x33\xb8\xfb\xff\xdf\x77\x90\xb4\x6d\xe7\xef\xdb\x3e\x8a\x7d\xf7\x82\xa7\x35\xbc\x3b\xb0\xb2\x41\x78\xd8\x40\x32\x49\x42\x56\x68\x5b\x98\xe5\xf3\xee\xb3\xac\x2b\x37\x35\x1e\x0b\x9d\x17\x4d\xc5\xa4\xf8\x86\x90\x3c\xb3\x8a\xdc\xe1\xe3\x76\xfb\x05\xba\x62\x27\xd1\x81\xe9\xde\x7b\x03\xcf\x78\x24\xeb\xa3\x33\xc6\x52\x94\xab\x28\xe2\x4a\x9a\xae\xf9\x00\x43\xea\x8f\xca\x58\x10\xc6\x8d\xce\xde\xc7\xd3\x59\x70\xcb\x54\x23\xf7\x20\x24\xfc\x86\x96\x41\x2c\x64\xa6\x56\x60\x90\x5b\xa1\x24\xa8\x0c\xa8\x59\x6e\x3e\x5d\xc0\x38\xa9\xb1\x9a\x06\x78\x33\xe1\xfb\xbf\xc3\x1d\x24\xce\xec\xf8\x8e\x91\xfc\xc2\x0c\x7e\x61\xb6\x98\xa3\x09\xe7\xff\x09\x51\x9f\xfc\x32\xaa\xde\x65\x5e\xfd\xaf\xbc\xc0\x0a\x0d\x30\x8d\x13\x60\xc6\x9f\xdf\x0e\x68\xd4\xa4\x90\x74\x01\x48\x30\x79\xe5\x98\xf4\x12\xb8\x46\x66\x09\x0c\x48\x3c\xde\x30\x17\x59\x23\xf9\x6c\x1c\x32\xa5\x2b\x66\x8d\x7f\x1b\xc9\xef\x98\x0b\x63\xf5\x69\x05\xef\x09\x0a\x33\x9c\x95\x93\x7c\xe7\x08\x40\xa3\x6d\xb4\x9c\x26\xfa\x43\xd8\xe2\x51\xc9\x4c\xe4\x21\xe5\x1a\xdc\xa8\x2d\xe0\x1e\x7c\xbf\x93\xc1\x9a\x52\x35\x86\x7a\xc6\x80\x37\xc6\xaa\x4a\x7c\x63\xbb\x12\x61\xd0\x23\xee\x12\x2f\x71\x7d\x0d\x71\xce\x7a\x0d\x3c\xcb\xe1\xfd\x36\x24\xeb\xbc\xaf\xd6\x22\x4d\x01\xa5\x69\x34\x82\x6c\xca\xd2\x61\xa9\x99\x66\x15\x5a\xd4\x06\x0a\x76\xe8\x47\x24\x02\xda\x25\xe1\xe6\xcd\x86\xca\xe3\x52\xc0\x70\x18\x00\x3d\xf5\x21\x6d\x17\x46\xb8\x26\x21\xee\x20\xcc\xcf\x0c\x70\xbc\x72\x81\x1d\xba\xae\xc2\xa3\x02\x31\xb9\xf7\xe5\x8c\x60\x74\xfc\xb0\x99\x0a\x7b\xf2\x8c\xc7\x98\x67\xb9\x7b\xa0\xae\x30\xfd\xa3\xe8\x7e\xf9\xc9\x5c\x4d\x06\x22\xee\xe3\xd7\x81\xd5\x68\x04\x6e\x69\xb7\x87\x16\xda\x37\x24\x04\x2f\xe5\x49\xd7\xcd\xed\xab\x8b\xbe\x6b\x86\x79\x29\x88\xb2\xc4\x63\xbc\xe8\xb4\xea\x7c\x92\xfe\x1a\xd8\x0c\xc5\x9a\xac\x88\xcf\x35\xad\x56\xa1\xe4\xaf\x5a\x35\xb5\xe9\xb4\x8c\x42\x97\x2f\x77\x6f\x3c\xfc\x4a\x2e\x95\x6c\xba\x53\x7c\x7d\x79\x29\x7c\x2d\x97\xfb\x3e\x2a\xef\xdc\x72\x14\xb6\x20\xbd\xa2\xe0\x5e\xb2\xd0\xd2\x57\x85\x01\xcb\x5e\x50\x42\xa6\x55\xe5\x24\xad\x22\xe5\x1a\x49\x96\xfb\x90\x08\xb2\xe5\x1f\xd6\xa5\xc1\x7b\xf5\x78\xfc\xb8\x7a\x06\x3f\x2d\x5b\xe9\x8f\xc6\xec\x61\xbc\x33\xd6\xbd\x29\xcc\xdd\xc3\x5c\xc0\x07\x17\x3f\x8c\x0f\x33\x45\xed\x1c\x5a\x5f\xb5\x57\xe5\x52\xd2\x32\x21\xbb\x0d\x33\x9a\x33\x2c\xdd\xd7\x18\x69\xb7\x53\x9c\x5e\xd3\x6f\xa8\x8e\x3d\xd5\xf8\xea\x22\x63\x75\xc3\xad\x27\x3b\xda\x87\xd1\x98\xdd\xf8\x2c\xa8\xfe\x9f\x7f\xf9\xc3\x8e\x00\x29\x98\x0b\x57\x07\xd4\x5a\xec\x71\xba\x1c\x0b\x57\xb5\x34\x75\xdf\x85\x62\x3f\x7c\x50\xde\xd2\xd1\x78\x59\xfa\xc2\x95\x71\x31\xc0\xbe\xd8\xe5\x20\x17\xb0\x71\x58\xc6\x9d\xe7\xd9\x98\x44\xcf\x79\x99\xc8\xae\xef\xef\x8f\x27\x13\xae\x8e\x77\xd3\xba\x5f\x25\xd5\xe3\xdd\xf4\xd8\x2e\x93\x0b\xcd\x5b\xe6\x66\xc2\x64\xfe\x78\x6a\xfe\xe2\xd8\xcc\xa6\xe7\x2a\xb5\xe1\xdb\xc3\x87\x2d\x13\x5b\x16\x33\x41\x5a\xd3\x29\x36\x69\xd7\xa2\xa2\x77\x4f\x62\x39\x7e\xf4\x30\xde\x10\xd4\xe5\x78\x12\x78\x23\xd9\xcb\xf8\xd0\xef\x87\x99\x84\x6e\xdf\x5a\x23\xc4\x93\x88\x7e\xc5\xe1\x0c\x78\x41\x90\xe6\x02\xa1\x3a\x25\xf0\xbc\x69\x9f\xb2\xb2\x04\x41\x1b\xa8\xd9\x69\x34\xaa\xd1\x1c\x4d\x68\x17\x81\x9c\x41\x6f\xdb\xd5\xe4\x9e\xb7\x97\xdc\xaa\x5b\x5a\xff\x7a\x1d\x2d\x2f\xa3\x64\x19\xc4\x74\xfd\xb4\xd1\x3f\x01\x00\x00\xff\xff\xc1\x1a\xbb\xf6\x36\x0f\x00\x00")
+var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x3b\x6b\x73\x1b\x37\x92\xdf\xf9\x2b\x7a\x75\x1b\xdd\xd0\x1e\x8d\x9c\xba\x4f\x47\x87\xa9\xca\xda\xde\x8d\xf7\x12\xc7\x65\x69\x2f\x77\xe5\x72\x6d\x41\x33\x4d\x12\xa7\x21\x30\x01\x30\xa2\x14\x2e\xff\xfb\x55\xe3\x35\xc0\x70\x28\x29\xd9\x5a\x6f\xd5\x8a\x03\x34\xfa\x85\x7e\xa1\x81\x5c\x5e\xc2\x1b\xd9\x20\xac\x51\xa0\x62\x06\x1b\xb8\x79\x80\xb5\xbc\xd0\x3b\xb6\x5e\xa3\x7a\x0d\x6f\x7f\x82\x0f\x3f\x5d\xc3\xbb\xb7\xef\xaf\xab\xd9\x6c\xb6\xdf\x03\x5f\x41\xf5\x46\x76\x0f\x8a\xaf\x37\x06\x2e\x0e\x87\xcb\x4b\xd8\xef\xa1\x96\xdb\x2d\x0a\x33\x9a\xdb\xef\x01\x45\x03\x87\xc3\x6c\x36\xeb\x58\x7d\xcb\xd6\x48\xc0\xd5\x47\xff\x9b\x26\x2e\x2f\xe1\x7a\xc3\x35\xac\x78\x8b\xb0\x63\x3a\x67\xc6\x6c\x10\x3c\x37\x60\xa4\x6c\xab\xd9\xe5\x25\xbc\x6b\xb8\xe1\x62\x0d\x26\xae\xdb\x5a\x6e\x3a\x25\xef\x10\x56\xbd\xb1\xa8\x36\x28\xe0\x41\xf6\xa0\xf0\x42\xf5\x22\xc3\x14\x48\x58\xb6\x99\x68\x66\xb3\x19\xdf\x76\x52\x19\x28\x66\x00\x67\xb5\x7a\xe8\x8c\xbc\x34\xad\x3e\xa3\x4f\x81\x26\xfc\xbd\xdc\x18\xd3\xc5\x8f\x5e\xb5\xf6\xb7\xe1\x5b\xb4\x3f\xb4\x51\x5c\xac\xdd\xaa\x35\x37\x9b\xfe\xa6\xaa\xe5\xf6\x72\x2d\x2f\x64\x87\x82\x75\xfc\x52\xf5\x22\x40\x13\x2a\xa3\x98\xd0\x96\xf0\xe3\xf0\x97\x75\xcb\x51\x98\x47\x10\x93\x92\x1e\x9b\xee\xb0\x7e\x64\x1a\x95\x92\xea\x59\x7c\xcf\x00\xb4\x51\xab\xed\x49\x8e\xdd\xec\xd9\x6c\x06\xb4\xd5\x8a\x89\x35\x42\xf5\x16\x57\xac\x6f\xcd\x7b\xab\x64\x0d\x87\xc3\x7e\x0f\x9d\xe2\xc2\xac\xe0\xec\xab\x5f\xce\xa0\x3a\x1c\x1c\xbc\x37\x97\x64\xed\x1f\x6f\xf1\xa1\x84\x3f\xde\xb1\xb6\x47\x58\x2c\xa1\xca\x90\xd0\x2c\x1c\x0e\x30\xc2\xe7\xc1\x47\x58\xe7\xd6\xda\x3c\x2f\x34\xbe\xe9\xb7\x4c\xf0\x5f\x11\xaa\x0f\x6c\x8b\x84\xe7\xfb\xeb\xeb\x8f\xe0\x94\x5d\xcd\xee\x98\x8a\xd0\x4b\xf8\x80\x3b\x9a\x7d\x63\x27\x0b\xc1\xdb\xf9\x6c\x56\x4b\xa1\x9d\xd1\x00\x0c\xa8\xbf\x97\xda\x00\xd7\xd6\xe4\x1a\xbf\x9e\xc6\x02\xd8\x4a\xf6\xa2\x01\x2e\xe0\x47\x34\x0c\x0a\x2e\x56\x72\x0e\x1a\x6b\xc3\xa5\x00\xb9\x02\xdd\x61\x6d\xfd\xc1\x2e\x48\x91\x3a\x03\x83\x65\x26\xef\xbf\xdd\x9d\x41\x45\xf8\xc9\xd1\x72\x4e\xfe\xc4\x34\x7e\x64\x66\x33\xe6\x26\x8c\xff\x53\x1c\x45\xe4\xa7\xb9\x8a\x20\x63\xed\x5f\xd5\x1b\xdc\xa2\x06\xa6\x30\x63\x4c\xfb\xf1\xe7\x33\x94\x6c\x52\x40\x3a\xc1\x48\x98\xf2\x11\x27\xdb\x4b\xa8\x15\x32\x43\xcc\x80\xc0\xdd\x33\xec\x62\xd5\x8b\x7a\x64\x0e\x2b\xa9\xb6\xcc\x68\xef\x1b\xd5\x27\x5c\x73\x6d\xd4\xc3\x1c\x5e\x10\x2b\x4c\xd7\xac\xcd\xf0\xed\x67\x00\x0a\x4d\xaf\x44\x8e\xe8\x67\x6e\x36\x6f\xa4\x58\xf1\x75\x40\x59\x82\x35\xb5\x09\xbe\x07\xd8\xdf\x28\x41\x49\xa8\x7a\x4d\x21\x94\x41\xdd\x6b\x23\xb7\xfc\x57\x76\xd3\x22\x0c\xf1\xa8\xb6\x4c\x4c\xc9\x7a\xcc\xe2\x58\xea\x12\xea\xd5\x1a\x5e\x5c\x07\x64\x0e\xfa\x51\x5d\x5c\x5e\x02\x0a\xdd\x2b\x04\xd1\xb7\xad\xe5\xa5\x63\x8a\x6d\xd1\xa0\xd2\xb0\x61\x77\xd1\x44\x66\x40\x39\x28\x50\x5e\x2e\x49\x3d\x16\x05\x0c\x83\x81\x21\x6f\x17\x33\x00\x72\x0c\xbe\xb2\x7c\x65\x4b\xec\x40\xb0\x9f\x11\xc3\xc5\xdc\x2e\x74\xdc\x39\x0d\x27\x0a\x62\xa2\xf1\xea\x9c\x41\x32\xbc\x58\xe6\x81\xbd\xfa\x80\xbb\xa2\x5e\xad\xab\x77\xf7\x1d\x13\x0d\x36\xe4\xa8\xc5\xdc\xaa\x28\xba\x87\xfb\xf2\x36\x4a\x54\x2f\x2f\xad\x57\x28\xfc\xa5\x47\x6d\x9c\x9b\x68\xbe\x16\xd8\x40\xcb\xb4\x29\xa1\x17\x0d\x2a\x0b\x53\xb3\x9a\x32\x9b\x04\xb3\x61\x06\x18\xac\x14\xea\x0d\x28\xd4\x9d\x14\x1a\x81\x6b\xf1\xef\x26\xac\x65\x6b\xc6\x45\xca\x6e\x15\x45\x86\x25\x5c\xf1\xb5\xe0\x62\x6d\xb9\xa5\xed\x8e\x73\xc5\x7c\x1e\xd5\x57\xbd\xb1\xf4\xfe\x90\x2a\xd1\x73\x5b\xb3\xb6\xd5\xb0\xe3\x66\x43\x9f\x5c\x81\xdc\x09\xab\x0c\xaf\x27\x2b\x85\x90\xc6\xb1\xdc\x94\x04\x05\x52\xa0\x26\x87\xa6\xdf\x1d\x2a\x8b\x04\x64\x47\x7e\x6e\xc5\xb6\xbb\x34\xcd\x2f\x71\x12\xf8\xa5\xdf\x38\x2f\x26\x00\xe7\x7e\xf7\x07\x6f\x2b\xde\x0b\x83\xaa\xc6\xce\x0c\xf0\x6e\x07\xe2\x84\x54\xba\xaa\xaa\x79\x19\x4c\x2a\xf8\x5f\x02\x00\x3b\xc5\x3a\x17\x55\x23\x96\x20\x48\xdc\x37\xff\xed\xdd\x0e\x8c\x84\xad\x6c\xf8\xca\xd6\x35\x5b\x6b\x42\x4e\x53\x61\xbb\xf4\x82\xa8\xb0\xa6\x81\x0d\xb2\x06\x95\x2e\xa1\x95\xeb\x12\x14\xd6\x52\x35\xb0\x45\xa3\x78\x4d\xac\xcd\xcc\x43\x87\x19\x3b\xe4\xa8\x85\xc0\x7b\x63\x55\x5e\x7d\xa2\x00\x7a\xad\x78\xd7\xa1\x9a\x1f\x0f\xd9\x68\x92\x0e\xfc\x99\x62\x1a\xa7\x18\x48\x88\x48\xfd\xd0\x6b\x6c\x80\x69\x60\xe2\x78\xbd\x95\x65\xa7\xb8\x41\xe0\x03\x13\xda\xb1\x75\x84\x97\x50\x16\x2f\x1c\x12\xa7\x9a\x39\xc4\x6f\x27\x79\x09\xb6\x06\x99\xe7\x8c\x59\x73\x70\x4a\x0e\x6c\xb9\x88\x54\xac\x8e\xa8\xcc\x87\x91\x42\xe1\x2f\xf0\x3c\x7a\x69\x24\x5e\xd1\xba\xa3\xad\x06\xd6\x75\x2d\x47\x9d\x09\x4a\xe2\x93\xa5\x66\xbb\xad\xc9\xc8\xad\xfd\xb3\xc1\x26\x4a\xe0\xa2\x6e\xfb\x86\xa2\xed\x73\xbc\xc4\x56\xb8\xd7\x1b\x84\x15\x57\xda\xa4\x44\x41\x23\xea\x94\xa2\x03\xa9\xe0\xbd\x81\x6d\xaf\x0d\xdc\x38\xec\x54\x39\xe3\x4a\x2a\x1c\x19\xa7\x46\xd1\x68\xe0\x46\x7b\xd4\x1e\x4b\x24\xf8\x2c\xff\xf5\x91\xc4\x97\xe6\x3e\x64\xa4\x5c\x96\xb0\xdb\xf0\x7a\x73\xc2\x31\x5c\x39\xeb\xd8\x75\x7a\x41\x9f\x63\x26\x9c\xd2\x6d\x61\xfc\xac\x3e\xb9\xd5\x65\x4a\x4e\x43\x55\xa5\x7e\x3b\x0f\x34\x2a\x97\xad\x86\x80\x41\x1b\x7d\xc3\xb4\x2d\x22\x27\xe2\x84\x8b\x70\x2d\x8a\x22\xc5\x3e\x87\x6f\xe1\x95\x0f\x73\x13\x8b\x60\x39\xf0\x82\x4d\x31\x01\x91\x33\x3b\x0a\x46\xe7\xc9\xe2\xb8\x62\x1f\xb1\x2c\x06\x05\x96\x96\xf5\x85\xfd\xff\x1c\xe5\x22\xfb\x3a\x90\xf5\x5a\x7d\xc6\x51\x6c\x4e\x84\x85\x1c\x0f\x7c\xfe\x92\xa9\xf1\x08\xdc\x6a\x81\xaf\xc0\x22\xcb\x92\xa8\x1b\xb1\xa6\x12\x52\x6e\x94\xc6\x0b\xbc\x92\x0a\x38\x69\xfe\x58\xc1\x17\xf0\xf5\x6b\xe0\xf0\xed\x12\x5e\xbd\x06\x7e\x71\x91\x23\x4d\x61\x3f\xf3\x2f\x56\x94\x91\x16\x69\xc8\x3b\xed\x94\x3e\xa3\xff\x92\x45\xa6\xe8\xc8\x87\x13\x8f\xb4\x56\x7b\xa3\xbc\x9f\x1e\x9b\xbf\xad\x9a\x08\x5e\x9a\x0d\x2a\x97\xb8\x28\xa3\x0d\x5e\x9f\x11\xc0\xe6\xa4\xf9\xbb\x28\x39\xc9\xab\x36\xaa\xaf\x9d\xad\x0e\xab\x01\x4e\x78\x42\xb0\xe8\xf0\xef\x68\xcf\x66\x30\x90\x39\xda\x63\xaf\xb3\xa1\xb2\x0b\xa7\x84\xd4\xe7\x27\xf3\xb2\x17\x47\x76\x74\x50\xb7\x23\x94\x0c\x4b\x68\xf9\x2d\x02\xd5\x87\xef\x44\xd3\x49\x2e\xcc\x22\xe8\x4c\x11\x53\x60\xbc\x25\xe5\xea\xb0\xca\x93\xbd\xc9\xf5\x27\x29\xf9\xb9\x50\x72\xd5\xdf\x6c\x79\xb2\x8f\xc3\xb6\x95\x36\x87\x52\x48\xab\xa5\xbc\xe5\x08\xff\xc7\x94\x4f\x10\x06\x5e\x4c\x69\x78\x9e\xc8\x5b\xcc\x7d\x92\xf0\xe2\x27\xa9\xe0\x3c\x19\xdf\xc7\xc5\x0b\x30\x95\xf3\xc0\xbf\x32\x45\x1f\xc3\x8e\xfc\x95\xa9\xc1\xf7\x4e\x13\x77\xa2\x14\xb2\x83\x17\x79\x9c\xfa\x29\xe8\x72\x4e\x07\x42\x83\x6a\xc5\x6a\xdc\x1f\xb2\x34\xc5\x57\x20\x23\xb3\x59\x05\xe6\x37\x6b\xb1\x84\x17\x11\x22\x99\x38\x19\xb0\x5c\x34\x2f\xc6\x50\xf3\x12\x4c\x35\x8e\x5b\x90\x10\x5f\xc2\x79\xac\x7d\x13\x4f\x4c\x15\x12\x25\x0d\x09\x95\xdc\xf4\x67\xc5\xac\x01\x70\x0d\x7c\xdb\xb5\x48\x3d\xa3\x21\x9b\xc4\xc5\xa7\x2b\x42\x67\x10\x0a\x5d\xe9\x91\xe4\x42\xeb\x9d\xd6\xfe\x7c\x55\x49\x28\x32\x53\xcc\x32\x67\xc8\x97\x3e\x9d\x49\x51\xa3\xc7\x6a\x50\x38\xff\xcc\xf8\x0d\x3b\x62\xf5\x4d\xa3\x1f\xf0\xde\x14\x64\xf5\x40\xe6\x56\x3c\xa7\xe6\x0a\x8a\xf0\x34\x59\xd3\xe8\x53\x49\x34\xd8\x78\xd4\x08\x49\xc3\x9c\x2a\xc8\x5b\x6c\x16\x1f\xc5\x27\x67\x7a\x7e\x47\x87\x85\xcf\xe1\x2c\x58\x17\xc9\x63\x13\x83\xbc\xcd\xb3\x64\x91\x28\x63\xfe\x1a\xe4\xad\xb7\x3b\xbf\xa0\x8a\x1a\xf1\xb2\x38\x7b\x09\x56\x11\xd0\xe4\xc6\xe2\x41\x87\xa4\x19\xf4\xf3\x01\x77\xcf\x39\xd0\xa6\x52\xd3\x21\x2b\xe2\x09\x71\xc5\x1b\x6b\xb4\xea\x12\x4e\x9c\x57\x1f\x3d\x99\xd6\xad\xcd\x5b\x02\x77\xc5\x24\x10\x89\x5a\xb7\x3c\x73\xb1\xc8\x4a\xd6\x04\x8b\x2e\xfe\x17\x25\xfb\xce\xf6\x22\xdc\xd2\x69\xe2\xb6\x8b\x11\xbe\xaa\x4c\xc2\xe4\x5c\x92\x77\xcd\xbc\x6a\xeb\x96\x7b\x5d\x8e\xd3\xf1\x51\xbf\x60\x3c\x13\x12\x19\x6d\x44\x6c\xca\xa0\xa1\x7e\xab\x06\xc3\x6e\x51\xc0\x4a\xc9\x2d\x81\xd0\x71\x84\xa5\x4d\x19\x1a\x8b\x8d\x19\x5f\xd6\x4d\x33\x40\x91\x77\x4c\x79\x9f\xda\xcc\xf9\xf4\x2c\xfd\x8f\x0e\xd0\x8b\x70\x64\xa7\x8f\x32\x4e\x85\xf3\x74\x9c\x8e\x07\xec\x08\xe2\x0f\xd9\x11\xc2\x7f\x3b\x1c\x07\xaf\xb5\x31\xf1\x5a\x0a\xc3\xb8\x18\x9f\xf6\x14\xb6\xb6\x4f\x4d\x0d\xbc\x72\x96\xb6\xd1\x9e\xa1\x1d\x1b\x63\xc6\x84\x92\xf4\x0f\x90\x74\xfc\x66\xa9\x74\xe9\x98\x67\x1f\x3e\x7f\x49\x06\x29\xa9\x4b\x6d\xfe\x9b\x29\x4e\x9d\x14\x5f\xa6\xf4\x37\xda\x70\xd3\x13\xc3\x54\x8d\x11\x3b\x7b\xc1\xb6\x78\x80\xae\x65\x35\x6e\x64\x4b\x07\x4e\xe2\x94\x81\xc1\x6d\xe7\x64\x8b\x7d\xcb\x1c\xe3\x96\x75\x9f\x1d\xc5\x11\xe1\xa4\xbe\x70\x74\x5d\xd6\x6e\x26\x0f\x4c\x5e\x2b\xde\x93\x09\xc3\xfb\xd3\xd5\x8a\x27\x60\x4f\xfa\xa0\x8d\x54\xf1\x30\xe4\xcf\xce\x21\x68\xfe\xe5\xdd\xf5\x09\x12\x25\x1d\xa1\x42\xdf\xc0\x4a\x45\xbf\xdd\x08\x5e\x11\xca\x59\x90\x83\x10\xd5\x52\x08\xbf\x7f\xd1\x05\x3c\x3e\x8a\xaa\x83\x25\xb8\x56\xc6\xaf\xa8\x24\xd8\xc6\xb3\x86\x5b\xc4\x2e\xeb\x6f\x4c\x17\xc7\x81\xda\x8f\xec\xfe\x7d\xd3\xe2\x1b\x29\x84\x86\x96\x6f\x29\xbc\xd3\x6a\xde\xb4\x29\x1b\x84\xb7\x33\xc0\x5a\x7e\x87\x65\xb6\xe8\x23\x2a\xda\xa0\x64\xed\x96\x62\x04\x20\xab\x37\xb0\x09\x7b\x98\x91\xf1\x0e\xc1\xc5\xf1\x5c\xc0\x16\xe6\x68\x5f\xfd\xdc\x35\xdf\x22\xd5\x6a\x5c\xc3\x46\xee\xa0\x95\xd4\x44\x14\x63\x46\x81\xa7\xac\x5a\xfc\x63\x04\x36\x42\xbf\xed\x5d\xd1\x13\xa8\xbc\xe5\xac\x0d\x00\x89\x1a\x08\x96\x76\x97\x6e\x23\x80\x25\x74\x4a\xf8\x2f\xc4\xee\x3b\x22\x12\x8a\xd7\x0e\x15\x97\x0d\x99\x31\x29\x82\xf6\xe1\xc2\xea\x0b\x3a\x25\x6f\x50\x5b\x4a\x29\x99\x63\x3e\x06\x94\x30\xcd\xe5\xf5\x0f\x57\xdf\x33\xd1\xe8\x0d\xbb\xc5\x53\xdc\x7a\x3b\x31\x2d\x75\x2f\x3d\xac\x5d\x3f\xb5\xf8\x14\x95\x21\xfc\xac\xf8\xba\x0f\x06\x4f\x38\x07\x15\x50\x95\x4c\x31\xdb\x97\x80\x35\x2a\xc3\x57\xbc\xb6\xe1\x5d\xaa\xf4\x1b\x58\x6f\x36\x52\x71\xc3\xbd\x1a\x06\x0a\x2f\x4c\xab\x2b\x47\x2d\x90\xff\xa8\xe4\xfd\x83\x0f\xc7\x5e\xb3\x76\xc4\xc6\x07\xef\x5e\x65\xd6\xbb\xe7\x3a\xad\xbd\x68\x86\x4a\xed\xbf\x7f\xfc\xf4\xd3\xff\xfc\x6f\x69\xcb\xee\x2b\xf7\x61\x8b\xf6\x0f\x3f\xf9\x8f\xbb\x10\x54\x2c\x65\x47\x76\xba\x61\xd4\xab\xb6\xfa\xdb\xa7\x1f\x42\x4d\xec\x83\x35\x15\x79\xd6\xf6\xe5\x1d\x2a\xc5\x1b\xd4\x19\x57\x64\xfc\x36\x38\xd3\xed\x20\x6f\x86\x6a\xf3\x39\xd9\x8b\x7a\xa1\x47\x99\x6a\x1e\x49\x16\x9b\x21\x44\x9f\xcc\x68\xd4\x58\xb4\xfc\x2d\x07\x47\x0c\x79\x7a\xb5\x4e\x84\x88\xf1\x7d\x5a\x90\x1b\x3f\xfd\xaf\x10\x26\x90\x2e\x6e\xf2\x1c\xf3\xa8\x50\x91\xdf\x65\xe4\xed\xb4\x70\x21\x51\x4d\xcb\xe6\xaf\x7d\xfe\x15\xa2\x79\xc2\x85\x1e\x65\xca\x47\x45\x0b\xdc\x2e\x03\x67\xa7\x05\x4b\xf3\x22\x68\xf4\x31\xc0\xa6\x01\xb2\x2a\x36\x91\x64\xa9\x42\x48\x73\x6c\x34\x51\xdd\xd7\x1b\xea\xb8\x9e\xed\x15\xae\xb9\x14\x87\x8a\x75\xbc\xc2\x7b\x46\x67\x25\xba\x92\x3d\x7b\x5a\xde\x94\x9f\x82\x48\x97\x2e\x27\x3d\xb5\xa3\xbe\xbd\x9f\x2e\x1f\x5d\xaf\x04\xe5\x8c\x40\x60\xcb\x6e\xb1\x38\x2a\x08\xe6\xbe\xa2\x9a\x5c\xf5\x99\x18\xfb\x02\x4b\xc7\xda\x69\xe5\x66\xe5\x80\x3d\x30\xa5\xa7\xd2\xe7\xd7\x16\xb1\x77\xe3\x3a\x9f\x74\x3c\x3c\xd1\x4c\x7d\x52\xbf\x29\x4b\xc5\xa3\x8d\xc8\x13\x8a\x1e\x5f\x34\xc0\x92\xe4\x40\xd1\x14\xe3\x99\xbc\x41\x57\x55\xd5\xfc\xb4\xa6\x6c\x09\xe3\x6e\x56\x7e\x73\x59\xe4\xec\xd1\x96\x54\x99\x19\x26\xd1\xfc\x03\xee\x7e\xc4\xad\x54\x0f\x96\xce\xd3\x56\x68\xc1\x0a\x8b\x32\xa9\xae\x1e\xd5\x89\x05\xb3\x77\x77\x52\xe1\x69\x41\xb3\x1a\xe6\xb9\xa5\x12\x17\x60\xa4\x61\xad\xcd\x3c\x59\x5d\xf4\xb4\x28\x29\xc1\xc2\x62\x29\xa1\x1b\x0a\xa4\x47\x65\xca\x98\x5d\x3a\x1e\x26\x27\x43\xc5\xb5\x0c\xa8\x4f\x2b\x20\xac\x09\xc5\x83\x8d\x39\xcf\xae\xc5\x9e\x96\x77\x84\xbf\x30\x53\x45\xca\xa3\x52\x8f\x39\x5c\x82\xc7\x71\x5a\xa8\xdf\x5e\xf8\xd1\x4e\xc6\x70\xfb\x44\xd1\xf7\xb4\xd0\x09\xfd\x20\x70\x69\x8b\x78\x57\x08\x3e\x5f\xf6\x54\x90\x5c\x6e\x9a\x1d\x4a\xcb\xe5\x80\xfd\xb4\x56\x7e\x6f\xa1\xf9\xb4\xbc\x13\x98\x7f\xc7\x46\x4f\xf1\xf7\x8c\xcd\x7e\x76\x65\x4b\x7a\xc8\x1b\xdf\xb4\xd4\xc6\xac\xef\x7a\xb3\x49\xda\x18\x75\xd2\xbd\x60\x13\xb5\xb0\x75\x7c\x36\x59\x0d\x3f\x3c\x4b\x5b\xbe\x6f\x61\x5a\x7d\x5c\x32\x3f\xa5\x23\x3f\xb6\x84\xb8\xfa\xb4\x6e\x5c\xfd\xeb\xae\xea\x8e\x72\x9a\xd9\x28\xd9\xaf\x49\xc2\x8e\xc0\x9e\x66\xdc\x62\x2b\x2c\xf0\xdf\x3e\xfd\x00\xa1\x82\x7e\x94\x61\xbb\x26\x5c\xe7\x7c\xf4\x4b\x23\x8e\x13\x29\x28\x7b\x2f\x10\xf7\xe5\xf8\xa4\x9c\x27\x9e\xc5\xf4\x9d\x51\xbc\x10\x18\x6c\x21\xf6\xa0\x6c\x82\x72\x9b\x39\x20\x8d\xb7\x2f\x01\xea\xe4\x89\x3b\xd4\x9f\x0d\x8a\x50\x5f\xa6\x2f\x10\xbc\x19\xd9\x1b\x9e\x1d\xd7\x4f\x38\xd2\xe8\x91\xc4\x89\xbe\x6a\x2c\xad\xf2\x64\xb0\x84\x57\x70\x7e\x7e\x3a\x11\x24\xf3\x61\x32\xba\x58\x32\x97\xc5\x1b\x37\x9e\x15\x6b\x49\xc4\x49\x56\x4d\x3a\x6e\x3e\x1f\x8c\xd6\x5d\x33\x9c\x9f\xa7\xb6\x31\xae\x0a\xbd\x39\x4c\x6a\xdc\x97\x81\xf6\x4f\xc3\x59\x8b\x8a\xda\xa9\xe7\x02\x8d\xe5\x1d\xd5\xde\x33\xb0\x80\xff\x78\x05\x2f\x6c\xf4\xa8\xae\xb0\x96\xa2\x49\x4e\xf7\xc7\x93\x87\x54\xb5\xa9\x16\x86\x0b\xdb\x81\x64\x15\x26\x97\x63\xf0\xa4\x4a\xe5\xab\x91\xc6\xfe\xb0\x9c\x42\x35\xcc\x2f\x73\xf8\x04\xd5\x60\x9a\x24\xab\xd5\x4b\x54\xc8\x80\xd0\x3a\xd7\x22\x7c\xc5\x7f\x83\xe3\xfd\x59\xc9\xed\x3b\x71\xc7\x95\x14\x74\x53\x32\x34\x3a\x49\xde\x37\x52\x18\xbc\x37\xe9\x7a\xcf\x61\x32\x3b\x2c\x49\x8d\x2c\x59\xf3\xf5\xab\x57\xd3\x30\xde\x10\x17\xde\x8e\x26\xa6\x86\x75\x61\xc6\xeb\x34\xa0\xff\xcf\xf1\x7e\xc6\x05\x13\xf6\x47\x8b\xbe\x7e\x6c\x81\xeb\xe6\x3b\xb3\x0c\x14\x32\x5b\x1d\xa0\xdf\xdd\x77\x58\x93\x9b\x1a\x2e\xfa\x81\xc0\x11\xe6\x6c\xdf\xed\x6e\xe4\xd7\x6a\xd9\x56\x06\xe3\x1f\x80\x8f\x71\xa4\x5a\x1a\x19\xe2\x80\x27\x03\x5a\x1e\xad\x3b\xc6\x1a\xa6\xa6\x2d\x7c\x40\x3c\x86\x5b\x4e\xad\x3e\x46\x3f\x15\x0c\x4e\x91\x98\x82\x5d\x9e\xc2\x92\x90\xf2\x11\x22\x22\xf2\x69\x23\x7d\x14\xe7\xa3\x88\xcf\x1a\x54\x0c\xdb\xb0\x4e\x49\x90\x8a\xba\x89\x5e\x35\xa5\x02\x85\xf6\x60\x3d\x74\x0b\x98\xb1\xaf\xc2\x6c\x71\x3f\xf4\x96\x1e\x0f\xe4\xf9\xdb\x3c\x7f\x52\xf6\xf2\x5b\x3c\x8b\x65\x3c\xc3\x86\x97\x86\x90\x9e\xad\x17\x4b\x7f\xc9\x73\x74\xd2\x4d\xb4\x68\x31\x2d\x3d\x76\x5d\x7d\x72\x9c\xdb\x1e\x52\x09\x67\xfb\xb3\x97\x84\xf1\xe5\xd9\xe1\xcc\x63\x2d\xe1\xe2\xeb\xf9\xb1\x0e\x09\xde\xab\x6f\xfa\xe2\x88\xeb\xa1\x04\xa2\xb3\xce\xd4\xed\x99\xbb\xe2\x9c\x5e\x9f\x5c\x42\x3c\x71\x79\x35\xbd\x7e\xbf\x07\x2d\xd8\x6d\x3a\xe6\xaf\xe2\xae\x50\xdd\xf1\x1a\x47\x4f\xbd\xaf\x9f\xba\xb8\x23\x69\x69\xaf\xaf\x70\x18\x83\x7a\x43\x8c\xf9\xba\x31\x8e\x4a\x91\x9e\x6b\x6d\x9d\xe0\xed\x47\xf7\x37\x0a\xb5\xec\x55\x8d\x3a\x18\x03\xdd\xfb\x8d\x04\x38\x1c\xe6\x19\x9d\xa7\xaf\x15\xe7\x56\x53\xf5\xef\xbe\x00\x9c\xbe\xfe\xab\xa6\x99\xc8\x2f\xfc\x9c\x15\xfc\x89\x4c\xfe\x8d\xf5\x13\x1d\x2e\x89\x55\x6f\xeb\x1b\x3b\x55\x02\xf7\xa7\x44\xf2\x23\x85\xba\x6f\xe9\xb7\xf0\x8d\xaa\x60\xa8\x9c\x1e\x66\x76\x86\x8a\x71\x67\x1d\x03\x5e\x52\x57\x31\x77\x6d\xd7\x81\xe4\x3b\xfa\xa4\xb6\xbb\x33\x4c\xd7\x59\xb5\x8b\xdc\x7f\x7c\xa1\xe5\x16\xc3\x91\x8c\x98\xd2\xb0\x62\xbc\x4d\x70\x3b\x04\x89\xb9\x51\x44\xa0\xb1\xe1\x5d\xb8\x25\x39\x94\x67\x84\xa5\xf4\xac\xd3\x0b\x1d\xd5\xd0\x6d\x35\x15\x23\xe1\x4a\x2b\x7d\xcc\xa3\xfb\xba\x46\x6c\xb0\x99\x41\xc0\xfb\xf9\x8b\xc5\x38\xbc\xd4\x40\x78\x31\xf0\x32\x77\x60\x79\x08\x20\xa6\xb1\xa1\xa2\xe5\xd5\x0c\x28\xa2\xf8\xe7\x73\x0e\x91\x8b\x05\x7f\x2f\x49\x39\x43\x18\xc0\xca\xd3\x8b\x05\x20\x4d\x8f\x72\x0b\x3d\x63\xb6\x98\x8e\xea\x29\x3f\x4c\x38\xfd\xd8\xc1\xff\x75\xcc\xbc\x7c\x19\xc3\x42\x72\xeb\x4b\x2f\x9e\xaf\xdc\xfb\xfb\xe2\xec\xab\x26\x28\xed\xab\xc6\x2b\xc5\xad\x2d\x47\x7d\x30\x0a\xb2\x0b\xf8\xea\xee\xac\xf4\xc8\x4b\xfb\x46\x2b\x48\x40\x6f\x60\x89\x9b\xd0\x6d\xb7\xca\x22\x57\x08\x7b\x5a\x4b\x51\xf7\x4a\xa1\x30\xed\x43\x09\xcc\xc0\x96\xc2\xdc\x4e\xaa\x5b\xba\x4d\xb4\x4f\x92\xc9\x6b\xa0\x20\x43\x72\x2c\x6d\x9d\x7d\x04\x18\xf7\x44\xb9\x93\x9a\x1b\x7e\x87\xf3\x58\xe1\x87\x7c\xc0\xd2\x2d\xca\x4d\xcb\xdd\x73\x91\x55\xd9\xdd\xb4\x60\x45\xc4\x4b\x4f\x6e\x1d\x93\x55\x55\x45\x6b\xf6\x76\x1c\xdf\x3c\x78\xe8\x6f\xa8\xd8\xfb\xc7\x3f\xe2\xf7\xb7\x56\x0d\x76\xf9\xdc\x6f\x4d\x98\x5a\x26\x53\x7e\x0b\x50\x29\x0d\x0b\xdf\x0b\xf5\x46\x56\x26\x60\xe4\xb7\x5c\x34\x78\x8f\x03\x18\x45\x30\xdb\x41\xf2\x66\xb5\x5b\x83\x7e\x10\x75\xf5\x33\xe3\xc6\x46\x08\x6f\x5c\x3b\x5a\xf1\xea\x35\xec\xe0\x9b\xc0\xc2\x6b\xd8\xbd\x7c\x19\xb8\x5a\x57\xdf\x35\x4d\xe1\x53\xc5\x5a\x06\x6f\x0d\xe6\xd4\xe0\x0a\x15\x41\xbd\x95\x02\x0b\x07\x95\x3c\xc8\x73\x06\x1b\x78\x1b\x6c\x90\x24\xfa\xcc\xa9\x47\x6b\x25\xa0\xb7\x77\x61\x2d\xd9\x1c\xc0\xa1\x98\x8f\x1f\xf7\xf9\x1c\x48\xf0\x1e\x53\xc0\xfb\xcd\x05\x70\x0f\x5d\xb7\x52\x63\xe1\x27\x08\xc5\x6e\x6d\x45\x2e\xe6\xb3\x53\xde\xa4\x9e\xf0\x24\xef\x00\xe7\x83\x99\xec\x9d\xf5\x2e\x08\x5a\x7b\x7e\x73\x6f\x11\xbc\x9d\x1d\x66\xff\x3f\x00\xfb\xa5\x71\x58\x02\x37\x00\x00")
 
 func templatesClientFacadeGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -151,12 +203,32 @@ func templatesClientFacadeGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 3894, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 14082, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientMockGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x4d\x8f\xe3\xb8\x11\xbd\xeb\x57\x3c\x18\xbb\x88\xbc\x50\xcb\xc8\xb5\xb3\x3e\x34\x66\x26\x48\x03\xd9\x99\xc1\x4c\x07\x7b\x08\x82\x05\x5b\x2a\xc9\x44\x4b\xa4\x96\xa4\xba\xe3\x15\xfc\xdf\x83\x22\x29\x59\x76\xcb\x9e\x0f\x24\x39\xd9\xa6\x8a\x8f\x8f\x55\xaf\x3e\xe4\xcd\x06\x6f\x74\x49\xa8\x49\x91\x11\x8e\x4a\x3c\xee\x51\xeb\x1b\xfb\x22\xea\x9a\xcc\x5f\xf0\xf6\x03\xde\x7f\x78\xc0\xbb\xb7\xf7\x0f\x79\x92\x24\xc3\x00\x59\x21\x7f\xa3\xbb\xbd\x91\xf5\xce\xe1\xe6\x70\xd8\x6c\x30\x0c\x28\x74\xdb\x92\x72\x67\xcf\x86\x01\xa4\x4a\x1c\x0e\x49\x92\x74\xa2\x78\x12\x35\xb1\x71\xfe\x5e\xb4\xe4\x57\x37\x1b\x3c\xec\xa4\x45\x25\x1b\xc2\x8b\xb0\xa7\x4c\xdc\x8e\x10\xa9\xc0\x69\xdd\xe4\xc9\x66\x83\x77\xa5\x74\x52\xd5\x70\xd3\xbe\xd6\x53\xe9\x8c\x7e\x26\x54\xbd\xf3\x50\x3b\x52\xd8\xeb\x1e\x86\x6e\x4c\xaf\x4e\x90\xc6\x23\x3c\x67\xa1\xca\x24\x91\x6d\xa7\x8d\x43\x9a\x00\xab\xaa\x75\x2b\xfe\xb4\x7b\x55\xac\x12\xfe\x56\x4b\xb7\xeb\x1f\xf3\x42\xb7\x9b\x5a\xdf\xe8\x8e\x94\xe8\xe4\xc6\xf4\xca\xc9\x96\xbc\xc9\x30\xc0\x08\x55\x13\xf2\xb7\x54\x89\xbe\x71\xf7\x1e\xd0\xe2\x70\x18\x06\x74\x46\x2a\x57\x61\xf5\xe3\xef\x2b\xe4\x87\x43\xb0\x8f\x6e\x99\xed\xfd\xe1\x89\xf6\x19\x7e\x78\x16\x4d\x4f\xb8\xdd\x22\x3f\x01\xe1\xa7\x38\x1c\x70\x86\x17\xcd\xcf\x50\xd7\x09\x3b\xea\x17\x5d\x3c\xbd\x69\x24\x47\x45\x5a\x08\x84\xef\x9f\xc9\x3c\xcb\x82\x50\x69\x03\x47\x36\xba\x92\x50\xb0\x0e\x7a\x3b\xfe\x1c\x06\xec\xfa\x56\x28\xf9\x07\x4d\xe1\xc2\xdd\xc7\x7b\x14\x1e\x05\x2f\xd2\xed\x74\xef\x60\x49\x95\xbc\xc7\xd0\xef\x3d\x59\x67\x7d\x88\xee\x1d\x0c\x15\xda\x94\x36\x40\x8b\xa6\xb1\x68\x45\x49\x70\x1a\xd2\x65\x10\xaa\x04\x89\x62\x07\xdd\x71\xb4\xa5\x56\x30\xe4\x7a\xa3\x2c\x5e\x76\xc2\x41\x3a\x8b\xbf\xf6\xaa\x40\x25\xa9\x29\x51\x6a\xb2\x19\x03\x6b\x03\xa1\x40\xc6\x68\x13\x42\x2c\xf9\x6e\xea\x4f\x0e\xd6\xf5\x8f\x8f\x54\xe6\x89\xdb\x77\x34\xbf\xba\x75\xa6\x2f\x1c\x86\x93\x30\x7d\x18\x8f\x65\xef\x06\x01\x77\xc2\x16\xa2\x99\x5f\xd7\x13\x90\xd6\xd3\x0f\x7a\x5c\x34\xcb\xf0\xd9\xf5\x8f\x8b\x8f\x60\xc9\x59\x48\xc7\xd7\x0e\xf7\x43\x21\x94\xa2\x12\x86\x6c\xa7\x95\x25\x9b\x60\x19\xd5\x1f\x5e\xf5\xaa\x18\x06\x38\x6a\xbb\x46\x38\xc2\x2a\x38\x7f\x62\x7f\x67\x6a\xbb\x42\x1e\x75\x71\xd1\xec\x13\xd9\xbe\x71\xd1\x32\xea\x35\x28\xa5\xed\x01\x80\xa5\x9e\xff\xd2\x3b\xfa\x77\x82\x18\xac\x7f\xfe\xcb\xbb\x50\x34\x4d\x72\x38\x8a\x49\x34\x4d\x90\x12\x1b\x4d\x01\x15\x33\x77\xcf\xdc\xcf\x26\x33\xe7\x6f\x36\x98\x08\x31\x08\x0b\x43\x96\xd0\x95\x97\xc8\x51\x07\x0c\x4d\x65\x82\x99\xb5\x75\x46\xaa\x3a\x60\x7c\x14\x46\xb4\x16\xc2\x90\xdf\xd7\x85\x9f\x11\x85\xf7\x66\x10\xe8\xb4\x54\x8e\x0c\xbb\x7d\x66\xe4\xa9\x9d\x9f\x97\x60\x84\xf4\x5b\x2a\x51\xd0\xc0\xc9\xb4\xd9\xe0\xae\x77\xbb\x7b\x55\xe9\x91\xad\xe8\xdd\x0e\x92\x17\x5e\x8c\x64\xf8\x93\x53\x95\x6c\x42\x4e\xcd\xd1\xed\x2c\x4f\x8a\xde\x48\xb7\x4f\x70\xc4\x8d\x25\x24\x0f\x89\x39\x2e\xff\xea\xc1\xd9\xed\xcf\xc2\xe0\xb7\xb3\xb4\xdd\x42\xd1\x4b\x7a\x74\x78\x48\xf5\xf7\xf4\x72\x5c\x42\x61\x48\x38\xe2\x38\x1d\x17\x33\xbc\xec\xb4\x3d\xa1\x16\x15\x39\x25\x14\xb3\x69\x98\xff\xde\xbb\x37\x66\x54\xc2\x22\x3c\x3d\x20\x5d\xe3\xa7\xe3\x2f\x1f\xdd\x88\x75\xce\x2d\x68\xe7\x8d\x97\xd4\x98\xe0\xaf\x2b\x02\xaf\xb4\xba\x78\xca\x20\x15\xb4\x29\xc9\x84\x43\xd3\x76\x7e\xce\x3a\xe0\xa4\xeb\x99\x38\xfd\xd9\x6d\xde\xf6\xf9\xdf\x75\xf1\x94\xae\x13\xa0\xa4\x8a\x4c\x58\xfb\x87\x6a\xc6\xd5\xf1\xae\x5d\x47\xaa\x4c\x8f\x00\xa9\x92\xcd\x3a\x43\x9b\x7b\x46\x79\x9e\x8f\x9c\x3f\x91\x25\xc7\x01\xad\xc9\x5d\xe7\x7c\x16\x70\xeb\xc4\x7e\xac\x46\xcb\xd7\xf0\xd0\xe9\xfa\xab\xb9\x47\x72\xd8\x42\x49\x9f\x8e\x8b\xa8\xa1\xe0\xa6\x13\x93\x98\x35\x19\xba\x57\xf2\xce\x20\xbe\x46\x82\xdf\xc3\x30\x3a\x38\x2e\x64\x53\x25\x18\xa6\x6c\xbe\x3d\x3a\x2b\x8b\xa9\x77\x1b\x39\x66\x53\x6a\xdc\x4e\x0c\x0f\x1c\x90\x0b\x85\x3b\xb9\x54\xb9\x5f\x75\x9f\xd0\x71\x4e\x5a\xcc\xe5\xaa\xcb\xdd\x66\x39\x72\x8b\x7b\xfe\xdb\x05\x3a\x7a\x3d\xc6\xf3\x7c\x7e\x88\x87\x8e\x61\xcd\x10\x47\x32\xf6\x9c\x36\xf2\x0f\xe2\xb2\x3e\x3a\x8f\xeb\x7c\x63\xb9\x9d\x28\xd9\x4c\x45\x9f\x23\x26\x2b\xb4\xf9\x65\x17\x6c\xbd\xd6\x3c\x93\x29\x77\x8e\x41\xf8\xdc\x17\x05\x59\xfb\x69\xec\x61\x01\x3f\x3b\xb6\x95\xaa\x75\xf9\x3b\xae\x29\x55\xba\xe2\xf4\xf8\xd1\x1e\x83\x7e\xda\xaf\x39\xe3\xc7\x54\x5a\x65\xb8\x70\x5b\x66\x7c\x38\x66\xf1\x15\xe6\x69\x70\xcb\x92\x53\x8e\xaa\x9f\x78\x46\xb3\xbf\x09\xfb\xd9\x19\x12\xad\x54\xf5\x78\x29\xef\xe3\x50\xe7\x27\xf3\x0c\xba\x73\xf3\x22\xb1\x48\xe3\x8e\xdb\x69\xac\x16\x8b\x06\x7c\x67\x81\x5a\x1b\xdd\x3b\xa9\x28\x54\x90\x62\xc7\x83\x41\xc3\xca\x25\xf9\x4c\x3c\x35\x58\x9e\x12\xfa\xc6\x79\xed\xf2\x1c\xd2\x68\x7b\xa9\xa6\x5c\x66\xf2\x95\xf2\xfc\xf9\x86\x19\x2c\xe3\x84\xf9\x21\xd6\x79\xff\xf5\x76\x8b\x56\x3c\x51\xfa\xa5\x3d\x19\xfe\xcc\xb1\xab\x35\x38\x9f\x62\xcd\x1b\xab\x88\xbf\x4f\x1a\xee\xc8\x56\x00\x37\x3c\x73\x0d\xcf\x5b\x5d\x15\xa2\x59\xd6\xc6\x4c\x9c\x86\xa5\x89\xed\x25\xfd\xff\xbf\x14\x04\x4c\xde\xfc\xf9\x06\x86\x15\x3e\xef\x54\xe1\x11\xeb\x2c\x1e\xf1\x51\x37\x0d\x0f\xda\x57\xca\xde\x9d\x2a\x7f\x15\xd2\x5d\xd3\x5e\x36\x65\x9b\x1f\xaa\x79\x72\xee\x74\xd3\x7c\x8b\xa8\xc2\x21\xd7\x64\xc5\xcf\xbf\xbb\xf2\x5d\xcf\xf1\x6f\x8b\xce\x49\xc2\x4e\xab\xec\xbf\xcb\x23\x3b\xeb\xfa\x52\xe2\x46\x6e\xec\xc2\x69\x80\xf7\xd9\xc9\x2b\xfe\xad\x64\xd9\x91\x17\x4f\x4b\xaf\x6a\x79\x18\x38\x23\x94\x68\x8f\x1b\xf0\xd3\x97\xe4\x4d\xc6\x84\x79\x6e\x6c\xdf\x8b\x1b\xfc\xeb\xc5\xf6\x7f\xf2\x82\xf1\x0d\x5d\x63\xe1\x82\xa7\x37\xe1\xac\xb8\x56\x6b\x5f\x4f\x96\x71\xda\xd1\xd5\xc2\xcc\xb6\x08\xf1\xa5\xa1\xf3\xf2\xb9\x7e\x12\x5d\x8e\x47\x7c\xa5\x60\x4f\x70\x4d\x1b\xdf\xa9\xae\x19\x27\xe0\x69\x13\xbf\x65\x9e\x34\xff\x01\x10\xde\x55\xdb\x7c\x3c\x8c\xd1\x7c\xe7\x66\x83\xe3\x24\x84\xed\xf6\x52\xdf\x8c\x5b\x80\xb3\xf1\x2c\x0e\x67\xfc\x91\x87\xd3\xf3\xf4\x1a\xb7\x35\x17\x26\x0e\xc5\x49\x1b\xe6\xed\xf6\x55\x62\x91\x7b\x30\x42\x59\xfe\xeb\xc2\x97\x18\x28\xed\x76\x52\xd5\xb3\xca\xc3\xff\x19\x58\x28\x3d\xfe\x69\x70\x21\x69\x66\x48\xa9\x9b\x30\x4f\x27\xd6\xc9\x62\x8d\x21\x39\x24\xff\x19\x00\xea\xc7\x83\xe4\xd0\x12\x00\x00")
+
+func templatesClientMockGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientMockGotmpl,
+		"templates/client/mock.gotmpl",
+	)
+}
+
+func templatesClientMockGotmpl() (*asset, error) {
+	bytes, err := templatesClientMockGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/mock.gotmpl", size: 4816, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdf\x6f\xe3\xb8\xf1\x7f\xd7\x5f\x31\x5f\x7f\xd3\xad\x15\x64\xe5\x7b\xce\xc1\x05\xf6\x92\x5c\x37\x05\xba\x97\xee\x06\xd7\x87\xc5\xa2\x60\xa4\xb1\xcd\x5b\x89\x54\x48\xca\x89\x6b\xe8\x7f\x2f\xf8\x43\x12\x25\x4b\xb6\x9c\x6c\x2e\x3d\x74\x9f\x6c\x53\xc3\xe1\xcc\x67\x3e\xc3\x19\x52\x9e\xcd\xe0\x82\x27\x08\x4b\x64\x28\x88\xc2\x04\xee\x36\xb0\xe4\x6f\xe5\x03\x59\x2e\x51\xfc\x08\x97\xbf\xc0\x87\x5f\x6e\xe1\xea\xf2\xfa\x36\x0a\x82\x60\xbb\x05\xba\x80\xe8\x82\xe7\x1b\x41\x97\x2b\x05\x6f\xcb\x72\x36\x83\xed\x16\x62\x9e\x65\xc8\x54\xe7\xd9\x76\x0b\xc8\x12\x28\xcb\x20\x08\x72\x12\x7f\x25\x4b\xd4\xc2\xd1\x8d\xfb\xae\x1f\xcc\x66\x70\xbb\xa2\x12\x16\x34\x45\x78\x20\xb2\x6d\x8c\x5a\x21\x38\x6b\x40\x71\x9e\x46\x5a\xfe\x2a\xa1\x8a\xb2\x25\xa8\x7a\x5e\x66\x56\xcc\x05\x5f\x23\x2c\x0a\x65\x54\xad\x90\xc1\x86\x17\x20\xf0\xad\x28\x58\x4b\x53\xb5\x84\x31\x9b\xb0\x24\x08\x68\x96\x73\xa1\x60\x1a\x00\x4c\xb8\x9c\xe8\x0f\x86\x6a\xb6\x52\x2a\x9f\x04\xfa\xd7\x92\xa7\x84\x2d\x23\x2e\x96\xb3\xc7\x99\x7e\x14\x73\xa6\xf0\x51\xb9\xa7\x54\xad\x8a\xbb\x28\xe6\xd9\x6c\xc9\xdf\xf2\x1c\x19\xc9\xe9\x4c\x14\x4c\xd1\x0c\x27\xc3\x12\xda\xa0\x3d\x8f\x51\x08\x2e\xe4\x1e\x81\x35\x49\x69\x42\x94\x59\x22\x16\x07\xec\x98\xc5\x29\x45\x66\x2d\x96\x4a\x2c\x32\x35\x68\x96\x79\x6a\x04\xb7\x5b\x10\x84\x2d\x11\xa2\x4b\x5c\x90\x22\x55\xd7\x06\x29\x09\x26\xba\xb9\xa0\x4c\x2d\x60\xf2\xa7\xfb\x09\x44\x65\x69\xe5\x5d\xc8\xbd\xb9\x27\x5f\x71\x73\x06\x27\x6b\x92\x16\x08\xe7\x73\x88\x5a\x4a\xf4\x53\x28\x4b\xe8\xe8\x73\xe2\x1d\xad\xa1\x61\xcc\x07\x7c\xd0\xd2\x44\xc6\x24\xa5\xff\x46\x88\x3e\x90\x4c\x8b\xde\x10\x41\x32\x09\xb1\x40\xa2\x50\x02\x01\x86\x0f\xb0\x4f\x92\xdf\xfd\x86\xb1\xd2\x2a\x1f\xa8\x5a\x19\x92\x24\xd6\x4f\x30\xcb\x4b\xa0\x8c\x2a\x6a\xe6\x26\x51\xb0\x28\x58\x7c\x60\xf1\x69\x08\xa7\xfb\x56\xdc\x5a\x77\x74\x1e\xb9\x91\xb2\x5c\x13\x61\xa8\xd7\x80\x5d\x3f\x72\xa2\xef\x89\x74\xf8\xd7\x63\x8c\x2b\x88\xae\xe5\xcf\x34\x45\x23\x6d\x1f\xac\x89\x60\x7a\xbd\xe8\xfa\xb2\x2c\xab\x29\xf3\x6a\xc5\x6b\x79\x23\x68\x46\x15\x5d\xa3\x96\x8e\xfe\xca\x6f\x37\x39\x96\xe5\xd4\x02\xdc\x8e\xe9\xff\xaf\x27\x75\xd4\x1b\x4b\x3c\x15\x50\x96\x61\x27\xde\xf6\xbb\xf7\xc5\x68\x0d\x00\x5a\x82\x02\x55\x21\x18\xbc\xd9\xc5\xa9\x82\x69\x7b\x14\x1a\x3b\x4a\xce\x9d\xc3\x84\x25\x30\x75\x40\xbd\x13\x82\x6c\xc2\xfa\xe7\xdf\x49\x5e\xfd\xd0\xea\xa8\x8c\xb5\x5b\x8c\x28\x2e\x42\x98\x72\xa1\x65\x3e\x14\x69\x4a\xee\x52\x04\x08\xa1\x2c\xdf\xf8\xfe\x79\x38\x43\x0d\xf4\x59\x2f\x08\x01\x80\x19\x8e\x49\x86\xd6\xc8\x5b\x9a\x21\x2f\x94\x23\xc6\x39\xc4\xa2\xc2\xd9\x3d\xd1\x8a\xca\xa0\x1c\xc1\xf5\x7f\x52\xb5\x72\x93\x5e\x8a\xf6\x67\x06\x46\x2d\x43\xee\x68\x4a\xd5\x06\x14\x07\x89\x0a\x08\x28\xb7\x32\x67\x40\x40\xe0\x7d\x81\x52\x8d\x49\x12\xcf\xea\x69\xa5\x43\x7f\x46\x97\x85\x20\x8a\x72\xf6\x3d\x89\x5e\x33\x89\xb4\xdb\x7f\xb0\x14\x52\x4f\x49\x9c\x0b\x5b\xc3\x5f\x21\x71\x5c\xf7\x00\x0b\x2e\x8e\xcf\x1c\x67\xf6\x34\x56\x8f\x95\xa2\xc8\x8d\xbd\x6e\xde\x34\xe1\xb1\x34\xfc\x1f\x4c\x9d\xdf\xab\xfe\xb4\xa1\x1e\x95\x3f\x8e\x22\xe7\x10\xab\xc7\xe3\xf2\xe4\xfd\xed\xed\xcd\x85\x69\x1e\x5f\x23\x55\x0a\xa9\x78\x06\x9e\x0d\x4f\x4a\x9a\x66\xfe\xd4\xf6\xc1\x70\xaa\xbb\xfb\xc8\x8e\x7d\xcf\x9b\xef\x79\xd3\x83\x43\x43\x9a\x73\xb0\xac\x69\x12\x67\x2f\x61\xf4\xb6\x4c\x28\x93\x40\xd2\xd4\xd0\x3a\xd7\xe3\xa8\x50\x48\xcb\x6c\xcd\x76\x6e\x9e\xbc\xbb\xb9\xd6\xab\xe5\x9c\x32\x15\x68\x6a\xeb\xc1\xed\x16\x56\x45\x46\x98\xaf\x1a\x78\x8e\xb6\x3b\x02\xb5\xc9\x69\x4c\xd2\xd4\x1c\x90\x25\x02\x11\x08\x0f\x82\x2a\x85\x4c\xab\x25\x60\xa8\xfd\xd1\x65\xc8\xe9\x2c\x50\x9b\x1c\xf7\x66\xab\x54\xa2\x88\x15\x6c\x83\xfe\x00\x0e\x78\xbb\xdd\xea\xb0\x5e\xa2\x0e\x42\x6e\x2c\xab\x08\x75\x97\xf2\xf8\x6b\x7d\x2b\xd0\x91\xf0\xb1\x3e\x9d\xd9\x5f\xad\xf6\xc3\x1d\x07\x9f\xc3\x04\x27\x74\xcd\x14\x8a\x05\x89\xb1\x19\xfa\xa4\x04\x92\x6c\x80\x2c\xa7\x3e\x09\x06\x13\xd6\x25\xa0\xa3\x4a\x2a\xf5\x37\x2e\x23\x2d\xd5\xa4\x8e\x77\xfb\xb1\xb7\x79\x69\x77\xbe\x41\xbd\x51\x77\x6b\x7b\x00\xfe\x26\xe8\xef\x5e\x6e\x23\xd7\xdb\x5c\x1b\xc9\xce\x42\x24\x49\xa4\xa1\x57\xdd\x73\xf3\x61\xb6\x19\xc6\x4a\xbb\xbd\xea\x7d\x26\xfa\x88\x31\xd2\x35\x8a\x4a\x60\x5f\x02\x84\x07\x8d\x79\x4e\xdf\xdf\x35\x25\xfa\x84\x6a\xcc\x5a\x61\xb3\x87\xf5\x68\x71\x28\x1e\xd0\xf5\xbb\x82\x38\xd2\xaf\x2e\x86\x43\x30\xed\x23\xe1\xbc\xf2\xc7\x23\x53\x45\xc4\xda\xe5\xaa\x6d\x7d\x61\xde\x3c\xbb\xc1\xed\x23\x88\xa7\x74\x2c\x0f\x5e\xc3\xff\xb6\xa5\xbb\xee\x0f\x79\x58\xd9\x3a\xd7\xed\x9d\x17\x43\x6f\xcb\xa8\xdd\xf0\xc6\x5e\x38\x92\xdf\xa2\xeb\xea\x0b\xe6\x8e\xde\xb1\x21\x7d\x3d\x38\xfa\xac\xee\xa0\x31\xe4\xb0\x67\xe0\xdc\xf5\x21\xda\xa3\x9e\x3a\xdd\x5f\x06\x6c\x41\xad\xfd\xf5\xcf\xde\x66\x09\x53\x84\x76\x3d\x3f\x19\x74\xfd\xe4\x80\xef\x27\x87\xab\x81\xb1\x69\xda\x6b\xca\xb7\xa9\xfc\x2f\x5d\xe6\xdd\xfc\x2e\x87\x4f\xfa\x49\xbc\x83\xd8\x6e\xcd\x1a\x46\xe4\x69\x75\x6b\x37\xea\x9d\xe6\xf7\xe5\xc3\x7e\x84\x8f\x7f\xb4\xa8\x0f\xc6\xb5\x2f\x08\xf3\xde\x9c\x73\x39\xec\x9a\x44\x9d\xb9\x82\x2a\xbc\xe5\xae\x6f\x37\x1d\x3d\x4a\xd7\xe2\xdb\x58\xd8\xee\xbe\x7a\x8f\xd5\x3a\x02\x3f\x65\x87\x6e\xad\x37\x15\xe0\xde\x14\xb9\x0d\xc9\x8d\x9f\x81\xc0\xa5\x7b\x63\x14\x7d\xc4\x25\x95\x4a\x6c\x42\x30\x2f\xa7\xec\x81\x81\x2e\xf4\x2f\x38\x9f\x83\xd0\xb4\xae\x6e\x76\x8f\x6c\x41\xc2\x1f\x8d\x96\xff\x9b\x03\xa3\xa9\xc1\xb7\x66\x3d\x0a\x61\xce\x5d\xa0\x41\x04\x81\x12\x3e\x7f\x31\xeb\x9b\x20\xb4\x36\xc1\xaa\xdd\x76\xe1\x75\x3c\x30\x54\x72\x24\xd2\x1f\x3f\xf1\x64\x63\xe4\xc3\xfa\xc4\xe2\xc8\xe7\x93\xc6\x92\xea\x5d\x9a\xf2\x87\xab\x2c\x57\x9b\x5f\x49\x5a\xa0\x9e\x41\x17\x26\x11\xcd\xef\xab\xc7\x5c\xa0\x94\xf6\x68\x53\x5b\x0f\xd5\xc9\xbc\xb9\x39\xb8\x96\xff\x28\x50\x6c\x2a\xa6\x05\x00\xb3\x19\xdc\xeb\x21\x1b\x5c\xa3\xb2\xca\x69\x6f\x56\x6d\x8e\xbd\x73\xb8\x17\xbd\x31\x85\x16\x73\x6d\x50\x0e\xd8\x68\x10\x1e\x52\x37\x37\xdc\xe9\x99\xae\x03\xd1\x24\xc6\xd0\xf4\xf3\xf9\xc0\xea\x1e\x2e\xf7\x7d\x57\x00\x6e\xa6\x76\xfd\x67\x2e\x32\xa2\x14\x0a\x97\x97\xfe\xef\xe9\xc0\xc2\xe1\x41\xd3\x6a\x5c\x2f\xcc\xc5\x92\xaf\x34\xfa\xa4\x04\x65\xcb\x69\xe8\x0e\x71\xf5\x47\xbd\x59\x74\xb8\x50\x23\xdd\xe3\x8a\x43\x7a\x32\xa9\xc9\x50\x4b\xfb\xc9\xd2\x70\x62\xda\x7d\xa1\xea\xb4\x9c\x0d\x68\x1f\x95\x2f\x7b\x6d\x2f\xbb\xb7\x40\x1a\x38\x77\x59\x44\xd4\xaa\xcd\xd4\x9c\xa8\x55\x2f\x51\x3b\x0e\xd5\x33\x87\xfd\x19\x13\xdf\x3e\xfa\x9f\x36\x01\xe9\x61\x96\x17\xfa\xe3\x27\x1f\xcf\x8a\xb1\xf0\x7b\xa0\xbe\x47\x92\xa0\x68\xc3\xba\x32\x63\x63\x80\xf5\x66\x7f\x87\xb6\x0b\xad\xd6\xea\x01\x5b\xaf\xe9\xd7\xf6\xbe\x4d\x75\xfc\x56\xd9\x5c\xec\x98\xb8\x2d\xb8\xc8\xec\x9f\x4f\xfa\x42\xb7\x13\xbc\xda\x8e\x43\xa1\x73\x3d\x49\x63\xdf\x9b\xfd\x91\xe9\x81\xaa\x03\x96\xbf\x11\x74\x3d\xef\xf9\xcb\x86\x8b\x73\xd0\x78\x79\x4c\x75\x5a\x7c\xdb\xea\x34\xa4\x6e\x64\x75\x1a\x9a\x3e\xa6\x3a\x2d\x9e\x53\x9d\x06\x16\x0e\x0f\x9a\xf6\x22\xd5\xa9\xc7\x95\x91\xd5\xa9\x4e\xab\x61\xda\xf6\x2b\x7f\x81\xe2\x34\xf0\xfd\x98\xbe\xad\xf4\x6f\x67\xbd\xdd\xc3\xb6\x87\x65\xc7\x26\xaf\x4d\x6c\x22\x73\xb1\xa2\x69\x73\x82\xd0\xdd\xa5\x19\xf1\xc2\xef\x06\xfa\x42\xa8\x33\xc4\xbe\xfc\xea\x8f\xc8\xe7\x2f\xd2\xc4\x58\xd3\x8f\x0b\xf8\xd7\x19\xac\x4d\x28\x4c\x83\x7b\xcc\x01\xc9\x3b\x08\x79\xc0\x84\x07\xf7\x6a\x17\xa9\x7d\x36\xce\x81\xe4\x39\xb2\x64\xba\x47\xc8\x6c\x66\x3b\xc0\xb4\x31\xdc\xa9\x49\x36\xa8\xeb\x96\xcc\x81\x3c\x68\x9d\xe6\x7a\xd4\x36\x22\x61\xa7\x6a\xe8\x58\x0c\xfb\x58\x67\xf9\x1e\xb4\x6b\x80\x5b\xe8\x1f\x85\x76\xff\xce\xfb\x5f\x66\xd8\x6f\x9c\x32\x4c\x86\x36\x43\x7d\x14\x8d\xfe\xc6\x29\xfb\x69\x63\x81\xdf\x4f\x8b\xc9\x76\x1b\x5d\xf0\x34\xc5\x58\x51\xce\xec\x8c\xb2\x9c\x84\x83\xa7\xa4\xfa\x88\x44\x4c\x8a\x8e\x68\x93\xc6\x34\xd4\x43\x3e\x69\x76\x45\xd1\xb1\xed\x87\xdb\x7e\xfc\x16\xa4\x2a\x9d\xa3\xad\x1e\xb1\xd1\xbe\x88\xd1\x7e\x9f\x5f\x35\xf9\xc3\x46\xdb\x6b\xa6\x66\x4e\xc2\x51\x9a\xbd\x52\x16\xb9\xf9\x03\xed\x9a\x08\x4a\x12\x41\x63\x20\x62\x59\x64\xc8\x94\x3c\x03\x49\x59\x8c\xf0\x80\x50\x48\x4c\xc0\x27\x8b\x55\xf9\x80\x10\x13\xe6\x5e\x8a\xae\x10\x16\x54\x48\x05\x54\x61\x06\xd4\xfe\x71\xd7\x5a\x44\x24\x50\xf5\xe7\xe6\x9d\xaa\x96\x90\xc0\x17\xf6\x05\xab\xc0\x35\xe5\x85\xb4\x2a\xed\x04\x8b\x18\x28\xbe\x44\xb5\x42\xf1\xa4\xb3\xca\x1e\xd4\x3f\xff\xf0\x65\x34\xea\x9d\xff\x63\xec\xbe\x1e\xb4\xdc\xaf\x0b\x4e\xb7\xba\x7d\x8a\x57\x98\x91\xdd\x22\xb7\x7b\x13\x36\xe6\x76\xa2\xaf\x43\xad\x97\x9e\x3e\xb3\xd3\xec\xba\xe9\x55\x5c\xf7\x8d\x2e\x20\x45\x36\x15\x28\x43\xf8\x0b\xfc\xb0\x83\x1b\x17\x32\xba\xe0\x59\xce\x25\x55\xf8\xab\xfd\x8f\x33\xe5\xec\x4a\x3f\xd1\xb3\x34\xdd\x1d\xb6\x6e\x12\xa3\x69\x50\x06\xff\x09\x00\x00\xff\xff\x19\xb4\xf2\x2c\xd0\x2e\x00\x00")
+var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xe3\xb8\x11\x7f\xd7\xa7\x98\xba\xe9\xd5\x0e\x1c\xf9\x9e\x73\x48\x81\xbd\x24\xd7\x4d\x81\xe6\xd2\x4d\x70\x7d\x58\x2c\x0a\x46\x1a\xdb\xbc\x95\x48\x85\xa4\x9c\xb8\x86\xbe\x7b\xc1\x3f\x92\x28\x59\xb2\xe5\x4d\xb2\xe9\x01\x79\x4a\x44\xce\x0c\x67\x7e\xf3\x87\x43\xd2\xb3\x19\x9c\xf3\x18\x61\x81\x0c\x05\x51\x18\xc3\xfd\x1a\x16\xfc\x44\x3e\x92\xc5\x02\xc5\x4f\x70\xf1\x2b\x5c\xff\x7a\x07\x97\x17\x57\x77\x61\x10\x04\x9b\x0d\xd0\x39\x84\xe7\x3c\x5b\x0b\xba\x58\x2a\x38\x29\x8a\xd9\x0c\x36\x1b\x88\x78\x9a\x22\x53\xad\xb9\xcd\x06\x90\xc5\x50\x14\x41\x10\x64\x24\xfa\x4a\x16\xa8\x89\xc3\x1b\xf7\xbf\x9e\x98\xcd\xe0\x6e\x49\x25\xcc\x69\x82\xf0\x48\x64\x53\x19\xb5\x44\x70\xda\x80\xe2\x3c\x09\x83\xd9\x0c\x2e\x63\xaa\x28\x5b\x80\xaa\xf8\x52\xa3\x4d\x26\xf8\x0a\x61\x9e\x2b\x23\x6a\x89\x0c\xd6\x3c\x07\x81\x27\x22\x67\x0d\x49\xe5\x12\x46\x6d\xc2\xe2\x20\xa0\x69\xc6\x85\x82\x71\x00\x30\xe2\x72\xa4\xff\x30\x54\xb3\xa5\x52\xd9\x28\xd0\x5f\x0b\x9e\x10\xb6\x08\xb9\x58\xcc\x9e\x66\x7a\x2a\xe2\x4c\xe1\x93\x72\xb3\x54\x2d\xf3\xfb\x30\xe2\xe9\x6c\xc1\x4f\x78\x86\x8c\x64\x74\x26\x72\xa6\x68\x8a\xa3\x7e\x0a\x6d\xda\x8e\x69\x14\x82\x0b\xb9\x83\x60\x45\x12\x1a\x13\x65\x96\x88\xc4\x1e\x3d\x66\x51\x42\x91\x59\x8d\xa5\x12\xf3\x54\xf5\x31\xd8\x59\x43\xb8\xd9\x80\x20\x6c\x81\x10\x5e\xe0\x9c\xe4\x89\xba\x32\x48\x49\x28\x8a\xcd\x06\x32\x41\x99\x9a\xc3\xe8\x2f\x0f\x23\x08\x8b\xc2\xd2\x3b\x97\x7b\xbc\x47\x5f\x71\x3d\x85\xa3\x15\x49\x72\x84\xd3\x33\x08\x1b\x42\xf4\x2c\x14\x05\xb4\xe4\x39\xf2\x96\xd4\x89\x89\x98\x6b\x7c\xd4\xd4\x44\x46\x24\xa1\xff\x45\x08\xaf\x49\x8a\x50\x14\x37\x44\x90\x54\x42\x24\x90\x28\x94\x40\x80\xe1\x23\xec\xa2\xe4\xf7\xbf\x63\xa4\xb4\xc8\x47\xaa\x96\x26\x48\x62\x6b\x27\x98\xe5\x25\x50\x46\x15\x35\xbc\x71\x18\xcc\x73\x16\xed\x59\x7c\x3c\x81\xe3\x5d\x2b\x6e\xac\x39\x3a\x8f\xdc\x48\x51\xac\x88\x80\xb1\x0f\x58\x3d\xe5\x48\x3f\x12\xe9\xf0\xaf\xc6\x18\x57\x10\x5e\xc9\x5f\x68\x82\x86\xda\x4e\xac\x88\x60\x5a\x9d\xf0\xea\xa2\x28\x4a\x96\xb3\x72\xc5\x2b\x79\x23\x68\x4a\x15\x5d\xa1\xa6\x0e\xff\xce\xef\xd6\x19\x16\xc5\xd8\x66\x6a\xd3\xa7\x7f\x5e\x8d\x20\x6c\xaf\xea\x8b\x80\xa2\x98\xb4\xfc\x6d\xbd\xe4\xfd\x63\xa4\x06\x00\x0d\x42\x81\x2a\x17\x0c\x7e\xd8\xc6\xa9\x84\x69\x73\x10\x1a\x5b\x42\x4e\x9d\xc1\x84\xc5\x30\x76\x40\x7d\x10\x82\xac\x27\xd5\xe7\x3f\x49\x56\x7e\x68\x71\x54\x46\xda\x2c\x46\x14\x17\x13\x18\x73\xa1\xc1\xba\xce\x93\x84\xdc\x27\x08\x30\x81\xa2\xf8\xc1\x33\xcb\xc7\x19\x2a\xa0\xa7\x9d\x20\x04\x00\x66\x38\x22\x29\x5a\x4b\xef\x68\x8a\x3c\x57\x2e\x30\x4a\x65\xcb\x61\x28\x0a\x5d\x36\xc2\x8b\x5c\x10\x45\x39\xd3\xce\x29\xe7\xc2\x6b\xc2\xb8\xc4\x88\xb3\x58\x47\xc7\x64\x0a\xb6\xfe\x7a\xbc\x53\x98\x0b\x9e\xc2\xd3\x89\x16\xc2\x73\xa5\xf5\x48\xa4\x0e\xd0\x48\x94\xee\x74\xd4\x53\x5f\xc7\x22\x28\x06\xe4\xd6\xbf\xa9\x5a\x96\x6b\xbd\x52\x9a\x4d\x8d\xdb\x74\x2a\x92\x7b\x9a\x50\xb5\x06\xc5\x41\xa2\x02\x02\xce\x24\xe0\x0c\x08\x08\x7c\xc8\x51\xaa\x21\x49\xe9\x69\x3d\x2e\x65\x34\x30\x7e\x4f\xda\xb7\x4c\xda\xab\x8b\x3a\x0b\xfe\x20\x29\xeb\xa2\x68\x7a\x50\xe2\x9c\xdb\x9e\xe1\x0d\x12\xc7\x75\x2b\x30\xe7\xe2\xf0\xcc\x71\x6a\x8f\x23\xf5\x54\x0a\x0a\xdd\xd8\xdb\xe6\x4d\xed\x1e\xed\x97\xf7\xfd\xee\x15\xf7\xbb\x26\xd4\x83\xf2\xc7\x85\xc8\x29\x44\xea\xe9\xb0\x3c\xf9\x78\x77\x77\x73\x6e\x9a\xd5\xb7\x48\x95\x5c\x2a\x9e\x82\xa7\xc3\x37\x25\x4d\xcd\x3f\xb6\x7d\x37\x1c\xeb\xd3\x44\x68\xc7\xde\xf3\xe6\x3d\x6f\x3a\xf2\xa6\x0e\x9a\x53\xb0\x51\x33\x75\xa6\xb8\xfd\x67\xf0\xde\xf4\x92\xdd\x63\xe9\x0e\x9b\xc0\x3b\x03\x57\x6f\x0f\x84\x32\x09\x24\x49\x4c\x9b\x97\xe9\xa8\x43\x85\x42\xda\x2e\x4e\x77\x76\xdc\xcc\x7c\xb8\xb9\xd2\xa2\x33\x4e\x99\x0a\x74\x8a\xe9\xc1\xcd\x06\x96\x79\x4a\x98\x2f\x1a\x78\xa6\x2f\x04\x28\x67\xa0\xd6\x19\x8d\x48\x92\x98\x8b\x01\x89\x40\x04\xc2\xa3\xa0\x4a\x21\xd3\x62\x09\x98\x14\xfb\xe4\x32\xf5\x78\x16\xa8\x75\x86\x3b\xab\x86\x54\x22\x8f\x14\x6c\x9a\x67\x5d\x37\x59\x14\x3d\xd6\x6e\x36\xda\x27\x17\xa8\x83\x21\xd3\x3d\x7a\x15\xd8\xf7\x09\x8f\xbe\x56\xb7\x21\x2d\x8a\x96\xcf\xad\x8c\x4c\x60\x44\x9c\x0c\x2f\x69\x77\xb0\x96\x3c\x18\x9f\x76\x2d\x59\x09\x0c\xaf\xb9\xa2\x11\xb6\xd9\x8f\x67\x01\xb4\x30\x31\x87\x98\xe7\xe6\x82\x23\xba\x62\x0a\xc5\x9c\x44\x58\x0f\xdd\x2a\x81\x24\xed\x49\x97\x63\x3f\x5d\x7a\x4b\x96\x2b\x41\x2e\x59\xec\x79\x86\xcb\x50\x53\xd5\xc5\xa3\x92\x14\x04\x3b\x53\xa4\x99\x21\x41\xb5\x55\xb5\xbb\x9b\x00\xfc\x6d\xc0\xaf\xdf\x6e\x2b\xd3\x9b\x55\x13\xc9\xd6\x42\x24\x8e\xa5\x8e\xd5\xea\xe4\xa2\x78\x7f\x9c\x9b\x5c\x91\xb6\x2b\xd3\xcd\x7d\xf8\x09\x23\xa4\x2b\x14\x25\xc1\xae\xd4\x9b\xec\x55\xe6\x39\x27\x9f\xb6\x2a\xe1\x2d\xaa\x21\x6b\x4d\xea\x2a\xde\x21\xc5\xa1\xb8\x47\xd6\x77\x05\x71\xa0\x5d\x6d\x0c\xfb\x60\xda\x15\x84\x67\xa5\x3d\x5e\x30\x95\x81\x58\x99\xec\x22\xf2\x35\x4d\x7e\x91\x16\x7f\xcb\xf2\x5b\x54\x9e\xd0\xa1\x71\xf0\x16\xf6\x37\x35\xdd\x36\xbf\xcf\x42\x47\x00\x67\xba\xc1\xf5\x7c\xe8\x95\x8c\xca\x0c\x6f\xec\x95\x3d\xf9\x12\x7d\xe7\x96\xa9\xb7\xa8\xb6\xe4\x0e\x75\x69\xcd\x58\x7b\xf5\xfb\xc0\xd1\xa5\x75\x0b\x8d\x3e\x83\x3d\x05\xcf\x5c\x27\xa6\x2d\xea\xe8\x10\x4a\xaf\x37\x35\xb1\x1b\x6a\x65\xaf\x7f\xfb\x60\x96\xd0\xb3\x1d\x96\x1f\xf5\x9a\x7e\xb4\xc7\xf6\xa3\xb6\xf1\x3d\x3a\x8d\x3b\x55\x79\x99\x9d\xff\xb5\xb7\x79\xc7\x3f\xd9\x6d\x7a\x19\xc4\x5b\x88\x6d\xef\x59\xfd\x88\x0c\x0d\xee\x7d\x5e\xaf\x8b\xff\x77\x72\xfb\x01\x36\xfe\xd1\xbc\xde\xeb\xd7\x0e\x83\xed\xed\xe9\x96\xc9\x2e\x87\x5d\x93\xa8\x33\x57\x50\x85\x77\xdc\x9d\x18\xcc\x59\x02\xa5\x3b\x5c\x58\x5f\x68\x7f\x91\xea\xe5\xb0\x71\x09\xf0\x2d\x15\xba\xb1\xde\x58\x80\x7b\x9b\x73\x05\xc9\x8d\x4f\x41\xe0\xc2\xbd\xd1\x85\x9f\x70\x41\xa5\x12\xeb\x09\x98\xe7\x40\x7b\x54\xa1\x73\xfd\xa5\xdf\xd2\x44\x78\x8b\xe5\x7d\xfe\xf8\xc0\x16\x64\xf2\x93\x91\xf2\xa7\x33\x60\x34\x31\x79\x53\x45\x3d\x0a\x61\xae\x6c\x40\xe7\x06\x08\x94\xf0\xf9\x8b\x59\xdf\x38\xa1\x51\x04\xcb\x76\xdb\xb9\xd7\xc5\x81\x29\x20\x2e\x88\xf4\x9f\x9f\x79\xbc\x36\x89\x3e\xa9\xce\x4a\x2e\xf8\xfc\xa0\xb1\x91\xf7\x21\x49\xf8\xe3\x65\x9a\xa9\xf5\x6f\xfa\x75\x40\x73\xd0\xb9\xe6\x08\xcd\xf7\xe5\x53\x26\x50\x4a\x7b\x32\xaa\xb4\x77\xdd\xbf\x27\x3c\xbc\x92\xff\xca\x51\xac\xcb\x48\x0b\x40\x9f\x77\x1f\xf4\x90\xad\xaf\x9a\xae\xf4\x90\xcf\x55\xa9\x63\x9f\xe6\x1e\x44\xa7\x4f\xa1\x11\xb9\x01\xc0\x7e\x1d\x0d\xc2\x7d\xe2\xce\xe0\xb8\x9b\x5d\x3b\xa2\x4e\x8c\x3e\xf6\xd3\xb3\x9e\xd5\x3d\x5c\x1e\xb6\x59\x2b\x4e\x6d\xfa\x2f\x5c\xa4\x44\x29\x14\x2e\x2f\xfd\xef\x71\xcf\xc2\x93\xbd\xaa\x55\xb8\x9e\x9b\xab\x35\x5f\x68\x78\xab\x04\x65\x8b\xf1\xc4\x1d\xe2\xaa\x3f\x55\xb1\x68\xc5\x42\x85\x74\x87\x29\x0e\xe9\xd1\xa8\x0a\x86\x8a\xda\x4f\x96\x3a\x26\xc6\xfe\x35\xd6\xc3\xa8\x92\x32\xed\x91\x3e\x28\x5f\x76\xea\x5e\x5f\xf5\xb8\xe3\xaa\xf6\xa9\xbb\x2e\x23\x6a\xd9\x8c\xd4\x8c\xa8\x65\x67\xa0\xb6\x0c\xaa\x38\xfb\xed\x19\xe2\xdf\xae\xf0\x3f\xae\x1d\xd2\x11\x59\x9e\xeb\x0f\x67\x3e\x3c\x2a\x86\xc2\xef\x81\xfa\x11\x49\x8c\xa2\x09\xeb\xd2\x8c\x0d\x01\xd6\xe3\x7e\x87\xb6\x0d\xad\x96\xea\x01\x5b\xad\xe9\xef\xed\xfe\x78\xa9\x7d\x09\x74\xb7\xea\xbe\x0a\x4e\x37\xa3\xcc\x6c\xa6\xdf\xae\x52\xfb\x33\xa1\x2e\xd7\x6d\x39\xaf\xd2\x63\x9f\xeb\x5c\x4f\x52\xeb\xf7\xc3\x4e\x70\xbb\xa0\x6a\x81\x05\xd0\x6f\xb9\x9b\xd9\x2a\x02\x65\x74\x1a\x2b\xbb\x0c\xdc\x12\xe7\xde\x04\xe6\x2f\xbb\x3b\xcd\x9f\xb7\x3b\xcd\x9f\xb1\x3b\xcd\x9f\xb3\x3b\xf5\x2c\x3c\xd9\xab\xda\xe1\xc9\xb2\xb3\xc2\x5b\xa4\x3b\x4c\x19\xb8\x3b\x55\x69\xd5\x1f\xb6\xdd\xc2\x87\xa6\xf0\x01\x9b\x53\xcf\xff\x87\xf4\x6d\x25\x66\x46\xa2\x57\x3d\x6c\x7b\xe8\x49\x74\x59\x58\xb5\x89\xb5\x67\xce\x97\x34\xa9\x4f\x10\xba\xbb\x34\x23\x9e\xfb\xdd\x40\x97\x0b\x75\xff\x66\x9f\xff\xba\x3d\xf2\xf9\x8b\x34\x05\x31\x00\x5d\x5f\xe0\x3f\x53\x58\x19\x57\x98\x06\xb7\xb6\x75\xff\x01\xc9\x3b\x08\x79\xc0\xb8\x33\x50\x19\x36\x1d\xf1\xef\x3c\xb5\x4b\xc7\x33\x20\x59\x86\x2c\x1e\xef\x20\x32\xc5\x6c\x0b\x98\x26\x86\x8d\x09\xf7\x94\xa7\x8b\x48\x83\x66\x4f\x1e\x38\x9e\x5e\xb1\x35\xc9\xc4\x2b\x76\xda\xef\x45\xb1\x43\xfd\x3a\xcb\x77\xa0\x5d\x01\xec\xbe\xed\xf1\xf4\x20\xb4\xdb\x51\xfd\x7f\xa9\xd8\xef\x9c\x32\x8c\xb7\xd5\xb1\xc5\x50\x1f\x45\xc3\x7f\x70\xca\x7e\x5e\x5b\x1f\xed\x0e\x8b\xd1\x66\x13\x9e\xf3\x24\xc1\x48\xdf\x54\x5b\x8e\xa2\x18\x4d\x7a\x4f\x49\xd5\x11\x89\x68\x23\x87\xb4\x49\x43\x1a\xea\x3e\x9b\x74\x95\x0d\xc3\x43\xdb\x0f\x57\x7e\xfc\x16\xa4\xdc\x3a\x07\x6b\x3d\xa0\xd0\xbe\x8a\xd2\x55\xb7\x6e\x95\x36\x4d\x7e\xbf\xd2\xf6\x9a\xa9\xe6\x89\x39\x4a\xd0\x51\x28\xf3\x4c\xff\x86\x56\x1f\xcf\x29\x89\x05\x8d\x80\x88\x45\xae\x7f\x84\x2d\xa7\x20\x29\x8b\x10\x1e\x11\x72\x89\x31\xf8\xc1\x62\x9b\x8c\x47\x84\x88\x30\xf7\x1c\xbb\x44\x98\x53\x21\x15\x50\x85\x29\x50\xfb\x53\x69\xab\x11\x91\x40\xd5\x5f\xeb\xd7\x5c\x4d\x21\x81\xcf\x0d\x49\x26\x70\x45\x79\x2e\xad\x48\xcb\x60\x11\x03\xc5\x17\xa8\x96\x28\xb6\x51\xaf\x2c\xf9\x26\xd4\x3f\xff\xf8\x65\x30\xea\x2c\x6e\xa4\x95\x3f\x5a\xdd\x57\xf8\x1b\x8e\xb7\x17\x19\x4f\xdd\x46\x4b\x4c\x89\x97\xc4\xbd\x37\x61\x43\x6e\x27\xba\x3a\xd4\x6a\xe9\x71\x37\x73\x97\xa9\x2d\x63\x6d\x89\x6d\x9b\xe9\xed\xb8\xee\x3f\x3a\x87\x04\xd9\x58\xa0\x9c\xc0\xdf\xe0\xc7\x2d\xdc\xb8\x90\xe1\x39\x4f\x33\x2e\xa9\xc2\xdf\xec\xaf\xca\x29\x67\x97\xfa\xc6\x47\x73\x85\x61\x58\xd6\x73\xc7\xc4\x68\x12\x14\xc1\xff\x06\x00\xdc\xd3\x72\xfb\x42\x30\x00\x00")
 
 func templatesClientParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -171,12 +243,12 @@ func templatesClientParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 11984, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 12354, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x4f\x73\xe3\xb6\x15\x3f\x97\x9f\xe2\x95\xdd\xdd\x21\x5d\x99\x4a\x7a\x74\xc6\x87\x8d\x57\xd9\xd5\x21\xb6\xc7\x76\xda\xe9\x64\x32\x1d\x2c\xf9\x24\xa1\x4b\x02\x0c\x00\x5a\x51\x39\xfc\xee\x1d\xfc\x21\x09\x4a\xa0\xec\x6c\x4f\xcd\x5e\x56\x26\x1e\xde\xdf\x1f\xde\xfb\x01\x6d\x0b\x05\x6e\x28\x43\x88\xf3\x92\x22\x53\x02\x65\xcd\x99\xc4\x18\xba\x6e\xb9\x84\x5b\xdc\xb7\x2d\xd4\x44\xe6\xa4\xa4\xff\x41\xc8\x6e\x49\x85\xd0\x75\x90\x0b\x24\x0a\x25\x10\x08\xaf\xef\xa9\xda\x69\xd5\xa4\x29\x15\xec\x90\x14\x28\x24\x3c\x93\xb2\x41\x19\x6d\x1a\x96\xcf\x6a\x4e\xda\x16\xe8\x06\xf0\x57\xc8\x6e\x78\x81\x70\xf9\x2d\x74\x5d\xae\x7f\x51\xa6\xda\x16\x90\x15\xd0\x75\x56\x28\x7b\xcc\x77\x58\x91\xe1\x6f\xc2\x0a\x48\xbc\x9d\x69\x2f\x91\xad\xe5\xa3\x12\x48\x2a\xe8\xba\x05\xb4\x2d\xb2\xe2\x48\x87\x2f\xb1\x17\x54\xa1\x00\xca\xb3\x7f\x98\x5f\xbe\x55\xfb\x23\x85\x8b\x70\xd8\x6d\x04\x20\x50\x35\x82\xc1\xbb\xa0\x84\x16\x00\x08\xc5\xf8\x2f\xa9\x88\x6a\xa4\xfe\x70\x05\x3a\xe0\x45\x2f\x3a\x18\x17\x84\x6d\x11\xb2\x4f\x2e\x9d\x43\x08\x9f\x88\xfc\xe0\x52\x6d\xbe\x9d\x9a\xbd\x32\x65\x12\x94\xa9\x0d\xc4\x6f\xff\xf2\x1c\x43\x36\xee\x38\x35\x74\x2e\xc9\x81\x84\xdd\x93\x43\xc9\x49\x71\x05\x36\x73\x73\xfa\xba\xa8\x8b\xa2\x65\x20\x73\x5d\x07\x3b\xc2\x8a\x12\x25\xa8\x1d\x95\x90\x13\x89\x21\x04\x39\x00\x65\x51\xe4\x5c\xf9\x80\x32\x17\xb4\x56\x94\x33\x6b\xe8\x73\xc9\xf3\x2f\x39\xaf\x2a\x64\xea\x74\x19\x4b\x89\x33\x09\xd2\xee\xee\x9a\x8a\xb0\x49\xb1\x2c\x50\xa2\x8b\x65\xa4\x0e\x35\xce\x40\x5d\x2a\xd1\xe4\xca\x94\x3e\x54\xd7\x08\xc0\x2b\xad\x46\x71\x14\xbd\xae\xac\x53\xf7\x4d\xe2\xce\xc5\x17\x01\x5c\x2c\x07\xbd\xd6\x46\x38\xd0\xec\x23\x7f\xd2\xf1\xf4\x52\xfe\x8e\x49\xc5\x23\x00\x57\x5b\xf0\x4e\x18\xe3\xca\x43\xc1\xf7\x44\xa2\xd6\x96\x1e\x2f\xac\x99\x42\xb1\x21\x39\xfa\xc7\xf0\x86\x57\x75\x89\xbf\xdd\x7d\xfe\x37\xe6\xea\x78\x87\x05\x54\x0a\x5d\x77\x71\x04\xc2\x59\x41\x1d\x8d\xfb\x3c\x04\xa5\xf7\x96\x52\xff\xf2\x8e\xb0\xad\xa4\x1f\x6e\x17\xac\x56\xb4\x5c\x82\xf9\x73\x8b\x4a\xc3\x11\xc1\x16\xcf\x1c\x49\xd8\x70\x61\xbe\x85\xd0\x02\x7d\xef\xb4\x0d\x4e\x37\xb2\xec\x01\x73\xa4\xcf\x28\x7a\x91\x70\xdb\x48\x8d\xc5\x24\xd5\xe0\xf0\x5b\x48\x40\x43\xe6\x61\x29\xea\xa2\x31\x9a\xe8\x2b\xac\xae\x84\xe0\x22\x49\x35\x82\x29\xdb\x42\x1b\xfd\xc9\x19\xde\x54\x2a\x7b\xb4\xed\x22\x89\x7f\x6e\x5b\x68\xea\x1a\x05\x64\x3f\xa2\xda\xf1\xa2\x47\xd1\x3d\x51\x3b\xe8\xba\x5f\x7e\x7e\x5b\xfc\xd2\x43\x67\x38\x39\x13\xc0\xb9\x72\x34\xec\x0b\xe3\x7b\x06\xa8\xed\xc2\x6c\x9f\x81\xb7\x7f\x7d\x1e\x16\xe3\x45\xf0\x54\xbd\x90\x9a\xd1\xa6\x16\x34\xdb\xce\x34\xb6\x05\xd4\x02\x95\x3a\xdc\xeb\x88\x13\x9e\x39\xcc\xa7\xa3\x8b\x69\xf4\x75\x19\x16\x48\x8a\x07\x07\x8b\xa4\xc7\x07\x88\x86\x29\x5a\x61\x76\x63\x46\x6e\xbf\xbe\x80\x9c\x33\xd9\x54\x28\x46\x01\xf7\x61\xa1\x81\x57\x11\x25\x75\xa9\x74\x71\x1e\x70\x4b\xa5\x12\x87\xb4\xcf\xa5\x45\xf6\x49\x27\x89\x00\x96\xcb\x01\x98\x7d\x1b\x6d\x5b\xd7\x76\xcd\x2e\x9d\x8a\x1b\xce\x9e\x51\xe8\xa9\x67\xb2\x93\x93\x0a\x27\x91\x2c\xb4\x1d\xb8\xba\x06\x9b\xce\x51\x78\x08\x2a\xfb\x88\xca\xda\x4d\x62\xaf\xfa\x71\x9a\x46\x60\xca\x27\x04\xfc\xf9\x1a\x18\x2d\xc1\xce\x40\x07\x35\xe3\xbf\xcc\xd6\xec\x99\x94\xb4\xd0\x27\x38\xf1\xb0\xb5\x80\xd8\xfa\x1c\x2f\x20\x9e\x74\xae\x78\x01\xaf\x32\xed\xce\xfc\x09\x58\xc2\xcd\xd1\x04\x78\x12\xbd\x6b\x1b\x1a\x50\x3a\x59\x6b\x79\xd3\x48\xc5\xab\x1f\x4c\x4d\x6c\x1e\xac\xc8\x7c\xde\x5c\xfd\xb2\x7b\x22\xa4\x89\x70\x18\xc6\xbf\xc6\x90\x3d\xee\xc9\x76\x8b\xc2\x2a\x34\xdb\xfe\x68\x69\xbd\x48\x42\xe9\xc9\x92\x8b\x89\x75\xa3\xda\xa5\x3a\x7c\xc6\xe7\xf4\xbf\xe8\xb4\x51\x7c\x3a\x04\x82\x33\xef\x98\xe8\xf4\x23\xee\xf4\x40\xd5\x6e\x3a\x12\xa9\x7b\xb7\x1d\x77\xa0\xb9\x42\x04\xfd\xda\xe4\xe8\xfc\xc8\x0b\x2c\xe5\x3d\xc9\xbf\x90\xad\x89\xe7\x27\x56\x11\x21\x77\xa4\x6c\x5b\xd3\x86\xeb\x7e\xed\x68\xaa\x9d\xec\x3c\xf6\xf1\xbd\x10\xe4\xd0\x75\x8f\x25\xcd\x71\x08\x6f\x3c\x9d\xdf\xf3\xe2\x90\xa4\x63\x8b\x79\x19\x3e\x67\x8a\xdc\x73\x82\xeb\x3e\xc6\xa3\x03\x32\x33\xed\xbb\x97\xf5\x31\xdc\x27\xa1\x91\x9e\x1e\x51\x26\xba\x81\x30\x0b\x99\x2d\xd1\x18\xef\xd5\xf5\x90\x85\xbe\xc1\x9e\xe6\x69\xb4\x91\x70\x31\x1b\x51\x88\x91\xbc\xb3\x7e\x86\xe1\xdb\x4f\x96\xef\xfc\xcc\xbf\x7b\xd7\xff\x45\x79\xb6\xba\xfb\xc1\x95\x42\xf7\x79\x33\x91\x4a\x96\xc4\xef\x1f\x56\xf0\xcf\xbb\x9f\x60\xf5\xf7\xd5\x2d\x7c\x5c\x3d\x3d\xad\x6f\x3f\xc2\xd3\xa7\xf5\xa3\x41\x76\xb8\x6e\x47\xf4\x7b\xe4\x15\x8c\x96\x3e\x77\x18\x19\x0f\x43\x41\x14\x16\xf0\xf9\x00\x5b\x7e\x29\x6d\x57\xfa\x0e\x3e\xdc\xc1\xed\xdd\x13\xac\x3e\xac\x9f\xb2\x68\x20\xdf\x37\xbc\x3e\x08\xba\xdd\x29\xb8\x34\x3a\xf4\x01\xef\x99\xe9\x64\xcd\x27\x29\xb5\x03\xb0\x6d\x46\x3d\x98\x0d\xeb\x7a\xd2\xd4\x7f\x43\x4b\x84\x3d\x91\x53\x67\x0c\x0d\xb3\xde\x80\xe2\xbc\xcc\xb4\xfc\xaa\xa0\x4a\xd3\x16\x35\xec\xab\x8c\xc5\x5a\xf0\x67\x84\x4d\xa3\x8c\xaa\x1d\x32\x38\xf0\x06\x04\x5e\x8a\x86\x4d\x34\xf5\x26\x8c\xdb\x84\x15\x51\x14\xd1\xaa\xe6\x42\x41\x12\x01\xc4\x94\xc7\xfa\x3f\x86\x6a\xb9\x53\xaa\x8e\x35\x6d\x8f\xb7\x54\xed\x9a\xcf\x59\xce\xab\xe5\x96\x5f\xf2\x1a\x19\xa9\xe9\xd2\xcd\xeb\x78\x5e\x42\xdb\x3c\xb3\x6c\xdb\xf5\x19\x01\xd3\xc6\x89\x3a\x67\x62\x70\x22\x02\x47\x13\x66\x9d\x31\xab\x71\x34\x21\x0d\xee\x3e\xb8\x36\x19\x70\xb7\x90\xc9\x90\x0a\x75\x4e\xbb\xf7\xcd\x17\x3c\x2c\xe0\x8d\xb9\x9d\xe9\xe3\x95\x4d\x94\xe8\x55\xc7\x03\x7d\x7d\x4e\xfc\x48\x6b\x6a\xa0\x10\xec\xf2\x0f\x96\xbc\x50\x09\x04\xdc\x6f\x8f\x8e\xcf\x5e\xcc\x1a\x81\xd9\x99\xeb\x9b\xd3\xe4\x5d\xe2\x66\xa8\xd6\x78\xd7\xb6\x67\x9d\xb2\x6d\xcf\xdc\x6c\x10\xee\xd9\x20\xf0\x6e\x10\x59\x80\x3f\x78\x64\xd0\x30\x43\x1d\x89\x44\xf1\xac\x19\x5f\xff\x9d\x32\xc5\x4d\x4c\xc2\xb6\x8e\x22\xd8\x31\x7f\x37\x15\xb5\x61\xa6\x13\x1f\xfe\x07\x42\x9a\x42\x32\xcc\xbb\xd6\xb2\x1c\x2e\x52\xef\x0e\x9c\xf5\x4a\x64\xd7\xc9\x3d\x55\xf9\x6e\x1c\xd1\xee\xb2\xd3\x86\xaf\xc1\xc3\x46\x9b\x55\xb0\xaf\x01\x1e\x8d\xbf\x32\x5f\x75\x33\x93\x4d\xa9\x34\xde\x5e\x78\x4b\x7a\xc5\x0b\x86\x86\xe9\x49\x92\xf7\x73\xaf\x3f\xce\x81\x71\x9e\x58\x57\xb2\x20\xdb\x1f\xb3\x68\xa6\xca\xa9\x19\x87\xb7\xe9\x4c\x68\x9d\x0d\xbf\x67\x2f\x5c\x7b\xd7\xff\xba\x68\xb2\xea\x02\x5b\xcb\xc7\x26\xcf\x51\xea\xdc\x59\x9f\x16\x7a\x63\xff\xf2\x61\x74\xd8\xef\x3e\x17\xf2\x5f\xc3\x5c\x17\xf0\x90\xdd\xb6\xf6\x29\x26\xb0\xe4\xbd\x65\xbd\xf1\x0b\xee\x5e\x6d\xfa\x42\x0d\x89\x7b\x45\xc5\x8e\x40\xf2\xea\x02\xce\xe4\xf6\xff\xa1\x84\x74\x73\x72\x34\x96\xf0\xed\x37\xdf\xc0\xf5\x35\xfc\xed\x54\x8b\x57\xd7\x30\x16\x26\x55\x1e\x02\xb7\x08\xf8\xfd\x15\xf3\x54\xba\x1e\x70\x8b\xfb\xf7\xf7\x6b\xfb\x76\x10\x4f\xae\xf4\xde\xe5\x61\x71\x1c\x53\x1a\xe0\xdc\x9e\x03\xdd\xa4\x59\xce\x74\x83\xb6\x05\x85\x55\x5d\xea\x99\x7d\xf2\x5e\x9d\x39\x09\xa7\x65\x16\xcf\x2f\x68\x09\x6f\xe8\xf9\xcb\xe8\xd8\xea\x37\x25\x88\xc5\xa1\xf1\x2d\xf4\xae\xe9\xa6\xde\x68\xad\xe0\xb9\x7d\x6b\x71\xee\x3a\x26\x72\x55\x69\x72\x0f\xde\x7d\x25\xba\x58\x4e\xfd\x94\xc6\xd2\x49\x94\xff\x0d\x00\x00\xff\xff\x9f\x76\x54\xc6\xc0\x17\x00\x00")
+var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\xcf\x72\xdb\x38\xd2\x3f\x7f\x7c\x8a\xfe\xb8\x49\x8a\xf4\xca\x54\x26\x95\xec\xc1\x53\x3a\xcc\x38\x9e\xc4\x87\x49\x5c\x76\xb6\xf6\x30\x35\xb5\x05\x93\x2d\x09\x1b\x12\xe0\x00\xa0\x14\x2d\x0b\xef\xbe\x05\x10\x24\x41\x09\x92\x1d\x27\x7b\xd8\x39\x89\x22\x1a\xfd\xe7\xd7\x8d\xee\x46\xb3\x6d\xa1\xc0\x25\x65\x08\x71\x5e\x52\x64\x4a\xa0\xac\x39\x93\x18\x83\xd6\xf3\x39\x7c\xc0\x6d\xdb\x42\x4d\x64\x4e\x4a\xfa\x6f\x84\xec\x03\xa9\x10\xb4\x86\x5c\x20\x51\x28\x81\x40\x78\x7d\x4b\xd5\xda\xb0\x26\x4d\xa9\x60\x8d\xa4\x40\x21\x61\x43\xca\x06\x65\xb4\x6c\x58\x7e\x94\x73\xd2\xb6\x40\x97\x80\x7f\x40\x76\xc9\x0b\x84\xf3\x1f\x40\xeb\xdc\x3c\x51\xa6\xda\x16\x90\x15\xa0\x75\x47\x94\xdd\xe5\x6b\xac\xc8\xf0\x9f\xb0\x02\x12\x6f\x67\xda\x53\x64\xd7\xf2\x4e\x09\x24\x15\x68\x3d\x83\xb6\x45\x56\xec\xf1\xf0\x29\xb6\x82\x2a\x14\x40\x79\xf6\x0f\xfb\xe4\x4b\xed\xc4\xa7\x70\x16\x36\xbb\x8d\x00\x04\xaa\x46\x30\x78\x11\xa4\x30\x04\x00\x21\x1b\xff\x29\x15\x51\x8d\x34\xaa\x5f\x80\x31\x78\xd6\x93\x0e\xc2\x05\x61\x2b\x84\xec\xbd\x83\x73\x30\xe1\x3d\x91\x6f\x1d\xd4\x5a\x07\xc5\x5e\x18\x3e\xb5\xa0\x4c\x2d\x21\x7e\xfe\x97\x4d\x0c\xd9\xb8\xe3\x50\xd0\x29\x90\x03\x80\xdd\x90\x5d\xc9\x49\x71\x01\x1d\x72\xc7\xf8\xe9\x48\x47\xd1\x3c\x80\x9c\xd6\xb0\x26\xac\x28\x51\x82\x5a\x53\x09\x39\x91\x18\x8a\x20\x17\x40\x59\x14\x39\x55\xde\xa2\xcc\x05\xad\x15\xe5\xac\x13\x74\x5f\xf2\xfc\x73\xce\xab\x0a\x99\x3a\x5c\xc6\x52\xe2\x11\x80\x0c\x3e\xeb\xa6\x22\xcc\x7f\xe9\x02\x25\x3a\x9b\x47\x6a\x57\xe3\x91\x50\x97\x4a\x34\xb9\x82\x36\x0a\xfb\x35\x02\xf0\x5c\x0b\x94\xa9\x28\x7a\x9c\x5b\xa7\xea\xcf\xcf\x1e\xb0\x2f\x02\x38\x9b\x0f\x7c\x23\x38\xa2\x6e\xdb\x42\xf6\x8e\x7f\x32\xf6\xf4\x54\xfe\x8e\x89\xc7\x23\x00\xe7\x5b\xb7\x64\x4f\x18\xe3\xca\x8b\x82\x9f\x89\x44\xc3\x2d\xdd\x5f\xb8\x66\x0a\xc5\x92\xe4\xe8\x1f\xc3\x4b\x5e\xd5\x25\x7e\xf9\x78\xff\x2f\xcc\xd5\xfe\x8e\x2e\xa0\x52\xd0\xfa\x6c\xd0\xaa\x93\x7b\x94\xb0\x6d\x87\xd7\x83\x51\x66\x6f\x29\x8d\x79\xde\x11\xee\x3c\xe9\x9b\x6b\x82\x71\x0e\xd6\x51\x2b\x54\x26\xf4\x10\x3a\x47\xd9\xe3\x07\x4b\x2e\xec\xbb\x50\x64\x40\x9f\x27\xbb\x64\x66\x92\x56\x76\x8b\x39\xd2\x0d\x8a\x9e\x24\x9c\x22\x52\x2b\x31\x49\x4d\x20\xf8\xe9\x22\x14\x3a\x01\xae\x99\x17\x4b\xa3\x9d\x86\xd0\x6e\xd3\x7a\xdf\xbe\x6b\x79\xd7\xe4\x39\x4a\xe9\x04\x49\x50\xa2\x41\xd8\xae\x91\x75\x87\xed\xa4\x79\xb0\x26\x26\xc7\xbf\xfa\xf2\xc5\x87\xe6\x09\x46\x0f\x6a\x24\x29\xdc\x73\x5e\x7e\xa3\xe9\xf3\x1f\x5e\xbe\x84\xc5\x02\x5e\x4d\x31\x18\x8d\x0d\x01\x71\x69\x2b\xdc\x95\x10\x5c\x7c\x0b\x18\xaf\xbf\x03\x18\x9e\x2a\xdf\x17\x90\xd7\x13\x40\xec\x71\x35\xb9\xc5\x90\xc0\xeb\x97\x2f\x53\x48\x4a\xe5\xfe\xbe\x31\x7f\x83\x01\x83\x62\x83\xe2\x9b\x71\x7a\xf3\x3d\x82\x66\x54\xe5\xfb\xe2\xf4\xe6\x04\x4e\x6f\xa6\x38\xfd\xed\x18\x4e\x76\xf5\xc9\x00\x99\xd4\xb2\xa2\x1b\x64\xdf\x1e\x4c\x26\xa1\xf4\x2d\x52\x10\xa5\x03\x44\x5c\x0e\x5a\x2c\x6c\xaa\x8b\x74\x74\x90\xf9\xe7\x73\x78\x87\xaa\xcf\xfe\x43\x82\x2c\xd0\x6c\x28\xa0\x76\x0b\x7c\xf9\x5f\xc9\x91\xa3\xe8\x24\xfd\x73\x94\x9e\x87\x3c\xe2\xcc\xed\x5c\xe1\x02\xed\x09\xc0\xf5\x47\x45\x2a\x41\xd9\x0a\xda\xe8\xff\x9c\xcc\x65\xa5\xb2\xbb\xae\x03\x4c\xe2\xdf\xda\x16\x9a\xba\x46\x01\xd9\xaf\xa8\xd6\xbc\xe8\x1b\x83\x1b\xa2\xd6\xa0\xf5\xef\xbf\x3d\x2f\x7e\x77\xb8\xf7\xbc\xdb\x76\x78\x84\xd1\xcc\x86\x7d\x66\x7c\xcb\x00\x8d\xdc\xb1\xb8\xee\x87\x13\x3c\xff\xeb\x66\x58\x8c\x67\x4f\x39\xb9\xa7\xab\xdd\xbe\xc0\x19\xd4\x02\x95\xda\xdd\x18\x8b\x93\x13\x80\xa7\xa3\xd2\xa9\xe9\x4f\x9f\x80\xb9\x40\x52\xdc\xba\x58\x4f\x86\x33\x2e\x1a\xa6\x68\x85\x59\x97\xea\xfb\xf5\x19\xe4\x9c\xc9\xa6\x42\x31\x12\xb8\x17\x33\xd3\x71\x54\x44\x49\x90\x4a\x18\x77\xdd\xe2\x8a\x4a\x25\x76\x69\x8f\x6e\xd7\xa1\x1d\xb4\x8b\x11\xc0\x7c\xee\x25\x17\xbb\x00\x6d\xeb\x7a\x6b\xbb\xcb\x38\xf2\x92\xb3\x0d\x0a\x73\xb5\xb1\x78\xe5\xa4\xc2\x89\x25\x33\x23\x07\x2e\x16\x46\xc8\x84\x78\x30\x2a\x7b\x87\xaa\x6b\x53\x93\xd8\x8b\x87\x38\x4d\x23\x30\xf8\x9b\xfd\xff\xbf\x00\x46\xbb\x34\x3d\x04\xbc\xd5\x5f\x66\xd7\x6c\x43\x4a\x5a\x98\x23\x9b\x78\xd1\x36\x83\xb8\xeb\xef\xe3\x19\xc4\x93\xf6\x34\x9e\xc1\xa3\x44\xbb\xc6\xee\xc0\xc7\x41\x87\xc1\x02\x42\xd6\x77\x2c\x6c\x58\x1b\xb0\xae\xe5\x65\x23\x15\xaf\x7e\xb1\x3e\xe9\x40\x8b\x20\xb8\x73\xc0\xcd\xf9\x2f\xbb\x21\x42\x62\xe2\xdf\xb8\xfe\x88\x21\xbb\xdb\x92\xd5\x0a\x45\xc7\xd0\x6e\xfb\xb3\xc1\x7a\x96\x84\xe0\xc9\x92\xb3\x89\xf4\x34\x1d\xa1\xd6\xfa\x6b\xf8\x3f\xa8\xb4\x65\x7c\xd8\xe9\x07\x2f\x36\x93\x57\x5e\x31\x39\x3c\x50\x7d\xad\x23\xd2\x14\xd8\xae\xb0\x80\xb9\x10\x46\xd0\xaf\x4d\x8e\xce\xaf\xbc\xc0\x52\xde\x90\xfc\x33\x59\x19\x83\xb3\xbf\xb3\x8a\x08\xb9\x26\x65\xdb\x9a\xb3\x4d\xeb\x7e\xad\x97\xee\xb0\x39\xd8\xb9\xaf\xe3\x4f\x42\x90\x9d\xd6\x77\x25\xcd\x71\x30\x6f\x3c\x9d\x3f\xf3\x62\x97\xa4\x63\x8a\x79\x38\x7c\x4e\x38\xd9\xe5\x47\x58\xf4\x36\x8e\x5e\x9b\x2a\x35\xad\xab\xfa\x61\x7e\x0c\xb7\x49\xa8\x78\xf6\x71\xe1\x25\xf4\x70\xbd\x3f\xea\xa2\xd1\xde\x8b\xc5\x80\x42\x9f\x60\x0f\x71\x1a\x65\x24\x5c\x1c\xb5\x28\x54\xfb\xcd\x70\xa7\x1f\x22\x1d\xb3\x34\xfd\xd1\x47\xfe\xc5\x8b\xfe\x1f\xe5\xd9\xd5\xc7\x5f\x4e\xb8\x62\x00\x60\x08\x5f\x47\xc5\x68\xe9\xf7\x07\xe3\xed\x95\xa1\x20\x0a\x0b\xb8\xdf\xc1\x8a\x9f\xcb\x2e\xd1\xfc\x08\x6f\x3f\xc2\x87\x8f\x9f\xe0\xea\xed\xf5\xa7\x2c\x1a\x86\x26\x97\xbc\xde\x09\xba\x5a\x2b\x38\xb7\x3c\xcc\x99\xed\x27\x0a\x93\xb5\x51\x83\x28\xaa\x5d\x4c\x1a\xbf\x8d\xf1\x69\x6f\xd0\x9f\xcc\xc5\x69\x49\x4b\x84\x2d\x91\x53\x65\x4c\x6b\xe8\xb4\x01\xc5\x79\x99\x19\xfa\xab\x82\x2a\xd3\x9b\xa8\x61\x5f\x65\xb5\xa9\x05\xdf\x20\x2c\x1b\x65\x5e\xd9\x2b\xd9\x8e\x37\x20\xf0\x5c\x34\x6c\xc2\xa9\x17\x61\xd5\x26\xac\x88\xa2\x88\x56\x35\x17\x0a\x92\x08\x20\xa6\x3c\x36\x3f\x0c\xd5\x7c\xad\x54\x1d\x9b\x71\x4b\xbc\xa2\x6a\xdd\xdc\x67\x39\xaf\xe6\x2b\x7e\xce\x6b\x64\xa4\xa6\x73\x57\x82\xe3\xe3\x14\x46\xe6\x89\xe5\x2e\x03\x9f\x20\xb0\x05\x8f\x28\x8c\x1f\xa1\x44\x04\xae\xf2\x1f\xa3\xec\x56\xe3\x68\xd2\x07\xb8\x39\xde\xb5\x45\xc0\x5d\x7c\x27\x75\xa7\x3f\x91\x43\x34\x0d\x7b\x9f\x7d\xc6\xdd\x0c\x9e\xd9\xa9\x9a\xa9\xfb\xd9\x84\x89\x59\x75\xcd\x9e\xcf\xcf\x91\xef\x71\x4d\x6d\x28\x04\x13\xf7\xad\x2d\x2f\x40\xcd\xdd\xd9\x3d\x7b\xa3\x95\x40\xa2\xef\x06\x6a\x8d\xc0\xec\xc4\xd8\xcd\x71\xf2\x86\x6f\x47\xba\x27\x17\xf5\xef\x89\x3b\xbe\x94\xad\xfa\x66\xcc\x84\x36\xb8\xa1\x25\x04\xe6\xbd\xee\xa6\x77\xeb\xf5\x77\xb6\xd9\x33\x96\x48\x7b\x33\x1d\x33\x10\x65\x8a\xdb\x28\x15\x5d\x36\x28\x82\x49\xf0\xab\xbb\x4b\x23\x1b\x45\x3a\xd1\x61\x48\x64\x5f\xdf\x63\xa6\x90\x0c\x25\xac\xd5\x33\x93\x92\xb8\x48\xfb\xce\xd2\xa4\xf5\x9e\x89\xd4\x5a\x6e\xa9\xca\xd7\x83\x89\xfd\xa5\xb1\xdd\xcb\x52\x2e\x0e\x87\x8d\x06\x38\x53\x65\xec\x14\xd7\xeb\xd5\x2f\x6c\xc2\x33\xd5\x47\x9a\x91\xee\xc5\xe2\xa1\x6f\x00\x2e\xf1\x9e\x9a\x3c\x9b\x30\x3d\x00\x79\x3b\xf5\xe2\xf0\x90\x3a\x05\xc6\x12\xd1\xa9\x92\x05\x1b\xf8\x11\xc5\x19\x04\xc5\xb8\x78\x9b\xa6\xf9\xd6\xc9\x18\x32\x3b\xa3\xa5\x85\xd9\xbd\xd7\xd1\x64\xd5\x19\xe6\xcf\xad\x3a\x9d\x66\xa6\xdb\xeb\x27\xd6\x96\x47\xf7\xde\x6f\x6f\xfc\xaf\x18\x2e\x0b\xf4\x56\x74\x66\xdb\x11\x7a\x60\xa9\x9f\xcf\xd3\x25\x3c\x1b\xfd\xa6\xb5\x9b\xb6\xf7\x8e\x1a\x80\x7b\x84\xc7\xf6\x82\xe4\xd1\x0e\x9c\xc1\xff\xac\x0b\xe9\xf2\xe0\x68\xcc\xc1\x8d\x97\x5e\x1d\x72\xf1\xfc\xba\xc7\xc8\x17\xd3\x7b\x79\x30\xbc\x8b\x80\xaf\xf7\x98\xc7\xd2\xe5\x80\x0f\xb8\xfd\xe9\xe6\xba\x1b\x10\xc4\x93\x7b\xbb\x77\x1f\xf0\x6e\x06\x9d\x4d\xe9\xc0\x33\x98\x23\xbc\x36\x45\x47\xd1\x91\x6c\xd0\xb6\xa0\xb0\xaa\x4b\xa2\x02\xdf\x19\x33\x47\xe1\xb8\x1c\x8d\xe7\x07\xb8\x84\x37\x38\xa6\x9e\x62\x57\x5f\x94\x20\x5d\x22\xb1\xba\x85\xbe\x47\xb9\xaa\x37\x4a\x2b\x78\x6e\xfa\x76\xb6\x72\xea\xba\x4e\xe4\xa2\x32\xfd\x3a\x78\x57\x10\xf3\xa9\x68\xb2\x53\x5a\x49\x07\x56\xfe\x67\x00\x74\x88\x44\xff\x78\x1d\x00\x00")
 
 func templatesClientResponseGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -191,12 +263,72 @@ func templatesClientResponseGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 6080, mode: os.FileMode(420), modTime: time.Unix(1518033948, 0)}
+	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 7544, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesDocstringGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x31\x0a\xc3\x30\x0c\x45\x77\x9f\xe2\x93\xbd\xf1\x25\x3a\x77\xea\x05\x82\xad\xb4\x82\x58\x2e\xb1\xba\x54\xe8\xee\x25\x0d\x94\x24\x64\xfb\x88\xf7\x9e\xcc\x32\x8d\x2c\x84\x2e\xd7\xd4\x74\x66\x79\x74\xee\x01\x30\xbb\x80\x47\xf4\x77\xd6\x89\xe0\x6e\x86\x54\x4b\x21\xd1\xed\x6d\x65\xae\xd4\xd2\xcc\x2f\xe5\x2a\x70\x0f\x31\x86\x18\xb1\x15\x0e\xc0\xe2\x91\xe4\xff\x9c\x1a\x1d\x3b\xfb\x87\x67\xfe\x22\xfd\xa8\xe7\xbb\x0c\xc2\x1f\x42\x7f\x1b\x0a\x9d\xf5\xd7\xf9\x0d\x00\x00\xff\xff\xae\xbb\x3b\x77\xeb\x00\x00\x00")
+var _templatesClientSigningGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\xdd\x73\x1b\xb9\x91\x7f\xe7\x5f\xd1\x9e\x2b\x49\x33\xab\xe1\x50\x96\x65\x55\x42\x87\x57\xa5\x48\xf6\xd9\x97\x5d\xaf\xcf\xd4\x66\xb7\xe2\xb8\x1c\x68\x06\x24\x71\x9a\x01\x68\x00\x14\x4d\x6b\x79\x7f\xfb\x55\x03\x8d\xf9\x22\x25\x3b\xf7\x90\x87\xab\x52\x95\x88\xaf\x46\x7f\xe3\xd7\xc0\x8c\x46\x70\xa9\x0a\x0e\x73\x2e\xb9\x66\x96\x17\x70\xb3\x81\xb9\x1a\x9a\x35\x9b\xcf\xb9\x7e\x01\x57\x3f\xc3\xdb\x9f\xaf\xe1\xe5\xd5\x9b\xeb\x6c\x30\x18\xdc\xdf\x83\x98\x41\x76\xa9\x96\x1b\x2d\xe6\x0b\x0b\xc3\xed\x76\x34\x82\xfb\x7b\xc8\x55\x55\x71\x69\x7b\x63\xf7\xf7\xc0\x65\x01\xdb\xed\x60\x30\x58\xb2\xfc\x96\xcd\x39\x4e\xce\xde\xd1\x6f\x1c\x18\x8d\xe0\x7a\x21\x0c\xcc\x44\xc9\x61\xcd\x4c\x97\x19\xbb\xe0\x40\xdc\x80\x55\xaa\xcc\x06\xa3\x11\xbc\x2c\x84\x15\x72\x0e\xb6\x5e\x57\x39\x6e\x96\x5a\xdd\x71\x98\xad\xac\x23\xb5\xe0\x12\x36\x6a\x05\x9a\x0f\xf5\x4a\x76\x28\x85\x2d\x1c\xdb\x4c\x16\x83\x81\xa8\x96\x4a\x5b\x88\x07\x00\xd1\xcd\xc6\x72\x13\xe1\xaf\x5c\x6f\x96\x56\x8d\x16\x15\xcb\xdb\x6d\xb3\x60\xa7\xcf\xcf\x7b\x3d\xcf\x9f\x9e\xba\x1e\x2e\x73\x55\x08\x39\x1f\xdd\x30\xc3\xcf\xcf\xba\x7d\x0b\xfe\xc5\x75\xcc\x2a\xeb\xfe\x2f\x98\x59\xb8\x1f\x42\xd1\xbf\x91\x50\xc8\xbf\x6b\x49\x6e\xc3\xff\xd1\xc2\xda\x65\xdd\x58\xe9\xd2\xfd\x36\x4a\xfb\x19\xc6\xea\x5c\xc9\xbb\xf0\x5b\xc8\xb9\x17\xc0\x6c\x64\x5e\xff\x18\x31\xab\x2a\xe1\xdb\x56\x54\x3c\x1a\xe0\xaf\xb9\xb0\x8b\xd5\x4d\x96\xab\x6a\x34\x57\x43\xb5\xe4\x92\x2d\xc5\x48\xaf\xa4\x9f\x02\x60\xac\x9e\x55\xf6\xa1\x89\x7e\x34\x1a\x24\xce\x92\x46\xcc\x25\xd7\xaf\x39\x2b\xb8\x86\x9c\x69\x2d\xb8\xf1\x9a\x77\x03\xa0\x66\xc0\x40\xf3\xcf\x2b\x6e\x2c\xcc\xb4\xaa\x40\x58\x03\x6c\x65\x17\x20\xe4\x4c\x81\x55\x6e\xf6\x54\xcc\x25\x1a\x58\x48\xcb\x75\xce\x97\x56\xe9\x14\xd6\x0b\x91\x2f\x40\xf3\x4a\xdd\x71\x03\xc2\x0e\x72\x25\x8d\xed\x6e\x39\x81\xe8\xb7\xe1\xd4\x5b\x79\x88\x54\xb8\x8e\x1c\x63\xef\xfd\x9e\xbe\xcb\xad\xf1\x7c\x11\x2f\x06\x39\xc3\x76\xce\xca\x12\x89\x83\xf0\xe3\x0d\x6b\x6a\x86\xbe\x87\xb4\xae\x49\x1e\x66\x57\x9a\x43\xae\xee\xb8\xee\x10\x83\x9b\x95\x28\x6d\xf0\x5e\xab\x99\x34\xe8\x5c\x29\x18\xd5\xa7\x29\xcb\x0d\x48\x56\x75\x94\x84\x5b\x30\x59\x74\x28\x0a\xe3\x07\xeb\x98\xd8\xa3\xa1\xb1\x1b\xc8\x4b\xc1\xa5\x35\x90\x6b\x1e\x62\xe8\x2d\x5f\xbf\xbe\xbe\x7e\x77\xe9\x46\x7e\x15\x76\x71\xa9\xe4\x4c\xcc\x71\x1f\xa5\x61\x2d\xec\x02\x58\xc3\x26\x2c\x99\x31\xbc\x40\x53\xbc\x09\xc4\xdd\xe6\x48\x5e\xe8\xc0\x92\xc9\x06\x76\xb3\xe4\x3d\xc5\x3a\x76\x66\x2c\xe7\x70\x3f\x00\x20\x27\xca\xfc\xce\x17\x2b\xbb\x78\x23\x67\xea\x57\x2d\x2c\xd7\x03\x80\xd1\xc8\x89\x01\xac\x28\x1a\xf9\xbd\x52\xad\x6a\xfc\x24\x85\x1b\x55\x6c\x82\x41\x72\x25\x2d\x26\x1a\xb2\x17\xcd\x19\x80\x23\x15\x6b\xfe\x19\x7e\xc0\x50\xc9\xde\x77\x16\x7f\xf8\x88\x41\x9d\x00\xd7\x5a\xe9\xc1\x76\x30\xb8\x63\xda\x05\x3b\x6e\x89\xe6\x03\x00\x0c\x91\xec\x27\xb6\x1c\x00\x94\xac\x96\x68\x25\xa4\x3d\x3f\x23\xf7\xd6\x7c\x2e\x8c\xe5\x9a\xc6\x2a\x76\xcb\x0d\x30\xb2\x1b\xdc\x4a\xb5\x96\x8f\xba\x30\x9a\x55\x73\xbb\xd2\x12\x7d\xcc\x80\x28\xb2\xb6\x43\x21\x23\x4c\x73\xb8\xe5\x4b\x0b\x33\xa5\x9d\x84\xa5\x98\xf1\x20\xed\x52\xab\xb9\x66\x55\x0a\xa5\xb8\xe5\x1d\x73\xaf\x0c\x6e\x66\x17\xbc\xca\x06\xb3\x95\xcc\x7b\xac\xc6\xc4\x62\xc7\x5a\x09\x86\x36\x2e\x43\x5b\x89\x02\xc6\x13\xa0\x3c\x92\xbd\x52\xba\x62\xf6\x17\x21\x6d\xec\x93\x46\x76\x51\x14\xd8\x3c\x3f\x8b\x0f\x1b\xed\xa4\xf0\x34\x49\xe1\xe9\x49\xd2\x68\x32\x9b\x5a\xa5\x79\x2c\x8a\x94\x7a\x70\xcc\xcb\x0c\xa2\x40\xd5\x93\xd9\x71\x63\xb2\x69\x4b\x47\x6e\x11\x89\x52\xbb\x1a\xac\x17\xca\xb4\xe3\x46\xa0\xd6\x3b\xb2\xa4\xa8\x47\x61\x31\xa1\xa3\x49\x66\x96\x7b\xed\x29\xbb\x08\x6e\x89\x8e\xac\xb4\xa1\x28\x14\x1a\xf2\x05\x93\x73\x9c\xad\x49\xfd\x85\x57\x1d\x71\x17\x4b\xfe\xc5\x82\x77\x26\xb5\x92\xc5\xb5\x16\xcb\x25\xd7\xc9\x6e\x97\x73\x76\x31\x03\xb7\x60\x32\x01\x29\x4a\xd7\x05\xd4\xe3\x57\x5c\xf1\x19\x5b\x95\xf6\x3a\x04\xda\x00\x60\xdb\xe8\xa6\x4d\xef\xd5\x4a\xe6\x31\xb2\xb2\xeb\xcf\x09\xc4\xa1\x6d\x96\x4a\x1a\x9e\x7a\x9f\x4e\x68\x43\x6f\x46\xcd\x3f\x67\x3e\x09\x67\xff\xc1\x2d\x19\xdf\x77\xa0\x39\x00\x4f\x71\x51\xc0\x64\x02\x51\x44\x0b\x6b\x46\x90\xe5\x46\x3a\xe4\xc0\x2f\x41\x5e\x83\x95\x53\x50\xb7\xb8\x4f\xb0\xf9\x8f\x8a\x15\xb1\x28\x6a\xda\x4f\xd4\xed\x0e\x59\x51\xa6\x30\xab\x6c\xf6\x12\xd9\x9d\xc5\x91\x54\xc1\xbe\x44\xc6\x67\x22\x34\x9a\x28\xe0\xe0\x73\x94\x42\xa0\xb8\xc5\x83\xca\xe5\x0b\x06\x1a\x39\x03\x4b\x8a\xaf\x56\xc6\x82\x54\x16\x2a\x55\x88\xd9\xa6\xed\x35\x98\xc4\x1d\x9c\x10\x77\x5c\xba\xf5\x1a\x59\x96\x7c\x1d\x77\x14\xea\x86\x7e\xc0\xa3\xe3\x07\xcd\x3f\xbb\x96\x26\xe5\xc1\xc4\x85\xb8\x9f\xef\xbb\x52\x28\xb9\x8c\x1b\xfd\x26\xc7\xcf\x3c\x8f\x18\xae\x98\xc9\x53\xb8\x63\xe5\x8a\x1b\xdc\x4b\xa3\x7f\xb5\x8c\x51\xeb\x04\x7d\x85\x55\x1c\x9e\x04\x15\xf6\xc6\x1b\x16\x3e\x20\xcd\x8f\x30\x21\xaa\x34\xbe\x6d\x59\x04\x33\x59\x2b\xc7\x05\x13\xe0\xae\x7f\xc6\xee\x27\xde\x1f\x0f\x0f\x3b\x5d\x4e\xa4\xb7\xca\xcd\x08\xbb\x22\x25\xae\x35\x25\x49\xec\x01\x97\x3d\x53\xec\x81\x09\x78\x64\x92\xbd\xe7\xac\xb8\x28\xcb\x38\x90\x4b\x68\x6a\x68\x67\x97\xa5\x32\x3c\x0e\xdd\x62\xe6\x96\x13\x1b\x2d\x09\x5b\x7e\xc1\x75\xd8\x6f\x4b\xff\xb5\x23\xdd\x6c\xfa\x56\x2d\x1d\x59\x1d\x63\x22\x37\xd9\x5b\xbe\x46\x3e\xb8\x8e\x91\xc3\xa4\xe6\x01\xfd\x9d\x56\xba\x00\x4a\x20\x16\xca\xb1\xec\x97\xf7\xc2\xa5\xc5\xc8\x77\x6e\x94\xa2\x18\x3b\xcc\x5e\xfa\x53\xe9\x47\x2e\xe7\x76\x81\x5c\xbb\x4c\x89\xae\xd2\x62\x6f\x1b\x6c\x83\xfa\xa8\xa3\x27\x8b\xbb\x49\x39\xc3\xf4\x13\x6b\x7f\xea\x25\x2f\xf6\x29\x6f\x9f\xea\xb6\x83\xf6\x48\x2f\x86\x51\x3d\xdb\x84\x92\xef\xeb\x9f\x2e\x2e\xe9\x00\x43\xe7\x33\x21\x60\x4c\x80\x02\x86\xe7\x9a\x5b\x30\x0b\xa6\x79\xd1\x44\xa5\xe1\xfa\x0e\x15\x38\x53\x65\xa9\xd6\x21\x47\x23\xb2\x68\x0e\x6e\x03\x85\x66\x33\x8b\xc9\x38\x76\xbf\x86\x39\xbb\x63\x73\x3e\x44\x7f\x1b\x36\xd3\x92\x31\xd8\x87\x50\x54\x89\x47\x6c\x01\x0b\x67\x5e\x93\xc2\x7a\xc1\x35\x87\x98\xb8\x1c\x5a\xa6\xe7\xdc\x26\xb8\x85\xb1\x4c\x16\xa6\x3e\x29\x2b\x6e\x17\xaa\xa8\xb1\xd3\x92\xd9\x45\x0f\x26\x10\x66\x69\x6b\xc0\xea\x55\x6e\x9d\x37\xfc\x85\x6f\xde\x5c\x01\x9d\x89\x08\x28\xbc\x1a\xea\xb0\x1a\x8d\xe0\xa2\x9c\x2b\x2d\xec\xa2\x42\x34\x82\x55\xc1\xd0\x97\x02\xa9\xdb\xb0\xf0\x09\x3e\x05\xa5\xeb\xc1\xe7\x4f\x4f\x07\xd0\x5a\x57\x53\x47\x43\x78\x09\xdd\xf9\x13\xb4\xd1\x16\xfc\x66\x13\x48\xee\x48\x9f\xc2\x42\x21\x32\x2a\x98\xe5\x4e\xe0\x42\xcc\x9d\x7c\x2e\x4b\x5e\x40\x25\x8c\x03\x04\x57\x38\xae\x34\x5c\xb9\x61\x22\x8d\xcc\xb3\xa2\xf0\x18\xaf\xa3\x1d\xa8\x79\xfa\xf0\x71\x97\xd3\x70\x5c\x13\x15\x35\xeb\xda\x70\x0c\x88\xf0\x94\x16\x5f\x99\x15\x4a\xee\xea\x64\x1a\x66\xd6\xfb\x04\x65\x0f\x00\x94\xcc\xb9\x87\x60\x3f\xcb\x9c\xe3\x79\x5a\x40\x6d\x0c\xef\xb8\x48\x9e\x4b\x2b\x72\x66\x39\xc5\xcc\x0e\x78\xee\x19\xdc\x1f\xe8\xb1\x81\x1f\x1a\x9b\x27\xfb\x08\xc5\xba\x87\x56\xa9\x3f\x85\x4f\x54\x00\x65\xef\x1d\xa2\xd2\x1b\x42\x91\xce\x67\x4c\x86\x7c\x67\x57\x2a\xa6\x6c\x73\x0f\x26\xc3\x93\x75\x07\x7f\x25\xb0\x6d\x21\x21\x9d\x4d\xb9\xf5\x3a\x78\xc7\x34\xab\x3a\x47\x74\xea\x68\x84\x70\xfd\x1e\x88\xbc\x5f\xcc\xef\x47\xc4\x4e\x16\x56\x3b\x29\xe6\x26\xa7\x78\x93\x5d\xab\x1f\xd5\x9a\xeb\xd8\x64\xb5\x0f\xa3\x18\x78\x54\x48\xbe\x7e\xcd\xcc\x02\x48\x72\x2c\x65\x33\xec\x40\xb5\xac\x85\xcd\x17\x2d\x8a\x48\x3f\x67\x86\x43\x14\xa5\x10\xb5\x22\x27\x1a\xbb\xbc\x55\xcf\x4c\x6b\xb2\x93\xee\xbc\x14\xf3\xd1\xe9\xf3\x73\x4c\xc8\x35\xb1\x30\x01\x6b\xef\x31\x01\xae\xb0\xda\x07\x1f\x4d\x27\x37\x1c\xb7\x93\x64\x1b\x8c\xac\xa4\x59\x2d\x11\x94\xf1\xa2\xa5\xe2\x86\x7f\x07\x47\x7a\x3a\xc0\x8c\x4b\xb1\x8a\x87\xbd\xa1\x13\xdb\xa0\xef\xce\x1c\x4c\xa0\xd1\x04\x81\xd6\x09\x65\xef\xb0\x62\x52\x87\xd8\x7d\xd4\x8f\x6e\xa7\x23\x65\x2c\xfe\xc7\x08\x77\xff\x5d\x04\x47\xb8\xa9\x83\x43\xde\xf1\xc7\x84\x50\x02\xad\xb4\xb3\x2f\x5a\xaa\x14\x72\xef\xbc\x93\xdd\xa9\x98\x47\x45\xea\x42\xaa\x41\x2f\x34\x81\xb8\x77\x63\xbb\xde\x81\xdd\x49\x3d\xc1\x7c\x10\x88\x58\xf0\xa7\xeb\x23\x6f\xc0\x36\x91\xf1\xae\xb0\x23\xf6\x98\xce\x37\xcf\xf3\x04\xd8\x72\xc9\x65\x11\xbb\xa6\xe7\xeb\x38\x1a\x43\x74\xdc\xdf\x1e\x91\xc7\x4f\x2e\xf9\x27\xc7\x11\x44\xc7\xd8\xfe\xe5\xfd\x8f\xc1\xe3\x7f\x79\xff\x26\xae\x11\x42\xae\xa4\x15\x72\xc5\x5b\x7c\x60\x2a\xfd\xee\xbd\x89\xe7\xd7\x0a\x73\x06\xff\xfc\x18\x5d\x67\xba\x40\x57\xcc\xfa\xa8\x3c\xc2\xe4\x1c\x25\x3d\x14\x0e\xed\x69\xd3\x7a\x5a\x0a\x2e\x37\xbd\x55\xeb\x38\xc9\x7e\xb9\xbe\x8c\x13\xaa\xd1\x3c\x38\xbd\x16\x15\xf7\xed\x9a\xa1\x6d\x9b\x13\xef\x3c\x8f\xf1\xe2\x67\xec\x72\x63\x56\x15\x3a\x03\x05\xdf\x74\x55\x9d\x3e\x3f\xf7\x50\xe8\x61\x86\x3d\xad\x14\xa2\xe9\xeb\x8b\xe1\xe9\xf3\xf3\x49\x74\xec\x2f\xc3\xb2\xa9\x2d\x5e\xd2\x5d\x58\xe6\x7e\xf0\x6b\x35\x75\xd6\x8c\xcd\xaa\xfa\x30\xfe\x98\x24\x7b\x21\x2e\x22\xea\x50\x74\x34\xdb\x7d\x70\xb2\x5f\x32\xa9\xa4\xc8\x59\xe9\x3b\xff\xc2\x37\x31\xba\x4a\xf2\xf1\x91\x6a\xa4\x1d\xfb\x3b\x47\x2f\x1c\x18\x3c\xea\xc2\x19\xea\xae\xa9\x5a\x67\x4a\xe4\x5d\xb1\x8d\xe9\xbe\xe5\x34\x3e\x88\xfe\x8a\x10\x3e\x0e\xc2\x60\x50\x3b\x69\x5d\x38\x57\x2c\x47\xd9\x30\xa1\x61\xca\x8a\x29\x8f\xe1\x51\xe0\x71\x08\xce\xc4\x41\x77\x7b\x12\x7b\x50\x12\x87\x40\xf8\x4f\x25\x64\xd8\x35\xfa\xbb\x8c\x92\x24\x54\xe4\xfe\xac\x18\x4f\x5c\xe9\x35\x5d\x6a\x21\xed\x2c\xfe\xc7\x2d\xdf\xbc\x29\x26\xd1\x81\x89\xd2\x3a\xc7\xf9\x26\x85\xbb\x6f\xd4\x04\x5c\xf3\x1f\xa9\x93\xd8\x64\x0e\x28\xa5\x4d\x76\x4f\xeb\x7c\xe0\xf8\x40\xb1\x4d\x0a\x11\x44\x49\x0a\xdf\xb6\x3b\x0a\x35\x5d\x55\xb1\x14\xa5\x67\x9b\x8c\xd0\x4a\xa9\x3e\xa3\x52\xbf\x77\xd0\xdf\x7f\xaf\x37\x7d\xf9\x79\xc5\xca\x57\xaa\x2c\x28\x99\xa5\x10\x75\x80\x48\x14\xc0\x7e\x18\x6e\xf4\x32\xe9\x4f\x45\x9f\xad\x47\xa3\xe3\x7a\x66\x5d\xab\x77\x3c\x7d\x87\x60\xeb\x84\xc7\x2a\xa1\x3e\xc0\xff\x7a\xf6\x08\xe0\xbe\xf8\x75\x8a\xb7\x75\x05\x42\x1b\x56\x9a\x3e\xc0\xc6\xe1\x7a\x03\x40\x94\x2c\x94\x84\xb3\xfa\xe2\x08\xb3\x57\xda\xb9\x1a\x73\x18\x37\xa0\xe0\xdf\x86\x17\xd5\xd7\xe1\x0f\x24\x7c\x7d\xcf\x49\x2c\xb4\xee\x3e\x08\x1b\x77\xb8\x6d\xc0\xf1\x45\x9e\x73\x63\x08\x22\xc3\x0e\x4a\xae\x87\x9b\x01\xbc\xe6\xe1\xc6\x08\x25\xaf\xd5\x2d\x97\x01\x3c\x5a\xd7\x40\x2e\x38\x5e\xb9\x33\xbd\xe9\x0a\xcf\xab\xa5\xdd\xd4\x90\xde\x5f\xdf\x28\xc9\xf1\x54\xed\x90\x6b\xef\x83\xc8\x4c\x49\x87\x83\xa7\x5c\xdf\x09\x44\x92\xb9\x5a\x36\xa8\xda\xe9\xce\x55\x12\xee\x1d\x60\xe3\xc4\xf6\x3b\xd5\x4d\xcb\x90\xb1\x3a\xd8\x51\xad\x9e\x7a\x57\x63\x74\xf7\x76\xcf\x96\x62\x9b\xf1\x2f\x3c\x5f\x59\x3e\x64\x4b\x91\xdd\x23\xe2\x53\x72\x9b\xb1\x8a\x7d\x55\x92\xad\x0d\x5e\x95\x0f\x20\x70\xd7\x56\x19\xf1\xf8\x2f\x03\xbf\x2d\xa3\xfe\xbf\x46\xbf\x1d\x39\xff\x49\xf8\x8b\x06\xa7\xe3\xa5\x73\xc0\x3b\xb6\xd1\xb2\x29\x18\x32\x9c\x4b\x4d\xa8\x10\xd7\x99\x91\x3d\x07\x74\xb0\x62\x37\x1d\xa3\xbf\xff\x5e\xaf\x69\x9f\xab\xf8\x64\x86\xb6\xdb\x66\xf7\x34\xbc\x7d\xd8\x7d\xc0\x95\x7a\x01\x94\x91\x5b\xba\xad\x16\x29\x7c\x4a\xc3\x65\x82\xe4\x36\x9b\x2e\x4b\xe1\x18\x7f\xa7\xb4\x8d\x71\x2a\xdd\x1f\x74\xee\x24\x5b\x04\x27\xb0\x68\x9d\x62\x62\x06\x4b\xa6\xad\xa1\x8b\x60\x97\x5c\x1d\xc9\x38\x2c\x48\x21\xca\xa2\xe4\x85\x43\x8e\x6e\x6a\x02\xff\x3e\x81\x33\x38\x3c\xac\x17\xbc\x66\x66\xba\x9a\xcd\xc4\x97\xce\xa2\x8e\x50\x75\x42\xde\x55\x58\xe8\x0f\x3a\x87\x89\x67\xe9\x43\xb3\xe3\xf0\xd9\xc7\xde\xf5\x8b\x98\xed\xd5\x32\xfe\xd5\xfd\xbb\x64\xce\xba\x64\xb6\x83\x7d\xfc\x3c\x60\xc0\x07\x71\x04\x2d\x0e\xc9\x37\xac\x55\xb3\x9e\xfb\xe6\x4c\x1e\x59\xb8\xd9\x9b\x75\xe0\xc0\x44\xa9\xfb\xd5\x40\x0c\x87\x11\xa4\x5a\xa3\x69\xfa\x40\x70\x00\xc0\xaa\xaf\x88\x12\x71\x54\xaa\x75\x40\x86\xd1\xe9\xc9\xc9\xf9\xc9\xd3\x93\xd3\xeb\xa7\xcf\x4f\xce\x4e\x9e\xff\x2d\x42\x82\x3e\x33\x8e\x27\x61\xd1\x87\xf1\x1f\x3e\xc2\x31\x44\xa3\x08\x8e\x83\xf0\xa1\x19\xf8\xc7\x36\x5b\x9b\xb3\x4f\x14\x1c\xd1\xe0\x1b\xc0\x70\xc9\x36\xa5\x62\x85\x2b\xc5\x10\xd6\xf0\x2f\x0f\xc0\xbd\xdd\x43\x35\xf2\x27\x16\xa1\x5e\x62\x12\x19\x47\x33\x67\x9d\x33\xe0\x49\xcb\x24\xfb\xa9\x4c\x79\xbe\xd2\xc2\x6e\x86\xee\x0c\x8a\xd2\x1e\x85\x50\xc6\xf5\x3c\xc8\x3c\x7b\x9c\x2a\xdd\xf6\x0d\xa7\xa1\x34\x6d\x89\x5b\x23\x3a\x82\x94\xae\x42\x5b\x7e\xf0\xe1\x41\xd5\xd7\x3d\x95\x1c\xce\xc8\xdb\xc1\x3f\x75\x79\xfc\xcd\x0a\x2c\xdc\x2d\xa3\x03\x13\x22\x18\xe2\xc9\xde\xc1\x4c\xaf\x99\x79\xa7\x39\x46\x29\x45\xe8\x97\x21\xab\xbe\x0e\x5b\x91\xe9\xd9\xaf\xaf\x9f\xf7\x23\xd8\xa8\xed\xa1\x0f\xd7\xa4\x54\x6b\x7a\x92\x75\xa9\xd9\xad\x33\x49\x5d\x8d\x90\x2d\x3c\x4d\x88\x32\xc8\x88\x1a\xc3\x97\xef\xcc\x57\x0e\xc6\x09\x6f\xc2\xb5\x44\xde\x2d\x07\x0c\x20\x52\x36\xd9\x9f\x57\xb3\x99\x7b\x75\x44\x5d\x7f\xea\x57\xb9\xd8\x32\x24\x7b\x9f\x80\x87\xdc\xe4\xb6\x38\x11\xa3\x65\xec\xc2\xa3\xad\xa3\x63\x8f\xbc\x03\x7f\x6e\x28\xb0\x30\x9e\xec\x87\xc8\x2f\xa2\x04\x03\x7b\x34\x6a\x2e\x2c\x85\x01\xf7\x05\x01\xde\xce\xad\x45\x8e\xef\x3b\x5f\xf2\xf0\x1e\x38\x7d\xe6\x62\xcb\x2e\x42\x1d\x84\x55\xee\x3b\x66\x31\x8b\xbb\xec\x6d\x17\x9d\x3c\xe5\xae\x40\x27\x18\xcc\xc4\x16\x75\xb0\xb5\x79\x69\x72\xb6\xe4\x31\x76\xa4\x30\x63\xa5\xe1\x49\x37\x10\x9e\x74\x02\xe1\x5b\x0b\xb7\x83\x96\xe2\xe8\xa8\xdd\x11\x3b\x78\x44\x13\x5b\xbe\x6a\x4f\xeb\x2d\xd2\xae\x05\xfe\x6b\xc5\xf5\x26\x0e\x82\xfa\x56\x92\xa4\xfb\xcd\x44\x16\xa2\xe1\x8e\x01\xc2\x06\x75\x94\x62\xc7\x96\x6a\x25\xb4\x40\x38\xf4\x29\x5f\x75\x93\x1a\x15\x5b\x7d\xf1\x9c\x23\x7b\x81\xae\x15\x82\x0d\x94\x37\xba\xf8\x75\x7a\x36\xc4\x5b\xb8\xe1\xf4\xf5\xc5\xe9\xf3\xf3\xbf\x4b\xf4\x14\x4a\x63\xe4\x24\xe8\x3a\x2e\x0b\xd7\xcd\x3d\x09\xb2\xc5\x12\x25\xca\x5b\xbe\x09\x35\xa2\xa7\x1d\x38\x73\x9b\x46\xc7\x26\xeb\x81\xf1\xa4\xce\x9f\x98\xe4\x43\xdc\x7d\xc2\x7c\xa5\x6d\xe3\xfc\xb5\x59\x7a\x38\x27\x85\xa8\x93\xf4\xb7\xe4\x0b\xc8\x47\x87\x8d\x5b\xbe\xf1\x34\x83\x27\xf4\xd3\x66\xbf\xc8\x6a\x17\xa3\x3b\x1a\x83\xcb\xba\x1e\x98\x1c\x98\xd1\x81\x49\x61\xda\x36\xe6\x24\xf4\x38\x54\x3f\xc1\xd3\x92\x0a\xd3\x5a\xf0\x37\x57\xa9\xd7\x70\xda\xf3\x83\x7d\x27\x51\x5f\x90\xb6\x49\x93\x24\x69\xe1\x56\x5f\xd3\xdd\xdf\x93\xde\xb2\x70\xba\x5c\xf1\x99\x90\x02\x2f\xbd\x0d\x6c\xb7\xf4\x91\x54\x78\xe3\xee\x77\x64\x6f\x8c\x83\xa9\xf8\x55\xd4\x68\x04\x6f\xf9\xfa\xfe\x1e\xbf\xb9\xc8\x59\x29\xbe\x72\xc8\xde\x5c\xc1\x76\x4b\x05\x98\xff\x88\x63\x1f\xc4\xc7\xaf\xa8\xdc\x4c\x30\xc4\x04\x98\x7c\xc1\x2b\x92\x18\x37\x7e\xb4\xca\xf4\x37\xc6\x8f\x6d\x1e\xb3\x8e\x36\xf7\x96\x79\x49\x07\x72\xc3\x7d\xa3\xaa\xc3\x56\x3f\x76\x77\x2a\xc8\x31\xb6\xa1\x4d\xdf\xcd\xe8\x79\xef\xb8\xbf\x29\x06\x6d\x28\xa7\xc6\x01\xe1\x01\x7e\x50\xe6\xef\x35\x20\x3a\xf8\x1c\x35\x7a\xa6\xba\x6b\xbb\x0d\xd4\x9d\x53\x8f\xbf\xb9\x8e\x26\xd2\xc2\xad\xb7\x38\x2f\x0d\xff\x17\x5b\x8c\x85\x17\x38\xaf\x86\xef\xb0\x18\x5e\xeb\x5c\x91\x69\x82\xc9\xea\x4a\xa7\xf5\x34\xd0\x31\x54\xd3\x8d\xbd\xf4\x0c\xe6\xb5\x74\xdb\xb7\x8d\xeb\xf6\x74\x51\x37\xad\x87\xad\xf1\x83\xea\xac\xa7\x90\x42\x01\x7a\xf1\x40\xb1\x09\xdb\x2d\xfd\x1a\xf7\x8c\xf3\x6f\x77\xd1\xbe\xd9\x35\x31\xff\x89\xa1\x5f\x3c\x86\xde\x62\xb4\xec\x5b\x56\xf5\xcd\xe9\x96\xec\xfe\xc0\x4a\x9b\xac\x80\x75\x54\xfd\xe2\x85\xbf\x5b\x95\x35\xf6\x1b\x77\xd5\xa2\xc2\xd7\x36\xf5\x9a\xdd\x92\xb3\xfb\x9d\x0d\xdd\xbd\x22\xc5\x2e\x7e\x75\x29\x26\x8c\xd5\x89\xb4\xee\xc5\xa3\xde\x8d\xf8\xfb\x80\x16\x20\x0b\x5c\x12\x84\x72\xf0\x0c\xbd\x8e\xd1\xa4\xb1\xfb\xd6\x88\x90\x25\xba\x96\x5a\x79\x69\xf8\x17\xab\x19\x98\x25\xcb\x11\xce\xfd\xb7\x12\x32\xbc\xf7\xe2\x23\xf0\x92\x69\x66\x95\xf6\x02\xee\xe2\xbf\xfa\xd8\x48\x9b\xb9\x24\x68\x47\x60\xab\x45\x55\xf1\x62\x0f\x2c\xc4\x9a\xce\xd3\x6a\x3f\x3f\xb8\x9e\xe6\x70\xa2\xcd\xbc\x92\x88\x96\x7f\x5f\xe8\x40\x8b\xd0\x78\x25\x78\x59\x18\x7f\xc9\x9a\xf8\x4b\xc8\xae\x2a\x3b\xab\x88\x5e\x4b\x82\x70\xef\x50\x1f\xf6\x0e\x74\x04\x0d\x7f\x76\x0d\x54\xad\xf4\x09\x35\xcc\x0a\x0e\xe0\x55\x8d\xb4\x2a\x6e\xb9\xae\xb1\x1c\x7e\xfb\xa7\xe9\x73\x3c\x04\x7f\xa8\x7e\xe9\xef\xb5\xbd\x7e\xbb\xfb\xc5\x7e\xa3\x95\x2e\x33\xa7\x72\xd3\xd1\x68\xc0\x87\x41\xa3\xad\xfa\xa2\xab\x5c\x47\x25\x49\xbe\x8d\xcc\x9b\x89\x0f\x57\x22\x6e\x4e\xb7\x08\x69\xd0\xa0\x5f\x62\xf5\x8a\x8a\x10\xc2\x19\x8f\xd9\xb2\x96\xa3\xae\x31\x08\xed\x77\xba\xd3\xd6\x26\x8e\x1a\xed\xd2\x14\x1e\xff\xf7\x6a\xc1\x99\xe9\xbb\xb5\xf2\x68\xcd\xd0\x28\xab\xc3\xbd\x1b\xeb\x6c\x4e\xfe\xfe\xfd\x4a\x22\x26\x6b\xe9\x7c\x3b\x3c\x2e\x4c\xa2\x63\xb7\xbc\x5b\x87\xed\x73\xf5\xb0\x2e\x3a\x8c\x82\x8f\xd7\x9a\x85\x25\x7e\x1d\x27\xed\xd0\x33\x6f\x80\x95\x25\x7a\xa8\x2f\x9d\x7c\x2a\x21\xff\xbb\xa1\xd4\xb1\x92\x9a\x23\x4c\xe4\x05\x7e\x46\xa7\x59\x6e\xe9\x72\xf9\xfd\xab\x4b\x78\xf6\xc7\x3f\x9c\xa7\xed\xcf\x58\x4d\xc9\xcc\x82\x9b\xde\x05\x2c\x7e\xe6\xe8\xbd\xbf\xb1\xb1\xa1\x8d\x52\xe0\xae\x63\x8a\x2b\xe1\x46\xa9\xb2\x13\x02\xee\xa3\xa7\x7d\x95\x9d\x40\x1b\x9c\xbc\x00\x01\x7f\x72\x36\x34\xc9\x0b\x10\xc7\xc7\xa4\x4e\xf7\xc6\x82\xcf\x93\xed\x77\xc9\x50\xf5\x19\x0e\x47\x17\x47\xf0\xa7\x09\xe4\x70\x78\x08\x39\xfe\x3a\xfa\xdb\x51\x0a\x47\xac\xdf\xfb\x15\x7b\x4f\xfa\xbd\x7f\x3c\x4a\x21\xc7\xfa\xeb\x68\x58\xff\xfa\x54\xff\xca\xea\x5f\xff\x73\x14\x1e\xe2\x6e\x7c\x6d\xf9\x67\x04\xf1\x79\xd2\xf0\xe1\xa9\x8c\x8e\x90\xf6\x93\x96\x22\x1e\x59\xd7\x79\xe1\x06\x77\x31\xf5\x8a\x10\xf6\xe1\x4d\x0a\xd1\xc1\xc1\xc1\xc9\xe9\x6f\x51\x0a\xf9\x5e\x5f\xb9\x21\x0f\x8d\x9d\x73\x38\xa3\x74\x71\x31\xe1\x08\xf7\xc9\x49\xf0\x86\x84\x3a\xe1\x7e\xcf\x0b\x16\xd5\x50\x6f\xf9\x3a\x85\x5b\xbe\xd9\xfb\x7e\x85\xb4\xda\x00\xbb\xfd\x16\x34\xd8\x0e\xfe\x77\x00\xea\x1f\x4e\x95\x9f\x30\x00\x00")
+
+func templatesClientSigningGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientSigningGotmpl,
+		"templates/client/signing.gotmpl",
+	)
+}
+
+func templatesClientSigningGotmpl() (*asset, error) {
+	bytes, err := templatesClientSigningGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/signing.gotmpl", size: 12447, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDocsHtmlGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x6f\xdb\x38\x12\x7f\xcf\xa7\x98\x3a\xb9\xe2\x0e\xa8\xec\xa4\x49\x0f\x77\xb2\x22\xa0\x17\xe7\xae\xc1\xa5\x4d\x90\xa6\x0b\xec\x23\x23\x8e\x2c\xa2\x92\xa8\x92\x54\x13\xd7\xf0\x77\x5f\x50\xa2\x24\x4a\xa6\xe2\xa4\x68\xbb\x2f\x0b\xbf\x88\x33\xc3\x99\x1f\xe7\x1f\xc7\x5c\xaf\x3d\xa0\x18\xb3\x1c\x61\x42\x79\x24\xdf\xdd\xbe\xbf\xbc\x5d\x15\x38\x81\xcd\x66\xbd\x06\x16\xc3\xf4\x06\x63\xd8\x6c\x02\x02\x89\xc0\xf8\x74\xb2\x9f\x71\x8a\xa9\xb7\x5e\x03\x25\x32\x41\xc1\xbe\x61\x23\x33\x09\xd7\x6b\x48\x54\x96\xc2\x54\xeb\xd0\xbb\x66\x44\xd3\x30\x95\x58\x2b\xec\x71\x35\x27\xa7\xdd\x87\xb7\xd9\xec\x05\x2f\x16\x57\x67\xb7\xbf\x5f\x9f\x57\x8a\xc2\xbd\xe0\x85\xe7\xc1\x19\xa7\x08\x4b\xcc\x51\x10\x85\x14\xee\x56\xb0\xe4\x9e\xbc\x27\xcb\x25\x8a\x39\x2c\xae\xe0\xc3\xd5\x2d\x9c\x2f\x2e\x6e\xa7\xe0\x79\xe1\x9e\x01\x7e\xc6\x8b\x95\x60\xcb\x44\x69\x20\x2f\x3c\x4f\xd3\x7b\xc4\x3d\x23\xdc\xda\x36\x26\x13\x24\x34\xdc\x03\x08\x32\x54\x04\xa2\x84\x08\x89\xea\x74\x52\xaa\xd8\xfb\xd7\xa4\x62\x28\xa6\x52\xb4\x4e\xab\x97\xda\xca\xac\x66\x68\x11\xa9\x56\xf5\x17\xc0\x1d\xa7\x2b\x58\x43\xcc\x73\xe5\xc5\x24\x63\xe9\xca\x07\x49\x72\xe9\x49\x14\x2c\x9e\x43\x46\xc4\x92\xe5\x3e\x1c\x02\x29\x15\xd7\xeb\x07\xef\x9e\x51\x95\xf8\xf0\xef\x7f\x1e\x16\x0f\x73\x28\x08\xa5\x2c\x5f\xfa\x70\x84\xd9\x1c\x22\x9e\x72\xe1\xc3\xfe\xf1\xf1\xf1\x1c\x36\x95\x89\x48\x7b\x68\x0d\x77\x24\xfa\xbc\x14\xbc\xcc\xa9\x0f\xfb\xf1\x89\xfe\x59\x9b\x0f\x61\xfa\x5a\xef\xaf\xb7\x28\x72\x97\x56\x7b\xb8\xa0\x28\xbc\x88\xa7\x29\x29\x24\xfa\xd0\x7c\xcd\xc1\x80\x38\x3a\x3c\xfc\x5b\x83\xd2\xbb\xe3\x4a\xf1\xcc\x20\x31\x9a\x92\x57\xa0\x68\xab\xca\x87\xa3\xe2\x01\x24\x4f\x19\x85\x7d\x4a\xa9\x85\x60\x7a\xa2\xed\x2b\x7c\x50\x1e\x49\xd9\x32\xf7\x21\xc5\x58\xcd\xe1\x2b\x0a\xc5\x22\x92\x36\x54\xc5\x8b\x4e\xf9\xd8\xb9\x6a\xfe\x34\x43\x95\x70\xda\xf8\xf7\x1e\x75\xc4\x7d\xb8\xe3\x29\x35\x96\x94\x20\xb9\x8c\xb9\xc8\x7c\x28\x8b\x02\x45\x44\x24\xb6\xbb\x29\x16\x02\xa3\x2a\xad\xd6\xad\x63\xc9\xe1\xa1\x25\x20\x23\xc1\x0a\xc5\x78\x0e\x6b\xb8\x4f\x98\x42\x4f\x16\x24\x42\x1f\x0a\x81\xde\xbd\x20\x06\x6a\x30\x33\x21\x0f\x66\x75\x02\x05\x3a\xee\x3a\x03\x82\xe4\xc8\x95\x2b\xc9\x91\x66\xea\x0a\xd4\x65\x76\x91\xc7\xbc\x2b\x3a\xbd\x9a\x2e\x2c\xd3\x1b\x0d\x27\x28\x20\x4a\x89\x94\xa7\x13\x0b\x95\x55\x75\x8e\x5d\xc1\xac\x68\xac\xe8\x34\xaf\xd5\x94\xa9\xa6\xf5\x6d\x4f\x7f\x43\x21\x5b\x4b\x00\x41\xca\xc2\x40\x2a\xc1\xf3\x65\x68\x58\xbe\x3e\x62\x45\x80\xbe\xc9\x6e\x6b\x30\x4b\x59\xa7\xbb\xb5\x38\x30\x75\xc6\x73\x45\x22\xe5\x32\x65\x58\x9d\xa9\xf5\x7a\x6b\xdf\xf4\x03\xc9\xb4\x0b\x07\x28\x06\x5c\x53\xd8\x9b\x8d\x4b\xc3\x79\x46\x58\xaa\x55\xbc\x4c\xd5\xdc\xad\xa6\x11\x79\xb9\x54\xf3\x47\x75\x7d\xba\xb9\xd4\x9a\xda\x0e\xe9\x56\x57\x4b\x4d\xc2\xc7\xb8\x4d\xbb\xcc\xe9\x93\x5d\x79\xc9\x22\xcc\x25\xba\x5c\x69\x58\xfd\xa8\x0d\x37\x36\x96\xdd\xe0\xfb\x42\x93\xd0\xcd\x35\x0e\x1f\x69\xf6\x2e\xd1\x5d\x87\x0c\x66\x75\x8a\x5a\x44\x9d\xb7\xc9\xeb\xf0\x3c\xa7\x05\x67\xb9\x0a\x66\xc9\xeb\xd0\xce\x65\xfb\xe4\xef\xb8\x54\xdb\xc7\xd6\x54\x6d\x52\xf7\xca\xee\x24\x0d\x75\xd6\x90\x0d\x7e\x95\x20\x24\x9a\x27\x51\x7c\x65\xf9\x12\x54\xc2\x24\x50\x1e\x95\x19\xe6\xca\x71\x00\x1b\xc0\x7f\x88\x44\x28\x88\x4a\x2c\x14\x03\xbb\x5a\xe4\x9a\xa8\xa4\xb3\xdd\xf7\x85\x46\xfc\x31\x4a\x30\x43\xe9\x0a\xae\x61\xf5\x4f\x29\x48\xbe\x44\x38\x60\xaf\xe0\x40\x82\x7f\x6a\x2b\xa8\x8b\xe0\x80\xc1\x66\xf3\x0a\x5a\xf4\x0d\x9a\x03\xf9\x84\xa0\x74\xb8\xce\x78\x2e\xcb\x11\x60\x0d\x6f\x14\x59\x56\x21\xb3\x54\xb8\xa1\xf5\xdd\x75\x90\xf5\x63\xf4\x34\x9c\xd7\x82\xd3\x32\x72\xe3\x6c\x78\x3b\x70\x5a\x2a\x7e\x34\x4e\x3b\xc9\x35\xda\x8f\x18\x95\x82\xa9\xd5\x42\xcf\x63\x4c\xf7\x6f\x69\x25\x7e\xc3\x6d\x12\x5f\xef\xaa\xd1\x8e\x6d\xd4\xfb\x8e\x81\xd1\xd3\x89\x34\x02\x83\xa9\xed\x62\x31\x28\x6a\xbd\x0e\x66\xc9\xb1\x8d\xea\xd9\x97\xd0\x33\xee\x1f\x3b\x1e\x7a\x26\xec\xc7\x62\x30\x49\xda\x3e\x64\x31\xe0\x17\xc3\x9b\x90\x82\xfd\x1f\x57\x13\x57\x90\x75\x67\xb2\x94\xf6\x63\xd5\xf5\xad\x8a\x0c\x2c\xb7\x2f\x94\xf1\xb8\x75\xbe\xf9\x6f\xca\xef\x5d\x66\x35\xdd\x75\x16\x23\xbf\x53\xef\xdb\x52\x25\x5c\xb0\x6f\x44\xbb\xb6\xee\xbd\x5b\x36\x7a\x32\xf0\xe9\xe6\xd2\x65\xd0\xa1\x68\xa7\xf1\x5b\xfe\x19\xc7\x8c\x56\xbc\x31\x63\xd6\xc6\xa7\x66\x7c\xc4\x8b\xa6\x3a\x83\x6a\x1e\x35\x79\xa1\x44\x18\xa8\x24\xac\xf8\xc1\x4c\x25\xd5\xca\x4a\xac\x9a\x36\x53\xa2\x33\xd2\xd4\x82\xa5\xb2\x51\x44\x1d\x21\x57\xb4\xcf\x18\x66\xad\xa2\x03\xfd\xd6\x21\x5a\xa4\x3d\xfa\xd8\xc2\xd4\xef\x55\xa1\xff\xbd\xe8\xda\x74\x54\x70\xcb\xfc\x9f\xe0\x65\xd1\x78\x24\x39\x76\x00\xff\xe9\xd5\xe9\x82\xd5\x22\x3a\xa9\xfa\x09\x6f\xe8\xee\x86\x12\xc8\x82\xe4\x0d\x8c\x7a\x3c\xaf\x10\x4c\xdf\x57\xdf\x95\x5d\x2d\xb2\x55\x90\xfd\xfb\xd0\x5c\xdb\x1f\xcb\x2c\x23\x62\xd5\x9b\xf7\x3a\x9a\xd5\x64\x93\x93\xbe\x67\xda\xc1\x7e\xcb\x31\x0d\x67\x12\x76\x52\x63\xae\xf8\x95\x3d\xb0\xf5\x37\x5c\x2c\x5c\x15\x76\xb1\xd8\xaa\xad\xbf\xae\xe3\x1f\x75\x1d\x77\x38\x9b\xfb\xd4\x85\xb3\xe1\xed\x1a\xbc\x3a\x15\x16\x4e\xe0\xa2\x43\xda\x62\x34\x87\x79\xd6\xac\x70\x4d\x04\xc9\x8c\x23\x83\xe4\x4d\x58\xad\x51\xa1\x90\xc1\x2c\x79\x13\x8e\xf4\x53\xdd\x44\xda\x76\x7a\x91\xb7\x9f\xfa\x1e\x6d\x17\x37\xf8\xa5\x64\x02\x69\x4b\x58\x60\x4c\xca\x54\x59\xeb\x27\xf4\x61\x1b\xe0\x73\xfa\xf0\x45\xd7\x7e\x6b\xb2\xc2\xac\x48\x89\xda\x7a\x9f\x9a\x0e\xe4\x74\xe0\x1a\xe8\xb0\xd9\xac\x50\x76\xb3\x7c\xce\x2d\xff\x76\x5b\x9a\x5a\xad\x8e\x67\xf1\x9e\x53\xdb\xc6\xf2\x79\x5e\x56\x13\xea\x9d\x08\xdf\xa6\x29\xbf\x47\x0a\x5f\x49\x5a\xa2\xf4\xbb\xea\x35\x32\x03\x28\xcf\xbb\x64\x74\xac\x6f\x50\x16\x3c\x97\xf8\x68\xa8\xf5\x93\x99\x3b\xbe\x4f\x0a\x5f\x6b\x63\x34\x82\xda\xc0\x77\x84\xea\x99\xbe\x35\x70\xde\x21\xa1\x28\xa4\xf1\x70\xbd\x1a\x5e\x1e\x6d\x4a\x55\x64\xf8\xfb\x70\x82\xfc\x87\x09\x55\xdf\x86\x0f\xe3\xc6\xdb\x3a\xfd\xfe\x78\x6d\x2d\x74\x9a\xbe\xd7\x2f\xa8\xf6\x6c\x5f\x13\x1c\x73\x41\x27\xd9\x0d\xf3\xae\xf7\x57\x73\xf6\x89\xc3\x1b\xfd\x79\xa1\x79\x7d\xd2\x59\x53\xb4\x4d\xcd\xf1\x38\x65\x38\xbf\xf8\x56\x6c\x54\x9f\xf1\xac\xe0\x6d\xf6\x05\x45\x68\x08\x14\x78\x3c\xe8\xb7\x91\xb9\xbf\xda\x0d\x23\xf7\xc2\x63\xef\xd7\x07\x51\xcf\x79\x07\x91\xf5\x9a\x51\x9d\x7a\xfa\x18\xd8\x6b\xa1\xe7\x21\xc5\x5a\xb8\xbb\x3a\xef\xae\x76\xfb\x84\xfa\x1c\xda\x74\x14\x68\x9b\x01\x3f\xbd\x97\x8e\x45\xbd\x56\x43\xe8\x55\x9e\xea\x8b\x50\x7f\x02\xcf\xd3\xd5\xd4\xf0\xfa\xf9\xd0\xbb\x19\xdb\x8f\x3f\xb1\xe7\xa6\x72\xbb\x52\xb6\xff\xa5\x3e\xee\xd3\x36\x6b\x2c\xc0\x7d\x8d\x03\xe8\x3d\xdd\xbd\x33\x8c\xa5\xe0\xce\x45\x30\xab\x1f\xa2\x83\x59\xa2\xb2\x34\xdc\xfb\x63\x00\xc5\x3e\xe9\x83\xf4\x19\x00\x00")
+
+func templatesDocsHtmlGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDocsHtmlGotmpl,
+		"templates/docs/html.gotmpl",
+	)
+}
+
+func templatesDocsHtmlGotmpl() (*asset, error) {
+	bytes, err := templatesDocsHtmlGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/docs/html.gotmpl", size: 6644, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDocsMarkdownGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\xd9\x79\x68\x84\xca\x7b\xcf\xd2\x00\x9d\x9d\xad\xc6\x92\x26\x48\xd2\xbd\x14\x05\xca\x4a\x67\x5b\xa8\x44\xaa\x94\xd4\xc0\xa5\xf4\xdd\x07\x4a\x47\x9a\x92\xa9\x34\xdd\xb0\xbd\xcc\x2f\xe6\xf1\xfe\x1f\xef\x7e\x14\x95\x8a\x20\xc1\x4d\xca\x11\x66\x89\x88\xcb\x6b\x26\x3f\x27\xe2\x91\x3f\xec\x0b\x9c\x41\xdb\x2a\x05\xe9\x06\x16\x77\xb8\x81\xb6\x7d\xaf\x14\x2c\x34\x07\xda\xf6\xc3\x8b\x79\x2e\x12\xcc\x22\xa5\x20\x61\xe5\x0e\x65\xfa\x0d\x8d\xe0\xa9\x52\x80\x59\x89\xbd\x01\xa3\xa2\x37\x79\x72\x58\x44\x6d\x1b\x9c\xff\x14\x45\xb0\x14\x09\xc2\x16\x39\x4a\x56\x61\x02\x9f\xf6\xb0\x15\x51\xf9\xc8\xb6\x5b\x94\xbf\xc0\xea\x06\xde\xde\x3c\xc0\xe5\x6a\xfd\xb0\x80\x28\xba\x08\x28\xa6\xa5\x28\xf6\x32\xdd\xee\x2a\x20\x3b\x9a\x31\xdc\x25\x69\xed\xac\x6d\x83\x39\x68\x81\x87\xb4\xca\x50\xab\x90\x99\x35\xdf\x88\x43\xa2\x9a\x5a\xac\xb0\x8c\x65\x5a\x54\xa9\xe0\x24\x38\xb5\x6f\xf3\xb1\xba\x7f\xa2\x2c\x89\x1f\x86\x44\x9c\x85\x21\x28\x75\x2c\xe0\x35\xb0\x14\xbc\x62\x71\xd5\x1b\x20\xe2\x2c\x0c\x3d\x22\x8b\xb7\x2c\xd7\x99\x1c\x6c\x8f\x18\x4f\xd9\x5f\x5c\xe6\x2c\xcd\xb4\xf6\xb9\x52\x13\xac\x8b\x27\x0d\xbc\xbb\xbb\xf2\x3a\xef\xf7\xad\xaa\xbf\x4c\x57\x69\x8c\xbc\x6b\x90\x20\x0c\x89\xa0\x32\x8d\x65\xc8\xe0\x7b\xa5\x46\xfb\x94\xe5\x87\x17\x47\x9c\x5e\x63\xd4\x85\x3e\x5d\x7f\x94\xb4\x35\x9f\xc3\x25\x4f\x0a\x91\xf2\x2a\x08\x42\x08\xc3\x37\xa2\xac\x9c\x20\x35\x09\x6d\xfb\x51\xa9\xc1\x9a\x5c\x56\x3b\x84\x9d\x96\x28\x51\x7e\x4d\xf9\x16\xaa\x5d\x5a\x42\x22\xe2\x3a\x47\x5e\x1d\xbc\x68\xc3\xbf\xb2\x12\xa1\x60\xd5\x4e\x5b\xef\xec\xe9\x9d\x5b\x56\xed\xb4\xcd\x40\x0f\xa9\xae\xca\x7d\xbc\xc3\x1c\x4b\xa3\x45\x24\x45\x24\x19\xdf\x22\x9c\xa4\x2f\xe1\xa4\x84\xb3\x57\xae\x74\x1f\xef\x49\x0a\x6d\xfb\x12\xac\x67\xa5\xb4\xe4\xb0\x06\x91\xbb\xd4\x2e\x97\x82\x97\xb5\xe3\xd3\xd0\xc7\x4e\xf3\xce\xa9\x23\xef\xf7\xaa\xb3\x3b\xc9\x4d\xa9\x26\xfd\xde\x4a\x91\xd4\xf1\xc1\xaf\xa1\xa7\xfc\x3a\xf2\x7f\xd3\xaf\x56\x5a\xdc\x63\x5c\xcb\xb4\xda\xaf\x34\x24\xa6\x1a\x00\x4a\xea\x04\xc3\x09\xac\xf7\x69\xe1\x1e\x68\xd6\x2b\x4d\x19\xcb\x43\xec\x50\x6a\xbc\x13\x98\x0e\x8c\x28\x63\x0d\x9a\x94\xad\x01\x50\x53\x1d\xfc\x42\x98\x3a\x63\x45\xfa\x07\xee\x67\xa6\x4a\x7a\x22\x6c\x0b\x51\x8b\x7f\x84\x94\xd3\x90\x1e\xe5\xdc\x9f\xf1\x6f\x99\x78\x34\x16\xf4\xda\x78\x35\xfb\x1e\x95\xd7\x75\xb5\x13\x32\xfd\xc6\x74\x89\xfa\x69\xeb\x02\x18\xec\xc3\xbb\xbb\x2b\x63\xcb\xa7\xe0\xb1\xfb\x20\x3e\xa3\x6b\xaf\xa3\x5d\x3b\xae\xc0\x40\x9f\x06\x44\x14\x5d\x0f\x04\x0d\xdc\xc7\xa2\x40\x68\xc0\x2d\x73\x13\x34\x51\xff\x33\xff\x44\xb9\xa7\x6a\x6c\x34\xba\x6a\x39\xdd\x87\x4b\xcc\x32\x5b\x52\xf0\xb0\x86\xa7\x09\x8d\x07\x50\x8e\x17\xba\xb1\x6e\x0a\x7d\xed\xe9\xe6\x71\x82\xb0\x9b\xbf\x4b\x51\x17\x83\xb6\xa2\x18\xfc\x7d\x15\x78\x1a\xcb\x75\x3b\x36\x6f\x2c\xcf\xe1\x9c\x01\x67\x39\xbe\x9a\x09\xc3\x1b\xdd\xec\x5d\x3b\xcf\x2e\xce\x7f\x66\x17\x5d\x7b\x5d\x63\xb5\x13\x89\xb9\x01\x0c\x56\x99\x83\xa8\xf3\x9c\xc9\xbd\xe1\x1e\xc8\x43\xea\x36\xfe\x42\x62\xdc\xdd\xfb\xba\x87\xc2\x03\x1d\x86\x83\xd0\x7f\x30\xd9\xae\x1d\x6d\x9e\xb0\x5e\x99\x0e\x5a\xaf\xdc\x86\xfb\xff\xe0\x1b\x5d\x21\x84\x59\xc6\xaf\xc1\xb0\xc9\x4b\xe4\x20\xef\xf8\x05\x21\x9f\x7d\x8f\x68\xa5\xc5\x2d\x93\x2c\xb7\xcd\x36\x87\x8e\xc6\x0a\x65\x19\x04\x0d\x74\x2d\xdd\xc0\x9a\x43\x03\x1d\xae\x35\x70\x87\x5f\xea\x54\x62\xd2\x0d\xf0\x86\xd5\x59\x35\x35\xca\x8d\x33\xce\xf4\x37\x5c\x4e\x8f\xba\x8d\xaa\x71\x27\xab\x9f\xee\x1e\x2e\xfb\x75\x85\x79\x91\xb1\xca\xfb\x89\xbc\xb0\x52\x3a\x4f\x1b\x76\xdb\xee\xb1\x3c\x7c\x0c\x70\x61\xab\xe3\x07\x8f\x3e\xc5\x29\xae\xdb\xe2\xe4\xe9\x92\xd7\xfa\xb4\xcf\x3f\xc9\x8b\xd7\x59\x26\x1e\x31\x81\xaf\x2c\xab\xb1\x3c\x3b\x36\x40\xb2\x4e\x08\x83\xc9\xa2\xa3\xd2\x07\x33\x87\x3b\x2c\x0b\xc1\x4b\xec\x0e\xa6\xfb\x30\xb7\x67\x32\x5d\xfe\xa7\x8a\x6c\x0d\xda\x3a\x77\x56\x7f\xac\xb6\xdf\x29\x08\xb9\x7a\x83\x2c\x41\x59\x52\x59\x7a\x6a\x74\x17\xbe\x70\xae\xd3\x53\x2a\xe5\xd0\xdc\xd9\x33\xfc\x8d\x4a\xf7\x34\xda\xdb\xd9\xbb\xd6\xef\x25\x1a\x02\xe8\x09\xa7\x4e\x2e\xd7\x81\x63\xdf\x23\x8b\xb2\xe9\xc1\xd8\x49\xcf\x4c\x9b\x7d\xe2\x74\xcf\x06\x4b\xfe\x33\x40\x25\x8d\xa5\xc8\x0b\xd1\x9f\x66\x40\xeb\x04\xc4\x66\x04\x1e\x31\x81\xa5\x95\xf5\x83\x96\xfe\xa6\x3f\x89\x27\x5f\x93\x1d\xeb\xd4\x4a\x2f\x8e\x82\xb9\x95\xfa\xb6\xaa\x52\x73\xe7\x13\x90\xf8\x30\xe4\x59\xad\x3b\xdd\xc5\x03\x4f\x1e\xb8\xf8\x17\x20\xa2\x17\x66\xc9\x0d\xcf\x34\xfc\xea\x25\x08\x9e\xed\x17\xc4\x1b\x1e\xd4\x00\x8f\xed\xe2\x3f\x06\x92\xcc\xbc\xe8\x9c\x8f\xd7\xef\x55\x26\x18\xc6\x11\x84\xe1\x28\x0c\xba\xb7\x0d\xdf\x9b\xe7\x60\x11\xb5\x6d\xf0\xd7\x00\x4b\xd6\x77\x95\xd8\x10\x00\x00")
+
+func templatesDocsMarkdownGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDocsMarkdownGotmpl,
+		"templates/docs/markdown.gotmpl",
+	)
+}
+
+func templatesDocsMarkdownGotmpl() (*asset, error) {
+	bytes, err := templatesDocsMarkdownGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/docs/markdown.gotmpl", size: 4312, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDocstringGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xcc\x41\xca\xc2\x30\x10\xc5\xf1\x7d\x4e\xf1\xe8\xfe\x6b\x2e\xf1\xad\x5d\x79\x81\x92\x4c\x75\xa0\x99\x48\x13\x37\x0e\xef\xee\x52\x05\x29\x45\x70\x37\x0c\xff\xdf\x73\xcf\x32\xab\x09\x86\x5c\x53\xeb\xab\xda\x65\x20\x03\xe0\xfe\x07\x9d\x31\x9e\xb5\x2f\x02\xd2\x1d\xa9\x96\x22\xd6\xf7\xbf\x77\xf3\x2f\x2d\xad\x7a\xeb\x5a\x0d\x64\x88\x31\xc4\x88\x3d\x38\x04\x9b\x13\xcb\x9f\x73\x69\x72\xdc\x21\x7f\xf9\x0d\xbd\xaa\xeb\xbd\x4c\xa6\x0f\xc1\x78\x9a\x8a\x7c\xdb\xb7\x0c\x32\x3c\x07\x00\xae\xbb\x3b\x77\xeb\x00\x00\x00")
 
 func templatesDocstringGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -216,7 +348,7 @@ func templatesDocstringGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesHeaderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x41\xaf\xda\x30\x10\x84\xef\xfe\x15\xa3\x88\x4a\xad\xd4\xd8\xf7\x56\x3d\x01\x07\x2e\xe5\x1d\xf8\x03\x86\x6c\x1c\x8b\xc4\xce\x73\x36\xa0\x68\xe5\xff\xfe\x44\x02\x48\xa0\x77\xf2\x78\x67\xe7\xdb\x31\x06\xeb\x58\x11\x1c\x05\x4a\x96\xa9\xc2\x71\x82\x8b\xe5\x70\xb5\xce\x51\xfa\x8b\xcd\x1e\xff\xf7\x07\x6c\x37\xbb\x83\x56\x4a\x89\xc0\xd7\xd0\xeb\xd8\x4f\xc9\xbb\x86\x51\xe6\x6c\x0c\x44\x70\x8a\x5d\x47\x81\xdf\x3c\x11\x50\xa8\x90\xb3\x52\xaa\xb7\xa7\xb3\x75\x04\x11\xfd\xb1\xc8\xdb\xd8\x18\x1c\x1a\x3f\xa0\xf6\x2d\xe1\x6a\x87\xd7\x2a\xdc\x10\xee\x5d\xc0\x31\xb6\xfa\xb6\xbf\xad\x3c\xfb\xe0\xc0\xcf\x5c\x37\xdf\xeb\x53\xbc\x10\xea\x91\x67\x54\x43\x01\x53\x1c\x91\xa8\x4c\x63\x78\x21\x3d\x4e\xcc\xa5\x6d\xa8\x94\xf2\x5d\x1f\x13\xe3\xa7\x02\x06\x4e\x75\xc7\x28\x9c\xe7\x66\x3c\xea\x53\xec\x8c\x8b\x65\xec\x29\xd8\xde\x9b\xc5\x2d\x94\x88\xaf\x11\x13\xf4\x6e\x4e\x0e\xd0\x1b\xaa\xed\xd8\xf2\xe3\x9f\xb3\x02\x5c\x4c\x63\x60\xdf\x11\x8a\xbb\x28\x14\x20\x92\x6c\x70\xf4\x4d\x44\x04\x7d\xf2\x81\x6b\x14\x3f\x3e\x0b\xe8\x19\x22\x42\xa1\xba\xab\x25\xb8\x3a\xd3\xf4\x1b\xab\x8b\x6d\x47\xc2\x9f\x7f\xcf\x12\x33\xe0\x66\x22\x67\xbc\xb1\x96\xed\x17\xe0\xe3\xfd\xa5\xbe\x02\x00\x00\xff\xff\x78\xb1\x8a\x20\x07\x02\x00\x00")
+var _templatesHeaderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xb1\x8e\xe2\x30\x14\x45\x7b\x7f\xc5\x55\xc4\x4a\xbb\xd2\xc6\xee\x77\x35\x15\x50\xd0\x0c\x53\xf0\x03\x86\xbc\x38\x16\x89\x9d\x71\x5e\x40\xd1\x93\xff\x7d\x34\x09\x20\x81\xa6\xbb\xf6\xf5\x3d\x3e\xc6\x60\x1d\x2b\x82\xa3\x40\xc9\x32\x55\x38\x4e\x70\xb1\x1c\xae\xd6\x39\x4a\xff\xb1\xd9\xe3\x7d\x7f\xc0\x76\xb3\x3b\x68\xa5\x94\x08\x7c\x0d\xbd\x8e\xfd\x94\xbc\x6b\x18\x65\xce\xc6\x40\x04\xa7\xd8\x75\x14\xf8\xa5\x13\x01\x85\x0a\x39\x2b\xa5\x7a\x7b\x3a\x5b\x47\x10\xd1\x1f\x4b\xfc\xbe\x36\x06\x87\xc6\x0f\xa8\x7d\x4b\xb8\xda\xe1\x59\x85\x1b\xc2\xcd\x05\x1c\x63\xab\x95\x31\xd8\x56\x9e\x7d\x70\xe0\xc7\xae\x9b\x5d\xfa\x14\x2f\x84\x7a\xe4\x19\xd5\x50\xc0\x14\x47\x24\x2a\xd3\x18\x9e\x48\xf7\x2f\x66\x69\x1b\x2a\xa5\x7c\xd7\xc7\xc4\xf8\xad\x80\x81\x53\xdd\x31\x0a\xe7\xb9\x19\x8f\xfa\x14\x3b\xe3\x62\x19\x7b\x0a\xb6\xf7\x66\x69\x0b\x25\xe2\x6b\xc4\x04\xbd\x9b\x97\x03\xf4\x86\x6a\x3b\xb6\x7c\x3f\xe7\xac\x00\x17\xd3\x18\xd8\x77\x84\xe2\x16\x0a\x05\x88\x24\x1b\x1c\xfd\x30\x11\x41\x9f\x7c\xe0\x1a\xc5\xaf\xcf\x02\x7a\x86\x88\x50\xa8\x6e\x69\x19\xae\xce\x34\xfd\xc5\xea\x62\xdb\x91\xf0\xef\xed\x21\x91\xb3\xc8\x5c\x22\x67\xbc\xb0\x96\xd7\x4f\x40\x11\x0a\x55\xce\xea\x8f\xfa\x1a\x00\x78\xb1\x8a\x20\x07\x02\x00\x00")
 
 func templatesHeaderGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -236,7 +368,7 @@ func templatesHeaderGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x51\xcd\x4e\xf3\x40\x0c\xbc\xf7\x29\x46\xb9\x37\xb9\x7f\xb7\x7e\xa2\x48\x3d\x80\x10\xf0\x02\x56\xd6\xa4\x2b\x6d\x76\xc3\x7a\x11\x05\x2b\xef\x8e\x92\x6e\xaa\x2d\x3f\x12\xaa\xb8\xd9\x1e\x7b\xec\x19\xab\x22\x71\x3f\x38\x4a\x8c\x6a\xcf\x64\x38\x56\xa8\x31\x8e\xab\x95\x2a\xec\x13\xea\x9d\x6f\xdd\x8b\xe1\x9b\x60\xd8\x4d\x75\x40\x75\x3d\x21\xfc\x8c\xfa\x96\x7a\x46\xb5\x19\xec\x3d\xcb\x10\xbc\x70\x85\x71\x6c\x1a\x6c\xee\x76\x4b\x05\x56\x90\xf6\x8c\xb8\xe4\x29\x80\xfc\xd4\x81\x96\x9c\xab\x33\x21\x3b\xe1\x23\xfd\x69\x41\xbd\x93\xed\x61\x08\x31\xb1\xc1\x3a\x43\x40\xd3\x40\x15\x03\x49\x4b\xce\xbe\x73\xbe\x61\x1c\x71\x26\xc5\x84\x56\x52\xb4\xbe\xcb\x6a\x8e\xb3\x99\xd8\x87\x34\x91\xff\x27\xe1\xc7\xb7\x61\x5e\xdb\x34\x90\x57\xea\x3a\x8e\xff\xfa\x59\xa9\xea\x89\xb9\x18\x5e\xae\x2c\xda\x8d\x95\x36\xda\xde\x7a\x4a\x21\x96\x63\x73\x7c\x55\xa2\xd7\x96\x9d\xf9\x44\xe8\x4d\xa9\x3a\xa7\x3f\x85\x85\x40\x69\xf7\xdc\x53\xf1\xab\x48\xbe\x63\xd4\xdb\x43\x8a\xf4\x30\x83\x72\xf6\xae\xd2\xcd\x23\xd9\x37\xdf\xbd\xd4\xdc\x8b\x8d\xfd\x53\x53\xbf\xda\xf6\x5b\x03\x55\x97\x9e\x8f\x00\x00\x00\xff\xff\xea\xef\x8c\xad\x11\x03\x00\x00")
+var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\x3d\x4f\xc3\x30\x14\xdc\xfb\x2b\x4e\xd9\x9b\xec\x6c\x45\x2d\x52\x06\x10\xa2\x88\xfd\xc9\x7e\x4d\x2d\x39\xb6\xb1\x8d\x68\xb1\xfc\xdf\x51\x42\x5a\x5c\xa0\x12\x54\x6c\x7e\x1f\x77\x79\x77\x97\x94\x10\xb9\x77\x9a\x22\xa3\xda\x32\x49\xf6\x15\x6a\xe4\x3c\x9b\xa5\x04\xb5\x41\xdd\x1a\xa1\x5f\x24\xdf\x5a\xc9\x7a\xe8\x03\x29\xcd\x87\x09\x3f\xa3\xbe\xa3\x9e\x51\x2d\x9c\x7a\xe0\xe0\xac\x09\x5c\x21\xe7\xa6\xc1\xe2\xbe\x3d\x74\xa0\x02\xe2\x96\xe1\x0f\x75\xb4\x20\x33\x6c\x40\x90\xd6\xf5\x44\xc8\x3a\xf0\x07\xfd\xf1\x03\x75\x1b\x56\x3b\x67\x7d\x64\x89\xf9\x34\x02\x9a\x06\x29\xc1\x51\x10\xa4\xd5\x1b\x4f\x37\xe4\x8c\x13\x29\xd2\x8a\x10\xbd\x32\xdd\xa4\x66\x84\x8e\xc4\x9f\x3b\xce\x5b\xc7\x3e\xee\x9f\x48\x2b\x49\x51\x59\xb3\xb4\x62\xfd\x33\x4a\x6d\x60\x6c\x44\xdd\x86\x6b\x0a\xfc\xb8\x77\xe3\xb1\x4d\x83\xf0\x4a\x5d\xc7\xfe\xaa\x1f\xfd\x49\xe9\x78\x4f\x01\x3e\x68\x2b\xd6\xa5\x0a\xc2\xab\x5e\x19\x8a\xd6\x97\xb0\xf1\xbd\x2c\xa7\x37\x8a\xb5\xfc\x42\x68\x8e\x8d\xa2\x3c\xf7\x2c\x6c\x09\x62\xcb\x3d\x4d\xea\x86\x84\x3d\x99\x8e\x51\xaf\x76\xd1\xd3\x7a\x1c\x86\x93\x90\xcb\x0c\x72\x3e\xf3\x4f\x5c\x1a\xc9\xdf\xe3\xb8\x38\x8a\x7f\x8d\xe1\xbb\xd1\xbf\xb5\x3c\x25\xb0\x91\xc8\x79\xf6\x3e\x00\x32\xc4\xe0\x15\x79\x03\x00\x00")
 
 func templatesModelGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -251,12 +383,12 @@ func templatesModelGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/model.gotmpl", size: 785, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/model.gotmpl", size: 889, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesModelvalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x50\xbd\x6e\xf2\x30\x14\xdd\xfd\x14\x47\x11\x9f\xf4\x55\x2a\xce\xde\xaa\x13\x30\xb0\x94\x0e\xbc\x80\x8b\x6f\x1c\x8b\xc4\x4e\x9d\x1b\x50\x64\xf9\xdd\x2b\x1b\x5a\x91\x6e\x57\x3e\xbf\x3e\x75\x8d\x8d\xd7\x04\x43\x8e\x82\x62\xd2\xf8\x9c\x61\xfc\x7a\xbc\x2a\x63\x28\xbc\x62\x7b\xc0\xfb\xe1\x88\xdd\x76\x7f\x94\x42\x88\x18\x61\x1b\xc8\x8d\x1f\xe6\x60\x4d\xcb\x58\xa7\x54\xd7\x88\x11\x27\xdf\xf7\xe4\xf8\x0f\x16\x23\xc8\x69\xa4\x24\x84\x18\xd4\xe9\xac\x0c\x21\x46\xf9\x71\x3b\xf3\x73\x5d\xe3\xd8\xda\x11\x8d\xed\x08\x57\x35\x2e\xab\x70\x4b\xb8\x77\x01\x7b\xdf\xc9\xcc\xdf\x69\xcb\xd6\x19\xf0\xaf\xae\x2f\x79\x43\xf0\x17\x42\x33\x71\xb1\x6a\xc9\x61\xf6\x13\x02\xad\xc3\xe4\x16\x4e\x3f\x11\xa5\xb4\x72\x5a\x08\xdb\x0f\x3e\x30\xfe\x0b\x20\xc6\xa0\x9c\x21\xc8\x2d\x35\x6a\xea\x78\x5f\xa0\x31\xff\x65\x08\xd6\x71\x83\xea\xdf\x57\x05\x99\x52\x21\x93\xd3\xf7\xeb\x26\x5b\x9d\x69\x7e\xc6\xea\xa2\xba\x89\xf0\xf2\x06\xf9\xa0\xcf\x58\x4a\x79\xad\x47\xa7\x1b\x77\x61\xf7\x54\x96\x66\xea\x87\x2e\xd7\xac\xc6\x53\x4b\xbd\xba\xa8\xce\x6a\xc5\xd6\xbb\xb1\x82\xcc\xa3\x7e\x07\x00\x00\xff\xff\xca\x6e\x42\x61\xbf\x01\x00\x00")
+var _templatesModelvalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\xc1\x6e\xea\x30\x10\x45\xf7\xfe\x8a\xab\x88\x27\xbd\x4a\xc5\xde\xb7\xea\x0a\x58\xb0\x29\x5d\xf0\x03\x53\x3c\x71\x2c\x12\x3b\x75\x26\xa0\xc8\xf2\xbf\x57\x04\x5a\x41\x77\x23\xdf\xb9\xc7\x47\x63\x0c\x56\xd1\x32\x1c\x07\x4e\x24\x6c\xf1\x39\xc1\xc5\xe5\x70\x26\xe7\x38\xbd\x62\xbd\xc3\xfb\x6e\x8f\xcd\x7a\xbb\xd7\x4a\xa9\x9c\xe1\x6b\xe8\x55\xec\xa7\xe4\x5d\x23\x58\x96\x62\x0c\x72\xc6\x21\x76\x1d\x07\xf9\x93\xe5\x0c\x0e\x16\xa5\x28\xa5\x7a\x3a\x1c\xc9\x31\x72\xd6\x1f\xd7\xf1\xf2\x6c\x0c\xf6\x8d\x1f\x50\xfb\x96\x71\xa6\xe1\x51\x45\x1a\xc6\xcd\x05\x12\x63\xab\x95\x31\xd8\x58\x2f\x3e\x38\xc8\x6f\xaf\x9b\x5d\xfa\x14\x4f\x8c\x7a\x94\x19\xd5\x70\xc0\x14\x47\x24\x5e\xa6\x31\x3c\x90\x7e\xbe\x98\xa5\x29\x58\xa5\x7c\xd7\xc7\x24\xf8\xaf\x80\x9c\x13\x05\xc7\xd0\x6b\xae\x69\x6c\x65\x3b\x47\x43\x29\x39\xf7\xc9\x07\xa9\x51\xfd\xfb\xaa\xa0\x4b\x99\x97\x39\xd8\xdb\x74\xad\x2d\x8e\x3c\x3d\x63\x71\xa2\x76\x64\xbc\xbc\x41\xdf\xf5\x2f\x59\x29\x97\x6b\xdd\x93\xae\xbb\x0f\xb8\xa7\xf9\xd2\xc2\x5d\xdf\x92\x30\xaa\xe1\xd0\x70\x47\x27\x6a\xbd\x25\xf1\x31\x0c\x15\x34\x4a\x51\xdf\x03\x00\xca\x6e\x42\x61\xbf\x01\x00\x00")
 
 func templatesModelvalidatorGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -276,7 +408,27 @@ func templatesModelvalidatorGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemaGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\x98\x0b\xd2\x40\x0a\x1c\xf9\x10\xdc\x53\x0e\x79\x48\xda\xbb\xad\x17\x68\xbb\x48\xba\xdd\x87\xa0\xd8\xd2\xd2\x28\x66\x2b\x91\x0a\x49\x39\xf5\x0a\xfa\xee\x0b\x92\xfa\x6f\x49\xb6\x93\x62\xd1\x62\xf7\x4d\x96\x86\xc3\x99\xdf\xfc\xe1\x8f\xe3\x3c\x07\x1a\x81\xbf\x60\x41\x9c\x85\xf8\x86\x87\x18\xc3\x59\x51\x38\x00\xf6\x0b\x61\x21\xf8\x0b\x79\x4d\x24\xbe\xdf\xa4\xa8\x9f\xff\xf7\x35\xe5\x42\x61\xa8\xe5\x94\x7e\x97\xe7\x90\x12\x19\x90\x98\xfe\x81\xe0\xbf\x25\x09\x42\x51\x00\x65\x0a\x45\x44\x02\x84\xdc\x01\xd0\xfa\x68\x04\x5c\x80\x7f\x83\x0f\x19\x15\x18\x82\xff\x9a\xc8\x0f\x24\xa6\x21\x51\x94\x33\x09\x45\x21\x32\xa6\x68\x82\x7e\xf9\x96\x2c\x63\xcc\x73\x40\x16\x82\x31\xc9\x18\x25\x08\xbb\x47\xf0\xaf\xe2\xf8\x5d\x54\xbd\xae\xac\xf5\x17\xf2\x8a\x71\xb6\x49\x78\x26\x9b\x6f\xed\x65\xbf\x08\x9e\xa2\x50\x14\x3b\xdf\xab\xf5\xc7\xfe\x42\xbe\xcf\xd2\x58\x3b\x90\xe7\xa0\x30\x49\x63\xa2\x10\x8e\x94\x7e\x19\x51\x8c\xc3\x85\x76\xe9\x08\x7c\x2b\x81\xb1\xb4\xb2\x8d\xa8\x54\x22\x0b\xd4\x90\x6c\xe3\x46\xb9\xe3\xd6\x9b\x33\xe3\xc4\x6b\x22\xaf\xc2\x90\x6a\x50\x48\x3c\x6e\xb0\x15\x1e\x94\x3c\xeb\x88\x02\xcc\xe7\x46\xbe\x31\x32\xe4\x81\x54\x82\xb2\xfb\xa3\x11\x0d\x3d\x05\xdd\xd5\xa9\x95\xdb\x34\xc1\x7b\xc5\x83\xdb\x29\x7d\x45\xd1\xcb\x92\x21\xa1\x2a\x75\x5c\x0f\x12\x92\xde\x59\x03\x3f\x76\xe2\x20\x83\x15\x26\x44\x67\xe2\x5e\x86\xe7\x39\xb2\x70\x0b\xf3\xee\x8b\x2e\x88\x0b\x85\x49\x1f\xbf\x3d\xd1\xb3\x4b\xb7\x82\x74\x38\x6c\x46\xd1\x04\x62\xe6\x7b\x0b\xac\xbb\xbd\x30\xda\x32\xaf\x9f\x80\x4d\x3a\xb7\x45\xfc\x9f\xb8\x29\xfc\x8e\x58\xa7\x20\xeb\x5f\x79\xbe\x55\x64\x8d\x54\xaf\xba\x1a\x65\x3b\x8a\xcc\x19\x34\x6d\x77\xc1\x0d\x58\x57\x85\xbe\x0c\xfa\x8e\x32\xdb\xb3\xc0\x9e\x51\x5a\x3f\x5c\x51\x75\xe1\xec\x3c\x4f\x55\xd1\x53\xea\xe7\x3b\xad\x9c\xc6\xeb\xc2\x71\x00\xaa\xe3\x2f\x20\x09\x76\x4f\xbf\x01\xbd\xd7\x3c\xdc\x94\xb9\xe9\x4c\x1f\x49\x51\xc6\x02\x70\xf3\x1c\x8e\xfd\x1b\x0c\x90\xae\x51\x68\xbd\x45\x01\xa7\xed\xcd\x8e\x7d\xfb\xd6\x1b\x38\x81\x8d\x7b\xe3\xce\xe9\x13\xa9\x73\x72\xe2\x03\x1c\xfb\xaf\xa8\x0c\x04\x4d\x28\x23\x8a\x8b\xff\xeb\x82\xaa\x1d\x12\xa8\x32\xc1\xcc\x46\x82\x32\x15\xc1\xd1\x8b\x87\xa3\xfe\x92\x0f\x24\xce\xb0\x73\x30\x36\xcb\xba\xae\x40\x51\xf8\x79\xde\x85\xad\x28\xcc\x96\xed\x9e\xfd\x44\x38\x6e\x51\x0d\x22\xb2\x26\xf1\x34\x26\x1e\x74\x51\x61\x38\x8d\xca\x21\x7e\xc1\x25\xac\x49\xdc\xf7\xae\x5b\x46\x25\xe1\x72\x19\x57\x9a\xcb\x2c\x2a\x0a\xe5\x81\x3b\xc5\x9c\xbc\x56\x81\xf6\x9d\x5b\x5b\x31\x2e\xea\xb6\xd8\x6c\xe9\xcc\xe7\xf0\x2b\x4b\x88\x90\x2b\x6d\xd9\x00\x8b\xbb\x8d\x69\x80\x90\x55\x32\x12\x52\x1e\x6f\x12\x2e\xd2\x15\x0d\x40\xea\x8f\x12\x78\x34\xcc\x00\x1d\x13\xb7\x3d\xf4\xbb\x02\x49\x88\x02\x28\xf7\x6f\xcc\xd3\x0c\x02\xce\x64\x96\xa0\x80\x8a\x11\xbe\x2c\x5f\x78\xe0\x9a\xa2\xdd\x56\x35\x03\x14\x82\x0b\x1b\xc2\x35\x11\x80\x31\x26\xc8\x94\x84\xbb\x8f\x9f\x25\x67\xfe\x0d\x79\x7c\x83\x52\x92\x7b\x74\xc0\xa4\xbc\x10\x70\x71\x59\x6f\x55\x6d\x51\x5a\x33\x83\x93\x4a\x81\xf7\x5f\x23\xfb\xaf\x4b\x60\x34\x2e\x33\xa4\x4c\x6c\x46\x63\xb3\xaf\xa3\xa3\x59\xee\x2b\x50\x66\xb1\x82\x11\x33\x1d\x80\x88\x0b\xf8\x7d\x56\xd9\xa7\x6d\xb0\x9d\xa0\x36\xd8\x6e\xc1\x97\x9f\x67\x95\x91\xd9\x24\x8a\x6e\xb9\xb2\xc1\xcd\x33\x1a\x4a\x27\x3b\x86\x0f\x99\x5e\x15\x5a\x69\xf9\x25\x90\x34\x45\x16\xba\xf6\xf7\x4c\x5b\xe2\x39\x56\xa8\x5c\x0c\xd5\x27\x46\x63\xdd\x0a\x77\x67\xd2\x58\x12\x3d\x39\x75\x0e\xcc\x9a\xdd\x39\x33\x9f\xc3\x23\x02\x43\x0c\x41\x71\xd0\xda\x41\xad\xa8\x04\xf5\x48\x03\x9c\x81\xe4\x10\x51\x21\x95\xbe\xd8\x70\x20\xb0\xcc\xa2\x08\x35\x7a\xfa\xa2\x52\x07\x8a\xf2\x4c\xd1\xd8\x58\x74\x15\xc7\xa5\x8d\x9e\x33\x1c\x8b\xa1\x24\x6a\x20\xde\x11\x73\xbb\x6d\x13\xf0\xc2\xb1\xa8\xed\xb1\x0c\xee\x3e\x2e\x37\x0a\x9f\x0b\xd8\x32\x8b\xb4\xcb\x5a\x95\xf4\xdf\xe2\xe3\xb5\x41\xc4\xec\xe0\x35\xac\xa0\xd5\x3e\xcd\x09\xa7\x97\x9d\x8f\xae\x6b\x77\x27\x13\x12\xb5\xc2\x12\x77\x6d\xa0\x8d\x08\x95\x36\x3c\x3a\x38\x1c\x22\x54\xc1\xca\xc8\xad\xcd\xf9\xc3\x23\xf3\x43\x73\xd7\x81\xd6\x5d\x14\x50\xb1\x09\xbf\x2c\xd8\x7b\x54\x86\xe2\x5a\x22\x09\x79\x2f\x27\x87\x95\x58\x02\x03\x9f\x74\x6b\xb9\xe8\x1d\x8b\xc3\x4b\x3e\x99\xe8\x4e\x34\x9e\x65\x16\xcd\xe0\xa4\xb4\xe6\x80\xa6\xd3\xa8\x2c\x9b\x3d\xd6\x27\x85\xe5\x48\xee\x5e\xf6\xcd\xe0\x68\xa9\x29\xca\xac\x02\xc4\xdf\x03\x87\x03\xcc\x9c\xcf\xe1\x7d\x3b\x48\xe3\x01\xa2\x12\x32\x69\xcb\x30\x44\x85\x22\xa1\x0c\xe1\x71\x45\x75\x98\x75\xa0\x14\x87\x40\xa0\x3e\xe4\xf4\x69\x59\x27\xbc\x09\xbb\xc9\x6f\x5d\xa2\x0e\x80\x7c\xa4\x3a\x35\x0e\x70\xc7\x06\xdf\xb6\xe3\xe3\x2f\x33\x38\x5e\x6b\x58\xb7\x72\xd8\xb8\x19\x10\x89\x5b\x84\xe8\x0b\x14\xc5\x45\xd9\x68\x5b\x87\x41\x4d\xb2\xdc\x2c\x4d\x51\x80\xdb\x18\x62\x69\x8b\xe7\x55\x9f\x8e\xd7\x9e\x65\x16\x3d\x62\xd3\x1b\x38\x68\xd3\xb6\xc7\x0a\xd3\xd9\x75\x3e\x83\x13\x6b\xd0\x50\xd8\xc6\xce\x86\xea\x74\xa8\xbf\x9e\xb4\x9b\x7f\xff\x5e\xd0\xea\x60\x95\x16\x2e\x4c\x99\xbb\xff\x39\x3f\x9f\xc1\x11\x65\x26\x4b\x27\xc2\x6f\x32\xe4\x02\x5e\x3c\x1c\x98\x8a\x8e\x53\x38\x15\x44\xed\x19\x96\xa6\x4e\x0b\xf9\x92\x27\x69\x8c\x5f\xdf\x2d\x3f\x63\x60\xd8\x95\xbd\x8a\xfa\x8b\xe1\x5b\xe0\x59\xe7\xea\x5a\x45\xa0\x22\xfc\x9a\x1b\x5a\x8e\x56\x4f\xc3\x4c\x30\x06\x23\xb5\xdd\x46\x6b\xc0\xea\x87\x1d\x17\x06\xd8\x39\xc4\xaa\x07\x60\xf5\xa8\xae\x75\x8f\x9f\x66\xd0\xfd\x5c\x7c\xee\x8d\xe2\x7b\xbf\x55\xb4\x53\xfa\x29\xd8\x7c\x83\xeb\xc5\x5f\x75\xc5\x68\xbb\x3a\x3e\xb8\x29\xaf\x1e\xfe\x2b\x8c\x48\x16\xab\x7a\x6d\x05\xcd\x34\x30\x95\xa5\x5e\x43\xd8\x7e\xbe\x7d\xf7\xd6\x5d\x96\x34\xc3\xb3\x3d\xa0\xe5\xfb\xf8\xd4\xf8\x2a\xa6\x44\x8e\x70\xc2\x6a\xb5\x6e\xab\x6a\x62\x79\x2d\xd8\x34\x43\xc3\xff\x6b\xeb\x5c\x6b\x97\x9b\xe7\xed\xac\x73\xb5\x50\x8d\x81\x57\x14\xde\x0c\x4e\x46\x1b\x65\xdd\xe4\x9a\x2e\xd9\x4e\xaa\xd1\xad\x97\xcf\x50\x7a\xba\x1d\x89\xcb\x61\x1c\x5c\xe5\x0d\xb4\xf4\x4e\x37\xef\x8c\xc3\x5a\x2d\x73\x2a\x3a\xbd\x71\xdd\x26\xc5\xdb\x5e\x86\x37\xd7\xcb\x76\x03\xf6\x17\xf2\x36\x5b\xb6\x9b\xd2\xd4\x1c\x7f\x72\x52\x3f\xd4\xe6\xaa\x34\xfd\xa7\xb9\x8d\x36\xb7\xbf\x6f\x6f\x6b\x9e\xf7\x19\xfb\xee\x35\xf4\x7d\xf2\xc8\xf7\x47\x1a\xf8\x96\x48\x6f\xc1\x37\x3c\xe4\x35\x90\x1c\x32\xe1\xfd\x2e\xe7\xbb\xdb\xb4\x6c\x60\xf9\x2d\x0a\x6a\x36\x16\xbd\x7f\xf6\xba\x7f\xa5\x7e\xa8\xe6\x6e\xe6\xcb\xc4\x60\xaf\x7c\x55\xf5\xb3\x1d\xa3\x3e\xf3\xbb\x91\xb5\x46\xef\x9a\xf9\x55\xcd\x85\x46\x70\xaf\xc0\x8d\x91\x95\x7d\xd7\x83\x7f\x1f\xae\x42\x1b\xec\x5a\x4e\x5d\xfb\x61\x5a\xbc\x12\x48\x92\xae\x2f\x45\x31\x9f\x43\x69\x3e\xd6\xd7\x53\x69\xaf\xf1\x79\x0e\xab\x2c\x21\x6c\x7b\xf2\x33\xc0\x39\xda\x54\xbe\x66\xee\x5b\x9c\x7e\x24\x99\x4f\x7b\x41\xfa\x26\xdc\xdd\xab\x1d\x73\x23\x2e\x12\xa2\x24\x48\x25\xa2\x44\xf9\x37\x78\x4f\xa5\x12\x9b\x36\xf3\x69\x1d\xc3\x03\xf4\xbf\x5f\x66\x75\xb2\xec\x09\xb4\x57\x09\x1e\x06\x8d\x49\xa0\xf9\x1c\xde\x58\x76\x72\x4d\x19\x11\x9b\xd6\x3f\xf7\x54\xeb\x48\x90\x29\x93\x7c\xa3\xb1\x19\xe3\x83\x1d\xb5\xae\x19\xdd\xda\xb9\x53\x6b\x88\x44\xed\x20\xa0\x77\x0a\xc0\xe5\xd8\x40\xc1\xf2\x98\xd6\x2d\x53\x3e\x92\x7b\xff\x37\x41\x15\x1a\xd6\x39\xa0\xcc\xcc\xc6\xda\x03\xca\x6f\xed\x67\x4f\xf1\x20\xf3\x2d\x87\x01\xa3\xdc\xb6\x61\x8c\xc6\xa1\x1b\x24\xa1\x65\xd1\xf6\xce\x3e\x31\x67\x69\x26\x87\xa7\x83\x48\xea\x6d\x7b\x09\xe8\xb4\x48\x9a\xf3\x67\x00\x00\x00\xff\xff\xb1\x0d\xa4\x52\x03\x22\x00\x00")
+var _templatesOneofGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5d\x6b\xe3\x46\x14\x7d\xd7\xaf\x38\x15\xd9\x20\x65\x15\xb9\x5d\xfa\x94\x92\x97\x52\x28\x2d\x6c\x02\x49\xda\x3e\x94\xc2\x5e\x4b\x57\xd6\x94\xd1\xc8\xcc\x8c\x9c\xba\x83\xfe\x7b\x19\x69\xfc\xb9\xb2\xd7\x0d\xfb\x14\x87\xfb\x7d\xe6\x9c\x7b\xe5\x1c\x4a\xae\x84\x62\xc4\xad\xe2\xc7\xea\x65\xbd\x64\x13\xa3\xef\x9d\x83\x26\xb5\x60\x5c\x89\x0c\x57\x2b\xdc\xdd\x23\x7f\xf4\x1e\xa3\x4d\x54\xb8\x12\xe8\xfb\x0c\xce\x81\x55\x89\xbe\xbf\x71\x0e\x57\xab\xfc\xe7\xd6\xe7\x18\xbd\x46\xc3\xf6\x47\x74\x5c\xed\xb9\xa8\xb9\x21\x5f\x2e\x72\xee\x16\xa2\x42\xfe\x8b\x2a\x64\x57\xf2\xc7\xb6\x64\x89\xdb\xbe\x8f\xac\xcf\xe6\x1c\x96\x64\x0a\x92\xe2\x5f\x46\xfe\x40\x0d\xa3\xef\x61\xac\xee\x0a\x0b\x17\x45\xc0\x6c\x86\x56\x31\xda\xca\x37\x64\xb9\x59\x4a\xb2\x47\x43\xe5\xbe\x0e\x30\x4e\x31\x99\x71\x34\x7d\xfa\xdb\xb4\xea\x2e\xbe\x8d\x3f\x45\x7d\x14\xcd\x66\xe7\x7c\x85\x81\x68\x96\x92\x1b\x56\x96\x4b\xcc\xd7\xb0\x35\x63\x45\xb2\x63\x03\x9a\x8e\x44\x41\x0a\x75\x2b\xcb\xbb\x2f\xf4\x7a\x7a\xf4\x50\x5c\x59\xd6\x15\x15\x0c\x17\x01\xbf\x93\x14\x25\x59\x4e\xaa\x56\x37\x64\x8d\x87\xa7\x6a\x6c\xfe\xc4\x0b\x61\xac\x5e\xa7\x60\xad\x5b\x1d\x66\xfa\x4d\x35\xa4\x4d\x4d\xf2\xd7\xe7\xc7\x07\x74\x9b\xff\x0c\x6c\x2d\x8c\x2f\x5a\x77\x0d\xa9\x83\xb6\x85\xb2\xed\x30\xde\x25\x40\x0f\x8e\x25\x59\x82\x30\x1e\x0f\x51\x82\x16\x24\x94\xb1\x99\x87\x74\x6b\x3d\x30\xc1\xf0\x8a\x35\x49\xff\x8c\xb6\xe6\xc6\xc7\x52\x33\x17\x8b\xae\xed\x4c\x54\x75\xaa\x40\xe2\x1c\xf2\x27\x2e\x58\xac\x58\x6f\x3a\xbb\x99\x04\x29\x3d\x1c\x32\xd1\xf4\x8a\x3f\xff\x9a\xaf\x2d\x07\x28\x06\xd8\x26\xf2\x05\xa2\xdf\x43\x09\x19\xc1\xd3\xd2\x58\x2d\xd4\x22\xf1\xb1\x26\x7f\xd1\xa2\x79\x5e\x52\xc1\x3e\x63\x9a\xe2\xfe\x1e\xb1\xea\xa4\x8c\x87\x7c\x80\x66\xdb\x69\x15\x82\x3d\xe3\x56\xa4\xd1\x90\x2d\x6a\x2e\x21\x94\x1d\x8b\x8e\xea\xda\x6a\x2a\xb8\x39\xe7\xff\x28\x6a\x76\x63\x78\xe7\x9d\xaa\xc6\x7e\x58\x6b\xaf\x48\xf3\x4a\x8b\xfc\x89\xa9\xdc\xcc\x97\xe1\x7a\x22\x43\xfa\x83\x9f\xd7\xf7\xa9\x84\xc4\xf5\x35\x26\x7c\xf2\x2d\x7d\x02\x6d\x7e\xe2\x8a\x3a\x69\xd3\x4d\xd8\x38\x9b\xd7\xf8\x49\xb8\xa6\x6a\x0f\x51\x61\xf8\xf7\xef\x03\x20\xbb\x8d\x00\x98\x57\x61\x8b\x7a\x8b\x8f\x2f\x53\x90\x61\x7c\x77\xf7\x39\x98\x83\xe1\xdb\x03\xc3\xf0\x90\x26\x7f\xe0\xd7\xe4\xfb\x0f\x1f\x32\xc4\xce\x6d\x6b\x43\x28\xcc\xdb\x72\x8d\xa6\x33\x16\xf3\xcb\x88\x1b\xa7\xbb\x26\xbf\x40\x8c\xb7\xb4\xc0\xff\x50\x61\xe5\xfa\xa2\x56\x32\x08\xfb\x99\x7c\xf0\xae\xdc\xc8\x23\xce\x36\xb0\xa5\x41\xd5\x1f\xf7\x34\xbd\xa7\xe8\xb0\x91\x50\xb3\x0c\x3b\xea\x84\xc6\xcf\x68\xec\x84\xc4\xf6\x2a\x26\x29\x92\x51\x5f\xd9\x88\x49\x3a\x08\x42\x54\xe7\xa0\xdc\x27\x57\xc0\x73\xcc\x91\x8c\x9a\x4a\xb3\x00\x76\xbf\x03\xdc\xef\xe7\x3c\x14\x9e\xea\x75\x7c\x26\x8f\xc9\xfe\xe9\x39\xb8\x2f\x81\xed\xad\xf6\xb6\xd9\x6c\xbb\x3c\x3d\x50\xc3\x8f\xaf\x05\xdb\xa9\xd5\x74\xe1\xb6\x86\xdb\x49\xe4\xe4\xa4\x79\xe2\x2f\xc5\x88\xf6\x20\x11\x25\xe4\x29\xf5\x9c\x25\xdc\x41\xd0\xe9\x72\xc7\xbd\x6f\x14\xf3\x16\x3d\x5c\xa6\x03\xd5\x5a\xbc\x7b\x89\x33\xbc\xed\xb5\xc7\xaf\x89\xf1\xa5\x03\x6f\x7e\x14\x8a\xf4\x7a\xef\x84\x6e\xcf\x38\x59\xd1\xaa\x37\xbc\xe8\x41\xe2\xff\xa5\x85\x49\x15\x28\x21\x27\xa8\x3f\x6c\xfc\x3f\xb4\xb0\xec\x35\x3e\xd5\x5f\x7a\x7c\xe0\xbf\xfe\xa4\x47\xa9\x93\xf9\xc4\x59\xf5\x37\x4f\xb3\x99\xfe\x7c\x39\x73\xc4\xe6\x19\xae\x35\x9b\x70\xb2\xbe\x99\x00\x86\xb5\x0e\x98\xdc\x4c\x35\x7d\x0f\xcd\x66\x07\x98\x47\xf0\x98\x19\xac\x4a\xf4\x7d\xf4\xdf\x00\x2e\xf9\x12\x65\xfc\x0a\x00\x00")
+
+func templatesOneofGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesOneofGotmpl,
+		"templates/oneof.gotmpl",
+	)
+}
+
+func templatesOneofGotmpl() (*asset, error) {
+	bytes, err := templatesOneofGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/oneof.gotmpl", size: 2812, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSchemaGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\xdd\x6f\xdc\xb8\x11\x7f\xd7\x5f\x31\x35\x7c\x81\x64\x6c\xb4\x45\xd0\xa7\x14\x7e\x70\x2e\xfd\x70\x81\x24\x85\x37\xbd\x3e\x18\x41\x8f\x2b\x8d\x6c\xe6\x24\x71\x43\x52\xeb\x73\x05\xfe\xef\xc5\x90\x94\x44\x69\xb5\x1f\x76\x82\x5e\x80\x7b\xd3\xf2\x63\x38\xf3\x9b\x0f\xfe\x38\xdb\xb6\xc0\x0b\x48\x3f\xd4\xf8\xa1\x00\x63\xda\x16\x34\x56\x9b\x92\x69\x84\x33\x41\x83\xab\xec\x1e\x2b\x76\x06\xa9\x9b\xc5\x52\x21\xbc\x34\x26\xf2\x1b\xaf\xeb\xac\x6c\x72\x7c\x27\x72\x2c\xed\x38\x80\x9b\x61\x75\x0e\xe9\xb5\x7a\xc3\x14\x7e\x7c\xdc\x20\x7d\xff\xe5\xd7\x8d\x90\x1a\x73\x5a\xa7\x69\xac\x6d\x61\xc3\x54\xc6\x4a\xfe\x5f\x84\xf4\x3d\xab\x10\x8c\x01\x5e\x6b\x94\x05\xcb\x10\xda\x08\x80\xe4\xf1\x02\x84\x84\xf4\x06\xbf\x34\x5c\x62\x0e\xe9\xdf\x99\xfa\x89\x95\x3c\x67\x9a\x8b\x5a\x81\x31\xb2\xa9\x35\xaf\x30\xf5\xa3\x6c\x5d\x62\xdb\x02\xd6\x39\x58\x95\x48\x08\x48\x56\xdf\x21\xa4\x57\x65\x69\x4d\xb5\xc3\x9d\xb6\xe9\xb5\xba\xaa\x45\xfd\x58\x89\x46\x0d\x73\xe1\xb6\x7f\x4a\xb1\x41\xa9\x39\x8e\xe6\xbb\xfd\xe7\xe9\xb5\xfa\xd8\x6c\x4a\x9c\x62\xa8\x69\xb0\xe0\x58\xe6\xd7\x64\xd2\x18\x47\xfa\x1a\x96\x2a\x2d\x9b\x4c\xcf\xad\xad\xf3\x89\x4e\x3b\x23\x2f\x49\x09\x82\xe5\x2a\xcf\x39\x81\xc2\xca\xfd\x0a\xbb\xc5\xb3\x2b\x5f\x8e\x96\x02\x2c\x97\x56\xf8\xa0\x64\x2e\x32\xa5\x25\xaf\xef\xce\x20\x3d\x7e\x16\x4c\x76\x6f\x9c\x4e\x8f\x83\xf3\xde\x8a\x6c\x75\x48\x9e\x31\x93\x28\x99\x5b\xd4\x85\x4e\x9c\x40\xc5\x36\xb7\x4e\xc1\x4f\x23\x3f\x28\x1b\xc6\x14\x89\x27\x29\xde\xb6\x58\xe7\xc1\x88\xc3\x7c\x3c\x30\x06\xf1\x5a\x63\x35\xc5\xef\x44\xf4\xdc\xd6\xd1\xce\xe7\xc1\x66\x05\x1d\x40\xcc\xce\x07\x60\xdd\x9e\x84\xd1\x8e\x7a\xd3\x00\x1c\xc2\x39\x5c\x92\xfe\x4d\x90\xa8\xf1\xb2\x61\x5b\xf8\xab\x6d\x77\x92\x6c\x58\x35\xc9\xae\x41\xd8\x91\x24\x8b\x66\x55\x3b\x9e\x70\x33\xda\x75\xae\x6f\xdb\x53\xd2\xec\xc4\x04\xfb\x8a\xd4\x7a\x5e\x74\xfc\x86\x49\x35\x86\x73\xf4\x7d\x28\x8b\x9e\x93\x3f\xdf\x69\xe6\x0c\x56\x9b\x28\x02\xe8\xae\xbf\x8c\x55\x38\xbe\xfd\x66\xe4\xbe\x11\xf9\xa3\x8f\xcd\xe8\xf0\x95\x54\x34\x75\x06\x71\xdb\xc2\x79\x7a\x83\x19\xf2\x2d\x4a\x92\x6b\x0c\x5c\x84\x87\x9d\xdb\x1a\x60\x4c\x32\xb1\xd7\x8d\xc6\x09\xec\x37\x8e\xee\xb7\x3e\x0a\x29\x1b\xf0\x0b\x9c\xa7\x6f\xb9\xca\x24\xaf\x78\xcd\xb4\x90\x7f\xa5\x44\xec\x0d\x92\xa8\x1b\x59\xd3\xe2\x8d\xe4\xb5\x2e\xe0\xec\x87\x2f\x67\xd3\x2d\x3f\xb1\xb2\xf1\x57\xa7\x4f\xd7\x61\xdb\xd8\x14\x30\x26\x6d\xdb\x31\x6c\xc6\xd8\x23\xc3\x9a\xfd\x4c\x38\x56\xa8\x67\x11\xd9\xb2\xf2\x30\x26\x09\x8c\x51\xa9\xf1\x30\x2a\x4f\xb1\x0b\x2e\x61\xcb\xca\xa9\x75\xe3\x34\xf2\x84\x2b\xae\x85\x26\xa6\x75\xdd\x51\xa8\x04\xe2\x43\xcc\x29\x19\xb2\x66\xc7\xb8\xad\x5b\x26\x64\x5f\x16\x87\x23\xa3\xe5\x12\xfe\x55\x57\x4c\xaa\x7b\x56\xee\x22\x06\xc6\xac\x4a\x9e\x21\x34\xdd\x1a\x05\x1b\x51\x3e\x56\x42\x6e\xee\x79\x06\x8a\x26\x15\x88\x62\x26\xfe\x48\xbc\xf5\xdb\x09\xf2\x63\x89\x2c\x47\x09\x5c\xa4\x37\xf6\x6b\x01\x99\xa8\x55\x53\xa1\x84\x8e\x11\xfe\xe8\x07\x12\x88\x6f\x3f\xcd\x8a\x5a\x00\x4a\x29\xa4\x73\xe1\x96\x49\xc0\x12\x2b\xac\xb5\x82\xdb\x4f\x9f\x95\xa8\xd3\x1b\xf6\xf0\x0e\x95\x62\x77\x18\x81\x0d\x79\x29\xe1\xf5\x65\x7f\x54\x77\x84\xd7\x66\x01\x2f\x3a\x01\xc9\x9f\x49\x34\xfc\xe1\x12\x6a\x5e\xfa\x08\xf1\x81\x5d\xf3\xd2\x9e\x1b\x91\x37\xfd\xb9\x12\x55\x53\x6a\xd8\xa3\x66\x04\x50\x08\x09\xff\x59\x74\xfa\x91\x0e\xae\x12\x74\xe7\xf9\x23\xc4\xfa\xf3\xa2\x53\xb2\xf7\xc0\xac\xcc\xd8\xef\x1c\x70\x4b\xac\x04\x5e\xec\x2a\x3e\xa7\x7a\x97\x68\x5e\xf3\x4b\x60\x9b\x0d\xd6\x79\xec\x7e\x2f\x40\xac\x3f\x93\x40\x13\xf5\x9b\xfd\xd2\x05\x49\x89\x4c\x74\x42\x24\xed\x0b\xa2\x67\x87\xce\x13\xa3\xe6\x78\xcc\x2c\x97\xf0\x80\x50\x23\xe6\xa0\x05\x90\x74\xd0\xf7\x5c\x81\x7e\xe0\x19\x2e\x40\x09\x28\xb8\x54\x9a\x1e\x36\x02\x18\xac\x9b\xa2\x40\x42\x8f\x1e\x2a\xbd\xa3\xb8\x68\x34\x2f\xad\x46\x57\x65\xe9\x75\x4c\xa2\x79\x5f\xec\x7a\x22\x84\xf8\x88\xcf\xdd\xb1\x83\xc3\x4d\xe4\x50\x3b\x61\x1b\xdc\x7e\x5a\x3f\x6a\xfc\x5a\xc0\xd6\x4d\x41\xc1\x4b\xa2\x54\xfa\x1e\x1f\xde\x58\x44\xec\x09\xc9\xc0\x0a\x82\xf2\x69\xc9\x15\x01\xf7\x6a\xef\xbe\xa0\x20\x3a\x97\xe8\x7b\xf4\xb8\x93\x82\xce\x23\x5c\x39\xf7\x90\x73\x04\x14\xa8\xb3\x7b\xbb\x6e\x6b\xef\x1f\x51\xd8\x1f\x6d\x0b\x73\xa5\xdb\x18\xe8\xd8\x44\xea\x13\xf6\x0e\x35\x5d\x01\xe0\x5e\x6e\xd0\x4e\x62\x72\x5e\x88\x23\x30\xf0\x33\x95\x96\xd7\x93\x6b\x71\xfe\xdc\x9f\xc1\x0c\x71\x30\x57\x78\xd6\x4d\xb1\x80\x17\x5e\x9b\x27\x14\x9d\x41\xa4\x2f\xf6\xd8\xdf\x14\xee\x51\x16\x9f\xa4\xdf\x02\xce\xd6\x44\x51\x16\xe0\x55\x48\x4f\xc0\xe1\x09\x6a\x2e\x97\xf0\x31\x74\xd2\x7e\x07\x71\x05\x8d\x72\x69\x98\xa3\x46\x59\xf1\x1a\xe1\xe1\x9e\x93\x9b\xc9\x51\x5a\x40\x26\x91\x2e\x39\x6a\x4f\xf4\x01\x6f\xdd\x4e\x51\x64\x53\x34\x02\x50\x0f\x9c\x42\xe3\x09\xe6\x38\xe7\xbb\x72\x7c\xfe\xcb\x02\xce\xb7\x04\x6b\xb8\x76\x60\x69\x19\x53\xb8\x43\x88\x7e\x01\x63\x5e\xfb\x42\x1b\x5c\x06\x3d\xc9\x8a\x9b\xcd\x06\x25\xc4\x83\x22\x8e\xc5\x25\x49\x37\x75\xbe\xa5\xeb\x7c\x97\xd8\x8c\x78\x15\x11\x8f\xad\x1f\xe9\xe8\x03\xc0\xb1\xe8\x7a\xb5\x80\x17\x4e\xa1\x39\xb7\xcd\xbb\x6e\xb8\x1d\xfa\x59\x2f\xc3\x15\x7f\x80\x90\x51\x8c\x2a\x58\x27\x45\x48\x9b\xe6\xf1\x9f\x5e\xbd\x5a\xc0\x19\xaf\x6d\x94\x1e\x70\xbf\x4d\xe3\xd7\xf0\xc3\x97\x27\x86\x62\x14\x99\x28\xec\x6d\x75\x25\x88\xa8\xd3\xb5\xfa\x51\x54\x9b\x12\x7f\xfd\xb0\xfe\x8c\x99\x65\x57\xae\xd1\x43\x2d\xa3\xb9\x27\x4f\xf7\x78\xf1\x55\xcc\x7b\xa0\x23\xfc\xc4\x0d\x85\x1e\x75\xc3\xac\x33\x66\x3d\x15\x28\x1e\x4c\xd7\x7e\x8b\xab\x76\x47\x1e\x0c\x00\x87\x5f\x0c\xbd\x9e\x41\xab\xae\x9f\x3a\xc6\xa0\x07\xfd\x06\x0a\xfd\x35\x2f\x8a\xef\xfd\x55\x11\x86\xf4\x73\xb0\xf9\x06\xcf\x8b\xff\xd7\x13\x23\x34\xb5\x8f\xb5\x9d\x9c\xf5\x4f\x8f\xf4\x2d\x16\xac\x29\xb5\x1f\x1b\xa0\x39\x0c\x4c\xa7\x69\x32\x10\xb6\x7f\xac\x3e\xbc\x8f\xd7\x9e\x66\x24\xae\x06\x04\xb6\x77\x59\xb4\x2b\xe3\xaa\xe4\x4c\xcd\x4f\xf5\xbb\xa9\xac\xea\x03\xdb\xfb\x85\x43\x31\xa4\x4b\x3a\xed\xb5\x8b\x9d\x5e\x71\xdb\x86\x51\x17\xd3\xa2\x1e\x83\xc4\x98\x64\x01\x2f\xf6\x16\xca\xbe\xc8\x0d\x55\x32\x0c\xaa\xbd\x47\xaf\xbf\x42\xe8\xc5\xae\x27\x2e\xe7\x71\x88\x75\x12\x4d\x44\x76\xb5\xba\x13\x38\x44\x49\x97\x57\x5d\xc5\xdb\xef\x9d\x71\x74\xd3\xba\xd5\x24\xc2\x87\xe7\x65\x58\x80\xd3\x6b\xb5\x6a\xd6\x61\x51\x3a\xd4\xc7\x3f\x50\xe4\xe6\xcb\x5c\x17\xa6\x27\x27\xf0\xef\xaf\xb8\xfd\x7e\x6b\xdb\xf0\x7d\x4a\xdb\xf7\xa4\xa6\xef\xb3\x5b\xbe\xcf\x6b\x6a\xfe\x36\x0d\x5f\x8f\xf4\x0e\x7c\xf3\x4d\x5e\x0b\xc9\x51\x44\x46\x8d\xd4\xef\xb0\xbf\xdb\x1b\x1b\x7e\x4c\xb7\xaf\x50\x72\x0b\xbf\xef\xa8\x85\x6b\x83\xbf\x52\xbd\x29\x42\xda\x99\x03\x8d\x3d\x3f\xd4\xd5\xb3\x23\xad\x3e\xdb\xfa\x1b\xd6\x3a\xa5\x8f\xf5\xfc\xba\xe2\xc2\x0b\xb8\xd3\x10\x97\x58\xfb\xba\x9b\xc0\x1f\x9f\x2e\x82\x14\xb6\x3a\x06\x76\x10\x13\x5e\x69\x89\xac\x1a\xdb\x62\xcc\x72\x09\x5e\x7d\xec\x9f\xa7\xca\x3d\xe3\xdb\x16\xee\x9b\x8a\xd5\xbb\x9d\x9f\x19\xce\x11\x52\xf9\x9e\xb9\xef\x70\xfa\x3d\xc1\x7c\x31\x71\xd2\x37\xe1\xee\x49\x6f\x58\x5c\x08\x59\x31\xad\xa8\x83\x50\x54\x3a\xbd\xc1\x3b\xae\xb4\x7c\x0c\x99\x8f\x2f\xeb\x74\x0d\x0f\x22\x76\x3f\x7c\x9a\xf5\xc1\x72\x22\xd0\x3e\x6a\x9e\x0a\x0d\x39\x88\x5a\x77\xef\x1c\x3b\x79\xc3\x6b\x26\x1f\x83\x7f\xee\x79\xb5\x71\x9d\x45\xdb\x67\xde\xeb\x9b\x8b\x59\xa0\x92\xb1\xd8\xd8\xb6\x6e\x89\x78\x8d\x9a\x48\xdc\x35\x02\x42\x89\xf4\x0e\xbf\x0c\xb9\xd1\x80\x5d\xf7\xe6\x0c\x5e\x99\xea\x81\xdd\xa5\xff\x96\x5c\xa3\x65\x9d\x33\xc2\x92\x68\xd2\xea\xfe\xd6\x76\x4e\x04\xcf\x32\x5f\xdf\x0c\x80\x59\x11\xa3\x26\x8e\x35\x88\x1a\x88\xd6\x1e\x22\x8c\x12\x0f\xf5\xa0\xbb\x16\x0b\xc0\xc5\x8c\xf1\x70\x49\xbd\xda\x49\x00\x46\x3e\xde\xa8\x78\xd3\xa5\x84\x75\x0e\x2f\x8d\x89\xfe\x37\x00\x5e\x79\x2e\x41\x47\x22\x00\x00")
 
 func templatesSchemaGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -291,12 +443,12 @@ func templatesSchemaGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schema.gotmpl", size: 8707, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/schema.gotmpl", size: 8775, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSchemabodyGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5b\x6f\xdb\x36\x14\x7e\xf7\xaf\x38\x30\xf2\x10\x07\x89\xfc\xde\xb7\x14\xdd\x25\x03\xd6\x0e\x69\xb7\x97\x62\x40\x19\x8b\x8e\x39\x48\xa2\x26\x52\xed\x3c\x41\xff\x7d\x90\x14\x49\xbc\x93\xb2\xbd\xc4\x4e\xfd\x66\x5b\xd4\xe1\xb9\x7e\xdf\xf1\x21\xab\x0a\x62\xbc\x26\x19\x86\x39\x5b\x6d\x70\x8a\xde\xd2\x78\x3b\x87\xba\x66\xbc\x28\x57\x1c\xaa\x19\x40\x55\x41\x81\xb2\x47\x0c\xd1\x6d\x92\x7c\x58\x43\x5d\xcf\x00\xda\x9f\xc9\x1a\x68\x01\x97\x28\x8b\xe1\x22\xba\x63\x1f\xcb\x87\x4f\xdb\x1c\x43\x74\xc7\xde\x22\x86\xfb\xcf\x3f\xfc\x93\xd3\x82\xe3\x78\xd1\x7c\xb9\xcd\x68\xb6\x4d\x69\xc9\x7a\x31\xa2\xfc\xdf\x0a\x9a\xe3\x82\x13\x2c\x3c\xed\x37\xca\x30\x5c\x44\xef\x08\x5b\x15\x24\x25\x19\xe2\xb4\xf8\x91\xe0\x24\x86\xe8\x3d\x4a\xb1\xb8\x5c\xd4\x2c\xa3\xbc\xd5\x6c\x54\xc1\xa5\xec\x42\x16\xd3\x0b\x6a\x56\x7f\x2a\xf3\x04\xab\x8f\xdb\x05\x1c\xa7\x79\x82\x38\x86\x79\x5e\x90\xaf\xbc\x59\xb7\x6e\x14\x9b\x43\x64\x10\x87\x13\x66\x14\x23\x4b\xe9\x9c\xef\x12\x93\xc5\x9a\xc9\x26\xd1\xd3\x0c\xd8\x5f\xf9\xdd\x14\xcf\x62\x39\xda\xd2\x22\xfd\xfb\x4d\x63\x53\xf4\x33\x62\xb7\x71\x4c\x38\xa1\x19\x4a\x6c\x89\xd3\x2d\x35\xae\xbb\x91\xd4\x58\x2e\x65\x57\xc4\x74\xc5\x78\x41\xb2\xc7\xb9\xe5\x75\xc5\x88\x1b\x29\x0f\xda\x55\xdb\x3f\x50\x42\x62\xd4\xbc\xf9\x8e\xae\x3e\xba\xa4\x19\xb2\xb7\x49\x53\x21\x71\xbb\x54\x1e\xd3\x76\xd1\xe8\x5f\x55\x90\x23\xb6\x42\x09\xf9\x17\x9b\x05\xf7\xc5\xd1\x28\xd8\x46\xaf\x7b\xcb\xbf\xb6\xf5\x38\xa4\x28\xff\xdc\xb9\xe1\x4f\xc9\x3b\x1d\x54\x34\x7a\xd8\xdd\x03\x5f\xfe\x62\x34\x7b\x33\xbf\x99\x7f\x11\x02\x22\xc6\x5a\x09\x7d\x67\xb7\x20\xee\x8e\xe3\x54\x8e\x53\x58\x94\xba\xf7\x94\x3c\x98\x1e\x9e\x56\x8c\x06\x43\xc6\xb8\x5c\x88\x81\xb1\xc7\xa5\x95\x38\xba\xb9\xaf\x27\x39\x20\xda\xa2\x2e\x14\x9f\x83\x22\xd0\x9b\xae\x3b\xdf\x54\x56\x4a\x39\x3f\xe1\x2c\xe5\x23\x48\x9a\x61\xbc\x53\xf9\x27\xda\x3e\x11\x94\x34\x6e\x25\x7e\xab\x2a\x0d\xe8\x35\x32\x31\x40\xf6\x85\x84\xd1\x97\x66\xc4\xd6\xa1\xce\x0b\xce\x62\x08\x3c\x00\x3c\x58\x21\x85\xcd\xb5\x61\xd8\x66\xee\x8d\x86\xea\x18\x0b\xe7\xa9\x4a\x3c\xe8\x17\x84\x7c\xbb\x62\xde\xc1\xd0\x2e\x10\xe9\xa6\x01\x9d\x10\x9e\xc0\x77\xda\x3e\x62\xac\xb4\xc3\x81\x9e\x12\x4b\x10\x03\x68\x83\xb9\x1d\x20\x6e\x5f\x78\xdb\x3b\x0c\x76\x5c\xf3\x2f\x57\x9c\xbf\x0f\xcc\x8d\x5e\xae\xfb\x7a\x11\xdb\xdb\xce\x8e\xd0\xfe\x56\xee\x6e\x43\x3b\x5a\x7b\x2b\x3b\x82\xab\x08\x6e\x7e\x18\x39\x65\xdc\x12\x7f\x73\x83\x96\x56\x14\xa6\xa7\xaa\xdb\x76\x81\x04\xef\xca\x83\x62\xc0\x00\xdc\x01\x00\xd0\x9b\xa9\xd6\xe1\xf1\xb5\x13\x8a\x31\x63\x1b\xa1\x34\x10\x93\xfb\x07\x55\xb0\xaf\x6d\xf8\x3e\x6b\x2a\xb8\x13\x08\x29\xaa\xf0\x6c\x3b\x66\xae\xb5\x27\xce\xa4\x52\x3b\x71\xca\xeb\x3f\x0a\xa4\xf7\x8d\xf0\x4d\x5f\x83\x2f\xcf\x7c\xa6\x22\x7d\x9d\x35\x3a\x91\xf7\x4e\x98\xd9\xce\x84\x76\x28\x42\x1b\x72\x61\xd8\xc3\x4b\x72\x46\xd4\x69\x5c\x53\xd7\x60\x77\x87\x60\x7f\xa3\xf6\xe8\xca\xbe\x4c\xef\xf1\xdf\x25\x29\xda\x4d\xae\x69\x4a\x1a\x31\x7c\x3b\xe8\xdf\xc3\xad\x48\x47\x06\xd0\xf5\x25\xfd\xeb\x60\x9e\x33\xe1\xd4\xf5\x4c\x65\x1c\x5a\xf2\xa9\xa4\x33\xb8\x6e\x8f\x13\x02\x9b\x77\x2d\xe3\x7d\x8d\x7f\xd4\x59\x75\xd0\x60\xdc\x3a\x78\x0f\x1b\x8a\xeb\x23\x71\xa3\x40\xbd\xc2\x9b\xc8\x34\x81\x89\xee\xd1\xb7\x5f\x31\x63\xe8\x11\xef\x57\xd4\xca\x00\xde\x31\x7f\x0f\x1f\xbf\xbb\xfa\x4f\x53\x24\x4e\x8d\x05\x9d\x83\x6d\xcd\x65\xce\x09\x75\xb8\x07\x8e\x67\x8a\xac\xa5\x6a\x38\x5f\x8a\x96\x8f\xc4\xb9\xbc\xe2\x05\x2d\x1f\x12\x7c\xb5\x0c\x98\x25\x87\xcf\x95\x15\x20\x30\x29\xac\x90\xeb\xcc\x54\xc8\xae\xa3\x39\xf3\x3c\xfd\x59\x69\xd9\x74\xac\x22\x75\x69\xe1\x14\x3d\xd3\xf5\xf7\x14\x96\x96\xbe\xcf\xc0\xb7\xe1\x14\xf4\xa1\x78\x4f\xb3\x5e\xb9\x33\x1f\x4d\xe5\xa3\x33\x1d\x9c\xe9\xe0\x30\x87\x8a\x21\x94\xe0\x25\x05\xdf\x25\x81\x03\x53\xc3\x99\x19\x4e\x8d\x19\xea\xff\x15\xd7\xad\x29\x63\x0e\x2d\xd4\xf5\x9b\x36\xa3\xef\xf1\x0a\x93\xaf\xb8\xe8\xe2\x1d\x19\x57\x5e\xef\x94\xe2\xd2\x67\xab\x05\x23\x2b\xe9\x4c\x64\xcc\xf1\x40\x5b\xc0\x6d\x8c\x4d\xd5\xfa\x7a\x66\xe7\x6f\x91\xbc\x6f\xb3\xd8\xc8\xde\xee\x20\x3b\x02\x69\x99\xb4\x38\x8c\x7e\xb6\xe2\xdc\x3f\xbe\xfd\x1d\x0a\x75\x58\x6b\x44\xb8\x17\xb0\x54\xb6\xa6\xc5\xa0\xda\xd3\x87\xd9\x43\xe9\x8a\xa4\x39\x7b\x67\x72\x29\x63\x08\xbb\x53\x68\xc9\xf9\x6e\xf3\xa7\xf1\x4d\xeb\x8b\x15\x4a\xb1\xb4\xe3\x80\x76\xb6\x59\x1d\xd4\xf5\xe5\x62\xf0\xca\xb5\xa0\xa0\xd6\xc8\x99\xb5\xd0\xa8\xe4\x72\x21\x49\x11\x50\xe4\x48\x92\xcc\x8a\x21\x72\x30\x77\x77\xa8\xe2\x4e\xa3\xa1\x6e\x00\xa2\x25\x1f\x39\x67\xb7\xa3\x93\x5d\xae\xc4\x7a\x8f\x51\x3c\xb7\x60\xf7\xb9\xfa\xfa\x5a\x4f\x4d\x1d\x69\xfe\xd2\x67\xd7\xe7\x40\x59\x8e\xb7\x87\x1b\x3c\x62\x5d\xfe\x52\x32\xd7\x60\x79\x79\x05\xcd\x0a\xe0\x1b\x0c\x0f\x88\x61\xe0\x8d\xef\xda\x0d\x59\x04\xbf\x33\x1c\xc3\x9a\x16\x50\x66\x29\x62\x1b\x94\x24\x24\x7b\x84\x9c\x26\xdb\x94\x16\xf9\x86\xac\xda\xe5\x2c\x6a\xff\x65\xf8\x7a\x47\x23\xce\x05\xb4\x8e\x96\xbf\x12\x47\xfe\xe7\x7f\x5a\x13\x3a\x86\xee\xbf\x00\x00\x00\xff\xff\x97\x0b\x66\x07\x5d\x30\x00\x00")
+var _templatesSchemabodyGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4d\x6f\xdc\x36\x13\xbe\xeb\x57\x0c\x16\x3e\x78\x0d\x5b\x7b\xf7\xcd\x41\xde\xb7\x75\x81\x26\x85\x93\xf6\x12\x14\x88\xbc\xe2\xda\x2a\x24\x51\xd5\x47\xd2\xad\xa0\xff\x5e\x50\x12\xc9\xe1\xa7\xa4\xf5\xc7\x26\xb1\x6f\xa2\x48\x0e\x67\x9e\x99\x79\x1e\x5a\xde\xb6\x85\x98\xec\x92\x9c\xc0\xaa\xda\xde\x93\x2c\x7a\x43\xe3\xfd\x0a\xba\xae\xaa\xcb\x66\x5b\x43\x1b\x00\xb4\x2d\x94\x51\x7e\x47\x20\xbc\x4a\xd3\xf7\x3b\xe8\xba\x00\xa0\x7f\x9d\xec\x80\x96\x70\x1a\xe5\x31\x9c\x84\xd7\xd5\x87\xe6\xf6\xe3\xbe\x20\x10\x5e\x57\x6f\xa2\x8a\xf0\xe7\xff\xfd\x53\xd0\xb2\x26\xf1\x9a\x0d\xae\x72\x9a\xef\x33\xda\x54\xdc\x0c\xb6\xff\x5b\x49\x0b\x52\xd6\x09\x41\xb3\xfc\xa0\x9c\xc0\x49\xf8\x36\xa9\xb6\x65\x92\x25\x79\x54\xd3\xf2\xff\x09\x49\x63\x08\xdf\x45\x19\xc1\xcb\xb1\x67\x39\xad\xe1\x44\x71\xc1\xe7\xec\x5a\x35\xc3\x0d\x31\x03\x1f\x9b\x22\xd5\x4e\x19\x17\xd4\x24\x2b\xd2\xa8\x26\xb0\x2a\xca\xe4\x4b\xcd\xd6\xed\x98\x63\x2b\x08\x2d\xe6\x48\x5a\x59\xcd\xa8\x56\x06\xf0\x7d\x66\xf2\x58\x7d\xeb\x30\xbd\x2c\x80\x87\x3b\x7f\x98\xe3\x79\x8c\x5e\xe9\x8b\xcc\xf1\x05\x8b\x29\xfc\x39\xaa\xae\xe2\x38\xa9\x13\x9a\x47\xa9\xab\x70\x86\xa5\xd6\x75\x17\x68\x21\xc0\x66\xa3\x42\x11\xd3\x6d\x55\x97\x49\x7e\xb7\x82\x70\xea\x98\xe1\x20\xb9\xb7\x18\x9c\xd9\xff\x11\xa5\x49\x1c\xb1\x9d\x6f\xe9\xf6\x83\xcf\x9a\x66\x8c\x39\xcd\xca\x14\x15\xee\x50\xca\xb2\x6c\xd7\x70\xd1\x75\x6d\x0b\x45\x54\x6d\xa3\x34\xf9\x97\xd8\x0d\xf3\xe6\x60\x0e\xf6\xd9\x1b\x76\x4d\xaf\xed\x11\x87\x2c\x2a\x3e\x0d\x30\xfc\xa9\xa0\x33\x50\x05\xf3\xc3\x0d\x0f\x7c\xfe\xab\xa2\xf9\xe5\xea\x62\xf5\x59\x04\xd7\xb6\x38\xd7\x5a\xea\x87\x5a\x45\xe6\xae\x6b\x92\xa9\x79\x9a\x97\xa5\x61\x1f\xda\x76\x58\x7a\x7a\x33\x8a\x15\x67\x5e\x10\x9f\xac\xc1\x9d\x97\xde\xa2\x84\x99\xf7\x53\xdb\x7a\x17\x0d\xa9\xf8\x34\x2b\x03\x3c\x74\x13\x7c\xb3\x8d\x8c\x76\x1e\x79\x96\xd6\x92\xd1\xed\x34\x3e\xb8\xfc\x13\xed\x67\xba\xce\x66\x5a\x8e\xf1\xa8\x6d\x0d\xa2\x37\xc4\xc4\x42\xd9\xd8\x93\x35\x9c\x2a\xa3\x71\xbf\x8d\xea\x26\xc9\x19\xa7\x60\x82\x80\x45\x14\x78\x8f\xf7\xc0\x79\x87\xf9\x0f\x12\xdd\x21\x1b\xa7\x6d\xe7\xb0\xdf\x2c\xe6\x3b\x94\xf3\x1e\x8d\xed\x66\x32\xdd\x32\xa2\x43\xe9\x99\xb9\xa7\xbf\x47\xb4\xed\xe3\x93\x9e\x96\x4b\xde\x03\x5e\x9a\x3b\x80\xe2\x1e\x4a\x6f\x0f\x4e\x83\x9b\xd7\xa6\x97\x6b\xe0\x3f\x84\xe6\x24\xca\x1d\xef\x17\x7c\xbd\x1d\x84\x73\xee\xfd\x56\x12\xd2\x08\x0c\xa7\x1c\xef\x8d\xd6\x7d\x95\x95\xe4\x8a\xc9\x6d\x9a\x46\xbe\x67\xde\xc2\xef\xfc\xa4\x65\x34\x85\x6d\x56\x87\xcd\x5a\x5c\x72\xa3\xb5\x20\x27\x57\x3e\x2a\x07\x08\xe2\x16\x96\x45\x25\x58\xaa\xd9\xde\x87\x33\xfb\xc8\x15\xe5\x13\x5c\x27\xb4\x60\xc6\x63\x95\x1a\xf7\x37\x8d\xeb\xfe\xa0\x1b\x96\xcf\x46\x53\x69\xe7\xbd\xa4\x9e\x9a\x7d\x13\x30\x0a\xcd\x32\x3b\xbf\xda\xe4\x66\x6b\xc9\x1d\x55\x6b\xdd\x85\xb3\xa8\xd5\xbe\x73\xc9\xe3\x8f\x48\xf4\xbe\x26\xf5\x3d\xef\xc1\xe3\x2b\x1f\xc6\x9a\xe7\xe0\xc7\xec\xd1\x85\xba\xa7\xe3\x62\xad\x21\xb9\xd1\x5a\x77\x47\x52\x36\x91\x60\x4b\xad\x2e\xa5\x18\x77\x53\xbd\x04\x41\x13\xb5\x20\xce\xd0\x7c\x30\x45\x4e\x02\x84\xf0\x64\xf8\x75\x1d\xb8\xe1\x40\xf1\xb3\xba\x91\x50\x8e\xe7\x84\x37\xe4\xef\x26\x29\xfb\x43\xce\x69\x96\x30\x33\xf5\x5e\xf8\xcf\xe9\x76\xf4\xce\x75\xbf\x99\x2a\xfa\xf9\x65\x21\x37\x5b\x6b\xe3\xa8\xca\xf3\xc2\x05\x67\x10\x1c\x5d\x71\x68\x53\x2f\x15\x1d\x01\x9d\xaa\x2a\xf2\x43\x81\xd1\x30\x72\x16\xc0\x8f\xae\xe5\x63\x91\xdc\x84\xe4\x20\x38\xe4\xc3\xb8\x4c\x85\xb6\xdd\xa3\x19\xda\x76\x5e\x3c\x1e\x83\x6a\x9a\xc7\xb4\x02\x4b\x4c\x78\x13\x7d\xfd\x95\x54\x55\x74\x47\x1e\xd6\xd4\x8a\x03\x63\x77\xdb\x3c\x9c\x6c\xef\x40\x03\x78\x6a\x99\x58\x88\x52\xe7\x2e\x6c\x69\x03\xc5\x68\x15\x8a\x67\x50\x41\x14\x2a\x27\x44\x0f\x64\x66\x6f\x05\x87\x20\xe0\x66\x82\xa7\x55\x49\x33\x32\x79\xb8\x9c\x9e\xa9\x97\xe3\x06\x00\x45\x38\x37\x67\x75\x49\x9b\xdb\x94\x9c\x6d\x9c\x07\xf2\x91\xfa\x6c\xd0\x83\xdc\xc1\x1b\x00\x39\x63\x71\x58\x13\xd7\xc0\xd6\xc8\xd6\x9b\xa6\xb4\xa4\x62\x01\xf0\xdc\xb2\x1c\x58\xfa\x57\x16\xe6\x22\x89\x0e\x4c\xff\x6d\x2b\x55\x27\x55\x04\x9f\x41\x6f\x65\x61\x4f\x49\xd0\xfb\xf2\x1d\xcd\xb9\x73\xaf\x7a\xb4\x54\x8f\x44\x39\x99\x0b\xe6\x95\x12\x5a\x39\xb5\x0c\xc0\x56\x4d\x8b\x6a\xd1\xca\x88\xaf\x72\xf0\xd4\x72\xe0\x22\xc1\xa5\x92\x30\x29\x0a\xf6\x83\xe5\xf8\x91\xa5\x41\xcd\x43\x60\x6b\xa9\x57\x65\xf8\xa6\x94\xa1\x7b\x52\x5e\x77\x96\x8c\x09\xcd\x88\xc2\x25\xdb\x19\xde\x90\x2d\x49\xbe\x90\x92\xbd\xec\xba\xd0\xba\xf2\x1c\x9f\x25\xb2\x62\x8e\xf1\x48\x7b\x76\x46\x20\x55\xc9\x54\x22\x35\x43\xc1\xa2\x58\xc0\x1f\x8c\xcb\xd5\xee\x3c\x70\xeb\x37\xf7\x8c\xfd\xfd\x78\x95\xc7\x56\xf5\xf6\x27\xd9\x09\x03\x07\xc2\x91\x42\x6b\x24\xcf\xd6\x9c\xdc\x13\x3c\xd2\x9e\x9d\x81\xc9\x0f\xb7\x63\x7a\x39\x64\x6b\x7b\xac\xc7\x89\x54\x8d\xa6\xd7\xc9\x0e\x0e\x4d\xa5\x2f\x93\xd6\xf0\x2e\xd1\x2c\x4b\x10\x81\x79\xbf\x29\x74\xd4\x7c\xb2\x43\x9f\x6f\x7a\x2c\xb6\x51\x46\x94\x13\x05\xdb\x09\x5d\x36\xbd\x3a\x5d\x0b\x54\x04\x01\x58\x64\xc3\xe1\x85\x66\x8f\x99\x53\xac\x70\xb4\x8d\x21\x1e\x69\xcf\x4f\x5b\x64\x97\x7e\x3c\xb9\x95\xc3\x01\x15\xd1\x78\x28\xc8\x4f\x40\xb4\xa9\xa5\xe6\x1c\xf6\xaf\x13\xc7\xaf\x4c\x0d\xd0\xb0\x10\xb9\xa1\x9f\xf7\x2b\x58\x35\x3d\xcb\x7e\xfa\xfa\xa3\xfe\xd7\xd4\x9a\xfd\x40\xeb\xb0\xf9\xf7\x54\xe5\x72\x2a\xec\xb9\x0f\x31\xf2\xa9\x95\xca\x6b\xa2\x78\xa2\xa4\x22\xc8\x5f\xf0\xe0\xbe\xfc\xa5\xa9\x7c\x1f\x96\x37\x67\xc0\x56\x40\x7d\x4f\xe0\x36\xaa\x08\xd4\xac\xdb\xfa\x03\xab\x10\x7e\xaf\x48\x0c\x3b\x5a\x42\x93\x67\x51\x75\x1f\xa5\x69\x92\xdf\x41\x41\xd3\x7d\x46\xcb\xe2\x3e\xd9\xf6\xcb\xab\xf0\x6c\xe3\xea\x6e\x9e\x34\x17\xcf\x19\x89\x96\xb3\x68\x2b\x4f\x33\x9e\x44\xd3\x1c\xe0\x6f\xe9\x8f\x7f\xf9\x46\x1d\xe3\x91\x7c\xee\xda\x96\xe4\x71\xd7\x05\xc1\x7f\x03\x00\x97\x0b\x66\x07\x5d\x30\x00\x00")
 
 func templatesSchemabodyGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -316,7 +468,7 @@ func templatesSchemabodyGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchematypeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x91\x31\x4f\xc3\x30\x10\x85\xf7\xfe\x8a\xa7\x4c\x09\x12\x16\x7f\xa1\x2c\xa8\x03\x30\xc0\x1f\x30\xdc\x19\x22\x5d\xce\x51\xed\x0e\xd1\xc9\xff\x1d\x99\xa6\xc5\x43\x17\x58\xe8\x76\x7a\xc9\x7d\xef\xbd\xb3\x19\x88\xc3\xa8\x8c\x2e\xbd\x7f\xf2\xe4\x5f\x97\x99\x3b\x94\xb2\x01\xcc\x6e\x31\x06\x78\x25\xf4\x71\x8f\xbe\x17\x56\xb8\xad\xc8\x73\x18\xf0\x91\x71\x37\xc0\xed\xd2\x56\xa3\x2e\x53\x3c\xa4\x01\x3d\x34\xe6\xaa\x3d\xfa\x79\x38\x32\x8e\x94\xcc\xd3\x2c\x3e\x9f\x4d\xee\x23\x2d\x1d\xdc\x8f\x0d\x4b\xe2\x76\xe1\x64\xdb\xf2\xdc\x2e\x3d\x1d\x44\xfc\x9b\xd4\x5f\x6f\xcc\xc0\x4a\xed\x92\x7b\x88\x35\x7d\x43\x55\x2a\x65\xb3\x4e\x55\xfe\x9e\x4f\x7d\x89\xf7\x1c\x02\xd3\xcb\x15\xf5\xfe\x65\x85\xbc\xcc\xdc\xc4\xff\xef\xf4\x7f\x7a\xb5\x31\xd4\x74\xa3\x4f\x4c\x6b\x75\xb3\x0b\xca\xea\x55\xbf\x9d\x6f\xd4\xc2\x2e\x1e\xeb\x2b\x00\x00\xff\xff\xbd\xa8\xfd\xff\xde\x02\x00\x00")
+var _templatesSchematypeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x91\xb1\x4e\xc4\x30\x10\x44\xfb\xfb\x8a\x51\xaa\x04\x09\x8b\x5f\x38\x1a\x74\x05\x50\xc0\x0f\x18\x76\x0d\x91\x36\xeb\xe8\xec\x2b\xa2\x95\xff\x1d\x99\x4b\xc0\xc5\x35\x57\x41\xb7\x5a\x79\xe7\xcd\x8c\xcd\x40\x1c\x46\x65\x74\xe9\xfd\x93\x27\xff\xba\xcc\xdc\xa1\x94\x1d\x60\x76\x8b\x31\xc0\x2b\xa1\x8f\x47\xf4\xbd\xb0\xc2\xed\x45\x9e\xc3\x80\x8f\x8c\xbb\x01\xee\x90\xf6\x1a\x75\x99\xe2\x29\x0d\xe8\xa1\x31\xd7\xdd\xa3\x9f\x87\xb3\xc6\x59\x25\xf3\x34\x8b\xcf\x3f\x90\xfb\x48\x4b\x07\xf7\x8b\x61\x49\xdc\x1e\x6c\xd8\x56\xcf\x1d\xd2\xd3\x49\xc4\xbf\x49\x7d\x7a\x63\x06\x56\x6a\x8f\xdc\x43\xac\xee\x1b\x55\xa5\x52\x76\xeb\x54\xd7\xdf\xf3\x96\x97\xf8\xc8\x21\x30\xbd\xfc\xa3\xdc\x57\x46\xc8\xcb\xcc\x8d\xfd\xbf\x76\xbf\x61\xaf\xfa\xb5\x31\xd4\x6e\x47\x9f\x98\xd6\xe8\x66\x17\x36\x2b\xcb\xac\xe9\xa8\x15\xbb\x58\xd6\xd7\x00\xbd\xa8\xfd\xff\xde\x02\x00\x00")
 
 func templatesSchematypeGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -336,7 +488,7 @@ func templatesSchematypeGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemavalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5f\x93\xdb\xb6\x11\x7f\xae\x3e\xc5\x56\xe3\x76\x24\x47\xa5\xf2\xd0\xe9\x83\xdd\xeb\x8c\x9b\x38\xcd\x4d\xe3\x3f\xe3\x73\xfc\x50\x8f\xa7\xc6\x49\xa0\x84\x98\x04\x69\x80\x3c\xdf\x95\xc3\xef\xde\x01\x40\x80\x20\x08\x52\x94\xc4\xb3\xef\x2e\xca\x4b\x8e\x04\xb8\xc0\x2e\x76\x17\xbf\xfd\x01\x56\x51\xac\x71\x48\x28\x86\x69\xca\x48\x4c\x32\x72\x85\x43\x82\xa3\xf5\x15\x8a\xc8\x1a\x65\x09\x9b\x96\xe5\x04\xa0\x28\x48\x08\xc1\x1b\xfc\x39\x27\x0c\xaf\xe5\x2b\x12\x02\x66\x0c\x9e\x9c\x41\xd5\x17\x9b\xf6\xa2\x10\xad\x88\xae\x61\x86\x3f\x43\xf0\xaf\xe4\xed\x4d\x8a\x61\xca\x33\x46\xe8\x66\x3a\x87\x19\x4d\x32\x08\xce\xf9\xcb\x3c\x8a\xd0\x65\x84\xe7\x50\x96\x17\xb2\xb1\x28\x00\xd3\x35\x94\xe5\x4c\xc9\x08\x5e\xa3\x6c\x0b\x65\x59\x14\xd6\x9f\x38\xe2\xb8\x2c\xa7\xd3\xa2\xc0\x74\x5d\x96\x0b\x28\x0a\x48\x19\xa1\x59\x08\xd3\x3f\x7d\x9e\x42\xf0\x4b\xb2\x42\x19\x49\x28\x54\x8d\x24\x04\x31\xe2\x2c\x61\x62\xd4\x67\x34\xa1\x37\x71\x92\x73\x77\x0a\x62\x90\x6a\xae\x72\x02\x52\x7a\x51\x04\xef\x50\x94\xe3\xe7\xd7\x29\xc3\x9c\x93\x84\xca\x8e\x03\x45\xce\x2b\x29\xf3\xa7\xd2\x58\x7f\x3c\x03\x4a\x22\x28\x26\x00\x00\x0c\x67\x39\xa3\xe2\xfd\x04\x40\x19\xb9\x52\xde\x18\xfc\x05\xa1\xbf\x60\xba\xc9\xb6\x5d\x16\x37\x1d\xc6\xb3\x97\x5a\x25\x2d\xaf\x56\x07\xca\xf2\xb1\x99\xa1\xcf\x2a\x73\x21\xd8\x9e\xf2\x40\xa5\xe5\xa4\x6a\x95\xd1\xf5\x0e\x95\x75\x87\xbb\xa3\x72\x3d\xe5\x83\x54\x7e\x8d\xb2\x0c\x33\xda\xa5\x70\xd5\x7c\x37\xd4\xfd\x58\x14\xf5\x7c\x3f\x1e\xb6\xc2\x84\x92\x38\x8f\x7b\x5c\x5a\x34\xab\xd9\x89\xf4\x71\xf1\x05\x6d\x36\x98\xa9\x1c\x42\x68\x86\x37\x98\x4d\xa1\x2c\xcf\x69\x76\x6b\xe9\xa2\x6f\x5c\xa2\xc6\x8d\xb8\x30\x58\x18\x25\xa8\x9e\xc6\xdf\xfe\x7a\x4c\xdc\x28\xab\xc8\xa7\xe7\xd7\xab\x28\xe7\xe4\x0a\x9b\xd7\x87\x06\x53\xaf\xa9\x55\xf3\xef\xce\xd4\xda\x2a\x8e\xa9\xf5\xeb\xc3\x4c\x9d\x47\x19\x49\x23\xfc\x2a\xec\xb4\xb6\xe9\x31\x9e\x09\xa5\x4d\x8e\x31\x85\x35\xeb\x83\xd4\x7e\x4e\x2b\xf7\x5a\x2e\x85\xa6\x39\x06\x4c\xf3\xb8\x61\x80\xa2\x08\xde\xe0\x15\x26\x57\x98\xbd\x44\x31\x2e\xcb\x40\x9b\x44\xa8\x86\xf8\x0a\x45\xe4\x7f\x18\x02\xd1\xa8\xac\x61\xbd\xbc\xc8\xc3\x90\x5c\x43\x59\x8a\x81\xc6\x76\xbd\xbd\xec\x75\x88\x75\xce\xf9\x0f\x39\xcf\x92\xf8\xa7\x84\xc5\x32\x69\x76\xf9\x86\xea\x30\xa6\x67\xb8\x8d\x55\x88\xa9\x81\xaa\x1e\x6d\x2d\x03\x05\xc3\x66\xf3\x05\x84\xb2\x27\xdf\x4b\xef\xfa\xff\x1a\x55\xf2\x88\xac\x70\x0b\x4c\x82\x8d\x26\x15\xe0\xe9\x05\x94\xa3\x9a\xc5\xd5\x1a\xfc\x8b\xeb\xa8\xd9\x01\xd1\x04\xfe\x7b\x41\xe8\x79\x86\x63\x2e\xf3\xad\xfa\xab\x52\x49\x8c\x76\x4e\xd7\xf8\xfa\x1d\x62\x2d\xd7\xae\xfc\xfd\x42\x3c\x3c\x39\x03\x42\x45\x20\x47\x58\x6c\xf4\x1e\xf7\x9b\xfb\x77\x52\x39\x5c\xb7\x01\x75\x8f\x71\x0d\x38\x44\x25\xbd\xa9\x55\x13\xdc\xc3\xc2\xad\x2d\x6c\x97\x8e\x55\x8f\x6f\xa5\xa3\x99\xe0\xc1\x3a\xfe\x4a\xc9\xe7\x1c\xef\x50\xd3\xea\x34\xa6\xa6\xc3\x32\xdd\x20\x35\xcc\x5e\x20\x77\x83\x30\x61\x20\xa3\xdf\x51\x68\xcf\xed\x60\xec\xbc\x3f\x92\xbe\x6a\xfb\x70\x62\xbd\xca\x07\xf2\x75\x9d\xde\xaa\xe7\x9f\x11\x7f\xa7\x14\x25\x09\xe5\xfa\xed\x39\xff\x27\xe2\x58\x02\x1f\xf3\xe6\x59\x44\x10\xaf\xf3\x22\x48\x53\x16\x85\x71\xc9\xb2\x14\x76\xfc\xfe\xa9\xf3\xee\xef\xd0\x99\x3c\x9c\xae\xdf\x7d\x67\xd4\x2c\x8a\x2f\x24\xdb\x56\x63\x9b\x01\xb5\x36\xa2\x84\xb7\x37\x49\x55\xb8\x6b\xcd\xe6\x60\x7d\x20\x97\x98\x7f\x41\x9b\xe0\x9c\xff\x07\xb3\x64\xd6\x91\x69\xa1\x10\xce\x21\xe4\xb0\x4a\x8c\x25\x02\x60\x95\xd0\x8c\xd0\x1c\x5b\x2f\xed\x49\xe9\x05\xd0\xcf\x19\x8e\xd3\x08\x65\x92\xbe\x48\x52\xcc\xb2\x9b\x7a\xaf\x81\xc0\x74\x6d\x7e\xa8\xd7\xcb\x5a\xcd\x0a\x62\x5a\x0b\xd9\xc4\x06\xda\x87\xfd\x4a\x35\x7d\xc7\xde\x23\x1c\xc7\x6f\xef\xb7\x95\x4b\xe0\x59\xcf\x76\x2b\x85\x5c\xe1\x05\x24\x9f\x84\x1c\xcc\x58\x30\x7b\x8c\x19\x4b\x18\x0f\x6a\x97\x9a\x3f\x15\xed\x85\x31\x4e\xe5\xc0\x57\xd8\x8c\x21\x22\x6a\x8f\x50\x9a\x4f\x9a\xf6\xf7\x44\x84\xdf\x58\x50\x3a\xfb\xa4\x0b\x0e\x40\xa3\x83\x18\xa5\xd6\x7a\xa9\x36\x21\xee\x67\xc4\x9f\xad\xd7\x44\xa8\x85\xa2\xd7\x6a\x65\x09\xe6\xa0\xa9\x97\xc0\xd7\xda\x00\x14\x5f\x0b\x4c\x54\x3c\x50\x83\x03\x3a\x88\x49\x72\x24\x74\x13\x47\xd6\x32\x48\x7b\x69\xd7\x3d\x22\xfc\x2a\x91\x94\x44\x95\xc8\x0a\x05\x8b\xb9\x75\xd8\xfa\x25\xc6\x6b\x2b\x9f\x89\x09\x54\x59\xca\xdb\xfd\xdf\xf8\xc6\x24\x2e\x86\xe8\x06\x77\x45\x52\xa1\xe2\x51\x25\xa5\x0e\x1f\x30\x09\xb8\x91\x8d\x40\x63\xeb\xc3\x93\x90\x95\x7c\xfc\xf9\x9e\xbf\xd6\x24\x69\xed\x8a\x76\xbe\xee\x5c\xce\x89\x2f\x4e\x44\x54\xa3\x48\x87\xb5\x7f\xaa\x55\x50\xd7\xf1\xd4\x70\xec\x01\xe9\xc3\x75\x97\xd6\x2c\xca\x5a\xb6\xe5\x4d\x45\x51\xad\x53\xf0\x2c\x8a\x5e\x85\xcd\x57\xcd\xd5\x28\x0a\xe8\x4f\xc3\x5a\x74\x3d\x88\xf9\x6b\x04\x81\x26\xba\xea\xfd\xea\x6d\x9e\x46\xd8\x76\x1f\xb3\x51\x2f\x97\xf0\xf6\xd5\x8f\xaf\x9e\xe8\xac\x40\xe8\x06\x90\xe9\x06\x44\xf6\xe3\xdb\x24\x8f\xd6\xb0\x49\x60\x8b\x19\x5e\x08\x93\xdd\x24\x39\x70\x8c\x21\xdb\x12\x0e\x0c\x11\x8e\x01\x51\x20\x9c\xe7\x58\xc8\x44\x19\x6c\xb3\x2c\xe5\x4f\x96\xcb\x0d\xc9\xb6\xf9\x65\xb0\x4a\xe2\x25\x27\x6b\xfc\x05\x45\x9f\x22\x74\xc9\x97\x9b\xe4\x2f\x5c\x95\x60\x4b\xf9\x19\x9f\x98\x89\x6b\xa3\x3b\x7a\xfb\xe9\x78\xb1\xab\xd9\x26\x94\xdf\x7a\x6b\x4d\x57\xe2\x95\x89\x56\xd5\x51\xb9\x8c\xda\x26\x1b\x72\x9e\x31\x86\x6e\xdc\xaf\x9d\x42\xae\xfd\xd5\x0b\x94\x3a\x9f\x34\xb3\x7b\xd0\x9c\xaf\x22\xce\x7f\x48\xe2\x34\xc2\xd7\xaf\x2e\x7f\xc3\xab\xcc\x5a\xba\x73\x7f\xfe\x3f\x05\xdb\x29\xd8\x8e\x0c\x36\x95\xd2\x1b\xa5\x7c\x6b\xcf\x93\x95\x4c\xa5\x41\xc8\x92\x18\x62\x94\x4e\x6c\x20\x07\x8d\x12\x06\xbe\x76\x0d\x33\x8c\xc2\x68\x01\x05\xad\x61\x63\x29\x2d\xae\x26\x91\x51\xe8\x25\x6b\x7c\x51\xa6\xe0\x9f\x76\x04\xab\xdc\x31\x0e\x6f\x10\xf7\x57\x42\x63\x43\x6b\xbb\x16\x96\xb5\xd0\xac\x05\xdd\x3b\x0b\x81\x53\x29\x70\x70\x29\xe0\x56\x5a\x6e\xde\x33\xd5\xa7\x37\x59\x59\xd5\xe9\xee\xa4\x65\xba\x36\x97\xb4\xf9\xd4\x33\xcc\xe0\x41\x7c\xc5\x8e\x1d\x1a\x7d\x39\x12\x14\x59\x32\x7a\x9e\xac\xe4\x1e\x94\x2b\xfb\xf8\xdc\xb6\x1d\xba\x01\x72\xd3\x2b\x5a\xac\x46\xc3\xbe\x6e\xee\x69\x5a\xd7\xf2\x99\xd6\xba\x74\xc3\x24\xdf\xe2\xf4\xa1\xa5\x49\x4b\x7a\x1f\x64\x72\xc4\x69\xd0\xd4\x16\xe2\x43\x4e\xce\xc7\x12\x3b\xb5\xbf\xf4\x00\x28\xfb\xc3\xe3\x21\x54\x7b\xc8\xae\x75\xe8\x61\xf8\x57\x5b\x1c\xa3\x46\x19\xef\xec\xa2\xea\x71\xd6\x3a\xe8\x33\xf7\x41\x54\x9f\x47\x9b\x24\x13\xef\x9f\x9c\x59\xc5\xf3\x64\x95\x50\x9e\xc1\xac\x0e\x55\x2d\x55\xfa\xb7\xf5\x99\xcb\xd3\x8a\xec\xb3\x42\x69\x96\x33\xcc\xe5\xa1\x54\x75\x3e\xe5\xee\x21\x42\xd6\x1f\x76\xc8\x69\x34\xc3\x99\x4f\x86\xc9\x94\xb5\xc3\x69\x12\x54\x1a\x68\x72\x85\x44\x79\x0c\x2b\x14\xe3\x16\x38\x80\xf7\x1f\x08\xcd\x30\x0b\xd1\x0a\x17\xe5\x24\xcc\xe9\x0a\x08\x25\xd9\x6c\x2e\xb3\xb8\xf8\x54\x68\xf1\xfe\x43\x63\xad\xd6\x98\xe1\x30\xc4\xeb\x0b\x39\x80\x30\x98\x59\xae\x7a\xab\xf9\x8d\x27\x34\xf8\x95\xc6\x88\xf1\x2d\x8a\x66\xef\x3f\x5c\xde\x64\x78\xf6\xb1\x28\x64\x8b\x31\xe7\xc7\xf9\x02\xfe\xcc\xb0\x77\xd3\x49\x11\x25\xab\x19\x66\x6c\x5e\x15\xc6\x42\xab\xff\x2e\xe0\xaa\xae\xe6\xc5\xec\xcc\x96\xe7\x57\xf1\x0c\x50\x9a\x62\x2a\x37\x7c\x6f\x8f\x05\x5c\xa9\x01\xca\x89\xb2\xc0\xcc\x03\xb4\x9a\x78\xc4\xce\x36\x8f\x5d\x10\x77\xce\x9f\x5f\xa7\x09\xcb\x74\xb1\xe0\xe0\x32\xa7\xf2\xb2\x8e\x1f\x95\x94\x39\xec\xc6\x74\x29\xca\xb6\x0b\x88\x34\x0a\x51\x0e\xbd\xa8\x1d\x6d\xf7\x5a\xcd\x41\xee\xde\xd2\x78\x1e\x94\xe4\x19\xa5\x12\xbf\xe8\xb4\xf4\xa0\x13\xbb\x3e\xca\x47\x91\xc1\x95\x63\x74\xb9\x6d\xdd\x67\x3c\xdf\xb5\x76\xc5\xe1\x0e\x6c\x4f\xf6\x56\xbd\xb8\x1e\xa8\xd7\x95\x4d\x37\xcb\x9f\xbb\xdd\xf9\xb1\x32\xf9\xa3\x1e\x6f\x7d\xe4\x73\xd7\xea\xed\x1e\x0e\x6b\xe6\x75\xac\xd7\xea\x55\x1a\xd3\x75\xad\xe9\x1d\xe7\xbe\xfd\xc4\xa1\xa7\xbc\x4b\x98\x8d\xb5\x04\xbc\xe1\x9d\xa9\x5a\x61\xf6\xb6\xcf\xdf\xb5\x84\xdd\x32\xd7\xbe\xae\x5e\x2b\xda\xeb\xea\xa6\xdb\xb0\xd4\xfd\xf8\xab\x24\x66\x33\xa9\xbb\x97\x9d\xcd\xd4\x8e\xf0\xf1\x26\xbe\xd0\xf5\x9c\x99\x13\x57\xd5\x40\x51\xc0\x36\x8f\x11\xb5\x47\x37\x2b\xe3\x9c\xbf\xda\x27\x97\x35\x78\x6c\xc1\xca\x8e\x98\x6a\x6d\xbb\x3f\x12\xbe\x12\xdb\x32\x95\xb3\x91\xaf\xdd\x58\x6f\xac\xef\x08\x1e\x61\xb9\x86\x5b\x44\x8b\x55\x0f\xe3\x2c\x78\x83\x37\x84\x67\xec\xc6\x5e\xd1\x3a\x4a\xe5\xbb\x8a\x14\xf2\x55\xa4\xb5\x85\x6a\x9a\xc3\x39\xcf\xb5\xca\x93\x8e\x4a\x68\xff\xda\x65\x58\xc5\xb2\x67\xd5\xd2\x12\xdb\xa8\x5c\x06\x56\x2f\x2d\x21\x75\x05\x33\xac\x8a\x69\x17\xe3\xe2\xb9\x55\x8d\x37\x64\xea\x3b\xe5\x54\xec\x89\x96\x9b\x25\xec\x27\x61\x40\xe5\x17\x73\x75\x27\xbb\x6b\x9d\xda\x67\xd6\x87\x5d\x4b\xe8\x27\x69\x34\x4b\xc2\xeb\xfc\xc9\x30\x5f\x40\x85\x3e\xf4\x7f\x5d\x27\xdb\x2e\x4b\x51\xb7\xed\x26\x15\x3c\x9c\xdb\xde\x7c\xa5\xba\x64\xb2\x43\xc3\x5e\xed\x7c\x33\x77\xee\xcc\x9f\xeb\x0d\xd4\x7b\x89\x5e\xdf\x8a\x98\xcf\x61\xa0\x46\x0d\x4d\x66\x6b\x96\xa4\xaf\xd1\xea\x13\x12\xfe\xa4\xaa\x49\x21\x69\x20\xc9\x36\x50\xbb\x26\xb1\xd5\xbc\xba\xef\xa7\x42\x06\x07\xff\x03\xa1\x2a\xb4\x55\x26\x7d\xd1\x3d\x7a\x64\xdf\x5a\x54\x2f\x97\x12\x21\x1e\x16\x03\xfb\x50\x83\x1e\xc6\x78\xfc\x18\xee\x99\xbd\xef\xf8\x5b\x4e\x58\x82\xc2\x99\x5b\x7e\xcf\x2d\x2a\xb3\x71\x0b\xf8\x28\x15\x24\xbc\x9a\x4e\x17\x30\xbd\x4c\xd6\x37\xd3\x85\x4f\xc2\x81\x9a\x59\x7e\x49\x42\x79\x6f\x4a\x40\x69\xf8\x07\x7c\xdf\x42\x63\x09\xe3\x81\x00\x43\x09\x27\x19\xae\xfd\xed\xb9\x68\x11\x5f\x05\x41\x30\xf7\x23\x36\x9f\xbf\x9b\x4b\xa3\x5d\x6e\xec\xb2\x67\x6e\x2d\x62\xea\x3e\x91\xd2\xbc\x66\x13\xe3\x3d\x60\x52\x69\x0f\x03\x78\x8b\x97\xe1\xdf\xdb\x55\xcd\x51\x1c\xa6\x68\x10\x79\x83\x0a\xf9\xa2\x49\x23\xf2\x9d\xe4\xa6\xcb\x02\xb4\xb8\x01\x23\xf6\x58\xfa\xf3\xb8\x91\x0e\x23\x48\x27\x55\x3e\xb5\x73\xc6\x00\xaa\xa4\xaf\xc2\xf0\x92\x25\x87\x10\x2c\x6d\x7e\xe5\x7e\x32\x83\xc3\xdd\xfd\x76\x79\x43\xbf\x57\xfd\xfe\xd8\xc4\xa1\x76\xe8\xcf\x5d\xfd\x1f\xef\x45\xc7\xdc\xf9\x78\xba\xab\xc4\xa5\xa5\x66\x8b\xaf\x18\x89\xcd\x6c\x5e\x75\xad\x90\xa1\xff\xfd\x9d\xc2\x0b\xde\x83\xee\xe1\x41\xd8\xa1\xda\xc3\x81\x13\xf7\x3f\x28\xc7\x88\xc7\xb6\x97\xdc\xb3\x3d\xef\xe0\x53\x07\xe7\xc4\xa1\xea\x6a\xa1\xa0\xfd\x76\xcf\xae\x73\x89\x07\x07\xf9\x77\x68\x7f\xc0\x9e\xe9\x3b\xc2\xb8\xff\xd1\x79\x07\xcf\x40\x06\x2e\xc3\x38\x27\x23\x93\xc9\x64\x3c\x52\xa9\xeb\xd8\xe4\xbe\x38\xc3\x90\xa3\x90\xdb\xf9\xf7\x14\xd6\x3a\xb5\x29\xa5\x9d\xff\x74\xcb\x59\xe8\xc6\xa5\x77\xe7\x2e\x92\x7d\x66\x53\xf6\x52\x2f\xa3\x78\xc4\x89\xa1\xb9\x7b\x90\x4a\x73\x09\xc2\x91\x4e\x4c\xc2\x89\x49\x38\x31\x09\x27\x26\xe1\xc4\x24\x9c\x98\x84\x07\xce\x24\x3c\x80\xa8\x3c\x51\x09\x07\x53\x09\x27\x9a\xe0\x1b\xd2\x04\x83\x88\x9c\xfb\x1f\x9e\x27\x2e\xe1\x44\x00\xdc\x09\x02\x60\xd2\xc7\x00\xb4\x7e\x35\xc4\xc0\x85\xfd\x40\xce\x6d\x96\x11\xf7\x08\xcb\x0c\xac\x22\x4e\xf5\xc2\x37\x86\x27\x23\x16\x09\xbd\x69\xae\x6d\x9b\x21\xa6\x1b\x9e\x78\x12\xd6\xf6\xef\x9a\x81\x73\x5b\x7c\x57\xaf\xd5\xaf\xcf\x34\x7e\xb7\x6d\xd7\x8f\xcd\x04\xdd\x33\x37\x21\xd1\x9f\xa4\xbc\x11\xed\xde\x07\x75\xcc\xdd\xcc\x5c\xff\x0f\x00\x00\xff\xff\xac\xc0\x6f\xc3\x80\x5b\x00\x00")
+var _templatesSchemavalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x4f\x93\xdb\xb6\x15\x3f\x97\x9f\xe2\x55\xb3\xcd\x90\x89\x4a\xe5\x90\xc9\x61\xd3\xed\x8c\x9b\x38\xcd\x4e\xe3\x78\x27\x76\x7c\xa8\xc7\x53\x63\x25\x48\x42\x4c\x81\x34\x40\xae\xb5\xe5\xe0\xbb\x77\x40\x02\x20\x48\x82\x14\x25\xd2\xdb\xb5\x23\x5f\x4c\x91\xc0\xc3\xfb\xf3\x7b\x7f\x49\x3b\xcf\x57\x78\x4d\x28\x86\x59\xc2\xc8\x8e\xa4\xe4\x0e\xaf\x09\x8e\x56\x77\x28\x22\x2b\x94\xc6\x6c\x26\x84\x07\x90\xe7\x64\x0d\xe1\xaf\xf8\x7d\x46\x18\x5e\x15\xb7\xc8\x1a\x30\x63\x70\x79\x05\x6a\x2d\x36\xcf\xf3\x1c\xc8\x1a\x10\x5d\x81\x8f\xdf\x43\xf8\xcf\xf8\xe5\x7d\x82\x61\xc6\x53\x46\xe8\x66\x16\x80\x4f\xe3\x14\xc2\x6b\xfe\x4b\x16\x45\xe8\x36\xc2\x01\x08\xf1\xa2\x78\x98\xe7\x80\xe9\x0a\x84\xf0\x4b\x1a\xe1\x0d\x4a\xb7\x20\x44\x9e\x5b\x97\x38\xe2\x58\x88\xd9\x2c\xcf\x31\x5d\x09\x31\x87\x3c\x87\x84\x11\x9a\xae\x61\xf6\x97\xf7\x33\x08\x7f\x8e\x97\x28\x25\x31\x05\xf5\x90\xac\x41\x9e\xe8\xc7\x4c\x9e\xfa\x84\xc6\xf4\x7e\x17\x67\xbc\xc9\x42\x9e\x1b\x5e\x0b\x06\x0a\xea\x79\x1e\xbe\x42\x51\x86\x9f\xee\x13\x86\x39\x27\x31\x15\x62\x38\xc9\x40\x51\x09\xbe\x2b\x94\xf5\xe7\x2b\xa0\x24\x82\xdc\x03\x00\x60\x38\xcd\x18\x95\xf7\x3d\x00\xa9\x51\x23\xbc\x51\xf8\x33\x42\x7f\xc6\x74\x93\x6e\xbb\x34\x6e\x16\x4c\xa7\xaf\xd2\x4a\x9a\x5e\x25\x0e\x08\xf1\xa5\xe1\xd0\xa5\x95\x40\xea\xba\xe2\x68\xb0\xd0\x05\x53\x95\xc8\x68\x7f\x40\x64\xb4\x7f\x6c\x22\xa3\xfd\x28\x91\x6f\x50\x9a\x62\x46\xbb\x04\x56\x8f\x1f\x87\xb8\x6f\xf3\x5c\x33\x24\xc4\xdb\xd3\x2c\x4c\x28\xd9\x65\xbb\x4e\xfb\x96\x8f\x4b\x69\x65\xf8\x78\xf1\x01\x6d\x36\x98\x15\x7e\x39\x23\x34\xc5\x1b\xcc\x66\x20\xc4\x35\x4d\x0d\xb7\xd3\x29\xe7\xf0\xb9\xa4\x3c\x37\xe2\x18\x84\x58\x47\x31\xaa\xd8\xf8\xf6\x9b\xd3\xb4\x9a\xe7\x95\x56\x8a\x5f\x4f\xf7\xcb\x28\xe3\xe4\x0e\x9b\xdb\xa7\xa9\x1a\xed\x7b\x55\x8d\xf6\x7f\x48\x55\xa3\xbd\x53\xd5\x68\x3f\x46\xd5\x59\x94\x92\x24\xc2\xcf\xd7\x9d\xda\x36\x2b\xa6\x53\x61\x01\xbf\x31\xaa\xb0\xb8\x3e\x49\xec\xa7\x54\xc1\x6b\xb1\x90\x92\x66\x18\x30\xcd\x76\x35\x05\xe4\x79\xf8\x2b\x5e\x62\x72\x87\xd9\x2f\x68\x87\x85\x08\xb5\x4a\x64\xde\x46\x7c\x89\x22\xf2\x5f\x0c\xa1\x7c\x58\x30\x6b\xdf\x7c\x91\xad\xd7\x64\x0f\x42\xc8\x83\xa6\xd3\xdb\x09\xfa\x3a\x45\x3b\xd7\xfc\xfb\x8c\xa7\xf1\xee\xc7\x98\xed\x8a\xa0\xd9\x85\x8d\x72\xc1\x94\xc8\x68\x3e\x54\x2e\x56\x1e\xa4\x56\xb4\xa5\x0c\xcb\x32\xcc\x0f\xe6\xb0\x2e\x56\xf2\xa3\x50\x51\xfd\xad\xab\x4a\x1e\x91\x25\x6e\x15\x93\x60\x57\x93\x65\xc1\xd3\x5b\x50\x4e\xaa\x96\xa6\xd4\xe0\x36\x6e\x43\x4c\x2d\xa8\x82\x88\x36\xb1\x2c\x29\x9f\x11\x7a\x9d\xe2\x1d\x2f\xe2\x6d\x79\xa5\x44\x92\xa7\x5d\xd3\x15\xde\xbf\x42\xac\x05\x6d\x85\xf7\x17\xf2\xc7\xe5\x15\x10\x9a\x7e\xfb\x8d\x1f\x61\xea\x3b\xe1\x17\x38\xe0\xa5\x0f\xee\x56\xa0\x5e\x31\xad\x02\x87\x88\xa4\x93\x9a\x62\xf0\x08\x0d\x37\x64\x44\xfb\x43\x32\xa2\xfd\xff\x55\x46\xb4\x1f\x2b\xe3\x6f\x94\xbc\xcf\xf0\x01\x31\xad\x45\x53\x4a\xea\x80\xda\xa9\x62\x98\x5c\x00\xb0\x58\xc8\xf8\x01\x85\xf7\x37\x04\x3a\x32\x1d\x4c\x1d\xf7\x27\x92\x57\x0a\x14\x36\x7d\x5d\xc5\x83\xe2\x76\x15\xde\xca\x65\xe1\x4f\x88\xbf\x2a\xdd\x92\xc4\x94\xeb\xbb\xd7\xfc\x1f\x88\xe3\xa2\xb6\x35\x77\x9e\x44\x04\xf1\x2a\x2e\x42\xa1\xca\x3c\x37\x90\x14\x42\x02\xe3\xeb\xef\x1a\xf7\xfe\x06\x9d\xc1\xa3\xb1\xf4\xab\xaf\x8c\x98\x79\xfe\x81\xa4\x5b\xc5\x8d\x39\x50\x4b\x23\x5b\x78\x3b\x49\x96\x8d\xbb\x96\x2c\xa8\x38\x54\x26\xe6\x1f\xd0\x26\xbc\xe6\xff\xc6\x2c\xf6\x3b\x22\x2d\xe4\x12\x1c\x92\x0e\x53\x64\x2c\x12\x00\xcb\x98\xa6\x84\x66\xd8\xba\x69\x33\xa5\x0d\xa0\x7f\xa7\x78\x97\x44\x28\x2d\xc6\x17\x71\x82\x59\x7a\xaf\xc0\x14\xb3\x19\x84\x66\x69\x7d\xa3\xf0\xea\xf7\xaa\x12\xd3\x32\x64\xbd\x36\xd0\x18\x76\x0b\x55\xc7\x8e\x9d\x23\x1a\xc0\x6f\x6c\x15\x22\x54\x90\xc0\x7e\x4f\xba\x2d\x88\xdc\xe1\x39\xc4\xef\x24\x1d\xcc\x58\xe8\x7f\x89\x19\x8b\x19\xd7\xfb\x49\x4c\x83\xef\xe4\x73\xbd\xc3\x00\xf8\x0e\x9b\x33\xa4\x47\x1d\xe1\x4a\x81\x22\x25\xbc\x1a\x41\xdb\x23\xdc\xca\x02\xd1\xc8\x93\xcd\xe2\x00\x74\x75\xb0\x43\x89\x65\xaf\xa2\x80\x28\xc8\xfd\x84\xf8\x93\xd5\x8a\xc8\x80\x8c\xa2\x9b\xd2\xb2\x04\x4b\x57\x53\x0b\x8c\x73\x09\xe1\x55\x1a\xfe\xb8\x75\x83\x1a\xf9\xd4\xc6\x3d\x27\x0d\x8d\x1a\x14\xba\x67\x44\x96\xc6\x85\x67\x55\xa7\x52\x26\x08\x5d\x1a\x0a\x7f\xc1\x78\x65\xc5\x19\x89\x42\x15\x3d\x9c\xcb\xff\x85\xef\x4d\x40\x61\x88\x6e\x70\x17\xc2\x25\x3b\x79\x0e\x65\xb0\x70\x91\xd2\xb6\x36\x42\xda\x26\x1a\x19\x1c\xac\xa0\xe0\x8e\xc3\xfc\x46\x0f\x2f\x2b\x88\xd8\x71\xb4\x53\xf7\x12\x3c\x77\x28\xd2\xae\xe5\x66\x4b\x3a\x96\x13\xe7\x5f\x7c\x21\x2b\x2d\x65\x31\x63\x9f\xc2\x6b\x6b\xa0\x1c\xe4\xe5\xc7\xfb\xf8\x44\x1e\x2e\x5c\x35\xbd\xf0\xac\xa8\x98\xe7\x0a\x1c\xe1\x93\x28\x7a\xbe\xae\xdf\xaa\x43\x20\xcf\xa1\x3f\x26\xab\x45\x2a\x3a\xd4\xae\x26\x20\xa8\x04\xcb\xf3\x2a\x79\xbd\xcc\x92\x08\xdb\x98\x35\x59\x7b\xb1\x80\x97\xcf\x7f\x78\x7e\xa9\xe3\x06\xa1\x1b\x40\x66\x19\x90\x62\x1d\xdf\xc6\x59\xb4\x82\x4d\x0c\x5b\xcc\xf0\x5c\xa2\xe0\x3e\xce\x80\x63\x0c\xe9\x96\x70\x60\x88\x70\x0c\x88\x02\xe1\x3c\xc3\xde\x62\x01\x28\x85\x6d\x9a\x26\xfc\x72\xb1\xd8\x90\x74\x9b\xdd\x86\xcb\x78\xb7\xe0\x64\x85\x3f\xa0\xe8\x5d\x84\x6e\xf9\x62\x13\xff\x55\xa6\xca\x0d\x66\x8b\x62\x1b\xd7\xc1\xb1\x52\x7a\x43\x6e\xf7\x6c\x5e\xa6\x38\x5b\x85\xd2\xbe\xe0\x6c\x3c\x9b\x14\xb5\xc8\x31\x2d\x3b\xd4\x12\x98\x65\xce\xac\xd1\x79\xc2\x18\xba\x6f\xee\x6e\x74\x75\x8d\x5d\x4a\xf1\xcf\x50\xd2\x74\xb7\x1a\x91\x7a\xf0\x0f\xa1\x46\x43\xf6\x55\xd7\xfc\xfb\x78\x97\x44\x78\xff\xfc\xf6\x77\xbc\x4c\x2d\x63\x5e\xbb\xd3\x83\xe5\xf3\xea\xfc\xb3\xeb\x9f\x5d\xff\x93\x71\xfd\xe2\x2f\xaf\x36\x65\xa8\xc9\x07\xba\xc9\x52\x12\xac\x59\xbc\x83\x1d\x4a\xac\x0a\x48\xa2\xd8\xee\xae\xe0\xa1\xdb\x2b\x97\x13\xb5\x21\x6f\x21\xae\x2e\xa1\xbe\x68\x8e\x91\xe2\x22\x02\x38\xe7\x48\x2e\xd7\x96\x2e\x52\x01\xc1\x54\x22\xea\x6d\x9d\xf1\x63\xbb\x70\xb0\x9a\x99\x07\x2a\x28\x9b\x9a\x72\x29\xca\x59\x79\x6b\xbf\x35\xfa\xd2\xbf\x5a\x61\x4a\x2d\x3f\x37\x2e\x27\x36\x2e\x26\x0c\xaa\x1d\xcd\x50\xa8\xbb\x4b\x77\xfc\xd2\x02\x0d\x8a\x63\x00\x6d\x13\xb4\x7e\xf5\x1c\x33\xf8\x10\x9b\x68\x63\x82\x73\x30\x6c\xaa\xd1\xce\xe4\xa1\x53\xd1\x3d\x29\x7c\x56\x52\xb4\xc3\x46\x5b\x0f\x4a\x58\x47\xdb\xa0\x95\xd3\xec\x1d\x5c\xfa\x6d\x86\xa3\xba\x76\x2d\xcc\xb4\xec\xd2\x5d\xc7\xb9\x8c\xd3\x57\xce\x79\x2d\xea\x7d\x35\x1d\x80\xb3\xaa\x6b\x13\x71\x95\x76\xf6\xe6\xee\xe2\xae\x4d\xcb\x51\xe1\x01\x7c\x84\x1a\xcf\x1b\x6c\xa0\x6e\xa8\xf0\xe5\x16\xef\x90\xb5\xa3\x95\x71\xcb\x9f\x7e\xeb\x7d\xa5\xf9\xac\xa5\x5c\x73\xb1\x89\x53\x39\xce\xbb\xbc\xb2\x06\x03\xde\x32\xa6\x3c\x05\xbf\xf2\x61\x4d\xb5\x00\xbe\xb5\xad\x39\x6e\x96\x51\x7a\x89\x92\x34\x63\x98\x17\xef\xd6\xd4\x6b\xb6\x66\x72\x91\xb4\xfe\x74\x80\x4e\xed\x31\x5c\xb5\x12\x54\x95\x7a\x83\x2a\x2b\x7b\x7a\x96\x5b\x28\xc8\xbb\x43\x72\x9a\x00\x4b\xb4\xc3\xad\x42\x02\x5e\xbf\x21\x34\xc5\x6c\x8d\x96\x38\x17\xde\x3a\xa3\x4b\x20\x94\xa4\x7e\x50\x64\x18\xb9\x55\x4a\xf1\xfa\x4d\xcd\x56\x2b\xcc\xf0\x7a\x8d\x57\x2f\x8a\x03\xa4\xc2\x8c\xb9\xaa\x1c\xf4\x3b\x8f\x69\xf8\x1b\xdd\x21\xc6\xb7\x28\xf2\x5f\xbf\xb9\xbd\x4f\xb1\xff\x36\xcf\x8b\x27\x46\x9d\x6f\x83\x39\x7c\xc1\xb0\x33\x1b\x25\x88\x92\xa5\x8f\x19\x0b\xd4\x1c\x41\x4a\xf5\x9f\x39\xdc\x55\xc3\x0f\xc9\x9d\xc9\x85\x6e\x11\xaf\x00\x25\x09\xa6\x2b\xbf\x6b\xc5\x1c\xee\x02\x55\x47\x97\x1a\xf0\x1d\x45\x59\xbd\x76\xb1\xc3\x90\xfd\x16\x52\x39\xeb\xd3\x7d\x12\xb3\x14\xaf\x5a\x36\x95\x7c\x35\x7a\x46\xcd\x89\xa1\x12\x98\x22\xa6\xbd\x57\x71\xec\x27\x28\xdd\xce\x21\xd2\xe5\x49\x09\xe8\x79\x05\xb4\xc3\xb6\x0a\xa4\x9d\x62\xd6\xee\x7f\x8a\x93\x43\xc7\x29\x8a\xfc\xbc\x53\xd3\x2e\x13\xd6\x32\xb9\xa8\x0a\x49\x4a\x22\xd5\xb6\xd4\x34\x27\xab\x78\x05\x8c\x2e\xd8\x56\x6b\xa6\xc3\xae\x95\x2e\x87\x03\xb8\x62\xe4\x23\xa3\xb8\x3a\xa8\x17\xca\x66\x99\x85\xe7\x6e\x38\x4b\xd4\x92\x35\x5c\xf4\xa0\xf5\xc2\x05\x57\xb8\x38\x16\xb0\x86\xaf\xb1\xa8\xd5\x56\x9a\x12\xba\x16\x7b\xe3\xe0\xdb\x3f\x67\x55\xf8\x56\x68\xd1\x01\xda\x2a\xc2\x64\xdd\xc3\x3b\x43\x75\x59\xcc\xb7\x31\xff\xd8\x02\x76\x4b\x5d\xc7\x42\xbd\x12\xb4\x17\xea\x66\xd9\xb0\xd0\xfd\xe5\x83\x04\x66\xc3\xd4\xe3\x8b\xce\x86\xb5\x11\x18\xb7\xae\x16\x0b\xd0\x8d\x9e\xe1\x89\x97\x6d\x42\x9e\xc3\x36\xdb\x21\x6a\x9f\x6e\x2c\x53\x33\x8c\x49\xa9\x31\xab\xd5\x90\xad\xea\xb2\xc3\xa7\x5a\x69\xf7\x07\xc2\x97\x32\x2d\xd3\x82\x1b\x21\x5a\x8a\x68\xd8\x77\x02\x44\x98\x8b\x00\x9a\xdd\x35\xf0\x94\xad\x77\x69\xf8\x2b\xde\x10\x9e\xb2\x7b\xdb\xa2\x95\x97\x16\xf7\x3c\xcf\x6e\x15\x6b\xad\x6a\xa5\x21\x33\x12\x69\xbe\x96\x56\x2b\x55\x43\xe8\x6a\x91\x14\xa1\x23\x9a\x9a\x61\xad\x4c\x8b\x6e\x7f\x3b\x03\xd0\xd7\xd2\x0c\x6c\x6b\x5a\x44\x9e\xa1\xa4\x8b\x82\xb3\x99\x51\xab\x14\x92\xf5\x6f\xa5\xfb\x0a\x60\xe6\x99\xd5\x68\xfb\x14\xc3\x85\x0d\xb3\x98\xfd\x28\x15\x58\x86\xa5\x00\xfc\x3e\x3b\xb5\x5f\xbd\x9f\xf6\x75\x45\xff\xf4\x46\x8f\x4f\x78\x15\x3f\x19\xe6\x73\x50\xd5\x87\xfe\x63\x0b\x87\xe9\xaa\x43\x31\xf6\xb3\xc3\xd3\x06\xb5\xb0\x2e\xda\x91\xb3\xcd\x22\x17\x1f\x92\xb0\x57\x3a\x17\xe7\x8d\x4f\xff\xaf\x75\x02\x75\xfe\x5b\x00\xfd\x71\x47\x10\xc0\x40\x89\x6a\x92\xf8\x2b\x16\x27\x37\x68\xf9\x0e\x49\x5f\x2e\xbb\x49\x49\xc9\x4c\xc6\x26\x91\xae\xb2\x52\xfd\xba\x7b\x46\x32\xd8\xf9\x87\x38\x7e\x8d\x5e\xbf\xd3\x77\x3b\xfc\x00\x67\xef\x70\xf4\xc3\x4e\x5e\x69\xc5\xeb\xf3\xee\xc9\x3d\xdb\x06\xca\xa4\x5e\xbd\x58\x14\x15\xe2\x71\x28\xd1\x3e\x60\x5f\x0d\xf4\xe2\x7e\xc4\x8f\xf5\xe1\x1e\xee\x9b\xfc\x9a\x01\x96\x2c\x79\xcc\x3f\x43\x32\x08\x37\xba\x6f\x7e\xcc\x3c\x4a\x04\x79\x96\x3f\x9b\xcd\x61\x76\x1b\xaf\xee\x67\x73\x17\x85\x13\x25\xb3\x70\x49\xd6\xc5\xe7\x5f\x72\xf6\x01\x7f\x87\xaf\x5b\xd5\x98\x9c\xb9\xcb\x62\x28\xe6\x24\xc5\x15\xde\x9e\xca\x92\x41\xd2\x0e\xc3\x30\x70\x57\x6c\x2e\xbc\x9b\x6f\x5f\xbb\x60\x2c\x44\xbd\x49\x69\xf6\x22\xa6\xef\x93\x21\xcd\xa9\xb6\x1b\x16\x27\x13\x37\xe6\x8f\x69\xa8\x74\x84\x02\x0c\x04\x4e\xdb\x6f\x77\x35\xa3\x66\x98\xf2\x81\x8c\x1b\x54\xd2\xbf\xbc\x32\x07\x1d\x1e\x6e\x56\xcc\x19\xb6\xeb\x77\x0d\xd9\xb1\xe3\xcf\x71\x27\x9d\x36\x20\xf5\x54\x3c\xb5\x63\xc6\xc1\xf6\xf1\xa2\xb7\xc3\x70\x0e\x4b\x4e\x19\xb0\x5c\xf4\x75\x19\x87\xc3\xd6\xa3\xe9\x3d\x87\xc3\xdd\xe5\xa5\x55\x18\x74\x87\xb8\xe1\x73\x43\x5b\x9f\x6d\x1e\xaa\x9d\x9f\xfb\x34\x71\xa8\x1e\xfa\x63\x57\xff\xe6\xa3\xc6\x31\x8f\xde\x9f\x8c\x58\x8f\x6c\x70\x69\x89\xd9\x60\xda\xe2\x79\xbc\x53\xb5\x2a\x43\xf7\xfd\xf0\x90\xdf\x3d\x64\xbd\xd0\xe0\xed\x58\x27\xec\x10\xed\xf3\x29\x27\xce\x49\x4e\x26\xb9\x36\x4a\x3e\xb1\x9c\x77\xf2\x5b\x87\xc6\x1b\x07\xb5\xd4\xaa\x82\x8e\xcb\x9e\x66\xae\x3c\xa1\x0b\x1f\xeb\xb3\x0f\xe2\xa3\x07\xa4\x3f\x21\x67\x9a\xcd\x9f\x95\x77\x1a\xa9\xc6\xba\xe8\x47\xf0\xc9\x7e\x86\x47\x38\xa4\x75\xe5\x79\x9e\x99\xb1\x8c\x1e\x2a\x69\x38\xd4\xd0\xf0\x09\x81\x61\xc8\xab\x10\xeb\xab\x0a\xa3\x8a\xf1\xff\xfc\xc4\xb2\x53\x7b\xa4\x54\x21\xaf\xfd\xb1\x59\x31\x78\x6c\x18\xda\x88\x6b\x7d\x83\xa4\xff\x56\xdd\x73\xf1\xce\x46\xf4\x8e\x5e\x26\x41\x44\x3d\xa0\x3f\x8a\x8a\xeb\x51\x86\xeb\x87\x2c\xa9\xf4\x2c\x41\x02\xe9\x3c\x49\x38\x4f\x12\xce\x93\x84\xf3\x24\xe1\x3c\x49\x38\x4f\x12\x3e\xf3\x49\xc2\x39\xcb\x15\x59\xae\x0d\x93\x4f\x2c\xe9\x9d\x36\x4a\x38\x2e\x35\x9a\x26\x6b\x42\xff\x3c\xd6\x21\x1f\xc4\x01\x0f\x48\x7f\x42\x42\x34\x9b\x6d\xd7\x1b\x32\xc8\xf9\xf4\xdd\xd3\x88\x3e\xd6\x47\x3f\x82\x53\xf6\x33\x3c\xc2\x23\xad\xab\xaa\xf0\xa9\x99\xf0\x3c\x00\x78\xe8\x01\x80\xd7\x37\x01\x68\xfd\xe7\x27\xa6\x5c\x38\xae\xc8\x69\x95\x8a\x7f\xcc\x5a\xa6\xad\x06\x67\xd0\x6c\x2d\xfb\xbc\x2a\x13\x23\xd6\xd8\xd0\xd7\xb6\xfc\x44\x91\x50\xc9\x6b\x18\x1d\x13\xf2\xfa\xc2\x5c\x5b\x37\x43\x54\x37\x3c\xf0\xc4\xac\xa5\x24\x6b\x02\xd7\x7c\x52\x9f\xc8\x49\xde\xf5\x7f\xf5\x55\xfb\xef\xe7\x2a\x90\xbb\xa3\x56\xd8\xcd\xb9\x71\x89\xfe\x20\xd5\x60\xac\xe4\xa4\xfe\x85\x59\x4b\xdd\xf5\xc8\xf5\xbf\x01\x00\x18\x7b\x72\xee\x47\x5c\x00\x00")
 
 func templatesSchemavalidatorGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -351,12 +503,12 @@ func templatesSchemavalidatorGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schemavalidator.gotmpl", size: 23424, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/schemavalidator.gotmpl", size: 23623, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\xdd\x6f\xe3\x38\x92\x7f\x3e\xff\x15\xb5\xc6\xec\x9d\xd5\x70\xcb\x8d\x7d\x3a\x64\x91\x03\xd2\xc9\xcc\x6d\xee\x7a\xbb\x83\x4e\xf6\xf6\x21\x68\x0c\x18\xa9\x6c\xf3\x22\x91\x1a\x92\x8a\x27\x63\xe8\x7f\x3f\xf0\x4b\xa4\x3e\xfc\x11\x77\x7a\xa6\x2f\x2f\xb1\xc5\x62\x55\xf1\xc7\x62\x7d\x51\x5e\x2c\xe0\x92\xe7\x08\x2b\x64\x28\x88\xc2\x1c\x1e\x9e\x61\xc5\xdf\xca\x0d\x59\xad\x50\xfc\x15\xae\x3e\xc1\xc7\x4f\x77\xf0\xe3\xd5\xf5\x5d\x3a\x99\x4c\xb6\x5b\xa0\x4b\x48\x2f\x79\xf5\x2c\xe8\x6a\xad\xe0\x6d\xd3\x2c\x16\xb0\xdd\x42\xc6\xcb\x12\x99\xea\x8d\x6d\xb7\x80\x2c\x87\xa6\x99\x4c\x26\x15\xc9\x1e\xc9\x0a\x61\xbb\x4d\x6f\xec\xc7\xa6\xd1\x0c\x7f\xf0\x03\x67\xe7\xe0\x47\xcc\x8c\xc5\x02\xee\xd6\x54\xc2\x92\x16\x08\x1b\x22\xbb\x5a\xaa\x35\x82\x53\x13\x14\xe7\x45\xaa\xe9\x7f\xcc\xa9\xa2\x6c\x05\xaa\x9d\x57\x1a\x55\x2a\xc1\x9f\x10\x96\xb5\x32\xac\xd6\xc8\xe0\x99\xd7\x20\xf0\xad\xa8\x59\x87\x93\x17\x61\xd6\x43\x58\x3e\x99\xd0\xb2\xe2\x42\xc1\x6c\x02\x30\x95\x4a\x50\xb6\x92\x53\xfd\x99\xa1\x5a\xac\x95\xaa\xa6\x13\xfd\x6d\x45\xd5\xba\x7e\x48\x33\x5e\x2e\x56\xfc\x2d\xaf\x90\x91\x8a\x2e\x34\x57\x4d\x2c\x2b\xcc\x76\xd2\x54\x98\x69\x9a\x8c\x33\x85\xbf\x2a\x98\xae\x78\x41\xd8\x2a\xe5\x62\xb5\xf8\x75\xa1\xa5\xb8\x11\x4d\x54\x70\x92\xcb\x5d\x9c\xcc\xa0\xa6\x42\x21\xb8\xd8\x49\x66\x47\x8d\x5a\x4a\x2c\x4b\xb5\x53\x31\x33\xaa\xe9\x44\xcd\x14\x2d\x71\x17\xa1\x1b\xd6\x94\x25\xcd\xf3\x02\x37\x44\x1c\x22\x5e\x04\x4a\xa3\x09\x66\xb5\xa0\xea\xf9\xd0\x2c\x4f\x67\x40\xdf\x6e\x41\x10\xb6\x42\x48\xaf\x70\x49\xea\x42\x5d\x9b\xad\x92\x60\xec\xae\x12\x94\xa9\x25\x4c\xff\xfc\xcb\x14\x52\x6d\x4f\x66\x82\xb3\xc6\x68\xf2\x0f\x8f\xf8\x3c\x87\x1f\x9e\x48\x51\x5b\x13\xec\x70\xd1\xa3\xd0\x34\xd0\x63\xe8\xc8\x7b\x5c\x13\x63\xb3\x1f\x71\xa3\xa9\x89\xcc\x48\x41\x7f\x43\x48\x3f\x92\x52\x93\x5e\xdc\x5c\x43\x26\x90\x28\x94\x40\x80\xe1\x06\x46\xc9\x80\x32\xa9\x08\xcb\x70\xb2\xac\x59\xb6\x8f\xdb\xcc\x98\xd5\x1b\xb3\xed\xe9\x15\xcf\x6a\x7d\x00\x13\x78\xb3\x53\xfa\x56\xef\x25\xaa\x5a\x30\xf8\xd7\x5d\x44\x9a\x06\x60\x4d\x58\x5e\xa0\x90\x67\xd0\xfd\x2b\xc9\x23\xce\x4a\x52\xdd\xdb\x93\xf0\x25\xfa\xa8\xcf\x42\xfa\x37\x3b\x2f\x99\x1b\x2e\x4b\x2e\x4a\xa2\x06\x4c\x9c\xdd\xf9\x5d\xb3\xb4\xb9\xfd\x72\xc9\x99\xac\x4b\x0c\x73\xa6\xdb\x6d\xbb\xbf\x7e\x10\x9a\x66\xda\x99\x75\x23\x78\x5e\x67\x3b\x66\xf9\xc1\x30\xeb\x16\xc5\x13\x8a\xdb\x75\xad\x72\xbe\x61\x41\x3d\x0d\xf8\x2c\x81\x2d\x40\x63\x09\x35\xc0\x03\xed\xdd\xf3\x88\xd5\x8f\xfa\x44\x75\xe9\xec\x21\x4b\xc3\xb0\x25\x7f\x4f\x24\xcd\x2e\x6a\xb5\x46\xa6\x68\x46\x94\x9f\xe6\xed\x3a\x6d\x09\x2c\xfd\xc5\xcd\xf5\x7f\xe3\xf3\x70\x42\x4b\x1f\x08\x9c\x00\x24\x02\xc5\x9e\x09\x81\xc0\x4e\x08\x87\x28\x42\xd7\xb9\xf9\xeb\xb2\x2a\x50\x1b\x15\x51\x94\x33\x77\xac\x06\x46\xe3\xe6\x89\x33\xcd\x6b\x38\x67\xbe\xdd\x62\x21\xf1\xe0\x64\x77\xc4\xbd\x1a\xe2\x27\xbd\x19\x66\x47\x04\x50\x9e\x7e\x46\x92\xa3\x98\x83\x22\x62\x85\x0a\x28\x53\x28\x96\x24\xc3\x6d\x93\x58\xb0\xc1\x5a\x6e\x6b\xe1\x6e\x07\x3e\x72\xd5\xaa\x84\xf9\x6c\xba\xdd\x1a\xd1\x4d\xa3\x1d\xae\x11\x04\x6b\x22\x81\x71\x05\xcf\xa8\xe0\x01\x91\x01\x0d\x13\xa6\x89\xe1\xda\x24\x7a\x19\x2c\x37\x07\x5e\x83\x66\x3e\x07\xec\x22\x1b\x7b\x11\x76\x6e\xde\x69\xd8\x85\xc9\x1e\x3b\xff\x24\x60\xb7\xd1\xd8\xfd\x53\x50\xa5\xb1\xcb\x89\x22\xaf\x81\x5c\xe5\xc4\x7c\x0d\x72\x0e\xb8\x4f\x95\x0e\xb7\x94\x33\xa9\x1f\xd2\x25\x30\x0c\x49\x80\xcf\x0c\xfa\xeb\x0f\x49\x42\xd8\x88\x21\x3c\xce\x17\x69\x68\xf7\xf1\x8d\xb8\xa5\x47\xb0\x0b\xd0\xba\x8d\xfe\x27\x55\xeb\x4b\x17\xbb\x9b\x26\x53\xbf\xfa\x48\x9e\xba\xa7\xf3\x10\x21\x2a\x22\x48\x29\x5f\x49\xa1\x1b\xc3\xcc\xf0\x4a\xf5\x79\xe6\x82\xfe\x86\x79\xd3\xcc\x4d\xa4\xca\x68\x45\x0a\x27\x89\x2b\x98\x01\xfe\xa2\xcd\xd4\x0f\x4c\x23\x33\x98\x42\xd2\x34\x6f\x5a\x25\x75\x7e\xe6\xe9\x5a\x84\x93\x28\xb4\xa7\x9f\x51\x56\x9c\xe5\x38\xb0\x9c\x88\xa6\x6f\x3d\xdc\x6f\xf4\x81\xd5\xef\xdf\xe6\x1e\x0a\x4d\x73\xa4\x09\xc6\xb6\xe7\x3e\x3b\x03\xbc\x75\x8e\xf1\x0a\x97\x94\xd1\xd8\x12\xd3\x6b\xd9\x7a\x63\x93\xe5\x5e\x54\x55\x41\x51\xda\xfc\x51\x27\x8d\x1e\x75\xbb\xae\xb5\xf1\x50\x40\x25\x48\x54\xb0\xa1\x6a\x6d\x88\x0c\x0f\x90\xd9\x1a\x4b\xf4\x1e\x37\x5a\xc6\xf5\x95\x8e\xbb\xb5\x5a\x9f\xd9\xf0\x53\x4b\x14\x60\x43\xea\x5c\xd3\x49\xf7\x25\x81\xd9\xd7\x6f\xe6\xdc\x9e\xed\xa4\xbf\x6f\x8c\x16\xf3\x5d\xc7\xfe\xc1\xe8\x4f\x6a\xb5\x06\xad\x82\xd3\x38\x39\x0a\xf8\x79\xf7\xcc\x5b\x50\x43\xc8\x1a\x47\xd5\xc4\x6e\x67\xe3\x53\xe3\x17\x6f\x79\x2d\x32\x9b\x1b\x19\x70\x8f\x80\x51\xf1\x47\x64\x7f\x34\x74\xa4\xa2\xa0\xf3\x47\x03\x5e\x8c\x5d\x70\xa5\x4b\xc1\x4b\xfd\xd5\x2e\x51\xfb\x56\x7d\xaa\xe1\x3e\xc2\xe0\xcb\x71\x50\xf7\x50\xfe\xa4\xc1\xf8\x4b\xff\x48\xed\x83\x69\x0e\x32\xe3\x15\x4a\xb8\xff\xf2\x07\xe3\xc6\x35\x60\x7f\x81\x07\x93\xaa\x0c\xd1\x7b\xb1\xe5\x8d\x7c\xd6\x20\x8d\x1e\x7d\x33\xbe\x58\xf8\xcc\xd2\x48\x37\x9e\xd5\x9c\xec\xf6\x5b\x0e\x25\x12\xa6\x4b\x4d\xc6\x41\xe0\x2f\x35\x4a\x25\x41\xd7\x3d\x0f\x05\xcf\x1e\x31\xf7\xe9\x5b\xeb\x99\xfb\x89\x5b\xcb\x69\x36\x70\x4f\xcd\x44\x57\xbf\x7b\xf2\x78\x97\x62\xb0\x25\x8f\x12\x0e\xb6\xe4\xe9\x15\xca\x4c\xd0\xaa\x4d\x39\x06\x4f\x6d\x49\x5e\x48\xcd\x4a\x1f\xb6\xed\x16\xd6\x75\x49\x58\xa7\x02\xd1\x65\x40\xd8\x4d\xf7\x01\xde\x2c\x26\xea\xb9\xc2\xf1\xaa\x45\xab\x25\x95\xa8\x33\x65\x36\xda\x54\x26\xd1\x5f\xaf\x48\x89\xca\xdd\x96\x22\x8a\x1d\x2e\x70\x4e\x42\x1d\x12\xea\x8f\x43\xa5\xc7\xa4\x2d\x3b\xfa\xe5\xc6\x67\x5c\x51\xa9\xc4\xf3\x64\x50\x6c\xb8\x03\x30\x19\xd4\x13\x61\xe0\xef\xa1\xb0\x0d\xa5\x42\xa4\xf2\xfb\x9a\x16\x39\x8a\x04\x3a\xba\x4c\x8c\x29\x0d\x93\xfe\xb6\xcd\xa0\x2b\x41\x9f\xbc\x75\x29\x8c\x63\x30\x9d\x89\xda\x38\xc8\x1c\x22\x47\xac\xa5\xeb\xdd\x4c\xad\x80\x6b\x65\xce\x04\x69\x8d\xb6\x3d\x10\xda\x0e\xa8\xeb\x70\xf8\x12\xdb\x45\xdb\x39\xac\xf9\x06\x9f\x50\x98\x56\x48\x46\x18\x08\xac\x0a\x92\x21\x50\xa5\x21\xd4\x8f\x85\x76\x47\x8a\x66\x75\x41\x04\xd4\x92\xac\x50\x4b\x1c\x59\x8f\x81\xa3\xb5\xed\x7f\x48\x14\x37\x44\xca\x88\x86\x72\x96\x8c\xaf\xd4\x2e\x61\xa4\xd0\x39\x09\x24\xeb\xd0\xbe\x03\x90\xc6\x16\x64\x51\xf2\xce\xd6\xff\xf7\xa8\xdd\x69\xd5\x5f\x00\xd9\x48\xa9\x77\x9a\x5d\x59\x37\xfb\xdd\x20\x37\xb6\xae\x2e\x72\x1e\xb1\x5b\x1d\xaf\xf2\x17\xe0\x36\x89\x12\x3f\x7f\xf8\x7d\x03\x73\x77\x6d\x0a\xc2\x78\x0e\xed\x86\x48\xa8\x1a\xf5\x1a\x88\x4d\x56\xfe\x8e\x39\x25\x77\xda\x37\xea\x8c\xa5\xa4\x25\x82\xf6\x94\x93\x7e\x92\x32\xe0\xdb\x2b\x79\x27\x71\x10\x68\x15\xf5\xce\x68\xb7\xa2\xbe\xec\xeb\x28\xda\x16\x69\xa7\x2b\x1a\xf8\xf6\xea\xcb\x71\x45\x77\xc5\xd3\xf1\x6c\x7a\x47\x62\xd2\x59\x83\x37\x44\x50\x6b\xa2\x40\x91\x47\x94\xa0\x13\x64\xa6\xf5\x23\x2c\x37\x09\xf2\x86\x8b\xdc\x7c\xb1\x99\x85\x5d\xbb\xcb\x3f\xac\x01\x53\x05\x15\x0a\x1d\x16\x6c\x04\x0f\x86\x62\xd3\xf4\xe0\x59\x07\x48\x04\xbd\x46\x0e\xaf\x49\x90\xe0\xb8\x0c\x09\xba\x29\x52\x4c\x19\x92\xa4\xc9\xa1\x64\xf9\x54\xd0\x88\x3f\xde\x27\xc2\xf4\x40\x24\xe6\xc0\x35\x03\xf0\x59\x6d\x94\xa2\x9a\xfe\x3a\xcd\x31\xf7\xde\x20\xca\x68\x8f\x83\xf4\x9b\x42\x09\x71\x4a\x0c\x5f\x07\x24\x03\x92\x65\x28\x65\x04\xa8\x76\x0a\x45\x81\x96\x96\x2f\x4d\x3a\x48\x05\xe6\x3e\x9f\x7e\x0d\xd0\xbb\x29\xb1\x95\xdd\x07\xdd\xa5\xa1\xc7\xda\x70\x27\xcd\x7f\x75\xe8\xe3\x4e\x7b\xf0\x14\xfb\xd2\x6e\x9b\x05\x84\x7c\xd9\xaf\x4f\x7a\xc4\x75\xca\x28\x78\x01\xb3\x8b\xcb\x0f\x8b\xcf\xef\x2f\x2e\x17\x17\xef\x2f\x2e\x13\x78\x78\x76\xa4\x3a\x1d\x6f\x77\x27\x86\xc4\x6e\x53\x40\x17\xf3\xce\x36\x74\xc5\xc6\xa1\xc3\x3e\x1a\x77\x77\x71\xeb\xca\x58\xd4\xb7\xe8\x5e\xe9\x72\x57\x9a\xa5\x84\x06\x8a\x4b\x8a\x8d\x63\xdf\x99\xc3\xb7\xe4\x1e\xf7\xd7\x57\x6d\x2f\xdb\x70\xc7\x77\x4c\x57\xad\x83\xf0\x62\x11\xb5\xd5\x75\xd5\x95\x91\xa2\xc0\xdc\x76\x08\x88\xeb\x4f\xea\xe7\x02\x33\xa4\x4f\x98\xcf\x35\x0c\x02\x4d\x81\xd6\x26\x29\xeb\x96\xf3\x62\x01\x0f\xb5\x6a\xf3\x10\x89\xca\x26\x1f\x7c\xc3\x7c\x9b\x86\xca\x49\xdc\xcb\x0f\x29\xbe\x49\xe7\x6d\xbf\x4b\xa2\xef\xa3\xbe\x71\x4f\x8d\x6d\xb5\x56\x1f\x69\xde\xde\x2d\xf4\xb5\xd7\xdb\xf5\xb7\xbb\xbb\x9b\xd9\x6d\x02\xd2\x50\x9a\x96\xc6\xba\x56\x60\xc8\xb5\x9d\xe6\x9c\xa1\xe5\x65\x96\x60\x8c\xba\x28\x80\x64\x8a\x3e\xa1\x3e\x04\xcc\xba\x1a\xe9\xa8\xd1\x56\xc3\xda\xf0\x2b\xd5\x1b\x7f\x86\x92\x0b\x9c\xf4\xaf\x3c\xdc\x4d\x87\x53\xf9\xb2\x96\x8a\x97\xfe\xc6\x13\x0a\xca\x10\x88\x58\x99\x4a\x0d\x56\x82\xd7\x95\x6c\xdb\x59\x54\x40\x1e\xaa\x49\x8d\xda\xa5\x9d\xf6\x81\x32\xfc\x64\x1f\xfe\xa7\x9d\x72\xff\x45\x6e\xc8\x2a\xdd\x31\xee\x64\xeb\x52\x41\x6f\x19\x65\x98\x43\xc1\xcd\x1d\x6c\x9c\x7e\x7e\xb0\x8f\xba\x57\x34\xde\x83\xa5\x69\x1a\x77\xb3\x27\xf6\xce\xf8\x16\x55\xff\xe6\xa7\x3d\x44\xde\x38\x2a\x3f\x52\xea\x8c\xc8\x26\x41\xe6\xba\x6d\xb6\xdd\xa6\x9f\xad\x59\x09\xd7\xa2\xd9\x59\x87\x27\x23\xa2\x66\x65\x9b\x62\x79\xef\xba\x9d\xfc\xcb\x80\x69\xda\x2f\x34\xcf\xa1\x9d\x38\x58\x46\x5b\xa6\xfa\x20\x12\xaf\x24\xf3\x83\xaf\xb5\x12\x2f\xed\x85\x2b\x69\x95\x1c\x5d\xc9\x6d\x85\x99\xdd\x05\x62\x7b\x03\x26\xa4\x6e\x68\x51\xc0\x03\xda\x93\x90\xb7\xfe\x2c\x2b\x28\x32\x25\xd3\x13\xd7\xa1\x65\xed\xb8\x1a\x1d\x5d\x80\x21\x3d\x37\x6a\x39\x85\xfb\xe6\x33\x86\xfb\x2b\x59\x50\xdf\x7c\x12\x07\xb6\x56\xd5\x75\xca\x0e\x1a\x4f\x57\xeb\xdf\xc3\x5a\xfa\xa6\xf2\x12\xad\xfd\x24\xa7\xf5\x4f\xae\x59\x13\x6b\xeb\x93\x30\x9d\x42\x59\xbe\xae\xa5\x73\x8a\xae\x4e\x80\xd5\x31\xee\x03\xed\x55\xd6\x0b\xb4\x4a\x7e\x76\x0a\x59\x5e\xdd\x7a\xd0\x3a\x4f\x4b\x0f\x4f\xa4\xa0\xb9\x29\x35\x4f\xd0\xb4\x2b\x65\x66\x8a\x1c\xef\xea\x1c\x7f\xb7\x04\x4b\x31\x0f\xe2\xfc\xc0\xff\xf8\x07\xb6\xdf\xba\x73\x5d\xe9\x45\x9e\x1b\x01\x9e\x73\xc4\xcb\xfb\x51\xc7\x0b\xfd\x08\xc6\x9b\xe3\x32\x9f\x90\xef\x8f\x2f\xea\x14\x18\xbc\xdc\x59\x7c\x3d\xf9\x44\x04\xd4\x2c\x32\x0c\x9f\xbd\x8e\x97\xf4\x13\xd0\x09\xed\x70\xf9\xfb\xeb\xf1\xf3\x73\x60\xb4\x70\x9d\xea\x8e\xb4\x73\x20\x55\x85\x2c\x9f\xc5\x4f\xe7\xa6\xa8\xde\xcd\xcf\xf4\xa2\xfb\x19\xf0\xa0\xa8\x7f\x91\xaa\x6d\x45\xfe\x4a\xaa\x7a\x7e\xfb\x54\xdd\x95\xaf\x1f\xa1\x75\xa8\x3c\x4e\xd1\xb7\x5f\x01\xef\xba\x44\x0f\x1d\xed\x11\xe9\x6d\x25\xa2\x39\xec\x5b\x66\x9c\xce\xef\x5e\xdd\x37\x49\xa4\x4f\x04\xe7\x75\x52\xef\x01\x26\x76\xf1\x05\xb2\x8e\xd0\x04\xfe\x03\xde\x39\x15\x9d\xd7\xd4\x0e\xc7\xa4\xcb\xcb\xd9\xb4\xa4\x52\x6a\x47\x1d\x7b\x87\x33\xf8\xb3\x9c\xfa\x5e\x89\x4c\xff\x8b\x53\xd6\x5f\xc7\x1c\xa6\x89\x95\x3f\x89\x6f\x86\x26\xcd\xa4\x53\x04\xfc\x64\x5a\x9b\x26\x7b\xb0\x2e\x21\x2e\x81\x08\xac\xe8\x13\xb2\xa8\x40\xa2\xf9\x69\xa9\x43\x24\x6e\xd6\x72\xbb\xbe\x6a\xf3\x9f\x17\x56\x04\xf1\xfb\x56\x43\x5b\x0a\xe2\xec\x6a\x3b\x9d\x4a\xd9\xae\x58\x7b\x57\xd2\x19\x6a\xf3\x24\x9d\xb1\xd0\x25\xd5\x51\xd2\x37\x5f\xed\x35\xf3\x49\x71\x72\x20\x7f\xe6\x98\xc5\x97\x2e\x5a\x64\xeb\x10\x6e\xcd\x78\x12\x8f\x8f\xf7\x9c\xb7\x07\x6b\x7f\x81\x52\xa7\x27\x67\xe7\x83\x37\xcc\x46\x39\x26\xf6\x96\x07\x6c\x04\xb3\x7a\xea\xc9\xf6\x28\x7b\xbd\xad\xb1\xca\x0d\x55\xd9\xda\x90\xb6\x77\x90\x07\x7d\x9b\xfe\xcb\x88\x34\x97\xd1\xe9\xf5\x55\xd3\x4c\xcf\x26\xbe\x04\x19\x69\x67\xfe\xac\xf3\xc7\x70\xc3\x6f\xcf\x88\x5e\xd1\xbd\x16\xfb\x05\xce\x47\xf6\x7f\x78\x8b\xf2\xa2\x36\x4c\xfb\xb2\x80\xc5\xa0\x6d\x84\xb6\x3d\x9d\x68\xc6\xe0\x0e\x36\x3a\xc4\xf1\xb5\xed\x71\xbe\xfc\x25\x5a\x8e\x68\x98\xb4\x3a\x04\xdf\x9c\xc4\x97\xb3\x31\xc6\x71\xfb\xf3\x20\xa2\x23\x57\x2e\xce\x86\x8d\xa1\x7b\x43\x49\xaf\xd9\x1c\x5e\x0c\x75\xef\x85\x82\xef\x03\x5d\xa3\xd4\x8b\x00\xf5\xaf\x05\x1c\x36\xcf\xe1\x2d\x4c\x17\xcc\xaf\x42\x70\xec\x5d\x83\xef\x08\x52\xaf\xde\x11\xd0\xc6\xdf\x1a\x17\x49\x9d\xa6\x16\x63\x1b\x59\xcd\x5b\x7c\xdd\x18\x17\xe6\xda\x7c\x3b\x6e\x41\x8e\x17\x43\xe1\x5d\x84\x53\x1d\xbc\x9d\x3d\x4b\x46\x9a\x9c\x47\x79\xe9\x1d\xb1\xac\xd3\x40\x3d\x7a\xc1\x3e\x4f\xee\x06\x3b\x7f\xc1\x36\x16\xe7\x42\xd5\x7a\x52\x88\x8b\x05\x86\xf6\x46\x6c\x84\x23\x81\xa7\xad\x0e\x02\x3e\x9d\x3a\xe3\x70\xe8\xf2\x1c\x7c\xd4\xfa\x79\x0e\xa5\x0a\xe1\x2a\x52\xa4\x13\xb1\x4a\x35\x8c\x57\x1d\xc9\x9d\x91\x8b\xa2\xb8\x45\x41\xcd\xaa\xc5\x30\x88\x05\x29\x06\x90\xee\x4d\xe0\x59\x3f\x6a\x1d\x9a\x30\xee\x32\xf6\x16\x43\x23\xc7\xe5\x35\x0f\x8f\x2f\x64\xba\xb6\xe4\xef\x40\xbf\x81\x2d\xc5\x02\x8f\xb6\xa5\xb6\x7c\x0b\xb6\xd4\x29\x04\x0f\xdb\x92\xe7\xf0\x0a\xb6\xd4\x91\xfc\xff\xc2\x96\xa2\xeb\xe7\x6f\x69\x4b\xfe\x4d\xdf\x50\x74\xc4\x2f\xf5\xb4\xa6\xd4\x5e\xbf\x85\xc2\xa3\x44\xb5\xe6\xb9\xbb\x99\x56\xeb\x53\xec\x2a\x08\x9f\x59\x6e\x73\xc3\x2a\x64\x1e\xb1\x2e\x73\x78\xe0\xbc\xb0\x61\x72\xb4\x60\x6d\xdf\x9f\xea\x94\x98\xf1\x2b\x78\x4b\x52\x48\x74\x70\xd5\xa5\xb6\x24\x5f\xaf\xdd\xf1\x7f\x54\x15\x7a\x35\x12\x2b\xe2\xe7\x39\xf0\x47\x4d\xb5\x5b\xd6\x7d\x5d\x7e\xf9\x2b\xfc\x89\x3f\x1e\x90\x46\x97\x76\x65\xe7\xe7\x30\x5d\x4c\x1d\xb1\x7d\x02\xd3\xa9\x23\x5a\x1f\x27\xef\x5e\xcf\xfb\x12\xb6\xd5\x4c\x0b\x61\xc6\xbc\x69\x16\x07\xd5\xe8\x77\x4a\xfe\x45\xb4\xbd\xb7\x69\x27\xb6\xb2\x9c\xe8\x59\x32\xf6\x7a\xdb\xee\x5d\xf3\x2a\x75\x36\x6d\x0f\x59\xe7\xbd\x6b\xdc\x7c\xe6\xb5\x22\x0f\x05\x7a\xe9\xe3\x2d\xef\xf9\x90\xe3\x5c\x8b\xeb\x97\xe3\xdb\x2d\x74\xc8\x20\x48\xd6\x00\x9f\x80\x8a\xce\x29\x9c\x01\x5f\x92\x6c\x8d\xb3\x5d\xcd\xca\x00\xdf\x62\x01\x39\x67\xff\xa6\x20\xd3\x5b\x46\x1e\x78\xad\x5c\x72\xa4\x4f\xf6\x1c\xfe\xb7\x96\xca\x5d\xd3\xaf\xd1\x08\x30\xde\xcb\xdf\x97\x56\x15\x32\xf3\x4a\xa6\xf5\xb9\x63\xad\x9e\xe1\x22\xc7\xcf\xce\xbe\x33\x76\xf4\xcf\xa3\xda\x8e\xcb\x9e\xde\xd3\x6e\x85\xee\x7b\x3f\x46\x9b\xd5\xfa\x9c\x6a\xb7\x6a\x0e\xaa\x79\x65\xb8\xa7\xf3\x57\x32\x1b\x2c\x6c\x7c\x35\x3b\x4f\xe8\x61\x19\xf7\x36\x49\x37\xe9\xb9\x76\x01\xda\x23\x34\xcd\x74\xda\xed\xed\xc5\x3c\xb2\x02\x09\x33\xb4\x66\x46\x12\xf7\xfa\x6c\x4c\x79\x51\x8b\x6c\xd7\xef\xec\x46\x4e\x4f\x16\x7e\xd7\xf1\xfb\x34\x08\x93\x5e\xc7\x32\x0e\x56\xa6\x8d\x14\xfd\xae\x50\xef\x4c\xdb\x1e\x53\xdc\xde\xad\xd9\x5f\x2b\xdc\x5c\x03\x7f\x42\x61\x2e\xa0\xf5\xd4\x8c\x30\x78\x40\xa8\x25\xe6\x90\x53\x81\x99\x2a\x9e\x81\x32\x1b\xed\x3e\xe8\xa2\x83\x5d\xb0\xdc\x08\x98\x4d\xcf\xfe\xfd\xdd\xbb\x77\xd3\x39\x90\x8a\xda\xd6\xd5\x4c\xfb\x8a\xe4\xe4\x46\xdb\xec\xc1\xbe\x31\x0b\x87\x5e\xa2\x75\xbe\x61\x68\xc1\xd7\x8c\x2a\x7b\x87\x3d\x72\x5e\x9a\x26\x8d\x5e\xd9\xfd\xd3\x48\xf4\x1b\x63\x19\xa6\x78\xf5\x92\x6e\x26\xb1\xd3\x18\x74\x09\xe4\x14\x0e\x53\xed\x0e\x69\x3d\x81\x14\x05\xdf\x48\xf3\x0e\x82\xe2\xd6\x5d\xb5\x5e\xca\x6e\x8f\xdf\xb3\x4c\xbb\xc4\x79\xfb\xb6\x82\xae\xbf\x41\x60\xc6\xcb\x8a\x4b\x6c\x83\x17\x16\x66\x61\xc4\xb2\x94\x88\xb0\xa4\xea\x94\xcd\xb0\x28\xfa\x58\x54\x20\x1b\x31\x78\x7f\x8e\x13\xed\x56\xde\xed\x74\x84\x43\xbf\xee\xde\x65\xff\xbf\x00\x00\x00\xff\xff\x44\x51\xd7\xa7\x66\x3e\x00\x00")
+var _templatesServerBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7f\x93\xdb\x36\xb2\xe0\xdf\xa7\x4f\xd1\xd1\x25\x3e\xd2\xa1\x25\x67\x37\xbb\xf5\x6a\x92\xd9\x2a\x67\x1c\x6f\xe6\x3d\xc7\xf1\xcd\xd8\x6f\xff\x70\xb9\xb6\x30\x24\x34\xc2\x9a\x22\xb5\x04\xe8\xf1\xac\xa2\xef\x7e\xd5\x40\x37\x00\x52\xa4\x46\x23\xdb\x9b\xbc\xaa\xcb\x56\xad\x47\x24\xd0\x68\x34\x1a\xfd\x1b\xe0\x7c\x0e\x67\x75\x21\xe1\x5a\x56\xb2\x11\x46\x16\x70\x75\x0b\xd7\xf5\x23\x7d\x23\xae\xaf\x65\xf3\x1d\x3c\xfd\x05\x5e\xfc\xf2\x0a\x7e\x7c\x7a\xfe\x6a\x36\x99\x4c\x36\x1b\x50\x0b\x98\x9d\xd5\xeb\xdb\x46\x5d\x2f\x0d\x3c\xda\x6e\xe7\x73\xd8\x6c\x20\xaf\x57\x2b\x59\x99\xde\xbb\xcd\x06\x64\x55\xc0\x76\x3b\x99\x4c\xd6\x22\x7f\x27\xae\x25\x6c\x36\xb3\x97\xee\xcf\xed\x16\x01\x7e\xc9\x2f\x4e\x4e\x81\xdf\xd8\x1e\xf3\x39\xbc\x5a\x2a\x0d\x0b\x55\x4a\xb8\x11\xba\x8b\xa5\x59\x4a\x20\x34\xc1\xd4\x75\x39\x9b\xcc\xe7\xf0\x63\xa1\x8c\xaa\xae\xc1\xf8\x7e\x2b\x8b\xe6\xba\xa9\xdf\x4b\x58\xb4\xc6\x82\x5a\xca\x0a\x6e\xeb\x16\x1a\xf9\xa8\x69\xab\x0e\x24\x1e\xc2\xce\x47\x54\xc5\x64\xa2\x56\xeb\xba\x31\x90\x4c\x00\xa6\x57\xb7\x46\xea\x29\xfe\x95\x37\xb7\x6b\x53\xcf\x1b\x51\x15\xf6\xb7\xac\xf2\xba\x50\xd5\xf5\xfc\x4a\x68\xf9\xe7\x6f\xbb\xcf\xfe\xa1\xeb\xca\x3e\x59\xac\x8c\xfd\x57\xd5\xf4\xcf\x5c\xd5\x88\x93\xfd\xb5\x16\x66\x69\xff\xd0\x75\xe3\x9a\x69\xd3\xa8\xea\xda\x0d\xa8\x6f\xab\xdc\xfe\x51\x49\x33\x5f\x1a\xb3\xf6\x3f\xda\xa6\x9c\x4e\xf0\xc7\xb5\x32\xcb\xf6\x6a\x96\xd7\xab\xf9\x75\xfd\xa8\x5e\xcb\x4a\xac\xd5\x1c\x69\x84\x6d\xf5\x5a\xe6\xa3\x6d\xd6\xd2\x02\xcf\xeb\xca\xc8\x0f\x06\xa6\xd7\x75\x29\xaa\xeb\x59\xdd\x5c\xcf\x3f\xcc\x71\x44\x7a\x83\x8d\xca\x5a\x14\x7a\x0c\x92\x7d\x89\xad\x64\xd3\xd4\xcd\x68\x33\xf7\x16\xdb\x69\xd3\x2c\x56\x66\xac\x9d\x7b\x8b\xed\x9a\xb6\x32\x6a\x25\xc7\x1a\xd2\x6b\x6c\xb9\x52\x45\x51\xca\x1b\xd1\xdc\xd5\x78\x1e\x5a\x62\x3f\x2d\xf3\xb6\x51\xe6\xf6\xae\x5e\xdc\xce\x12\x7d\xb3\x81\x46\x54\xd7\x12\x66\x4f\xe5\x42\xb4\xa5\x39\xb7\xec\xa2\x61\xbb\xdd\x6c\x60\xdd\xa8\xca\x2c\x60\xfa\xd5\x3f\xa7\x30\x43\x9e\x06\x08\x3b\x22\xea\xfc\xe5\x3b\x79\x9b\xc1\x97\xef\x45\xd9\xba\x6d\xd0\x81\x82\x6f\x61\xbb\x85\x1e\x40\x6a\xde\x83\x9a\x4e\x70\x1f\xbc\x90\x37\xd8\x5a\xe8\x5c\x94\xea\x5f\x12\x66\x2f\xc4\x4a\xc2\x76\xfb\xe4\xe5\x39\xe4\x8d\x14\x46\x6a\x10\x50\xc9\x1b\x18\x6c\x06\xaa\xd2\x46\x54\xb9\x9c\x2c\xda\x2a\xdf\x07\x2d\xb1\x6c\xf5\xd0\x2e\xfb\xec\x69\x9d\xb7\x28\x04\x52\x78\x38\xd6\x1e\x36\xb8\x96\xd2\xb4\x4d\x05\x0f\xc6\x1a\x61\x1b\x80\xa5\xa8\x8a\x52\x36\xfa\x04\xba\xff\xad\xc4\x3b\x99\xac\xc4\xfa\x8d\xdb\x1e\x6f\xa3\x3f\x71\x5f\xcc\x7e\x72\xfd\xd2\xcc\x42\x59\xd4\xcd\x4a\x98\x1d\x20\xc4\x77\xbc\x6a\xae\x6d\xe1\x7e\x9c\xd5\x95\x6e\x57\x32\xf4\x99\x6e\x36\x7e\x7d\xf9\x25\x6c\xb7\xd3\x4e\xaf\x97\x4d\x5d\xb4\xf9\x48\x2f\x7e\x19\x7a\x5d\xca\xe6\xbd\x6c\x2e\x97\xad\x29\xea\x9b\xca\x77\x02\x24\x78\x92\xc2\x06\x60\xeb\x1a\x22\x81\xc3\xeb\xf0\x1f\x3e\x8f\x40\xfd\x88\x3b\xaa\xdb\xce\x6d\xb2\x59\x78\xed\x9a\xff\x20\xb4\xca\x9f\xb4\x66\x29\x2b\xa3\x72\x61\xb8\x1b\xf3\xf5\xcc\x37\x70\xed\x9f\xbc\x3c\xff\x2f\x79\xbb\xdb\xc1\xb7\x0f\x0d\x68\x00\x29\x1a\xd9\xec\xe9\x10\x1a\xb8\x0e\x61\x13\x45\xd4\x25\x55\x73\xbe\x5a\x97\x12\x99\x4a\x18\x55\x57\xb4\xad\x76\x98\x86\xfa\x35\x27\xc8\xcf\xbb\x7d\xb2\xcd\x46\x96\x5a\xde\xd9\x99\xb6\x38\xa3\xd1\x3c\xc3\xc5\xb0\x2b\xd2\x80\xaa\x67\x17\x52\x14\xb2\xc9\xc0\x88\xe6\x5a\x1a\x50\x95\x91\xcd\x42\xe4\x72\xb3\x4d\x1d\xb1\x2d\x77\x03\x78\x0e\xa7\x15\x78\x51\x1b\x8f\x92\x2c\x92\xe9\x66\x63\x37\xda\x76\x0b\x39\x0d\x04\x4b\xa1\xa1\xaa\x0d\xdc\x4a\x03\x57\x52\x56\xa0\x42\x87\x69\x6a\xa1\x6e\x53\x9c\x46\x55\xd8\x0d\x8f\x44\xb3\x7f\x07\xda\x45\x3c\xe6\x68\x27\xff\x49\x53\x9c\x5a\x05\x34\x46\x3b\xea\xd7\x9c\xc0\xcb\xba\x2e\x65\xf1\x9f\x97\xbf\xbc\xe0\x67\x09\x8e\x09\x48\xbb\x7b\x2c\x46\x00\x78\xc4\x62\x84\xce\xbc\x18\xfc\x24\x2c\xc6\x0d\x2e\xc6\xdf\x1a\x65\x70\x31\x0a\x61\xc4\xa7\x58\x8a\x35\x0d\x73\xfc\x52\xd0\xdf\xb4\x1c\x97\xc4\xed\x4f\xe5\x42\x55\x0a\xe7\xae\x71\xca\x96\x8c\xda\x6f\x31\x6b\x3e\x3d\x59\xaf\x4b\x25\xb5\x33\x4c\xd0\x1a\xc1\xbd\x53\x37\xea\x5f\x8e\xcc\x4b\xcb\x76\xa0\x34\x68\x69\xe0\x46\x99\xa5\x35\x59\x2c\x0c\xd0\xf9\x52\xae\x24\x0d\x1d\xd3\xf3\xfc\x29\x0a\xd3\xd6\x2c\x4f\x9c\x4c\x69\xb5\x6c\x50\xea\xa9\xea\x3a\xc3\x76\x9a\x7e\xa4\x90\x58\xac\x90\xfb\x12\xcb\x33\x2f\x1b\x55\xe5\x6a\x2d\x4a\x98\x46\x74\x9d\x42\xba\xdd\x3e\xf4\x8a\x66\xb3\x09\xed\xb6\xdb\xcc\xd1\x37\xed\x53\xbd\x52\x65\x36\x46\xfa\x2b\x8b\xbf\x68\xcd\x12\x10\x05\xc2\x38\x3d\x88\xfe\x2c\x37\x68\x0b\x38\xa2\x06\x39\x34\x4c\x55\x2b\xc6\x89\xcd\xa6\x48\xad\xd9\x65\xdd\x36\x39\xee\x01\x22\xee\x01\x64\x34\xf5\x3b\x59\xfd\xd6\xa4\x13\x6b\x05\x68\x14\x58\xe2\xc5\xb4\x0b\xec\xbc\x68\xea\x15\x9a\xda\x6e\x8a\xdb\x2d\xac\x45\x23\x56\xf0\x26\xa2\xc1\xdb\xc3\x48\xdd\xa3\xf2\x2f\x48\x8c\x3f\x6c\xb7\x87\x93\x29\x03\x9d\xd7\x6b\xa9\xe1\xcd\xdb\xdf\x98\x6e\x35\x12\xec\x0f\x70\x65\xf5\xcf\x2e\xf5\xee\xcd\x79\x03\x7f\xab\xc5\xc8\xd6\xb7\xef\xe7\x73\x36\x17\xec\xe8\xb8\xc7\x65\x83\xcc\xe7\x7f\x15\xb0\x92\xa2\x42\x1f\xa6\xaa\xa1\x91\xff\x6c\xa5\x36\x1a\xd0\x98\xbd\x2a\xeb\xfc\x9d\x2c\x58\x27\xb3\x8c\x90\x7d\x6d\xec\x21\x25\x69\xd6\x43\x70\x3b\x41\xb7\x6a\x8f\x71\x46\x3a\xb7\x5a\xd4\x91\x06\xae\x16\xf5\xec\xa9\xd4\x79\xa3\xd6\x5e\xec\xef\x3c\xb5\xcd\x51\xae\xc3\x76\x8b\x9b\x6d\xb3\x81\x65\xbb\x12\x55\x3c\x04\xa2\x1d\xad\x26\xfd\x01\x0f\xe7\x13\x73\xbb\x96\x30\x8a\x96\x36\x4d\x9b\x1b\xbb\x41\xd0\xea\x61\xfb\x06\xff\xd7\xb3\x3c\x23\x1f\xc6\xb7\x08\x56\x3e\xea\x75\x7c\x37\x09\xc6\x25\xb7\xba\xdb\x9e\x9c\x78\x5b\xb2\x6f\x43\x5e\xc8\x6b\xa5\x4d\x73\x3b\xd9\xb1\x20\x69\x03\x84\x17\x5e\x47\xfb\x17\x3f\x7b\xec\x22\xfb\x2f\x42\xf9\x87\x56\x95\x85\x6c\x52\xe8\xe0\xe2\x4c\x7e\x5c\x9d\xbf\x29\xb3\xfc\x49\x8a\xd2\x2c\x61\xbb\x5d\xda\x3f\xce\x96\x32\x7f\xa7\x11\xd8\x9b\xb7\xd1\x13\x6b\x78\x8b\x42\x55\x52\x6b\x6a\xd2\x7d\x1f\xfb\x11\xf3\x39\xa8\xea\x59\x69\x9d\xe7\xbc\x6e\x2b\xa3\xad\xce\xf1\x0c\x79\x25\x91\x45\x35\x9a\x96\x36\x66\x50\xaf\xd1\x33\x47\xee\x38\x7f\x9a\xc1\xd3\x46\xa8\x0a\x6e\x84\x32\x1a\x89\x86\x5d\x57\x13\xf0\x10\x9f\xd7\xf9\x3b\x40\x87\x76\xf6\x73\x6b\xe4\x87\xe8\x4d\x7f\x2d\x54\x65\x90\x78\x08\x0e\xc7\xc3\xb7\x57\x75\x5d\xf2\x33\x59\x80\x7d\x96\x2f\x85\x15\x36\x6d\x6e\x36\x5b\x74\xcb\xe6\x73\xc6\x0d\x09\x6b\x31\xaf\x5b\x74\x7a\xea\x05\x22\x03\x79\xdb\x34\x18\xad\x40\x76\xca\xe0\x42\x22\x17\x41\x23\xd7\xa5\xc8\xa5\x66\x74\x1d\x84\x80\xec\xc5\xdf\x18\x5d\x82\x8d\x43\xf7\x58\xe4\xca\x2d\x17\xbe\xd9\x5d\x44\x5c\x01\x44\xe3\xa5\x30\xcb\x20\x0d\x09\x5f\x6f\x12\x78\xa3\xd9\xc7\x3b\xd0\x57\x63\x6b\xa8\xdb\xc2\x4a\x79\x9c\x90\x6e\xad\xb6\x2b\x20\xd2\xaa\xc8\x4a\xb8\x61\x67\x6e\x80\x73\x63\xe5\xbd\x60\x5e\x0c\xd2\x0d\x97\x4d\x51\x1c\x84\xc4\x08\x50\x50\x26\x83\x65\x7d\x23\xdf\xcb\xc6\x06\x4c\x72\x51\x31\x95\x40\x19\xbb\xb4\xb7\x75\xdb\xa0\x6e\x31\x2a\x6f\x4b\xd1\x40\xab\xc5\xb5\xc4\x11\x07\xe6\x83\x08\x25\x5e\x50\xbd\xd6\xb2\x79\x29\xb4\x8e\xda\xa8\xba\x4a\x87\x67\xea\xa6\x10\x34\xfc\xc7\x11\xc9\x69\xa7\xdf\x01\x91\x86\x26\xe4\xa8\xc4\x9a\x93\xff\x65\xaa\xbd\x42\xd4\xef\x41\xb2\xe0\x6b\x7d\x1c\xc9\x48\x67\xfe\x6e\x28\x37\x34\xaf\x2e\xe5\x98\x62\x97\x79\xbd\x96\xc5\x3d\xe8\x36\x89\xac\x78\x96\xe4\x1c\xe6\xdc\x55\x50\xd4\xa2\x81\xc6\xaa\x01\xd9\x20\x55\xbd\x5f\x87\x73\x10\xce\xf2\xfc\x59\x16\x4a\xbc\x42\x45\xb7\xdd\x4e\x61\x85\xc1\x2c\x54\x7b\x13\xb8\x0b\x2e\x21\xc9\x0f\x26\xb1\x46\xf7\x88\xb2\x66\x19\x47\x94\x5a\x74\x11\xf5\x5e\xcf\xf1\x88\x06\xb8\x84\x28\x3f\x18\x46\x74\xcc\x38\x62\xfb\xd2\xcb\x8d\x81\x99\x78\x2b\xb3\x33\x07\x66\x44\x30\x4b\x61\xc0\x88\x77\x52\x03\x7a\x3b\x15\xe2\x27\xaa\x02\xad\x0a\x7d\x53\x37\x85\xfd\xe1\xcc\x44\x37\x77\x32\x26\x1d\x03\x2b\x03\x6b\xd9\xa0\x8e\x77\xe6\x58\x60\x14\xe7\x73\x05\xc9\x3a\x81\x51\xbc\x06\x36\xaf\xb5\x76\xe1\x30\x73\x17\xba\x7e\x42\xdc\x32\x58\xbc\x81\xae\x4c\xb3\x20\x46\x3e\x8a\x68\x82\x05\xe3\x91\x64\xc2\xd8\x77\x01\x75\x05\xa2\x02\x76\x51\x22\x7f\xc3\x46\xe1\x55\x21\x0b\x96\x06\x91\x7b\x72\x18\x49\x3f\x2b\x29\x21\xf6\x6f\xe0\xe3\x08\x59\x81\xc8\x73\xa9\x75\x44\x50\x14\x0a\x65\x29\x5d\xdb\x7a\x61\x6d\x7b\xd5\xc8\x82\x9d\xa3\x4f\x41\xf4\xae\x7f\xe3\xc6\xee\x13\x9d\x4c\xb8\x43\x79\xf8\xcd\xdb\xcf\x49\x7a\x6a\x13\x96\x61\x72\x97\x0f\x35\x9f\x77\x9d\x1f\x9e\x9f\x66\x8a\x63\xa6\xa2\xa9\x4b\x48\x9e\x9c\x3d\x9f\x5f\xfc\xf0\xe4\x6c\xfe\xe4\x87\x27\x67\x29\x1a\xa9\xae\x29\x1a\x92\x7e\x75\x62\x92\xb8\x65\x0a\xd4\x95\x45\x67\x19\xba\xc3\xb2\xb0\x0b\x8f\x86\xc5\xdd\x2f\x6c\x18\xb3\x64\x46\x12\xca\x90\xe8\xe2\xec\x57\xdf\xa1\xe6\xf7\xdb\x6d\x60\xd0\x5d\xd9\x4b\x86\x27\x06\x86\xac\xd9\x1a\xd9\xe1\xe4\xe1\xb0\xfd\x3d\xec\x90\xf9\xe6\x13\xf8\x5c\xa8\xed\x05\xcb\x0f\xb7\xdb\xd9\x01\xb0\x3a\x14\x9e\xcf\xa3\xc0\x37\xba\xd0\xb9\x28\x4b\x59\xb8\x70\x8f\xa0\x80\x1f\x3e\x6f\x64\x2e\xd5\x7b\x59\x64\x48\x86\x46\x82\x8a\x8d\x14\xa2\x92\x83\x77\xd5\x1a\x6f\x87\x60\xa8\xcd\x1a\x1f\xf5\x0d\xc9\x7f\xcc\x29\x4e\xe2\x68\x7b\xf0\xd7\xac\x13\x70\x21\xf5\xba\xae\xb4\xe4\xc0\xe4\x43\x7a\x6a\xb7\x9b\xe7\xfa\x08\xf3\x17\xb5\x79\x56\xb7\x55\x91\x39\x98\x3f\x4b\xb3\xac\x8b\x17\xb5\x79\x52\x96\xf5\x8d\xe4\xc7\xaf\x2b\xb4\xed\xeb\xc6\xc8\xc2\x2b\x66\x7a\x85\x6d\xf3\x5c\xae\x8d\xb8\x2a\x9d\xa6\xe3\xc7\x51\xd4\xc3\x0d\x88\x6e\x10\x11\x08\x73\x3b\x52\x14\x50\x2f\xe2\xb9\x30\x9b\x50\xde\x8e\xa3\x8c\x0a\x63\x86\xc2\xb4\x1a\x92\x6f\x1f\x7f\x9b\xc1\xb7\x8f\xff\x94\xc1\xb7\xdf\xe0\xff\x3d\xfe\xb3\x1d\xf2\x4f\x8f\xbf\x49\x33\x1f\x64\xbb\xb5\xa1\x0a\x17\x4a\x63\x64\xec\x24\xd9\x67\x3e\x8a\x68\x30\x4c\xa1\x8f\x81\x35\x44\xd6\x63\x61\x75\xd7\xe1\xe3\xe6\xd8\x5d\x3c\x80\x8f\x65\x32\x9f\x62\xea\x6f\x11\x5c\xec\x9f\x5e\xbd\x7a\x99\x5c\xa6\xce\x57\xb6\x71\x28\xbd\x6c\x0d\x60\x46\xca\xae\x6d\x51\x57\x18\x5a\x9e\xcf\x5d\xbc\xc4\x4a\xce\xb2\x04\x91\x1b\xf5\x5e\x62\xa4\xa5\x72\xfa\x4c\x53\x6b\xe9\xe2\x67\x28\x5d\xd7\xa6\xf7\xfe\x16\x56\x75\x23\x27\xd0\x47\xcb\xd2\x9c\x51\xfe\x59\x7c\xf8\xa1\x2e\x6e\x2f\x71\xf3\x2b\x27\xd1\x56\xe2\x83\x5a\xb5\x2b\xd0\xf6\x59\x05\x57\xb7\x91\x1f\xcf\x92\xfb\xaa\x2e\x54\x78\xea\xa5\x9a\xb6\x3b\xb7\x6e\x0d\x7c\x78\xb4\x12\x1f\x1e\x5d\xd5\xc5\xed\x23\x04\x84\x81\xb1\xf9\x1c\x1e\x5b\xe9\x58\xd5\x50\xaa\x95\x32\x27\x20\x3c\x40\xec\x07\x02\x4a\xcc\xf4\x34\x80\xfd\xe0\x1a\x65\xac\x80\x6f\xbf\xf9\xe3\x04\xba\x88\x56\xe6\xcf\xdf\x86\x09\xfc\x64\xc3\xf5\x67\x18\x34\xe9\xcf\xa1\x6a\x57\x57\xb2\xc1\x9d\x47\x31\x7d\x9b\xc2\xb5\x78\xfb\xa1\xb3\x3e\x56\xb4\x81\xbb\xa8\x21\x2d\x09\x88\xf6\x98\xfd\xf1\x9b\x09\xec\x60\x50\x19\x42\xed\xd2\x34\x2a\x37\x97\xa5\xd0\x4b\xb7\xe0\x0e\x37\x2c\x3b\xd0\x50\x57\xe5\x6d\x48\x2d\x98\x46\x28\xbb\xda\xda\xb6\x76\x64\x55\x8d\x8b\x5e\xb0\x21\xe1\x62\x27\xf8\x57\x6d\x96\xa8\x88\x2d\x20\xdc\xfe\xc8\x03\x0b\xdc\xf4\xe4\x1a\xfe\x82\xef\x6f\x94\x96\x20\xfa\xa0\x95\x06\x75\x5d\xd5\x0d\x8a\x3b\x39\xbb\x9e\xc1\x7c\x2d\x8d\x9e\x23\xe1\xec\x60\xe8\xab\xbb\x67\x13\xe8\xcc\xc0\x86\x81\x1c\xf8\x0b\x59\xa8\x46\xf2\x8b\x86\x7e\xc5\xb3\xb3\x13\x13\x95\xc3\xb3\x87\x81\xc1\xd8\xd1\xc8\xf4\xd8\x40\x40\x28\x9e\xe5\x10\x2d\xe2\x1f\xe2\x92\x3f\x3e\xfe\x0f\xd0\xae\xe5\xca\x8a\x28\xbb\x1d\xf0\xa7\x65\x1c\x24\xc9\x3b\xb9\x46\x91\xd8\x45\x35\x9a\xc3\x99\xd0\xf2\xbc\xd2\xb2\xd2\x0a\xf7\x96\x8b\x10\x59\x7c\xe2\x79\x34\xf2\x5a\x34\x45\x29\xb5\x67\xf5\x5c\x68\xc9\x7f\x97\xa8\x77\x44\x09\x5a\x5e\xa3\xf4\xd0\xfb\xd6\xcd\xe1\x8f\x3f\x49\x03\x5a\x2e\x1a\x9e\x6c\xe0\x8b\xc0\xae\x18\xd9\xb3\x19\x05\xe9\xcc\x60\x4c\x9f\xd8\x58\xdd\xe0\x44\xe2\x89\xb6\xda\xd4\x2b\x2e\xaf\x81\x52\x55\x12\x44\x73\x6d\xa3\xb7\x70\xdd\xd4\xed\xba\xa3\x7c\x8a\x10\x61\xd6\x08\xdd\x75\x7b\xae\x2a\xf9\x8b\x0d\x3b\xeb\xbf\xba\x2e\x6f\xde\x62\x9d\xcb\x6c\xe4\x3d\x8d\x8d\x11\x27\x0c\x4f\xd8\xc0\x61\x59\xdb\x82\x1f\x36\xdf\x91\x55\x9f\xbb\x47\xfe\xbf\x8e\x21\x3c\x9b\xcd\x22\x2b\x37\xb5\x91\x74\x96\xb1\x18\x3b\xa7\xad\x7e\xd5\x6a\x1b\x61\x85\xb2\xbe\x56\x39\xd3\x71\x2c\x1a\x9e\xb9\xb9\xd6\x95\xe7\x9c\xb5\x6c\x82\xfc\xb2\x35\x4d\x67\x75\xb5\x50\xd7\x2d\xc5\x31\x71\x28\x62\xe7\x10\x61\x11\xec\x54\xa0\x8d\x12\x92\xa1\xb1\xaa\xd7\xd2\xd8\xca\x28\x5c\x38\xbf\xe4\x38\x2e\x06\x6f\x2b\xe9\x22\xf0\xd1\x6c\x3c\x8c\xcd\xbf\xcf\x9a\x25\xc4\xf4\x6f\x62\xaf\x92\x7b\x63\x03\xea\x94\x27\x80\xed\x36\x37\x1f\x38\xa3\xc0\xd9\x83\x2c\xf8\x2d\x76\x07\xe8\x3b\x30\x89\xc6\xdf\x6b\xe4\xbe\xb4\xc0\x2c\xac\x28\x89\x83\x6e\xbf\xf7\x42\x68\xa4\xbb\x3d\xb0\xae\x03\x16\xda\x79\x12\xa4\x71\x78\xda\x59\xae\x45\xc7\xc0\xde\x4e\xba\xbc\xe7\x3d\x8c\xc0\x3c\x0b\x10\x65\xd9\x57\xb8\x24\x31\x1d\x37\x5b\x11\x34\xc8\xa8\x9e\xd3\x5c\x85\x51\xb2\xd9\xcc\x2e\x9c\x9d\xde\x50\x02\x73\x34\x4b\x95\x06\xac\x12\xdc\x01\x81\x6b\xd3\x71\x66\xdd\x81\x3f\xfb\x4c\xee\xce\xe9\x27\xe2\x06\x82\x67\x8b\x1e\x70\x96\x9f\x1a\xdf\x28\xf4\x81\xa2\x2c\x94\x91\x78\xaa\x91\x64\xdd\x6e\x27\x3b\x91\x10\x82\xf1\x31\xc2\x2f\xb0\xcc\x21\x32\xf0\x39\xda\x61\x28\x30\xd1\x86\xad\xe0\xca\x1a\x9f\x8e\x07\x0a\x17\xac\xd6\x18\x45\xb6\xca\xaf\x79\xaf\x72\xa9\x33\x90\x22\x77\x92\xd5\x73\x1f\xca\x3f\x64\xd7\x20\x20\x91\x3d\x9d\xd6\x41\x4e\x0d\x38\xed\x49\x48\x46\x93\x3e\x50\x46\x7e\x02\x49\xf7\xff\xe5\xd5\x3d\xe5\xd5\x20\xc6\xc3\x42\xec\x00\x16\x3d\x54\xaa\xed\x67\x18\x2f\xea\xe0\xcb\x8e\x30\x82\x1d\x69\xf7\xe5\xb0\xb8\x1b\x04\xef\x64\xe0\xfe\x91\xf7\x0a\xc6\x5d\x6c\xfe\x07\xca\xc6\x3b\x25\x9c\x67\x2f\x64\x93\x4b\x69\xfa\x85\x99\x9e\x35\x38\x32\x44\x99\x11\x0d\x2b\x0c\x0f\x00\x0a\x84\x63\x74\xd5\xee\x50\xc9\xca\xc7\x1b\x38\xb4\xba\x99\xfc\xaf\x5d\x05\x55\x74\xbb\xc1\x29\xf8\x8e\xde\xf8\x64\xd8\x94\x1b\xd2\x3e\x82\x1c\xcf\x84\x92\x51\x9f\x6e\x26\x3c\xda\x3d\x67\xe2\x91\x1c\x9c\xc9\x25\xfa\x5b\x76\x15\x04\xf9\x5e\x98\xcd\xb9\x51\x65\x89\xe2\x9e\x32\xee\x1c\xa5\xca\x4b\x85\x1e\xce\xec\xc8\x79\xe0\x58\x23\x95\xcb\x83\x13\xb0\x4d\x4f\x2d\x5a\x84\xf0\xd3\xde\xe2\x0c\xd1\xfd\x13\x71\x50\x6f\xa8\x24\x25\x62\x23\xad\xa9\xe6\x69\x94\xe4\xdc\xa9\x8b\xf5\xbf\x83\x5b\x7a\x43\xdd\x0b\x6b\xee\x44\x58\x3f\xa3\xb2\x9b\x18\x5b\xce\xc0\x60\xfe\xc4\xc1\xa5\xe2\x9c\x63\x70\xa5\x01\x92\xb4\x5f\xd1\xb3\x17\x59\x1e\xd0\x21\x79\x41\x08\x39\x58\x9d\x0c\x51\xee\x5c\x5e\xd7\x1e\xde\x8b\x52\x15\x36\xcf\x7c\x04\xa6\xdd\x51\x12\x9b\xe1\x64\x07\x95\xe0\xd3\x14\x5c\x8b\x2c\x0c\xc7\x73\xfb\x6f\x7e\xc0\x4a\x61\x64\x5e\xb3\x27\x45\x61\x07\x60\xc8\x11\x2c\xf6\x7e\x09\x96\xe4\x37\x64\xd0\xb8\xc9\xb3\xee\xa4\xb0\xc3\xd8\xa4\x8e\x59\x30\x1e\x37\x89\x8b\x7d\xdf\x63\xf5\x49\x15\x31\x86\x2f\xb0\x89\x54\x1f\xb3\x96\x4d\x21\xa8\xc5\xc0\xf4\x07\x47\xa5\x6e\x0d\x9c\x9e\x62\x81\x21\xd5\x1c\x76\x46\x3b\x05\xb1\x5e\xcb\xaa\x48\xe2\xa7\x19\x4c\xf7\xc2\xb3\x55\x85\xdb\x48\x51\x45\xa8\xf2\xde\xbd\x27\xaa\xd4\xed\x93\xa1\xca\xf0\xf6\xa1\x3a\x96\xac\x3b\x00\xeb\x90\x76\x3c\x06\xdf\x7e\xfa\x1b\x46\x2c\x86\x50\x9b\x38\x30\xba\x37\x0d\x10\xc2\xbe\x69\xc6\x76\xd3\xf8\xec\x3e\x8f\xe9\x74\x1c\x71\xc6\x10\xe1\x87\x87\x19\x5a\x3b\x34\x71\x93\x2f\x65\xd5\x19\x34\x85\xbf\xc0\x63\x42\x91\xa4\x26\x0a\x1c\x9b\x5f\x5a\x24\xd3\x95\xd2\x1a\x05\x75\x2c\x1d\x4e\xe0\x2b\x3d\xe5\x42\x09\x3d\xfb\xcf\x5a\x75\x41\x66\x30\xcd\x60\x9a\xba\xf1\xc3\xc9\xa1\x4a\x95\x93\xad\x0f\xbf\xd9\x01\x9e\xd5\x0d\xc7\xc1\x9d\x48\x20\x13\x1f\x85\x17\xfa\x78\xea\xbd\xac\x82\x45\x0f\xaa\x38\x46\xee\x74\x86\x4b\x3c\xb4\xf3\xa7\x34\x83\xf4\xbe\x99\x9a\xf8\x38\xd4\x2e\x2f\x69\x3f\x1c\xc9\xdb\xf0\x80\x23\xf8\x3e\xd1\xe9\x63\xb4\x3c\x6f\x4c\x60\xe2\xdc\x31\xe0\xe7\x52\x78\x19\x70\xbb\x30\x0f\x1b\x61\x7c\x85\xe1\x6e\xdb\x04\x9d\x18\x2c\x54\x58\xad\x6b\xad\x0c\x65\x03\xd9\xbb\x47\x5f\x9a\x42\xbf\x0b\xd5\x68\xe3\xde\x66\x18\xfc\xb5\xa3\xef\x9c\x37\x3a\xca\x3c\x0b\x73\x4c\x9a\x1b\x18\x24\x65\x33\x40\xcc\x98\xa0\x0e\xbb\x93\x53\x7c\xe6\xca\x76\x89\x2b\xfd\xc4\x32\xa8\xdf\xe1\x41\x3b\xdb\x72\x96\x3c\x24\xd4\xcf\xf8\xfd\x8f\x9c\x93\xb3\x8c\xfe\x45\xfd\x0e\x7e\xfd\xd5\xf2\xbb\x87\x30\xb3\x4d\x74\x8a\x3b\x93\x99\x1e\xe0\xaa\x91\xe2\x9d\xed\x86\xe2\x8f\x31\x39\x85\x7e\xb7\x37\x8f\xdf\xd2\x96\x52\x0b\xe8\x63\x43\xc8\xd8\x01\xd2\xef\xf0\xdd\x83\x07\x20\xe1\x8b\x58\x04\xbc\x17\x11\x87\xdf\x33\x3b\x88\xfd\xf5\x8d\x32\xf9\x12\xe4\x0c\x8f\xff\x26\x5c\x44\x6f\xb3\x09\xb6\xcf\xa5\x65\x07\x4e\xde\x9e\xd0\xf4\x78\xc4\xd3\x01\x66\xe5\xec\xa5\xcd\xf6\x0e\x42\xeb\xa7\x6f\x0f\x86\xda\xef\x38\x08\x7d\x28\xa1\x7b\xf0\x08\x43\x9d\x07\x47\xe9\xa4\x7a\x0f\x06\xdf\xe9\x35\x06\x37\x4a\xfb\xde\x07\x70\xd4\x2d\x62\x3c\xb5\xf0\x9d\x3b\x7c\xe3\x61\x26\xcd\x4d\x06\x8d\xe5\x89\x94\xde\x38\x99\xed\x81\x6c\x07\xad\xc3\xb0\xbb\x3b\x10\x9c\x7c\x7a\x5d\xf9\x98\x88\x0c\xd5\x4c\x28\x3b\xce\x9f\xfa\x58\x8b\x17\x9b\x78\xe0\x49\xe5\x4b\x58\x8a\xf7\x98\x33\xf4\x08\xdf\x4a\x63\xf3\x8a\xb7\xd0\x58\x7e\x2e\x28\xe1\x01\x7f\x7a\xfc\xcd\x31\x12\xa5\x83\x55\x92\xfa\xc2\x6b\x6f\x35\xaa\x22\xaa\xc6\x8e\x8f\x23\x06\x85\x0f\xdb\xed\x6f\xa6\xee\x11\x3d\xaf\xe5\x55\xa1\xb3\xfe\x49\x60\xee\x1d\xd4\x34\xc7\x3a\xbc\x72\x51\x05\x3b\x2a\x5d\x96\x41\xd9\x2e\x88\xce\x5a\xfa\x2a\x90\x9d\x35\x12\x8d\xac\xfe\x4f\x54\xd3\x2b\x0b\xb8\x95\xe6\x04\x01\x2a\x83\x40\xc8\x41\x0f\xea\xa5\x37\x8e\x2d\x10\xe1\xa6\xe6\x98\x65\xec\x02\x4c\x76\x94\xc0\x4a\x6a\xac\x3d\xf7\xaa\x78\x28\x60\x18\xeb\xdb\xa1\xf7\xd1\x81\xcf\x11\xdd\xb3\x1e\xab\x76\x65\x19\xaa\x16\x87\x6d\xd6\xae\x3c\x87\xc3\x3a\x45\x7b\x6e\xe0\x7c\x13\x51\x20\x1d\xd9\xd0\x9d\x23\x09\x23\x5d\x67\x56\xc7\xf2\xa4\xed\x70\x3c\x63\x84\xba\x45\xcf\x0f\x4b\xf0\x42\x95\x5c\xdd\x68\x6f\x7b\xe1\x4e\x8f\x0a\xe8\xf0\x02\x00\xe6\x28\x8c\x9d\xa8\x05\x56\x92\xfb\x1a\x70\x77\x74\x51\x1f\xc3\x0b\x3b\xe3\x27\x04\x2c\x3e\x3c\x82\x43\x7a\xd7\xe4\xd2\xbe\x4f\xe3\xf7\x71\x09\x9f\x07\x06\x9b\x3b\x4b\x10\x1b\xa9\x31\xbc\x73\x72\xba\x73\x14\x7d\x10\x62\x4a\x26\x88\xf3\xa5\x1d\x9e\xa8\xed\x9d\x9b\xc7\x78\x6f\x62\xb5\x8c\x4d\x23\xc6\x20\x69\x34\x86\x8f\xd7\x27\x78\xb0\xf8\xfc\xe9\x76\x3b\x65\xfd\xc1\x33\xe9\x54\x55\xff\x1d\x4e\x69\x54\xdf\xca\xcd\xe8\x0d\x0e\xfb\x76\x50\xd9\xf8\xee\x7e\x56\xf7\xaa\x06\xf5\x07\x50\x71\x84\x2c\xd4\x63\xf3\x56\x4d\xa2\x1e\x6c\xa6\xf8\xf9\x07\x4e\x0e\x82\x6d\x17\xc3\x11\xaf\xf2\x3e\x58\x0e\x60\xc8\x3b\x09\x20\x9c\x57\x4b\xd9\x0b\xea\xd3\x38\xae\xc2\xbe\x93\xa2\xa1\x71\x20\xa9\x5b\x95\xd9\x8b\x88\x51\x66\xe7\x55\x06\xf7\x99\xc4\xd0\x21\xd5\xdf\x07\x75\x2d\x52\xf7\x22\x28\x1f\x35\xbd\x9b\x3d\x77\x0f\x83\x74\x89\xf9\x51\x14\x1c\x3a\xbf\xfa\x3b\x22\x29\xa3\x77\x00\x69\xe3\x5f\x6c\xe2\x11\xa6\x8e\xc6\x56\xf6\xe1\x29\xce\xd8\x76\x40\x6f\x3b\xf4\x75\x56\x84\x4f\xf8\x35\x63\x61\x59\x7f\xa2\xf5\xa8\x40\x67\x80\x9f\x74\x8f\xe9\xd0\xa0\x87\x48\x69\x5a\x81\x3e\xe1\x3b\x75\xdc\x07\x4d\x98\x32\xad\x03\x23\x51\x3e\xe9\x82\x6a\xf9\x2f\xa9\x94\x9f\x6a\x75\x88\x6d\xfc\xc1\x27\x59\x84\x08\x84\xe6\x03\x00\x59\xf7\xf8\xa4\xa8\x76\x34\xe4\x04\x9d\xbd\xde\x10\xa7\xb1\x22\x8b\xfe\x64\x16\xdd\x8c\xdb\xb1\x34\x1b\x4f\x03\x2a\xd2\x1f\x32\x28\x4f\x88\xa7\x03\x24\xa6\xc1\xde\x3e\x44\x2e\x37\xfb\x6e\xc3\xff\xfd\x7e\xda\x7d\x43\x61\xb9\x4a\x95\x9e\x69\xf9\xd4\x32\xfd\x9c\xd0\xa9\x6b\xff\x20\xbc\x71\xbc\x68\xcb\xe4\xa2\x29\x32\xf9\x23\x5a\x5f\xdd\x72\x6d\x01\xd2\x17\x8b\xe9\x2c\x51\xfb\x3d\x3b\x54\x3d\x84\x90\x31\x01\x12\xfb\x03\x92\x76\x8d\xf5\x0b\x33\xe7\xb4\xa6\x30\x85\x29\x5a\xff\x66\x99\x32\x71\x86\xa8\xd6\x99\x20\xcd\xcb\x92\x29\xb0\x6a\x38\x23\x4e\x25\x81\x5e\x32\x74\xcf\x2c\xa0\xad\x11\x0a\x59\x4d\x6d\xab\x7e\x31\x5f\xed\xe9\x91\x21\xd5\xc2\x11\xbf\xc0\xa5\xbe\x05\x33\x27\x47\x76\x44\xbe\xdc\xe1\x4a\xcc\x0c\xed\xe0\xe8\x65\x94\xe5\x1c\xff\xc2\x36\xd3\x49\x5c\x18\x70\xb8\x98\x8b\x4b\x03\xe2\x96\xdb\x6d\x16\x61\xdc\x93\xd5\x03\x7b\x82\x92\x05\xc3\xd4\x45\xcb\x1f\x54\xe7\x40\x4f\x8b\x07\x6b\xec\x91\xc6\x5e\xdb\xc1\xa9\x5b\x00\xd8\xf7\x77\x32\xcb\x8e\x94\xa6\x1d\x87\x9c\xe0\x56\x9a\x27\x49\xb2\x79\x31\x34\x9b\xf4\xf7\xb9\x7e\xb1\x0f\xb7\x08\x28\x45\xb0\x18\x08\x87\x25\x7a\xd3\x08\xb9\xfe\xa0\xa3\x38\x38\x81\x75\x3d\xa6\xde\x5d\xf2\xcc\xd7\xce\x73\x9c\x95\xf0\xac\x17\x3d\xd1\x6c\x23\xaa\x4f\xfc\xfe\xeb\xde\xed\x50\x57\x58\x95\x89\xb5\xb3\x9e\x0a\x4a\x77\xf7\x6f\x06\x57\x72\x81\xd5\xdd\xfd\x12\xdb\x06\xcb\x89\xf1\xb8\x08\x0e\x80\x62\xcc\x79\xd3\x8b\xba\xb9\x52\x45\x21\xab\x50\xd6\x2f\x76\x95\x33\xc7\x89\x8f\x0a\xc9\xf6\xe8\x97\x44\xf0\x7b\x64\x1a\xcb\x29\x76\xcf\x4e\x9d\x0e\x68\xf4\xc8\xf3\xee\x3b\xf6\x11\xad\x02\x6b\xc5\xcc\x40\xc7\xea\x33\xf8\x3b\x47\x52\x77\x31\xa0\x62\xa8\x24\x9d\x5d\xa0\xd0\xc7\xeb\x2b\x92\x6e\x84\x97\xcd\x37\x62\xad\xe0\x62\xdb\x88\x66\x32\xad\xea\x88\x5b\x51\xc8\x7e\xa5\x5d\xf6\xa2\x21\x59\x8f\x7f\xbd\xbe\x78\xee\x84\x7d\xe4\x75\x87\x5e\x27\xa7\x7d\x95\x43\x3c\xae\x67\xaf\xea\xd7\xa8\x37\x12\x06\x96\x7e\x3d\x85\xe9\xd7\xfe\x6d\xa3\x56\x2f\x1b\xb9\x50\x1f\x12\x3b\x55\x3b\xc6\x4b\x61\x8c\x6c\xaa\xcc\xc1\xc4\x6b\xbb\x6c\xb9\x75\xfa\x96\xf5\xa7\x5a\xec\xdd\x9b\x68\x58\xdb\xa9\x86\xf5\x9c\xf5\x97\x7a\x78\x7b\x75\x39\xfe\x8d\x7f\xf3\x36\x0d\x1a\x7d\xcd\x6b\xe1\x41\xcc\x92\x87\x7d\x01\x70\xc8\x02\xc8\x9b\x24\x0a\x94\x3e\x63\x76\xcf\x60\xda\x56\xf2\xc3\x5a\xe6\x9d\x83\x7a\xf0\xd5\xab\x69\xc4\x32\xf1\x3a\x1c\x30\xdb\x7b\xcc\xd2\xdb\x26\x69\xa7\xba\x68\xb3\x79\x84\x0c\x35\x3b\xbb\xbc\x78\x76\x56\xd7\xef\xf0\x58\x8a\x33\x12\xcf\xb5\x6e\x25\x3e\xb6\x47\xd1\xb9\xd4\x05\xef\xe0\xc3\x5b\x24\x51\x19\xdb\xe7\x94\x2e\xcf\xa9\x2f\xc9\xa5\xa2\x6e\xaf\x4a\xf9\x48\xb7\x57\x2b\x65\x00\xa1\xe0\xc1\x47\xe3\x8e\xdf\x20\xf4\xc4\x1b\x29\x5f\xaa\x0c\xbe\xcc\x91\xf2\x3d\x24\x1c\x47\x7c\xa9\xac\x4a\xf1\x18\xe3\x05\x83\x79\x6c\x55\xa5\x99\x0f\xda\xac\xc5\xb5\xf4\xa1\x3d\xaa\x81\xbb\x6a\xea\x1b\x2d\x1b\x1d\x32\x47\xb6\x40\xdf\x63\xca\x7d\x9c\x80\xba\x12\xf9\x3b\xae\x00\xa0\x33\x2f\xdc\xce\xa3\x1f\x64\x6a\x5b\x69\xb1\xf0\xa7\x7a\xb8\xbc\xa7\x4b\xb8\x43\xb3\x42\x29\xf8\xd2\xfd\xc8\x3f\x6b\xc4\x8d\x0f\xdc\xbc\x79\x8b\x67\x89\x32\xf8\xe3\x1f\x90\x4b\xd4\x02\xc5\x07\x66\x92\x70\x97\x8a\xaa\xb0\xd7\xbd\x25\x8d\xb8\x49\xbf\x43\x59\xd3\x8d\xd7\x11\x2f\x4d\xa7\x19\x25\x99\x90\x15\xac\x3b\x86\xe0\xf1\x4c\xee\x9f\xbf\x9d\x5d\x88\x9b\xd7\x17\xcf\x7f\xa4\x6b\x40\x67\xf6\x0f\xf9\xaa\xc6\x83\x38\xd5\xb5\x85\x4c\xa1\xa1\xbf\x67\x50\x89\x38\x2a\xc4\x2a\x6f\x13\xd9\x9e\x3b\x8b\xd9\xb1\x23\xbb\x8b\x0a\x5b\xc2\xd3\x52\xe4\x52\x1a\xd7\xd1\xc6\xf3\x1e\xd8\x67\xee\x01\x6f\x39\xf4\x92\x4f\xf0\x0f\x8b\x47\x46\x4f\xff\x1b\x8f\x7b\xd8\xc7\x76\x66\xfc\x18\x85\x8c\x7d\x0a\xd3\x39\xdd\x66\x88\xa7\xba\xd0\xc1\xc1\xc7\xcd\xec\xd5\xf3\x4b\xa6\xd6\xaf\xbf\x92\x50\x74\xf1\x37\xcc\x96\x4d\x71\x7c\x1d\x3a\x8a\x95\xbc\x54\x46\x9e\x50\x3a\x84\x7e\x22\x91\x72\xf3\x73\x5d\xc8\x8c\xae\x5c\xeb\xfa\xab\xe4\xfa\xa2\x6f\xda\x2b\xee\xe3\xda\x8a\x6e\x58\x92\xca\x9a\x06\x23\x92\xa1\xd2\xe9\xa8\x60\x64\x3c\x60\x28\x89\x8b\xc3\x05\x91\x31\xc3\x9a\x8f\x3b\x45\xf1\x46\x7a\x74\x68\x90\x91\x21\x70\x7c\xf1\xef\x19\xac\x4c\x60\xa1\x08\x91\x4e\x6c\x71\x65\x76\x23\x8b\x9d\x91\x3b\x6f\x9e\x94\xe5\xa5\x6c\x94\x9d\x75\xb3\x1b\x6e\x0c\x85\x7c\xc8\x41\xbd\xab\x23\x42\x14\x92\x02\x38\x77\x75\x18\x0e\xee\x0c\x12\x9e\x27\x4f\x43\xb0\xaf\xfe\xa9\xc3\x1c\x2b\xf1\x21\x5c\x8a\xc8\x79\xf0\x5c\xac\x45\x8e\x97\x98\xb4\x6b\x2c\x0c\x76\x49\x2c\x7c\x71\xd5\x2e\x16\x51\x51\xb1\xed\xe3\xf3\x05\xd7\xb5\x13\x86\x4e\x8c\xaa\x06\xd6\x75\x5d\x66\xe1\x8c\x21\x66\xd7\xdd\xad\x4a\xa5\x5c\x18\x96\xb6\x7f\x3d\x9b\x20\xe7\x9a\x1e\x26\xa7\xf0\xe7\x6f\xe1\xfb\xef\xe1\x9b\xc7\xd6\x97\xc1\xfb\x1d\x7f\xb0\x83\x23\x8e\x82\x10\x19\xc4\x83\xaa\xed\xd1\xb8\xb4\x77\x16\xb3\x37\x13\x81\x88\xae\xdb\x42\x01\xa9\x67\xee\x39\x5e\xea\xeb\x7a\xc0\x43\x6c\x4d\xf2\xac\x41\x23\x1b\x9d\xe9\x00\x01\x1d\x69\x7b\xbd\x13\x62\xbc\x79\x21\x6f\xe8\x52\xb8\x34\x36\xe0\x1c\xfc\x76\x81\x1c\x5b\xc9\x9b\x24\x74\x47\x86\xbe\x6a\x17\x33\x1e\xed\x14\xf0\x1d\x1a\x02\x34\x62\xf2\x00\x5f\x87\xc6\xb4\x80\x57\xed\x62\x42\xf7\x46\x07\x52\x71\x7a\x26\xba\xf6\xd6\x2f\x09\xd1\x47\x23\x27\x62\x5b\xac\x64\xe0\xdd\x15\xf7\xcd\x68\x8d\x1b\xd9\x6a\xa9\xad\xfd\xce\x4b\x8d\x76\x38\xe1\xa9\x71\x25\x44\xde\xd4\x9a\x63\x61\x2e\x89\xa2\x67\x56\x4d\x32\x07\xdd\x34\xca\x18\xa7\xe7\x05\x60\x41\x4c\x89\x1c\x55\x96\xec\x1b\x60\x1b\x07\xb1\xc8\xf0\x54\xa1\xaf\xf0\x20\x6c\xf9\x56\x69\x28\xa5\xe0\x33\x9c\xf6\x98\xa1\x5c\xad\xcd\x2d\xa9\xcc\xdd\xf9\x47\x61\x34\x7e\x14\x3b\x52\xfd\x77\x47\x5d\xb6\x49\xab\x19\x31\xc2\xec\xaf\xd2\x24\xe9\x2c\x79\xd8\x5d\x5d\x7b\x1f\x1a\x57\x2e\x84\xc0\xa9\x5a\x20\x43\xcc\xce\xc4\x3a\x49\xe1\xfb\xd3\x1e\xcf\x73\x2b\xc7\x1c\x17\x52\x23\x68\xff\x2c\x1e\xf4\x65\x6b\x92\xab\x76\xc1\x2f\x49\x10\x50\x63\x34\x8a\x9d\x92\x8f\x78\x8c\x78\x39\xc1\xcb\x44\x87\xd4\xbd\xa7\x93\x53\xf6\x0c\x33\x18\x0c\x08\xcb\xa6\xce\x5e\xd5\xc9\x4d\x1a\x9b\x08\x64\x1e\xb0\x2f\xca\x04\xee\x6a\x27\x66\xc9\xcf\xa0\x9d\xe2\x01\x0f\xd6\x4e\xdc\x29\xd2\x4e\xf4\xe8\x50\xed\xc4\x10\x3e\x81\x76\xea\x8c\xfc\x3f\x42\x3b\xf1\xe4\x07\xf4\xd1\xa7\xd4\x4e\x54\x2d\xe0\x39\x49\x74\x6e\xa3\xf3\xac\xe4\x6f\x80\xf1\x1e\xcc\x4e\x30\xf4\x08\xbe\x0a\x83\x27\x2b\x72\x7f\x11\x14\xc5\x71\x52\x48\x62\x5c\x32\x7b\x04\x38\xb5\xec\x34\x98\x20\xf7\x07\x72\x3a\x95\x0f\x61\xee\x19\x2c\x44\xa9\x25\x91\xab\x5d\x21\xeb\xf5\x5d\x67\x87\x46\xb0\xe5\xc7\x42\x01\x3c\xd6\x9b\x76\xf5\xf6\xbb\xc8\xf3\x1c\x1b\x4d\x2d\xdc\x99\x68\xb4\x5d\xe7\x53\x6a\xec\x9e\xc0\x74\x4a\x8d\x96\x87\x8d\xf7\x06\xfb\xbd\x0d\xcb\x6a\xbb\xd1\x72\x52\x88\x82\x5e\xd1\xcd\x01\x3e\x63\xcf\xc7\xba\xfc\xb2\x0e\x9e\x59\x3a\xb2\xa0\xda\x47\x47\x86\xae\xcb\x1c\x5f\x35\x46\xa9\xb3\x68\x7b\x9a\x45\xd3\x41\x75\x8e\x91\x18\xbc\xba\x84\x47\xdf\xed\x89\x52\x30\xdb\x1d\x38\xc3\xe1\xfa\x45\xa1\x18\x5b\x88\x9b\x41\x18\x19\x09\x7c\x04\x55\x30\xeb\x4f\x0c\x7c\x26\xf2\x25\xd7\xc9\xed\x09\x2e\xe1\xa1\xfa\xa2\xc6\x4a\x99\x1c\x97\x4c\x5c\xd5\xad\x61\x63\xa0\x2d\x4d\x06\xff\x68\xb5\xa1\x9b\xa2\xec\x41\x44\x65\xac\x6d\xcd\x57\xf6\x60\x25\xaf\xad\x6f\x73\xb9\xad\xa1\x82\xe3\xdd\x49\x32\x7f\xdd\xb5\x0c\xa1\xdd\x8e\xd4\x8e\xfe\x8c\xb7\x6d\x28\x28\x22\x81\x7b\x3f\x84\xde\xf4\x9c\xd4\x7e\x6a\x64\xbb\x7d\xdb\xc7\xf9\x23\x81\xed\x4c\x6c\x78\x36\x9d\x41\xee\x37\xc6\x9b\x28\xae\x86\x22\x60\x3a\x9f\xa2\x72\x98\x86\xc0\x57\x1f\x46\x5e\x4a\x51\xd9\xb6\x3e\x0d\xe4\xfd\xd5\xb7\x77\x9d\x89\xdb\x2d\xd4\x1e\xfb\x18\x43\x32\xba\xef\xb2\x7f\x5b\xdd\x5a\x7c\xe4\xae\xaf\xac\x6c\x35\x53\x64\x85\xe3\xca\xf8\x92\x3f\x53\xbb\x28\x93\x8f\xc1\xd7\x78\x1f\x0d\x5e\x4f\x83\x5d\xe9\xc8\xaf\xcd\xc7\xb8\xfb\x33\xca\x5b\xb4\x9f\x11\xc4\xec\xb9\xd2\x46\x56\x4f\xaa\xc2\x0e\x90\x4c\x4f\xfe\xe3\xf1\xe3\xc7\xd3\x0c\x2f\xa0\x73\x65\x57\x09\xca\x8a\xf4\x98\xfd\xef\xba\xf3\x95\xae\xbb\xf7\xb9\x76\x2f\xe5\x25\xd9\xb0\xcb\xc1\xe7\x95\x32\x49\x3a\x19\x79\x1b\x6e\x99\x9d\xe1\xff\x25\xe9\x48\x3b\x46\x03\x6d\x4d\xbe\x4d\x76\x1c\x1e\x9c\x0e\xbe\xb4\x91\x62\xcd\x53\x4a\xef\x46\xe9\x75\x85\xb7\x4e\x27\x69\x24\x67\xe3\x39\xdf\x5d\x2f\xb7\x13\x95\x1b\xdf\xe9\xd1\xb0\x17\x9e\x14\x7c\xd9\x6e\x06\xb9\xf9\x40\xa1\x6e\xdc\x46\xda\xe9\xda\x31\x28\xd9\x3e\x75\x30\xfc\x32\x80\x3e\x08\x43\x4f\x19\x6a\xbc\x73\x19\xf3\x88\x70\xb4\xf8\xb9\x46\x36\x1c\x17\x15\x8e\xec\xd4\xeb\x79\x39\x31\x02\x2b\xbe\x10\xe7\xd7\x5f\x07\x9b\x74\x6f\x9c\x19\x69\x34\x78\x69\x0b\x63\x85\xbe\x7d\xfd\x8e\xef\x5d\x26\x17\xaa\x41\xbb\x65\x1f\x93\xd1\x7a\x73\xc1\x62\x20\x6e\x1a\x9b\x5c\xbd\x59\xf3\xbc\xad\x5a\xa7\x91\x77\x0e\x59\xb0\x17\x47\x39\x9f\xe6\x0c\xf9\xc2\xd9\x5e\xb9\xf9\xd0\x49\xef\x7c\x07\xd1\x48\x88\xf5\x99\xf9\xd0\x77\xec\x00\x70\x4f\x21\x14\x7a\xc0\x76\xba\xb7\x96\xcf\x9f\x02\xe5\x6d\x82\x42\x9e\x9d\x3f\xa5\x66\x2a\xba\x37\x00\x5b\x9e\xc2\x94\x6d\xc4\x5d\x28\xa3\xd9\x1e\xf8\xda\x16\x0a\x7c\x0d\x3b\xe9\x9d\x1e\x61\x68\xc8\x2f\x86\x08\xaf\x8d\x68\x0c\x13\x3e\x1a\x38\x70\xd8\x50\xaf\xc1\x8a\xee\x81\xcc\x0b\xb6\x53\xb9\x7c\x5d\x89\xf7\x42\x95\x68\xb7\x65\x30\x45\x79\xdd\xbd\x3d\xcc\xde\x32\x83\x37\x7b\x4d\xc7\xeb\x50\x9d\xf7\x3f\x84\x8c\xac\x8a\xa1\x09\x44\x82\xc0\x09\x75\x54\x0d\xb4\x7d\xbc\x77\x3d\xb4\x09\xf1\xea\xb2\x70\xc5\x39\xe2\x28\x6c\x88\xbb\x80\xdc\x3e\xb0\xe7\x5d\xd6\x4d\x7d\x45\xf5\x0c\x71\xe3\x28\x0e\x86\x5d\x20\xf0\x9f\xeb\x8b\x1a\x25\x21\x91\xc2\x26\x20\x05\x44\x48\xe7\x3d\x29\x8a\x9f\x22\x80\x5c\x1d\x85\x48\xf8\xe1\x91\x82\x73\x37\xec\xbf\x30\x4a\x77\x25\x4f\xe8\xaa\x64\x4b\x6e\x8b\x72\x89\xd7\xad\x51\x05\xb5\xa6\x09\xb9\x09\x60\x8a\x58\x87\x5c\x0c\x3d\x13\x4d\xa7\x14\x8b\x12\xca\x08\x15\xaf\x65\xf5\xd5\xda\xb3\x63\x94\x62\x77\x4e\xdd\x23\xa8\x77\xd2\x65\xcc\x80\x8e\xc8\x1e\xd5\xba\xef\x6f\x97\xc5\x2b\xbb\x41\x3c\x4e\xa8\xc6\xd6\xa2\x71\xe2\x08\xb4\xf5\x45\x00\x45\x71\xd1\xb9\xfe\x7e\xcf\x72\x34\x52\x14\xb7\x63\xab\x61\x5f\x06\x8b\x85\x93\x55\x61\x7d\x1a\x1e\xe6\x37\x5c\xa2\xee\x54\x3f\xd1\x2a\xf9\x89\xdd\xbd\x50\xbd\xa6\xf7\x5c\xab\x48\x47\x52\x18\xb5\xf0\x37\x0b\xfd\xf5\xc7\x57\xbc\x4e\x76\x7d\xa8\x44\x29\x38\x59\xf4\x56\x35\x44\x6a\x8a\x78\x0b\xf8\xd3\xe3\x3f\xba\x45\xa2\x83\x6c\x78\xcd\x3f\x2c\x84\x2a\x8f\x8a\xab\x75\xf5\xf8\x81\xc6\x0f\x2a\x51\x76\xa7\x59\xf6\xa3\x42\xb2\xbd\xdd\xcf\xbf\x4a\x03\x0f\x1e\x8c\xbd\xc5\xab\xfd\x48\x9a\x93\x39\x16\x47\x2a\x50\x61\xe6\x43\x9f\x76\xf0\xf1\xb5\x50\xa1\x60\xa1\xd8\x48\xd9\xb8\xc3\x42\x25\x6d\xbe\xb4\x00\xa6\x2c\xa9\xa6\x29\x7a\x5c\x2e\xc3\x43\x23\x0e\x86\x3e\x02\x0e\xfa\xa8\xe1\x90\x8f\x6e\x0f\x1d\xad\xc7\x74\xe1\xb3\x1b\x27\xc3\x04\x9b\x80\x3f\x19\x89\x51\x60\xb4\x20\xa6\xf5\xbb\x69\x16\x1f\xcc\xfa\xe5\xbf\x7c\xb8\x53\x0f\xc5\x3b\x79\x53\xd9\xc3\x81\x76\xd8\x34\x0a\x79\xe6\x21\xe2\x49\x78\xfb\x43\x22\x14\x38\xce\x67\xf6\x45\xd2\xf0\x1e\x4c\xd2\xe1\x00\x74\x07\xd3\x53\x2c\x7e\xf0\x7a\xb8\x8b\xf1\xae\x9e\xf6\x6a\x18\xb7\x87\x7e\x93\xcf\xb8\x92\x5a\x36\x8d\x3b\x66\x48\x66\x2c\xd8\x20\x93\xaa\x5a\x19\x29\xeb\xdd\x6e\x48\x24\xe2\x38\xb5\x88\x59\xea\xf4\xf4\xf8\xd5\x45\xa6\xdf\x5d\x52\xfb\x25\x11\x55\x5d\xfb\x2c\xc1\xc7\x92\x81\x67\x33\xe5\x8f\x8a\x4c\xed\x8c\xf6\xd9\x31\x3e\xce\x74\x33\xc3\xed\x87\xb9\x94\xd9\xa5\x34\xc9\xd4\xae\x58\x65\x1e\x61\x84\x18\xcf\x29\x0b\xfc\x06\x87\xbb\x0b\x7d\x8e\xf9\x08\x7b\x6e\x7a\xb7\x17\x86\x92\x1e\x61\xdf\xa6\x2e\xb1\x5b\x55\x3f\xd2\xa6\x6e\x24\x37\xb7\xd2\x83\xfa\xe0\x34\xd3\x9e\xbc\x38\xdd\x91\x17\x8e\x47\xfa\x59\xb2\xe6\x26\xe5\xbc\x46\xc4\xb0\x51\xf6\x66\x33\x75\xd4\x9c\x9e\x78\xee\x9a\x5a\x6e\xd4\xd3\x13\x26\xd4\x4e\xde\xbd\x69\x25\x19\x5b\xfe\x4b\x31\xb1\xdd\xc9\x5f\x8b\x09\x15\xa8\xaa\x82\x85\xfb\xb8\x0b\x1f\x47\xf6\x96\x5d\x06\x6d\x55\x4a\xad\x63\xbd\xc7\xeb\x72\x94\x4c\x1e\x31\x80\x49\xf5\x45\xf2\x77\x97\xd3\xe2\x8f\xd3\x04\x4f\x9c\x2d\xd5\xbd\xad\x63\x27\x79\x30\x4e\xca\x73\x82\xcd\xb0\x20\xda\xf1\x22\xbe\x88\xbc\x08\xb5\xd8\x33\x7e\x37\x7e\xb6\x6f\x5e\x03\xc1\x31\x55\x99\xb8\x3e\x6a\xbc\x6f\xa8\x7d\x3a\x7f\xfa\xf6\xeb\xaf\x87\x39\x62\x3e\x87\x60\xbd\xef\xb2\x41\xbd\xe8\xd4\x20\x63\xae\x15\x4f\x45\x97\xd2\xd0\xd5\xdc\x50\x0a\x6d\xec\x85\x6d\xfc\x9c\x2e\xa6\xf9\x08\x86\x18\x76\x27\x3c\x3b\x90\x26\x1e\xf3\xdf\xbc\xdf\xb2\x9d\xec\xa3\xce\x47\x72\xcc\x81\x64\x7f\xf4\x68\x8c\xbb\x06\x9b\xc3\xf7\xe1\x5c\x7a\x21\x91\x9a\xc9\x78\xcf\xa8\xec\xed\xfc\x29\x6f\x78\xba\xe1\x61\xbc\x17\x9d\x7d\x1f\x94\xdb\xfc\x79\xa6\x8e\x12\xcb\xcb\x5a\xcb\x64\xb4\x71\x3a\xc2\x85\x0c\xeb\x94\x92\x60\x1c\x51\x3c\x27\x3c\x68\x9d\x1c\xb7\x84\x2b\x96\x0f\xfa\x56\xd5\x31\x4c\xc5\xe3\x26\x9d\xd4\x29\x5a\x2e\x9f\x57\xb2\xd0\x8b\x21\x2b\x44\x55\x26\xbb\x6b\xb5\xd8\x2c\x89\x96\x3a\x73\xdb\x34\x18\x29\xe3\xdd\x69\x09\x87\x79\xed\xd4\xc1\xe9\xca\x05\x6e\x4a\xab\xc5\x9a\x1c\x8c\xc4\x22\x74\xb5\xf0\x42\xbf\x91\x0b\x5b\x57\x81\xbf\x6d\x39\x24\x2d\x5c\x06\x62\x61\x64\xe3\xbf\x26\xc6\xf7\x97\x1f\xb3\x66\x91\x1d\xf1\xf9\x75\x00\x11\x60\x5c\x0f\xc4\x24\x01\x6d\xea\xb5\xa6\x3b\xd3\x31\x76\xc2\x7c\xeb\x84\x22\x12\xa4\xae\xa4\xbb\x9e\xd9\xfa\x30\x19\x88\xaa\xe8\x7e\x5c\xcd\xf7\x89\x94\xad\xa9\xbd\x1c\xb5\xbe\xe7\xdf\xd0\xf3\xc1\xeb\x6c\x51\xc7\xa2\x94\xb5\x77\x5f\x64\xa0\xc2\xfe\x11\xf0\xd0\xd2\xe9\xc7\xee\x8d\x22\x1e\xb8\x36\x78\x1b\x5b\x18\xa2\xb7\x97\x8e\xf2\x55\xed\x80\xc9\xc0\xb5\x95\xe4\x8b\xde\x6b\xa5\xc6\x29\x0e\xa7\x4e\x4f\x1d\x2e\xd6\x38\x4e\x7d\xd8\xaa\x47\x6a\x9d\x85\xd4\x88\xb4\xf6\xd2\x2c\x96\x8c\x7b\x9a\x39\xad\xdd\xf9\xc2\x1d\x4b\x68\x6e\x73\x72\x3a\x0e\x60\x72\xf8\x1c\xd0\x1d\x92\xf8\x85\x9a\xe0\x1a\x7e\xff\x88\xc0\x74\x3c\x28\x37\x43\x6a\x80\x31\xd7\xa7\x75\x25\x93\xb4\xd3\xe6\x41\xe0\xa4\x0d\x0b\xcc\x93\x01\x54\x82\x30\x0d\x1f\xa8\xa4\x9d\xe1\xd8\xb0\x91\xee\x53\xe8\xf7\xe1\xc4\x70\x14\xc2\xaf\x7f\xbd\x88\x8d\xcc\xbc\x35\xa0\x97\x75\x63\x5c\xd4\x2f\x1a\x2e\x0a\xfa\x31\x6a\x3d\x29\x1f\x92\xc9\x32\xde\x2f\x29\x90\x27\x45\xd6\x85\x05\xe1\x91\x0a\xbe\x23\xd7\xdd\x64\xf0\xd8\x89\x6c\xe9\x69\xe0\x3d\xc7\x7d\x22\x3a\x34\x27\xde\x89\xc6\xf0\x01\x99\xf0\x2c\xb3\x77\x30\x5d\x5a\xcf\x7a\x91\x4c\xbf\xd2\x90\x7c\x55\xa4\xd3\x6c\x60\x0c\xba\x66\x09\x40\xd7\x8d\xb1\xb9\x85\xea\x5a\x07\xbb\x49\x47\xa5\x76\xd3\xb1\x95\x38\xb1\x41\x6c\x0e\x73\xdb\xfb\x9c\x02\x00\xba\xcd\x89\xd6\x98\x6e\xb7\xe7\x8c\x60\x7c\x77\x11\x2d\x16\xb5\x18\xf8\x66\xe3\x31\x92\xa6\x9b\xfb\x3a\x3e\x9d\x17\x52\x19\x23\xf9\x0f\x2c\x5a\x40\x07\x57\x27\xe4\xb9\xf5\x1b\x6d\xb7\xb3\xe8\xb3\x9f\x1d\x13\x89\xe8\x3b\x04\xd6\x7e\x0d\x82\xac\x59\x9d\x0c\xb5\x08\x40\x7d\x86\x8f\x97\xf4\x1e\x70\x47\xb2\xc8\xb3\x27\x2f\xcf\x89\x34\x11\x74\xbe\xfc\x90\x3e\xa0\xb9\x12\x6b\x3d\xb0\x74\xfe\x54\x15\x6a\xb3\xf7\xb2\xd1\x74\x5f\x2e\x3a\x83\x48\xad\xcc\x7f\xa7\x03\xfd\x05\xeb\x4e\xfa\x78\x29\xf1\x84\x87\x15\xd8\xc9\x7f\x58\xa1\xbf\xfd\x33\x90\xef\xe3\xf3\x50\x38\x04\xac\x6a\xaa\x96\xc4\x83\x16\xfe\x63\x10\x58\x8e\x63\x8f\x43\xe2\xb7\x7f\xa8\x2e\xb7\x7b\xe6\xca\x29\x3d\x7b\xf2\xca\x2a\x5e\xbe\xea\xd0\x9a\x92\x38\x39\x74\xb6\xfd\x27\x15\xe9\x36\x6a\x7c\xbe\x6e\xe4\x7b\x55\xb7\x6e\x86\x47\xe9\x46\x47\xd6\x91\x8b\x4a\x83\x76\x54\x0b\x3b\x04\x9c\x0e\x30\xd2\x70\x2a\xe7\x1c\x63\x00\x95\x28\x31\x54\x24\x1b\x2b\xb7\x32\x98\xe6\x02\x0b\x57\x1a\x3b\x68\xbc\x88\x61\x6d\x70\x18\xba\x0c\xee\xee\xd4\xf1\x8e\xfd\xb4\xb7\x75\xac\x85\x06\x9b\x46\x57\xb0\x0e\xb7\x20\x3e\xf5\x6e\xc2\x50\x1b\xcf\x4c\xfb\x1a\xed\x96\xfd\x8c\x34\x6c\xe4\x4a\xac\xa9\xe5\xe8\x66\x0f\xf3\xec\x3a\x43\x7b\xda\xed\x49\xab\x0e\x6f\x7c\xde\x8f\xdd\xcd\x8e\x33\x24\x51\x1b\x23\x1a\x76\xc2\xbe\xbd\x45\xd1\xf6\x5e\xa1\x20\x35\x54\x91\x8a\x82\xf3\xa7\x7c\xc0\xe7\x68\xc9\xdc\xa5\xa3\x15\x85\x1e\xb5\x01\x6f\x27\xfa\x33\x96\xd8\xb4\x4c\x3b\xa5\x4a\x74\xc8\x69\xef\xf2\x25\x3c\xde\xe8\xe5\x4a\x19\xdc\x59\x1c\x94\xc1\x27\x2d\x0e\xa2\xf9\xd0\xc9\x96\x61\x8e\xf1\x64\x3a\xf5\x8b\x79\x64\xb1\xdb\x20\x35\xe0\x6e\xaa\xf7\x2c\x09\xae\x05\xb5\xdc\xda\x09\xb4\x10\xcc\x50\x27\x39\x3e\x9b\x37\x0e\xca\xdb\x37\x16\xca\xdb\x49\xef\x88\x60\x27\x2e\xa3\x16\xb0\xca\x60\x6d\x0f\x7f\x2e\xac\x94\x1e\x01\x8e\xdc\x39\x7b\x52\x89\xf2\x16\x8f\xfe\x79\xf6\x78\x56\xdb\x16\x71\x7c\x28\xfd\x8e\x20\x21\x23\x42\x6f\x4a\x03\x75\xa7\xa9\x2b\x7d\x9d\x9d\xe1\x62\x26\x6b\x5f\x67\x4e\x1d\xe2\xb2\x51\x3a\xaa\xca\x95\xa3\xa1\x02\x38\x5c\xd8\xe6\x67\xdf\x15\xe8\xbb\x6f\x77\xf6\x45\x7f\x33\x6c\x27\xbb\xdd\x88\xa4\x81\x5f\x48\x44\x78\xa3\x05\x4a\xa5\xb9\x2a\x1d\x8d\x18\x96\x0d\x5d\x8d\x8e\xcd\xc3\x49\x92\x2b\x74\x05\x90\x04\x19\x35\xad\x4d\x2c\x2e\x9c\x7a\x72\x87\x5b\xf0\xbd\x6f\x8e\xe9\x6c\x59\x2e\x8e\x61\xd7\xc8\xc8\xf2\xa5\xec\x96\x3d\xae\x38\xbd\x10\x57\x08\x37\x6a\x75\xd9\x2e\xf0\xf8\xec\x28\x67\x70\x5a\x22\x49\x33\x5c\xaf\x94\xd2\x69\x38\x8d\x50\x2c\x4f\xf6\xf9\x3a\x98\xe3\x77\x73\xda\x93\xb2\x64\x44\x7d\x40\x77\xcd\x5c\xf1\xe0\x41\x40\x38\x0e\xfa\x02\xac\xbb\x3c\x02\x84\x89\x37\xf1\xed\xcf\xcc\xf7\xfe\x7a\x3d\x68\xbd\xdb\x56\x91\xe1\x6e\x7f\xb3\x56\x88\xea\x74\x10\x6a\xa9\x48\x2d\xd4\xee\xd3\x49\xbc\x7a\x76\xe5\xf1\x73\xa2\x51\x34\x97\xf2\xd5\xee\xb8\x8a\x6d\x50\x9c\x38\x7e\x18\xaa\x25\xca\xe8\x30\xbb\x59\xa2\x36\x0a\x67\xf2\xe8\xe3\x55\xf8\x92\xaa\x79\xd0\xc2\xda\xf9\x48\x18\x7d\xb7\x8b\x3e\xd4\x15\xdf\x43\x4a\x1f\xb6\xd2\xfe\xab\x5f\x7c\x42\xbf\x53\xf6\x64\x0d\xbc\xba\x09\xdf\x25\xa3\x5b\x50\x43\xf5\xd4\x0c\xce\x43\x18\xc4\xc6\xe5\x39\x9d\x8f\xf6\x28\xe6\x9f\xc3\x01\x58\x22\xc1\x51\x16\x5d\xaf\x34\xea\xa0\xac\x71\xd6\xe3\xc1\x14\x92\xde\xfb\x50\x5b\x4f\xb8\xb9\x98\x80\xcb\xf5\xfe\xa8\x73\xb1\x96\x05\x72\x98\x35\x51\x88\xd0\x2b\x61\xf2\x25\x46\xde\x9d\x34\xb6\x3f\x6d\x1b\xe2\x2b\x0f\x29\x3b\xb8\x62\x2c\x1d\x96\xd3\x58\x51\x44\xb1\x17\x5b\xd7\xb5\x14\xda\xae\x0a\x0e\x8b\xfe\xaf\x1f\x09\xef\x0f\xfe\x06\xd3\x80\xbc\x6d\x7f\x12\x9a\x76\x6d\x84\x0d\xed\x4d\x0a\xe3\xd8\xd9\xec\xeb\x88\xef\xdd\x86\x26\xa4\x68\xe2\xf0\xb5\xdd\x80\xb4\x67\xda\x4a\x3a\x32\xf9\x03\x3d\x6d\x53\xda\x9c\xe6\x6b\x7a\x93\x50\x3f\x1a\x7b\x27\x41\x3b\x30\x57\xdb\xf0\x46\x54\xa6\x37\xdf\xc3\x51\xfe\x2e\x10\xeb\x8b\xd3\x18\x54\xe7\xcc\x4c\x74\x77\xeb\x9d\x15\x80\x7c\xea\xa5\xac\xe9\xf3\xc1\x34\xd5\xd7\x17\xcf\x37\x38\xdd\x93\x98\x14\x17\xe2\xc6\x3d\xa3\xb9\x67\x70\x21\x6e\xfe\x6f\x2b\x9b\xdb\x13\xca\xfa\xf2\x6f\xae\x99\x23\xbe\x74\x03\x72\x39\x19\x8f\x45\x42\x29\x49\x3b\x79\xda\x97\xb2\x59\x89\x4a\x56\x86\xbb\xa5\x04\x6b\xe8\x38\xc6\x9e\x69\x46\xb5\x90\x3c\xc9\xa1\x66\x7a\xb4\xda\xcd\x5f\xf4\x80\x3b\x00\xbe\xd2\x36\xe2\xec\x45\xc6\x34\xda\x12\xe9\x7e\x14\x23\x95\x4e\x84\x43\x69\xef\x7b\x8f\x33\xcc\x84\x6a\x11\xe3\x6f\xfa\x74\x0a\x03\x90\x53\x91\x8d\x1e\x5a\xea\xe3\x2f\xcb\xa3\x19\xb4\x33\x5a\x2b\x38\x8d\x17\x90\x86\x47\xb8\xd8\x01\x4e\xe1\x41\x3b\xd9\x19\x9a\x8f\xba\x92\x04\x80\x85\xaa\x8a\xc8\x8b\x47\x59\x2b\xac\x0c\x02\xb1\xaa\x49\x85\x77\x4c\x03\xd4\x77\x59\xef\xe3\x84\x28\x9d\xbb\x62\xdc\x1d\x83\xec\x7f\xa4\x70\xc2\x1f\xa7\xad\x57\x6b\x81\x97\xbd\x77\xc1\x50\x21\x8f\x60\x81\x1c\xc6\xb6\x9d\xf0\xb9\x0a\x62\xc8\x9a\x1f\xd5\xd0\x97\x0b\x57\xb5\x36\xbb\x1f\x48\xbc\x51\x95\xb6\x81\x86\x48\xfa\x87\xce\xfc\x11\x47\x1c\x2f\x40\xda\x01\x12\x6b\xc9\x38\x90\xd1\x9b\xbd\x53\x17\x41\xce\x92\x3d\xc5\x52\x3d\x62\x2f\x92\x09\x78\xe5\x4c\x47\xc8\xd2\x19\x2a\x7f\xad\x01\xff\x1b\xc4\xbf\xc7\x29\xb2\x7d\x2e\xd7\xa5\x32\xd4\xc7\x5d\x24\x12\x24\xae\x93\x32\x1d\x7b\x87\xa6\xc1\x8c\xeb\xcd\x9e\x2b\x34\x12\x4e\x4e\xe1\xd1\x37\x93\x5c\x54\x05\x7e\x42\x42\xea\x13\xb2\x87\xfe\x9e\x81\x7f\x18\x4c\x23\x9a\x21\xb1\x3b\xfe\xb8\x3c\x0c\x3f\x0f\xab\x87\x5f\x57\xea\x33\xb0\x14\xfd\x6a\x14\xac\x3c\x7b\x96\xf4\x83\xd5\x2c\xb4\x7c\x16\x85\xc7\xf6\xc9\xda\x2c\x07\x82\xb3\x1d\x80\x6e\xbf\xe3\x4c\x55\xc6\x44\xee\xcd\xd3\x4f\x8d\xc7\x56\x0b\x3f\x45\xcc\x68\x08\x55\x69\x06\x88\xb7\xf3\x7b\x7d\x44\x28\xbc\x51\x98\x46\xa3\x06\xfa\x8d\x7a\xeb\x5f\x76\x26\xc1\xd3\x70\xf0\x09\x93\x2f\x3a\x1d\xe1\xc1\x03\xf8\x22\xe9\xf3\x4e\xa4\x6a\x7e\xfc\x67\x2b\xca\x67\x75\x59\x04\x7c\xa2\xee\x69\x8c\x18\x8f\x1d\x96\x57\xf7\xd0\xe8\xa3\x4e\x8f\x99\xcc\xb6\x58\x80\x5b\xab\x85\x7f\x0e\x7f\x71\x1c\xc5\x43\xe1\x8f\xac\xcf\x7c\xa7\xbe\x79\xc4\x5f\x96\x1b\xfc\xc5\x38\x36\xc2\xbc\x36\xcb\x88\x47\x58\xfc\xb2\x94\xeb\xc0\xc4\xdb\x95\xb4\x81\xbf\x9c\xc2\x63\x2f\xf7\xfc\x97\x7c\xc3\xd5\x71\xf1\x47\x87\xc3\x0e\x67\xdb\xd7\x7d\x6c\x78\xf7\x4e\xb9\xcc\x05\xff\x7a\xdf\x1a\xb6\x37\xcd\x75\x46\xe9\x5c\x33\x67\xbf\x1e\xbc\xd9\x1b\xba\xc0\xa3\x05\xf1\x07\x87\x8f\xbf\x7a\xae\x07\x66\xef\xb5\x7a\x9d\xb8\x30\x34\xf2\x1f\xfe\xa3\xba\x44\x08\xae\xbc\xac\x6b\x58\x89\xea\x96\xee\x77\xd1\xf8\x95\x01\x61\x9f\xda\xaf\x27\x23\xb9\x6e\xa3\xfa\x56\xf7\x85\xf0\xf0\x71\xdf\x5e\x38\x89\xae\xcf\xb2\x9d\xea\x05\xb4\xd5\xbb\x0a\xbf\x46\x5d\xca\xea\x1a\xaf\x2d\x40\x4b\x5f\x14\x74\xf3\x02\xea\x98\x78\xa5\x32\xfe\x0a\x6f\xb8\xec\xa3\xc2\xcf\x02\xba\x3e\x6b\x2c\x2f\x51\xc7\xd9\xed\x1d\x5a\x24\x15\x86\x19\x3b\xce\xf6\x6e\xe6\x00\x33\x9a\x83\x79\x39\x0a\x53\x06\x06\xfd\x24\xe7\x69\x06\x53\x8d\xbd\x4f\x41\x87\xaf\x73\x40\x48\x2a\x3d\xa6\x07\x24\xc6\xe9\x0b\xbf\x41\xb6\x51\xf1\x9a\xef\xc8\x5d\xbf\x76\x32\xd7\xb5\x4f\x7b\x42\x41\x2d\xa8\xd5\x5f\xee\xc6\x2a\x00\xde\x6d\x7a\xe8\xd1\x04\x22\x86\x03\xfb\x4c\xc9\xb2\xd0\xaf\xea\xda\x7e\x34\x92\xce\x28\xf0\xde\x5d\x0a\x6d\xbf\x43\x8e\x67\x30\x2b\xf8\xaa\x60\xa6\x9d\x66\x77\x62\xea\xad\x3e\x5e\xb9\xde\xc9\x0c\x52\x2f\x78\x3f\xd3\xe0\xc2\x47\x5b\x8f\x97\xec\x73\x1f\x61\x41\x1d\x81\x5f\x3b\xf7\x4e\x9e\xc7\xe0\xf3\x5c\x3c\xd6\x41\x96\x89\x71\x6a\x71\xe8\x11\x8b\xb0\x73\x2d\x90\x31\x6d\xc9\x33\x62\xc7\x93\xeb\x3c\xb0\x7b\xe4\x45\x6d\xdf\x33\x7c\x24\xc6\x8c\x4a\x30\x9f\x3b\xf1\xf0\x17\x1a\x32\xa0\xf0\xd1\x3c\xf5\x63\x65\x94\xb9\x1d\xe1\x26\x2b\xa5\x94\xe6\x2b\x5c\x98\xa7\xec\xad\x29\xd3\xcc\x21\xb3\x9f\x6d\x06\xa7\xf1\x7d\xb4\x51\xc1\xca\x4f\xef\x96\xaa\xba\x35\xaa\xb4\x57\x53\x3d\x29\xcb\x44\xd5\xb3\xe7\x38\x08\xfe\xb6\x97\xc7\x21\x85\x68\xe0\xaf\xbf\x89\x86\x1e\x72\x59\x3f\x96\x42\x3f\x08\x2e\xae\xcb\x60\x8a\x22\x96\xe3\x7b\x31\x79\x4e\xe0\xab\xf7\xee\x92\xac\x08\x9b\x1e\x29\x02\x31\x2c\x39\xac\x46\x4c\x50\xba\x20\x80\x34\x1d\x58\xd6\xdf\xd9\xc2\xee\x99\x0f\xf1\xb0\x5f\xb9\x17\xf5\xfa\x0c\xcb\xe0\x9a\xc4\x72\x09\x22\x47\x8b\x87\x63\x46\x30\xfb\x4c\x71\xba\x43\x97\x81\x2d\x85\x9a\x69\xfc\x80\x14\x3a\x3a\x95\x32\xf8\xd9\xe6\xfa\x46\xc3\x6d\xdd\xa2\x02\xb6\x47\xd2\xfd\x49\x74\xd9\x49\xc4\xe7\x98\xff\xca\x6c\xcb\xdc\x45\xdf\x2a\x68\x24\xd6\x67\xd6\x9a\x2c\x25\xbc\x7b\xb2\xc4\x9c\x33\x56\x74\x62\x43\x2d\xb1\xb6\xe8\xa8\x4f\x3c\xb8\x93\xb2\xb0\xd9\x57\xa6\x43\xa8\x75\xbf\xbc\xb3\xdb\x6c\x30\x89\xb7\x9d\x6c\x27\xff\x6f\x00\xd4\xbd\x12\x50\xf3\xa2\x00\x00")
 
 func templatesServerBuilderGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -371,12 +523,12 @@ func templatesServerBuilderGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/builder.gotmpl", size: 15974, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/builder.gotmpl", size: 41715, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x4f\x6f\xdb\x38\x16\x3f\xaf\x3f\xc5\x83\xd0\x05\xec\xc2\x96\x81\x39\x76\x91\x43\x36\xe9\x74\x82\x6d\x1b\x63\x6c\xec\x1c\x06\x73\xa0\xa9\x67\x89\x1b\x8a\xe4\x90\x54\x13\x8f\xa1\xef\xbe\x78\x24\x65\x49\xb1\x9d\xa6\xed\x61\x4e\xb6\xf8\xfe\xf2\xc7\xf7\x8f\x5c\x2e\xe1\x46\x17\x08\x25\x2a\xb4\xcc\x63\x01\xdb\x3d\x94\x7a\xe1\x1e\x59\x59\xa2\xfd\x17\xdc\xde\xc3\xe7\xfb\x0d\xbc\xbf\xbd\xdb\xe4\x93\xc9\xe4\x70\x00\xb1\x83\xfc\x46\x9b\xbd\x15\x65\xe5\x61\xd1\xb6\xcb\x25\x1c\x0e\xc0\x75\x5d\xa3\xf2\xcf\x68\x87\x03\xa0\x2a\xa0\x6d\x27\x93\x89\x61\xfc\x81\x95\x48\xcc\xf9\xf5\xea\x6e\x95\x3e\x89\x26\x6a\xa3\xad\x87\xe9\x04\x20\xe3\x76\x6f\xbc\x5e\x7a\xe9\x32\xfa\x54\xe8\x97\x95\xf7\x26\x7c\x48\x5d\x66\x93\x09\x00\x5a\xab\xad\x83\xac\x14\xbe\x6a\xb6\x39\xd7\xf5\xb2\xd4\x0b\x6d\x50\x31\x23\x96\x91\x4a\x02\xb6\x51\x5e\xd4\x78\x89\x31\x91\x89\xb3\x16\x45\x21\xf1\x91\xd9\xaf\x31\x2f\x7b\x4e\x92\x73\xc8\x1b\x2b\xfc\xfe\x6b\x52\x1d\x1f\xc9\x94\x96\x71\xdc\x35\x72\x24\xe3\xf7\x12\xed\x76\xd9\xd1\xc2\x76\x4b\x2d\x99\x2a\x73\x6d\xcb\xe5\xd3\x92\x80\xe0\x5a\x79\x7c\xf2\x01\x83\xc3\xc1\x32\x55\x22\xe4\xb7\xb8\x63\x8d\xf4\x77\x01\x43\x47\x98\x1b\x2b\x94\xdf\x41\xf6\xcf\x3f\x33\xc8\xdb\x36\x30\xa3\x2a\xd2\xbf\x28\xf6\xe6\x01\xf7\x73\x78\xf3\x85\xc9\x06\xe1\xdd\x15\xe4\x03\x79\xa2\xb5\x2d\x1d\xd4\x50\x53\xe4\x1d\xa9\x9b\x51\x40\xbc\xe9\x0e\x96\xb4\x0c\x4f\x75\xb9\x84\x4d\x25\x1c\xec\x84\x44\x10\x0e\x1c\xdb\x21\x78\x0d\x58\x08\x9f\xc3\xbd\xe2\x08\xc2\x03\x3e\x09\xe7\x1d\xfd\x7b\x14\x52\x82\xd2\x1e\xb6\x08\xfa\x0b\xda\x47\x2b\xbc\x47\x45\x36\x1e\x85\xaf\x20\xff\x80\xea\xde\x78\x47\xca\x97\xcb\x52\xbf\xeb\xa2\x16\x52\xb8\x42\xbf\x80\xf6\x0b\x5a\x58\x2c\x3c\xb3\x25\xfa\x10\x73\x9b\xf0\x77\xc5\x7c\x05\x6d\x0b\x8b\x85\x62\x75\x0c\xc6\xcf\xf4\x27\x2c\x39\x83\x3c\x2c\xad\x0d\xf2\xc4\x39\x39\x1c\x16\x21\xe8\x47\x31\x1b\x13\x41\xe1\x68\x39\xd3\x86\xcc\x0b\xad\x5c\x16\x15\x32\x23\x16\x17\xe3\xfe\x98\x1c\x7d\x96\x74\xb6\x3e\xe9\x02\xe5\x39\x6b\x23\x42\x56\xd3\x57\x67\x2b\x7c\x8c\xac\x9d\x6a\xb9\x64\x6f\x1d\xf0\x3a\x67\x70\x4c\xc9\x2c\x3a\xcf\x8c\xc8\x22\x5c\x81\x36\x32\x79\x46\xd1\x25\x9b\x37\x52\xa0\xf2\xe7\x6c\x8e\x29\x19\x0f\x9f\x69\x97\xf1\x63\x64\xf3\x8c\xa2\x4b\x36\x37\x58\x1b\xc9\x3c\xde\x0a\x1b\xd5\xf9\xb4\xb0\x28\x84\x8d\x51\x32\xe2\x18\x6b\x48\x09\x77\x7f\x3c\xe5\xa8\xe3\x78\xea\x41\xc1\x25\xa9\x0d\x2b\x13\xbf\xa7\x7f\x67\x59\xc9\xc5\x95\x15\x8a\x0b\xc3\x64\x64\x36\xc7\x4f\x92\x18\x12\x4f\x45\x53\x25\x58\xf3\x0a\xeb\x31\xa2\x63\x4a\x16\x0a\x6a\xd4\x5f\x44\xca\xc2\x45\x12\x19\x39\xa3\xe6\x1c\x9e\x69\x5f\x21\xc8\xdc\x20\x04\x2f\x6e\x4d\x5b\x98\x52\x7a\xe7\x77\x8a\xcb\xa6\xc0\x20\x39\x1b\xaf\xfd\x97\x49\x51\x30\xaf\xed\x2c\x65\xe4\x83\x30\x51\xad\xfb\xaa\xbe\x5f\x98\x2a\x24\xda\x67\x1a\x57\xcc\xb2\x1a\x3d\x5a\x07\xcf\x28\xbf\xa2\x33\x5a\x39\x74\x43\x5b\x7d\x0a\x9f\xd8\x1b\xca\xae\x1b\x13\x5a\x56\x2f\xe8\xe2\xca\x8b\x52\x9f\x98\x50\x51\x04\x9f\xc2\xc2\xa2\x66\x42\x9d\x1e\xe4\xfb\x48\xa5\x2a\x34\x66\xa7\x02\x75\xe6\xdc\x9b\xda\xdc\x32\xcf\xd2\x89\x36\xb5\x59\x14\xcc\xb3\x53\xc6\xdf\x84\xaf\x6e\x62\x0f\x89\xbc\x54\x57\x17\xa9\xab\x0c\xd9\xbb\x7f\xbb\x46\x71\xe0\x5a\xed\x44\xd9\x58\xfc\x59\xb2\xd2\x4d\x99\x11\xf0\xf6\x70\xe8\x4a\x7d\xdb\xe6\xd4\x28\x98\xe3\x4c\x8a\xbf\xf0\x58\x4e\xaf\x57\x77\x33\x38\x4c\x00\x96\x4b\x60\x46\xe4\x37\xba\xae\x99\x2a\x3e\x0a\x85\xf7\x26\x00\xfc\xc1\xea\xc6\x38\xb8\x82\xdf\xff\xa0\x02\x7e\x89\xe3\x00\x79\x9e\x43\x3b\x69\x27\xcf\xdc\xb9\x5e\xdd\x7d\x93\x33\x14\xf5\x79\x0a\x92\xce\xb3\xa3\x32\xf0\x15\x92\x9f\x50\xa1\xc5\x09\x04\x97\x43\x31\x7b\x4f\xd3\x04\x5c\xa5\x99\x63\xb0\x36\x89\x1a\xd6\xe8\x61\xaf\x1b\x0b\xbc\x71\x5e\xd7\x20\x75\x68\x45\x21\xf1\xb0\xc0\x22\x87\x94\x4f\xa0\x55\x68\x83\x52\x97\x21\x8f\xfd\x2e\x2a\x78\xff\x64\x90\xd3\xe8\x25\x94\x47\xbb\x63\x1c\x81\xf6\x39\x75\xde\x0a\x55\xce\x69\xf7\x47\xca\xa1\x9d\x05\xa1\x4e\x92\xd5\x46\xe2\xbb\x1e\xe4\x8f\xd1\xf8\xd5\xd0\x48\xe8\xd7\x5d\xb6\xde\x68\xe5\x9a\x1a\xdd\xb1\x3a\x50\xdf\x97\x48\xa3\x5b\x2c\x61\x6d\x4b\x7a\xce\x82\x98\x64\x49\x3d\xa5\xf8\x89\x60\x1c\x0c\xa4\xc3\xd7\xe9\x48\xa3\x51\xe7\x92\xfd\x99\x36\x1d\x76\x6e\x41\xe8\xfc\x57\x64\x05\xda\x39\xa4\x0e\x3e\x84\x20\x9e\x45\x38\x42\x00\x8b\xbe\xb1\xaa\x3b\x9e\xcf\xda\x1f\xfd\xc2\x62\x9a\x1d\x0e\xc1\x72\xdb\xd2\x49\x47\xcb\x15\x73\x21\x29\xf7\x48\x93\x06\x2a\x10\xbd\x40\x46\xf0\xb6\xb3\xe1\xb8\xd4\xff\xeb\x30\x5c\x59\x5d\x34\xfc\xfb\x30\x4c\xb2\x3f\x84\xe1\x40\x47\x87\x61\xb7\xd4\x63\xf8\x48\x18\xfe\x66\x85\x27\x0c\xa9\x1a\xfc\x38\x82\xa6\xb3\xfb\xdd\x08\x26\x00\xd7\x69\x18\xbe\xc5\x9d\x50\x22\x64\x7a\x62\x08\x60\xba\x7f\x33\x27\xf8\x75\xe3\xab\xb0\xba\x5c\xc2\xb5\x31\x52\xa0\x83\xc7\x0a\x55\x48\x54\x22\x6a\x2b\xfe\x8a\xb0\x55\x21\x54\xc2\x88\x89\x3e\xce\x8a\xc4\x14\xd4\x40\x6c\x6c\x29\xa3\xc7\x78\xde\xdd\x52\x69\x68\x7c\x05\x57\x31\xe5\x1a\x87\x16\xba\xbc\x33\xcc\xb9\xf4\x31\x83\x69\xf0\x8d\x36\x3d\x05\xfc\x73\xd8\x88\xb3\x01\xae\x19\xcc\xda\xf6\xed\xa0\x5f\xf6\x7c\x6d\x3b\x8f\x08\xcf\xc6\xa8\x2b\x21\xe7\x97\xa0\xdf\x86\x0d\x30\x72\x90\x1c\x48\x0e\xcf\x5e\x81\x7f\x8f\x7b\x87\xe9\xf5\xea\xee\x3f\xb8\x7f\x11\xd4\x6c\x30\x0c\x67\x71\x9a\xd3\x8d\xe5\x61\x36\x8e\xd8\xbe\x0e\x45\xaf\x1f\x50\xfd\xbd\xc8\x51\x21\x7f\xc0\x7d\xc4\x6e\x08\x5d\x1f\xcd\x3b\xab\x6b\xfa\x8c\x7b\xa4\xf0\xa6\x41\x01\x7e\x1f\x80\xf0\xc7\x77\x21\x7d\x4f\x58\xfc\x14\x50\xfe\x46\xb0\xe6\xe0\xb8\x36\xe8\xa8\x21\xfe\x9d\xe8\x69\x82\xed\x27\xd8\x22\xb3\x68\x4f\x31\xfc\x16\x50\x9e\xfd\x8b\x77\x8d\xf3\xd9\x7f\xa6\x97\xb2\x94\xe6\x2f\xf6\xd3\xee\x6a\x9d\x77\x45\x01\x8b\xe9\xec\x62\x6b\xed\x2a\xe6\x91\xd9\xbe\xd8\x50\xaf\x57\x77\x3d\x27\x5c\x5d\x34\x76\xa6\xca\xf5\xd7\x84\xae\x96\xa7\x61\xbc\xbb\xa9\x74\x17\xe7\x70\x65\xef\x43\x64\xd5\xaf\xa6\xb8\x3a\xd3\x03\xba\x31\x86\xda\xc8\xd7\x86\x9f\xc4\xdb\xf7\x86\xd4\xb5\xc6\xd3\x20\xf7\x4f\x90\x26\xc1\x3c\xad\xce\xe1\x18\x52\x21\x39\xdc\x2b\x8c\x85\x71\xdb\xc5\x93\xee\x31\xa2\x28\x1c\xde\x64\x7e\x34\xa6\x13\x34\xb3\xc1\xbb\x4d\x1e\xc7\xf9\x02\x9f\xb5\xb5\x01\xc7\x49\x9c\x0f\x2e\x6e\x2f\x9c\xcd\xe9\x91\xe4\xa3\x03\x4b\x05\xe5\x15\x5d\x71\x10\x28\xc3\x11\xd3\xae\xab\xc6\x17\xfa\x51\x75\x45\x61\x06\x07\xca\xa2\xc9\x71\x13\x0e\x7d\x63\x3e\x48\xbd\x65\xf2\xd3\x71\x3f\xd3\xa3\x82\x69\xa0\xf7\x14\x37\x9b\x4d\xba\x07\x18\x84\xcd\xc7\xf5\x71\xce\x8d\xdb\xdd\xe2\x4e\x5b\x84\x5f\x36\x9b\xd5\xba\x7b\x2b\x71\x9e\x59\xef\xf2\x67\x33\xf6\xe6\xe3\x7a\xea\xa5\xbb\x09\xdf\xf0\xd6\x4b\x97\xc7\xff\xc7\xd9\xfe\x13\x7b\x40\x60\x52\x82\x42\x8e\xce\x31\xbb\x07\x5e\x51\x06\x38\xf0\x3a\xf4\x95\x53\xfb\x34\x63\xe7\xc9\xc3\x6b\x07\x4e\x6b\x05\xcc\x75\x9e\x08\x07\xa1\x30\x04\x78\x0b\xd8\x36\x3e\xe0\x6a\x1b\x45\xd8\xce\xc1\x87\x47\xa5\x46\xf1\xa0\x2b\xbc\x1a\x6d\x11\x38\x93\x12\x8b\x9c\x54\xde\xed\xa8\x8a\x84\x7a\x41\x3e\xd4\xba\x10\xbb\x3d\xb0\xe4\xc4\x1c\x9c\xa7\xdd\x77\xd6\x94\xf3\x4c\xf1\xf0\x32\xe5\xbc\x36\x20\x68\xd8\x2c\xc4\x17\x51\x34\x4c\xca\x3d\x48\x16\x66\xa8\x60\x55\xb8\xb0\x23\x23\x19\xc7\xbc\x7f\xe0\xea\x7c\xe1\x4c\xf5\xae\x40\xdd\x48\x2f\x8c\x44\xa0\x82\xe3\xe6\x50\xa0\x41\x55\x08\x55\x82\x8e\x0d\x57\x35\xf5\x16\x2d\xe8\x5d\xf0\x85\x08\x71\x58\x71\x41\x75\xba\x91\xc7\x17\xba\x6e\x97\x34\xe0\x30\xce\xb5\x25\x3d\x72\xff\x2e\xdd\xe5\xe7\xf1\xd7\x65\x74\x29\xce\x1a\x25\x9e\xb2\x67\x07\x19\x03\x6d\xea\xe0\x6d\xf7\xc4\x98\x62\x6f\x9e\x0c\xcd\x81\x15\x85\x3d\xb6\xed\xc3\x20\x80\x06\x8f\xa3\xe3\x73\xa4\xbd\x6b\x1b\xf6\x52\xa5\x82\x84\x4f\xc8\x1b\x4f\x5d\x85\x44\x1d\x42\xa1\xc3\xe9\x31\x63\xe4\xbe\x8b\x88\xf4\x5e\x97\xff\xcf\x69\x05\x85\xe6\x0d\x65\x49\x7e\xc6\x5c\xd4\x86\x0e\xd8\xce\xa3\x05\xab\x1b\x4f\x30\x51\x48\xa4\x18\xa6\x16\x81\xca\x0b\x1e\x3c\x9a\xc3\x56\x44\x88\x99\x2a\x08\x3a\x51\x84\xf5\x08\xc6\xf3\x2c\x99\x76\x4e\x0f\x6f\x86\x27\xf7\xc4\x7f\xa4\x1c\x4c\xcc\xaf\xc1\xa5\x62\xc6\xa0\x72\x47\x1f\xd5\xde\x57\xa1\xbf\x87\x20\x1a\x88\x31\xe9\x74\x80\x46\xc4\x74\xe9\xe2\xe0\x65\x90\xd6\xfa\x18\x8d\x0c\x4a\xad\x8b\x18\x90\xa4\xc0\xc8\xa6\x04\xa1\x80\x81\x61\x4a\xf0\xe8\x34\x69\xec\x8d\xce\xc3\x15\xb5\xc3\xa8\x46\x6f\x05\x77\x03\x80\x4e\xca\xcc\x77\xa2\xf4\xff\x00\x00\x00\xff\xff\x1d\xae\x37\x64\x5c\x18\x00\x00")
+var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\x4b\x93\xdb\x36\xf2\x3f\x47\x9f\xa2\x8b\xe5\x7f\x95\xe4\x92\x48\x27\xe5\xfc\x0f\xde\x9a\xc3\x64\xc6\x49\x66\xd7\xf6\xa8\x22\xd5\xe6\x90\xca\x01\x22\x5b\x14\xd6\x20\x40\x03\xa0\x67\x64\x16\xbf\xfb\x56\xe3\xc1\x87\x46\x33\x7e\xe4\x90\xda\x83\x3d\x24\xd1\xe8\x17\x7e\xdd\xe8\x6e\x65\x19\x5c\xa9\x02\xa1\x44\x89\x9a\x59\x2c\x60\x77\x84\x52\xad\xcc\x1d\x2b\x4b\xd4\xff\x80\xeb\x5b\x78\x77\xbb\x85\xd7\xd7\x37\xdb\x74\x36\x9b\xb5\x2d\xf0\x3d\xa4\x57\xaa\x3e\x6a\x5e\x1e\x2c\xac\xba\x2e\xcb\xa0\x6d\x21\x57\x55\x85\xd2\x9e\xac\xb5\x2d\xa0\x2c\xa0\xeb\x66\xb3\x59\xcd\xf2\xf7\xac\x44\x22\x4e\x2f\xd7\x37\xeb\xf0\x4a\x6b\xbc\xaa\x95\xb6\x30\x9f\x01\x24\xb9\x3e\xd6\x56\x65\x56\x98\x84\x5e\x25\xda\xec\x60\x6d\xed\x5e\x84\x2a\x93\xd9\x0c\x00\xb5\x56\xda\x40\x52\x72\x7b\x68\x76\x69\xae\xaa\xac\x54\x2b\x55\xa3\x64\x35\xcf\xfc\x2a\x6d\xd0\x8d\xb4\xbc\xc2\xc7\x08\xc3\x32\x51\x56\xbc\x28\x04\xde\x31\xfd\x39\xe2\x6c\xa0\xa4\x7d\x06\xf3\x46\x73\x7b\xfc\xdc\xae\x48\x47\x7b\x4a\xcd\x72\xdc\x37\x62\xb2\xc7\x1e\x05\xea\x5d\x16\xd7\x88\x2e\x29\x95\x60\xb2\x4c\x95\x2e\xb3\xfb\x8c\x1c\x91\x2b\x69\xf1\xde\x3a\x1f\xb4\xad\x66\xb2\x44\x48\xaf\x71\xcf\x1a\x61\x6f\x9c\x0f\x4d\xd7\xb5\x6d\xad\xb9\xb4\x7b\x48\xfe\xef\x43\x02\x69\xd7\x39\x62\x94\x45\x78\xf2\xdb\x9e\xbd\xc7\xe3\x12\x9e\x7d\x64\xa2\x41\x78\x75\x01\xe9\x68\x3f\xad\x75\x1d\x1d\xd4\x98\x93\xa7\x9d\xb0\x5b\x10\x20\x9e\xc5\x83\x25\x2e\xe3\x53\xcd\x32\xd8\x1e\xb8\x81\x3d\x17\x08\xdc\x80\x61\x7b\x04\xab\x00\x0b\x6e\x53\xb8\x95\x39\x02\xb7\x80\xf7\xdc\x58\x43\x4f\x77\x5c\x08\x90\xca\xc2\x0e\x41\x7d\x44\x7d\xa7\xb9\xb5\x28\x49\xc6\x1d\xb7\x07\x48\x7f\x41\x79\x5b\x5b\x43\x70\xca\xb2\x52\xbd\x8a\xa8\x85\x00\xd7\x1e\xc6\x60\x50\x7f\x44\x0d\xab\x95\x65\xba\x44\x4b\xa6\xa4\x5b\xf7\xb8\x66\xf6\x00\x5d\x07\xab\x95\x64\x95\x07\xe3\x3b\x7a\x70\x9f\x4c\x8d\xb9\xfb\xb4\xa9\x31\x0f\x94\xb3\xb6\x5d\x39\xd0\x4f\x30\xeb\x03\x41\xe2\xe4\x73\xa2\x6a\x12\xcf\x95\x34\x89\x97\xc1\x6a\xbe\x7a\x14\xf7\x7d\x70\x0c\x51\x12\x65\xbd\x55\x05\x8a\x73\xd2\x26\x0b\x49\x45\x6f\x51\x96\x7b\x99\x48\x7b\xc8\xe5\x31\x79\x1b\xe7\xaf\x73\x02\xa7\x2b\x89\x46\x63\x59\xcd\x13\x67\x9d\xf7\xf2\x44\xe4\x19\x46\x8f\xc9\xbc\x12\x1c\xa5\x3d\x27\x73\xba\x92\xe4\xee\x35\x58\xe9\x5f\x26\x32\xcf\x30\x7a\x4c\xe6\x16\xab\x5a\x30\x8b\xd7\x5c\x7b\x76\x36\x7c\x58\x15\x5c\x3b\x66\x53\x8a\x87\x1c\x7e\x6a\xb8\x28\xb6\xac\x24\x14\xc2\x6a\xb5\xa3\xd7\x95\xa5\xf7\x93\x70\x99\x50\x9e\xd1\xc4\xa1\xd1\x07\xdd\x80\x49\xc2\x79\xc0\xec\x2a\x24\xc5\xb6\x3d\x4b\x3c\xe5\x18\x52\xc1\x6d\x8f\x3f\xcf\xae\xc7\xa3\x33\xed\xb1\x5d\x83\x35\xd1\x8e\x87\xa4\xa4\xf2\x5a\x73\x99\xf3\x9a\x09\x4f\x5c\xf7\xaf\x6d\x3b\x5d\x7c\xb8\x35\xe4\xa8\x4d\x7e\xc0\x6a\x7a\xd6\xd3\x95\xc4\xa5\x7a\xcf\xbf\xf0\x2b\x2b\xe3\x97\xda\xf6\x94\x78\x24\xe8\xac\x5d\x0e\xfe\xc1\x32\x17\x1c\x8f\x9a\xa6\x34\xcc\x29\xf1\xa4\x37\x32\x17\x4d\x81\x6e\xe7\x62\xfa\xed\xdf\x4c\xf0\x82\x59\xa5\x17\x21\x57\xbc\xe7\xb5\x67\x6b\x3e\xcb\xef\x57\x26\x0b\x81\xfa\x84\xe3\x9a\x69\x56\xa1\x45\x6d\xe0\x64\xe5\x37\x34\xb5\x92\x06\xcd\x58\xd6\x90\x5c\x1e\xc8\x1b\xef\xdd\x34\x35\xc1\x64\xb4\xd1\xf8\x2f\x4f\xee\x7a\xcb\xb8\xf4\x5b\xf0\xde\x7d\x58\x55\x8c\xcb\x07\x5b\xd2\xd7\x7e\x95\xf2\xe3\x94\x9c\x52\xe7\x43\xf2\xeb\xa6\xaa\xaf\x99\x65\xe1\x44\x9b\xaa\x5e\x15\xcc\xb2\x87\x84\xbf\x73\x7b\xb8\xf2\xb7\xdb\x28\x12\xc2\x7d\x37\x26\x8f\x4f\xfb\x46\xe6\x90\x2b\xb9\xe7\x65\xa3\xf1\x67\xc1\x4a\x33\x67\x35\x87\xe7\x6d\x1b\x2f\xa1\xae\x4b\x29\x26\x99\xc9\x99\xe0\x9f\xb0\x4f\xf4\x97\xeb\x9b\x05\xb4\x33\x80\x2c\x03\x56\xf3\xf4\x4a\x55\x15\x93\xc5\x1b\x2e\xf1\xb6\x76\xd1\xf3\x8b\x56\x4d\x6d\xe0\x02\xfe\xf8\x93\xae\x96\xc7\x28\x5a\x48\xd3\x14\xba\x59\x37\x3b\x51\xe7\x72\x7d\xf3\x55\xca\x10\xea\xd3\x00\x92\xa8\x59\xcf\x0c\xec\x01\x49\x4f\x38\xa0\xc6\x19\xd0\xa3\x4f\xb3\xaf\xa9\xce\x81\x8b\x50\x0d\x8d\xbe\x79\x06\xdb\x03\xc6\x42\x89\x9c\xe9\xd8\xbc\x7c\xf1\x72\x09\x2f\x5f\xfc\xb8\x84\x97\xdf\xd3\x7f\x2f\xfe\x1f\x98\x2c\xe0\xc7\x17\xdf\x83\xb1\xcc\x36\x06\x0d\xe4\x4c\xd2\x0d\xec\x92\x7b\xd1\x6f\xe5\x1a\xd4\x9d\x84\x83\x57\x72\x09\x98\x96\xe9\xe0\x42\x27\xfb\x9d\xb2\x3f\xab\x46\x16\x70\x01\xe4\x8e\xb9\xbe\xf3\x86\x45\x34\xff\xae\xb9\xa5\xad\x1a\x9e\x87\xef\x1f\x1a\x34\x76\x49\x5a\xd2\x3f\x0a\xad\xe8\x52\xcf\x7a\x83\x16\x8e\xaa\xd1\x90\x37\xc6\xaa\x0a\x84\xa2\xaa\xd4\x5f\x13\x58\x60\x91\x42\xc8\x08\xa0\xa4\x2b\x31\x84\x2a\x5d\x26\xb2\x7b\xcf\xe0\xf5\x7d\x8d\x39\x95\xb5\x5c\x5a\xd4\x7b\x96\xa3\x57\xcd\x58\xcd\x65\xb9\x24\x61\xfd\x4a\xdb\x2d\xdc\xa6\xb8\x93\x55\xb5\xc0\x57\x83\x8d\x6f\xbc\xf0\x8b\xb1\x10\x57\x0b\xf5\x00\xfe\x15\x99\x70\xc9\x39\x78\x3f\x3b\xb8\x0f\x9f\x9c\x8f\x33\x8d\xac\x38\x7e\x82\x5a\xab\x1d\x1a\xd0\x8d\x74\x27\x92\x1f\x30\x7f\x6f\x40\x63\xc9\x8d\x45\xed\x54\x8d\x27\x7e\xea\xe5\xcb\xa2\xf8\x0d\x59\xc1\x25\x1a\x73\x45\xfb\xe6\x09\x45\xd3\x8e\x19\x4c\x96\xde\xb0\xdc\xde\x43\x88\x9a\x34\xc4\xd3\xc2\xfb\x16\x5a\xd0\x68\x1b\x2d\xa1\xd8\xa5\x6b\x2e\xcb\xb0\x3c\xcf\xed\xfd\x02\xba\x45\xb0\xc5\x87\x97\x7b\x0c\x69\xf4\x4a\x49\xd3\x54\x18\xae\x31\xb2\xf5\x86\x3c\x43\xd5\xbe\x4b\x47\xd0\x75\xa4\xdc\x59\x74\x87\xbd\xe4\xb5\xb6\x3d\xb3\xd1\xc9\x44\x61\xd0\xb5\x11\xdb\xdb\xeb\xdb\x57\xbd\x2b\x9c\x17\xf2\xc8\x40\xed\x07\x95\x9e\xf1\x25\x3c\x33\xa8\x5d\xdd\x7a\x29\xc4\x06\x35\x77\x51\xa5\x07\x25\x9f\x71\xe8\xba\xe5\x60\xd1\x69\x31\x6b\x50\xa7\x6f\xb1\xe0\x6c\x7b\xac\x27\x57\xc9\x12\x2c\x15\xad\xc6\x36\x3b\xd8\x33\x2e\x42\xf4\x30\x97\x2e\x79\x34\x00\x0b\xef\xd5\x10\x8f\x9f\x33\x3e\xb4\x01\x69\xfc\xf4\x33\x9d\x95\x3b\x30\x0d\x5c\xa5\x74\xaa\x14\x19\xa1\x5a\x1d\x43\x32\x1e\xde\x0c\x00\xe2\x01\x86\x80\x7f\xa7\x6c\xef\x50\x2c\xe6\x49\xdb\xba\xa4\xd2\x75\x83\xd7\x0e\xcc\x38\xbd\x8f\x48\x55\x35\xca\xb1\x01\x09\xc1\xbd\x5b\x8c\x5b\x83\xe1\x29\x78\x3a\x5d\x6b\x55\x34\xf9\xe8\xf0\xf1\x43\xb0\x2f\xf9\x8f\x51\x32\x79\xea\xf0\xc3\xde\x70\xf8\x7d\x9b\xd0\x75\xe9\x5a\x29\x81\xc5\x3f\x37\xb7\xef\x22\xcd\x7c\x11\xe0\x27\x0c\x7e\x25\xc6\xa6\x62\xbe\x01\x63\x75\x64\xf0\x3f\x88\xb1\x91\xf1\x11\x63\xf1\xd3\x80\xb1\x3b\xc2\x58\xcc\xbe\x94\x31\xfe\x3a\xc2\x7a\x9f\x7d\x33\xc2\x02\xc0\x36\xa1\x31\xbe\xc6\x3d\x97\x9c\xd2\x82\x09\x04\x0e\x05\xe6\x27\x66\x78\x7e\xd9\xd8\x83\xfb\x9a\x65\x70\x59\xd7\x82\xa3\x81\xbb\x03\xfa\x0c\x4a\x8b\x4a\xf3\x4f\xfe\xbc\x0f\x2e\x94\xe8\x2e\x30\x68\x87\x8b\xcf\xb1\x01\x5f\x4a\x9e\xf5\xe7\xcd\x35\x55\x06\x8d\x3d\xc4\xdb\xab\xa1\x04\x13\xef\x89\x9a\x19\x13\x5e\x16\x30\x6f\xdb\x50\x3d\xcd\x01\x3f\x8c\x4b\xdf\x64\xe4\xd7\x04\x16\x5d\xf7\x7c\x84\x8d\x81\x8e\x12\x53\xbc\xef\xc6\x5e\x97\x5c\x2c\x1f\x73\xfd\xce\x19\xc0\x48\x41\x52\x20\x28\xbc\xf8\x82\x08\x1f\xfc\x1e\x7d\x7a\xb9\xbe\xf9\x17\x1e\x9f\x74\x6a\x32\x6a\x8c\x13\x42\x78\xba\x51\x8d\xce\x09\xc5\xc1\xb7\x5f\xe6\x45\xab\xde\xa3\xfc\x7b\x3d\x47\xa5\xd3\x7b\x3c\x7a\xdf\x8d\x5d\x37\xa0\x79\xaf\x55\x05\x6d\x1b\x6c\xec\x3a\xa8\xa9\x34\x87\x3f\x46\x4e\xf8\xf3\x9b\x3c\x7d\x4b\xbe\xf8\xa1\xeb\xbe\xde\x59\x4b\x30\xb9\xaa\xd1\x50\x09\xfa\x77\x7a\x4f\x91\xdb\x7e\x80\x1d\x32\x8d\xfa\xa1\x0f\xbf\xc6\x29\x27\x4f\x7c\xff\x78\xf4\x9f\xa9\xfd\x58\x08\xf3\x27\xeb\xbf\x38\x66\x4b\x63\x52\xc0\x62\xbe\x78\xb4\x14\x8c\x19\xb3\x27\xd6\x4f\x16\x80\x97\xeb\x9b\x81\x12\x2e\x9e\x10\x36\xda\x13\x97\x36\xfe\x34\x0d\x5a\x03\x4c\x8e\xad\xc9\x99\x10\xa3\x42\x3b\x9e\xbb\xc6\x0f\x0d\xa7\x7a\x70\x77\x74\x9f\xfb\xf6\xef\xc4\x8d\xe4\x8d\x69\xe3\x1f\xaa\xcf\xa1\x5f\x74\xbc\x55\x63\x81\xc5\xfa\x1d\xb4\xab\xc9\x83\x54\x46\x0d\xc0\x12\x1a\x29\xd0\x18\x27\x2c\xcc\xcf\xc8\xa3\x96\x69\x1b\xd5\x5b\xad\x08\x9c\xb9\x5d\x05\x36\x26\x78\xc7\x52\x2e\xe6\x16\x34\xee\x5d\x0b\x61\x95\xdf\xe7\x0a\x5f\xe1\xe6\x7b\xf6\x80\x55\x28\x65\xa9\x31\x89\x0c\x5c\xb7\xc1\x84\x51\xbe\xe5\xb0\xc0\x84\x00\x46\xe7\x99\x63\x50\xce\x75\x68\xa1\x17\x9a\x53\xcc\x2d\x96\x9e\x0f\x3d\xc3\x0e\xb9\x2c\x3d\x50\x7a\xe8\x39\xab\xfd\x6d\x3e\x6a\xbf\x5c\x8f\xa2\x2f\xd7\x37\xe7\x0f\xb9\x8f\x98\xf1\xed\x34\xf8\xd5\x15\xa8\x74\xa2\x3e\x08\x71\x18\x75\xc6\xc2\x86\x62\x6d\x14\xdd\xbd\xe0\x70\x58\xd3\xd8\x0f\x59\x25\xf6\x7c\x17\x53\x55\x9f\xa2\x1d\xae\xf5\xb6\x3d\xd3\x3a\x9f\x69\x00\x46\x15\x8a\xcb\x6b\xe6\x0b\x84\xb9\xd9\x84\x71\xb6\x8e\xe0\x4d\x09\x64\x3c\xf6\xf9\xab\xe9\x28\xb8\x66\x31\x1a\xbf\x87\x6e\xb1\x18\x1a\xe1\x3e\x4d\x8d\x88\x1e\x64\xa9\x78\x4e\x93\x81\xee\x67\x93\x53\x96\x51\xe3\x33\xc4\x53\x48\xd3\x1e\x29\x9b\x43\x63\x0b\xea\x79\x43\x76\xa6\xe6\x14\x1c\x4d\xd0\xc7\xa0\x6d\xea\x5f\x84\xda\x31\xf1\xb6\x57\x6d\xde\x33\x98\xbb\xf5\x61\xc5\x2c\x16\xb3\x38\x15\x47\xd8\xbe\xd9\xf4\x2d\xbe\x43\x18\xec\x70\xaf\x34\xc2\xaf\xdb\xed\x7a\x13\x07\xd8\x2e\x8a\x4c\x7a\x32\x5e\xd8\xbe\xd9\xcc\xad\x30\x57\x6e\x3b\x3c\xb7\xc2\x84\x08\xe9\xc7\x1a\x6f\xd9\x7b\x74\xa1\x24\x31\x47\x63\x98\x3e\x42\x7e\x20\x48\x1b\x1a\xc0\xdb\xb3\xf2\x69\xbc\x90\x06\x0d\x2f\x0d\x18\xa5\x24\x30\x13\x35\xe1\x06\x5c\x7d\xe6\x60\x52\xc0\xae\xb1\xee\xe8\xa9\x8d\x3d\xa2\x0d\x05\x2d\xa9\xe9\x6c\x71\xa3\xfc\x1d\x86\xdc\x96\xce\xb2\x0c\x6e\xf6\x14\xa5\x2e\x71\x93\x0e\x95\x2a\xf8\xfe\x08\x2c\x28\xb1\x04\x63\xc9\xfa\x28\x4d\x1a\xcb\xe8\x07\x02\x97\x49\x54\x4d\x3f\x0f\x70\x59\xf0\x8f\xbc\x68\x98\x10\x47\xa0\x11\xad\x0e\x52\xb9\xcf\x59\xb5\x60\x39\xa6\xc3\xaf\x0e\x51\x97\x30\xcf\x08\x69\xb6\x6a\x84\xe5\xb5\x40\xa0\x1f\x73\xcc\x12\x0a\xac\x51\x16\x94\x43\x94\x2f\x27\x65\x53\xed\x7c\x2f\x40\xba\xd0\x82\xaf\x1a\x8d\x63\x1d\x86\x91\xee\xa7\x90\xde\x4a\x97\xb7\xf2\x5c\x69\xe2\x23\x8e\xaf\xc2\x18\x73\xe9\xff\x9a\x64\x09\x49\x23\xf9\x7d\x42\x73\xc1\x84\x15\x15\x97\xc9\x2c\xcb\x88\xdd\x6b\x96\x1f\x5c\x82\xa4\x1f\x2e\xa0\x62\x47\xb8\xd3\xac\x06\x3b\x64\x48\x9f\x04\xb9\x35\x6e\xfc\x32\x84\x40\x98\x0d\x38\x36\xdf\xf1\x7d\x50\x12\x2e\x2e\xa2\x04\x68\x69\xe5\x3b\xd3\xcf\x95\x2e\xc0\x2d\xdc\x4a\x71\x9c\xf7\x5f\x17\x44\x74\x3a\x4d\xf3\xf8\x9f\x1b\x78\x1e\x7f\x8e\x0a\x3f\x03\x2c\x83\x98\x25\xb0\xa2\x88\xd5\x31\x81\x6e\xc0\xf5\xa0\x61\xcf\xcf\xc3\x8b\xe0\xa1\xf4\xc4\x34\xbc\xc7\xbc\xb1\x54\x75\x50\x48\x18\x84\x42\x39\x50\xb1\xba\x16\xc7\x08\xd4\xf0\xdb\x4e\x4a\xed\x26\x14\x2a\x6f\x28\x7e\xd3\x33\xe2\x3c\x37\x34\xc0\xf6\x34\x3d\xd0\xaa\xb1\x74\x7a\x84\xd4\x10\x5a\x74\xe9\xa2\xb4\x3c\x77\x1a\x2d\x61\x47\x90\x92\xa5\xbb\xa5\x3e\xfa\xf1\x2e\xdd\xaf\xce\x19\xa7\xc1\x3b\x8f\x4a\x8f\x67\x75\x0f\x26\x77\xdf\x85\xd4\x10\x88\xbf\xc4\x2f\x07\x56\xd7\x28\x4d\xaf\xa3\x3c\xda\x83\x9b\x4d\xb9\x88\x1a\x6d\x73\xb7\x24\x0b\x85\xba\x55\x3d\x3c\x9f\x76\xd2\x46\xf5\x41\xc2\xa0\x54\xaa\xf0\x71\x42\xde\xad\x45\x53\xd2\xb4\x89\x41\xcd\x24\xcf\xfd\xb1\x90\xcb\x06\xa1\x4b\x37\x72\x8b\x3e\xaa\x90\x6e\x7f\x33\x72\xd0\x83\xec\xf7\x8d\x5e\xfa\xef\x00\x3d\x3a\xf1\xba\x88\x1e\x00\x00")
 
 func templatesServerConfigureapiGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -391,12 +543,32 @@ func templatesServerConfigureapiGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 6236, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 7816, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerConfigureversionsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xcd\x6e\xdb\x4c\x0c\x3c\x67\x9f\x62\x90\x53\x02\x24\xd2\x03\x7c\xa7\x20\xf9\x80\xfa\x90\xda\x40\x8d\xde\xd7\x12\xb5\x22\xba\xe6\xaa\x5c\xca\xaa\x21\xe8\xdd\x8b\x55\x9c\x04\xfd\x49\x4f\x5a\x92\x23\x72\x86\xc3\xba\xc6\x63\x6a\x09\x81\x84\xd4\x1b\xb5\x38\x9c\x11\xd2\x7d\x9e\x7c\x08\xa4\xff\xe1\x69\x8b\xcf\xdb\x3d\xfe\x7f\xda\xec\x2b\xe7\xdc\x3c\x83\x3b\x54\x8f\x69\x38\x2b\x87\xde\x70\xbf\x2c\x75\x8d\x79\x46\x93\x8e\x47\x12\xfb\xad\x36\xcf\x20\x69\xb1\x2c\xce\xb9\xc1\x37\xdf\x7c\xa0\x02\xae\x76\x97\x77\x29\xf0\x71\x48\x6a\xb8\x71\x57\xd7\x42\x56\xf7\x66\xc3\xb5\xbb\x75\xae\xae\xb1\xef\x39\xa3\xe3\x48\xe0\x8c\xec\x3b\x82\x25\x50\xcb\x56\x61\x2b\x0d\x81\x0d\xf4\x83\xb3\xe5\xf2\x9a\x38\x46\x48\x32\x1c\x08\xe9\x44\x3a\x29\x9b\x91\xac\x8d\xbe\x90\x8d\xc3\x57\xd2\xcc\x49\xf2\x33\xb7\x6d\xa4\xc9\x2b\x61\x52\x3f\x64\x58\x4f\xe8\xbd\xb4\x91\x14\x99\xf4\xc4\x12\xe0\x63\x5c\xf3\x17\xc5\xcf\x69\x14\xc3\xb2\x1c\xcb\x97\x5a\x3c\xec\x36\xb9\x88\x8b\x99\xb0\x2c\xa7\x4b\xe7\x37\xb9\x98\xd8\xfa\xf5\xff\xe3\xdb\xb0\x75\xce\x19\xb9\xf7\x4a\x77\x85\x14\x55\xa1\x2a\x39\xc4\x14\x42\x99\x99\xba\x35\x54\xfa\x3e\x52\x11\x95\x14\x5e\xe0\x47\xeb\x49\x8c\x1b\x6f\x9c\xa4\x20\xce\x2b\xbb\x02\x63\xa5\x0a\x1b\x83\x8e\x92\x71\xa0\x2e\x29\x15\x00\x32\x45\x6a\x56\x78\xea\xfe\x26\xe3\x61\xb7\xf9\x83\xfd\xbb\x57\x75\x8d\xd4\xc1\xbf\x12\xb9\xfb\xa0\xc3\x3f\x17\x50\xb6\xdb\x24\xe9\x38\x8c\xfa\x72\x56\xd6\x13\x2b\xd2\x24\xef\xf9\xd5\xdb\xca\x75\xa3\x34\x1f\x59\x74\xf3\x6a\x4c\xb9\x8b\xea\xd3\x4b\x70\xfb\x4b\x84\xd9\x5d\x29\xd9\xa8\x82\xde\x4b\x1b\x49\xdd\xe2\x7e\x0e\x00\x62\xc6\x88\xa5\xda\x02\x00\x00")
+
+func templatesServerConfigureversionsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerConfigureversionsGotmpl,
+		"templates/server/configureversions.gotmpl",
+	)
+}
+
+func templatesServerConfigureversionsGotmpl() (*asset, error) {
+	bytes, err := templatesServerConfigureversionsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/configureversions.gotmpl", size: 730, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerDocGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x8f\xdb\x20\x10\xbd\xf3\x2b\xe6\xbc\x52\xf0\xdd\xad\x2a\xb5\xc9\x4a\x8d\xb4\xdd\x44\xdd\xb4\x77\x6a\x4f\x1c\xd4\x00\x11\x90\xae\x52\xc4\x7f\xaf\xf8\xb0\xc3\xe2\xa4\xd2\x9e\xec\x79\xf3\xe6\xbd\x61\x3c\xa6\x69\x60\xa9\x7a\x84\x01\x25\x6a\x66\xb1\x87\x5f\x17\x18\xd4\xc2\xbc\xb2\x61\x40\xfd\x01\x56\x1b\x78\xde\xec\xe0\x71\xb5\xde\x51\x42\x88\x73\xc0\xf7\x40\x97\xea\x74\xd1\x7c\x38\x58\x58\x78\xdf\x34\xe0\x1c\x74\x4a\x08\x94\xb6\xca\x39\x07\x28\x7b\xf0\x9e\x10\xd2\x3c\x90\x2d\xeb\x7e\xb3\x01\x03\x9f\x7e\xde\xae\xc7\xd0\x7b\xc8\xc2\x6b\xb9\x57\x74\xc7\xed\x31\x80\x81\x55\x03\x78\x34\xf9\xed\x70\x16\x4c\xf2\xbf\x08\xf4\x99\x09\x4c\x66\x28\xfb\x98\x9b\xa4\x56\x68\x3a\xcd\x4f\x96\x2b\x19\x9a\x98\x14\xe7\x78\x6a\xf3\x4d\x1b\xa8\x85\xd9\xec\x5f\x50\xff\xe1\x5d\x30\x25\x11\x81\xcd\x1e\x32\xd6\x92\xab\xe2\x9c\x5d\x89\x2a\x0d\xf4\xa5\x3b\xa0\x40\x03\xf4\xab\x32\x16\xe8\x17\x66\x70\xcb\xec\x21\x49\xe4\x9a\xe0\x3f\xf2\xbc\x27\x00\x00\x39\x6c\xc3\x94\x34\x93\x03\xce\x18\x00\xce\xd1\x62\xdc\xf5\x81\xa2\x5f\xe6\x86\xf7\x28\x35\xa2\x35\x79\x6a\x2b\x17\x8c\x71\x2a\x2a\xb2\xa9\x30\x3e\x5f\x79\x71\x8c\xac\xf3\x13\xb5\xc9\x03\x0e\x32\x39\x4c\x2a\xd7\x5c\xed\xfe\xc4\x3b\x94\xf1\x23\xc7\xaa\x1c\xb6\xf0\x36\x9d\x3e\x7a\xda\x91\x12\x4a\xab\x74\x4b\x90\xfe\xf8\xfe\x54\x15\x4c\xc8\xed\xa1\x2d\x95\xb4\xac\x9b\xe6\x96\xc3\xa9\x93\x1c\x97\x9d\xcc\xa1\x5b\x82\xf4\x51\x30\x7e\x04\xef\x3f\x96\x35\x23\xf8\xe9\x5e\x55\xea\x16\xca\x9a\xff\x1c\xa0\x96\x30\xe7\xbc\x2e\xe3\x59\x22\xd0\x5e\x37\xaa\xe4\x04\xca\x22\x3a\x7d\xc3\x9e\xb3\xdd\xe5\x14\x7f\x30\x92\x16\xed\x8e\xc9\x56\xab\xfe\xdc\x15\x26\x23\x50\x98\x94\x9c\xf7\x99\x5c\x7f\x27\x92\x2f\xa7\x56\xa0\x65\xe4\xa1\x21\xa7\x7b\xb7\x0a\xf9\x17\x00\x00\xff\xff\x2c\x04\xd8\xf5\xdf\x04\x00\x00")
+var _templatesServerDocGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xcd\x8a\xdb\x30\x10\xbe\xeb\x29\xe6\xbc\x10\xf9\xee\x96\x42\x9b\x2c\x34\xb0\xdd\x84\x6e\xda\xbb\x6a\x8f\x1d\xd1\x48\x0a\x92\xd2\x25\x15\x7a\xf7\xa2\x1f\xc7\xb2\xbd\x29\xec\xcd\xf3\xcd\xf7\x33\x1a\xcb\xae\x2a\x58\xab\x16\xa1\x47\x89\x9a\x59\x6c\xe1\xd7\x15\x7a\xb5\x32\xaf\xac\xef\x51\x7f\x80\xcd\x0e\x9e\x77\x07\x78\xdc\x6c\x0f\x94\x10\xe2\x1c\xf0\x0e\xe8\x5a\x9d\xaf\x9a\xf7\x47\x0b\x2b\xef\xab\x0a\x9c\x83\x46\x09\x81\xd2\xce\x7a\xce\x01\xca\x16\xbc\x27\x84\x54\x0f\x64\xcf\x9a\xdf\xac\xc7\xc0\xa7\x9f\xf7\xdb\xa1\xf4\x1e\xb2\xf1\x56\x76\x8a\x1e\xb8\x3d\x05\xd0\xb9\x25\x80\x27\x93\x9f\x8e\x17\xc1\x24\xff\x8b\x40\x9f\x99\xc0\x30\x88\x73\x28\x5b\xef\x4b\xab\x0d\x9a\x46\xf3\xb3\xe5\x4a\x86\x21\x9c\xbb\x8b\xa7\x31\x27\x63\xa0\x16\x66\xd7\xbd\xa0\xfe\xc3\x9b\x10\x4a\x22\x02\xbb\x0e\x32\x56\x93\xd1\x71\xc9\x9e\x99\x2a\x0d\xf4\xa5\x39\xa2\x40\x03\xf4\xab\x32\x16\xe8\x17\x66\x70\xcf\xec\x31\x59\x64\x0d\xef\x46\x9e\xf7\x04\x00\x20\x97\x75\xd8\x92\x66\xb2\xc7\x05\x03\xc0\x39\x5a\xac\x7b\x96\x9d\xf2\x32\x37\x3c\x47\xab\x01\x9d\x93\x6f\x63\x65\xc1\x50\x27\x51\xd1\x4d\xc2\x68\xf0\xca\x8b\x63\x64\x9f\x9f\xa8\x4d\x5e\x70\x18\x31\x97\xc9\x65\xec\xcd\xd3\x9f\x78\x83\x32\xbe\xe4\x18\x9e\xcb\x1a\xa6\xed\xf4\xd2\xa3\x7a\x02\xa5\xab\xf4\x96\x21\xfd\xf1\xfd\x69\x26\xb8\x21\x37\xfe\x44\xb8\x56\xd2\xb2\xe6\xb6\xb7\x5c\xd6\x30\x6d\x97\x93\x2c\xa1\xb7\x0c\xe9\xa3\x60\xfc\x04\xde\x7f\x74\x6e\x09\x7e\xba\xa7\x4a\xd3\x42\xa9\xf9\xcf\x01\xe6\x16\xe6\x92\xaf\xcb\x70\x96\x08\xd4\xe3\x8d\x2a\x39\x81\xb2\x8a\x49\xdf\xb0\xe5\xec\x70\x3d\xc7\x0f\x2c\x4a\xef\x86\xec\xb5\x6a\x2f\x4d\x11\x32\x00\x45\x48\xc9\x79\x5f\xc8\xf8\x39\x91\xfc\x73\xaa\x05\x5a\x46\x1e\x2a\x72\xbe\xf7\x57\x21\xff\x06\x00\x2c\x04\xd8\xf5\xdf\x04\x00\x00")
 
 func templatesServerDocGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -416,7 +588,27 @@ func templatesServerDocGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\xdf\x6f\xdb\xb6\x13\x7f\x16\xff\x8a\xab\xd0\x2f\x20\xf5\xeb\x50\x2b\xf6\x96\xc2\x0f\x41\x92\x76\x1e\xd2\x24\x80\xd3\x87\x61\x1d\x0a\x46\x3a\xc9\x5c\x68\x52\x23\x29\xbb\xae\xa1\xff\x7d\x20\x45\xcb\xb2\x1d\xb7\xd9\x8a\xec\xa5\x2f\x96\xc5\xbb\xfb\xf0\xf8\xb9\x5f\x54\x96\xc1\xb9\x2a\x10\x2a\x94\xa8\x99\xc5\x02\xee\x57\x50\xa9\x13\xb3\x64\x55\x85\xfa\x0d\x5c\xdc\xc0\xf5\xcd\x1d\x5c\x5e\x4c\xee\x28\x21\x04\xd6\x6b\xe0\x25\xd0\x73\x55\xaf\x34\xaf\x66\x16\x4e\xda\x36\xcb\xdc\x72\xae\xe6\x73\x94\x76\x4f\xb6\x5e\x03\xca\x02\xda\x96\x10\x52\xb3\xfc\x81\x55\x08\x73\xc6\x25\x21\x7c\x5e\x2b\x6d\x21\x21\x00\xb1\x50\x55\xec\x9e\xca\xf8\x87\x44\x9b\xcd\xac\xad\x63\x42\x00\x84\x62\x85\x81\xb8\xe2\x76\xd6\xdc\xd3\x5c\xcd\xb3\x4a\x9d\xa8\x1a\x25\xab\x79\xe6\x85\x31\x89\x82\x5b\x1f\x0c\xbe\x53\x53\xab\x9b\xdc\xbe\x15\xac\x32\xd0\xb6\xa5\x7f\x0e\xcd\xff\x44\x63\x70\x51\x3c\x38\x1c\x2f\x75\x7b\x06\x3f\x4f\xda\xb6\x7b\x09\x68\xb7\x43\x98\x1d\x14\x53\x97\xaf\x7f\xce\x6a\xb7\xbe\x67\x1f\x55\x9a\xe5\x58\x36\x62\x47\xdf\xae\x04\xea\xfb\x6c\x23\xf3\x47\x5b\xaf\x35\x93\x15\x02\xbd\xc0\x92\x35\xc2\x4e\x3c\x25\xc6\xb1\x56\x6b\x2e\x6d\x09\xf1\xff\xfe\x8a\x81\x06\xa7\x50\x16\xe1\x5f\x67\xf6\xf2\x01\x57\x23\x78\xb9\x60\xa2\x41\x38\x1d\x03\x1d\xd8\x3b\x59\xdb\x3a\xb7\x86\x48\x9d\xee\x0e\x5c\x4a\x48\x96\xc1\xdd\x8c\x1b\x28\xb9\x40\x58\x32\xb3\x9b\x0d\x76\x86\x10\xd2\x01\xac\x52\x82\x3a\xfd\xf7\xec\x01\xc1\x34\x1a\x41\x2a\x0b\x56\x81\x5a\xa0\x5e\x6a\x6e\x11\x6c\x0f\xc5\x4a\x8b\x1a\x56\xaa\x19\x00\x72\x0b\xf7\x98\xb3\xc6\x20\x30\x21\x9c\x50\x03\x16\xdc\x1a\x58\xaa\x46\x14\x70\x8f\x20\x94\xb1\x2f\x48\x88\xc1\xe5\xe7\x5c\x34\x05\x4e\x6b\xcc\x5d\x12\x95\x8d\xcc\x81\x4b\x6e\x93\x14\xd6\x9b\xe4\xa0\x67\x45\x71\xa5\x58\x81\x3a\x29\xe7\xd6\xd0\xdf\xce\xde\x5f\xbd\x67\x36\x9f\xa1\x1e\x41\xbf\x72\xa1\xf2\x94\xb4\x64\x90\x90\x1e\xcc\x25\x63\x00\x7b\x24\xec\xdd\x92\x3b\xe3\xbe\x27\xb0\x21\xc5\x2d\x8c\x00\xb5\x76\x21\x08\xfe\x48\x26\x56\x5f\xb0\x48\xd6\x6b\xa0\x67\xb7\x93\xdb\x90\xf8\x6d\x4b\xa7\x9d\xd1\xaf\xd3\x9b\xeb\x11\xc4\x71\x4a\xc0\x6d\xe0\xac\x5f\x8c\x41\x72\xe1\x1d\x71\xe7\xaa\xe8\x5b\x66\x99\x10\x32\x41\xad\x9d\x5a\xbb\xcd\x32\xbf\xfd\x82\x69\x30\xa8\x17\xa8\xe1\xd5\x23\xfb\x74\x92\x2c\x83\x79\x1f\x2a\xc7\x1b\x70\x03\x39\x13\x02\x0b\x42\x22\x97\xbc\xf4\x83\x71\x26\x63\x70\x6c\x04\x22\xc0\xb1\x46\xdf\xfa\xcc\x49\x94\xa1\x53\x5b\xa0\xd6\x23\x88\xbd\xee\xe9\x47\x19\xa7\x24\x8a\x8e\xe8\x78\x2f\x0b\x66\x66\xa8\xf9\x17\x04\x7a\xcd\xe6\xce\xa3\x93\xe0\xeb\xef\x37\xb7\x77\x93\x9b\xeb\xe9\x1f\x1f\xa5\xc7\xf1\xdb\x59\x6e\x85\x4f\xe1\x10\x82\x89\x2c\x55\xcf\xbe\x7f\xa3\x77\x5e\xc5\xaf\xed\xd4\xc6\xbe\x10\x85\xc1\xad\xe9\x6e\xd0\xe2\x78\xab\x30\x88\x1e\x75\x3f\x49\x3a\x80\xea\x79\xde\xf9\xf3\x0c\xc8\xae\x5d\x1c\x21\xd2\x73\xf2\xff\x38\xd0\x14\x45\x05\x9a\xfc\xeb\x14\x5d\xa0\xc9\x35\xaf\x2d\x57\xf2\x18\x51\x07\x2a\xdf\x7b\xa8\x01\xe0\xb3\x90\x76\x1c\xdf\x17\x81\xaf\x1e\xcf\xcc\x8b\x31\xc4\x31\xac\x49\x34\xe4\xb3\x1c\x12\xea\xd4\x06\x7c\xee\x32\x2f\xe4\x50\xd5\x17\xc6\xb9\x9a\xcf\x99\x2c\xae\xb8\x44\xea\xfa\x81\x4f\x7e\x93\xa4\x29\x71\xb6\x59\x06\x35\xd3\x06\x7d\x7f\x3c\xbf\x9a\x78\x1b\x13\x6a\xea\xd6\x49\x92\x94\x6c\x9b\xca\x61\xf7\xe8\xca\xc1\xc7\x73\xaf\x76\xaf\x71\xd9\x95\x6f\x22\xb9\x48\xbf\xda\x69\x3c\x55\xc6\x6a\x2e\xab\xa4\x43\xf4\x4b\xe9\x3f\xec\x2b\xac\xe6\x5d\x6a\xd1\xe0\x46\xe7\x85\x4b\x21\x66\x72\x26\x86\x85\x7c\x76\x3b\x49\x06\x0e\xa5\xfd\x59\xe8\x14\xad\x13\xb2\x9a\xa7\xa1\x57\x75\xb1\x25\x10\x75\x1b\xfc\x3b\x7c\x47\x75\x85\x76\xc3\xd8\x92\xdb\x99\x27\x1b\xfc\x30\xf3\xb3\x46\x60\x01\xaa\xb1\x24\x7a\x12\xab\x03\x07\xbb\x66\x1a\x15\x58\xa2\xee\x8f\x31\x6b\x6c\xa1\x96\xd2\xc5\x2f\x00\xd2\x73\x25\x4b\x5e\x35\x1a\x9d\x77\x29\x89\x02\xb7\xa7\xe3\xed\xd9\xf5\x02\x93\xf4\xcd\x2e\xe5\x51\x74\x40\x78\xd4\xee\x70\xf3\x8d\xf4\x38\xfd\x76\x7e\x0c\x79\xfe\xef\x67\xd2\xf7\x26\x4f\xf4\xb4\x83\x86\x90\x1d\x89\xd3\x60\xa6\x43\x57\x95\x1e\xd0\x57\xa4\x03\xf1\xe5\xa8\x43\x81\x8c\xc2\x7a\xb8\x73\xa5\xbd\x09\x9d\xce\x94\xb6\xc3\x0e\xf9\x43\xce\xa3\x9e\x8e\x2b\x25\xab\xa7\xb2\xf1\xc3\x8d\x9e\xbe\xb3\x1f\xb9\x1b\xee\xb5\x0d\x7f\x9f\x4c\x5c\xae\x95\x4a\xc3\xa7\x11\xa8\xda\x9a\x77\x5a\x35\xb5\x4b\xd4\xee\x3a\xcf\x6a\x3e\x9c\x39\x37\x7e\xe7\x4e\xc9\x84\x12\xfc\xd4\x17\x75\x88\xd1\x59\x51\x78\x85\xa4\xc7\x3b\xc8\xe2\xc1\x5e\xfb\x21\x1d\x8a\xc2\x76\xe9\x66\xa8\x1e\x94\xff\xa3\x0d\xa0\x1b\x1f\xbb\x57\x53\xd7\x1c\x0f\x1c\x0d\x13\xf1\xa0\x3f\xe6\xee\xeb\xf3\x74\x0c\xaf\x49\xe4\xec\x4a\x1c\x81\x7a\x70\x0b\xa8\x35\x4d\x5e\x75\xa5\x7a\xa9\xb5\xd2\xe9\x1b\x27\xf1\x03\xde\x2b\xd2\xbb\x55\x8d\x30\xde\x94\xf9\xa5\xd6\xbf\xa0\xa8\x3b\x85\x0e\x76\x0c\x3f\xb9\x97\x36\x0c\x7b\x65\xe8\xe5\x67\x6e\x13\x27\xdb\xf6\xe1\xc7\xbb\xef\xf3\x0e\xdc\x67\x9a\xb8\xc7\xa6\xd8\x30\x38\x8f\xe4\x66\x37\xd2\xb6\xfe\x7f\x6b\xa8\x3d\xe9\xfb\xa4\x25\x7f\x07\x00\x00\xff\xff\x9e\xc0\x8e\x4b\x5b\x10\x00\x00")
+var _templatesServerJwtGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3b\x6b\x73\xd3\xc8\xb2\xdf\xfd\x2b\x3a\xaa\x43\x56\x62\x85\x4c\xb2\x84\xa5\xcc\xf5\x56\x85\x10\x2e\xbb\xb0\x81\xc2\xe1\x00\x95\x4a\xed\x8e\xa5\xb1\x3d\x58\x1e\x09\xcd\xc8\x8e\x8f\xf1\x7f\xbf\xd5\xf3\xd2\xc8\x0f\x12\xce\xd9\xbd\xf7\x16\x55\x24\x9a\x47\x77\x4f\xbf\xbb\x67\xd2\xed\xc2\x59\x91\x51\x18\x53\x4e\x2b\x22\x69\x06\xc3\x25\x8c\x8b\x07\x62\x41\xc6\x63\x5a\x3d\x85\xe7\x6f\xe0\xe2\xcd\x25\x9c\x3f\xff\xf5\x32\xe9\x74\x3a\xab\x15\xb0\x11\x24\x67\x45\xb9\xac\xd8\x78\x22\xe1\xc1\x7a\xdd\xed\xc2\x6a\x05\x69\x31\x9b\x51\x2e\x37\xe6\x56\x2b\xa0\x3c\x83\xf5\xba\xd3\xe9\x94\x24\x9d\x92\x31\xc5\xc5\xc9\x5b\xf3\x3b\x4e\x74\xbb\x70\x39\x61\x02\x46\x2c\xa7\xb0\x20\xa2\x4d\x8c\x9c\x50\x30\xd4\x80\x2c\x8a\x3c\xe9\x74\xbb\x70\x9e\x31\xc9\xf8\x18\xa4\xdb\x37\x53\xd4\x94\x55\x31\xa7\x30\xaa\xa5\x02\x35\xa1\x1c\x96\x45\x0d\x15\x7d\x50\xd5\xbc\x05\xc9\xa2\x50\x64\x13\x9e\x75\x3a\x6c\x56\x16\x95\x84\xb0\x03\x10\x0c\x97\x92\x8a\x00\x7f\x4b\xab\x65\x29\x0b\xef\xd7\x2e\x4d\x33\x41\x5a\x03\x79\xce\x4a\xc9\x52\x7f\x6c\x32\x23\xad\xef\x4a\x6f\xf9\xc3\x0d\x88\x09\x39\x3e\x79\xbc\x35\x76\x72\x74\x8c\x63\x01\xe5\x69\x91\x31\x3e\xee\x0e\x89\xa0\x8f\x1f\xb5\xc7\x3e\x8b\x82\xab\x91\xd1\x4c\xaa\x9f\x33\x22\x27\xdd\x21\x1b\xab\x0f\x4e\x65\x77\x22\x65\xa9\x3e\x84\xac\x18\x1f\xeb\xa3\x88\x25\xd7\x34\x49\x36\xa3\x41\xa7\x03\x40\xab\xaa\xa8\x04\x04\x63\x26\x27\xf5\x30\x49\x8b\x59\x77\x5c\x3c\x28\x4a\xca\x49\xc9\xba\x7a\x56\x2d\x5c\xad\xa0\x22\x7c\x4c\x21\x79\x4e\x47\xa4\xce\xe5\xaf\x8a\x59\x02\xd6\xeb\xd5\x0a\xca\x8a\x71\x39\x82\xe0\xde\x97\x00\x12\x14\x35\x40\x23\x76\x6f\xf3\x3f\xa6\x74\x19\xc3\x3f\xe6\x24\xaf\x29\xf4\xfa\x90\xb4\xa0\xe0\x2c\xac\xd7\xb0\x01\xd0\x2c\xdf\x80\x1a\x75\x3a\x69\xc1\x85\x16\x57\xb7\xab\x44\x8b\xfb\x05\x95\x80\x1a\x41\x65\x3a\xa1\x19\x90\x31\x61\x1c\xc8\x48\xd2\x4a\xab\x0a\x1e\xbd\x03\xf0\x79\x31\x15\xef\xe8\xa8\xa2\x62\x02\x7d\x35\x98\xbc\x2c\xea\x4a\xc3\x22\x20\x8b\x29\xe5\xb0\x60\x72\x02\x84\x43\xcd\xa7\xbc\x58\x70\x05\x9f\x65\x06\xb6\x68\xa1\x54\x78\x62\x20\x12\x66\x85\x90\x50\xf0\x94\x02\xe3\x5b\x28\x7f\x67\x7c\x03\xeb\xef\x8c\xd7\x92\x36\x67\xc0\xc1\xa2\x96\x50\x8c\xd4\x67\x45\xbf\xd4\x54\x48\x01\xa3\xa2\xf2\x11\xc6\x5a\xb7\x71\x24\x2d\xf8\x88\x8d\x61\x42\x04\xf0\x02\x50\xf0\x90\xe6\x8c\x72\x69\x70\x5e\x1a\x88\x7d\x38\x7a\x08\xf7\x15\x35\xc9\x80\xa6\x05\xcf\x3a\x91\x32\xbd\xdf\x3e\x5c\x9e\x69\x18\x1a\x54\x5d\x99\xc3\xcd\x49\xce\x32\x22\x59\xc1\x2d\x39\xbf\x7d\xb8\x84\x21\x25\x15\x72\x13\x59\x24\x3a\x72\x59\x52\x0f\x82\x90\x55\x9d\x4a\x58\xe9\x03\xfd\xf6\xe1\xd5\xe0\xfd\xbb\xd7\x28\x10\xdc\x8d\xbf\x5a\x40\x83\x37\x17\xb0\xa0\x43\xc7\x40\x33\xce\x84\xa8\x69\x65\x57\x29\x31\x88\xb8\xe1\xce\x94\x2e\x05\x90\x8a\x6e\xc8\x97\xce\x69\xb5\x84\x49\x51\x57\x31\x10\x9e\x69\xde\x58\x29\x22\x63\xb6\x84\xd8\x01\x47\x9c\xb6\x10\x8d\xe3\x15\x4e\x36\x92\x6d\x91\xe1\x38\x5e\x51\x5c\xc3\x0b\x4b\x7b\x4f\x2d\xba\xba\x46\x9f\x01\x82\xa6\x55\x73\x9c\x97\x03\x20\xf9\xb8\xa8\x98\x9c\xcc\xec\x39\x8c\x20\xef\x57\x82\x24\x6f\xeb\x61\xce\x52\xc4\x5a\x54\x70\x5f\x39\x16\x7f\x4c\x03\x29\x10\x27\x14\x9c\x8a\x0e\x00\xae\x65\x5c\xd2\x6a\x44\x52\xba\x42\x9b\xe8\x76\xe1\xd4\xe1\x50\xcc\xc1\x4d\x24\x4d\x69\x89\xde\x5c\xb0\x31\x27\xb2\xae\xa8\x4f\x09\x90\x3c\xd7\xb0\x39\x15\x16\x8f\x62\xae\x3a\x24\x9d\x95\x72\xd9\x01\x1f\xee\xd5\xb5\xcf\xa7\x5f\xb5\x98\x90\xd7\xa7\x75\xc6\x28\xaa\xbb\xc5\x4c\x6f\x4a\x9a\x22\x66\x26\x90\xf1\x19\x90\x3a\x83\x34\x27\x6c\x26\x62\xc4\xb9\x44\x1a\xf9\x0f\x12\xd2\x09\x4d\xa7\x34\x6b\xa3\x34\x90\x01\x1c\x3a\x87\xc0\x27\xe0\x35\xa5\x0b\xe2\x64\x95\xe6\x45\x3a\x05\x31\xa5\x0b\x90\x45\x6e\x22\x87\xb5\x18\x7a\x53\x2a\x32\xf8\x70\x64\xc8\xe8\x80\xdd\xaf\x8c\xe1\x79\x5d\x29\x25\xd7\x90\x5f\x5e\x5e\xbe\x3d\x53\xf6\xb3\xcb\xd0\x63\x20\xc6\xba\x8c\x77\x40\xa3\x12\xca\x9a\xb4\x6f\x41\xdb\x55\x07\xe2\x2c\xef\x80\x0f\xed\x3e\x9a\x66\xa2\x41\x77\xd6\xce\xf2\x14\x45\x8e\x79\x9a\x40\x94\x08\xd1\xe6\xa7\x95\xaf\x31\x33\x3d\x3f\x23\xe5\x95\x66\xc7\xb5\xaf\x0c\x08\x73\x50\x0f\x3f\xd3\x54\x5a\xd6\x88\x7a\xa8\x4f\xdd\x19\xd5\x3c\x85\x30\x6d\xa0\x44\x76\x6d\x18\x19\x6e\x2b\xbb\x15\xf5\x30\x86\x3f\xd0\x3d\xa7\x57\x81\xa8\x87\xc1\x75\x12\xea\xe9\xa8\x03\x50\x51\x59\x57\x1c\x44\x3d\x34\x67\x18\xa4\x45\x49\x9b\x03\x08\xfd\x39\xae\x08\x47\x1d\x90\x45\x63\x41\x31\x8c\xaa\x62\x06\x4c\x0a\x10\x25\x41\x81\xd2\x92\x68\x59\xa9\x5d\x9a\x4e\x28\x2a\xbd\x24\x2d\xbf\x41\x38\xae\x17\x61\xe4\xd4\x52\x53\xbe\x60\x32\x9d\x58\x12\xcc\x01\xf0\x03\x8f\x80\x1c\x8c\xd4\xb2\x94\x08\xab\x4c\xbd\x0e\x40\x73\x26\x05\x49\x24\x2f\x18\xcd\x33\x11\x6a\x30\x78\xe6\xf5\x5e\xd8\xe5\x7f\x08\x59\x6d\xb8\xf2\x65\xa8\x29\x9a\x93\x0a\x2a\x2a\xea\x5c\xfa\x86\x07\x2a\x0a\xfc\x11\x1b\x76\xf5\xfa\x26\xac\x1a\xa2\x90\x02\xfc\xc7\x46\x48\x43\x0c\xc5\x14\x85\xa8\x26\x9d\x04\x9f\xe2\xa8\x5d\x08\x16\x47\x1f\x48\x59\x52\x9e\x85\xfa\x3b\xc6\xfd\x91\x59\xb4\xee\x34\xff\x9b\xe3\xe8\x55\x86\x31\x66\x0c\xd5\xdd\x29\xf5\x3f\x51\x73\x89\x2c\x2a\x1b\x42\xa8\xd8\x0e\x1d\xda\x55\xcf\x48\xa9\xfc\x2d\xab\x8c\x71\x5a\x95\xc1\x14\x20\x65\x25\xc9\x37\x43\xa1\xb3\x85\x06\x8b\x17\x75\x4c\x34\x74\x11\x09\x87\x76\xd8\x5f\x07\x60\x56\xe3\x91\x00\x30\x2b\x4a\x7e\xaf\x25\xbd\xe9\x00\x9a\xb9\xc0\xc1\x3d\x06\x06\x2e\xf0\xa0\xad\x27\x18\x5a\xb5\xd7\xa8\x74\x54\x67\x7c\x6c\x0d\x4f\x2d\xf4\x5c\x2b\x7a\x0f\x4c\x09\xca\xaa\x18\x57\x54\x08\x8c\xe0\x2c\x9d\x78\x1e\xde\x86\x19\xc2\x24\x0a\xba\x03\x3e\xd4\xfb\x98\x3e\xbc\x40\x98\x86\xcd\xee\x1b\x11\x92\x9d\xe8\x62\xc8\x0a\xae\x82\x55\x9a\x17\x82\x66\x26\x2d\x51\xbe\xa1\x98\xd3\x4a\x73\xb2\x01\xe4\xb1\x51\x6d\x4c\x27\x84\x9b\x41\x75\x76\x5a\x55\x26\x65\x34\x34\x5c\xd0\x45\x4b\x0e\x69\x45\x95\xac\x8d\xef\x52\x63\xed\x18\x8a\xd1\x08\x5d\x3e\xfa\x4e\x4b\x69\x51\xf9\x44\xe3\x19\x88\xc9\x44\xb4\xe9\x6f\x60\x09\x37\x45\x1c\xc1\xfd\x16\x15\xab\x46\xe6\x68\xa7\x6a\x75\xd2\x38\xe2\x8e\xb2\x10\xb3\xa0\xdf\x07\xce\x72\x63\x11\x76\x0c\x0e\x3d\x55\x59\x99\xfc\xa9\xe7\x27\x53\xeb\xb6\xf2\x1f\xfa\xf8\x57\x9a\xbe\x9e\xc1\x1c\x1b\x5a\x7a\xe6\xe7\xda\xf0\xee\xcd\x69\x2d\x27\xc7\xf8\x9f\xd5\x18\x82\xbf\xe3\x89\x6d\xc6\x45\x38\x14\x38\x78\x8c\x59\x45\x5d\x31\xb9\x04\x91\x4e\xe8\xac\xc9\xcc\x54\x01\xa4\x83\xbd\x10\xd6\xae\x3a\xdd\x6e\x2b\x6d\x61\x72\x82\x51\xc9\xda\x10\xab\xac\xc3\x35\xa9\x54\x51\x0d\x59\x96\x51\xae\xea\xaa\xcb\xfd\x41\x48\xad\x4e\x0b\x3e\xa7\x95\xe7\xda\xf7\xd9\x69\x62\xfc\xf6\xbc\x2d\x9d\xc8\x3b\x78\x68\x80\x01\xae\x0c\x3d\xf7\x1e\xea\x22\x93\x17\x12\x42\xa0\x5f\x20\x79\xeb\x90\x04\x9e\x39\x06\x10\xc1\x7a\x7d\xdf\x15\x05\xab\x95\xbf\x72\xbd\x8e\xb5\xb2\x46\x91\x46\xa0\xed\x39\x76\x1e\xf5\x2f\xc7\x03\xab\x46\x25\x14\x46\x25\x01\xe3\xfd\x8d\xd7\x16\x7f\x33\x7a\x30\xd2\x53\x67\x47\xff\x3f\x4f\x0c\xef\xa9\x26\x47\xfb\x75\x36\x52\xf3\x07\xbe\xfa\x3b\xda\x39\xcb\x0d\x4c\x91\x5c\xd0\x45\xa8\xac\x61\x20\x89\xac\xc5\x7b\x8e\x0a\x59\x54\xec\x5f\x34\x8b\x91\x4a\x4f\x3f\x7a\x70\x6f\x1e\xa8\x8d\x91\x17\x33\x6c\x26\xd0\xeb\xc3\x8c\x4c\x69\xe8\x79\xd6\x61\x51\xe4\xd1\x37\xe2\x9a\x3e\x49\xe2\x82\xbd\x25\xd3\x80\xbc\x52\x0c\xbd\xc6\xfa\xad\xaa\xa9\x87\xf2\xae\x51\xf2\x60\x03\x90\x9d\xba\x0b\x23\x5e\x58\xab\x89\x21\x70\xc6\x06\x59\x41\x05\xa6\xb6\x0a\xb0\xb2\x86\x7b\x42\xd3\x11\x18\x7a\xbe\x11\x56\x8d\x35\x84\xfa\xd8\xb8\xd0\xfa\x8a\x67\x2a\x6e\xde\xe6\x2b\x48\xc9\xb0\x3a\xd8\xf4\x15\xaa\x0c\xa5\x70\x6a\xe4\xa6\x52\x5d\x98\x50\x92\xd1\x2a\xf6\x1d\x49\x2b\x38\xff\x2f\xf9\x82\xe6\x60\xff\x27\xbe\xe0\xef\x31\xc1\x7d\x1e\xe0\xef\xc1\xa6\x6c\x39\xa7\xdc\x58\x37\xfc\x02\x3f\xc3\xe1\xa1\x41\x29\x92\xf3\x2f\x35\xc9\x5f\x14\x79\xa6\xe7\xaf\x7a\x3f\x5f\xc7\x10\x68\xbe\x43\xd0\xd8\x94\x9a\x45\x53\xc2\x9f\x57\x3f\xf7\xae\x3d\xfd\xfc\x7f\xe6\x51\x0c\xcc\xfd\xe6\x62\xc9\xd3\x15\xa6\xa9\x83\x5c\x19\xac\x54\xd9\x14\x24\x12\x2b\x35\xac\x36\xe8\x4d\x19\x03\x1f\x8e\xe2\x9d\x55\x2b\x26\xaa\x1a\xab\x50\xb5\x89\x29\x23\xf7\x28\xb5\x45\xbf\x29\x7c\xa7\xd4\x2d\xf9\x95\x04\x1b\x60\xbd\xbe\x93\xd8\xa0\xcc\x99\x0c\x0d\x81\x41\x12\x44\x1d\x27\x62\xb5\x36\x82\x83\x3e\xfc\x04\x2b\x9f\x17\x8a\xbf\xa3\x99\x4c\xce\x91\xc7\xa3\x30\x40\x73\x21\x36\xdb\xfa\xed\xc3\x65\x60\xeb\x18\xac\x2c\xb4\xf1\xfb\xe9\x9e\x2a\xf5\x0d\x05\xf0\x27\xb6\x17\x7b\x01\xc9\xc7\xc1\x9f\x0a\xc9\x2b\x96\x6d\xcc\x4d\x59\x16\xfc\x69\x20\x1a\xc1\xf7\xfa\xf0\x79\x21\x9f\xd3\xb4\xc8\xe8\x80\x8e\xb1\x13\xac\xe9\xbd\x7a\x78\x1d\xc3\xa1\xc6\x19\x3d\xdd\x56\x92\x7d\x47\xb0\x9a\xa0\x77\xb6\x55\xc1\xe0\x45\xb5\x9f\x27\x26\xc3\x6b\x9a\x15\x11\xfc\x02\x0f\xd1\x08\x0e\x3e\x2f\xe4\x59\xc1\x25\x61\x5c\xec\x5a\x18\x1b\xe0\xc9\x69\x3e\x8e\x6e\x21\x07\x95\xe8\xde\x97\xa6\x89\x02\x4c\x79\x7a\xdb\x67\x09\x5a\xb0\x0c\x89\xae\xf7\xe2\x6c\x47\x77\x73\x93\x77\x64\xf1\xfe\xdd\xeb\x73\xd3\xcf\x4d\x0c\xd7\x14\x8b\x0d\xd3\x8e\xaf\xa3\xce\x6e\xa3\xba\x8d\x5f\x0e\xe9\x36\xcb\x54\xfb\xd5\x50\x32\x4f\xa6\x74\x19\x1a\x9a\x5f\xb1\xec\x2e\xe8\x68\x55\x19\x48\x66\xa5\x16\xfa\x3f\x69\xc5\x46\xcb\x81\xc5\x6b\x81\x9e\xe6\xe3\x18\xb3\x7a\xcc\xb8\xb0\x37\xe6\xd4\xe1\xc7\x20\x09\x7e\xd4\x1f\x47\xd7\x51\xdc\x50\x1c\x3d\xbd\x1b\x7e\xa3\xc7\x26\x36\x39\xbb\xba\x8b\x32\x1e\xa1\x32\x1a\x97\xf1\x6f\x28\xa3\xde\xb9\xcd\x59\x5e\x2c\x30\xd3\x50\x55\xe1\x45\xb1\x08\x2d\x37\xd1\xad\xe8\x3a\x5c\xef\xbc\x0a\xe8\x4d\x19\x5c\x7b\x65\xb8\x74\x12\xf9\xbc\x90\x17\xf5\x8c\x56\x2c\x7d\x8e\xbe\x95\xde\x94\x77\xf5\xac\xbb\x28\xc5\xb6\x97\xc2\xb9\xdb\x89\xb2\x11\x1c\xf0\x62\x91\x3c\xa3\xa3\xa2\xa2\xa1\x4c\x4e\xb3\xac\x31\x11\xdd\x1e\x8b\xa2\x5b\xb1\x35\xa9\x0f\x53\x4e\x14\x8b\x8b\xa0\xc1\x63\x54\x45\x39\xd6\x36\x1b\xf8\x70\x74\x37\x36\xf0\xe1\xe8\x3f\x61\x83\xeb\xfa\xed\x65\x03\x72\x61\xd7\xe1\x1d\x6b\xbe\x8f\x0b\xe8\x14\x34\xea\x25\x95\xdb\xac\x70\x58\x4c\xa3\xf3\xa0\x0f\x41\x60\x10\xb0\x11\x46\x1f\xdb\x7c\x33\x8c\x62\x42\x78\x1d\xb8\xa7\xb8\x02\x79\xb0\x09\xe7\x7b\x49\x54\x8d\x76\x75\xd7\x77\x4f\x04\xf1\x26\xb8\xfd\x64\xbb\x6e\xac\x4f\x38\x06\x15\x62\x27\x5a\xed\x2c\x05\xc7\xf4\xce\xb0\x0f\xec\x1d\x8c\xd4\x59\xbb\x7b\xb6\xa3\x7f\x06\x0d\xd8\x7e\x1b\xf0\x8a\xd4\x99\xc9\x4e\xf6\xf4\xd0\x5a\x7b\x49\x9d\xb9\x23\x61\x81\x50\x73\x45\xcb\x88\xe4\x82\xfa\x35\x83\xa1\x51\x57\x0c\x6e\xbf\x65\x2e\x1b\x21\x4c\xe8\xf7\x77\xf0\xc3\xae\xb1\xd0\xbd\xaa\xc4\xa2\x6d\x2c\x4f\x2f\xf9\x5e\x99\xcd\x28\x56\x15\x48\x69\x5b\x66\x96\x86\xb6\xd4\x0c\x54\x9b\xc3\x34\x0d\xba\xe9\xd6\x25\x07\x31\xb7\x22\x3d\x7f\xb0\xb9\x5b\x8a\x6d\x8b\x06\x9b\x42\xc5\xc8\xef\xd6\xec\x4b\xec\x31\xb6\x4c\x5d\xda\x10\x41\xe8\x89\xa7\x95\xfe\xf8\xba\x65\x6f\x64\xfa\x6d\x9b\x70\xf3\x58\xdd\xb4\x1a\x36\xfb\x59\x67\x6e\x67\x64\x01\x73\x15\x9c\x9a\x86\x88\x67\x92\x6e\xbb\x8f\x41\x73\xca\x46\x99\x64\x56\x27\xaf\x8b\x74\xaa\x1c\xba\x8a\x65\xda\x8d\xcd\x93\xcf\x8b\x69\x38\x65\x59\xe4\xf5\x04\x55\x54\x35\x1f\x76\xf3\x7b\x9e\xdb\xed\xd8\x97\x65\xa8\x2a\x36\x50\x0c\xf0\x2b\x34\x1b\xa2\xa7\x66\xf6\x97\xd6\xe5\xe4\xd7\xaf\x10\x1e\x14\x53\xcc\x66\xfc\xe9\xe6\x22\xd1\xda\x8e\x71\x8f\x8a\x06\xd3\x37\xf4\x40\x7b\x9e\x13\xf3\xa2\x62\xba\x93\x83\x3a\xbe\x5a\xe6\xb4\x0f\xdf\x1c\x7f\xe3\xf4\xdb\x07\x35\x3e\xa3\xc1\x72\x8b\x94\x5c\x37\x90\x65\x70\xef\x4b\x10\x83\x81\xec\x69\xf1\xd4\x0a\xc6\x35\x3f\xf7\xa8\xb0\x2d\x77\x8d\x7e\xc6\xee\x1a\xd0\xb6\xc1\xcc\xb2\x5a\x98\x8b\x9d\x82\xe7\x4b\x5f\xe5\xbf\xa1\xd4\xe6\xd0\x7b\x94\x5a\x75\x33\xac\x4e\xe3\x32\xad\xc6\x87\x87\x2a\x75\x57\xe9\x96\x88\xd0\x77\x1c\xc1\xca\x77\x39\x88\xdb\xb9\x1c\xbd\xcc\x2c\x68\x9f\xbe\xd5\xe4\x58\x6f\xaa\x23\x6e\xbb\x9a\xb2\xec\x7a\x83\x67\xc5\xd4\x58\xbd\xd1\x09\xa3\xac\x3b\x6f\xaf\x6b\x9e\x53\x81\x15\x8e\x7a\x83\x61\x94\xc7\xa8\x1d\x2e\x1f\xb3\x39\xde\x39\xa3\xea\xa2\x10\x4e\x71\x6a\x9c\xdb\x56\x37\x13\x7e\x7b\x1b\xaf\xc2\x89\x5a\x1b\xfb\xcd\x48\xd3\x6f\xa0\x99\x72\x66\x8b\x89\x7a\xa5\x61\x1a\xde\xc0\xf6\x37\x0a\x36\x34\xba\x69\xbf\x47\xa8\xf5\xa6\xe9\xdb\x56\x58\xd4\x40\x67\x8e\xba\x0e\x76\x06\x01\xab\x9d\x8a\xeb\x58\x67\x5d\x80\x82\x92\xd6\x55\x65\x9a\xc9\xce\xb4\x18\x1f\x3f\x75\x13\xad\x94\x64\x1b\xe6\x7f\x3d\x30\x0b\x93\xac\xe0\xd4\x47\x63\xc7\x9b\xbc\xda\x8c\xa0\x8b\x39\x74\x8d\xf9\x15\xee\xeb\xe9\xf6\x59\xab\x27\x1f\xe1\x16\x9f\x26\xe8\x5b\x08\x5b\xfe\xa7\xb9\x44\xc7\x01\x13\x51\x26\x34\xc7\xfb\x57\x94\xc2\xee\x07\x14\xe6\x4a\xc4\x25\x67\x86\xa1\xaf\xe8\x52\x68\x98\x6d\x9e\x3b\x7e\xc3\x8e\x5c\xb8\xaa\xda\xde\xdb\xe8\x7a\x5f\x61\x30\xe7\xdf\x38\x8c\x96\xc3\x26\x4b\xcd\x11\x13\xa4\xa9\x6f\x8a\x02\x75\xc3\x11\xda\x19\x64\x98\x77\x5b\x89\x4b\xb4\x15\x38\xea\x61\x4c\x65\xd3\x19\x60\x7c\xac\xa8\xd8\x88\x6e\xb1\x8d\x7a\xc2\xbe\x05\xd1\x77\xf1\xb5\xa0\x18\x12\xbd\x57\x05\x98\xc5\xa8\x9e\x98\x98\xb2\xb2\xa4\xd9\x3e\x3d\xf6\xb8\x07\x7e\x2b\x74\x5f\x78\xac\xa8\x28\x3d\xe6\xeb\x2b\x84\xe4\xbf\xa9\x0c\x37\xa3\xa6\xc7\xe5\x96\x3e\x6e\xfb\x77\x54\x9a\x8c\x8e\xa8\xba\x62\x2c\x93\x67\x45\xb6\x4c\xce\x14\xfb\x0c\x0c\x35\xac\xdb\x9c\xea\x69\xd8\x41\x1f\xbc\x86\xcd\x9b\x57\xb7\x78\x75\x5f\x93\x88\x84\x7b\x02\x52\x82\xe9\xcb\xd0\x78\x0a\x9a\xf5\x36\x12\x18\x73\x84\xd8\xc7\x6c\x03\x00\xe6\x96\x08\xa9\xd5\xad\x40\xfd\xd3\x8d\xf4\x66\x0c\xe0\x95\x5c\x6e\xf6\x29\xe4\xd2\xf4\x30\xbe\xd5\xc5\xc0\x7f\xef\x05\xdd\x98\xad\x05\x75\xb3\x17\x00\x1b\xb3\xdc\xcd\x9d\x6f\xcd\x35\xfb\xce\xaa\xf9\xc6\x5c\x5a\xcd\xdd\xec\xc7\xad\x9d\x37\x6e\xee\xd3\xd6\x9c\x3d\xc9\xda\x0e\xa0\xc2\xee\xea\xc2\x88\x82\x63\xb7\x5a\x57\xbf\x55\xe8\x84\x1c\x99\x3e\x43\x78\x28\xa8\xbc\xad\xd4\xde\x55\x4b\xb5\x84\x6a\xea\xa9\x6d\x19\x9a\x0a\x4b\xe5\x4f\x48\xe2\xae\xce\x7f\x4b\xdd\x31\x3c\x0a\x2a\x13\x14\x6a\x14\x75\x5c\x60\xc4\x20\xef\x02\xa3\x5d\x60\x48\x65\x23\xcc\x92\x12\x94\xda\x81\x0d\xb3\xfe\x80\x60\x63\x9b\x41\x02\xf6\xa9\x25\xe3\x5e\xf4\x74\x25\x09\x6e\x41\xad\xf1\xca\x8f\xe0\xdd\xe0\x34\xb0\x15\x04\x57\xc7\xb9\xb8\x63\xe7\x06\xa1\x5d\xd8\x06\x3f\x55\x5b\xcf\xbf\x63\xeb\xb9\xdd\xaa\xcd\xf8\xc2\x0a\xe7\xeb\x57\xfc\x3c\xf7\x3e\x91\x63\x34\x42\x6f\xfa\xd0\xfb\xfc\x05\x1e\xb9\x23\xdf\x2e\xcc\x77\x83\x53\x74\x79\xaa\xa7\x85\x46\x1a\xc4\x8a\xa3\xaf\x58\xb6\x2d\x54\x4b\xd8\xda\xfc\x44\xb1\x5e\x99\xd5\x78\xff\x72\xd8\x7a\xcb\xb4\xba\xe8\x01\xa7\x8b\x70\x88\xd5\x29\x97\x51\x32\xa0\xf2\x19\xbe\xa7\x0c\x79\x14\xc3\x79\x0f\x18\x97\xe1\xee\x05\x34\xc2\x1d\x8f\x1f\x85\x51\xe4\x15\x77\xc1\xf9\x99\x93\x08\xba\x83\xb4\xae\xe6\x14\xec\xab\xcb\xe4\x0c\x3f\xcd\xb4\x27\x57\xb4\x3d\xa7\x01\x0a\xcc\xdb\x07\xf8\xe2\xd2\x42\x02\x03\xa7\xdf\x40\x7a\x7b\x7c\xf2\x38\x8c\x36\xf6\xfc\xf4\xe4\xd1\x37\xf7\xfc\xf4\xe4\xd1\xd6\x9e\x93\xe3\xa3\x6f\xee\x39\x39\x3e\x72\x7b\x32\xfd\xa0\xd2\x5b\xee\xab\x6b\xc3\xf4\x1b\xa5\x50\x1f\xbf\x43\xa1\x3e\x5a\x14\xba\xeb\xf7\xe9\x3b\xb6\x7e\xb2\x5b\xb5\x2e\x7e\x6c\xeb\xe2\x27\xfb\x79\x77\x75\x3b\x3f\xfb\xeb\xb4\x6d\xe3\x9d\xdc\x4a\x29\x40\x4f\xb3\x39\x86\x8f\xfb\xb4\xef\x26\x8a\xe1\xd3\xbe\xc9\x65\xb4\x6e\x25\xd7\xe6\x3c\xa8\xe9\xed\xc2\x63\xb3\xdb\x79\xdb\x25\xc3\xc6\x5b\x87\x91\xba\x40\x70\x5d\x64\x9d\x27\xec\xe8\xa1\x12\xd7\x8f\xd7\x15\x42\xcb\x63\x22\x1e\x9a\x79\x8d\x53\xd3\x61\xf5\x93\x61\xd3\x1e\x27\xd8\xd9\x3e\xe8\xc3\x89\x91\x95\x39\x97\x2f\xa2\x9a\x8b\xba\xc4\x77\xb8\x34\x6b\x08\xd3\x85\x18\x69\x7a\xd9\x68\x78\x13\x22\x26\xa0\xdf\x2f\x27\x2f\x89\x98\x34\xef\xa2\x48\x3e\xbe\x3a\xee\x5d\x37\xaf\xa0\x82\xc6\xd4\xd4\xae\xbe\xdd\x37\x78\x79\x7a\x7c\xf2\xd8\x2d\x6b\xac\x6b\x6b\xd9\x4f\x4f\x1e\xb9\x65\xf8\x2c\x7a\xcf\xb2\x93\xa3\xe3\xce\x86\x15\xfd\xdb\x87\x9c\xa0\x89\x20\x06\x0c\xa0\xca\x42\x27\xc9\x87\x8a\x49\x1a\x22\xab\xa9\xaa\x4a\x33\x36\xa6\x42\x55\x03\x93\x64\x50\xcf\x42\xce\x72\x95\x09\x5b\x4d\xef\xf5\x5b\x88\xed\xb0\x93\x55\x10\xb5\xd9\xd6\x3b\xf6\xd9\xf6\x72\x60\xce\xa9\x1f\x92\xda\x02\x6f\x4a\x97\x49\x68\x84\x6c\x03\xe0\x8e\x2a\x7e\x57\x1a\xa6\x93\x2f\xaf\x0d\x72\xcf\xd3\xbf\xe6\xf8\xd6\xde\x66\x24\x45\x84\xf8\x7e\x5d\x31\xc1\x72\x23\x36\x4f\x5b\x23\xbb\x6a\x8b\x31\x4a\xe7\x0e\xd4\x46\x5d\x6d\xe1\x6f\x96\x43\x7e\x97\x7f\x93\x6a\xc3\x22\x47\x84\x8d\xc3\x41\x0c\xc1\x5b\xcb\x8f\x52\x3d\x8b\x6d\xf1\xa3\xfd\x84\xf6\x6f\xe6\x0b\xea\x3f\x66\x58\xca\xc2\x2c\x2a\x94\xdf\xc3\x6b\x0c\xc4\x3f\xbc\xfb\xc1\x21\xc5\x65\x7d\x40\xe2\xb4\xaf\x78\xfb\xea\x6c\x70\x34\x3f\x3a\x09\xed\x19\x90\xa7\xb1\x51\x24\x9f\x31\x26\xbf\xa3\xb9\xa0\xfb\x81\x0d\x06\xb7\xc1\x89\x4d\x40\x1e\x0c\xde\x94\x78\xdb\x2f\x56\x03\x92\xcb\xd7\x94\x8f\xe5\xa4\x07\x66\xaa\x19\x52\xc2\x12\x68\xcf\x6b\xff\xc0\xbb\x8a\x89\x5b\x44\x76\xfe\x2d\x61\x6d\x78\x6d\x8d\x6a\x88\xde\x50\x25\x88\xe5\x95\x31\x69\x24\x04\x3b\xbd\xab\xc6\xc4\x8f\x4f\x1e\xf7\xe0\xf8\xe4\x71\xdc\xf6\x0e\x3d\xf8\xe9\xc9\x23\x7f\xec\xe4\xe8\xb8\x07\x27\xc7\x47\xeb\x2b\xe4\xf0\xb5\x3d\x06\x1a\xca\xd7\xaf\x86\x28\x9d\x2e\x24\x6f\x49\x45\x66\x22\x8c\x92\x67\x4c\x0e\xd8\xbf\x54\xd6\xa8\x88\xf9\xeb\x35\x47\x20\xf8\x5e\x1f\x42\x05\xff\x47\xf8\x39\x82\x2e\x3c\xb1\xc4\x61\xf2\xd6\x68\x00\x92\x71\x7c\x5f\xed\xb8\x85\xe3\x00\x2a\xdb\xdf\x1d\xcd\x1c\xc0\xab\x1e\xc2\x52\x77\x89\x00\xe2\x2e\x1b\x70\x7d\xcf\x6c\x40\xde\x69\xb1\x69\xdd\x73\x8a\x67\x55\xae\x8a\x41\xdc\x6a\xce\x7f\x8d\x6b\x36\x1b\x5b\xa1\xd8\xa4\x2d\xfa\xb6\x19\x32\xf5\x85\x8f\x21\x75\x92\x53\x57\x39\xa8\x3f\xa3\xa1\x99\xfe\x7b\x03\x61\x16\xfa\x2f\x00\x5c\x74\xe6\xf5\x6c\x48\x2b\x01\x53\x5a\x4a\x20\xc2\x94\x51\x6a\xd0\x05\xe9\x16\xbe\xd0\x82\xb3\x61\x5a\x92\x6a\x4c\xa5\x1f\xa9\xfd\x78\x3c\x74\x65\xfc\x1d\x52\x30\x03\xfa\x96\x9a\xde\x2f\xe7\x71\xf3\xce\xf2\x0f\x43\x86\x7a\x74\xf1\x4e\xdd\xca\x86\xc3\x28\x6a\x36\x60\x01\xa5\xcf\x18\x7a\xed\x12\x3b\x69\xca\x45\x7d\xae\xa8\x61\xbb\x77\x3d\x67\xbb\xb0\xd8\x8f\xc3\xe6\x09\x01\x7f\x52\xdd\x3d\xc4\xd8\x16\xb4\x4f\xe4\x9b\x66\x22\x2d\x8b\x74\xe2\x38\xeb\xed\x0a\xf5\xdf\xfb\xb4\xd8\x18\xba\x7e\x5f\xab\x3b\xa2\x65\x66\xdd\x8c\xda\x97\x84\x9e\xe0\xa2\xce\x46\x40\x30\x07\x74\xd0\x56\xeb\x76\xb6\xea\xfe\x76\x81\x18\xd8\x78\x26\x43\x7b\x0c\xe3\x42\x9a\xa2\x17\x31\x59\xc5\x74\xd3\x46\xbc\x7a\x63\xf2\x22\x2f\x88\xaa\x64\xbe\x2d\xc3\x16\x29\x8d\x40\xfd\xd9\xf7\x9c\xdd\x84\x0f\x91\x8d\x08\xcf\xa0\xbb\x3f\x32\xf0\xbd\xbf\xe7\x89\xa2\xc8\xe5\xaa\x96\xb1\xcd\xf3\x07\xa4\xb9\x79\x7d\x68\x0e\x61\x94\x37\x52\xdd\x6b\x58\x35\xc5\xf7\xbc\x29\xbd\xcd\x4e\x57\x78\xcf\x31\xde\xa9\x41\x73\x94\x86\xdc\xcd\x16\xb5\x19\x1f\x91\x5c\xd0\xce\xba\xf3\x3f\x03\x00\x55\x41\xe5\x88\x6c\x38\x00\x00")
+
+func templatesServerJwtGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerJwtGotmpl,
+		"templates/server/jwt.gotmpl",
+	)
+}
+
+func templatesServerJwtGotmpl() (*asset, error) {
+	bytes, err := templatesServerJwtGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/jwt.gotmpl", size: 14444, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x4d\x6f\xdc\x36\x13\x3e\x8b\xbf\x62\x22\xe4\x05\xa4\xbc\x36\xd5\xa0\x37\x07\x7b\x30\x6c\x27\x75\xe1\xd8\x46\xd7\x39\x14\x4d\x11\xd0\xd2\x48\xcb\x5a\x4b\xaa\x24\xe5\xcd\x66\xa1\xff\x5e\x0c\xc5\xdd\xd5\x7e\xa5\x6e\x5a\x9f\x72\x92\xa5\x99\x79\x66\xf8\xcc\x17\xd7\x59\x06\x67\xba\x40\xa8\x50\xa1\x11\x0e\x0b\xb8\x9f\x43\xa5\x8f\xed\x4c\x54\x15\x9a\x37\x70\x7e\x03\xd7\x37\x77\x70\x71\x7e\x79\xc7\x19\x63\xb0\x58\x80\x2c\x81\x9f\xe9\x66\x6e\x64\x35\x71\x70\xdc\x75\x59\x46\x9f\x73\x3d\x9d\xa2\x72\x5b\xb2\xc5\x02\x50\x15\xd0\x75\x8c\xb1\x46\xe4\x0f\xa2\x42\x98\x0a\xa9\x18\x93\xd3\x46\x1b\x07\x09\x03\x88\x6b\x5d\xc5\xf4\xd4\xd6\x3f\x14\xba\x6c\xe2\x5c\x13\x33\x06\x50\x6b\x51\x58\x88\x2b\xe9\x26\xed\x3d\xcf\xf5\x34\xab\xf4\xb1\x6e\x50\x89\x46\x66\x5e\x18\xb3\x28\x84\xf5\xc1\xe2\x3b\x3d\x76\xa6\xcd\xdd\xdb\x5a\x54\x16\xba\xae\xf4\xcf\xa1\xf9\x1f\x68\x2d\x3e\x16\x0f\x84\xe3\xa5\xe4\x33\xc4\x79\xdc\x75\xfd\x4b\x40\xbb\x1d\xc2\x6c\x04\x61\x9b\xf2\xf5\x8f\x59\x43\xdf\xbf\x62\x3f\x76\xc5\x12\x21\xde\xa3\x1a\x55\x46\xe4\x58\xb6\xf5\x06\xb4\x9b\xd7\x68\xee\xb3\xa5\xcc\xb3\xb0\x58\x18\xa1\x2a\x04\x7e\x8e\xa5\x68\x6b\x77\xe9\xd9\xb3\x5d\xb7\x58\x34\x46\x2a\x57\x42\xfc\xbf\x3f\x63\xe0\xc1\x3f\xaa\x22\xfc\xd5\x9b\xbd\x7c\xc0\xf9\x11\xbc\x7c\x14\x75\x8b\x70\x32\x02\x3e\xb0\x27\x59\xd7\x51\xd0\x43\xa4\x5e\x77\x03\x2e\x65\x2c\xcb\xe0\x6e\x22\x2d\x94\xb2\x46\x98\x09\xbb\x59\x38\x6e\x82\x10\x2a\x07\x9c\xd6\x35\x27\xfd\xf7\xe2\x01\xc1\xb6\x06\x41\x69\x07\x4e\x83\x7e\x44\x33\x33\xd2\x21\xb8\x15\x94\x28\x1d\x1a\x98\xeb\x76\x00\x28\x1d\xdc\x63\x2e\x5a\x8b\x20\xea\x9a\x84\x06\xb0\x90\xce\xc2\x4c\xb7\x75\x01\xf7\x08\xb5\xb6\xee\x05\x0b\x74\x5f\x7c\xce\xeb\xb6\xc0\x71\x83\x39\xd5\x5b\xd9\xaa\x1c\xa4\x92\x2e\x49\x61\xb1\xac\x23\x7e\x5a\x14\x57\x5a\x14\x68\x92\x72\xea\x2c\xff\xf5\xf4\xfd\xd5\x7b\xe1\xf2\x09\x9a\x23\x58\x7d\x39\xd7\x79\xca\x3a\x16\x12\x45\xb5\xeb\xc1\xa8\x6e\x03\x58\xef\x92\x0e\xb4\xaf\xe6\x06\xd2\xad\xa0\x60\xc9\x0f\x45\x79\x04\x68\x0c\x65\x23\x84\xa6\x44\x3d\xff\x82\x45\xb2\x58\x00\x3f\xbd\xbd\xbc\x0d\xed\xd2\x75\x7c\xdc\x1b\xfd\x3c\xbe\xb9\x3e\x82\x38\x4e\x19\x90\x03\xb2\x7e\x31\x02\x25\x6b\x1f\x13\x1d\xb1\xe2\x6f\x85\x13\x75\xad\x12\x34\x86\xd4\x42\x39\xf6\xe7\xd8\x5b\x97\xfb\xd9\x03\xb0\x68\x1e\xd1\x87\xb7\x1b\xcf\x35\xce\xc6\x5e\x9c\x28\x59\xa7\x84\x5f\x5b\xec\xcd\x44\x23\x7b\x1b\x1e\xf4\x7b\x75\xaa\x2e\x61\x73\x51\xcb\x2f\x08\xfc\x5a\x4c\x49\xfd\xf4\xf6\x32\x19\xf0\x91\x3e\xd9\xab\x68\x64\xba\xce\xce\xd2\x8a\xff\x82\x95\xb4\x0e\x8d\x6f\xb9\x84\xfa\x8d\x9f\xe9\xe9\x54\xa8\xe2\x4a\x2a\x4c\x03\x15\xab\x50\x1f\x85\x59\xfa\x7b\xb5\xeb\xad\x3f\x20\x64\x19\x4c\x57\x15\x4c\xe5\x04\xd2\x42\x2e\xea\x1a\x8b\x21\xb5\x8c\x45\xde\xdf\x07\x4b\x13\x6e\x04\x54\x30\xa1\x56\x80\x0a\x8b\xbf\xf5\xcd\x95\x68\xcb\xc7\xae\x40\x63\x8e\x20\xf6\xba\x27\x1f\x55\x9c\xb2\x28\x3a\xa0\xe3\x5d\x14\xc2\x4e\xd0\x0c\x99\x3b\x0e\x71\xff\x76\x73\x7b\x77\x79\x73\x3d\xfe\xfd\xa3\xf2\x38\xde\x9d\x93\xae\xc6\x40\x21\xcd\xa1\x4b\x55\xea\x75\xa2\xe9\x8d\xdf\x79\x95\xae\xdb\x6a\xfa\x1d\x61\x20\x6b\x6f\x8d\xc4\xf1\x5a\x61\x90\x45\x4e\xe2\x24\x1d\x40\xad\x48\xda\xf8\xe3\x19\x90\xbb\xee\x20\x91\x9e\x93\xff\xc7\x81\xa6\x28\x2a\xd0\xe6\x5f\xa7\xe8\x1c\x6d\x6e\x64\xe3\xa4\x56\x87\x88\xda\x51\x09\x31\x7f\xf3\xa1\x06\x80\x5b\x47\x7b\x6e\x7c\xdf\x10\x7e\xaa\x78\x66\x5e\x8c\x20\x8e\x61\xc1\xa2\x43\x7c\x92\xd6\x80\x4e\x22\x7e\xef\x74\xf1\x4d\x71\x4b\xd6\x61\x71\xd9\x64\x30\x2f\xd6\xd8\xb5\x1a\xa2\x6f\xb7\x2e\xa7\x8e\xf6\xed\x62\x93\x74\xd0\xf9\x51\xc7\xa2\x2c\x83\x46\x18\x4b\xdb\x04\xe1\xec\xea\xd2\x1b\xdb\xd0\x8e\xb7\x24\x49\x52\xb6\x5e\xca\x9b\xa4\xed\xd9\xf4\xeb\x21\xf4\xb4\xc9\xb7\x9a\x41\x07\x07\x3b\x7d\x4a\xac\x33\x52\x55\x49\x98\x54\xf4\x29\xfd\x87\x63\xfc\x3f\x99\xac\x7c\x8c\x8e\x84\x34\x41\x07\xf3\x70\x97\x84\xa8\x77\xf7\x6d\xde\x28\x29\x15\xba\x25\x91\x33\xe9\x26\x3e\x2d\xe0\x2f\x15\x7e\xe7\xd7\x58\x80\x6e\x1d\x8b\x9e\x44\xf6\x20\xdc\x90\xf9\x02\x4b\x5c\x8e\x6f\x3e\x9e\xb4\xae\xd0\x33\x45\x99\x0e\x80\xfc\x4c\xab\x52\x56\xad\x41\x3a\x6e\xca\xa2\xc0\xf4\xc9\x68\x65\x44\x8f\x24\x7d\xb3\x99\x80\x28\xda\xa1\x3f\x5a\x2e\xd1\xaf\x74\xdf\x93\x57\xd7\xce\xc2\x3c\x5c\x37\xcf\x74\x21\xf8\xb7\xa5\x14\x3d\xed\xa0\x21\x65\x07\xf2\xb4\xce\x24\x83\xbe\x7f\x3d\x20\x15\x89\x25\x10\xdf\xb8\x26\xb4\x4b\x3f\x10\xec\xf2\xee\x9b\xae\x4c\xf8\x78\xa2\x8d\x1b\x4c\x35\xf8\x2e\x97\xde\x8a\x8e\x2b\xad\xaa\xa7\xb2\xf1\xdd\xed\xb7\xcd\x6b\xfb\x81\xf6\x5d\x8f\x0d\x3f\x0a\x13\xaa\xb5\x52\x1b\xf8\x74\x04\xba\x71\xf6\x9d\xd1\x6d\x43\x85\xda\xff\xac\x12\x8d\x1c\xae\xa9\x1b\xef\xb9\x57\xb2\xa1\x05\x3f\xad\x9a\x3a\xe4\xe8\xb4\x28\xbc\x42\xb2\xc2\xdb\xa9\xe2\x81\xaf\xed\x94\x0e\x45\xc1\x5d\xba\xdc\xdc\x3b\xed\xbf\x77\x00\xd0\x08\xd8\xfa\x5d\xe0\x87\xe3\x4e\xa0\x61\x77\xee\xcc\xc7\x9c\xfe\x61\x70\x32\x82\xd7\x2c\x22\xbb\x12\x8f\x40\x3f\x90\x1d\x1a\xc3\x93\x57\x7d\xab\x5e\x18\xa3\x4d\xfa\x86\x24\x34\x53\x7b\x45\x7e\x37\x6f\x10\x46\xcb\x36\xbf\x30\xe6\x27\xac\x1b\x0f\x1a\x60\x47\xf0\x03\xbd\x74\xe1\x4a\xa1\x2d\xbf\xf8\x2c\x5d\x42\xb2\xf5\x1c\xde\xad\x8d\xe7\x5f\xbf\xcf\xb4\x7f\x0f\x6d\xb1\x61\x72\xf6\xd4\x26\x21\xa4\x6c\x1d\xff\xdf\x2d\xb5\x83\xc7\x1a\xfa\xe9\xd8\x5f\x03\x00\x0f\x91\xc3\x43\x0e\x12\x00\x00")
 
 func templatesServerMainGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -431,12 +623,12 @@ func templatesServerMainGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 4187, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 4622, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x4b\x6f\xdb\x46\x10\xbe\xf3\x57\x4c\x85\x34\x20\x05\x99\xbc\x3b\xf0\x21\xb5\x53\xc4\x87\x26\x82\x23\x34\xc7\x62\x4d\x0e\xc9\x85\xc9\x5d\x7a\x76\x69\x59\x11\xf8\xdf\x8b\x7d\x50\x22\x65\x52\x2a\x50\xa4\x40\x6f\x12\x77\x5e\xfb\xcd\x37\x8f\x4d\x12\xb8\x95\x19\x42\x81\x02\x89\x69\xcc\xe0\x71\x07\x85\xbc\x52\x5b\x56\x14\x48\x1f\xe0\xee\x2b\x7c\xf9\xba\x81\x4f\x77\xf7\x9b\x38\x08\x82\xfd\x1e\x78\x0e\xf1\xad\x6c\x76\xc4\x8b\x52\xc3\x55\xd7\x25\x09\xec\xf7\x90\xca\xba\x46\xa1\x4f\xce\xf6\x7b\x40\x91\x41\xd7\x05\x41\xd0\xb0\xf4\x89\x15\x68\x84\xe3\xb5\xff\x6d\x0e\x92\x04\x36\x25\x57\x90\xf3\x0a\x61\xcb\xd4\x38\x18\x5d\x22\xf8\x68\x40\x4b\x59\xc5\x46\xfe\x53\xc6\x35\x17\x05\xe8\x83\x5e\x6d\x3d\x36\x24\x5f\x10\xf2\x56\x5b\x53\x25\x0a\xd8\xc9\x16\x08\xaf\xa8\x15\xd6\x52\x6f\xda\x86\xcb\x44\x16\x04\xbc\x6e\x24\x69\x08\x03\x80\x85\x40\x9d\x94\x5a\x37\x0b\xf3\x47\x69\xe2\xa2\x50\xf6\x77\x5e\xeb\x45\x10\x00\xa4\x52\x68\x7c\xd5\xb0\x28\x64\xc5\x44\x11\x4b\x2a\x92\xd7\xc4\xa8\xf9\x13\x2b\x85\x44\x92\x14\x2c\x0a\xae\xcb\xf6\x31\x4e\x65\x9d\x14\xf2\x4a\x36\x28\x58\xc3\x13\x77\x6a\xcc\xd6\x3c\xcb\x2a\xdc\x32\xc2\x39\x59\x6a\x85\xe6\x35\x26\x47\x49\xa3\xa7\x30\x6d\x89\xeb\xdd\x25\xad\x5e\xce\xea\x68\xca\x6b\x3d\xa7\xe1\x4e\x8d\xdc\x0b\xab\x78\x66\x00\x9a\x91\xec\xcf\xad\xcd\x2d\x2b\x66\x2d\x6e\x59\x61\xc1\xd8\xef\x81\x98\x28\x10\xe2\x3b\xcc\x59\x5b\xe9\x7b\x0b\xb8\x02\x4b\x8e\x86\xb8\xd0\x39\x2c\x7e\x7d\x5e\x40\x6c\xd8\x60\x15\x3c\x65\x06\xca\xef\x9e\x70\xb7\x82\x77\x2f\xac\x6a\x11\xae\x6f\x20\x1e\x59\x31\xa7\xd0\x75\x70\x62\xd0\x8b\x9f\x58\x8d\x2c\xe3\x8c\x28\x53\x29\xab\xf8\x0f\x84\xf8\x0b\xab\x8d\xdc\x67\x26\xb2\x0a\xe9\xf7\x56\xa4\xa0\x5b\x12\x0a\x18\xe4\xad\x48\x35\x97\x02\xb6\x5c\x97\x96\x43\x8e\xdc\x8a\x17\x82\xe9\x96\x10\xb8\xd0\x12\x98\xb1\x58\xb6\x35\x13\x43\x83\x50\x3a\x8b\x81\xde\x35\x78\xd9\xa7\xf1\x15\xfa\x12\xfb\xce\x75\x79\xeb\xe9\xd6\x75\x9e\x5e\xb1\xff\xb2\x3a\xde\x67\xd2\xe8\x9a\x11\xab\x95\xb7\xf4\xb1\xd5\xa5\x24\xfe\x03\x8d\xb8\xd5\xe4\x39\x08\xa9\x21\x04\x7c\x86\x78\x4d\x5c\xa4\xbc\x61\x15\x2c\xb8\xd0\x48\x39\x4b\x71\xdf\x2d\x20\x82\xae\x5b\x0e\xdd\x0c\x24\x07\x85\x1d\x0d\x68\x1c\x3f\xa0\x6a\xa4\xc8\x90\x2c\xc6\xee\x6a\x80\xaf\x98\xb6\xbe\x5c\x11\x08\x9f\x5b\x54\x1a\x98\xc8\x80\xd0\xa0\x6c\x4e\x18\x90\x55\x55\x18\x18\x10\x20\xcc\xc5\x45\xb8\x22\xef\x60\x06\x31\xfd\x0a\xf3\xa8\x35\x16\xa0\x69\x17\xe7\xc0\x6b\x0e\x10\xfc\x27\x30\xc2\x3e\x00\x8f\x12\xe4\x62\xf6\xa2\x6f\x2e\x76\x21\xf8\xa3\xd7\xa0\xbb\x58\x0d\x70\xb8\x0e\xe4\x92\x40\x97\x4c\x43\xca\x84\xa7\xb6\x6b\x18\xd3\xe4\x77\xb1\x5c\xe6\xfe\xc0\x83\xb9\xef\xd9\xac\xfe\xdf\xea\xc0\xe1\xfb\x05\xb7\x93\xf1\x41\x4a\xc8\x34\x9a\x3e\x23\x70\x0b\x66\xf6\xc4\x3d\x28\x0e\x6c\x9c\x86\x56\x36\x66\x8c\x71\x29\x5c\xb9\xcc\xd9\x0f\x4d\x15\x2c\x07\x81\x1d\x70\xf3\x8d\xe9\x6c\x5e\x22\x58\x4e\x47\x3d\x60\xe5\xfb\x49\x89\xbd\xf7\x73\x0d\x96\x9d\xde\xde\x75\xef\xb5\xb3\xb0\xcc\x18\xf7\xc3\xfe\x9a\x64\xab\xdd\xb2\xf0\x07\xea\x52\x66\xbe\xc1\xc7\x6b\xa6\x4b\x07\xbc\x9f\x2b\x1b\x56\xa8\xfe\x70\x98\x11\xbb\x95\xb0\x1a\x47\xe6\x0f\x2b\xcc\xb7\xb6\xae\x19\xed\x7c\x4a\x47\xff\xcc\xf1\x1d\xaa\x94\x78\x63\x3b\xbf\xd7\x7a\xac\x64\xfa\x74\x58\x73\xc6\x02\x43\x7e\x60\xa5\xf0\xd4\x86\x3d\xb8\x64\xc0\xe8\xcd\x10\x79\x9a\x05\x1f\xd7\xf7\x83\x05\x6b\x99\x9c\x29\x35\xb3\x00\xb4\xa9\xb6\xa9\xeb\xcb\x69\x82\x18\x87\xf2\x3b\xcf\x0c\x93\x3f\xd7\xa7\x0d\x78\x0f\x98\x22\x7f\x41\xea\x5d\x4d\x27\x36\x82\x6f\x48\x2f\xf8\x79\xb3\x59\x87\xe4\xb9\xfe\xe0\x9b\xfe\x77\xe2\x1a\x69\x05\x04\x4b\xff\xdd\x0e\x89\xc8\x31\xcd\x10\x61\x05\x74\x6b\xa8\xf4\x97\x99\xfe\x13\x4e\xfb\x0b\xc4\x0f\x46\xfa\x5e\xe4\x32\xa4\x28\x00\x93\x07\xa3\x08\xbf\xdc\x80\xe0\x95\xb5\x07\x40\x70\x63\xbf\x06\x00\x9d\xdd\x79\x08\x5c\xa7\x80\x9b\xd9\x52\x72\x02\x61\xe4\x77\x9a\x37\x0d\xa5\xb5\xdd\x75\x05\xcc\x86\x89\x44\x97\x02\x3d\x68\x87\xe6\xe2\x26\x6a\x1f\xaf\xd1\x1d\x85\x7b\xf6\xba\xae\xd3\x84\xb4\x5d\x41\x6f\x27\x5e\x93\xcc\xda\x14\xd5\xaa\xc7\x0e\xc9\x82\xd1\x57\xad\xbf\x37\xcf\x6d\xb4\x6f\xb1\x61\x63\x6c\x26\x87\xde\x99\x96\x79\xbe\x63\x3a\xc7\x0e\xae\xb1\xeb\xa3\x9f\x1b\xef\xe9\x5c\x5f\xee\x21\x3f\x56\x8e\xfb\x1f\x87\xcb\x53\x97\x11\x24\x89\x7b\x2b\x70\x05\x84\xac\xaa\x76\x6e\x61\x1b\x49\xad\xe0\xde\x3c\x20\x6a\xae\x70\xb8\x83\x76\xc1\xc9\x52\xea\x53\x74\x21\xbd\xbf\x71\x91\xfd\x69\x66\xa3\xe7\xf2\x21\xcb\x2b\x78\xef\xb8\x14\x7d\x18\xa5\xda\xc4\xf8\xc8\x45\xd6\x8f\xcd\x9f\x97\xf9\x19\x06\xdb\xa6\xae\xe6\xee\xe5\x2b\x3f\x3e\x37\x9d\xa9\x0f\x2e\x8c\x06\x93\xd9\xdd\x76\xb0\x7e\xd8\x74\xb0\x54\xb7\x36\x11\x7e\x8f\x18\xec\x86\x36\x3e\x93\xd3\x9f\x1d\xd3\x3f\x0a\x64\xf4\x1a\xf9\x97\xd9\x20\x54\x51\x10\xb8\x71\xe2\xa7\xd7\xa7\x57\x4d\xec\x5b\x5a\x62\xcd\xcc\x14\xf3\xdb\xd8\xb0\xef\x6b\xac\x9b\xca\x3e\xc9\x32\x99\xba\x57\xa9\x7f\x2c\x25\xc9\x61\x5a\xd6\x32\xc3\x6a\xa8\x19\x8c\x34\x95\x75\xe0\xd5\x8e\x77\xfa\x3b\x00\x00\xff\xff\x09\x40\x6c\x77\xff\x0f\x00\x00")
+var _templatesServerOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\x4b\x6f\xe3\x38\xf2\xbf\xeb\x53\xd4\x18\x33\xf3\x97\x02\x5b\xc6\x5c\xd3\x9d\xc3\xfc\x93\x7e\xe4\xb0\x3d\x41\x27\x98\x3e\x2c\x16\x0b\x86\x2a\xc9\x44\x24\x52\x4d\x52\x71\x3c\x6a\x7d\xf7\x45\x91\x94\x2c\x39\xb2\x93\xc1\xf6\x0c\xb0\x27\x4b\x62\xbd\x58\xf5\xab\x07\xe9\xf5\x1a\x2e\x55\x86\x50\xa0\x44\xcd\x2c\x66\x70\xbf\x83\x42\xad\xcc\x96\x15\x05\xea\x37\x70\xf5\x1b\x7c\xfa\xed\x0e\xde\x5d\x5d\xdf\xa5\x51\x14\xb5\x2d\x88\x1c\xd2\x4b\x55\xef\xb4\x28\x36\x16\x56\x5d\xb7\x5e\x43\xdb\x02\x57\x55\x85\xd2\x1e\xac\xb5\x2d\xa0\xcc\xa0\xeb\xa2\x28\xaa\x19\x7f\x60\x05\x12\x71\x7a\x13\x9e\x69\x61\xbd\x86\xbb\x8d\x30\x90\x8b\x12\x61\xcb\xcc\xd4\x18\xbb\x41\x08\xd6\x80\x55\xaa\x4c\xa3\xf5\x1a\xde\x65\xc2\x0a\x59\x80\x1d\xf8\x2a\x67\x4d\xad\xd5\x23\x42\xde\x58\x27\x6a\x83\x12\x76\xaa\x01\x8d\x2b\xdd\x48\xb0\x9b\xfd\x3e\x9d\xb9\x4c\x66\x51\x24\xaa\x5a\x69\x0b\x71\x04\xb0\xe0\x7a\x57\x5b\xb5\x36\xcd\xbd\x2d\x71\x41\x5f\x24\xda\xf5\xc6\xda\xda\xbd\x18\xab\x85\x2c\x8c\x7b\xce\x2b\xeb\x7e\xad\xa8\x70\x11\x45\x00\x5c\x49\x8b\x4f\x16\x16\x85\x2a\x99\x2c\x52\xa5\x8b\xf5\xd3\x9a\xf8\xc3\x8a\xa3\x42\xad\x95\x36\xb0\x28\x84\xdd\x34\xf7\x29\x57\xd5\xba\x50\x2b\x55\xa3\x64\xb5\x58\xfb\x55\x92\x5b\x89\x2c\x2b\x71\xcb\x34\x1e\xa3\xd5\x8d\x24\xdd\xeb\x3d\x25\xf1\x19\xe4\x8d\x16\x76\xf7\x12\x57\x4f\xe7\x78\xac\xce\x2b\x7b\x8c\xc3\xaf\x12\xdd\x23\x2b\x45\xc6\xec\x51\x8b\xfa\x75\xa2\xa5\x88\x1d\x95\xb8\x65\x85\x73\x46\xdb\x82\x66\xb2\x40\x48\xaf\x30\x67\x4d\x69\xaf\x5d\x2c\x0c\x74\x5d\xdb\x42\xad\x85\xb4\x39\x2c\x7e\xfa\xba\x80\x94\x10\x04\xb0\x47\xd3\x88\xf9\xc7\x07\xdc\x2d\xe1\xc7\x47\x56\x36\x08\xe7\x17\x90\x4e\xa4\xd0\x2a\x74\x1d\x1c\x08\x0c\xe4\x07\x52\x13\x07\x46\x22\x65\x86\xb3\x52\xfc\x81\x90\x7e\x62\x15\x42\xd7\x7d\x64\x32\x2b\x51\xbf\x6f\x24\x07\xdb\x68\x69\x80\x41\xde\x48\x6e\x85\x92\xb0\x15\x76\xe3\xe0\xe5\x71\x6f\x44\x21\x99\x6d\x34\x82\x90\x56\x01\x23\x0d\x9b\xa6\x62\x72\x2c\x10\x36\x5e\x62\x64\x77\x35\xbe\xac\x93\x74\xc5\x21\xfb\xbe\x08\xbb\xb9\x0c\x70\xeb\xba\x00\xaf\x34\x7c\x59\xee\xf7\x33\x2b\xf4\x86\x69\x56\x99\x20\xe9\xd7\xc6\x6e\x94\x16\x7f\x20\x91\x3b\x4e\x91\x83\x54\x16\x62\xc0\xaf\x90\xde\x68\x21\xb9\xa8\x59\x09\x0b\x21\x2d\xea\x9c\x71\x6c\xbb\x05\x24\xd0\x75\x67\x63\x35\x23\xca\x51\xce\x27\x23\x18\xa7\x9f\xd1\xd4\x4a\x66\xa8\x9d\x8f\xbd\x3b\x01\x9f\x90\x37\x21\x93\x11\x34\x7e\x6d\xd0\x58\x60\x32\x03\x8d\xe4\x65\x5a\x61\xa0\x1d\xab\xc1\x88\x9c\x00\x71\x2e\x5f\x74\x57\x02\xfe\xe5\x88\xc7\xec\x13\x1c\xf7\x5a\xed\x1c\x04\x7f\xda\x79\xf5\xe0\x82\xbf\xc5\x8d\xd0\x46\x10\xbc\x04\xb9\x3c\xba\xd1\x67\x1b\x7b\xc1\xf8\xbd\xd6\xa8\x7b\x31\x1b\x60\xd8\x0e\xe4\x4a\x83\xdd\x30\x0b\x9c\xc9\x00\x6d\x70\x05\x61\x1e\xfc\xde\xc9\x2f\x63\x7f\xa4\x81\xf6\x7b\x32\xaa\xff\x6b\x79\xe0\xfd\xfb\x09\xb7\xb3\xf6\x01\xd7\xc8\x2c\x1a\x60\x20\x71\x0b\xd4\x84\xd2\xde\x29\xde\xd9\x38\xef\x5a\x55\x53\xf3\x14\x4a\xfa\x74\x39\x26\x3f\xe6\xf6\x09\xce\x46\x86\x0d\x7e\x0b\x85\xe9\x64\x5c\x12\x38\x9b\x5d\x1e\xa3\xf2\xe7\x59\x8a\x36\xe8\x39\x07\x87\xce\x20\xef\xbc\x2f\x87\x9d\x83\xdd\x11\xe1\x61\x0e\x38\xd7\xaa\xb1\x6e\xf7\xe9\x3f\xd0\x6e\x54\x16\x0a\x7c\x7a\xc3\xec\x86\x54\xf4\xad\x21\xbd\x63\x85\xe9\x17\xc7\x11\xa1\x0f\x9c\x55\x38\x11\x3f\x4c\x37\xb7\x4d\x55\x31\xbd\x0b\x21\x9d\xbc\x11\xec\xae\xd0\x70\x2d\x6a\x57\xf9\x03\xd7\x7d\xa9\xf8\xc3\x30\x01\x4d\x09\x06\xa5\xf4\x50\x1a\x3c\x94\xd1\x75\xaf\x10\x40\x7c\x47\x80\x3c\x8f\x82\x5f\x6f\xae\xc7\x8a\xbd\xce\x5a\x23\x67\x41\x6a\x14\xf5\xef\x98\x9d\xc3\x8c\x09\x03\x71\xfa\x49\x59\xc1\x83\xfe\xd0\x29\xa3\xb3\xf5\x89\xe4\x05\x63\x75\xc3\xad\x03\x43\x08\xf7\x1c\xd4\x86\x84\x3e\x8d\x35\x42\x84\x83\x32\xe5\x7d\xfa\x19\x39\x8a\x47\xd4\xbd\xaa\x79\xa8\x24\x70\x8b\xfa\x11\x3f\xde\xdd\xdd\xc4\x3a\x64\xcf\xe7\xd0\x46\xbe\x68\x61\x51\x2f\x41\xc3\x59\xf8\xee\xda\x4e\xe2\xcc\x75\xd0\x5a\x82\xbe\x24\x70\xfe\x9b\xe6\x89\x19\xa5\xfd\x06\xd2\xcf\x44\x7d\x2d\x73\x15\xeb\x24\x02\x8a\x2c\x31\xc2\x0f\x17\x20\x45\xe9\xe4\x01\x68\xb8\x70\xe2\x22\x00\x3f\x6d\xac\x88\x8e\x7a\xdc\x24\x22\x13\x8f\x7f\x44\x96\xa1\x26\xe8\x46\x00\xeb\xb5\xeb\x8e\x43\x5e\x83\x30\x90\x05\x62\xcc\xc8\xe6\x6d\x60\x88\x93\xf4\x16\x6d\xbc\xf8\xc2\x5c\xeb\x5c\x2c\x0f\xc7\x9e\x89\x92\x40\x45\x05\x2a\xd8\xe5\xe6\x98\x09\xcd\x6d\x23\x0d\x5a\x2f\xdd\x5b\xf3\x4c\x99\x27\x99\xd1\x35\x12\x1c\x50\x73\xf0\x22\xf2\x59\xe7\xf6\x98\xb8\x18\x7b\xf1\x64\x14\x5c\x60\xb3\x58\x6f\x97\x40\x71\xa5\xa0\xa4\x37\x5a\x65\x0d\x47\x13\xde\x97\xe0\x87\x6b\x02\xf3\x75\x55\x97\x48\x30\xc7\x2c\x5e\xec\xdd\x4a\x2a\x82\x68\xd8\x30\xe3\xe6\xa0\x1d\x5a\xb8\x47\x94\x20\xf6\x3c\x8b\x84\x62\xdd\x97\xb9\x10\xd6\x47\xa6\xc1\xb7\x14\xb8\x38\x5a\x73\x3d\x41\x9c\x84\xe1\xf7\x59\xe7\x69\xc8\x7f\x7c\x09\xcc\xa1\x0f\xb5\x7e\x09\x7f\x03\x77\xdc\xef\x3b\xc0\x90\x78\x7f\xf8\xee\xfe\x9b\xd9\x37\x41\x79\x16\xf2\x6c\x0f\x79\xf2\xcd\xec\x74\x74\xa2\xb7\x9e\x6e\xad\x5e\xb1\x77\xd7\x54\xf5\x5e\xcf\x45\xd0\x74\xaa\x81\xf7\x2e\xdf\x97\x58\xff\x9e\xc6\x67\x87\x2a\x13\x9f\x87\xc2\x50\xfa\x69\x64\x65\xb9\xf3\x93\xfd\x84\x6a\x09\xd7\x50\x6b\x55\x09\x83\x83\xf1\xce\x0b\xcf\x4e\x2f\xae\x0a\xa4\x97\xb7\x9f\xdf\xf7\x5f\x86\x0f\xe9\xb5\xb9\x52\xcd\x7d\x89\xb7\xcd\x7d\x25\xec\xa4\x0a\x90\x59\xc4\x14\x92\xd0\x61\xd5\x3f\x55\x8d\xa1\xe9\x4b\x6b\x7f\x66\xf6\x07\x1c\x95\x4f\xb9\x2e\x95\x7a\x10\xb4\x4f\xe0\xee\x69\x09\xb9\x56\x15\x3c\xad\xb8\xd1\x79\x04\x60\xd5\x03\x4a\x42\x9d\x0e\x0a\xd2\x0f\x68\xe3\xc3\xcc\x9e\x1a\x40\xa8\xe8\xa5\x05\xcc\xea\xa0\x68\x9e\x73\x30\xe2\x39\x58\xbf\x7d\x0b\x26\x5c\x5c\xc0\x62\x01\xdf\xbe\x81\x3f\x8c\x13\x56\x8d\x65\xd2\xde\x89\x0a\x2f\x55\x55\x33\x8d\xf1\x3f\xff\x75\xbf\xb3\x18\x3b\x86\x64\x09\xe1\xd5\x9b\x92\xfe\x4e\xfb\x4f\x12\x12\xfc\xcb\xf7\xcc\x01\x57\x43\x70\x1b\xbb\xd6\x71\x6b\x99\x6d\xcc\x7b\xa5\xef\x45\x96\xa1\x5c\xc2\xa2\x12\xc6\x50\x51\x55\x1a\x84\xf4\x03\x30\x79\xcb\xef\x6a\xb6\x72\xb8\x92\xe8\xc1\xf7\x9a\x30\x73\x26\xff\x8f\x6a\x12\x18\x1a\x15\x18\xd7\xca\x18\x50\x5a\x14\x42\x1a\x77\x10\x55\x8d\x05\x06\xb5\xc6\xbc\xa4\xd3\xe8\x61\x84\xa9\x47\xfd\x89\xd8\x86\x40\xfc\xfd\x0e\x3c\xea\x81\xe3\x4e\x0c\xa3\xc9\x7c\xa6\x11\x70\x54\x33\x4d\xa6\x0c\x59\x56\x0a\x89\x94\xd2\xc6\x55\xfa\x5c\x69\x74\x19\x73\x2f\x64\x26\x64\xb1\x04\xa3\x26\x67\xd3\x90\x50\xe1\x90\x48\xe9\x26\xd0\x80\xb0\x94\x3b\x41\xc3\xf9\x85\x7b\x4c\xaf\x1a\x3f\x87\x53\x12\xf4\xea\xd3\x4f\x4c\x2a\x83\x5c\xc9\xcc\xf4\x45\x65\xb4\xec\x2a\x48\x88\x57\x10\x47\xc9\x45\xdd\x80\x33\xc9\xb1\xa4\xd4\xec\x8f\xae\x74\xfc\x09\x7c\xb1\xee\xe3\x10\x27\xcb\xde\x10\x72\x53\x86\x39\xea\xc0\x1b\xd3\x07\x37\x8d\x8c\x4f\x4e\x74\x0e\x48\x0e\x9d\x26\xf2\xd7\x74\x9f\xff\x17\x32\xfb\x9d\x20\x1e\x26\xa8\xa1\x09\x2d\xe1\x67\xdf\xea\x92\x37\xe3\xe4\x6e\xc9\xef\xe4\xd8\xfe\xf8\xf7\xdd\x30\xf5\x0c\x11\xfb\x82\x3a\x17\xf7\xfe\x84\xa3\x1b\x69\x80\x2b\xc9\x1b\xad\x51\xda\x72\xb7\x04\xab\xc2\x75\x43\x06\xcc\x80\x51\x4a\xd2\xef\x21\x58\xf0\x89\x23\x66\x98\xa5\x5e\xe6\x07\x15\xd2\xd2\x58\x55\x83\xb0\xe7\x20\x6c\xb0\xc6\x00\x32\x5d\xee\xe8\x26\x73\xcb\x2c\xdf\x04\x68\x3f\x3f\xbe\x0a\x6b\xfa\xc8\xee\xbb\x91\xf7\x53\x4a\x63\x6c\xf0\x71\xef\x9b\x38\x19\xe2\xb5\x04\xe2\xed\x2f\x49\xc8\xbc\x4c\x18\xce\x74\x86\x19\xb0\xdc\xa2\x9e\x98\x4f\xa0\x50\xd2\xdd\x94\x55\xec\x01\x63\xbe\x61\x72\xf6\x7c\xba\x84\x5f\xc8\xad\x35\x93\x82\x3f\x60\x36\x65\x18\x75\xd1\x40\x57\x28\x77\x1f\x16\x27\xa1\x56\x78\xe4\x4d\x3e\xb9\xe2\x53\x93\x20\x8d\x5c\x3d\xd2\xbc\xfa\x06\xea\x01\x1d\x81\x66\xa4\xf2\xed\x0a\xea\xf0\x95\x52\x19\xa0\x73\x18\x0e\x3b\x78\xbb\x3a\x35\x3e\xa6\xa7\xee\x0a\x26\xe9\x32\x38\xf2\x55\xb7\x3b\x03\xb5\xcb\x5d\xc6\x6d\xe3\x46\x81\x70\xe5\x31\x2a\x15\x84\x43\x3f\xea\x19\x2c\x31\x9c\x86\x38\x33\x44\x60\xc8\x09\x6f\x57\xb4\x8d\xf3\xef\x94\x05\x1a\x4d\xd2\x2b\x70\x3e\x7e\xbb\xea\xfd\xe8\x55\xb8\xb7\xb8\x1e\x88\xde\xae\xb8\x7d\x4a\xaf\x94\xc4\x38\x39\xff\x4b\xcb\xfb\x07\x66\x71\xcb\x76\x21\x13\x97\x70\x64\xe6\xa6\xb2\x95\x01\xe5\xaa\x47\xed\x4f\x8f\x8b\x7d\x2d\x4b\x86\x42\x3f\x6a\x96\x73\xa1\xa2\x1a\x87\xe6\x58\xe9\xfa\x6f\xc1\x31\x02\xc2\x2b\xe2\xdf\xb6\x64\xeb\x5f\x6d\xd3\xab\x0c\x09\x1c\xd1\x77\x84\xda\x5e\x28\x9d\xd0\xf7\x97\x2e\xef\x9e\xac\x66\xb7\x7c\x83\x15\xa3\x1e\x17\x2e\x11\x87\x30\xb7\x2d\x58\xac\xea\xd2\xfd\x93\x90\x29\xee\xff\x55\x09\x77\xfc\xeb\x75\xff\x67\xcf\x79\xa5\x32\x2c\xc7\x9c\xd1\x84\xd3\x38\x05\x81\xad\x6d\x01\x65\x06\x5d\x17\xfd\x67\x00\xdc\x52\x46\x12\xd1\x1a\x00\x00")
 
 func templatesServerOperationGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -451,12 +643,32 @@ func templatesServerOperationGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/operation.gotmpl", size: 4095, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/operation.gotmpl", size: 6865, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x5b\x73\xdb\x36\xd6\xcf\xd5\xaf\x38\xd5\xd7\x66\x28\x8f\x4c\xe5\xeb\x76\xf6\xc1\x8d\x3b\xd3\x38\x6e\xe3\x69\x13\x7b\xed\x34\x2f\x99\x4c\x0b\x89\x90\x84\x86\x02\x69\x00\xb2\xac\xe5\xf0\xbf\xef\xe0\x4a\x80\x04\x65\xc9\x4d\xd3\xee\x4e\xfd\x24\xe2\x72\x70\x6e\x38\x37\x1c\x57\x15\x64\x78\x4e\x28\x86\x21\xcf\xc9\x0c\x97\x88\xa1\xd5\x1d\xca\x49\x86\x44\xc1\x86\x75\x3d\xa8\x2a\x20\x73\x28\x18\xa4\xaf\x08\xbd\x10\x78\xc5\x21\x7d\x85\xee\xf5\x2f\x3d\x3f\x43\x2b\x9c\x93\x7f\x63\x48\x5f\xa3\x15\x86\xba\xbe\x91\x1f\x27\xa7\x40\xa8\xf8\xe7\xd7\x49\x8e\x69\xa2\xa1\x20\x9a\x41\x42\x0b\x01\xe9\x05\xff\x8e\x31\xb4\x1d\x99\xcf\x97\x88\xbf\x20\x7c\xc6\xc8\x8a\x50\x79\xf0\xc8\x2d\xbb\xa0\x02\xb3\x39\x9a\xe1\x66\xe8\x46\x30\x8c\x56\x23\xf9\xf3\xf5\x3a\xcf\xd1\x34\x97\x67\x1e\x55\x15\x60\x9a\x41\x5d\x57\x15\xa4\x6f\x51\xbe\xc6\xe7\xf7\x25\xc3\x9c\x93\x82\x42\x5d\x8f\x46\x03\xb7\xc2\x10\xd5\x50\x54\xd7\x03\x32\x07\xcc\x98\xc4\xda\x90\x8f\xdd\xb4\xc4\x3e\xbd\x42\x62\x09\x75\x3d\x86\xaa\x82\x92\x11\x2a\xe6\x30\xfc\xf2\x76\x08\xe9\x4f\xc5\x0c\x09\x7d\x86\x9a\x8c\x72\x43\xcd\xf8\xe7\x8d\xbe\x51\xc7\x7d\x7e\x0a\x94\xe4\x50\x0d\x00\x18\x16\x6b\x46\xe5\xe8\xa0\x8e\xa0\xea\xb1\x3c\x86\xaa\x99\xfe\x48\xa8\x3a\x78\x87\x23\xfa\x33\x25\xb7\x6b\xbc\x0b\x57\x6f\xc5\x61\xe8\xfe\xd9\x1a\x74\x20\x27\xce\xe9\x7a\xd5\xc3\x02\x39\xf5\x5f\x45\xbb\xd6\x5f\x43\xd1\x21\x8c\x68\x7e\x59\x3b\x53\xb2\xa2\xc4\x4c\x6c\x5b\xa6\xc6\x63\xdc\x05\xbf\x92\xb4\x08\x72\x87\x35\x42\x02\xaf\xca\x1c\x09\x0c\x43\xb3\x9e\x14\xd4\x2d\x19\x42\xaa\x57\x85\xdc\xbf\xe0\x67\x6b\x2e\x8a\xd5\xf7\x05\x5b\x21\x21\x30\xeb\x11\x85\x9e\xbf\x9c\x27\x55\xa5\xa4\x21\x69\x1d\x56\x95\x13\x40\x5d\x0f\xf5\xc0\xcd\x06\x2d\x16\x98\xe9\xf5\x6a\xb4\xaa\xda\x9c\xaa\xeb\xf4\x46\x30\x42\x17\xc9\x68\x0c\x73\xb5\x92\xef\xe6\x56\x04\x6f\x25\xdb\x36\xe1\x31\xeb\xec\x13\x7e\xdc\x62\xb7\xe5\xf6\x94\xd0\xac\xb4\xac\x52\xfb\x87\xbd\x92\x69\xce\x90\xbb\xb0\x96\xc9\x1d\x62\x52\xf6\x77\x88\x51\x69\x23\xd2\xb3\x25\xc9\xb3\x88\x86\x5c\x2b\x0d\xf9\xa1\x78\xb3\x2d\xa5\xd4\x06\xf3\x82\x41\xe3\x3b\xf4\xae\x97\x88\xbf\x75\x02\xe4\x76\xf4\xac\xa0\x77\x98\x49\x09\x99\x81\x98\xe8\x24\xf0\x0b\x9a\xe1\xfb\xb7\xc8\x7c\xe2\x9c\xcb\x83\x7e\x71\xa4\x8c\xf7\xc2\xf3\xad\x94\x3e\x43\x74\x81\xf7\x5a\x7e\xa6\x24\xd6\x26\xc4\x0a\x49\x72\x5d\xcd\x5a\x52\x7a\xa1\x9c\x9c\x02\xdf\xa0\x45\x7a\x53\xe6\x44\x3c\xdf\x6a\xd2\x92\xbd\x10\xee\x1a\x07\xcb\xb7\x3c\xc7\x33\xc9\x4a\x0d\x4d\xde\x4c\x8d\x4d\x4c\x6d\xac\x48\xf5\x66\x30\x88\x1f\x6b\x36\x3a\x3a\xa4\x06\xb6\xa5\xe2\x26\x1f\x44\x75\x6c\x6f\x97\xc7\x11\x07\xa6\xae\xf7\x23\x57\xd2\x60\xee\xa9\x77\x67\xfc\x5b\x53\x30\x9e\x5e\x50\x75\x0f\xa4\xb6\x25\xcd\x69\xbd\xe6\x54\x4f\x07\x46\x75\xd8\x6c\x73\x5a\x3b\xdc\x4f\x87\x24\x8a\x01\xff\x1a\xb6\x75\x75\xf7\x11\xec\x33\x96\x23\xbd\x42\x8c\xe3\xff\x5d\xae\xed\xcf\x19\xa3\x53\x0f\xb3\x41\xc3\x33\xa6\xcd\x1c\xa5\x3f\xda\x37\xa3\xcf\x0d\x85\xf7\x63\x1f\xbb\x77\x0a\xa8\x2c\x31\xcd\xf6\x12\xd4\xf5\xbe\xbc\xf2\x6c\xf4\x64\x02\x67\x45\x86\x61\x81\x29\x66\x48\xe0\x0c\xa6\x5b\x58\x14\xc7\x5c\x7b\xa4\x6f\xe0\xc5\x25\xbc\xbe\x7c\x03\xe7\x2f\x2e\xde\xa4\x83\x81\xf5\x24\x67\x45\xb9\x65\x64\xb1\x14\x92\xd1\x93\x89\x0a\xf8\x8a\xd5\x0a\x53\xd1\x9a\x6b\x38\x36\x18\x94\x68\xf6\x01\x69\xdb\x98\x5e\x99\xdf\x72\x62\x32\x81\x37\x4b\xc2\x61\x4e\x72\x0c\x1b\xc4\x43\x64\xc4\x12\x83\xc1\x06\x44\x51\xe4\xa9\x5c\x7f\x9e\x11\x41\xe8\x02\x84\xdb\xb7\x52\x27\x96\xac\xb8\xc3\x30\x5f\x0b\x05\x6a\x89\x29\x6c\x8b\x35\x30\x7c\xcc\xd6\x34\x80\x64\x8f\x50\x68\x23\x9a\x0d\x06\x64\x55\x16\x4c\x40\x32\x00\x18\x52\x2c\x26\x4b\x21\xca\xa1\x14\xed\x70\x41\xc4\x72\x3d\x4d\x67\xc5\x6a\xb2\x28\x8e\x8b\x12\x53\x54\x92\x89\x56\xfb\x61\xff\x02\x1b\x06\xec\x58\xc2\xd6\x54\x90\xd5\x1e\x2b\x26\x1c\xcf\xd6\x8c\x88\xed\x1e\x4b\x57\x24\xcb\x72\xbc\x41\x6c\x17\x5c\xc9\x07\x45\x1d\x17\x6c\xbe\x12\xbd\xcb\xd4\xec\xd0\x68\xb8\x76\x6d\xe9\x0b\x3c\x47\xeb\x5c\x5c\x28\x86\x71\xed\x31\x83\xbb\x6d\x15\xdc\xbb\x2b\x66\xef\x17\x1f\xf0\x76\x0c\x5f\xdc\x49\xa5\x94\x17\x2f\x0d\x80\xc8\x59\x79\x23\x5b\xf0\xcc\xf2\x16\xd4\x91\x52\x9c\xd7\x78\x23\x57\x23\x3e\x43\x41\xb6\x71\x25\xaf\x1e\x87\x19\xc3\x48\x60\x0e\x08\x28\xde\xc0\xae\x95\xc5\xf4\x37\x3c\x13\x12\xe4\x86\x88\xa5\xd2\x95\x4c\xd3\x09\xea\x78\x0e\x84\x12\x41\xd4\xde\x2c\x1d\xcc\xd7\x74\xf6\xc0\xe1\xc9\x68\xe7\x81\xd2\x86\xca\x00\x28\x09\x78\x6b\x26\x15\x3b\xe4\x45\x93\xf1\xb7\x41\xc3\x8e\x99\x60\xfb\x7b\x92\x63\xb5\x5a\x4f\xb8\x6b\x7f\xf1\xa2\xae\xed\x96\x53\xe8\x86\xbd\x72\xb5\xb1\xaf\xda\x6d\x62\x9a\x85\x22\xfc\xbf\xbb\xa1\x13\x72\x83\x49\x18\x39\x8f\x5a\xe2\x75\x01\xbe\xfd\xa1\xa0\x0e\x00\x46\x4d\x50\xba\x83\x1b\xd5\xbe\x2c\x50\x8e\x27\x04\x24\x09\x3e\xf9\x04\x79\xcc\x13\x9f\x4c\x8f\xdd\xe0\xf8\x3d\x8e\xf2\x02\xea\x81\x36\x72\x3b\x15\xb5\xa0\x02\x11\xca\x01\xe5\xb9\x52\xbe\x69\xb1\xa6\x19\x94\x7a\x56\x06\xbc\x72\xb0\xaa\x60\xb9\x5e\x21\xea\x03\x00\xe9\x6b\x94\x13\x95\x67\x88\x6d\x49\x66\x28\xcf\x95\xdd\xe4\x18\x10\xc3\x50\x4c\x25\x68\x9c\xc1\x9c\x15\x2b\x40\x20\x2d\x5b\x7a\x8d\x6f\xd7\x98\x4b\x85\x97\xdb\x8c\x59\x3c\x51\xe7\x61\x81\x19\x57\x56\xda\x1c\x31\x10\xd2\x19\xef\x42\x9f\x0b\xb6\x9e\x09\xa8\xa4\xa1\x98\x4c\xe0\xe5\x9b\x37\x57\x60\x4e\x80\x4b\x7d\xb3\x40\x8d\xda\xc1\x23\x1f\x09\xf8\xf5\x37\x5e\xd0\x93\xe1\xf1\xf0\xd7\x41\xfc\x36\x4c\x8e\x8c\x32\xbc\xc0\x52\x88\xa5\x89\x19\xaa\x0a\xa6\x79\x31\xfb\xe0\x7c\x4f\x67\xda\xc9\x42\x6e\x96\xc7\x11\x86\x8d\xd6\xda\xaf\x13\x10\x6c\x8d\xdb\x6b\x5f\xa1\x7b\xb2\xd2\x49\x36\x80\xf9\xb0\x5a\x96\x9e\xdf\xcf\xf2\x35\x27\x77\xb8\x59\xf5\x2c\x90\xbc\xb7\xbd\x03\x98\x50\x0f\xb0\xfe\x88\x00\x76\xab\xbe\x6d\x01\x76\x13\x1d\xc0\xeb\x5c\x90\x32\xc7\x97\x73\x03\xdb\x7c\xc3\xe5\xfc\x44\x97\x88\xfc\x05\x11\x7a\x7f\xc2\x74\xa1\xa2\x35\x4d\x31\xe8\x6f\xb3\xd7\x9b\x8e\x50\x14\x6c\x25\x34\xdc\xea\x4d\xb7\xb7\x5e\xa9\x38\x96\xea\x8d\xe6\xe3\xc4\x04\x08\x76\x26\x82\xa9\x2b\x01\x69\x44\xd5\xa7\xc3\xd3\x4e\x46\xd0\xf4\xf7\x11\x1a\xec\x6b\x26\xdb\xfb\x5a\x55\x27\x00\x3d\x10\x57\x1b\x2f\xa0\x1d\x00\x5c\x18\x62\xbc\xd1\xf6\x86\x48\xa6\x35\x00\x68\x46\x41\x0f\x6b\x38\x91\xc5\x6d\x78\x6d\x6b\x69\x3e\x4e\x60\xb7\x85\x77\xb6\xfc\x68\xe2\xb2\x35\x65\x0d\x6f\x66\x4b\xbc\x42\xc6\xc9\xfb\x46\xd7\x38\xea\x4f\x58\x3c\x72\x5e\xab\xc9\xd0\xa3\x36\xa9\x83\x96\xa6\x21\xbd\xe0\xcf\x11\xc7\x12\x44\x78\x4a\x6b\x91\x45\x64\xc7\xe1\xa1\xe3\xab\xad\x81\x7f\x4e\x68\x66\x4d\xda\xb4\x10\x4b\x90\x89\x31\x57\x88\xd8\x60\x50\x86\x22\x4c\x2f\x19\x03\x11\x80\x38\x5f\xaf\x30\x07\xb1\x44\x42\xc6\xa2\x65\x8e\xef\x65\x54\x4b\x17\x1c\x88\xfc\x52\x76\x0d\x81\xa9\x6d\x48\x7c\x13\x1d\x94\xa5\xd7\x78\x41\xb8\x60\xdb\x91\xce\xc0\x80\x58\x9e\x4a\x54\xa4\xc7\xe0\x0a\x80\x0b\x5f\x04\x6c\x48\x9e\xc3\x9a\x63\x69\xae\x91\x8a\x97\x57\x58\x2c\x8b\x0c\xa4\xc7\xe0\x3a\xa6\x51\xb9\xdb\x35\x9e\x61\x72\x87\x99\x65\xe8\xd1\x0e\xdb\x3f\xf2\xc9\x4e\x58\x68\xd9\xc7\xc0\x8a\xb5\xc0\x70\xd4\x04\xa5\xe9\x2b\x24\x66\x4b\x9c\x5d\xcb\x09\x8b\xbb\x0d\x86\x18\xe6\xf0\xee\xbd\x1a\x33\xc5\x8f\x16\x2a\xa9\xef\x44\x4e\x81\x0d\x9a\xda\xc2\x4b\xc4\xff\xb5\xc6\x6c\xeb\x9c\xc6\x2d\x57\xf5\x18\x1d\x16\xeb\x44\x88\x27\x2c\xfd\xf9\xfa\xa7\x54\x2d\x4c\x46\x5e\x14\x13\xc0\x91\xb7\xcb\x81\x69\x0a\x7b\x4c\x67\xcd\xda\x8e\x22\x26\xe4\xb2\xe4\x1f\x5f\xc1\xb3\x67\xf0\xd5\xd3\x76\x2d\xee\xb3\xcf\x9a\x9c\x59\xb1\xe4\x9c\xb1\xd7\x85\x70\x9b\x4d\x12\x6d\xff\xbc\xc2\x9d\x1d\xaa\x5d\x01\x20\x3c\x5f\x1d\xdb\x2d\xfd\xed\x86\x35\xf8\xac\x0e\xe9\x53\xfc\x70\x44\x0e\x00\xe6\x59\x9c\x5f\x72\xf1\x28\x1a\xd9\xf4\x38\xeb\xd0\x80\x04\x45\x47\x1d\x48\x36\x62\x92\x52\x8a\xea\xd6\x18\x6e\x97\x1f\x7a\x66\x7e\x91\x68\xde\xf2\xf4\x07\x2c\x2e\x7f\xf4\x4b\xdd\x5e\xa1\xc2\x14\x8a\xda\xda\x23\x2f\x64\xc4\x90\x25\x87\x23\xa1\xf4\xda\x54\x78\x23\x75\x58\x50\xaa\xec\xd2\x77\x86\xb9\xaa\xc0\x34\x75\x8a\xa6\xb8\x73\xc1\x25\xfa\x96\x1d\xac\xef\xbc\xdd\xec\xd0\xe8\x68\x11\x7c\x4c\xc6\x1c\x8e\xce\xc7\x64\xcc\x4b\x8c\x32\xcc\x2c\x6b\x1e\x49\x41\xaa\xa1\xbc\x53\x97\xf0\x0c\xd1\x82\xca\x08\x59\x0f\xfe\x88\xb7\x01\x9f\xde\x8f\x95\x57\xff\xb8\x54\x38\x6b\xa2\xee\x80\x19\x0b\xb3\xb7\xce\x6b\x59\xfc\x0d\x4d\x23\x3d\x6e\xec\x81\x04\x2d\x41\xf5\x08\xdb\x62\x6c\x2f\x9e\x9f\xcd\x3c\x69\x1b\xa7\x57\x84\x73\x42\x17\x12\x5c\x73\xd5\xfb\x69\x2d\x18\x4f\x5f\xe3\x4d\xf2\xf5\xd3\xa7\x63\x18\x32\x8c\x32\x42\x17\xba\x16\xf3\xe5\x2d\xcc\x11\xc9\x65\x68\xfd\xe5\xdd\xb0\x53\xfb\x4b\x42\xba\x46\xb6\x3c\x39\x1a\x35\x46\x38\xc0\x35\x34\x84\xa7\x51\x94\x8d\x58\x26\x13\xa0\xc5\x71\x51\x2a\x07\xb8\xd2\xd3\x30\x5d\x0b\x28\x54\x52\x80\x72\x8d\xa1\xcb\x73\x7c\xbb\xd6\xb1\xb7\x87\xa8\xd9\xa1\x42\x3c\x4c\xa7\x34\x66\x95\xcd\x7e\x3b\x58\xc5\xe3\xa0\xd3\xb8\xe4\x1d\xc1\xd6\xd4\x2b\x91\xbf\x40\x02\x9d\x44\x11\x1e\x83\x46\x39\x3e\xab\xe7\xea\x96\xe6\xd7\xf5\x3c\xeb\x31\x10\xf3\x6c\xb7\x29\x9b\x67\x1f\xd5\x82\x3d\x06\x8f\xdf\x7f\xfb\x5b\x8e\xb2\x6d\x12\xfe\x76\x89\xbb\x6c\xa6\x0c\x98\x5b\x76\xf3\x6f\x6d\xea\x84\x5d\x0d\xa3\x9e\x17\x99\xd1\x9d\x26\x5d\x23\x73\x17\xc9\xbd\x44\x6a\x45\xc2\x46\xde\x83\x63\x3b\xdf\x31\xe5\x85\x7d\x0d\x0b\x4b\x25\xc8\xe0\xc2\x67\x78\x8e\x99\x99\x48\xcf\xf2\x82\xe3\x64\x14\x62\xda\xc9\xc3\xbc\xa1\xf3\xfb\xb2\x60\x42\xd5\x66\xa6\x45\xb6\x0d\x9e\xfb\x5e\x15\x19\xce\x79\xf3\x64\x90\xfe\x4c\x57\x88\xf1\x25\xca\xab\x4a\xe6\x32\xa4\xb4\x73\xf6\xe1\xa8\xb3\xa5\xf3\x02\x7e\x93\x93\x59\xe3\xe7\x12\x8d\xb6\x95\xd5\x59\x41\x65\x5a\xc6\x22\xae\x14\xa2\xb5\x24\xf0\x9c\x13\x29\xd2\xf3\xcb\xef\x5d\x48\xae\x46\xad\xc3\xb4\xbb\xf6\x6e\xd0\x18\x0d\x74\xf4\x6e\x0c\xbf\xb1\xdb\x7d\xfa\xd2\x08\x43\x26\x53\x92\x8f\xad\x97\x73\x5f\xed\x43\x52\xed\x0f\xc7\x89\x27\x72\x7b\x4b\x5b\x0f\x26\xbe\x37\x72\xf0\x19\xf1\x60\x6c\xb0\x8b\x3f\x86\x41\xc6\x41\x06\xaf\x0e\xbb\x03\x17\x95\x4a\x9d\xcb\xcf\xdf\x8b\xc3\x18\x86\x43\x13\xc0\xf4\xf0\xa7\x25\xbf\x48\xd0\xe1\x5c\x7b\xd4\x3f\xd8\x77\x43\xfd\x99\x34\x95\x0d\xfb\x30\xec\xd7\x53\x82\x56\x82\x9c\x20\x8e\x33\xef\x05\x59\x97\x18\x74\x4d\x76\x24\x49\x93\x81\xd2\x2f\xba\x07\x27\x6c\x82\xe8\x54\x01\x5c\x73\x83\x56\x2c\x23\xe2\xd0\x8e\xee\x06\x91\x9a\x32\x06\x4e\x1e\xb4\x89\x3b\xed\xa2\xfe\x9b\x32\x8c\x3e\x0c\x6c\x7e\x1b\xe1\x73\xf0\xc3\xf8\x96\x24\x52\x16\x6a\x73\xcf\x4d\x38\xf6\xb9\x91\x2e\xff\x1a\xfa\x25\x5b\x0e\xa2\x70\x07\x7d\x5d\x8d\x51\x9c\xce\x31\x95\x0b\x47\xf2\xaa\x3d\x75\x70\x0e\x8f\x08\xf7\xae\x8d\xf9\xe1\xe2\x54\x5b\x7c\x8d\x5c\xfb\x89\x2f\x52\x63\xf7\x35\xfb\xd3\x18\x82\xda\xc7\xa9\xfb\x06\xe9\x7e\xfb\x9c\xfc\xd6\x31\x32\xec\x67\x90\x92\x2e\x38\x11\xb8\x69\x2b\xd2\xd6\x82\x61\x9e\xa6\xa9\x75\xcf\x66\x13\x25\xf9\xa0\x56\xcf\xe3\x5f\xcc\x72\xc4\xb9\x62\xf8\xc9\x29\x24\x2d\x21\x8c\x4c\x5f\x54\xa7\x66\xe2\xae\xbe\x9f\x19\x3e\x50\x92\xf3\x8e\x6a\xaa\x71\xbd\x91\x8b\x7a\x25\xb7\xd5\x27\x15\xf3\x8f\x61\xa9\x82\x77\x38\x0a\xc7\x4d\x86\xe2\xd5\xe6\x54\xda\x6a\x1a\x75\x4d\x31\xbf\x79\x12\xa8\x6b\xae\x7a\x3b\x75\xbc\x45\x72\x9c\xde\x60\xfc\x21\x79\x3a\x96\xde\x40\xfe\x3c\xa7\x99\x64\x57\x6c\xea\x46\x20\x26\xb4\xeb\xb2\x2f\x86\x3a\x45\x76\x07\xa9\x1b\x26\x0f\x80\x67\xaa\x7b\xb4\x19\x8f\x8a\xed\xfc\x7e\x86\x71\xc6\xcd\x33\xc9\x61\x8d\x90\xc1\x53\xc5\x18\xe6\x28\xe7\xb8\x09\xc3\x5a\xf8\x35\xd4\x5b\xfc\xbe\x35\xdd\xad\x7b\xe1\xa7\xdf\x87\x0e\xc5\xcf\x7b\x85\xe9\xc5\x2f\xd0\xc8\x76\x0f\xa0\xbc\xf3\xca\xc6\x05\x51\xa3\xa7\x75\x23\x08\x1a\xb3\xfd\x57\xdf\x78\xdf\xe3\x47\x54\x51\x86\x36\x32\x0b\x85\x77\xef\xb9\xea\x74\x1c\xc3\x12\xf1\x1f\xf1\x16\xa6\x45\x91\xbb\xa6\x47\xe8\xa9\x7f\x57\x41\xb0\x69\x9f\x15\x5c\x6d\x6d\x14\xd8\x26\x32\x87\xcf\x0d\xf0\x98\x94\x1e\x15\xa7\x05\x56\x46\x55\xb3\xd1\x06\x34\x25\x9e\xcd\xd1\x34\x06\x76\x07\x6d\x64\x44\xad\x27\xde\xf9\x8b\x8e\xff\xff\x7d\x03\x77\x1f\xc2\xf4\xe4\x77\x79\x5e\x6c\xce\x57\xa5\xd8\xaa\x22\x6e\xe8\xa5\x5c\x77\xaa\xdd\x64\xba\x4a\xf7\xd7\x44\x86\x36\xf1\x2c\xc6\xab\x3a\x47\x32\xba\x04\xda\x98\x83\xf6\xb7\x1a\x69\x8b\xce\xa8\x0f\x7f\xc5\xa6\x53\x18\x0e\xa1\x82\xc9\x04\xb0\x9c\xb7\x8f\x1b\x25\xe2\xfa\xe9\xbc\x10\x4b\xcc\xa0\x69\xe5\xe5\x7e\x5c\x15\xbc\x8d\x99\xe6\xd3\xd0\xcd\x34\xad\x13\xbb\x7a\x2d\x9a\x80\xba\x09\xb8\x0b\xae\xcb\x28\xfa\x1a\x7e\xaa\xce\x0b\xed\xfb\x23\x4d\x6d\x5d\x47\xff\xf0\xdb\x9c\xbe\xef\xce\xf5\x43\xf7\x6d\xae\xd5\x04\xd1\xa9\x05\x19\xd4\xdb\xd9\x8a\xb3\x47\xdd\xb0\xc1\xbd\x82\x36\xad\x9c\x4a\xa4\x61\xbb\xa7\xdf\xe8\x29\xb5\xef\x51\x0d\x89\x7b\x9b\xd9\x60\xd2\x89\x5a\xeb\x7d\x43\xc2\x2e\xae\xf7\x45\x50\x8a\xb4\x4e\x31\xa8\x6b\x54\x43\x16\x74\x5a\x36\x03\x04\x83\xde\x71\x1f\xcf\xbf\x34\x87\x1e\xf1\x66\xec\xb9\xa8\xb6\x5e\xda\x6f\xcb\xf4\xf0\xf1\x36\x51\xec\x4c\x93\xa3\xe0\xea\x9a\x6a\xb3\x94\x43\xdf\x7f\x26\x3c\x5c\x40\x45\x9b\x8e\x3e\x1b\x43\xe3\x37\xa3\x3f\xf4\x74\x61\x4d\x72\x34\x6c\xdb\x55\x36\x8a\x18\x5c\x8b\x48\x2b\x0a\x68\xd7\x1e\xff\x7a\x8e\xbb\x95\x3c\xfc\xe1\x0e\xda\x19\x1f\x7c\x1b\x69\xac\x18\xaa\x88\x78\xd8\x6a\xf6\xda\xd9\x83\x6f\x98\x10\xd6\x60\x6e\xef\xe2\x89\xd8\x1e\x61\x41\xdf\xd6\x78\xa8\x00\xc7\x60\x82\x85\x3d\x9b\x8b\xfb\xfe\x6f\xa0\xe7\xd8\x48\x07\x77\xe4\x7f\x04\x3a\x57\x41\xd5\xfd\x1e\x0e\x4f\x1a\x46\xec\x85\x7a\x90\xff\xfe\x61\x8a\x11\x84\x25\x3d\x1d\x36\x19\x9e\xbf\xb5\xcd\xac\xf1\x7f\xc2\xf0\xfc\xf9\x7e\x3c\x7c\x1c\x2f\x9e\x3c\x51\x5b\x2c\x3e\xbe\x22\xf5\x1a\x37\xbb\x38\xa8\x1c\xfe\x5e\x39\x50\x92\xb7\xeb\x02\x3e\x5f\x77\xfe\xff\x88\x5b\xd5\x6b\x8c\x1f\x6e\x82\x0f\x1a\x39\x54\x95\xf7\xcf\x35\xc5\x0f\x65\x64\x32\xea\x6a\xff\xf3\x52\x1c\xf3\xc7\x58\xec\x3d\xe8\x79\x20\x9f\xda\xe3\xdf\x1a\xa2\x1e\x27\xfa\x8f\x7a\xe6\xd7\x7f\x02\x00\x00\xff\xff\xd6\x94\x22\x28\x1b\x3c\x00\x00")
+var _templatesServerOperation_testGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xdb\xba\x15\x7f\xd7\xa7\x38\x35\x9a\x4d\x2e\x14\x19\xd8\xa3\x07\x3f\xe4\xb6\xbd\xa8\xb7\xbb\xd6\xa8\x33\xec\xe1\x62\xb8\x60\xa4\x63\x89\x88\x4c\x2a\xe4\x51\x1c\xd7\xe0\x77\x1f\x0e\x49\xc9\xb2\x9d\xb4\x19\xd0\x87\x61\x4f\x51\xc8\xc3\xdf\xf9\xf1\xfc\xa7\x67\x33\x78\xaf\x4b\x84\x0a\x15\x1a\x41\x58\xc2\xdd\x1e\x2a\x7d\x6d\x77\xa2\xaa\xd0\xfc\x15\x3e\x7c\x81\xcf\x5f\x6e\xe1\xe3\x87\xe5\x6d\x9e\x24\xc9\xe1\x00\x72\x03\xf9\x7b\xdd\xee\x8d\xac\x6a\x82\x6b\xe7\x66\x33\x38\x1c\xa0\xd0\xdb\x2d\x2a\x3a\xdb\x3b\x1c\x00\x55\x09\xce\x25\x49\xd2\x8a\xe2\x5e\x54\xc8\xc2\xf9\x2a\x7e\x3b\xf7\x07\xa1\xa5\x24\x99\xcd\xe0\xb6\x96\x16\x36\xb2\x41\xd8\x09\x7b\xca\x88\x6a\x84\x48\x09\x48\xeb\x26\x67\xf9\x8f\xa5\x24\xa9\x2a\xa0\xe1\xdc\xd6\x53\x6a\x8d\x7e\x44\xd8\x74\xe4\xa1\x6a\x54\xb0\xd7\x1d\x18\xbc\x36\x9d\x02\xaa\x8f\x97\xf5\x9c\x85\x2a\x93\x44\x6e\x5b\x6d\x08\xd2\x04\x60\xa2\x90\x66\x35\x51\x3b\x19\xff\xe3\x57\x98\xa9\x5f\xb5\x64\xa4\xaa\xac\xff\xe6\x45\xa9\xaa\x49\x92\x00\x44\xeb\xfc\x4b\x52\xfd\x5e\x2b\xc2\x27\x02\xe7\x8a\xf8\x35\xa9\x74\x23\x54\x95\x6b\x53\xcd\x9e\x66\x0c\x1c\x77\x18\xe6\x68\x27\x80\x46\x8b\xd2\xc2\xa4\x92\x54\x77\x77\x79\xa1\xb7\xb3\x4a\x5f\xeb\x16\x95\x68\xe5\xcc\x6f\xf2\x89\xad\x2c\xcb\x06\x77\xc2\xe0\x4b\xa2\xa6\x53\x24\xb7\x38\x3b\x4a\xf6\x24\x8d\x50\x15\x42\x7e\x8b\x96\x6c\xbe\x32\x52\x15\xb2\x15\xcd\xd2\x1b\xc1\x82\x73\x87\x03\xb4\x46\x2a\xda\xc0\xe4\xea\x61\x02\x79\xe0\x35\xe6\xc8\x3e\x0c\xc7\xd7\x68\x1e\xd1\x1c\xfd\x09\xe7\x87\xc7\x62\x41\xc5\x00\x21\x37\xa0\x06\x1e\x37\xab\x65\xdc\x8e\x0b\x11\x73\x38\x73\xd4\x79\xb3\x5a\xfe\x50\xe1\x11\xae\xd7\x16\x02\xf1\x34\xfa\x5e\x38\x7c\xae\x3a\x99\xfa\x08\x55\xb8\x63\x79\x61\x0b\xd1\xc8\x6f\x08\xf9\x67\xb1\x65\x94\x70\x39\xb0\xfc\xc7\xfa\x10\xbb\x59\x2d\x61\x27\xa9\x06\x01\xb5\x50\x65\x83\x06\x36\xda\xf8\xad\xc3\x01\xea\x6e\x2b\xd4\x18\x00\x74\xcb\xc1\x2e\xb5\xca\x58\xcf\xae\x96\x45\x0d\x06\xa9\x33\x2a\xe0\x19\xb4\xad\x56\x16\x41\xa8\x12\x08\x9b\xc6\x82\xa4\x08\x5d\x46\x89\x87\x8e\x33\x69\xd3\xa9\xe2\x07\x44\x53\x82\x77\x31\x6c\xf3\xdb\xec\x88\x7d\x0c\x94\xfc\xab\x5f\x2b\xd1\x64\x83\x92\x77\x77\x5a\x37\x53\xe0\x44\xc8\x3f\xc5\x3b\x1d\x12\xe8\xf3\x72\xdd\x62\x91\x01\x1a\x03\xf3\x45\x08\xe1\xfc\x46\x89\x66\xff\x0d\xcb\xf4\xe5\x68\xc9\xd7\xe1\xf4\xdf\xd6\x5f\x3e\x67\x30\x99\x4c\x13\xe0\x02\xc3\x30\x6f\x16\xa0\x64\xe3\x55\x00\x50\xfe\xab\x20\xd1\xa4\x68\x0c\x8b\xb0\x47\x45\x2b\x59\xd5\x0b\x51\x91\x7f\x3e\x37\xc1\x20\x14\x6d\x71\xb3\x5a\xa6\x23\xee\x0c\x2b\x5a\x99\xff\xa6\x99\x0f\x2c\x80\xf8\x73\xf3\x4c\xc2\xac\xb1\xe8\x8c\xa4\x7d\x08\x49\x4e\xf8\xa5\xfd\x45\x58\x59\xdc\x74\x54\x83\x73\x8c\x72\xaa\x7a\xf9\x81\xf5\xf1\xee\x02\xd8\x3f\x69\x67\x39\x5c\x7c\x15\xc9\x58\xd0\xc6\x7f\xa6\x90\xc6\xb4\xd0\x04\x29\xe0\x03\x1c\xd3\x13\x26\x52\x11\x9a\x8d\x28\xf0\xe0\x26\x30\x05\xe7\xde\x9d\x46\xf5\x20\xe9\x9c\xf7\x84\x36\xd3\x68\xbe\x10\x4a\x31\xe5\xbe\x87\xea\x1c\x53\x63\xd8\xc6\xb2\x7f\x14\xee\xd2\x73\xec\xe9\xa0\x35\x63\x0f\x45\x77\x8c\xa9\x04\x9b\xdc\xac\x96\x7f\xc7\xfd\xeb\x8d\x42\xfa\x1e\xd5\xff\x8e\x21\x3c\x9d\x9f\x63\x89\x2f\x7c\xcd\xbf\xfc\xf7\x56\xc8\xc0\x16\xba\x45\x0b\xbf\xff\xfb\xff\xcb\x2c\x43\x1f\x89\x06\x89\x8d\xa0\x4f\xdf\xe7\x12\xfa\xd4\x6c\x27\xeb\x03\xec\x65\xc1\xeb\x0b\xd5\xe2\x6c\xea\xc8\xbf\x27\xfd\x2b\xfb\xc1\xe7\xe9\xf3\x0d\x9d\x9e\x20\xb6\xee\x3c\xae\x66\xc7\xdb\xb5\xc2\x88\xad\x7d\x95\xba\x95\x17\x8d\x3a\x38\x06\xb4\x91\xdf\x90\x41\x32\xdf\x7f\x83\x3d\x7f\x8e\xc3\x87\x8d\xe9\xb3\x65\x3e\xc6\xc1\xbb\xbe\xd8\x2f\x80\x4c\x87\xe3\xd8\xe8\xbb\x04\xa7\xfb\x34\x19\x96\x45\x2b\x43\x51\x4f\x95\x6c\xa6\x89\xf3\x7d\x92\xdd\xf7\xec\x8d\xc1\xa2\x2a\x6d\xdf\xab\x2c\x90\x7e\x45\x4b\x9c\x7b\x99\x47\xd1\xc8\x12\xb4\x42\x1e\x0d\x09\x50\x14\xf5\xb1\x73\xe9\x8d\x97\x39\xed\xa2\x7e\x85\xe5\xef\x0c\x8a\x7b\x9e\x14\x05\xfb\xcd\x92\x11\x52\x11\xe8\x0d\x08\xf0\xde\x42\x42\x03\xda\xf8\x86\xad\x3b\x82\xc2\x60\x89\x8a\xa4\x68\x2c\x94\x5a\xfd\x99\xc0\x78\x75\x0c\x18\xbb\x79\xc2\xe1\xf1\xf2\x3d\x4f\x3a\x6c\x48\xb2\x42\x58\xb4\xdc\xb0\x7c\x2e\x77\x05\x45\x93\x2b\x0e\x3d\xb6\x73\xc8\x70\xbf\xb6\x45\xaa\x75\x79\xba\xd6\x99\x06\xce\xe5\x6a\x14\x25\x1a\x0b\xb0\x15\xed\xef\x61\x3d\x16\x0a\xbf\x7d\xa7\xcb\xfd\xf9\x91\xef\x36\x7b\x7f\xca\x92\xa0\xce\x02\x80\x54\xc4\xce\x0e\x34\xcf\x9b\xe0\x7b\x7f\x1d\x17\x77\xc3\x35\xe6\x00\x17\xf3\x54\x34\x48\x16\xc5\xc2\xcd\xe6\x17\x62\xff\xf0\xeb\x23\xc1\xce\x34\x73\x78\x06\xef\x9f\x5f\x7f\x1b\x49\xc5\xdc\xf9\x14\xed\xe0\x5c\xb4\xc8\xfc\xd2\x22\x3d\xd1\xd1\x55\xde\xde\xe3\x3e\x83\xb7\x8f\xa2\xe9\x90\x3d\x33\xc2\x39\x53\xcb\x92\xe0\xdc\x05\xef\x78\xf6\x48\x68\x54\xe6\xe2\xca\x98\xeb\x90\x9a\xdc\x16\x7e\x61\xef\x38\xc7\x4e\xba\xb4\x47\xdc\x7c\xe9\xec\xd7\xde\x8b\xce\xf5\x0e\xf5\x18\x6f\xf3\xef\x4d\x40\xa3\x53\xb1\x8d\xe4\x1f\x70\x23\xba\xa6\x9f\xae\xd7\xc1\xf5\xe3\x6a\x71\xc1\x20\x84\xc7\x1c\x4e\xe4\xb3\x64\x74\xd5\x41\x94\xa3\xe7\xf8\x2c\xe2\x0a\x76\x16\x3c\x94\xaf\xef\x65\x9b\x4e\x94\x8e\xe9\x1d\x0b\x03\x14\xba\x6b\x4a\xb8\x43\xd8\x8a\x12\x87\xc9\xf9\x98\xde\x20\xca\x12\x84\x82\xa7\x6b\x7c\x12\xdb\xb6\xc1\xbe\x92\x0c\xf9\x6c\xfb\xf1\xbb\x15\x44\x68\x14\xe7\xb7\x60\xa4\xad\xa0\xc9\x34\xb2\x0a\xfe\x60\xf8\x3f\x32\xa0\x82\x63\x20\x4c\x7a\x21\x59\x43\xc8\x3c\x0a\x33\xcc\xc0\x3c\x02\xfb\x45\x3f\xea\xfb\x51\xf7\x47\xa3\x36\x03\xe7\xbd\x93\x32\xf8\x53\x84\x9a\xc6\x6c\x7c\x60\x8c\xfe\x71\xc9\x43\xeb\xd7\x60\x82\x94\x8a\x3c\x24\x8b\x07\xe8\x4c\x93\xc5\x34\xb6\x41\x8a\x23\x95\x85\x38\x7e\xa6\x01\x8d\x2f\xc2\x79\x98\xc1\x10\xd2\xe1\x3a\x54\xe4\x7d\xa9\xe8\xd3\xc0\xe0\x43\x0c\xf7\x7c\x8d\x94\x8e\x8e\x05\x2c\xee\xd8\x00\x66\x77\x49\xaf\xd0\x86\x55\x4f\x47\x76\x08\x1d\xe0\xd3\xed\xed\x2a\x35\x3b\x7e\x50\x3c\x78\x0b\xfb\x51\xde\xec\x72\xff\x0b\xc3\x9b\x05\xdf\x23\x16\x97\x9e\x05\xe5\x1f\x79\x0c\xd9\xa4\x93\x2b\x3b\x07\x7c\x6a\xb1\xe0\xdf\x20\xd8\x93\x51\xf2\xaa\xcc\xa0\xd2\x04\x57\xe5\x1c\xae\xec\xc4\x1b\x23\x90\x1d\xd0\xb2\x5e\x87\xff\xe0\xcc\xc9\xd7\xde\x52\x69\xb4\x8b\xeb\xb9\xf4\x6e\x7c\xb3\x80\x74\xe4\x95\xf8\xd4\x98\xbe\x86\x56\x1f\xa1\xa4\x2f\x9b\xc2\x1c\xae\x28\xe3\x77\x59\x29\x99\x2d\x9d\xb1\x3d\x53\x37\xbc\xac\x8e\x24\x5d\xe2\x92\xff\x0c\x00\xc3\xf7\x32\xcb\x92\x11\x00\x00")
+
+func templatesServerOperation_testGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerOperation_testGotmpl,
+		"templates/server/operation_test.gotmpl",
+	)
+}
+
+func templatesServerOperation_testGotmpl() (*asset, error) {
+	bytes, err := templatesServerOperation_testGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/operation_test.gotmpl", size: 4498, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x6b\x73\x1b\xb9\x91\x9f\x97\xbf\xa2\xc3\xb3\x7d\xa4\x8b\x1a\x3a\xb9\xd4\x7d\xd0\x2e\xb7\x2a\x96\xb4\xb1\x6e\xfd\xd0\x49\xb6\xbf\xb8\x5c\x1b\x88\x03\x92\x88\x87\x18\x0a\xc0\x48\x64\xa6\xe6\xbf\x5f\x35\x5e\x03\xcc\x83\xa2\xb4\x5e\x27\x97\xda\xe2\x17\x0e\x1e\x8d\x46\xbf\xbb\x01\x94\x25\xa4\x74\xc1\x38\x85\xa1\xcc\xd8\x9c\x6e\x88\x20\xeb\x5b\x92\xb1\x94\xa8\x5c\x0c\xab\x6a\x50\x96\xc0\x16\x90\x0b\x48\xde\x30\x7e\xae\xe8\x5a\x42\xf2\x86\x6c\xcd\x3f\xd3\x3f\x27\x6b\x9a\xb1\x7f\x50\x48\xde\x92\x35\x85\xaa\xba\xc2\x8f\xe3\x19\x30\xae\xfe\xfb\xcf\xa3\x8c\xf2\x91\x81\x42\x78\x0a\x23\x9e\x2b\x48\xce\xe5\x5f\x84\x20\xbb\xb1\xfd\x7c\x45\xe4\x29\x93\x73\xc1\xd6\x8c\xe3\xc2\xae\xfd\x5c\x9e\x73\x45\xc5\x82\xcc\x69\xdd\x74\xa5\x04\x25\xeb\x31\xfe\x7d\x5b\x64\x19\xb9\xce\x70\xcd\xe7\x65\x09\x94\xa7\x50\x55\x65\x09\xc9\x47\x92\x15\xf4\x6c\xbb\x11\x54\x4a\x96\x73\xa8\xaa\xf1\x78\xe0\x47\xd8\x4d\xd5\x3b\xaa\xaa\x01\x5b\x00\x15\x02\x8e\x67\x60\xb7\x4f\x7d\x37\x62\x9f\x5c\x10\xb5\x82\xaa\x9a\x40\x59\xc2\x46\x30\xae\x16\x30\x7c\x7a\x33\x84\xe4\x75\x3e\x27\xca\xac\x31\x81\x3e\x6a\xe8\x9e\x70\xbd\xf1\xf7\x7a\xb9\x3f\xcc\x80\xb3\x0c\xca\x01\x80\xa0\xaa\x10\x1c\x5b\x07\x55\x07\xaa\x64\xbb\x17\x55\xb2\xfd\x9a\xa8\x7a\x78\x0f\x47\xf4\x03\x67\x37\x05\xdd\x87\x6b\x30\xe2\x61\xe8\xfe\xb3\x25\xe8\x81\x94\x38\xe3\xc5\xba\x87\x04\xd8\xf5\xff\x6a\xef\x1a\x41\xb7\xa3\x87\x10\xa2\xfe\xe7\xec\xcc\x46\xe4\x1b\x2a\xd4\xae\x61\x6a\xec\x28\x14\xa1\x73\x79\x81\x96\x40\xb1\x5b\x94\xc9\xb2\x04\x45\xd7\x9b\x8c\x28\x0a\x43\x3b\x9e\xe5\xdc\x0f\x19\x42\x62\x46\xd5\x4b\x19\x20\x27\x85\x54\xf9\xfa\xa7\x5c\xac\x89\x52\x54\xf4\xb0\xc2\xf4\xbf\x5b\x8c\xca\x52\x73\xa3\xaa\x26\x30\x2c\x4b\xcf\x80\xaa\x1a\x9a\x86\xab\x3b\xb2\x5c\x52\x61\xc6\xeb\xd6\xb2\x6c\x52\xaa\xaa\x92\x2b\x25\x18\x5f\x8e\xc6\x13\x58\xe8\x91\x72\x3f\xb5\x3a\xf0\xd6\x96\xb1\xb9\xf1\x2e\xeb\x1c\x6e\xfc\xa8\x41\x6e\x47\xed\x6b\xc6\xd3\x8d\x23\x95\x26\xf9\x10\x1a\x43\x3b\x3c\x00\xce\xa2\x42\x8f\xbc\x25\x02\x79\x7f\x4b\x04\x47\x1b\x91\x9c\xac\x58\x96\x76\x48\xc8\x25\x8e\x4a\xfe\x9a\xbf\xdf\x6d\x90\x6b\x83\x45\x2e\xac\xdc\xa2\xef\x30\xb3\x5e\x11\xf9\xd1\x33\x50\xba\xd6\x93\x9c\xdf\x52\xa1\xa8\x1f\xd6\xc5\x3a\x04\x7e\xce\x53\xba\xfd\x48\xec\x27\xcd\x24\x2e\xf4\x8b\xdf\xca\xe4\x20\x3c\x3f\x22\xf7\x05\xe1\x4b\x7a\xd0\xf0\x13\xad\xe8\xcd\x8d\x38\x26\x21\xd5\x01\xe1\xd8\xf6\x7e\x28\xc7\x33\x90\x77\x64\x99\x5c\x6d\x32\xa6\x5e\xee\xcc\xd6\x46\x07\x21\xdc\x36\x0e\x8e\x6e\x59\x46\xe7\x68\x24\x0c\x34\xd4\x4c\x83\x6b\x97\xd8\x38\x96\x9a\x75\xc0\x22\x7e\x64\xc8\xe8\xf7\x61\x08\x63\x17\xb8\x22\x9c\x29\xf6\x0f\x2a\xd0\x92\x07\xa8\x3e\xe9\xc7\x15\x66\xb8\x7e\x72\x42\xb2\x0c\xaa\x6a\x74\xd8\xa4\x31\x4c\xa7\x7a\x9a\xf5\x42\x13\x58\x88\x7c\x0d\xdb\xa3\x65\x7e\x24\x2d\x0e\x66\x63\x56\x66\xf1\xff\x11\x72\xa4\x25\x41\x7e\x23\x6e\xd9\xde\x55\x27\xce\x12\x94\x65\x1b\x4c\x8c\x7a\x2f\x8c\x8f\xe3\x01\x00\x5b\x34\xf5\x3b\xd4\xf0\x5c\xc8\xe4\x9c\x6b\x9d\x45\xcd\x18\xd5\xab\xf5\x9a\x7e\xb3\x5a\xe4\x00\x86\xf5\x34\xaf\x61\xc3\xc3\xe4\x1d\x51\x8c\x78\xcd\x16\xfd\x7a\xf6\x08\xf2\x59\x2b\x97\x5c\x10\x21\xe9\xbf\x2f\xd5\x0e\xa7\x8c\x95\xa9\x7b\xc7\x7d\xec\x10\x69\xfb\xd1\xd4\xe2\x3e\x97\x19\xeb\xf2\xfd\xa8\x5d\xc2\x0c\xc8\x66\x43\x79\x7a\x10\xa3\x2e\x0f\xa5\x55\xe0\x4f\xa6\x53\x38\xc9\x53\x0a\x4b\xca\xa9\x20\x8a\xa6\x70\xbd\x03\xd4\x63\xe3\x3d\xbf\x87\xd3\x77\xf0\xf6\xdd\x7b\x38\x3b\x3d\x7f\x9f\x0c\x06\xce\xeb\x9d\xe4\x9b\x9d\x60\xcb\x95\x82\xa3\xaa\x32\xd6\x60\x9e\xaf\xd7\x94\xab\x46\x5f\x4d\xb1\xc1\x60\x43\xe6\x5f\x88\xb1\xe3\xc9\x85\xfd\x8f\xd4\x9b\x4e\xe1\xfd\x8a\x49\x58\xb0\x8c\xc2\x1d\x91\x31\x32\x6a\x45\xc1\x62\x03\x2a\xcf\xb3\x64\x30\x9d\xc2\x59\xca\x14\xe3\x4b\x50\x7e\xde\x5a\x63\xb3\x11\xf9\x2d\x85\x45\xa1\x34\xa8\x15\xe5\xb0\xcb\x0b\x10\xf4\x48\x14\x3c\x82\xe4\x96\xd0\x68\x13\x9e\x0e\x06\x6c\xbd\xc9\x85\x82\xd1\x00\x60\xc8\xa9\x9a\xae\x94\xda\x0c\x07\xf8\xb5\x64\x6a\x55\x5c\x27\xf3\x7c\x3d\x5d\xe6\x47\xf9\x86\x72\xb2\x61\x53\x23\xf6\xc3\xfe\x01\x96\xf1\x74\xcf\x10\x51\x70\xc5\xd6\x07\x8c\x98\x4a\x3a\x2f\x04\x53\xbb\x03\x86\xae\x59\x9a\x66\xf4\x8e\x88\x7d\x70\x91\xa2\x7a\x77\x52\x89\xc5\x5a\xf5\x0e\xd3\xbd\xc3\x41\xe4\x6d\x4e\xe9\x82\x14\x99\x3a\xd7\x04\xb3\xbe\x26\xd2\x6d\x27\xe0\x96\xf3\xc1\xdc\x27\x5f\xe8\x6e\x02\x4f\x6e\x51\x76\x51\xf1\x92\x08\x08\xf6\x42\x55\x35\x6d\x85\x1d\xde\x80\x3a\xd6\x82\xf3\x96\xde\xe1\x68\x22\xe7\x24\xca\x8c\x2e\xd0\x87\x4a\x98\x0b\x4a\x14\x95\x40\x80\xd3\x3b\xd8\x37\x32\xbf\xfe\x3b\x9d\x2b\x04\x79\xc7\xd4\x4a\xcb\x4a\x6a\xf6\x89\x99\x50\x41\x25\x30\xf4\x6c\x7a\x6e\x9a\x0c\x16\x05\x9f\xdf\xb3\xf8\x68\xbc\x77\x41\xb4\xa1\x18\xac\x8d\x22\xda\xda\x4e\x4d\x0e\x54\x34\xcc\x15\x2c\x1a\xae\xcd\x26\x06\x3f\xb1\x8c\xea\xd1\xb1\xb3\x4f\xce\x4f\xab\xca\x4d\x99\x41\x3b\x44\xc7\xd1\xd6\xbe\x1a\xb7\x49\x79\x1a\xb3\xf0\x3f\x6e\x87\x9e\xc9\x50\x55\x6d\x10\x68\x70\x1b\xec\xf5\xc9\x88\xfb\xa3\xa1\x0e\x00\xc6\x75\x00\xbd\x87\x1a\xe5\xa1\x24\xd0\xee\x3a\x06\x84\x1b\x3e\xfe\x06\x39\xd7\xb3\x70\x9b\x01\xb9\xc1\xd3\x7b\xd2\x49\x0b\xa8\x06\xc6\xc8\xed\xd9\x3f\xcc\x73\xae\x08\xe3\x12\x30\x12\x43\xe1\xbb\xce\x0b\x9e\x82\xf6\x20\x12\x53\x13\x2d\x91\x65\x09\xab\x62\x4d\x78\x08\x00\xd0\xd7\x68\x27\x8a\x6b\xa8\xdd\x86\xcd\x49\x96\x69\xbb\x29\x29\x10\x41\x21\xbf\x46\xd0\x34\x35\x61\x1a\x01\xb4\x6c\xc9\x25\xbd\x29\xa8\x44\x81\xc7\x69\xd6\x2c\x1e\xeb\xf5\xa8\xc2\x10\x32\x08\xf0\x06\x0a\x9d\xf1\x3e\xf4\xa5\x12\xc5\x5c\x41\x89\x86\x62\x3a\x85\x57\xef\xdf\x5f\x80\x5d\x01\xde\x19\xcd\x02\xdd\xea\x1a\x9f\x87\x48\xc0\xdf\xfe\x2e\x73\x7e\x3c\x3c\x1a\xfe\x2d\xb6\x34\x16\x7a\x55\x4d\x9f\x5b\x61\x38\xa5\x58\x76\xda\xd8\x98\xa1\x2c\xe1\x3a\xcb\xe7\x5f\xbc\xef\x69\x75\x7b\x5e\xe0\x64\x5c\x9c\x09\x6a\xa5\xd6\x7d\x1d\x83\x12\x05\x6d\x8e\x7d\x43\xb6\x6c\xad\xd3\xe7\x01\x80\xfd\x70\x52\x96\x9c\x6d\xe7\x59\x21\xd9\x2d\xad\x47\xfd\x10\x71\x3e\x98\xde\x02\xcc\xb8\xed\x41\xc0\x8c\xf7\x00\xf6\xa3\x7e\x6c\x00\x66\xbc\x0f\x70\x91\x29\xb6\xc9\xe8\xbb\x85\x85\x6d\xbf\xe1\xdd\x42\xc3\x8f\x07\xb4\x66\x93\xed\x6b\xca\x97\x3a\x5a\x43\xc4\xc8\x16\xcc\xb7\x9d\x1b\x74\xb7\xa6\x32\x1e\x4d\x65\x3c\x9e\xca\x78\xef\xd4\x0b\x1d\xc7\x22\xaf\x06\x00\xf6\xe3\xd8\x06\x08\xae\xa7\xb5\x9c\xad\x75\xd5\x88\xea\x4f\x8f\xa7\xeb\x6c\xcd\xab\xab\x79\x16\xcb\x70\x1e\xe3\x7d\xf3\x1a\x15\x32\x00\xd3\xd0\x2d\x36\x41\x40\x3b\x00\x38\xe7\x06\xab\xa0\xb5\x39\xa1\x23\x2b\x1c\x00\xd4\xad\x60\x9a\x0d\x9c\x8e\xc1\x4d\x78\x4d\x6b\x69\x3f\x8e\x61\xbf\x85\x8f\x60\x9c\xd2\x8d\xa0\xf5\x3e\x34\x14\xd3\x82\xda\xd2\xa1\x74\x7e\x78\xf2\x36\x57\x6c\x6e\xcb\x40\xde\x3f\x3c\x9f\xfa\x9c\x5c\x5b\xd8\xab\xf9\x8a\xae\x89\x0d\x1c\x6a\x93\x72\x7e\x6a\x9d\xff\x37\x2c\x9e\x79\x4f\x58\x57\x28\x3a\xed\x5c\x0b\x2d\xb3\x87\xe4\x5c\xbe\x24\x92\x62\x8a\x17\xaf\xd2\x18\xe4\x10\xd9\xb3\x78\xec\x4c\x2b\xed\x34\x2c\x3f\x2e\xc8\x92\x71\xcf\x8e\xe9\x14\x2e\xc8\x92\x7e\xb8\x7c\x6d\x1d\xab\x04\xc2\xa1\x10\x19\x5c\x17\x2c\x4b\xa9\xf0\xee\x62\x83\xd1\x76\xbe\x00\x41\x65\x91\x29\x09\xc2\x98\x5b\x9a\xfa\x18\x47\x52\xeb\x62\x26\xe8\x05\x54\x6e\x40\xe8\xc9\x19\xe3\x5f\x24\xa8\x5c\x7f\xe4\x6a\x45\x85\x86\x27\x21\x5f\xe8\x26\x0b\xd4\x44\x42\x18\x47\x24\x97\x74\x4e\xd9\x2d\x15\x8e\x64\xcf\x3b\x29\x69\x6c\xfa\xd8\xed\x61\x34\xee\x19\x87\xfb\x0b\xaa\x6f\xcf\xfa\x06\x95\x2e\x24\xf0\x3e\x43\xad\xbc\xdf\x88\x27\x69\x01\x3b\x86\x0e\x5c\x93\x8e\x81\x13\x07\xd8\xb1\xcb\x79\xa5\xff\x2d\xa8\xd8\xfd\x16\x4b\xe8\x24\x36\x2c\xf6\x4d\xa7\xf0\x92\xf1\xd4\xb9\xc9\xeb\x5c\xad\x00\x0b\x43\xc8\xf1\xd4\xd7\x44\x31\xbc\xb5\xac\x9d\x00\x53\x40\xa4\x2c\xd6\x54\x82\x5a\x11\x85\xf9\xcd\x26\xa3\x5b\xcc\x94\xf8\x52\x02\x5b\x6f\x32\xaa\xf3\x34\x02\xb6\xb6\x87\x5a\x31\x32\x69\x40\x72\x49\x97\x4c\x2a\xb1\x1b\x9b\xac\x1e\x4f\x84\xcc\x71\x0e\x8a\x07\x8a\x95\xd4\x00\x7c\x48\xac\xe0\x8e\x65\x19\x14\x92\x82\x54\x82\xe8\x1c\x6c\x4d\xd5\x2a\x4f\x01\xa3\x90\xc7\x4b\x47\xb0\xed\x91\x88\xa3\x85\x09\x88\xbc\x50\x14\x9e\xd7\x89\x4e\xf2\x86\xa8\xf9\x8a\xa6\x97\xd8\xe1\x70\x77\x01\xb6\xa0\x12\x3e\x7d\xd6\x6d\x03\xe8\xe4\x4c\x18\x98\xcc\x40\xd8\x18\xc4\x5a\xd3\x98\xdb\x37\x12\xd3\x16\x9b\x6a\x99\x1c\x5c\x8e\x44\xf2\xe1\xf2\x75\xa2\x07\x8e\xc6\x41\x64\x1c\xc1\x41\x8b\xed\xc1\xd8\x72\x0a\x82\xc2\x78\x57\x52\xe3\x9b\x89\x50\x38\x6c\xf4\x5f\x7f\x82\x1f\x7e\x80\x3f\xbd\x68\xd6\xa2\xbf\xfb\xae\xae\xc3\x68\x92\x9c\x09\xf1\x36\x57\x7e\xb2\x2d\xcc\xb8\x9f\x55\x1d\x3c\xef\x70\x4d\x95\x2f\x2a\xc5\xeb\xeb\x65\xdb\xa5\xef\xfd\xb0\x06\xdf\x05\x5e\x07\x21\x68\x7a\xf8\x4d\x0e\x00\x16\x69\x37\xbd\x70\xf0\x78\x10\x6b\x57\x44\x34\xaf\xcc\x35\xac\x28\xfb\x09\x8a\xee\xb8\xfe\x79\xc0\x26\xa8\xaa\x9b\x4e\xd9\x9a\xc0\xcd\xea\x4b\x4f\xcf\x2f\x88\xe6\x8d\x4c\xfe\x4a\xd5\xbb\x9f\xc3\xa3\x9e\xa0\xf8\x75\x3c\xeb\x94\x1e\x54\xc8\x18\xaa\xd6\xed\xd1\xc3\x91\xd0\x72\x9d\xfc\xd4\x77\x0e\x81\x4c\x90\x75\x49\x48\x50\x39\x41\xbc\xea\xda\x57\x5d\x30\x3c\x97\xde\x0c\x42\x55\x89\xbe\xf5\xf6\x93\xc3\xa0\xa3\x81\x7c\x55\xc2\x3c\x1c\x9d\xaf\x49\x98\x57\x94\xa4\x54\x38\xd2\x3c\x72\x07\x89\x81\xf2\x49\x2b\xe1\x09\xe1\x39\xc7\xac\xcb\x34\xfe\x4c\x77\x11\x9d\x3e\x4f\x74\xa4\xf8\x75\x77\xe1\xad\x89\xd6\x1d\xb6\xe8\xa8\x08\xb4\x4e\x8b\xbb\xcf\x90\x0d\xd2\xbe\x3e\x6c\x74\x13\x41\xf5\x30\xdb\x61\xec\x14\x2f\x08\xac\x9e\x3d\x6b\x1a\xa7\x37\x4c\x4a\xc6\x97\x08\xce\x6b\xf8\x9e\xbd\x62\x1d\xf9\x2d\xbd\x1b\xfd\xf9\xc5\x8b\x09\x0c\x05\x25\x29\x16\xf9\x74\x7d\xef\xe9\x0d\x2c\x08\xcb\x30\x00\x7d\x7a\x3b\x6c\xd5\x93\x47\xf1\xbe\xc6\xae\xe4\x3d\xb6\x56\xa6\x85\x6b\x6c\x08\x67\x9d\x28\x5b\xb6\x4c\xa7\xc0\xb1\x24\xa6\xe3\xaa\xb5\xd9\x11\x5c\x17\x0a\x72\x9d\x68\x92\xcc\x54\x2e\x7d\xee\x6c\x99\xc5\xd3\xd6\x32\x0f\x14\xb3\x87\x32\xf1\x61\x32\x65\x30\xf3\xe1\x53\x0b\xab\x18\x23\xdb\x0a\xb3\x4e\x6a\xd6\xb5\x11\x67\xea\x35\xcb\x4f\x89\x22\xc7\x9d\x08\x4f\xc0\xa0\xdc\xdd\x6b\xfa\xaa\x86\xe4\x57\xd5\xa2\x41\x26\x0f\x6c\x91\xee\x37\x65\x8b\xf4\xab\x5a\xb0\xc7\xe0\xf1\xeb\xb5\xbf\xe1\x28\x9b\x26\xe1\x77\x97\xb8\xcf\x25\x62\xc0\xdc\xb0\x9b\xbf\x4b\x53\x20\x4d\xde\x4c\x5a\x42\xbd\xcc\x53\x2b\x3b\x36\x8b\x35\x51\xab\x53\xef\x57\x44\x8f\x18\x89\x71\x70\xe0\xde\xcc\x77\x6d\xc9\xaa\x49\x87\xce\x2d\x01\x86\xc2\x2f\xf3\x74\x17\xb0\xad\xaa\x52\xba\xa0\xc2\x76\x24\x27\x59\x2e\xe9\xa8\x36\xe8\x1a\xd3\x56\x1e\x1e\x34\x9d\x6d\xf1\x70\x41\xd7\xfb\xae\xf3\x74\xe7\x7d\x1c\x32\xe7\x4d\x9e\xd2\x4c\xd6\xc7\x50\xc9\x07\xbe\x26\x42\xae\x48\x56\x96\x98\xcb\xb0\x8d\xeb\xb3\x59\x7a\x7b\x4a\x59\x36\x34\xef\x0a\x2f\x64\x78\x92\x8e\x0c\xda\x8e\x57\x27\x39\xc7\xb4\x4c\x04\x72\xe2\x18\x06\x9d\xf5\x49\x3f\x6c\x36\x03\x96\x27\x67\xef\x7e\xb2\xac\x05\xd3\xea\x1c\xa6\x9b\x15\x0a\x63\xfb\xbc\x35\x28\x41\x21\x06\x46\x0e\x02\x49\xe8\x95\x97\x9a\x19\x98\x4c\x21\x1d\x1b\x37\x47\x3c\x9e\xc7\xb3\xc6\x56\xdd\x1f\x4f\x89\x67\x38\x7d\xfc\xfd\xaf\xdb\x7c\x27\xa6\x4d\x42\xdc\x1b\x1b\xec\xa3\x8f\x25\x90\x75\x90\x35\x8d\xee\x0d\x5c\x74\x2a\x77\x86\x9f\xbf\x16\x87\x09\x0c\x87\x36\x80\xe9\xa1\x4f\x83\x7f\x1d\x41\x87\x77\xed\x9d\xfe\xc1\x9d\x45\x9b\xcf\x51\x5d\xd9\x72\x97\x0d\xc2\x7a\x5a\x74\x95\x26\x63\x44\xd2\xb4\x6e\x38\x31\x25\x06\x53\xe7\x1f\x63\xe8\x85\x81\xd2\x2f\x13\x68\x5f\x02\x6a\xda\xc4\xfa\x72\x0f\x4a\x86\x67\x71\x2d\x50\xf7\x83\x48\x6c\x19\x83\x8e\xee\xb5\x89\xbd\xec\x1b\xfb\xee\x6b\x41\xc9\x17\xfb\xd5\x49\xe7\xe8\x8f\xf5\x2d\x01\xf1\xbc\xed\x69\x52\xcf\x77\x78\xf2\xf9\x96\x36\xfd\xea\xfd\x23\x59\x1e\xb4\xc3\x3d\xfb\x6b\x4b\x8c\x56\x5d\xbc\xef\x2b\xa8\x1c\xc3\x6c\x06\x2f\x3c\x9c\x87\x18\xee\xda\x1c\x1f\x54\x1b\x0d\xc3\x45\xdc\x9f\x47\x2e\x72\x4d\xf8\xdd\x16\xfd\x50\xb2\xbf\x8d\x21\xa8\x42\x9c\x1a\x08\xfa\xff\x16\xd1\x53\x8a\xb8\x50\x3e\x67\xd4\x16\x2d\xda\x38\x76\x11\xd6\x55\xf2\xc2\xf9\x23\x31\x4e\x92\x64\xdc\x58\x31\xe4\xd7\x8f\x9e\x5d\x75\x71\x06\x0d\x11\xca\x53\x2e\x99\xa2\x56\x6e\x58\xce\x8d\x4d\x12\x54\x5a\x90\x55\x5d\x58\xe5\x2c\x1b\x54\x83\x9e\x0d\x4c\xa7\xd0\x85\x1b\xcc\x57\x74\x8e\xd5\xe1\x55\x90\xf6\x48\xb8\x5b\xb1\xf9\x4a\x57\x99\x19\x1e\x3b\x0a\xa0\x78\x9c\x95\x52\xc8\x39\x05\xc2\x75\x11\x59\x17\x9a\x53\x3a\xcf\x88\x08\xea\xd0\xb0\x3d\xb2\xd3\x4c\x69\x73\x7b\xb4\x2e\x54\x81\xe7\x98\x47\xd4\x1d\x89\x01\xdd\x2a\xca\xf1\xae\x8b\xaf\x41\xd7\x47\x9f\x8f\xad\x33\x76\x53\x3e\x2e\x38\x8e\x5d\xf9\x50\x53\x1b\x2f\xdc\x60\xfd\xf4\x78\x06\x6b\xb2\xf9\x84\xa1\x02\x5f\x7e\xbe\xce\xf3\xac\x8c\x4a\x57\x0e\xa2\xf2\x35\x2c\x68\xc9\x9a\x45\xe6\x18\xba\x63\xf6\xa8\xae\x88\x11\x67\x14\x6f\xa2\x07\x1d\x0e\xf7\x15\x37\x5c\x99\xe2\xa0\xa9\x61\xf5\x40\x24\x51\x3d\xd2\xd9\x98\x67\xcf\x8c\xf0\xc5\xbd\x09\xce\xfc\x14\x80\xff\xac\x45\xb3\xbf\x5e\x21\x92\x8b\x5c\xea\x42\xe7\x3d\x88\x55\x55\x3b\xdc\xf4\xca\x10\x1d\xb6\xa3\x3c\x2f\xd9\x2d\xe5\x68\x33\x51\x16\x46\x78\x07\x4a\x42\x92\x24\x86\x41\xc8\x43\xf3\xcf\xaa\x0c\x06\x33\x0b\x7d\xc6\xee\x3a\x74\xb3\xf5\x5c\x38\xbb\xf6\x4d\xf8\x25\xed\x3c\xad\x82\x56\x06\x3e\x61\xc7\x67\xdf\x01\x16\xa0\xd7\x77\xfd\x39\x01\x1c\x35\xee\xf0\x29\x56\x01\xf5\x28\xad\x93\x7d\x05\xeb\x86\x44\x59\xe5\x6c\x1e\x19\x9b\x00\x5d\x43\x43\xd4\x35\x39\x46\x7e\xf6\x13\x36\x81\x27\x6e\x5b\x5a\x0b\x6a\x18\x4f\x98\xb3\x84\xde\x9a\x85\x62\x6a\xa6\x05\xae\x6f\xfc\xbd\x96\x03\xbd\x14\xf2\xfa\x8f\xf7\x19\x64\xac\xe9\x68\x8d\xba\x52\x44\x15\xf2\x03\xdf\x88\x7c\x4e\xa5\x44\x77\x70\xc6\x15\x53\xbb\x09\x0c\x1b\xc6\xe4\xa9\x84\x39\xe1\xff\xa9\xe0\x9a\x5a\xd6\xaa\x7c\x49\xd1\x84\x0c\x27\x3a\x40\xe7\x4b\x99\xfc\x4f\xce\x2c\x1e\x13\xc0\x7b\xdf\xe3\x71\x98\xe2\xa0\xf8\x69\xa2\x20\xb6\xbf\x25\x3d\x22\xdf\xf9\xeb\xa8\x80\xa6\x32\x5f\x34\x2d\xeb\x53\x09\x4c\x3a\xcb\x9a\xb6\xcb\x5f\xc9\x6b\x26\xf1\x7a\x4c\xb4\x7d\x87\xbb\x77\x1e\x56\xe0\x04\x95\xd1\xb5\x7f\xfc\xfb\x64\x9e\x11\x29\xdf\x5a\x7a\x8c\x1a\x06\x73\x6c\x6f\x9e\xb7\xaa\xf2\x65\xd9\x61\x3d\xee\x31\xc6\xc1\x52\xb5\x1d\xee\xcd\x8d\xb1\xb2\xb6\x76\xe6\x46\x9b\x9a\x09\xac\xb4\x55\x83\xe7\x71\xbb\xad\x81\x05\xa7\x3f\x65\x69\x6f\x81\xd7\x57\x10\xea\x8b\x0c\x55\x25\xf5\xeb\x19\x93\xd1\xb3\x8c\x26\x57\x94\x7e\x19\xbd\x98\x60\xbe\x81\x7f\xcf\x78\x8a\xd4\xec\xea\xba\x52\x44\x28\xec\xac\xef\x39\x95\x65\x74\x15\x42\x8b\x1d\x2e\x00\x78\x31\x24\x6c\xef\x74\xd9\x67\xdb\x39\xa5\xa9\xb4\xd7\x41\x42\x93\xb8\x37\x40\x99\xb4\x2e\x58\x4c\x60\x41\x32\x49\x6b\x31\x68\xe0\x47\xb6\x4d\xfc\x7e\xd4\xf8\x91\xed\x41\xf8\x91\xed\x63\xf0\x23\xdb\xfb\xf1\x6b\x46\x23\x56\x36\xeb\x43\x1f\x1d\x45\x47\x75\x89\x40\xea\x9c\x80\x5a\x7e\x87\x77\xd5\xba\x5f\x96\x7c\x45\x11\x15\xe4\x0e\xeb\x9c\xde\xc1\x4c\x60\x45\xe4\xcf\x74\x07\x18\x13\xf8\x67\x25\xd0\x73\xc2\x5a\x57\x4f\xea\xf8\x39\x38\xbd\x19\x47\xd1\x2f\x5b\xc0\x1f\x2c\xf0\x2e\x2e\x85\x71\xef\x41\xfc\xa9\xd9\x60\xe9\x8d\x5e\x51\x90\x3b\x6b\x5f\x83\x78\xd3\xec\x31\x8a\x39\xc9\x1d\xd6\x6c\x4c\xc7\xa7\x70\xd0\xd1\x1f\x3f\xd7\x70\xad\xc9\x88\xde\x26\xe0\x0a\xf1\xc3\x03\x41\xee\x1e\xfa\xaa\xe0\x60\xb2\x99\xce\xbf\x64\x59\x7e\x77\xb6\xde\xa8\x9d\x3e\xa4\x8c\xb3\x2c\x17\x05\xfa\x49\xf6\x55\xd0\xe1\x72\x8e\x1b\xe8\xc8\xc7\x6a\xfe\x04\x94\xb6\x51\x91\x46\x1c\x9a\x98\x83\xc9\x17\x0d\xd2\x0e\x9d\x71\x1f\xfe\x9a\x92\x18\x06\x42\x89\xe4\xa3\xd8\xef\x0e\xe7\x37\x44\x9a\xeb\x84\x3a\xee\x76\x7b\xc4\xf0\xd9\xe5\x81\xf6\xe4\xb6\xbe\x08\x64\x1f\x0f\xc5\x69\x52\x7d\x9d\x34\x3a\x81\x0d\x2d\x7e\x54\x10\xaa\x3d\x6f\x2e\xb5\xc1\xb6\x4a\x1e\x1e\x1d\x78\xf5\xfc\x0d\x6e\xa3\x6a\xc1\xe8\xb8\xf7\xde\x91\xa8\xda\xa2\xc8\x9e\xbb\x45\xb9\x88\x52\x57\x68\xdf\x2d\x0a\xb3\xd9\xae\xb3\x0c\x8b\x7a\xb3\xda\xe6\xad\x5d\x2d\x17\x6e\x03\x76\x8b\xe1\xf3\x16\xcd\xd2\xa8\x7e\x19\x3d\x7e\x41\xe9\x6b\x97\x15\x0f\x78\xa4\x71\x98\x70\x37\x3b\x3d\xab\x8d\xdc\xd7\xa2\xbd\x8f\xea\x7d\x15\x00\xbd\xb5\x58\x33\x3a\x4d\x76\x4c\x82\xd6\x33\x96\x08\xc1\xe8\xed\x5f\x88\xe7\xbf\x34\x85\x1e\x22\x97\x4d\x25\x6c\xcb\xa5\xfb\x76\x44\x8f\x2f\x9f\x8d\x34\x39\x93\xd1\xf3\x48\x75\xed\x69\x29\xf2\xa1\xaa\xee\xc1\xb6\x8f\x9f\x82\xdc\xb5\xe4\xd9\x1a\x9a\xba\x1e\x21\x23\xf3\xbb\xaf\x24\xd2\x19\x14\xf6\x17\xc0\x6a\x66\x76\x28\x56\x23\xc6\x08\xc4\x4d\x93\xfb\x5f\x2f\x2c\x60\x8b\x6f\xeb\xfe\xbd\xf1\xa1\x37\x1d\x97\x4d\x87\x3a\xde\x1e\x36\x2e\xc0\xf7\x3d\x33\xd2\x6f\x28\x2d\x11\x6a\x97\x80\x1e\xe6\xe6\x36\xa6\x97\x23\xf1\x01\x41\x47\xdf\xd4\xee\x40\x04\x8e\xc0\x86\x22\x07\x3e\xb8\xea\x7b\xf7\xd9\xb3\x6c\x9b\xb8\x1d\x17\x74\x23\xdf\xa4\x59\x8a\x7a\x7e\x40\x78\x52\x13\xe2\x20\xd4\x1b\x39\xe8\x6f\x24\x18\x51\x58\x52\xbb\xe3\x28\x8a\x48\xe9\xe2\xa3\x7b\xe0\xd3\xfd\x88\x36\xf0\xe7\x87\xd1\xf0\x71\xb4\xb0\xf5\x2a\x87\x4f\x28\x48\xbd\xc6\xcd\x0d\xee\x2a\x21\x3c\x9a\x0f\x9c\x65\x21\x29\xa3\xa4\xfc\xbe\xf7\xbf\x7e\x54\x37\xbe\x87\xe0\x74\xe9\xd9\x87\xae\xe4\x0a\xd7\xf8\xe7\x9a\xe2\xfb\xf2\xbd\x5c\xb4\xfc\x45\x0f\xe6\x8f\xb1\xd8\x07\xec\xe7\x9e\x6c\xed\x80\xa7\x9e\x9d\x1e\x27\xd8\x65\xeb\xdf\xff\x0d\x00\xac\xfc\x4c\xbd\xdb\x45\x00\x00")
 
 func templatesServerParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -471,12 +683,12 @@ func templatesServerParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 15387, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 17883, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xdd\x6f\xdc\xb8\x11\x7f\xd7\x5f\x31\xd9\xba\xc5\xae\xe1\x95\xd2\x87\xbe\xf8\xce\x07\x34\x76\xda\xb8\x68\x63\xc3\x76\x5b\xa0\x87\xc3\x1d\x2d\xcd\xae\x78\x91\x48\x85\xa2\xd6\xd9\x0a\xfa\xdf\x0b\x7e\x48\xa2\x24\x6a\xbd\x4e\x93\x3c\xdd\x93\x4d\x71\x66\x38\x1f\xbf\xf9\x20\xb7\xae\x21\xc1\x0d\x65\x08\x8b\x12\xc5\x0e\x45\x8a\x24\x41\xf1\x58\xd1\x2c\x41\xb1\x80\xa6\x09\xea\x1a\xe8\x06\x18\x97\x10\x5e\x97\x7f\x16\x82\xec\xa1\x69\xea\x1a\x24\xe6\x45\x46\xa4\xe2\xa4\x79\x91\xa1\x97\x3f\x34\xb4\x98\x95\x38\xe1\xca\x68\x7c\x98\x89\x25\xe6\xfc\x75\xff\x6f\xaf\xed\xfc\x99\x9d\xce\xe1\x75\xf9\xbe\xca\x32\xf2\x98\x21\xac\x9b\x26\xd8\x11\x01\x75\x0d\x3b\x22\x18\xc9\x11\xc2\xeb\x2b\x68\x1a\x28\xa5\xa0\x6c\x1b\xd0\x8d\xda\x0b\xef\x30\x46\xba\x43\xf1\x5e\x51\x34\x4d\x58\xd7\x50\x90\x32\x26\x19\xfd\x6f\xc7\xf1\xea\x02\x18\xcd\xa0\x0e\xc0\x23\xee\x02\xec\xe1\x7f\xe1\x22\x27\x52\xa2\x30\xd6\x0c\xd6\xcb\xd3\x23\xcf\x5a\x0d\x9c\xd7\xc7\xe1\xb2\x2a\x25\xcf\x5d\x91\xa7\x9d\xc7\x8e\x14\xdd\xf9\x68\x2a\x2b\xbc\xd7\x3e\x59\xae\xea\x1a\x59\xa2\x48\xf5\x9f\x40\x3b\xb6\x57\x67\x64\xf9\xf9\x71\xa6\x7f\x96\xe5\x5f\xc9\x20\xeb\x33\x05\x0e\x13\xff\x91\x49\xaf\x2e\x60\xb1\xd0\x81\x16\x4f\xe1\x3b\x0d\xb3\xe5\x2a\xbc\x47\xa9\xac\x28\x04\x65\x72\x03\x8b\xdf\x7f\x5c\x40\x68\xf5\x3a\x9b\x0a\x59\x05\xdd\x39\x23\x08\xab\x04\xa0\x12\xf3\xcf\x42\x71\xf8\x2f\x92\x55\xf8\xf6\x53\x21\xb0\x2c\x29\x67\xd0\x34\xf7\x23\x2c\x4f\x29\x46\xd0\xf5\xca\x78\x01\x80\xa7\xec\x4e\xd4\x66\x28\xfe\x2f\xd8\xad\x8d\x57\xbc\x7a\xbf\x00\x7e\x07\xf5\xfe\x62\x6a\x4f\xc0\xe5\x55\xbb\x87\x98\x9f\xe2\x0e\x2e\x80\x14\x05\xb2\x64\x46\xf5\xbb\xb3\x39\xd9\x63\xe4\x0d\x80\x37\x07\xba\x71\x91\xbc\x4c\x69\x96\x78\xf5\xfa\xf1\x27\x0b\xb7\x0d\x17\xf0\xf3\xd9\x51\x5c\x2a\x4a\x82\xb0\x2d\xce\x01\xd4\x38\x62\xdd\x95\x3a\x23\x68\xae\xf1\x1c\xcc\x20\xc3\xfb\x59\x0d\xc8\xe5\xb4\x21\x6c\x02\xb7\x13\x1a\xb5\x6e\x89\x40\x26\x5b\x54\x4e\xcb\x61\xf9\x44\xb6\xe1\xdf\x38\x65\x6f\xf6\x06\x30\xcb\xa3\x3c\xab\x5d\x39\xa8\x2e\x97\x3c\xcb\x30\x96\x94\x33\x23\x47\x97\x15\xba\x81\x0c\xd9\x72\x5a\x70\xe0\x07\x78\xad\x1d\x99\xee\x6c\x56\x0c\x09\x7e\x7c\xfd\x53\x00\xca\x94\x74\xe7\xc0\xef\x05\x35\x2e\xdd\xad\x02\x80\x17\x24\xe6\xb7\x72\x84\x37\x0b\x3a\x77\xcc\x10\x94\xd6\x49\xbe\xbd\xce\x55\xb3\xbc\xae\x03\xbf\x78\x06\x97\xae\x9f\x2d\x10\x87\xff\x0e\xa7\x37\x81\x65\xc1\x59\x89\xee\xe0\xa6\x1c\xcc\x13\x84\xf5\x1f\xa1\x69\xa2\x08\xea\xda\x69\x9c\x2a\xa4\x4d\xa3\xf7\x69\x09\x32\x45\x78\xf7\xf0\x70\x0b\xb1\xfa\x20\x50\x56\x82\x61\x02\x2a\xbf\xe5\xbe\xd0\x39\x3b\xe1\x0d\x62\xce\x4a\xe9\xdd\x32\x62\x99\x34\x2d\xc5\x68\xe1\x4e\x76\x41\x74\x6a\xcb\xea\x15\x96\xb1\xa0\x85\xec\x6a\xed\x48\x96\xae\x0c\x35\x3c\x66\x3c\xfe\x10\xf3\x3c\x57\x59\x37\x61\x52\x40\x3c\xc0\x9c\x56\x39\x61\xee\xc7\xb6\x4e\x07\x0a\x9d\x5b\x14\xe7\xad\xf7\x14\x75\x4c\x72\x1c\x88\x08\x4e\xa3\x60\xc6\x09\x76\x8a\xac\x62\xd9\xc2\x8c\x6e\x00\x3f\xba\x7e\x0f\x00\x7e\x2e\x25\x91\x55\xd9\x3a\xc5\x10\x76\x13\x9b\x29\x8a\x36\xff\x4a\x15\xa9\xd3\xba\xf6\xba\xe6\xb0\x13\x7a\x89\x8a\xf9\x0e\x3f\x56\x54\x60\x62\x34\x68\x57\xe7\x20\x45\x85\x63\xda\x7f\x90\x4f\x34\xaf\x72\x43\x6a\x17\xe7\x6d\x4b\x7d\xfb\x29\xce\xaa\x92\xee\xb0\xa7\xfa\x7e\xa0\xbf\xc3\x3e\x11\x4c\x99\x23\xd8\x2c\x3c\x82\x3b\xaa\x1f\x46\x82\xbb\x8d\x89\xe0\x2a\x93\xb4\xc8\xf0\x66\x63\x65\xdb\x35\xdc\x6c\xb4\xfc\x21\x81\xc7\xde\xbf\x23\xdb\xca\xb4\xb3\x18\xcc\xda\xf2\x3a\xdb\x1e\x8b\x06\xac\x94\x0d\x59\x9d\xed\x31\xeb\xad\x9e\x1a\x98\x61\xb4\x0b\xc3\xd5\xef\x78\x34\xbd\x56\x4d\xae\x57\x54\x2f\x3b\x3d\xdb\x4d\x8f\x9a\x2e\x1f\x65\x03\xbe\x7e\x73\xcc\xf7\x4f\x46\x3f\x56\xe8\xb0\x9a\x0f\x7e\xd8\xbc\x23\xe5\x15\x6e\x48\x95\x49\x43\x6b\x17\xe7\x83\xd2\xfd\xbb\xdd\x42\x41\xb5\x25\xeb\x4b\x00\xc0\x69\x64\x32\xc1\x9f\xb4\xe1\x5f\xf9\x83\x4a\xba\xa6\x81\x5f\x7e\x2d\x39\x3b\x5f\xd4\xb5\xad\x2e\x4e\x37\x76\x60\x7e\xc6\x73\x35\x10\x14\x72\xdf\x1d\xb2\xf8\xc5\xcd\xb5\x2e\x41\xc3\xfb\x38\xc5\x9c\x18\x75\x9e\xa8\x4c\x9d\x2f\x01\xc0\x17\xc9\xbf\xdf\x72\xea\xb7\x9c\x7a\x49\x4e\x05\x00\xd7\xec\x1c\xde\xf0\x64\xaf\x53\xc3\xdd\xb8\x25\xfb\x8c\x93\xc4\x06\x99\xb0\x04\x96\x1a\xfc\x06\xb4\xe1\x75\xf9\x86\x94\xa8\x92\x65\xe5\x7c\xbb\xe4\x79\x91\xe1\xa7\x9b\xc7\x5f\x31\x96\x93\x57\x02\x4b\x36\xc9\xb1\x47\x9e\xec\xfb\x44\x1a\xe5\x8f\xea\xdb\x11\xbc\xc7\x27\x7f\xd2\xc6\x02\x89\xc4\x72\x26\xa5\x75\x9e\x25\xb6\x10\xa4\xb6\xd9\xed\xd4\xe0\x53\x06\x9b\x8a\xc5\xb3\x72\x97\xbe\xae\x1a\xdb\x5e\xda\x29\xb7\x82\xd3\x99\x52\x32\xd7\x95\xe9\xc6\x0c\x3b\xdf\x5f\xd8\x21\x11\xcc\xfa\x02\xfe\xf4\xfa\xb5\x1e\xbe\xdc\x18\x98\x91\x08\xfe\xe0\x3d\xa4\x9b\x01\x27\xe7\x38\xad\xff\x5c\x8b\x3f\x6b\x49\xe7\xfb\xbf\xaf\xbc\x7a\x8f\x3d\x58\x69\xcf\x46\x75\xcf\xfc\xef\xdc\x66\x46\x0e\x89\x22\xf8\x37\x95\xe9\x7d\x3f\xaa\x90\x24\x31\x83\xa1\xb1\x01\x24\xd7\x2b\xdf\x40\x05\xed\x00\x65\x42\xe9\x7b\xe9\x99\x89\xcf\x6a\x74\xea\xb2\x8d\xec\x7c\x40\xed\x3c\x3f\x7e\x17\x72\xa7\xac\x0b\xed\xeb\x3e\x6c\x1e\x7a\x8b\xe6\x7b\x94\x8e\xc9\x25\xca\x6f\x61\xf2\xe0\x50\xc7\xe2\x17\x98\xe6\xa0\xd3\x87\xa1\x36\x9c\x7e\x17\x76\x91\x9d\x8e\xbb\x6a\xdb\x63\xf5\xc9\x01\xb3\x4f\x9e\xb1\xfb\x64\x18\xeb\xd9\x24\xef\x6e\x85\x9d\x22\xfd\x18\x30\x4d\xf0\x93\x31\x20\x26\x6a\x84\x7e\xe3\x87\xd7\xe2\xf6\xac\x23\xb1\x32\x73\x05\x68\x61\xf3\xad\xfd\x39\xa7\xd1\x31\xee\xfc\x32\x6e\x1b\xe2\x70\x30\x5c\xb5\x18\x6c\xdb\x57\x87\xba\xc2\x7e\xf8\x8a\x05\xc5\x9e\xb9\x2c\x26\xad\x73\xae\x43\xce\xb6\xd4\xe7\x5a\xe7\x8b\x0b\x55\xeb\x8f\x8b\xd6\x11\x47\x62\xaf\xe5\xeb\xd0\xf6\x95\xfd\xd8\x1f\xf9\x6d\xdc\x78\xbc\xbf\xdc\xd6\xac\x51\x26\xa8\xc4\xbb\xf6\x0e\x6f\xdd\x11\x67\x14\x99\xfc\x1c\xfc\xb8\xd2\x96\xe2\x09\x52\x29\x8b\xb0\xfd\xa0\x77\xc5\x19\x14\x82\x27\x55\x8c\x02\x44\xc5\x24\xcd\x31\xbc\xb5\x1f\x3a\x43\xa6\x45\x19\x20\x8a\xba\x88\xd8\x21\x08\xba\x6b\x8d\xe1\x72\x5e\x29\xfd\xbf\x90\xad\x87\x1d\xbd\xbb\xd5\x38\x8e\xb7\xf5\xcc\x79\xd4\xbb\xc2\x6c\xd9\x2a\x6a\x3e\x5e\x72\x26\x91\x49\x13\x9c\x28\xba\xc3\x9c\xef\x10\xec\xd7\xb5\x0e\x0a\x67\xa0\xc7\xc0\x4e\xe5\x72\x74\xb0\x78\x0a\xb5\x3b\xec\x31\xbe\xb9\xe2\x99\x76\x36\x7c\x7c\x1f\xbf\x13\xad\x3c\xf7\xb5\x7e\xed\x19\x72\xfb\x17\xbb\x39\x10\xb5\x3f\x83\x0c\xec\x68\xe1\x6d\x5f\x03\x67\x78\xc7\x87\xb7\x0f\xd3\xe6\xd0\x56\xc6\x45\xff\x3b\x4b\x2f\xd8\xc8\xb5\x9c\xff\x41\xc1\x0d\xdb\x38\x90\x5a\x10\x0a\xa1\x14\x69\xf1\xd5\xe2\x6a\x29\x9e\xce\x5a\x79\xab\xef\x34\xd5\x2b\xf7\x28\x75\x18\xa3\xf1\x12\x85\x50\xf1\x84\x0c\xa5\x4e\x03\x81\x31\xdf\xa1\xd8\x43\x4e\x93\x24\xc3\x27\x22\x10\x12\x24\x99\x19\xc8\x65\x4a\x4b\xcd\x3e\xb9\x1d\x7b\xbc\x0b\xcd\x60\x70\xed\x6e\x05\xdd\xff\x51\x04\x3a\x84\x5b\x64\x28\x88\xc4\x04\x1e\xf7\xb0\xe5\x6b\xfb\xcc\xf6\x1d\x5c\xdd\xc0\xfb\x9b\x07\x78\x7b\x75\xfd\x10\x06\xed\x20\x1a\x5e\xf2\x62\x2f\xe8\x36\xd5\xef\xe9\xfa\x9d\x12\xba\x5b\xf6\x60\xcf\xc9\xfa\xa0\x20\xf1\x07\x62\x7f\x4d\xb8\xb5\xff\xdb\x72\xf0\x90\xd2\x12\x36\x34\x43\x78\x22\xe5\x50\x19\x3d\xdb\x19\x6d\x40\x72\x9e\x85\x8a\xfe\x6d\x42\x25\x65\x5b\xed\x0c\xc3\x97\xeb\x13\x0b\xa1\x52\x62\x53\x49\x2d\x2a\x45\x06\x7b\x5e\x81\xc0\xb5\xa8\xd8\x40\x52\x7b\x84\x56\x9b\xb0\x24\x08\x02\x9a\x17\x5c\x48\x58\x06\x00\x0b\x86\x32\x52\x35\x64\xa1\x16\x5b\x2a\xd3\xea\x31\x8c\x79\x1e\x6d\xf9\x9a\x17\xc8\x48\x41\x23\x25\xe9\xc0\x36\x0a\xc1\x45\x79\x80\x60\x47\x32\x9a\x10\x89\x07\x48\x6c\xfa\x3f\x4f\x11\x95\x18\x57\x82\xca\xfd\x22\x18\x14\x32\x7b\xb7\xb8\xd6\x96\xd9\x8b\xca\xe0\x89\xde\x57\x98\x0c\xef\xc9\x07\xdc\x9f\xc1\x89\xbe\xee\x29\x70\x87\x03\x21\x6a\xd7\xce\x27\xae\x3c\x4b\x3e\x92\xba\xd2\xb0\xb1\x2a\xb5\x45\xb9\xb4\xaf\xde\xe3\xe2\xd9\xbf\x8a\x87\xd0\x8c\x7e\x8c\x35\x4f\x3c\xda\xa4\xae\x75\x3c\x2b\xc5\xcf\x60\x85\xfe\x2f\x00\x00\xff\xff\x5a\x59\xc5\xfd\x53\x21\x00\x00")
+var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5b\x73\xdb\x36\xf6\x7f\xe7\xa7\x38\xd5\xdf\xfd\x8f\xe4\xca\x54\xf6\x61\x5f\xd4\x2a\x33\x1b\x3b\xd9\x78\x27\x89\x3d\xb6\xb3\x9d\xd9\x4e\xa7\x85\xc9\x23\x11\x0d\x09\xd0\x00\x28\x5b\xcb\xe1\x77\xdf\x01\x08\x90\xa0\x48\xca\x97\x49\xf3\xd4\x27\x0b\x97\x73\xc1\x39\xbf\x73\x01\xe8\xb2\x84\x18\xd7\x94\x21\x4c\x24\x8a\x2d\x8a\x04\x49\x8c\xe2\xb6\xa0\x69\x8c\x62\x02\x55\x15\x94\x25\xd0\x35\x30\xae\x20\x3c\x97\xff\x10\x82\xec\xa0\xaa\xca\x12\x14\x66\x79\x4a\x94\xa6\xa4\x59\x9e\xe2\x20\x7d\x58\xef\xc5\x54\x62\x8f\x2a\xa5\xd1\x61\x22\x16\xd7\xf2\x4f\xda\x9f\xad\xb6\xe3\x32\x1b\x9d\xc3\x73\xf9\xa9\x48\x53\x72\x9b\x22\x9c\x54\x55\xb0\x25\x02\xca\x12\xb6\x44\x30\x92\x21\x84\xe7\x67\x50\x55\x20\x95\xa0\x6c\x13\xd0\xb5\x5e\x0b\xaf\x30\x42\xba\x45\xf1\x49\xef\xa8\xaa\xb0\x2c\x21\x27\x32\x22\x29\xfd\x6f\x43\xf1\xdd\x0a\x18\x4d\xa1\x0c\x60\x80\xdd\x0a\xac\xf0\x77\x5c\x64\x44\x29\x14\xf5\xc1\x3b\xe3\xe9\xf1\x13\x65\xcd\x3a\xc6\x6b\xfd\x70\x5a\x48\xc5\x33\x9f\xe5\x71\x63\xb1\x27\xb2\x6e\x6c\xd4\xe7\x15\x5e\x1b\x9b\x4c\x67\x65\x89\x2c\xd6\x1c\xcd\x9f\xa0\x0a\x3a\xea\xec\x9d\x7c\xf9\xb4\xa3\xbf\xe8\xe4\x7f\xd2\x81\xac\xcd\x34\x38\xe8\x7a\xc0\x99\xdf\xad\x60\x32\x31\x8e\x16\xf7\xe1\x7b\x03\xb3\xe9\x2c\xbc\x46\x35\xd5\x1a\x0b\xca\xd4\x1a\x26\xdf\xdf\x4d\x20\xb4\x7a\xcd\xfb\x4c\x66\xd6\x6c\x7d\x08\xeb\x00\xa0\x0a\xb3\x17\xa1\x38\xfc\x37\x49\x0b\x7c\xfb\x90\x0b\x94\x92\x72\x06\x55\x75\xbd\x87\xe5\xfe\x8e\x3d\xe8\x0e\xf2\x78\x06\x80\xfb\xe4\x9e\xd7\x46\x76\xbc\xc0\x4b\x2d\xec\xf4\xf9\x87\xd9\x5e\x3f\x07\x7e\x07\xf5\xfe\x6a\x6a\xf7\xc0\x35\xa8\x76\x0b\xb1\xe1\x1d\x57\xb0\x02\x92\xe7\xc8\xe2\x11\xd5\xaf\xe6\x63\xbc\xf7\x91\xd7\x01\xde\x18\xe8\xf6\x93\xe4\x69\x42\xd3\x78\x48\x2c\xfc\xf2\xab\x85\xdb\x9a\x0b\xf8\x6d\xfe\x24\x2a\xed\x25\x41\xd8\x06\x47\x74\xb6\x86\x38\x69\x4a\x4e\xcd\x68\xac\xf0\x1c\x8c\xa0\x9a\xf6\x45\x05\xc8\xa7\xb4\x2e\xac\x02\x1b\x8f\x9e\x5a\x97\x44\x20\x53\x0e\x95\xfd\x74\x28\xef\xc9\x26\xfc\x17\xa7\xec\xcd\xae\xc6\xe0\xf4\x29\x36\xaa\x1d\xda\xc9\x2e\xa7\x3c\x4d\x31\x52\x94\xb3\x9a\x8f\xce\x8f\x1a\x54\x29\xb2\x0e\xcb\x3a\x73\xc2\x6b\x78\x65\x0c\x99\x6c\x6d\x54\x74\x37\xfc\xf2\xea\xd7\x00\xb4\x85\x93\xad\x07\xbf\x67\xe4\xb8\x64\x3b\x0b\x00\x9e\x11\x98\xdf\xca\x10\x43\xf2\x5b\x73\x8c\x6c\x90\xd6\x48\x43\x6b\x8d\xa9\x46\x69\x7d\x03\x7e\xf5\x08\x96\xbe\x9d\x2d\x10\xbb\x3f\x9b\x98\x36\x38\x16\x28\x73\xce\x24\x7a\xe5\x83\x69\xa4\xf1\x18\xe1\xe4\x6f\x50\x55\x8b\x05\x94\xa5\x57\x38\xb5\x4b\xab\xca\xac\x53\x09\x2a\x41\x78\x7f\x73\x73\x09\x91\x9e\x10\xa8\x0a\xc1\x30\x06\x1d\xdf\x6a\x97\x23\x74\x8b\x6e\x4d\x1b\x44\x9c\x49\x35\xb8\x54\xb3\x65\x0a\x6a\xf3\x9a\xa1\xdf\xd9\x05\x8b\x63\x9b\x56\xcf\x50\x46\x82\xe6\xaa\xc9\xb5\x7b\xbc\x4c\x66\x28\xe1\x36\xe5\xd1\x97\x88\x67\x99\x8e\xba\x1e\x91\x8e\xf1\x03\xc4\x49\x91\x11\xe6\x4f\xba\x3c\x1d\x68\x74\x6e\x50\x2c\x9d\xf5\xb4\xb6\x11\xc9\xb0\xc3\x22\x38\x5e\x04\x23\x46\xb0\x5d\x64\x11\x29\x07\x33\xba\x06\xbc\xf3\xed\x1e\x00\xfc\x26\x15\x51\x85\x74\x46\xa9\x37\x36\x1d\x5b\x9d\x14\x6d\xfc\x49\xed\xa9\xe3\xb2\x1c\x34\xcd\x61\x23\xb4\x1c\x35\xf1\x15\xde\x15\x54\xa0\x96\x11\x00\xb8\xd1\x12\x94\x28\x70\x7f\xef\x47\xf2\x40\xb3\x22\xab\xb7\xda\xc1\xd2\x95\xd4\xb7\x0f\x51\x5a\x48\xba\xc5\x76\xd7\x4f\x1d\xfd\x3d\xf2\x1e\x63\xca\xec\x4a\x00\xf0\x91\xb2\x11\xc6\xcd\xae\xd7\x7b\x8c\x29\x1b\x63\x5c\xa4\x8a\xe6\x29\x5e\xac\x2d\x6f\x3b\x86\x8b\xb5\xe1\xdf\xdd\xd0\xa3\x26\x0f\x1f\x90\x6d\x54\x62\x89\xc9\x03\xd4\x63\x4b\xeb\x2d\xf7\x48\x29\xeb\x90\x52\xd6\x25\xa5\x6c\x94\xf4\xd2\x34\x22\xda\x57\x01\x80\x1d\xd4\x02\xdb\x95\x9e\x38\xf2\x70\xae\xdb\xc4\x56\x51\x33\x6c\xf4\x74\x8b\x3d\x3a\xca\x7c\x3a\xca\x3a\x74\x94\x8d\xd1\x7d\x66\xf4\xae\x40\x8f\xb4\x9e\x18\x86\xcd\x7b\x22\xcf\x70\x4d\x8a\x54\xe7\xe2\x00\xc0\x0e\x96\x9d\xd4\xfd\x7f\xdb\x09\x84\xed\xb6\x86\x47\x00\x70\xbc\x08\x60\x24\xa6\xb4\x9a\xff\xe4\x37\x3a\xe8\xaa\x0a\x7e\xff\x43\x72\xb6\x9c\x94\xa5\xcd\x2e\x5e\x35\xf6\x60\x3e\xe7\x99\x6e\x08\x72\xb5\x6b\x84\x4c\x7e\xf7\x63\xad\x09\xd0\xf0\x3a\x4a\x30\x23\xf5\x49\xee\xa9\x4a\xbc\x99\x00\xe0\xab\xc4\xdf\x5f\x31\xf5\x57\x4c\x3d\x27\xa6\x02\x80\x73\xb6\x84\x37\x3c\xde\x99\xd0\xf0\x17\x2e\xc9\x2e\xe5\x24\xb6\x4e\x26\x2c\x86\xa9\x01\x7f\x0d\xda\xf0\x5c\xbe\x21\x12\x75\xb0\xcc\xbc\xb9\x53\x9e\xe5\x29\x3e\x5c\xdc\xfe\x81\x91\xea\xbd\x12\xd8\x6d\xbd\x18\xbb\xe5\xf1\xae\x0d\xa4\xbd\xf8\xd1\x75\x7b\x01\x9f\xf0\x7e\x38\x68\x23\x81\x44\xa1\x1c\x09\x69\x13\x67\xb1\x4d\x04\x89\x2d\x76\x5b\xdd\x11\xc9\x60\x5d\xb0\x68\x94\xef\x74\xa8\xaa\x46\xb6\x96\x36\xca\xcd\xe0\x78\x58\x6e\x09\x43\xf4\x75\x17\x6c\xb8\xfc\xb4\xb2\x4d\x22\xd4\xcd\xcf\x0a\xfe\xfe\xea\x95\x69\xbe\xda\x93\x83\x6d\x89\xe0\xff\x07\x85\x34\x3d\x60\x4f\x8e\x57\xfa\x97\x86\xfd\xdc\x6d\x1d\xaf\xff\x43\xe9\x75\x50\xec\xc1\x4c\x3b\xf7\xb5\x6f\x7e\x7b\xb7\x99\x3d\x83\x2c\x16\xf0\x33\x55\xc9\x75\xa3\x2f\x90\x38\xae\x1b\xc3\xfa\x0c\xa0\xb8\x19\x0d\x35\x54\xe0\x1a\xa8\xda\x95\x43\x2f\x3d\x23\xfe\x99\xed\x49\x9d\x3a\xcf\x8e\x3b\xd4\xf6\xf3\xfb\xef\x42\x7e\x97\xb5\x32\xb6\x6e\xdd\x36\xb0\x5f\x1b\x62\xb1\x80\x6b\x54\xde\x91\x25\xaa\x6f\x71\xe4\x8e\x50\xef\xc4\xcf\x38\x5a\x15\x1c\xc4\x90\x73\xe7\xb0\x09\x1b\xcf\xf6\xdb\x5d\xbd\x3c\x70\xea\xa3\x03\xc7\x3e\x7a\xe4\xdc\x0d\xed\x6c\x5c\xa5\xce\xad\xb0\x51\xa4\x6d\x03\xfa\x01\x7e\xb4\x0f\x88\xa3\x47\x5e\x0a\xdd\xf6\x15\x0c\xc9\x7a\x22\x56\x86\x59\x36\xb0\xf9\xd6\xf6\x1c\xd3\xe8\x29\xe6\xfc\x3a\x66\xeb\xe2\xb0\xd3\x5c\x39\x0c\xba\xf2\xd5\xa0\x2e\xb7\x13\x03\x76\x39\x60\x96\x47\xac\xe2\x28\x67\xbe\xcc\x69\xde\x2b\x9d\x63\x15\x72\xb4\xa4\x3e\x56\x3a\x9f\x9d\xa8\x9c\x3d\x56\x60\xb5\x7b\x22\xf6\x1c\x5d\x83\xb6\x3f\xd9\x8e\xad\xc8\x6f\x63\xc6\xa7\xdb\xcb\x03\x9d\x49\xe2\x3f\x0b\xaa\xf0\xca\x9e\xd4\x99\x23\x4a\x29\x32\xf5\x12\xfc\xf8\xdc\xa6\xe2\x1e\x12\xa5\xf2\xd0\x4d\x18\x59\x62\x0e\xb9\xe0\x71\x11\xa1\x00\x51\x30\x45\x33\x0c\x2f\xed\x44\x73\x90\x7e\x52\x06\x58\x2c\x1a\x8f\xd8\x26\x08\x9a\x6b\x4d\x7d\x7c\xef\x95\x72\xf0\x81\x12\x4e\xba\x15\xbd\xb9\xd5\x78\x86\xb7\xf9\xcc\x7b\xd4\x3b\xc3\x74\xea\x14\xad\x27\x4f\x39\x53\xc8\x54\xed\x9c\xc5\xe2\x0a\x33\xbe\x45\xb0\xb3\x27\x7a\x1a\x38\x03\x73\x9f\x6a\x54\x96\x7b\x82\xc5\x7d\x68\xcc\x61\xc5\x0c\xf5\x15\x8f\x94\xb3\xce\x03\x6d\xef\x9d\x68\xb6\x9f\x52\x3a\xe3\x1e\xf6\x5c\x5b\x77\x08\x44\xee\x33\x48\xe7\x1c\x0e\xde\xcb\xd5\x21\xda\x7d\xe1\xee\x61\xba\x16\xea\x78\xac\xda\xef\x2c\x2d\xe3\x9a\xaf\xa5\xfc\x0f\x0a\x5e\x7b\x68\xdf\x91\x86\x11\x0a\xa1\x9f\x25\x1d\xbe\x1c\xae\xa6\xe2\x7e\xee\xf8\xcd\x7e\x34\xbb\xbc\x4f\x3a\x9a\x36\x27\x8c\x46\x53\x14\x42\xfb\x13\x52\x54\x26\x2b\x08\x8c\xf8\x16\xc5\x0e\x32\x1a\xc7\x29\xde\x13\x81\x10\x23\x49\xeb\x86\x5c\x25\x54\x3b\xb5\x51\xe5\xa0\x75\xa1\x6a\xb5\x6d\xd5\xf6\x82\x71\xb1\x00\xe3\xc2\x0d\x32\x14\x44\x61\x0c\xb7\x3b\xd8\xf0\x13\xfb\xcc\xf6\x23\x9c\x5d\xc0\xa7\x8b\x1b\x78\x7b\x76\x7e\x13\x06\xae\x11\x0d\x4f\x79\xbe\x13\x74\x93\x98\xf7\x74\xf3\x4e\x09\xcd\x2d\xbb\xb3\xd6\x0a\x0d\x82\x9c\x44\x5f\x88\xfd\x9a\x70\x69\x7f\xdb\x74\x70\x93\x50\x09\x6b\x9a\x22\xdc\x13\xd9\x55\x46\x5b\xc4\x6a\x03\x8a\xf3\x34\xd4\xe9\xe3\x6d\x4c\x15\x65\x1b\x50\x0d\x5d\x66\xb4\xc9\x85\x0e\x89\x75\xa1\xf4\xd4\x7d\x82\x0c\x76\xbc\x00\x81\x27\xa2\x60\x1d\x4e\x4e\x84\x51\x9b\xb0\x38\x08\x02\x9a\xe5\x5c\x28\x98\x06\x00\x13\x86\x6a\xa1\x73\xc8\x44\x0f\x36\x54\x25\xc5\x6d\x18\xf1\x6c\xb1\xe1\x27\x3c\x47\x46\x72\xba\xd0\x3a\x1d\x58\x46\x21\xb8\x90\x07\x36\x6c\x49\x4a\x63\xa2\xf0\xc0\x16\x1b\xfe\x8f\xef\x58\x48\x8c\x0a\x41\xd5\x6e\x12\x74\x12\x99\xbd\x5b\x9c\x9b\x93\xd9\x8b\x4a\x73\xfb\xd0\x5f\x09\x86\x12\x53\x4d\x7b\xf4\x05\x77\x73\x38\x32\xd7\x3d\x0d\xee\xb0\xc3\x44\xaf\xda\xfe\xc4\xe7\x67\xb7\xef\x71\x9d\x05\x41\xab\x92\x4b\xca\xd2\xbe\x7a\xef\x27\x4f\x97\xb8\x74\xde\x6c\xde\xc5\x75\x17\x70\x14\x5e\x92\x0d\x65\xc4\xbc\xde\x84\xe7\xf2\xba\x88\x22\x94\x7e\xe7\x7c\x49\x36\xf8\x81\xb2\x2f\xf5\x35\x88\x40\xaa\x7f\x2b\x0e\x84\x71\x95\xa0\x80\x5c\x83\x8f\xaf\x6d\x8c\xc9\x22\x55\xcd\x75\xc1\x90\xd9\xec\xce\xd7\x8f\xd7\xe6\xb9\x96\x69\xc2\x91\x80\xc0\xb4\x56\x2a\xa5\x5f\x50\x43\xe7\x41\x4d\x80\x0b\x98\xe4\x02\xb7\x93\x97\x54\x33\xef\x2c\x53\xa3\xf4\x48\x37\xf9\xf9\xea\xc3\x5c\x8b\xb7\x9f\x92\x0f\xb6\x35\x26\xdf\x6d\xb0\x9b\xec\x0e\xf4\x30\x75\x7e\x31\x26\x5c\xae\x60\x9d\xa9\xf0\xba\xf6\xf4\x74\xf2\xd3\xf7\xf2\xf5\x8f\x5a\xee\xea\xfb\xbb\x89\xce\x70\x1b\x6c\x3e\xab\x1a\x7d\x66\xe3\x49\xdd\x18\xda\xff\x0c\x63\x24\x0c\x27\x71\xb3\xf7\x07\x98\xcc\x61\x02\x3f\x18\x6f\xb6\x09\x78\x78\xf3\xca\xed\x3a\xd8\x9c\xb5\xc8\xf4\xd1\x75\x6a\xde\x42\xe2\x0f\x3c\xaa\xbd\x39\xd5\x55\xd1\xa8\xd2\x5b\x6b\x40\x3c\xb3\xd1\x90\x3a\xa2\xe5\x6a\x80\x53\x8b\x50\x37\x75\xb1\xee\x5c\x40\x1a\xfa\xd0\xa3\x79\x0e\x1c\x1d\x8c\xd7\x45\x9a\x42\x21\x52\x47\x54\xbf\xef\xc4\x1a\xed\xbc\x10\x91\x85\x6d\x42\xa3\xa4\xc7\xb3\xd5\xe1\x22\x47\xd1\x28\xc1\x9b\x81\x09\x4f\x39\x77\x45\x08\x41\xea\xda\x88\xa6\xb5\xd4\xc3\x84\x4b\x57\xbe\xee\x0a\x94\x0a\x84\xc9\xe5\x52\xd7\x04\xc5\x5f\x18\x06\xad\xc1\xa6\x02\x8e\x6d\x57\x67\xd8\xb7\x89\xaa\x51\xfc\x92\xe8\xb8\x11\x24\x93\xc3\xff\xdc\xe1\xdd\xa6\x3a\x2d\xcb\xa1\xb0\x71\x16\x5c\xae\xf6\x1e\x92\x86\xed\xf5\xf9\xea\x43\x09\x8f\xa9\xd6\x95\x66\x3e\x03\x2f\xfb\xda\xce\xdb\x0c\x3a\x0a\xfa\x11\x8d\x7c\x14\xad\xdc\x11\xc2\x8f\x85\x54\x53\x37\x78\xa3\xbb\xd3\x77\x45\x9a\xbe\xe3\x62\x2a\x66\xb3\x26\x80\x9f\x15\x3c\x9d\x30\x72\x2f\x59\x2e\x38\x1e\x4f\xf0\xc3\x04\xc8\x62\xa8\xaa\xe0\x7f\x03\x00\x9f\x91\xc9\x08\xee\x26\x00\x00")
 
 func templatesServerResponsesGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -491,12 +703,12 @@ func templatesServerResponsesGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 8531, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 9966, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerServerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7c\x71\x73\xdb\x36\xb2\xf8\xdf\xd2\xa7\xd8\xd3\xdd\xa5\x54\x87\xa2\xe2\xf4\x9a\xb9\xf3\x8d\x7e\x33\xaa\xe3\x24\xfe\xc5\x49\x34\x91\xda\xbe\x37\x9d\x8e\x03\x93\x90\x84\x67\x0a\x60\x01\x50\xb2\xea\xd1\x77\x7f\xb3\x00\x48\x82\x14\x65\xd9\x6e\xda\xbe\xd3\x4c\x2c\x11\x58\x2c\x76\x17\xbb\x0b\xec\x2e\x98\xe1\x10\xce\x44\x42\x61\x41\x39\x95\x44\xd3\x04\xae\xb7\xb0\x10\x03\xb5\x21\x8b\x05\x95\xff\x86\x57\x1f\xe1\xc3\xc7\x19\x9c\xbf\xba\x98\x45\xdd\x6e\xf7\xee\x0e\xd8\x1c\xa2\x33\x91\x6d\x25\x5b\x2c\x35\x0c\x76\xbb\xe1\x10\xee\xee\x20\x16\xab\x15\xe5\xba\xd1\x77\x77\x07\x94\x27\xb0\xdb\x75\xbb\xdd\x8c\xc4\x37\x64\x41\x11\x38\x1a\x4f\x2e\x26\xee\x11\xfb\xd8\x2a\x13\x52\x43\xd0\xed\xf4\x62\xb9\xcd\xb4\x18\xea\x54\xf5\xba\x9d\x5e\x2a\x16\xf8\xc5\xa9\x76\x5f\xc3\xa5\xd6\x19\xfe\x56\x5a\xc6\x82\xaf\xf1\x27\x95\x52\x48\x03\xae\xd9\x8a\xf6\xba\xdd\x2e\x40\x6f\xc1\xf4\x32\xbf\x8e\x62\xb1\x1a\x2e\xc4\x40\x64\x94\x93\x8c\x0d\x91\xaf\x5e\x17\xc0\xf1\xf1\xbd\xa2\x6f\xc4\x54\xcb\x3c\xd6\xaf\x53\xb2\x50\xb0\xdb\xcd\xcd\xb7\x3f\xfc\x7f\xa8\x52\x74\x9d\xdc\x20\x1e\xd3\xeb\x10\x20\x63\x83\xdd\xee\xf0\x64\x32\xe7\x48\xd0\x10\x07\xd1\x5b\x5d\x9f\x77\xe2\x4f\x58\xc3\xa0\xb2\xf9\xc9\x37\xc3\x0c\xdb\xf7\x66\x5a\x48\x12\xd3\x79\x9e\xd6\x06\xe8\x6d\x4a\xe5\xf5\xb0\xe8\xeb\x75\xed\x28\x49\xf8\x82\x42\xf4\x8a\xce\x49\x9e\xea\x0b\x23\x62\x9c\xf0\xee\x0e\x32\xc9\xb8\x9e\x43\xef\xef\xbf\xf4\x20\x02\x83\xb9\x5a\x29\x6f\xf0\xdf\x6e\xe8\x36\x84\xbf\xad\x49\x9a\x53\x38\x1d\x41\x54\xc3\x82\xbd\xb0\xdb\x41\x03\xa1\x03\x6f\x60\xed\x77\xbb\xb1\xe0\xca\x2c\xb2\x8a\x97\x74\x45\xdf\xce\x66\x13\x80\x11\xf4\xdc\x92\x56\xad\xd3\xa2\x55\x95\xcd\xdf\x73\x76\x6b\x80\x73\xce\x6e\x7b\x88\x6d\x4d\x24\x24\x96\xb7\xa9\x01\x51\xf0\xd3\xcf\x4a\x4b\xc6\x17\xdd\xee\x3c\xe7\x31\x30\xce\x74\xd0\x87\xbb\x6e\xa7\x01\x37\x2a\x21\xef\xdc\x8a\x04\x4b\xa2\x2e\xb8\xa2\x71\x2e\x29\x44\x0e\xae\x8f\x74\x77\x3c\xba\x42\xcb\x8e\x61\xde\x0d\x9a\x1e\x19\x32\x0d\x4b\x11\xb8\x41\xb1\xe0\x9a\x30\xae\x20\x3a\xbf\xd5\x92\x14\x34\x59\xc6\x6a\xe3\x91\xe7\x6a\x78\xb7\xb3\xeb\xee\x4a\x0b\xf4\x35\xc8\x88\x22\x70\x1d\xe7\xb7\x71\x9a\x27\x74\x9a\xd1\xd8\x2e\x81\xca\x68\xfc\x9a\xa5\x14\x8a\x8f\x93\x91\xb7\x38\x94\x93\xeb\x94\x26\x97\x4c\x69\xf4\x03\x9e\x20\x01\xe2\x94\x12\x9e\x67\x33\xb6\x12\xb9\xc6\xe1\xa8\xd2\xd1\xab\x5c\x12\xcd\x04\xef\x02\xac\xc8\xed\x5b\x4a\x12\x2a\xa7\xec\x57\x33\x89\x53\xf7\xe8\xbb\xad\xa6\xd8\x86\xea\xa8\x44\x7c\x43\xf5\x84\xe8\x65\x31\x7d\x17\x60\x29\x94\xde\xa7\xca\x78\x82\xe2\xc3\xb8\xee\x02\xa4\x86\xb0\x4b\xb6\x62\xba\x68\xba\xa1\x34\x1b\xa7\x6c\x4d\xdb\x48\x92\x94\x24\x33\xb6\xa2\x86\xe2\x66\xe7\x46\x32\x4d\x8b\xde\x7a\x67\x17\x40\xa7\xea\xad\x4f\x96\x47\x98\x4e\xd5\xc4\xa7\xad\x20\x45\xa7\xea\xd2\x27\xd0\x6b\x7f\xe7\x53\xb9\x4f\x8a\x4e\xd5\x27\x9f\xd4\x56\x88\x1f\x7d\x7a\x5b\x21\xce\xa8\xd4\x6c\xce\x62\xa2\x69\x93\x60\xaf\xeb\x1d\xdd\xd6\xbb\xc6\xb5\x71\xae\xab\xdf\xb4\x9d\xe6\x02\x8f\xf6\xd6\x37\x38\x79\x6e\x3e\xfd\x43\x1a\x88\x03\xa2\xa9\xc1\xff\x03\x91\x93\xe0\x59\xa1\x92\x21\xf4\xf0\x67\x2f\x84\x5e\xf1\x4f\x2f\x29\xb8\xcd\xc7\x68\xae\xa5\x8f\x09\x0e\x5a\x80\xa2\x72\x4d\x7b\xfd\x9a\x5f\xe9\x76\x3c\xf4\xd3\x94\xc5\xf4\x07\x22\x83\x67\x4d\x95\xc6\xa9\x8c\x51\xf5\xc2\x86\xd7\x70\x93\xa6\xa5\xf2\x6b\x01\x76\x74\x08\x7a\xc9\x14\xc4\x84\xc3\x35\x05\x49\x33\x6a\x76\x48\xc2\x93\x02\x85\x01\x36\x24\x3b\x2b\x66\x1c\x9a\x1c\xf4\xfa\x8e\xc4\x62\xd1\x0c\x7d\x35\xb3\x0a\xa1\xe7\x9e\x07\xda\x2e\x74\x2f\x84\x93\xe7\x5f\x9b\xb5\x9e\xd2\x58\xf0\x24\x84\x9e\xf1\xef\x90\x51\xc9\x44\x02\x73\x21\x61\xb3\x64\xf1\x12\x29\xd8\x10\xa6\xe1\x9a\xce\x85\xa4\xa0\x96\xb9\xd6\x8c\x2f\x20\x11\x1b\x47\x0c\x4a\x4d\x96\x64\x98\xe9\x6b\x6b\x1a\x42\x6f\x45\x6e\x07\x4b\xd3\x30\x50\xec\x57\x14\x52\x0f\xfd\x94\x14\xa9\x32\x38\x56\xe4\x96\xad\xf2\x15\xf0\x7c\x75\x4d\x25\x88\x39\x5c\x6f\x35\x55\x1e\x7e\xd8\xb0\x34\x35\x96\x07\x19\x91\x0a\x29\xc0\x4e\x49\x7f\xc9\xa9\xd2\x60\x91\x7f\xa5\xe0\x86\x6e\x95\x11\xa1\xd9\x25\x54\x08\x8c\xa3\xba\x34\xe1\x53\xc6\x69\x04\x17\x1a\x12\x41\x15\x70\x81\x2d\x68\x5d\x66\x42\xd4\x43\x31\xaf\xc1\x5f\x8b\x64\xdb\xeb\xd7\xb5\xc1\x70\x5a\x79\x1e\x54\x01\xf3\x30\xc8\x88\x5e\x22\x8b\xc3\x35\x91\xb8\x4b\x0f\xb5\x48\xc4\x00\x35\x20\x42\x88\x42\x0f\xd1\x23\x3b\xcf\x85\x52\xb6\x1a\x02\x82\xb7\xce\x83\xce\x2c\x84\x1e\x7e\xe1\xf8\x54\xc4\x24\x2d\x1e\x10\xd9\xc5\xa4\x89\xc3\xa2\xb8\xe0\xda\x8c\x47\xb7\x17\x42\x0f\xbf\x7a\x21\x3c\x77\xa3\x8c\x33\xf4\xc7\x99\x85\x67\xc5\x4e\x15\x0b\xce\x69\x8c\x4a\xa5\xc2\x9a\x4e\x12\xdc\xc0\x13\xb1\xb2\x52\xde\x9b\xcc\x73\xa8\x48\xab\x79\x1a\x18\x01\xbb\xb9\x2b\x61\x57\x2b\x2e\x72\xad\x34\xe1\x66\xa9\x9c\xd8\x55\xbb\x72\x97\xce\x39\x84\x1e\xfe\x1e\x10\x7c\xe8\x85\xf0\x8d\x55\xe9\xf7\x8c\xe7\xda\x58\x3f\xd5\x56\x87\x66\x67\x13\xa8\x20\xc1\x59\x81\x42\x86\x49\x1c\xd3\x0c\xed\xce\x63\xd6\x68\x46\x26\x73\x4e\x15\x24\xa8\x72\x38\xde\xeb\x87\x00\x68\xb4\x88\x20\x4e\x85\xd1\xc4\x94\x64\x5a\x64\xb0\x62\xc9\x00\xcd\x22\x15\x24\xe9\xb7\x93\xee\x6d\x1d\x21\xf4\xf0\xc9\x33\xc9\x6f\x9a\x26\x59\x98\x45\xe2\x50\x14\x46\xa8\xd9\x0a\xa7\x45\x8f\x6d\x2c\xa2\xae\xac\xed\x33\xfb\xfb\x52\x08\x3d\xf3\xf8\x1b\xe7\x36\x38\xaa\xc9\x55\x26\xb8\xa2\xad\xda\xeb\xb6\x3d\xd4\xba\x54\x0d\x9e\xac\xc4\x6e\x8b\x74\x68\x1e\xa4\xcb\x4f\xd4\xe4\x3a\xed\xde\x4e\xe6\xe6\x8e\xab\x16\x7f\x6b\xf1\x9a\x11\x79\xae\xe8\x01\x22\x8e\x4f\xf4\x0e\x8f\xc6\x66\xae\x1b\xba\xf5\xe7\xc8\x24\x5b\x23\x7e\x3c\x1d\xb7\xce\x71\x64\x8a\x71\x0b\x37\xe4\x10\x13\x24\xd7\x4b\x21\x99\xde\xc2\x1c\xcf\x78\x5a\xe0\x56\x95\x2b\x9a\xc0\x86\xe9\x25\xac\x72\x9d\x93\x14\xb7\x7b\x03\xd9\xb6\x60\x97\x35\x5f\x80\xb3\x7d\x71\x7f\xe0\x9f\x84\x4a\x99\xfd\x47\xb9\x85\xfa\x49\xcd\xf1\xf0\x47\x7a\x87\xc6\x41\xd0\x51\xf0\x7b\x3a\x89\x9d\x3b\x09\xda\x83\xe1\x39\x5f\x7f\x5c\x53\x29\x59\x42\x03\x21\xd9\xc2\x35\x1b\x5b\x2d\x7f\x9b\xbd\x3d\x8a\x22\xfb\xdc\x77\xed\x18\x80\xa1\x01\x5c\x85\x70\x83\x41\xa4\x0d\x2d\x0d\xec\x5d\xb7\xd3\x61\x73\x10\x2a\x7a\x43\x35\xe5\xeb\xe0\xa6\x0f\x7f\x19\x41\xaf\x67\x7a\x3a\x92\xea\x5c\xf2\x5a\x77\xb7\xd3\x31\x91\x10\x0e\xc3\xa9\x2d\xf4\xb3\x67\x60\x88\x1a\x95\x63\xdd\xd0\x84\xce\x0d\x74\x81\x49\xb2\x45\xc9\x18\xe3\x7a\x8f\x2b\xc6\xb5\x65\xc9\xfc\x68\xf2\xc3\xb8\x7e\x3a\x33\xeb\x10\xa8\x94\x38\xc6\xa5\x2e\xa2\xb1\x16\x2c\xf0\xc1\x91\x3b\x44\x81\x70\x7f\x19\x01\x67\xa9\x1d\xda\x99\xaf\x74\xf4\xda\xc4\xd8\x29\xc7\x11\x53\x9d\x50\x29\x43\xb8\x09\xa1\xc7\xec\xf1\x88\xa0\x83\x64\x89\xb3\xcf\x9e\x41\xd5\x11\x2a\x3a\xbf\x65\x3a\x38\x31\x8f\x3b\x4f\xa6\xeb\x16\x41\x3e\xf7\xe5\xf8\xfc\xb8\x18\xbd\x43\xf8\x70\x08\x1f\xe8\x66\x6a\x4f\x82\xb1\xc4\x83\xb2\x02\x02\x9c\x6e\x80\x64\x0c\x8f\xeb\xcb\x7c\x45\x38\x1e\xdc\xa2\x0f\x64\x45\x61\xb7\x2b\xce\x8d\xd7\xb9\x77\xc8\x8b\x05\x9f\xb3\x05\xfa\x49\xa6\xed\x2a\x95\x68\x03\x44\xf4\xf5\xdd\x1d\x44\x55\xe6\x28\xba\xbb\x83\x8c\xa8\x98\xa4\x3e\xe6\xf1\xe4\xa2\x0f\x5f\x3b\x62\xee\xba\x1d\x85\x42\xe7\x74\x13\xd8\xa6\x7e\x7b\x1e\x06\xa3\xd4\xe8\xbc\x19\x09\x8f\xa0\x19\x49\x74\x3b\x2a\x3a\x2b\x4f\xef\x26\x2a\x1b\xd5\xa3\x64\x84\x78\xdf\x08\x9a\x6a\x07\x6e\x04\x98\x56\x11\xf1\xc8\x0b\x8f\xb1\xcb\x04\xa0\xa3\x16\xc3\x73\x67\x4c\xdc\x07\xde\x7e\x9c\xce\x70\x91\x55\x64\x62\xd2\x51\x53\x9b\xed\x71\x12\x5d\xf7\xe4\xe3\x27\x07\xe9\x47\xa9\x23\x3f\xa8\xc6\xce\x2a\x54\x1d\x55\xc1\x35\x76\xf8\x11\xea\xc8\x0f\xad\xb1\xb3\x16\x9c\x8e\x6a\xb1\x35\x76\xcf\x2e\xa7\x07\x99\x29\x8f\x1c\x96\xe1\x10\x7a\xb3\xcb\xe9\x95\xe1\xab\xc6\xdf\xec\x72\xda\xce\x62\x79\xd8\x78\xee\xc6\x56\x9c\xce\x2e\xa7\x7e\x70\x7b\x60\xfa\xfa\x3e\xdb\x73\x58\xce\xce\x3f\xcd\x2e\x5e\x5f\x9c\x8d\x67\xe7\x6d\xc8\x30\x8c\x3e\x8e\xcf\x1e\x0e\x0a\x94\x93\x4f\x17\x3f\x8c\x67\xe7\x57\xef\xce\xff\xdb\x44\xaf\x16\xe7\xf8\x21\x24\x8e\x0f\x10\x39\x6e\xa5\xb3\xbe\xc2\xf5\xcd\xdd\x81\xf8\xeb\xec\xef\xcb\xae\xbb\xbe\xda\xf5\x6d\xcf\x81\x34\xd6\xbc\xb1\x33\x1d\x4c\x43\x45\xe6\xf7\xa8\xcc\x47\xf9\x51\xbc\x97\xe4\x52\x11\xda\xf9\x08\xdd\x46\xe9\x6f\x54\xd7\xba\x98\xb3\xc2\x3b\x8c\x27\x17\x95\xab\xb0\x47\x05\x6c\xc2\xb0\x72\x49\x78\x92\x52\xa9\x22\xeb\x3e\x02\x55\x78\x82\x7e\x6d\xb8\xcb\x6d\x00\x12\x6b\xa7\x2c\x1d\x6e\x99\xf8\x89\x1c\x2e\xb4\x6f\x7f\xa8\x81\xef\x1b\xb8\x5d\x93\x32\xeb\x4d\x1a\xb4\x91\x24\x61\xb8\xfb\x92\x14\x6c\x7a\x39\xa1\x73\xc6\x6d\xb2\x1d\xfb\x4b\x9a\xe1\x03\xa5\x89\x72\xa7\xb8\x98\xa4\x29\xc2\xb8\x1d\x1b\x4f\x96\x44\x2a\x2a\xa3\x09\x7e\xdd\xc3\x9e\xa1\xe1\x38\x83\x71\x1d\xbe\x85\x2b\xe7\x42\x71\xbf\xc3\xe9\x5b\xbd\xf8\x78\x72\xd1\xd5\xdb\x8c\x16\xc0\xca\x64\xd3\xd1\xed\xee\xf9\xd2\x32\xe9\x7a\x30\xf9\x0e\x9f\x53\xc1\x17\xa7\x45\xaa\x06\x12\xaa\x62\xc9\x32\x94\xdd\xe9\xef\x9c\xa5\xf9\xec\xe9\x60\xc3\xbd\x37\x92\x6e\xf7\x90\x0f\x50\x70\xd0\xcc\xe7\xd4\x59\xf9\x8d\xa9\x9c\x82\xb1\xd3\xde\xc9\x73\x55\xa3\xfc\xfd\xb1\x64\xec\x71\xd9\x37\x53\x41\x75\xca\xff\xf3\xb2\x42\x91\x2f\xae\xf7\xec\x3b\x5f\x5e\x5d\x00\x6f\x1f\x6e\x39\x14\x94\x0a\x4b\x53\x45\x8b\xea\x50\x84\xee\x8b\xa3\xfe\x17\xea\xea\xa5\x95\xf6\x75\xf6\x60\x1a\xa9\xa2\xab\x4c\x44\xdd\xdd\x41\x42\xd4\x92\x4a\xdf\xc6\x6c\x52\xca\x5f\xe6\x44\xac\x08\xe3\x96\xf4\x4b\xe0\x54\x47\x85\x95\x75\xbb\x1d\xb3\xe3\x3e\xd4\xd2\x4c\x74\xbf\x4f\x73\x33\xd0\xaf\x48\xad\x72\x02\x40\xf9\xfa\xd4\x6e\xd4\x3e\x6d\x66\xb3\x66\x5c\x3f\xc8\x4c\x4c\x8a\x60\x7f\xfa\x2f\x94\xf6\xb2\x14\x9a\x63\x81\x4f\xe1\x65\xa3\x9c\x70\x3f\xa5\xee\xe3\x08\xae\xc5\xc6\x75\xc2\x1f\x1c\x23\xfb\xb4\xbc\x3b\x58\xc7\x38\xbe\x76\x5e\x0c\x5d\xa7\xe4\x4f\x8d\x9f\x2b\x55\xf9\x66\x55\x63\xf5\xd3\xc1\xaa\xcc\x71\x56\x6b\xa1\x76\x9d\xd9\x27\x46\xd9\x1e\x99\x0d\x1f\xfa\xe3\xc1\x02\xd1\x71\x3a\xeb\x01\xf9\xa3\x09\x6d\x8f\xc5\x2b\x52\x5f\x36\x48\x5d\x6a\x9d\xd9\x7d\xf7\x12\xa0\xe9\x07\x8a\xc3\x77\xb3\x94\xf5\x00\x75\x77\xdc\x94\xb9\xc0\xa3\x0e\xc2\x9e\x11\x52\x15\xc2\x66\x49\xb9\xf1\xcc\xae\x7a\x43\x13\x60\xfa\x2b\xb7\x27\xa0\x3f\x23\x0a\x06\x03\xcf\x81\x94\xa7\x7e\x9f\xb1\xe2\xd0\x5f\xab\xb5\x3d\xc8\x4e\x7d\xda\x1f\xe5\x5d\x9e\xe4\x5b\xca\xb0\xa3\x41\x7c\xa3\x24\xf7\xe4\x9d\xa5\x99\xca\xdc\x67\xe6\xe1\x19\xcd\x8a\x62\x3f\x74\x38\x4c\x38\x46\x3a\xbf\x89\xf0\x1b\xba\x6d\x93\xfe\x43\xd3\xa3\x9e\x84\xbd\xf8\xa9\x49\x6f\xbd\x8a\xf9\xdb\x04\x4d\x8e\xc8\xf7\x91\xc9\x56\x4f\xe0\xe3\x43\x32\x07\x68\x84\x6d\x4f\x54\xf5\x2f\xbd\x2f\xd5\x22\xc5\xfd\xe2\xf5\x7d\xf4\xd5\x34\xe0\xff\xe2\x0e\xd5\xe0\xf3\xbe\x12\xfc\x03\xf9\xfc\xf2\xdb\x53\x83\xc6\xfb\x2e\x01\x3c\x90\xc6\xdf\x65\x6b\x6a\x6e\x46\xaa\xdc\x8d\x1a\x9b\x51\x6b\x5a\xc0\x7c\x3d\xfd\xd4\x8d\x61\xdb\xbe\xc1\x1e\xb9\x3d\xf0\xb9\x2d\xe9\x80\xe1\x71\xfd\xf3\xd0\x44\x63\xb7\x53\x24\x09\xaa\x0f\x0a\x22\x7a\x6b\x9b\xb1\x5f\x55\x21\xf0\xb5\x10\xa9\x0b\xae\x2f\xc5\x62\x0e\xa9\x58\x28\x58\x51\xa5\x70\x1a\xca\xf4\x92\x4a\x58\x33\x52\x26\x08\x72\x45\x25\x02\x21\x43\xc2\x76\xa9\xad\xd2\x74\x05\x82\x53\x94\x1b\x17\x35\x18\x56\xe6\x16\x5a\xf2\x1f\x38\x63\x50\x65\xeb\x89\x5c\x98\xec\x36\xe3\x9a\xca\x39\x89\xe9\xdd\xce\x5c\x96\x6a\x26\x0c\x9e\x3d\xb3\xcf\xd1\xa5\x9d\xc3\xcb\x4c\xfb\xed\xc1\xdc\xa2\x8c\xa2\xa8\xdf\xed\xec\xec\xda\x21\x50\x2a\x16\xd1\xc4\xdc\x0f\x6b\x80\x38\x41\xbc\x26\x9a\xa4\xbf\xaf\x28\x86\x43\x38\xbf\x65\x5a\x59\x3f\xcd\x05\x1f\xfc\x4a\xa5\x00\xa5\x89\xce\x15\x90\xb9\xa6\xd2\x5e\x61\x63\x7c\xb1\x2f\x37\x4b\xe0\x1f\x24\x39\x54\x20\x3f\x6d\xdf\x10\x64\x41\x4b\x9b\x20\xa7\x54\xb7\x24\xc6\xca\x40\x5c\x2f\xed\x73\x79\x2e\x1b\x4f\x2e\xee\xcb\x38\x19\xf6\xf7\xa5\x61\x67\x79\x64\x36\xde\x0a\xc7\x64\xf6\x1a\x32\x00\xf3\x6c\x9e\xaa\x74\x9b\x6d\xb1\xd9\x3f\xe4\xaf\x91\x16\xac\x09\x75\x04\x95\x82\x75\x3b\x07\x93\x76\x26\xb9\x55\x94\x7e\x7c\x7e\x96\x44\xd9\x9b\x40\x81\x4d\x0f\x41\x51\xed\x41\x5b\x35\xe5\x03\x97\x35\x3a\x1d\xb5\x14\x08\x0c\x5f\x29\xe5\x6e\xb0\xea\x57\xb5\x13\x55\xde\x36\xac\x5f\x38\xb2\x0c\xb9\x22\xd2\xba\x2a\x22\x15\xf0\xae\x8e\xb4\x46\x4c\x8e\x24\xbf\x1a\xa6\x65\x4e\xcb\xe2\x8d\x6b\x9b\x93\x54\x51\x3f\x69\x67\xd3\x8e\x19\x6b\x5b\x3e\xb9\xa6\x41\x1f\x02\x2a\x25\x98\x9b\xb3\xc5\xea\xfc\x05\x65\xe7\xf9\x2a\x47\x07\xc2\x21\xe7\xb6\x23\xe8\xff\xbb\x59\x9e\x02\x70\x54\x50\x29\x0b\xc2\xba\x9d\xe1\x10\x14\xd5\x05\xeb\x45\x8a\x33\xb4\x86\x8a\x06\xab\xb0\xdf\xd9\x4c\xb9\x66\x15\xd6\xd2\x96\xbc\xb6\x4e\x35\x91\x90\x2a\xfa\x40\x37\x41\x2f\x26\xfc\x2b\xed\x4a\x4e\x86\xeb\xbd\x19\x89\x42\xd7\x80\xa8\xec\x9c\x3d\x5b\x44\x34\x2a\x37\xa5\xda\x39\x6a\x9b\xff\x8c\xac\x78\x38\x4b\xfb\x7d\xcb\xc7\x9a\x48\xd8\x2c\x40\x6d\x79\x1c\xfd\x48\x98\x7e\x23\x45\x9e\x75\x4b\xba\xeb\xba\xf3\x3d\x67\xb7\x46\x9c\xb5\x0c\x0d\x2e\xf1\xb3\xe2\x76\xae\x9d\x41\xde\xd9\xaf\x53\x53\x97\x32\x1b\x86\x5b\xa0\x5d\x63\x70\x55\x49\xfa\xce\xe4\xd6\x4c\x19\x24\x68\x14\x98\xfa\xcd\x41\x6f\x4b\x23\x28\x85\xdb\x04\xb9\x14\x8b\xd7\xa8\x1c\x66\x6d\xc5\x62\x6e\x65\xce\xb8\x7e\xf9\x8f\xa0\x59\xe1\xea\xc3\xff\x73\x4a\x5d\xc7\x51\x55\x01\x9a\x23\x0a\x11\x97\x36\xe8\x8a\x78\xfe\xf0\xd0\x5d\x7a\x0d\x9d\xc9\x05\x7e\x55\xac\xdf\xc7\xe1\x9b\x45\x34\x4e\x12\x5b\xbf\xb4\x64\x06\x3d\xc4\x64\xca\xca\x6d\xe9\x68\xa2\x4d\xbe\xed\x74\x38\xfc\xbb\x42\xc4\x3e\xc6\x6e\xa7\xb3\x10\x80\x16\x11\xa4\xb5\xf3\x49\xdf\xaa\x71\x42\xe7\xe8\x28\x17\xd1\x2b\xc1\x69\xd0\x37\x6d\xce\x00\x4e\x47\x50\x63\xdc\xaa\x49\xba\x6f\x0c\x1d\x55\xb8\xe8\xde\xdf\xd7\x3d\x53\xf2\xb5\x88\x76\xe6\x6f\xc9\x83\x16\x59\x46\x13\xe3\x9c\x9f\xca\xcb\x2e\x50\x51\x2d\x11\xe8\x34\xb6\x55\x33\xdf\xce\x66\x13\xab\x99\x55\xce\xe0\xd1\x7a\x59\x0d\x7d\xb0\x56\x7a\x43\xea\xa5\xa5\x5a\x61\xb1\x0e\xd8\x28\x30\xd5\xab\x8c\x75\xd0\x29\xd5\x65\x98\xa2\x9c\x6f\x0e\x0a\x1d\x2e\x7b\x8c\xfa\x36\xa8\x99\x9d\x4d\xfc\x52\x98\x07\x5d\x38\x1f\x3f\x2a\x2b\xd5\xdf\xc3\x50\x2f\xb6\xd5\xe0\x0b\xed\x7f\x88\x41\xf9\x34\x1d\x35\x27\x0f\xb8\xdd\xc4\x7d\xfa\x9a\x06\xde\x62\x8e\x15\x78\xe8\xee\xe1\xa3\x9e\x79\x69\x25\x34\x3f\x19\xf4\xdd\x1d\xa5\xe0\xe9\x56\x89\x38\x2b\x4d\xde\x9f\xe1\x1e\xeb\x74\x8e\x67\xcf\x3a\x3b\x95\x71\xd6\x54\xe2\x90\x69\x1e\xb0\x4d\x7b\x2f\xe2\xb1\x96\xe9\xf3\x93\x7a\x3c\xa0\x55\x7a\xdc\x1d\xb3\xc9\x69\x65\x94\xea\x37\x58\xa5\x7a\x82\x59\xaa\x03\x76\x59\x0f\x89\x1b\xc0\x7b\xb6\xd9\x08\x4e\x1b\xe0\xf7\xda\xa7\x9f\x63\xa8\x99\xa8\x3a\x64\xa3\xfe\x88\xc2\x4c\x1b\xf9\x93\x9a\x5d\xa9\x03\xa6\x5a\x1f\x63\x0f\x2d\x0f\x36\x56\xf5\x30\x6b\xad\x03\x1f\xb6\x56\x75\xd0\x5c\x31\x14\x18\x0e\xe1\x82\xab\x8c\x49\x5b\x2d\x36\x03\x4e\x87\xc3\x6b\x3c\xf3\x5e\xa3\x92\x5c\x33\x6e\x5e\x02\x22\xf1\x92\x51\xd4\xd8\x41\x46\xe5\x9c\xc6\x7a\xa0\x54\x3a\x48\xc9\xb5\x1a\xa8\x58\x48\x3a\xc0\x00\x60\xb0\x10\x4d\x11\x5f\x4e\x6d\xdd\x18\x46\xf0\x4c\xa7\x2a\xb2\x4f\x86\xd9\xe1\x10\xce\x48\xae\xa8\x72\x31\x84\x2a\xf2\x75\x6f\xc4\x57\xaa\x3c\x67\xc5\x2c\x5b\x52\xa9\x72\xa6\x29\x64\x12\x8d\x94\xf2\x98\xaa\xd0\x61\xb0\x95\x4d\x22\x29\xe8\x1c\xc3\x38\x2d\x80\xac\x05\x4b\x80\x68\x4d\xe2\x1b\x15\xc1\x2b\x57\xcb\x5b\x9a\x54\x03\x87\x38\x65\x94\x6b\x15\x21\x82\x89\x41\x68\x69\x3d\x33\x13\x4d\x71\x22\x75\x6a\x8e\xc1\xc5\x1c\x1f\x79\xba\x35\x84\xc5\xb9\x5c\x53\xe5\xe6\x5c\x92\x35\x05\xa2\x14\x5d\x5d\xa7\x5b\x60\xab\x2c\xa5\x2b\xca\xb5\xc9\x07\x28\x37\xb2\x90\x67\xed\x05\xae\x94\xf0\xc5\x70\x21\x86\x5a\x52\x3a\x5c\x11\xa5\xa9\x1c\x2a\x19\x0f\xdd\xab\x69\x34\x4d\x59\xa6\x59\x8c\x28\xce\x70\xc2\x49\xc5\xf5\x29\xfc\xf4\xb3\x91\x22\xb6\x5f\xbc\xba\x2b\x7f\x4f\x5e\x7c\xfb\x72\x17\x56\xb9\x8e\xf7\x22\xa1\x92\xe3\x5f\x9b\x05\x04\x43\xce\xf7\x8a\xc2\xca\xf4\x98\x2c\x22\xfe\x2c\x49\xdc\xb0\x1b\x16\xad\xc4\xaf\x2c\x4d\x49\x24\xe4\x62\x68\x5e\x3d\x62\x7a\x3b\xb4\xe2\xb9\x9a\xb2\x84\x5e\xcd\x2e\xa7\x7f\xb5\xb8\xaf\x62\xb1\xca\x88\x66\xd7\x2c\x65\x7a\x8b\xc4\x7e\xa0\xb7\x7a\x22\x85\x16\x86\x4c\x97\x64\x31\x5e\x7f\x78\x12\x9d\xf4\x70\x07\x78\xd1\xdb\x85\x0d\xd1\x6c\x36\x9b\x48\x6c\x88\xca\xcc\xa4\x8c\x27\xf4\x36\xca\x96\xd9\x70\x26\x09\x57\x99\x90\xfa\xea\x92\x6c\xa9\xbc\x42\xcc\x36\x0b\x77\x75\xb6\xa4\x44\x5f\x4d\x97\x94\xea\xbf\x7e\xca\x53\x7a\x35\xb8\xc2\x25\xba\x9a\xe6\x99\x19\x30\xd5\x52\xf0\x85\x19\x21\x62\x91\x9a\xc5\x78\xcf\xf8\x0f\x54\x2a\x26\xf8\x29\xf2\x1e\xb9\x87\xd9\xe5\xf4\xe4\x45\xe8\xae\x2c\x0c\x87\x30\x5b\x52\x5c\xe7\x4a\xe7\x14\x28\x8b\x15\x5e\x0b\xb9\x21\x32\x81\x29\x8d\x25\x8d\xb7\xa7\x25\x07\x94\x47\x28\xbc\x8c\x26\xcc\x4a\x0e\x9f\x86\x0e\xfc\x4a\x59\x70\xb3\x9a\x35\x0d\xfb\xe9\xe7\x9c\x71\x7d\xf2\xd2\xee\x1d\x48\xd3\xec\x72\x7a\x75\x7e\xf6\xea\xed\x39\xfe\x9d\x8e\xaf\x7e\xbc\x98\xbd\xbd\x1a\x9f\x4f\xaf\x5e\x7c\xfb\xf2\xea\xcd\xd9\xfb\xab\xe9\xdb\xf1\x37\xff\xfc\x47\xd8\x32\xe0\xd3\xe3\xc0\x1b\xf8\x4f\x5e\xfc\xb3\x18\xf0\xe2\xdb\x97\x47\xf1\xb7\x80\xef\xfc\x17\xc9\xca\xc3\xc9\xde\x4d\xa9\xf2\x3a\x65\xdb\xb5\x27\xef\x32\x63\xab\x0b\x89\x3c\x78\x65\xee\xbc\xdd\xd0\xc0\xd9\x83\x7f\x8b\xe9\xa4\xdf\xad\x12\x65\xf7\x63\xf9\xe9\xf9\xcf\xa1\x8b\x40\x11\xcd\xa5\x20\xc9\x7f\x7d\xfb\xfc\x5f\xef\xe8\x76\x42\x98\x0c\x0e\xa7\x3e\x5d\x44\x51\x32\xdd\xe4\xe7\xf0\xc8\x7e\x39\x26\xbc\xe7\x35\xd1\x63\xf8\xdf\xd1\xed\x43\xa6\x70\xa1\x68\x79\x4f\x67\xaf\xa2\x51\xc8\xdc\x5d\xd9\x21\xd8\x17\xba\xef\x73\x7b\xf6\x61\x22\xd7\x2c\x35\xdb\xf8\x6b\x96\xd2\x47\x0b\x65\xfc\x48\xb1\x14\xab\xc7\xe6\x1e\x1d\x7b\xb7\xa7\xca\x64\x55\x50\x02\x15\x03\x77\x35\x6e\x26\x42\xa4\xc8\xc6\xed\xb7\xcf\xff\x85\x21\x7d\xd1\x16\xf4\xf7\xc0\xa2\x71\x96\x51\x9e\xe0\xa3\x7a\x2d\xc5\x6a\x72\xfe\xde\x61\x3f\xa2\x51\x66\x47\x39\x1b\xa3\x52\x56\xd8\x1e\x30\x64\x9c\x9b\xeb\x99\xa8\x7a\x9f\xe8\x2f\x39\x93\x74\xcc\x93\x1f\xa8\x64\xf3\xad\xc3\x49\xa5\x76\x57\xa6\xfc\xd3\xf5\xec\x72\x1a\xb4\xe2\xb5\x74\xb6\x4f\xf9\x5d\xce\xd2\x04\x4f\x98\x33\xe1\xad\x48\xd0\xef\x76\x5b\x2f\x05\x37\x92\x2e\x16\x28\xa5\xbc\x7d\xe2\x9a\x55\x79\x49\xaa\x56\x2f\x50\x5d\xa3\x6e\xed\x37\x57\x20\x3d\x10\xef\x5c\x5d\x94\x30\xcc\x79\xc5\xde\x75\xfb\x3c\x18\x34\xaa\x98\x9f\xcd\x4d\x1f\xd7\x7e\x43\xb7\x9f\x61\x43\x25\xad\x17\x8d\xdd\x05\xe6\x5d\xf7\x08\xfe\x56\xf4\x1b\xa2\xda\xb0\xed\x0e\xf0\xdb\xe4\xe7\x01\xd3\x59\xaa\x0f\x4f\xd3\x9a\xfb\xf0\x16\x26\x2c\xde\x6f\x2e\x82\x21\xf5\x7b\xc4\x5b\xaa\x1e\x70\xa9\x2f\x1d\x71\xa9\x3f\x3e\xe4\x52\xed\x31\x17\x5a\xe8\x07\xba\x29\x18\x08\xea\x0c\x87\xed\x16\xd7\x47\x6b\x34\xde\x77\xb3\x30\xb9\x3d\xe4\xd1\x99\x15\x67\x65\x9d\xc6\xde\x12\x28\x2e\xb5\xd7\x6f\x21\x16\x57\x23\xed\x01\xb9\xa5\xe4\xe2\x92\xa6\x36\x65\x69\xdc\x63\x11\x0a\x7a\x89\x56\x3c\xd8\x90\x54\x52\x92\x6c\x21\x11\x9c\x9a\x1b\x8d\xc6\x53\x78\xd4\x38\x52\xef\x8f\x24\xdd\x29\x09\xcf\x91\xc8\xb2\x7d\xc5\x9a\xcd\x2d\xff\xf6\x69\x43\x14\xff\x4a\x17\xb5\x91\xda\xd6\x63\xef\x6a\xd7\x76\x9c\xda\x1d\x6e\x73\x51\xdb\xb9\xbb\xf2\xbc\x6a\xe6\x71\x57\x29\x6c\xa9\xb9\x9c\xaf\xd6\xda\x98\xb7\x3d\x7a\xab\xfc\x52\xcb\x7d\xe6\xfd\x14\x4b\x9d\x08\x1d\x67\xa6\x96\x0c\xb6\x96\x5c\x92\xd1\x68\x6f\x23\xa4\x3d\x24\x6d\x50\x73\x38\x5f\xd4\xa4\xc4\x54\x74\x5d\xa9\xb5\xa2\xa3\xd6\x7a\x84\x0a\x2f\x02\xdf\xa3\xe3\xfe\x44\x5a\x93\x16\x5b\xb8\xdd\x23\xa6\xde\x7c\x84\x1a\x3f\xc2\xdf\x23\xe7\x58\xba\x6e\x77\xaf\xea\x16\x29\x73\xd4\xaa\x44\xac\xa6\x22\xbe\x29\x2c\xa3\x7c\x61\xa6\xf2\x4d\xc1\xfd\x19\x63\xa7\xcc\x74\xff\x40\xe2\xed\x96\xd5\x11\xa4\x91\x3f\x85\x51\x93\x82\xa3\x46\x57\x50\x9e\xde\x47\xb2\x8e\xb3\x5e\x68\x5a\xfe\xbf\x60\x1c\x6d\x68\x22\xa4\x0e\x8a\xf7\x1e\x8a\xd7\x81\x2e\xb4\x20\x81\x7d\x9f\xa3\xff\x38\x5e\xec\xa1\x22\x84\xac\x7a\xc5\x68\x43\x16\xd1\x34\x4b\x99\x2e\xa7\x2b\x48\xdc\xdf\x61\x1e\x2d\x35\xe7\x0f\x96\xee\xd1\xbd\x9e\x91\xb9\x47\xff\x52\xda\xa8\x94\xcc\xc3\xfd\x57\xf9\xda\xc2\x63\xc5\xe9\x3c\xd5\x9e\x44\xdd\x65\xb2\xa7\x08\x55\x2d\x43\x50\xf7\x8a\xd5\xa3\xf6\x0b\x48\xd6\x73\xb6\xcb\xaa\xc9\x09\x58\xf9\x12\x56\x95\x88\x3d\x12\x0a\x29\x37\x76\x98\x91\xab\x1a\xee\x6d\x6e\xd3\x65\xae\xcd\xed\x72\x57\x25\xc6\x83\x99\xb9\xba\x0e\x39\x7a\x31\x25\x72\x19\x53\xd5\x52\x45\x74\xe3\xbc\x9d\xad\xe3\x15\xd0\x64\xd5\xdf\x32\xe9\x9b\xb2\xe8\xe6\xc4\xa0\x80\x14\xe5\x3a\xc8\x95\xf9\xff\x6d\xcc\xc6\x4a\x55\x7b\x41\xbe\x42\x10\xf4\x6b\xb7\x2d\x90\x8c\xe2\x9d\x91\x32\xcd\x56\x16\xc7\x0b\x20\x92\xa6\x62\xa3\xdc\xad\x33\x7b\xc3\x9e\xb8\xed\xd2\x41\x08\x6e\x5f\x2a\x38\xb4\xad\x7b\x65\xc3\x62\x88\x4f\x46\xdf\x8a\xa3\xaa\x44\xd7\x49\x41\x97\x57\xac\x4d\x29\x01\x53\xba\x34\xde\xa8\xb8\x2b\x5e\x5a\xce\xde\xf4\x3e\x82\xa0\x0f\x81\x7f\x76\x0b\x1f\x5c\xd4\x3d\xbd\xb7\xaa\xeb\x2d\x5b\xe8\x57\x76\x2b\xf9\xd6\x7c\x67\xe8\xad\x2f\x5a\x73\x2b\x7f\xde\x51\xa1\x8d\x2d\x7f\xdc\x9f\xc7\x96\xe7\xbe\x7c\xa6\xca\xd3\x48\x0b\x4f\xea\x1e\xa6\xbc\x71\x7f\x2e\x4f\xaa\xc1\xd4\xff\x06\x00\x00\xff\xff\x9e\x1a\xe8\x57\xfb\x4b\x00\x00")
+var _templatesServerServerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\xbd\xf1\x73\xdb\x36\xb2\x38\xfe\xb3\xf4\x57\x6c\xf5\x5e\x73\x54\x8e\xa6\x1c\xf7\xda\xb9\xe7\x56\x6f\xc6\xb5\x9d\xc6\xdf\x3a\xa9\xc7\x72\xda\xf7\x9d\xbe\x8c\x4b\x8b\x90\x84\x67\x8a\xd4\x01\x90\x65\x9f\xeb\xff\xfd\x33\x0b\x2c\x40\x80\xa4\x24\xdb\x49\xda\xbb\x74\x9a\x88\x04\xb0\xd8\x5d\x2c\x16\x8b\xdd\x05\x38\x18\xc0\x61\x99\x31\x98\xb2\x82\x89\x54\xb1\x0c\xae\xee\x60\x5a\xee\xc8\x55\x3a\x9d\x32\xf1\x2d\x1c\xfd\x04\xef\x7e\xba\x80\xe3\xa3\x93\x8b\xa4\xdb\xed\xde\xdf\x03\x9f\x40\x72\x58\x2e\xee\x04\x9f\xce\x14\xec\x3c\x3c\x0c\x06\x70\x7f\x0f\xe3\x72\x3e\x67\x85\xaa\x95\xdd\xdf\x03\x2b\x32\x78\x78\xe8\x76\xbb\x8b\x74\x7c\x9d\x4e\x19\x56\x4e\x0e\xce\x4e\xce\xe8\x11\xcb\xf8\x7c\x51\x0a\x05\x51\xb7\xd3\x1b\x97\x85\x62\xb7\xaa\x87\x3f\xc5\xdd\x42\x95\x03\xb9\xbc\x52\x39\xf3\x5e\xa8\x5c\xe2\x13\xbb\x5d\xdc\xa4\x02\x7f\x4d\xe6\xba\x3e\x2f\xf1\xef\xbc\x9c\xe2\x3f\x05\x53\xf4\xcf\x60\xa6\xd4\xc2\xff\x3d\x58\x2c\x44\x39\xb1\x6f\x96\x22\xc7\x9f\xa5\x86\xb9\x48\xd5\x6c\x30\xe1\x39\xc3\x1f\xf8\x42\x2a\x31\x2e\x8b\x1b\xfa\xc9\x8b\xa9\xae\x26\xef\x8a\x31\xfe\xcb\x84\x28\x85\x7e\xa3\xf8\x9c\xf5\xba\xdd\x2e\x40\x6f\xca\xd5\x6c\x79\x95\x8c\xcb\xf9\x60\x22\x8b\x52\xf1\xc9\x9d\xfb\xd1\xab\x55\x98\x96\x3b\xe5\x82\x15\xe9\x82\x0f\xf2\x32\xcd\xe4\x86\x72\x1c\x12\x2c\xa6\x21\x78\x2f\xd9\x0f\xe5\x48\x89\xe5\x58\xbd\xce\xd3\xa9\x84\x87\x87\x89\xfe\xd7\x6f\xfe\x7f\x4c\x4a\x76\x93\x5d\x0f\xa6\xe5\x8e\x2e\x25\x00\x38\x26\x3b\x0f\x0f\xeb\x3b\x13\xcb\x02\x29\x1a\x60\x23\x3d\x1a\x7e\xbf\x67\x7e\x87\x01\x04\xb9\x98\xbc\xfa\x6a\xb0\xc0\xf7\x8d\x9e\xaa\xf6\x23\x95\x59\x08\xbd\xd6\xaa\x53\x91\x8e\xd9\x64\x99\x07\xb0\xd5\x5d\xce\xc4\xd5\xc0\x96\x61\xa3\xde\xb4\xcc\xd3\x62\x9a\x94\x62\x3a\xb8\x1d\xd8\xe1\xdd\xeb\xe1\x30\xdc\xdf\x83\x48\x8b\x29\x83\xe4\x88\x4d\xd2\x65\xae\x4e\xb4\x8c\x61\xa7\xf7\xf7\xb0\x10\xbc\x50\x13\xe8\x7d\xf9\x8f\x1e\x24\xf0\xf0\x50\x61\x60\x7f\x9b\xc6\xff\x79\xcd\xee\x62\xf8\xcf\x9b\x34\x5f\x32\xd8\x1f\x42\x12\x40\xc1\x52\x78\x78\x80\x1a\x40\xaa\x5e\x83\xda\xef\x76\xc7\x65\x21\xb5\x94\xcb\xf1\x8c\xcd\xd9\x9b\x8b\x8b\x33\x80\x21\xf4\x10\xeb\x9e\xff\x76\x64\xdf\x4a\xf7\xfa\x7d\xc1\x6f\x75\xe5\x65\xc1\x6f\xdd\xdb\x83\x6c\xce\x0b\x7c\x9b\xe2\x8f\x5e\xb7\xdf\xed\xde\xa4\x02\x32\x43\xf2\x48\xd7\x91\xf0\xeb\x07\x23\xbb\xdd\xee\x64\x59\x8c\x81\x17\x5c\x45\x7d\xb8\xef\x76\x6a\xf5\x86\xae\xe6\x3d\x0d\x77\x34\x4b\xe5\x49\x21\xd9\x78\x29\x18\x24\x54\xaf\x8f\x0c\xeb\x10\x06\x88\x6e\x6c\x78\xf7\xf0\x50\x35\x1a\x6d\x69\x32\xa2\x36\xe0\x1a\xe1\xc4\x4f\x79\x21\x21\x39\xbe\x55\x22\xa5\x86\x44\x6f\xd0\x1e\x59\x51\x35\xef\x76\x1e\xba\x0f\xdd\x6e\x8b\x78\x6a\x56\x44\x54\x70\x7c\x3b\xce\x97\x19\x1b\x2d\xd8\x18\x8b\x00\xe4\x82\x8d\x5f\xf3\x9c\x81\xfd\x43\x3c\xf2\xc6\x8c\x15\xe9\x55\xce\xb2\x53\x2e\x15\xea\x47\x8f\x91\x00\xe3\x9c\xa5\xc5\x72\x71\xc1\xe7\xe5\x52\x61\x73\x9c\x2f\xc9\xd1\x52\xa4\x8a\x97\x45\x17\x60\x9e\xde\xbe\x61\x69\xc6\xc4\x88\xff\x53\x77\x42\x73\x29\xf9\xfe\x4e\x31\x7c\xe7\xd7\x39\x2c\x97\x05\x42\xe1\x85\x32\xaf\xbf\x2f\xb3\x3b\xdb\xb0\xb5\x29\x22\x32\x56\x6f\xd2\x22\xcb\x11\x33\x80\xab\xb2\xcc\xbb\x00\xab\x54\x8d\x67\x9a\xca\x3a\x59\x4a\x2c\xa5\x62\xd9\x99\x28\x6f\x39\xc3\x16\x8e\x1a\x07\x6e\x94\xa7\x72\x46\xed\x2a\x90\x82\x65\x5c\xb0\x5a\x29\x95\x8d\x53\xc9\x50\x40\x0a\xc9\x15\xbf\x61\x67\xa9\x9a\x49\xd3\xae\x0b\x30\xdb\x73\x58\xd4\xfe\xa3\xd6\x28\xe2\x7b\x6f\xd3\xdb\xc3\xb2\x18\x2f\x85\x60\x85\x1a\x29\xc1\xd2\xb9\x84\x25\x2f\xd4\x57\x7b\x5e\x95\xd7\x22\x9d\xb3\x8a\x23\x6d\x4c\xe9\x02\x64\xec\x6a\x39\x3d\x13\x6c\xc2\x6f\x7d\xd2\xf5\xeb\xf7\x92\x89\x90\x23\xfa\xf5\x59\x2a\xe5\xaa\x14\x99\x7d\x8d\xcc\x28\xc7\xd7\x4c\x21\x2d\xde\xcb\x59\x29\x95\xed\xda\xbe\x06\x40\x6d\x60\x5f\xd2\xe8\xe5\x5a\x5c\x4e\xf9\x9c\x2b\xfb\xea\x9a\xb1\xc5\x41\xce\x6f\x58\x9b\xa0\x08\x96\x66\x17\x7c\xce\xb4\x1c\xd5\x0b\x57\x82\x2b\x66\x4b\xc3\xc2\x2e\x80\xca\xe5\x1b\x1f\x2d\x8f\x36\x95\xcb\x33\x1f\x37\x8b\x8a\xca\xe5\xa9\x8f\xa0\xf7\xfe\x47\x1f\xcb\x26\x2a\x2a\x97\xe7\x3e\xaa\xad\x35\x7e\xf1\xf1\x6d\xad\x71\xc8\x84\xe2\x13\x3e\x4e\x15\xab\x23\xec\x15\xfd\xc8\xee\xc2\xa2\x83\xa0\x1d\x15\x75\x01\xb4\xe2\xd3\x4c\x70\xd5\xf5\x2b\x4d\x3c\x92\xd6\xaf\x6b\xbd\xfa\xd4\x1c\x36\x24\x29\x7a\xb5\xab\xff\xf4\x6b\x73\x71\x7d\xcd\xdd\x7e\xab\xa8\xb6\x35\x80\xef\xbe\x83\xbd\xdd\xfe\x3a\xb5\x84\x0d\x92\x91\x26\xe5\xe7\x54\x9c\x45\x2f\xac\x9e\x8a\xa1\x87\x3f\x7b\x31\xf4\xec\xff\x6a\xc6\x80\x2c\x35\xad\xce\x0c\x7b\x78\x59\x80\x2a\x41\x32\x71\xc3\x7a\xfd\x60\x0d\xea\x76\x3c\xf0\xa3\x9c\x8f\xd9\xcf\xa9\x88\x5e\xd4\xf5\x1c\x76\xa5\x35\x6d\x2f\xae\x2d\x25\xd4\x69\xee\x34\xa2\x2a\xc1\xb4\x8e\x41\xcd\xb8\x84\x71\x5a\xc0\x15\x03\xc1\x16\x4c\x9b\x93\x69\x91\x59\x10\xba\xb2\x46\x99\x54\x3b\x2f\xa0\x4e\x41\xaf\x4f\x28\x5a\x99\xd1\xf8\x05\xba\x36\x86\x1e\x3d\xef\xa0\x74\x95\x4b\xd5\x8b\xe1\xd5\xee\x4b\x7c\x48\x46\x6c\x5c\x16\x59\x0c\x3d\x6d\x26\xc0\x82\x09\x5e\x66\x30\x29\x05\xac\x66\x7c\x3c\x43\x0c\x56\x29\x57\x70\xc5\x26\xa5\x60\x20\x67\x4b\xa5\x78\x31\x85\xac\x5c\x11\x32\xc8\x35\xe1\xd0\xd0\xdd\x07\xe2\x12\x43\x6f\x9e\xde\xee\xcc\xf4\x8b\x1d\xc9\xff\xc9\x70\x24\x70\xf1\x12\x65\x2e\x35\x8c\x79\x7a\xcb\xe7\xcb\x39\x14\xcb\xf9\x15\x13\x50\x4e\xe0\xea\x4e\x31\xe9\xc1\x87\x15\xcf\x73\x3d\xf1\x61\x91\x0a\x89\x18\x60\xa1\x60\xff\x58\x32\xa9\xc0\x00\xff\x8b\x84\x6b\x76\x27\x35\x0b\xb5\x45\x21\x63\xe0\x05\xae\x62\xf5\xfa\x39\x2f\x58\x02\x27\x0a\xb2\x92\x49\x28\x4a\x7c\x83\x93\x1b\xeb\x20\x86\x88\x82\x5f\xff\xaa\xcc\xee\x1c\x89\x27\x85\x0a\xa9\xd4\x6b\x51\x48\xe6\x18\x5f\x69\x36\xef\x92\x04\x34\x69\x34\x48\x13\xa6\xf8\x22\xb5\xfd\xc5\xb0\xab\x87\xa0\x28\x0d\x5e\x0d\xee\xda\x09\x46\x9d\x22\x7a\x8e\xb3\x7e\x67\x6b\x68\xc1\xd5\x8c\xde\x96\x0b\xdc\xc6\xf0\xb2\x90\xb0\xe2\x6a\x86\x4a\xe8\x76\x27\x80\xb9\x16\x99\xef\xcb\x32\xd7\x8c\x08\x57\xd6\x18\x7a\xe6\xc5\xce\x8c\xde\xf4\x62\x98\xa4\xb9\x64\x31\xf4\x04\x9b\x2c\x25\x8e\x6c\x09\x52\xa5\x42\xc1\x6a\xc6\x0a\x1f\x89\x59\x7a\xc3\xa0\x28\x81\xda\xe2\x00\x4a\x85\xc3\x5e\x4e\x40\x30\xb9\x28\x0b\x33\x98\x25\x62\x3f\xd7\x38\x43\x0a\x5f\xef\xbe\x72\x68\x39\x55\x10\xbd\x70\x4b\x7b\x0c\x3d\xfd\x7b\xc7\x57\x08\x19\xbb\x61\x79\xb9\xd0\x9b\xb0\x79\x99\xb1\x7d\x10\x6c\x9e\x2e\x8c\xd8\x89\x72\xa9\x2a\x2e\x1d\x9c\x9d\x00\x4b\x71\x3a\xf0\x39\x33\xf3\xb6\x5d\x8d\x8c\x67\x68\x05\xcb\xd8\x31\x13\xc7\x54\x53\xda\xeb\xaf\xd1\x25\xa1\x95\x81\x03\x68\x5e\xec\x2c\x44\x79\x7b\xd7\x8b\xa1\xe0\x39\x0d\xeb\xe1\xc9\xd1\xb9\x45\x69\x41\x56\xc9\x6a\x56\x4a\x06\xff\xb3\xf3\xba\x14\xab\x54\x64\x2c\xc3\x5f\x71\xf0\xe2\x4c\x94\xaa\xd4\xb3\xc2\x7f\xab\x97\x00\x23\x83\x12\x52\xc1\xac\xb9\xd3\xae\x97\x7a\xfd\x6e\xfb\xb0\x6b\x1b\xa7\x1a\x73\x89\x8f\xde\x80\xeb\xe9\x6b\x58\x8a\x3b\x44\x09\x65\x91\xdf\x69\xee\xe8\x77\x4a\xa4\x3c\xc7\x01\xd5\xed\x88\x36\x2e\x0c\xfb\x9d\xbe\xd3\x23\xe8\x09\x02\x9f\x16\x25\x0e\x32\xb4\x49\x63\x60\x7c\x69\x91\x33\xcf\x0d\xd4\x6c\x81\x8f\x9d\x46\x2c\x2d\x4a\x35\x63\xa2\x8e\x9c\x9a\xa5\xc5\x3a\xf4\xac\xa6\x46\x28\x76\x84\x74\xa5\x26\x7e\x6d\x06\x20\x2a\xc4\x54\xb2\x1d\x5e\xbd\xdf\x41\x50\xd2\x47\x17\xc1\xf9\xb8\x0a\x36\x4d\x45\x96\x33\xe9\xc4\x14\x41\xdb\xdf\x39\x57\x4c\xa4\x39\x48\x36\x45\x09\x97\x1b\x78\xdb\x32\xb6\xb3\xbd\x71\x0c\xbd\xd9\xde\xb8\x39\x94\xb8\x7f\x19\xec\x39\x01\xbf\x38\x1d\x01\xae\x9f\x33\xa6\x2d\x4f\xb7\xd2\xc5\x96\x25\xe3\x9c\xeb\xfe\xb1\x01\x2c\x04\x2f\x05\x5c\x17\xe5\x2a\x67\xd9\x94\x81\x5c\x8e\x67\x90\x4a\xc0\xbd\x3c\x5c\xa5\x79\x5a\x8c\x51\x61\x58\xa6\xbd\xd7\x46\xad\xc1\x68\x9d\xe5\x8b\x78\x62\x99\xd6\x5a\x63\x57\xba\x23\x4d\x71\x2f\x86\xbd\xaf\xd7\x2b\xe1\xaa\x01\x50\x03\x7c\x9b\x16\x96\xcc\x71\x59\x14\x6c\x8c\x0b\xab\x43\x2a\x40\xc7\x99\x2e\x01\x1a\x13\x7c\x1b\x68\xe4\x3c\x15\x53\xd4\xbe\x04\x56\x57\xf0\xd7\x37\x5c\xda\x64\x0c\x57\x4c\xad\x18\x2b\xe0\xd5\x37\x3f\xf2\xef\xf5\x94\x7d\xf5\xcd\x5b\xfe\x7d\x35\x42\x9e\x76\xf3\x4c\x77\xad\xcd\xae\x96\xd3\x9d\x85\x7e\xb4\x1a\xce\x9f\x7c\xe8\xc1\x01\xfc\x8b\xe7\xcc\x2c\x91\xd8\xbb\x71\x09\xc1\x4d\x2a\x38\xda\x24\x12\x96\x45\x86\x92\x8f\x1a\x0e\xa5\x2c\x06\x96\x4c\x13\x18\x68\xe8\xb1\x5e\x29\x35\xd0\xcc\x28\x6e\x36\x5f\xa8\xbb\x36\xcd\xeb\xf6\x0f\x0e\xb3\xa5\x64\xc2\xe2\x85\x3d\xe3\xb3\x95\xd5\xab\x54\xf2\x31\xa4\x4b\x35\x83\xe9\x32\x15\x6e\xb9\xd6\x50\xd0\x14\x5b\x94\xbc\x50\x72\x6d\x47\x76\x47\x52\xb1\x81\x5e\xf8\x1d\xda\x77\x4f\xef\xb4\xd9\x6b\xb5\xdf\xc1\x79\xa1\x1f\xf4\x7c\xc5\xbe\x06\x37\xa9\x18\x88\x65\x31\x50\x65\x56\xee\xe0\x74\x48\xb0\xba\xa3\x1b\xdd\x12\xf8\x82\x29\x9c\x21\x58\x8e\x2b\x60\xd1\xda\x0f\x6e\xa1\x50\xb0\x4a\x89\x36\x5b\x2f\x2f\xc7\x69\x6e\x1f\x10\xd8\xc9\x59\x1d\x46\x68\xa2\xe0\x66\x2b\x86\x1e\xfe\xd3\x8b\xc1\xce\x02\x7c\x0c\xda\x69\x63\x03\x75\x8f\x76\x41\x54\x22\x2f\x9d\x35\xab\x57\xec\x14\x1d\x44\x59\x39\x37\x26\x4b\xa3\x33\x6f\x1b\x87\xb8\xea\xa7\x1d\x63\xbf\x98\xbe\x2b\x1b\xab\x9a\x7f\xe5\x52\x49\x95\x9a\x45\x9d\x2c\x14\xd9\x6e\xd3\xba\x2d\x61\x0c\x3d\xfc\xbd\x93\xe2\xce\xab\x17\xc3\x57\xc6\x92\x7d\xcb\x8b\xa5\x42\x1b\x43\x32\x52\xe9\x17\x87\x67\x50\xd5\x04\x32\x7e\x71\x09\x82\x74\x3c\x66\x0b\x34\xb7\x3d\x62\xb5\x41\xb8\x10\xcb\x82\x49\xc8\x70\xa5\xc1\xf6\x5e\x39\x44\x66\x32\x8c\xf3\x52\x1b\xa0\x79\xba\x50\xe5\x02\xe6\x3c\xdb\x41\x6b\x18\x55\x58\xbf\x1d\x75\x6f\xc3\x8a\x8a\x9c\xa5\x99\x67\x89\x7f\x55\xb7\xc4\xad\x92\xca\x08\x84\xb5\xbd\x15\x9f\x63\xb7\x68\xa2\x09\x5a\x08\x3d\xbb\xae\xbd\x67\x7f\x37\x8c\x46\x10\x3e\x7e\x64\xdf\x1a\x64\xd5\x39\x9a\x64\x92\xb5\x4a\x2f\x6d\xb6\x51\xea\x72\xb9\xf3\x6c\x21\xa6\x8d\x39\x81\x79\x94\x2c\x3f\x53\x92\x43\xdc\xbd\xfd\x33\xf5\x3d\xae\xde\xf8\x9a\xc5\x7b\x8d\xc0\x97\x92\xad\x41\x62\x7b\x47\x3f\xa2\xf7\x54\xf7\x75\xcd\xee\x02\xed\x25\xf8\x0d\xc2\x47\x07\x6a\x6b\x1f\x5b\xba\x38\x68\xa1\x26\x5d\x47\x04\x2a\xc5\x52\x70\x75\x07\xe8\xd9\x47\x9a\xae\xb4\xc2\xce\xf4\xaa\x0f\xf3\xa5\x5a\xa6\x39\xfa\x52\xb4\xce\x6e\x1b\x30\xcf\x63\x42\xbd\x7d\x72\x7d\xe0\xfb\x5f\xa8\x8f\x7f\x33\xb5\x10\xfa\x87\x88\x86\x3f\x52\x3b\xd4\xdc\x4f\x84\xc1\x1f\xab\x24\x9c\x3b\x2a\x26\x9f\xfc\x46\x45\x41\x10\x75\x45\x9a\xf3\x4c\x34\x04\xd0\xf9\xb3\x1c\xcc\x36\xad\xd1\x0a\x2b\x26\xbf\x87\x67\x3a\xa5\x0b\x6e\xe4\x9e\x2b\x09\xe8\xf6\x98\xf3\x2c\xcb\xd9\x0a\xf7\x4c\xd6\x8e\xaa\x19\x0d\x4d\x4b\x69\xb7\xd7\xef\x3e\x74\x2b\xc7\x92\xf1\x66\x61\xad\xb6\xc0\x94\x71\xc0\xe1\x36\xba\x98\x1e\x17\x37\x3f\xdd\x30\x21\x78\xc6\xa2\x52\xf0\x29\xbd\xd6\x0a\xcd\xfd\xd6\x7e\x8f\x24\x49\xcc\x73\x9f\xde\x63\xc4\x02\x35\xd1\x65\x0c\xd7\x18\x8c\x31\x21\x1a\x5d\xf7\xbe\xdb\xe9\xf0\x09\x94\x32\xf9\x81\x29\x56\xdc\x44\xd7\x7d\xf8\x62\x08\xbd\x1e\xb6\xe9\x74\x04\x53\x4b\x51\x04\xc5\xdd\x4e\x47\x87\x0e\xb0\x59\xc6\x26\x54\xfb\xc5\x0b\xd0\x48\x0d\x5d\x5b\x6a\x9a\xb1\x89\xae\x6d\x21\x09\x3e\xed\x3e\x38\xcf\xa2\x6a\x50\xc5\x0b\x65\x48\xd2\x3f\xea\xf4\xf0\x42\x3d\x9f\x98\x9b\x18\x98\x10\xd8\x86\x82\x93\xc9\x81\x2a\x79\xe4\x57\xef\x63\x3d\x3e\xd1\xf5\xbe\x18\xe2\x96\xdb\x34\xed\x4c\xe6\x2a\x79\xad\x63\x55\x79\x81\x2d\x46\x2a\x63\x42\xc4\x70\x1d\x43\x8f\x1b\xd7\x51\x8a\xab\x08\xcf\x48\x89\xa1\x30\x76\x3a\x9d\x52\x26\xc7\xb7\x5c\x45\xaf\xf4\xe3\x83\xc7\xd3\x9b\x16\x46\xee\xfa\x7c\xdc\xdd\xce\x46\xcf\x41\x39\x18\xc0\x3b\xb6\x1a\xa1\xb8\x0a\x18\x0b\x74\x22\x4a\x48\xa1\x60\x2b\x2d\xb8\xf7\xf7\x30\x5b\xce\xd3\x02\x1d\x41\xc9\x3b\xdc\x74\x3c\x3c\x54\x3e\x35\xda\x85\xd3\xd2\x08\xe5\x82\xd6\xca\xab\xa5\xe7\x1a\x1b\x97\xc5\x84\x4f\x71\x99\xe1\xca\x08\xa6\xeb\x30\xc2\x2e\x5e\x62\x7c\xba\x0a\x4e\x27\x18\xdc\x4b\xe5\x38\xcd\xfd\x3e\x0f\xce\x4e\xfa\xf0\x92\xd0\xbc\xef\x76\x24\x0e\x47\xc1\x56\x91\x79\xd5\x0f\xe2\x9d\x55\x40\x0a\x40\x26\xc7\xf5\xa0\xd2\x10\x58\xed\x55\xb7\x23\x93\x43\xe7\xf3\x44\xd5\x09\xc3\x30\xe0\x84\x35\xde\xfa\x6e\x49\x18\x86\x5e\xed\xa0\x82\xf6\xe8\xf9\x35\xf4\x0b\xaa\xe2\x79\xb7\x3d\x57\x1c\x16\x8e\xc2\x10\xd3\x10\x64\xf0\x02\xab\xfc\xe2\xa2\x4d\xc3\x2a\xf2\x84\x05\x17\x61\xb0\x69\x58\x8b\x3e\x55\xe0\xb5\x5f\xc3\xc1\xd6\x4f\x58\x78\xee\xbb\x3d\x60\x18\xc6\xa0\xb0\xc2\x61\x5b\xe0\x69\x08\x6d\xee\x08\xac\xfe\x66\xef\x10\x86\x18\x90\xd2\x0f\x17\x17\x67\xed\x61\xa7\x21\xac\xdd\x98\xfb\x0d\x7d\x0f\x7f\x63\xeb\x8c\x15\x8f\xbc\x38\xd4\xd0\x8f\x4a\xb9\xc2\xf7\xb8\x61\x1c\xb6\x28\x45\x7f\xaf\x89\x4b\xc5\xd1\xf1\xf7\xef\x7f\xb8\x7c\x3f\x3a\x3e\xef\xf5\x5d\x6b\x17\xb4\x5a\x0b\xc1\xdb\x44\x56\x50\xce\x0e\x46\xa3\x5f\x7e\x3a\x3f\x32\x90\x46\x55\x98\x6b\xe8\xc5\xbc\xb0\x48\x7b\xd3\xda\x60\xd3\x16\x0e\x41\xbe\xf9\x69\x74\x61\x00\xe9\x58\xcb\xb0\xae\x07\x71\x91\x32\x3b\xa5\xb3\x9f\xce\xa9\xa6\x1f\x7a\x1a\xd2\x2a\xa5\x9f\x10\x4c\x15\x7f\x1a\x56\x11\x33\x2c\xf0\xc3\x4e\x28\x0a\xee\x09\x0b\xfd\x25\x1f\x86\x41\xc0\x0c\x8b\x2f\x4e\x47\x6b\x89\x71\x16\xbd\x21\x38\x86\xde\xc5\xe9\xe8\x52\xd3\x15\xd0\x77\x71\x3a\x6a\x27\xd1\xd9\xf2\xbb\xd4\xb6\xa2\xf4\xe2\x74\xe4\xd9\xa8\xeb\xba\x0f\xcd\xd8\x1e\x41\x39\x3c\x3e\xbf\x38\x79\x7d\x72\x78\x70\x71\xdc\x06\x0c\x63\x63\xdb\xe1\x19\xdb\xdb\x82\x3c\x3b\x3f\xf9\xf9\xe0\xe2\xf8\xf2\xc7\xe3\xff\x5f\xc7\x84\x0c\xcc\x83\xc7\xa0\x78\xb0\x06\xc9\x83\x56\x3c\xc3\x11\x0e\x6d\x67\xaa\xe2\x8f\xb3\x6f\xf6\x52\x71\x38\xda\xa1\x55\x49\x55\x6a\x63\x5e\x33\xfc\xb0\xd2\x81\x0b\x0b\xb6\x91\xe5\x5b\x69\xc8\xa1\x83\xa3\xb7\x27\xef\x2e\xab\x01\x3f\x70\x11\xc4\xc6\x90\x7b\xc6\xd8\xae\x6b\x69\x86\x7d\x5d\x48\x4f\x26\xa4\x21\x6d\x28\xcf\x8f\xc9\xe1\xda\x97\x4b\x5c\x53\xba\x9d\xc1\xc0\x5f\xbf\x9c\x1f\xd4\xad\x63\xad\x29\x34\xc6\xc0\x3b\x67\x53\xe4\xb3\x30\x4b\x8d\x0e\x4a\xa4\xb2\x15\x1a\x9a\x96\xb2\xea\x95\xe2\x62\x68\x45\xa6\xc2\x73\x62\xe9\x6a\xa0\x9d\x0f\xe9\x34\xe5\x45\xb5\x46\x77\xee\xef\x77\x5a\xf0\xe8\x76\x5a\xd7\xb6\x30\x7e\x68\x1a\x5b\x40\x2d\xab\xdc\xab\x5d\x78\x09\x9e\x7d\x1e\xac\x63\x6b\x63\xaa\x36\x06\xdb\xb6\xe8\xbd\xda\xdd\x6d\xae\x74\xeb\x40\xec\xf6\xb7\xad\x0f\x7b\x5f\xef\xae\x5b\x09\xd6\xc6\x7a\x1b\xca\xb6\xf2\xaa\xdd\xdf\x43\x96\xca\x19\x13\xbe\x75\x61\x3c\x6c\x9e\x1e\xf6\x36\x0f\x75\x45\xf9\x95\xe5\x97\xd9\x19\x36\xd5\xe5\x57\x2d\x1c\xad\xcd\x9f\x6f\x5a\xaa\xf8\x13\x28\xe8\x7e\xcd\xe8\x3b\xe9\x65\xc5\x0d\x17\x65\x81\x5e\x7a\x28\x69\xd6\x84\x92\xb8\x7d\x0d\xf4\xca\x9f\xbd\x0a\xd6\xea\x6c\x58\x07\x89\xca\x36\x10\x9e\x8e\xa8\xb4\x43\xbb\x62\x30\xef\xeb\x0b\xde\xa6\xe5\xc7\x95\x52\x0f\x6e\xf1\xb1\x2d\xd7\x75\x44\x45\xcf\x5c\x76\xea\x95\x3e\x7e\xe1\x69\xa9\xb7\x76\xe9\xe9\x3c\x72\xe5\x69\x56\xdb\xb2\xf6\x6c\xd6\xf8\x5e\xf9\xd3\x75\xbe\x57\xd8\xd4\xfa\x81\x4a\xf3\x93\x2d\x3a\x32\xc1\xfd\xc4\x10\x37\x2e\x6e\xc7\x23\xc3\x5c\x35\x7f\x06\x0d\x06\x35\x2d\x9e\xb1\x09\x2f\x68\xd7\x8e\xba\xa5\xbe\x20\xd8\x47\xda\xff\xf0\x02\x26\xd2\x6e\xf8\x69\xae\x51\xd4\x8a\x0b\x20\x45\x46\xf1\xf9\xa4\x3b\x18\xc0\xf1\xfc\x8a\x65\x2e\x50\x50\x41\xb1\x01\xc3\x2b\x5e\xa4\xe2\x2e\x0e\xba\xa4\x38\xaa\x64\x0a\x8c\x81\x9e\xdf\xd9\x98\x76\x62\x76\x53\x91\xb4\x1b\xa3\x7e\x48\x4f\x34\x91\xf0\x12\xe9\x48\x50\x69\x8c\x98\xc2\x64\x1c\xab\x4d\x6a\x8b\x66\x67\x22\x7d\xaf\x8a\x59\x41\xab\x0c\x18\xf7\xfc\xa8\x0c\x18\x6f\x48\x26\x32\x71\xc1\xde\x62\x2a\x7f\x4e\xf3\x25\xbb\x37\x2c\xd9\x87\x17\xcd\x05\xec\xc1\x4f\x85\xf9\x03\x52\x5f\x64\xe8\xd1\xaa\x2f\x90\xad\xb9\x2f\x2d\x95\x3e\x41\xf6\x8b\x65\x54\xb8\xfa\x86\x69\x21\xff\xe6\xd9\x2f\xd2\x79\xd8\xea\x66\x43\x7b\xf6\x4b\x4b\xad\x4f\x92\x0a\xe3\xb3\xda\xda\x28\x7f\x5a\x2a\x8c\xac\xa2\xe6\x75\x0f\x40\x6b\x2a\x4c\x4b\xa5\xcf\x9c\x15\x53\xd3\x0c\xbf\xac\xcb\x8a\x09\x8b\xfe\x84\xf4\x98\x2d\xba\x26\x74\x91\xa0\xa6\xa9\xa7\xca\xfc\x6b\x64\xc9\xb4\x48\xc4\x9a\x2c\x99\x46\xf1\x1f\x91\x2d\x13\xa0\x77\xbe\x2d\x5b\x66\x7d\x95\xcf\x9f\x37\x13\x60\x7a\xf8\xc4\xbc\x99\xb5\x2d\x3e\x77\x22\x4d\x80\xf5\x9b\xbd\x43\x97\x48\x63\x9f\x28\xb6\x40\xa9\x20\x9f\x3d\x91\xc6\xcc\xaa\xe8\xe5\x92\x92\x69\xf2\x25\xeb\x47\x2f\xaa\x1d\x59\x63\xd3\xd6\xdf\x9e\x52\xf3\x69\xb2\x69\x9c\x1e\x6f\x6c\x0e\xff\x80\x6c\x9a\x9a\x56\xf4\x1c\x90\xcd\x6c\x9a\x7a\xf1\x1f\x98\x58\xd3\x86\x66\x5b\x62\x4d\x58\xf4\x31\x19\x36\xad\x8c\xd9\x90\x61\xd3\xac\xf2\xd1\xe9\x36\x35\x14\x46\xeb\xd3\x6d\x6a\x85\xdb\x33\x6c\x6a\xa0\x69\x7f\x43\x61\x46\xf7\xbc\x2e\x2f\xc1\xb7\x80\x28\xc0\x48\xa1\x45\xf7\xfc\x89\x33\x6c\xfc\x1e\x4f\x37\x65\xd8\x34\x8b\xad\x4d\xf7\x88\xe8\x7a\xc3\x8c\xfe\x71\x5d\xb6\x4d\x58\xf4\xa7\x07\xd5\x1b\x88\x9f\x6f\xca\xb5\xa9\x17\x5b\x0d\xf6\x8c\x60\x7a\xa3\xe3\x5f\x36\xa7\xda\x34\x2a\x7c\x5c\x14\xbd\x26\xc6\x95\x5f\xc4\x4b\xb5\x09\x5e\x37\x05\x5a\xef\x73\x54\x8e\xd6\x18\x9a\x9b\xb8\x0f\x20\x6b\x8d\x65\xc0\xd5\x5f\x68\x07\x82\xaa\x35\x95\xb0\x63\xe2\xf2\x75\x91\x74\x9e\x15\x3f\x39\x27\x78\xbd\x76\x3a\x3c\x6b\x32\xd4\xa9\xde\x96\xa4\xd3\x56\xe9\xc9\x29\x3b\x1b\xbb\xad\xa7\xec\xac\x29\x7f\x6a\x0e\x4f\x4b\x9f\xeb\x72\x78\xda\x4b\x3f\x3e\xa7\xa7\x36\xce\x5b\x73\x7a\x5a\x6a\x7d\x9c\x12\xf2\x43\x1f\xd4\x65\x4d\x17\xd5\x6a\xfc\xeb\xa9\xa3\x30\x3c\xd3\x9e\xe3\xd3\x52\xeb\x53\x2a\xa6\x5a\xf8\x67\x4d\x96\x4f\x5b\xbd\x4f\xaa\xa2\x0e\xd6\x25\xfa\x84\x45\x5b\x13\x7d\x7c\xa9\x3c\x58\x97\xe8\x13\x16\xfd\x99\x29\x3f\xe8\x37\xf4\x37\xb4\xc0\x31\x3d\x03\xbd\x7a\xd4\x6d\xdd\x0b\x86\x4a\x39\xbd\x66\x90\xea\x1b\x02\x52\x90\x6c\x91\x9a\x9b\x06\x10\x61\xdc\x77\x32\x98\x70\x21\x15\x94\x05\xb2\x7c\x91\xa7\x63\x42\x9b\xb4\x67\x57\xdd\x2d\x58\xd8\xa9\xd4\xe7\xde\x31\xb1\x84\x7c\x2c\x2f\xdd\x59\xd6\x0e\xba\x26\xed\x09\x53\x9b\xa1\x13\xdd\xc0\x4b\x1f\x40\x1f\xcc\x58\x46\x7e\x6a\x11\x9f\xc0\x0d\x0c\x4d\xb6\xcc\xef\xbf\xc3\x4d\x42\xb0\xe9\x95\x97\xc5\xd2\xeb\xf9\x49\x2c\x04\x38\xf9\xff\x4a\x5e\x44\x2f\x6d\xb3\x18\x7a\x71\xaf\xbf\x09\x03\xa6\xa2\x1b\x4b\x8d\xce\x0a\xd2\xb7\x09\x10\x26\x5f\xdc\x24\x48\x08\x76\xea\x40\x82\x46\xa4\xdb\xe9\x98\x32\x9d\x53\xc1\x34\x26\x94\x48\x74\x93\xe6\x55\x2a\x91\x45\x6b\xb4\xc8\x39\xf5\x64\x70\xb2\xf9\x45\x58\xdb\xba\xca\x31\x61\x83\xcf\x47\x8b\x74\xcc\xb0\x6a\xff\x5b\x5c\xa5\xfc\xa4\x23\x1f\x89\x74\xb1\x60\x45\xe6\x93\x8a\x4d\x5c\x1e\x10\xb1\x05\x51\x35\xf2\xe2\xed\xd4\x3c\x71\xc1\x9d\x14\x15\x59\x91\xb5\x8e\x6e\xb0\x57\x45\xe4\xe9\xf8\x5a\x9a\xf1\xf7\x81\xd0\xe1\xe0\x8a\xb3\x5e\xe1\xd6\xa1\xf5\xc7\x71\xb7\x3e\x90\x3a\x9d\xea\x75\x29\xe6\xa9\xc2\xc3\x1a\x11\x02\xfe\xe6\x6f\xd1\xcb\x9b\x3e\x1e\x7e\x0b\x87\x33\xec\x74\xfd\x68\x16\x8d\x7c\xad\x33\x8c\xb4\x6a\xf8\x34\x2c\xaf\x76\x63\xf8\x6a\xaf\xdf\x6d\xc9\xd9\x22\xdc\x98\x10\x1a\xd5\x97\x37\x30\xf4\x79\x11\x15\xfd\x1a\xc7\x2b\x6f\xb7\xbe\xdc\x83\x92\x9d\xf0\xc8\x97\xcb\x7c\x92\xce\xcd\x85\xfb\x3b\xf2\xc2\xc9\x16\xff\xbd\xdf\x9c\xce\xce\x02\x86\x2e\x4c\x64\xc3\x61\x69\x4f\x18\xcb\x44\x2f\x90\xba\xb2\xf7\x92\x3a\x80\x61\x85\x01\x56\xd1\x40\x30\x17\x01\xe0\x81\x24\xc5\x36\x07\xb7\xf2\xe9\x37\xb2\xb6\x30\x38\x77\xba\x25\xa1\x16\x14\x41\x35\xa7\xdd\x22\x02\x26\xa2\x9c\x57\x01\x94\xb8\x4b\x41\x4a\x8b\x88\x5e\x58\x2c\x74\x84\x86\xea\xcb\xb8\xf5\xb0\xd5\xbc\xc9\x92\x8a\x42\x12\xac\x30\xb4\xfc\xdf\x94\xef\xa6\x89\x0b\x4a\x74\x54\xe9\x9b\xbf\x45\x41\xfd\xbe\xcd\x9c\xab\xfb\x96\x9b\x80\xfc\xc2\x61\xa3\x7e\x05\xc7\x73\xc1\x79\x00\xfc\xb7\x9e\xe2\xe0\x93\xba\x47\xcc\x6b\x13\xbe\xaf\xb7\x6a\x73\x47\x79\x8d\x5b\x8b\x2b\x18\x0f\xdd\x40\x3c\x31\x22\x24\x2b\xf1\xa0\x65\x2b\xcb\x38\x8e\x4f\x9a\x53\xf8\xcb\x04\xc4\xf4\x1d\x35\x58\xee\x04\x17\xde\x31\x96\x49\x32\x02\xc7\x69\x9e\xb3\xcc\xad\xed\x2e\xb1\xc1\xcc\xba\x0d\x32\xae\x71\xd8\x2e\xe5\x0e\x49\x53\xbf\x45\x8a\x29\x2d\x10\x95\x32\xa2\xd9\x9a\xb3\x78\x70\x76\x62\xf4\x1a\x55\xae\x56\xb4\x46\x0e\x85\x5d\xda\xaa\xa8\x61\x3d\xbf\x16\x7e\xcb\xcb\x62\xba\x6f\x23\x55\x90\x31\x39\x16\x5c\x07\x09\xf7\x3f\x73\xd0\xea\x37\x2f\xb8\x16\x86\xa1\xea\xa7\xff\x37\xa0\x0f\x60\x29\xa8\x47\xb7\x42\x52\x3e\x32\xac\x65\x09\xdb\xef\xbd\xda\x95\x01\xe6\x6e\x2e\xd9\x0b\x26\xea\xa9\x1c\xdb\x79\x5f\x0f\x8b\x85\x98\xff\xfb\x45\xc8\x12\x9f\x5d\xe8\x65\x6c\xe5\x97\x77\x6f\xc9\xe6\xf1\xad\xfe\x34\xf9\x65\xe2\x6b\x21\xc3\x3e\x3a\xb0\xe6\x0f\xf6\x6e\x1d\xf9\xcd\xb7\xab\x3c\x6e\xb0\xab\xd0\xdc\x7a\xcc\x3f\x4b\x94\xce\xa7\xec\x6d\x38\x2e\x61\x28\x8e\xac\xe0\x47\x0e\x0c\x91\x56\x8f\xf0\x85\xc4\x7d\xc6\xd0\x9e\x4f\x87\x0b\xdc\x59\xe4\xd6\xd3\x80\x83\x27\x13\xcc\xaa\x2b\x50\xb5\x12\x15\x5e\x20\x30\x24\xe0\x0f\x0e\x03\xfe\x56\x25\xda\x39\x25\x6e\x89\xbc\x58\x77\x1f\xcf\x23\x06\x8c\xc8\x0c\x03\x86\x21\xa5\x7f\x76\xec\x10\x58\x71\xb3\xdf\xbb\x38\x7f\x3f\xba\x38\x3e\xba\x3c\x3b\xff\xe9\x7f\x4e\x8e\x47\xfa\xed\x4e\xc6\x72\x3e\xdf\xef\xc5\xbe\xec\x5a\xe1\x6d\xb9\x82\x68\x03\x3f\x42\xa1\xd5\x01\xba\x1a\x1b\x3e\x7b\x0c\xd2\x97\xdb\xd0\x62\x7a\x12\x09\xb5\x48\x65\x48\x84\x2d\xfc\xbc\x31\x4a\x9f\x92\x56\xf3\xed\x71\x94\xac\x09\x61\xd6\x28\xfa\x7c\x51\x4b\x8f\x8c\x6e\x07\xf3\xfe\xdb\xff\x6c\x21\xa6\x45\x35\xe2\xfd\x01\xad\xc2\x45\x71\xbb\xcf\x1a\xfa\xf4\x07\x67\x6d\xa8\x93\x76\x86\x8f\x22\xcb\x12\xb5\x29\x32\xba\x7e\x65\x7b\x56\x90\xb4\x5a\xb9\xf6\xbe\xde\x6d\xa5\xa8\x4a\xa7\x05\x78\xee\xe2\xdc\x1a\x69\x6d\x52\xf2\x91\x41\xd7\xf5\xd6\x51\xb7\xe3\x45\x57\xdd\x0d\x57\xdb\xf1\x0e\x82\xb4\xad\x72\xf6\xb9\x03\xb4\xfe\x88\xb8\xf0\x2b\xc0\x93\x69\xc0\x50\x6d\x8b\xec\x3c\x27\x82\x8b\xeb\xc5\xbe\x9f\xfd\xdb\xc0\xd1\x06\x6a\x9f\xca\x67\x6a\xd6\x82\xe7\x73\x63\xbd\x3e\xae\x2e\xc3\xd8\xc3\xb7\x0b\x50\x45\x77\x9f\x6c\xd2\xf8\x81\xe2\x16\xde\xae\x8b\x12\x57\x72\xfa\x98\x9c\xf3\x4d\xf6\x4a\x56\xce\x53\x5e\x18\x0a\x4e\xa1\x60\x8a\x22\xb4\x4c\x74\xbb\x1d\xef\x06\xb6\xed\x23\xa0\x3d\xe5\x4d\x1a\x4e\xce\xd6\xa1\x5e\xe5\x9f\x1b\x1e\xeb\x2c\x69\x5f\x12\xec\x6d\x6f\x1b\xfa\xae\x54\x1e\xfa\xce\xdb\x86\xfd\xd3\x44\xb9\x0d\x86\x3a\x37\xd8\xc7\xd0\x0b\x24\x3d\x7e\xbb\x44\x08\x07\x81\xa9\x10\xf1\x47\x47\xa4\x7c\x5c\x5c\x8c\xa9\x71\x89\xdf\x06\xac\x08\x17\x2f\x62\x15\x62\xf2\xa7\x06\xab\x2a\x51\xf9\x6a\x1e\xa8\x08\x2f\x0e\xf5\x64\x52\x83\xb8\x56\x48\xec\x23\x42\x49\x6d\x01\x2d\x0f\xcd\x9a\x07\xc2\x0f\x55\x3d\x15\xcf\x30\xf6\xf5\x64\x44\xdb\x63\x5e\x15\xaa\xdf\xd4\x50\xc5\xf5\xd5\x78\xad\x4e\x01\xea\x7a\x80\x42\xf4\x56\x80\x1f\xb3\x74\xd8\x3f\x44\x8d\x8b\xf8\x6f\x55\x10\xcf\x8a\xf8\xd3\xb6\xc4\x1e\xb5\xf0\x09\xa3\x28\xbf\xc5\xe7\x29\x6e\x0d\x0f\xf7\x27\x69\x97\x67\xe9\x16\x77\xe2\xa3\x86\xbc\x17\x31\x7f\xce\x9e\xb9\x9e\x6d\xd0\xa4\xc1\x0f\xc0\xb7\x46\xfc\x2d\x11\x1e\xa2\xfe\x39\x8d\x8d\xbb\xe1\x7a\x96\xc1\xb3\xf0\xc7\x9c\x85\x16\xde\x3f\x36\x53\xc1\xe3\xaf\x77\x68\x65\x1b\xda\x07\x01\xe3\x9f\xc5\xf6\x74\x0b\xb7\x9f\x98\xee\xe0\xb1\xff\xe0\xb1\x23\x00\x10\xa6\x3b\x3c\x57\xfe\x3f\xf5\x62\xe5\xe7\x44\xb4\x5c\x4c\xbb\x09\x3f\x0f\xab\x7f\xcd\x65\xab\x46\x67\xb0\x58\x3d\x8f\xce\x4f\xbf\x66\xd5\x70\x0c\x16\xaa\xe7\xe1\xf8\x59\xd6\x2b\x1f\x4d\x5c\xa1\xa4\x5b\xa2\x6a\x2b\x94\xcb\xce\x78\xb4\xb9\xea\xa5\x77\xb4\xae\x49\xad\x69\x18\x1b\xac\x57\xef\xe4\x99\x8f\xb5\x4b\xf1\xd8\x3e\xed\x02\xc4\x36\x2d\x38\x7f\x70\x86\x88\x4f\x5f\x63\x85\xd2\x6c\x3c\x85\xa6\xcd\x90\x8e\x95\xd6\xcf\x19\xcc\xd3\xc5\xaf\x66\x54\x3e\xd4\xea\xe0\xbd\x20\xe5\x88\x4f\x8b\x34\xd7\xee\x6d\x94\xd8\x9c\xa5\x88\xff\xe8\xe4\x87\x93\x77\x17\x7a\x27\x3c\x3a\xf9\xe1\xe2\xf8\xfc\xad\xf5\xb3\xa4\x8b\x45\x6e\x9d\xb4\xac\xe5\xb0\x9b\x65\x04\x86\x0c\x25\x8c\x66\x4b\x85\x53\xd3\x3a\xfa\xba\x9d\x46\x8f\xe8\x33\xea\x76\xf4\xc6\x59\xc8\xd3\x72\x7c\x4d\x26\xce\x5d\x31\x4e\xde\x2e\x15\xbb\x75\x85\x56\x31\xe2\x2d\xeb\x2f\xed\x37\x0b\x12\x23\x93\xdd\xce\xfd\x7d\xcb\xa1\x37\xfd\xcf\x53\x57\x8f\x16\x17\x77\x3d\x46\xd7\x72\x26\x6e\xfd\x32\xe0\x8f\xd7\x82\x5b\x1a\xe8\xcf\x63\xaf\x46\xe9\x76\xc8\xfd\x6f\x1b\x82\xb9\x1c\x3b\xa1\xc8\x44\xb7\x83\x78\x69\x27\xbf\xab\xf3\xd2\x7e\x22\x23\xa1\xf7\x08\x84\x2e\x0f\x40\x7e\xda\x7c\x9e\xc1\x00\x4e\xcb\xe9\x04\xf2\x72\x2a\x61\xce\xa4\xc4\x3c\x11\xc6\xf5\x81\xc5\x1b\x9e\xba\x18\xb1\x76\x36\xe4\x25\x7e\xc5\x04\x4a\x53\x24\xef\xa4\x62\x73\x9d\x67\xa4\x2f\x2b\x0a\xea\x70\x17\x5e\x6e\xc9\x83\xc0\x1e\xa3\x09\x29\x8c\x18\x52\x31\xd5\xd7\xf9\xf0\x42\x31\x31\x49\xc7\xec\xfe\xa1\xca\x06\xf0\x62\xc6\x2f\x5e\x98\xe7\xe4\xd4\xe0\xe1\x42\xc9\x36\x48\x6e\xde\x47\x13\x03\x32\x49\x12\xcc\x07\x30\x23\x83\x91\xf4\xbc\x9c\x26\x67\x78\x59\xcf\xa4\x56\x85\x18\xf1\x3a\x55\x69\xfe\x79\x59\x81\xc7\x44\x6f\xb9\xf5\x55\x16\x65\xb1\xf3\x4f\x26\xf4\x6d\xc7\x6a\x29\x21\x9d\x28\x26\x30\x11\xb4\xc0\x80\x6b\x93\x6f\x06\xc1\x3f\x88\x73\x28\x46\xfe\x3d\x45\x35\x46\x5a\x5c\xda\x18\x39\x62\xaa\x25\x41\xc6\xc5\x62\x29\x56\x50\x6d\x2e\x0e\xce\x4e\x36\x25\x1d\x68\xf2\x9b\xdc\x30\xbd\x3c\xf1\x92\x21\xc3\x1c\x6c\xe3\xe5\x2f\xd9\x93\xc5\x98\x63\x85\x1c\xb1\xd3\xcd\xbe\x31\x49\x43\x48\x5f\xed\x14\x72\xc0\xd4\x21\x54\x02\x86\xf5\xaa\xd4\x96\x6e\x00\xd3\xb1\x85\xb0\xf7\xd2\xa0\x3c\xea\x66\xa9\x34\x97\xc4\x47\x26\x0d\x81\xc6\xbc\xaf\xe3\x1f\x34\xc6\x97\x31\x94\xd7\x98\xa2\x26\x13\xa7\xf4\x7f\x35\xd5\x3f\x7c\x8b\x45\x5e\xc6\x93\xcd\x14\xb1\x9f\x3a\xd1\x57\x2c\x35\x4f\xe8\x6a\xb8\x39\x2b\xa8\x57\xd9\xaf\xee\x9c\xb2\xed\x9a\x97\x50\x3c\x74\xab\x9c\x39\x2f\x63\x8e\xea\xdb\xfc\x38\x84\x44\xb4\xe0\xab\x10\xb1\x30\xd9\x4d\x5f\x08\xee\x84\x49\xa0\xfd\x88\xcb\xee\x82\xb7\x49\x81\xb8\x61\x51\x1f\x22\x4c\x0a\xd3\x29\x7f\xd5\x0c\xa8\xc5\x6f\x5f\xbc\x08\x67\x05\x21\x36\xe7\x52\x9b\x94\x9a\x1f\x38\x9e\xef\x0b\x3e\x5f\xe4\x0c\xcf\x73\xb1\x2c\xea\x7f\xab\xf9\x41\xb5\xfa\x2e\x93\xc8\xe1\x3a\x57\xc9\x31\xf6\x3b\x89\x7a\xb5\x10\xf4\x97\x8d\x00\x6e\x2f\x76\x19\x82\x3a\xbf\x91\xa0\x62\x26\x21\xf4\xfa\x2e\xe9\x4f\x8f\xc2\x17\x32\x09\x54\x36\xa1\x8b\x74\x22\xa6\x46\x97\x23\x7a\xb5\x14\x37\x00\x3f\xc9\xcd\x02\xc4\x44\x53\xa6\xdc\x41\x76\xc2\x27\xc6\xe5\xb1\xd0\x7a\x4b\x62\x39\x31\x8e\x4a\xfd\x39\xe2\x54\x8a\xf7\xce\xb2\x40\xb3\x5d\x26\xef\xd8\x2a\xea\x8d\xd3\xe2\x2f\x8a\xae\x1a\x23\x3b\xa7\xd6\x63\x8a\x39\x13\x38\x98\xd4\x27\xe6\xed\x6a\x9a\xf1\x5a\x0f\x66\x87\x2b\xa2\x44\x2b\x9c\x0e\x51\xc1\xf3\x7e\xdf\x31\x06\x87\xa3\x7e\xde\xb3\x1a\x17\x17\x46\x45\xb6\xec\x0f\x4d\xba\xd2\xe1\xc9\xd1\xb9\x6c\x36\xaa\x38\xfa\x45\x2b\x55\x1a\xb1\x1a\x5e\x13\x1b\xc1\x35\xe9\x62\x32\x72\xec\x8a\x6d\x08\xd7\xe2\x3a\x18\xb4\x59\x7a\xfa\xa0\xa8\x3e\xba\x49\xd6\xde\xd5\xdd\x1a\xab\x92\xe9\x30\x23\x5e\xad\x86\xfb\x26\x46\x36\x1f\x21\x82\x13\xcd\x75\x4d\xe3\xe6\x47\x4a\xaa\x6c\x53\x5d\x44\xe6\xa2\x4f\x67\x00\x0d\x81\xcd\x31\x73\x44\xc3\xa8\x88\xd2\x83\x53\xa9\xfe\xfa\x18\xb5\x36\x09\xe5\xd8\x3b\x38\x1c\x22\x65\x25\x79\x65\x8b\x23\xa3\xe3\x22\xaf\x45\xbf\xff\xed\xd6\xf1\x41\x56\xdf\xa4\x02\x56\x53\xc0\x2f\x89\x25\xbf\xa4\x5c\xfd\x20\xca\xe5\xc2\xf6\x5f\xd7\xa5\xef\x0b\x7e\xab\xb5\x44\xe0\x84\x47\x86\xbe\xa8\xd9\x96\xf7\x5a\xbd\x88\x7d\xbc\x31\x2f\xd2\x36\x17\xe9\x9d\x87\x5a\xe3\x2a\x7f\x10\x23\x6b\xa8\x24\x31\x1f\xd5\x4b\x2b\xa4\xf4\xc4\xb0\x91\xcf\x7c\x62\x5e\xbd\xca\x69\x39\x7d\x8d\x3a\x0f\xab\xa0\xdd\x54\x2f\xff\x5e\x6f\xe8\x9c\xb1\x8d\xd5\x32\x91\xf2\x42\xdb\x8d\x48\xbe\x4d\x93\x0c\xd3\xc9\x3c\x3d\x16\x80\xa3\x62\x18\x36\xee\x41\xb0\x93\xd4\x2d\x5f\x74\xc9\x9f\xdf\x3c\xa6\xef\x4b\x59\x2d\x17\xf9\x07\xf0\xfa\x7d\x6c\x2e\x93\x34\xcb\x5a\x9a\x22\x6f\x56\xd3\xe4\x20\xcb\xcc\xad\x88\x86\xda\xa8\x87\x55\x51\x3b\xb7\xa6\xfd\xa5\x0a\xb0\xbf\xfd\xc1\xe0\x4b\x3a\x3c\x5f\xf5\xd6\xed\x74\xa6\x25\xe0\x7a\x11\xe5\xc1\x16\xa9\x8f\x54\xe3\xd7\x8a\x26\x68\x8d\x4c\x93\xa3\xb2\x60\xb8\x46\x77\x74\x2e\x2e\x69\x0d\x1f\x35\x52\x42\x79\x8b\x28\x4a\x6b\x07\xf5\xbe\xbc\xe9\xe9\xc4\x64\x03\x08\xc5\x03\x68\xc4\xa2\xde\x48\x95\x8b\x05\xcb\x40\x7e\x04\x2d\x0f\x91\x4c\x7c\xa4\x4e\x2b\x7d\xd8\x14\x70\x0c\xc2\x1a\x01\xaf\xbc\xcb\x4f\x16\xef\xaa\xe9\xa3\x85\xdb\x6b\xe2\x3b\x5f\x50\x98\xbc\xe7\xb0\x62\xe0\x01\xc1\x9a\xfe\x8b\xb0\xea\x88\x29\xe7\xbb\x92\x64\xb9\x44\x56\xbe\x5d\x89\x16\xed\x1a\x36\x17\x87\x67\xae\x5c\xcb\xb6\x7b\xb2\xfa\xd1\x77\xd5\xb9\xa9\xe1\x41\xf0\xcb\xab\xe5\x97\xee\x61\xd3\x23\xf1\xa8\xc9\xe6\xe3\xb4\x75\xaa\x79\x95\xdb\x35\x85\xd6\xec\x98\x93\x51\x87\xed\x55\xc7\x17\x6f\xf6\x0e\x2b\xf5\x8c\xf3\x04\x21\xef\xd1\x2c\xb4\x66\x87\xdf\xbe\x45\xeb\x78\xa5\x9b\x74\x4e\x8b\x86\xa8\x5a\xd2\xc1\xed\x9e\x45\x81\x9c\x4a\x38\xeb\x45\xd4\xa7\x13\x41\x51\x53\x51\x54\x75\x9f\xab\x26\x10\x42\x35\xb5\x9a\x7d\x6f\x50\x17\xa4\x25\x1b\xea\xc2\x2e\x61\xfb\x43\xa8\xe0\x6d\xd0\x15\x6b\x94\x85\x66\x7d\xe7\xa9\xaa\xc2\xa7\x27\xf7\x68\x78\x88\x02\xea\xb6\x29\x89\x51\xa5\x25\xe4\x47\xa8\x09\xf9\x0c\x3d\x21\xd7\x28\x8a\xd0\x71\x5b\xab\xdc\x50\x16\x35\x17\x6a\xad\xfa\x46\x85\xe1\x7b\xc2\x03\x9d\x21\xd7\x29\x0d\xbf\x85\x9d\x7d\x35\x2f\x7f\x30\xd1\x2d\x20\xbf\xc2\xb0\xd1\x86\x66\xdf\x63\xb5\x87\xc3\x6e\xb3\xfa\x08\x2b\xb7\xab\x0f\xbf\xc6\x9a\x19\x2f\x1f\x33\xe5\xd1\x55\x30\x18\xc0\x49\x21\x17\x5c\x18\x83\x56\x83\xde\x1f\x0c\xae\x70\x4f\x7c\x85\xab\xce\x15\x2f\xf4\x87\x47\xd3\xf1\x8c\x33\x94\xed\x9d\x05\x13\x13\x9d\xa2\x27\xf3\x9d\x3c\xbd\x92\x3b\x72\x5c\x0a\xb6\x83\xae\x91\x9d\x69\x59\x43\x00\x23\x43\x5a\xaf\xc0\x10\xf0\x0e\xf9\xc4\x3c\x69\x5e\xe3\xc9\x9c\x54\x5f\xd2\x68\xdd\x85\x14\x96\xfa\xa1\xfc\x8b\x74\x1b\x90\x31\x5f\xcc\x98\x90\x4b\x0c\xcf\x62\x5a\x10\x13\xac\x18\x33\x19\x13\x04\xe3\xc4\x45\xdb\x5c\x2d\xd1\xcd\x83\xa9\x08\x37\x25\xcf\x20\x55\x0a\xcf\x4d\x25\x70\x44\xe9\xde\x33\x54\x34\x65\x61\xf3\xce\x12\x04\x80\xd7\xd1\x32\x61\x70\x3d\xd4\x1d\x8d\xb0\x23\xb9\x8f\x7b\x02\x66\xfb\xf8\x09\xad\x7e\x8c\x97\x8d\x97\x3a\x03\xc9\xf4\xa9\xf7\x88\xa9\x94\x6c\x7e\x85\xd7\x7f\xd9\xbd\xa7\x76\x32\x4a\x6a\x69\xf9\xe9\x7d\xc1\xd5\x7c\xad\x75\x30\x2d\x07\x4a\x30\x36\x98\xa7\x78\x61\xe5\x40\x8a\xf1\x80\x3e\xec\xcb\xf2\x1c\x5d\xeb\x63\x04\x71\x88\x1d\x9e\x55\x54\xef\xc3\xaf\x1f\x34\x17\xf1\xfd\xc9\xd1\xbd\xfb\x7d\xb6\xf7\xf5\x37\x0f\x71\xe5\x37\x7d\x5b\x66\x4c\x14\xf8\x37\x7a\x31\x01\x40\xa3\xf3\x5e\x32\x9d\xef\x8b\x8e\x85\x5c\xea\x9f\x6e\xc8\x57\xfc\x9a\x27\xf3\xf2\x9f\x3c\xcf\x53\xfd\x2d\x59\xfd\xf1\x52\xae\xee\x06\x86\x3d\x97\x23\x9e\xb1\xcb\x8b\xd3\xd1\x7f\x20\x54\x51\x5c\x8e\xcb\xf9\x22\x55\xfc\x8a\xe7\x5c\xdd\x21\xb2\xef\xd8\xad\xd2\x49\xb3\x72\xbf\xca\xe2\xed\xcd\xf6\x7a\xb4\x7e\x0c\x5e\x25\xaf\x7a\x0f\x71\x8d\x35\xab\xd5\x2a\x29\x57\xa9\x5c\xe8\x4e\x79\x91\xb1\xdb\x64\x31\x5b\x0c\x2e\x44\x5a\x48\xf4\xea\x5f\x9e\xa6\x77\x4c\x5c\x22\x64\x13\x55\xba\x3c\x9c\xb1\x54\x5d\x8e\x66\x8c\xa9\xff\x38\x5f\xe6\xec\x72\xe7\x12\x87\xe8\x72\xb4\x5c\xe8\x06\x23\x25\xca\x62\xaa\x5b\x94\xe3\x32\xd7\x83\xf1\x96\x17\x3f\x33\x21\xd1\x37\x8c\xb4\x27\xf4\x70\x71\x3a\x7a\xb5\x17\xd3\xa9\x96\xc1\x00\x2e\x66\x4c\x32\x5f\xe6\x24\x48\x03\x15\x28\x07\x18\x46\x6c\x2c\xd8\xf8\x6e\xdf\x51\xc0\x8a\x04\x99\xb7\x60\x19\x37\x9c\xc3\xa7\x01\x55\xbf\x94\xa6\x3a\xe2\x10\x4a\xd8\xaf\x1f\x30\x4f\xf1\xd5\x37\x7a\x2e\x74\x10\x27\x0c\x55\x1e\x1f\x1e\xbd\x39\xbe\x3c\x3e\x3c\x1a\x1d\x5c\xfe\x72\x72\xf1\xe6\xf2\xe0\x78\x74\xb9\xf7\xf5\x37\x97\x3f\x1c\xbe\xbd\x1c\xbd\x39\xf8\xea\xef\x7f\x8b\x5b\x1a\x9c\x3f\xad\x7a\x0d\xfe\xab\xbd\xbf\xdb\x06\x7b\x5f\x7f\xb3\x15\x7e\x4b\xf5\x07\xff\x53\xb4\xce\xae\xaa\x1f\x9b\xa7\x8d\xe4\x8b\x17\x8d\x12\xbc\x54\xb1\xda\x65\xb6\xab\x90\xc4\xab\x8f\xd6\xec\x3c\xbd\x66\x11\xcd\x87\xaa\x24\x86\x57\xf6\xd8\xdd\x76\x28\xbf\xee\x7e\x88\x69\x43\x8b\x60\x4e\xcb\x34\xfb\x9f\xaf\x77\xff\xeb\x47\x76\x77\x96\x72\x11\xad\x8f\x43\xd0\x46\xc9\x11\x5d\xa7\x67\x7d\xcb\xbe\x6b\x13\xc3\xfa\x5a\xdb\xe0\xff\xc8\xee\x1e\xd3\x05\xf9\x68\xdc\x51\xae\x46\xa0\xde\xf2\x9c\x4e\x75\xa5\xc8\x9c\x98\xfe\x3d\x36\x7b\x2a\x5e\x2e\x15\xcf\xf5\x82\x8f\x71\x97\x27\x33\xc5\xef\xef\x71\x38\x53\xda\xc9\xc4\xc3\xc3\x59\x64\x14\x28\x01\xe7\xcc\x8e\x5c\x25\xdb\xf0\x81\xfe\x35\x05\x67\x65\xa9\xcf\x03\xdf\x7e\xbd\xfb\x5f\xe8\xeb\xb2\xef\xa2\x7e\xa3\x5a\x72\xa0\xcf\xf4\x62\x0d\xf9\x5a\x94\xf3\xb3\xe3\xb7\x04\x7d\x8b\x44\xe9\x15\xe5\xf0\x00\x85\xb2\x82\xf6\x88\x26\x07\xf8\x75\x2c\x23\x7a\xe7\xec\x1f\x4b\x2e\xd8\x41\x91\xfd\xcc\x04\x9f\xdc\x99\x0a\x08\x8b\x4e\xd5\xf9\x16\xfa\xc5\xe9\x28\x6a\x85\xdb\xef\xae\xef\xf2\xfb\x25\xcf\x33\xb4\x45\x2f\x4a\x6f\x44\xa2\x3e\xcd\xd5\x2d\xee\x9a\xae\x5e\x40\x28\x4d\x18\x3f\x92\xc0\xa6\xa5\xe2\x3a\x5e\xe9\x42\x03\x2e\xa3\x5b\xaf\x8f\x56\x6f\x72\x55\x75\x40\x17\xc5\x27\x87\x2d\x7b\x0d\x8b\xb1\xdd\x73\xd4\xb7\x3b\xdf\x3e\x02\x45\x72\x33\xb6\x33\xc0\xa3\xda\x77\x90\xb7\x2a\xaa\xea\xd3\x17\xad\xe5\xa8\xae\xfc\x2a\xde\x26\xc1\x66\x0d\x68\x93\x4a\x87\x2b\xe1\xb7\x9d\x9d\x5a\x5a\xd1\x6f\x3a\x50\x4b\xef\xaf\xd9\xdd\x6f\xb0\x62\x82\x85\xc9\x5b\xf4\xd1\x89\x87\xee\x16\xf8\xad\xe0\x57\xa9\x6c\x83\xf6\xd0\x7d\x1c\x3d\x8f\xe8\xce\x60\xbd\xbe\x9b\x56\xaf\x93\x37\x30\x64\x14\x54\x3b\x3b\x19\x6e\xed\x36\x6f\x2b\xe5\xc7\xef\x2b\x65\xb8\xb1\x94\x9f\x7a\x67\x29\xff\xf8\xad\xa5\x6c\xdf\x5b\xa2\x7e\x79\xc7\x56\x96\x80\x28\x24\x38\x86\xd6\xe9\xd2\x47\x5d\xe2\x76\xa1\x4d\x3f\xb4\x7e\xf3\xcc\xcd\xa7\xd7\xf6\xd1\x9b\x4f\xbf\x4d\x7d\xf3\x19\xee\x3c\xfd\x9a\x8d\x9d\x67\x6d\xdb\xe9\xd7\x7d\xa2\x9f\xca\x6f\xba\xcd\x51\xb5\x75\x8b\x18\x00\xdb\xbc\x45\xac\x75\x5d\xed\x11\xfd\xc0\x40\xad\x52\xcb\x36\xd1\x2f\x7e\xa2\x67\xc8\x6b\x1a\x53\x6c\x50\xa7\xed\xc4\x4e\x50\xb6\xce\x61\x0f\xc4\xb6\x39\xec\x82\x2c\x5c\x2a\x4a\x87\x2a\x27\x1b\xa7\x41\x35\xb1\x03\x6c\x3e\x6e\x4a\x7b\x18\x7f\xea\x29\xfd\x7c\x0a\xeb\x3e\x24\x0d\x85\x7c\xcc\x80\x94\x60\x50\x25\xaa\x5f\x8e\x81\xc1\x7e\x3b\xbe\xe0\x0e\xb3\x62\x42\x22\x5b\xb9\xf4\x43\x9b\x3e\x84\x29\x01\xa9\xd2\x1f\x09\x47\xe1\x88\x5d\x2e\x5a\x70\xa2\x1e\x37\xe0\x98\x9b\xce\x32\x7d\xbb\x04\xae\x6e\x78\x1a\x5d\xba\xf3\xff\x74\xa6\xdd\x66\x14\x22\x21\x73\x4c\x3f\xb3\xe5\xae\x5b\x5e\xc0\x24\xe7\xd3\x99\xce\x13\xc6\x3d\x66\xce\x14\x6b\x06\x90\x2d\xfe\x51\x10\x5a\x6f\xc4\x3a\x1b\x61\xf4\xb1\xba\xc5\xd1\xc4\xa3\xe8\xfa\x40\x55\x3a\xbe\x9e\x8a\x72\x59\x64\xc8\xa5\x47\xcc\x54\x8c\x58\x8d\xf1\x34\x5a\xee\x60\x1c\xea\x47\x0c\xf7\xe0\x5c\x51\xb7\xb1\xad\x50\x75\xf3\x0b\x57\x33\x02\x15\xe9\x1a\x8d\x0e\xba\x56\xfc\x4c\xdb\xc8\xdd\x89\x41\xe2\xa7\x29\x4b\x8e\x90\x6a\x84\xd0\x14\x3d\x27\x5c\xc1\xc1\x7f\x6d\x9b\x79\x92\x58\x05\xeb\xd1\xc1\x41\xb2\x50\x45\x03\xfd\x6c\xb8\xa7\x9e\xb1\xb2\x11\xd3\x05\x5d\xa8\x69\x1b\x94\x18\x0c\xad\xc6\x57\x63\x54\x20\x53\x50\x50\x2e\x66\xec\x4e\x47\x56\xf5\x37\x62\xad\x31\xe9\x9d\x35\xb2\xe1\x54\x02\xae\x33\x74\x4a\x51\xdd\x44\x89\x6d\x25\x53\x2d\xe9\x4a\x5e\x8c\x13\xbb\x0b\x72\xaf\xfa\xc1\x13\x0e\xac\xc1\x1a\x45\xa3\x37\xe8\xc1\x5f\x5d\xbc\x1f\x6f\x04\x8a\xea\x57\x85\x0e\x7a\x7d\x3f\x74\x8b\x57\x77\x92\xf9\xf4\xe2\x45\xfd\xea\x4c\xcf\xae\x6a\xd3\x6c\x8d\x28\xb3\x82\x2f\xab\x53\xf0\xc8\x02\x56\x28\x4a\x5d\xeb\xc5\xc4\xdc\x30\x50\x6d\x06\x8a\xfc\x83\x12\x26\x9c\x18\xef\x86\xce\x8c\x90\x39\xf5\x36\xd0\xb5\x07\xdd\x8e\x7e\xb2\xb6\x4a\x62\xbf\xfe\xf5\x76\x79\x8b\xa2\xa7\x0b\x89\x3d\x28\xd8\x51\x2f\x68\x8d\x88\xe0\x8f\xe4\x04\xdd\x35\xdb\xeb\x8f\xe7\x19\xde\xbe\xe0\x9a\x1d\x9a\xe7\xed\x0d\x89\x04\xd7\xf0\xcc\x3c\x6f\x6f\x28\xef\xe6\x57\x65\xee\xda\x8d\xf4\xe3\xf6\x66\x0a\x8d\x18\xd7\xea\x02\x9f\x6a\x8d\x5c\x83\x9b\x54\xdf\xfc\x6d\xee\x84\xa5\x42\xbd\xc8\xb8\x19\xe6\x8b\x98\x66\x22\x8a\x68\x24\x56\x46\xf6\xce\x29\x67\x57\x1b\x24\x22\x06\x01\x2f\xe9\xbd\x56\x84\xee\x82\x2a\x91\xbc\x3f\x3f\x4d\xf4\xf7\xa9\xbe\x18\xd2\xf8\x63\x66\xd8\x17\x56\x42\xdf\xa4\xd2\x08\x66\x54\x55\xb5\x82\xf2\xd7\xde\x80\xae\xba\xea\xe0\x1c\x30\x0b\x17\x6e\xe2\x22\xb1\x8a\xc1\x58\x9b\x36\x4d\xca\xf3\xda\x54\x52\x6d\xfc\x03\xbf\xff\xde\x90\x6a\xcf\x59\x83\x73\x32\x76\x33\xd2\x26\x37\x89\xe4\x7b\x9c\xc5\xb8\xc5\x75\x4b\xe9\x17\xe5\xb5\x86\xb5\xbc\x52\x39\xc3\x4d\x20\x26\xbd\x2b\x54\x8c\x87\xe8\x4c\x14\xe8\xce\xb9\xba\x53\x2c\x42\x90\xfd\x18\xe8\xc9\x43\xa8\xaf\xbf\x68\xf8\xea\x71\x50\x2c\x4a\x0d\x48\x96\x0a\x0b\x4d\x93\xd1\x11\xab\xc4\x58\xa2\x18\xd3\x62\x2a\xea\xfd\xf2\xcb\x2f\x3b\x07\xd5\x0c\xc4\xab\x27\x7f\xd3\x44\xe1\xa5\x24\xf9\x7c\x68\x0e\x51\xf6\x7e\xd3\xe4\x69\x9f\x95\x49\x29\xd2\xcc\xd5\x8f\x23\x95\xaa\xa5\xbc\x60\xb7\x8a\x6c\x60\xfd\xfc\xbe\xa0\x93\x0c\xff\x64\x59\x3f\x86\x75\x25\xdd\x8e\x3f\x3a\xd5\xa6\x4a\xec\xd9\xef\xec\x05\x02\x83\x97\xa4\x89\x3d\x18\xc2\x4b\x0c\x12\x88\x3d\x14\x06\x30\xf5\x96\x22\xc7\x27\xc4\xf3\xa5\x2b\x78\xa9\xc5\xc5\x55\x4d\xdc\x57\x79\x0c\x55\x35\x1d\xb8\x56\xc4\xfa\x15\x84\xf3\x74\x65\x81\xf4\xf4\x7a\x86\x4a\xa4\x26\x72\x78\xbf\xd7\x83\xbd\x43\xaf\x4a\xf2\x31\xf9\x3e\xd6\x0a\x51\xab\x52\x5c\xbb\x23\xf0\x94\x9e\x63\xef\x6e\x88\x21\x05\xcc\xfc\xca\x75\xda\xf9\x15\x43\x4d\x9a\xda\x46\xf6\xe2\xab\x72\x55\x98\x15\xc1\xcb\x23\x1a\xf3\xcc\xbb\x48\xa8\x0f\xd1\xaf\x1f\x5e\xa2\x15\x78\x72\xf6\x0e\x73\x20\xaa\x2c\x34\x87\xc0\xbe\x73\x32\xfa\x15\x77\x63\xed\x64\xd0\xe0\xfa\x7d\x97\x43\x87\xcf\x55\x1a\x1d\x3e\xb9\xe4\x2f\x37\x57\x0f\xcb\x42\xa5\xbc\x90\x11\x16\x9b\x85\x84\x7c\x10\x0b\x6c\x8a\x9d\xe8\x0b\x9a\x4e\xce\x74\x0d\x3b\x6f\xf8\xc2\xb7\x69\xac\x50\x40\xc1\xf3\x38\x48\x64\xe3\x85\xf9\x6a\xa5\xcf\xb1\x3b\xf8\xf2\x1f\xbd\x18\x1c\x38\x14\xa3\xce\x15\xf2\x68\x7f\x08\x7f\x87\x97\xc8\xb9\xe4\xe4\xec\xe6\x9b\x9c\x15\xae\xbb\xe4\xa2\xfc\x5b\xd4\xf7\xcd\x0b\x44\x31\x06\xdd\x6e\xe8\x2a\xc4\xf0\x77\x62\xcc\xcd\xdf\xa8\xb9\x06\xef\x18\xe8\xae\xc9\xb3\x6f\x62\x78\xe1\x38\x79\x7f\x72\xb6\x0f\x08\xf5\x6d\x2a\xaf\x31\x4a\xa9\x92\xc3\x93\xa3\x73\x7c\x8a\xb0\x23\xd3\x5d\xff\x41\xa3\x8d\x96\x14\x2f\x6c\xce\x61\xe7\x32\xb6\x23\xee\x32\xc6\x1c\xf3\x10\x88\x63\x1f\x9f\xd4\x0d\xa5\x27\x73\x6f\xdf\x18\x50\x08\xd1\x19\xf4\x48\xa6\xa5\xa9\xba\x0c\xd0\xbe\x71\xc8\x05\x16\x97\x57\xea\xcc\xf0\x7a\x5a\x1a\x08\xa6\x0f\x86\x48\xcf\xa1\x06\x69\x96\x09\x26\x65\xec\xdd\x43\xe5\xac\x2b\x3c\x51\x61\x27\x8a\xb3\xb1\x24\x5e\xec\x42\xf9\x69\x3e\x35\xf8\xcd\xc9\xc1\xc0\x5d\x07\xc7\xc5\xc7\xde\x80\x62\xcf\x0e\xa0\x15\x96\x09\xb3\xab\x71\x97\xcd\x85\x86\x9f\x99\x8e\x8d\x34\xbc\x86\x69\xe6\xd2\xf1\xc0\x9f\x75\x4d\x83\x8d\x4b\xca\x0a\xc4\x89\x83\xb0\x23\xbe\x20\x59\xae\xb6\x04\x76\x6e\x12\xeb\xab\xe9\x69\xfb\xb0\x0e\x40\xaa\x50\x4d\x50\xbe\xa0\x99\x59\x4b\x78\x25\x35\xec\xa4\xc8\xe4\xbc\x6a\xc5\x4c\x6f\x3e\xc1\xb2\x8f\x83\x1a\xc3\x65\x20\xda\xfa\x06\x4b\xe4\x3d\x9e\xf2\x8e\xd0\x07\x32\x2f\x15\x3b\xc8\xd6\x8a\x39\x02\x81\x21\xf8\x35\x09\x75\xdc\xe6\x34\xf4\x0d\x56\xef\x7f\xeb\xa9\x9a\xdf\x7f\x87\x2f\x1c\x93\x2b\x7e\x08\x5a\x24\x93\x23\x96\x47\x3d\x5f\x30\x5e\x97\xfa\xab\xcd\x9b\xaa\x68\x89\xda\x56\xe9\x0d\xdd\x49\xfd\x68\x9b\x65\x30\xf0\x67\x0b\xa7\x3b\x13\x53\xe9\x66\x0e\x49\x29\x97\x98\xe4\x9a\xba\xc1\xc7\x29\x41\x5f\x76\xa2\xd9\x41\x13\xd9\xee\x7d\xf1\x14\x9f\xbb\x26\x14\x2f\xdb\x42\xf9\x21\xbc\x7f\x0d\x70\x46\xda\x3f\x98\x94\x63\x53\x35\xf0\xed\xe0\x52\xb0\x5f\xdd\x32\xaa\x87\x92\xb2\x26\xe9\xc6\x54\x7b\x75\x19\x5e\x4d\x4a\x97\xa6\x76\x68\xb3\x69\xa8\xb2\x17\xbb\x1a\x91\xe6\x38\x7a\xd8\x19\x12\x28\xfb\xb0\x03\xaf\xbe\x05\x0e\xff\x3d\x84\xdd\x6f\x81\xef\xec\x90\xe8\x62\x29\xec\xb7\x5d\x6f\x8a\x25\xf2\x57\xfe\xa1\x4f\xaa\xbd\x2e\x0d\x29\xc9\x55\xeb\xf2\xd3\xb9\x12\x2c\xbd\xf6\xbc\xd3\xc4\x79\x74\x92\x68\x21\xd3\xad\xda\x84\xa7\xd1\xd4\x3a\xa4\x09\x82\x67\x55\x76\x7c\xc1\xd5\x76\x8c\xd2\xac\x72\x53\xc0\x34\x89\xf1\xfe\x52\xb7\xb0\x91\x74\x2f\x50\xcc\x02\xc2\xcb\xd3\x72\x85\x9e\xcf\x06\x23\xec\x1b\x33\x26\x4e\x28\x7f\x60\x2a\x14\x4a\x92\x5c\x33\x36\xbf\xee\x7e\xc0\x78\x84\xe9\x06\xf7\x77\x38\xe7\xb5\x99\x1c\xbe\x92\x44\x8b\xb6\xb4\x12\x73\x30\x00\xd0\x88\x2f\x55\x59\x61\x5b\xe9\x44\x9c\xb1\xad\xc3\xf5\x58\x2c\x91\x39\x3e\x92\xdf\x7a\x0a\xb7\x94\x01\x7f\x85\xfd\xac\x62\x50\xa3\xc2\x76\x4d\xe9\x43\x77\xed\xb4\x74\x66\x9d\x17\xb7\xa9\x9f\x26\xa1\x00\x92\x7f\x44\x54\x5f\x60\x67\x3d\x49\xcd\x6d\x7c\x10\x04\x32\x7b\xa4\x3d\xf2\x25\xc3\xbd\x53\xba\x2f\xfc\xf7\x48\x5f\xdb\xc5\x40\xfb\xb0\xe1\x03\x29\x18\xc6\x7e\x9b\xde\xa2\x2b\xd9\xdd\xbf\xb3\x8f\xd1\x34\xba\x4e\x28\x6a\xf9\xa2\x49\x3f\xae\x4e\xcf\xd8\x8c\x3d\xdf\x85\xd2\x42\x6d\x70\x3d\xd2\x24\x0c\x9b\xb5\x5c\x84\xb4\xdd\x8d\x42\x9f\x95\xb7\xf9\x82\x2d\xeb\xe9\x6c\x4f\x86\x7c\x6b\x2e\xa6\x9f\x6e\xe9\xd2\x3b\xd6\xb7\x4c\xcd\xca\x0c\x57\xa3\xde\xd9\xf9\x89\x9e\x18\xc2\x56\x7b\x7f\x7e\xa2\x0b\x5e\xd2\x6b\x3d\xb1\xde\xa6\xff\x57\xea\xcd\xe6\xde\x93\x36\xab\x33\xfe\x7f\xe9\xf8\x9a\x09\xb7\xe7\x5c\x25\x66\x9f\xf5\x86\x0a\x68\x69\xfc\x82\x0e\xd5\xd4\xf7\x68\xf8\x0d\x1f\xcc\xd2\xd7\x91\x32\x13\xae\xb4\x89\xfd\x5c\x7a\xc3\xd6\x0b\x36\x69\x27\x78\x6c\xab\x48\x73\xc3\x4c\x0d\xad\x8e\x9b\x8e\xd5\x16\x31\x5c\x2d\x27\x6e\x11\xb7\xc8\x12\x72\xd1\xba\x65\x3b\x44\x91\x09\x41\x4f\xfd\xa7\x23\x41\x0b\x23\x09\x0d\xa0\xcf\xc5\x0a\x1d\xfa\x07\xd2\x31\xd3\x11\x3c\x7d\xe0\x39\x95\xfe\x3d\x92\x68\xe7\xe3\x42\x88\x67\x3d\x14\xcf\x73\xa4\x64\xc2\x04\xcb\xd0\xc5\x89\x3b\x6e\x0b\xe0\xb8\xc8\x70\xbf\x37\x7a\xfb\xbf\xe2\x7f\x0b\xfc\x1f\x77\x7e\xd8\xb2\xda\x3b\xe1\x1e\xde\x6c\x99\xaa\x36\x7d\xa2\xbe\xb2\x71\x78\x69\x52\x0c\x96\x79\x1e\x19\xb6\x15\x59\xe8\xe5\xc4\x3d\xbf\xd6\x83\x11\x2b\xb2\xbe\xf5\x86\x10\x0e\xf7\xb4\x49\x28\x92\x43\x74\x43\x47\xed\x23\x92\x8c\x98\x3a\x62\xa9\xf6\x3e\x45\xe8\x8a\x4e\xd0\xf9\x70\xaf\xb7\x18\xb3\x3d\x3c\x24\x21\x6e\xd8\x61\x59\x14\xd1\x8b\xd9\xde\x18\x7f\xdc\xe3\x5f\xfb\x5a\x16\x62\xdb\xdf\x3e\xf0\x32\x79\xbb\xcc\x15\x47\x8c\xbd\x65\xe5\x1d\x5b\xd1\x1b\x0a\x73\xeb\x25\x0a\xb7\xce\xe8\x48\xd2\xe2\xd0\x7f\x88\x03\x65\x85\xe0\x7f\x5a\x28\x79\x4f\xd3\x0e\xf7\x41\xb7\xea\x21\x50\xa7\x06\x13\x14\xd4\xd4\x4a\x91\x7f\x0a\x9f\xac\x1b\x1c\x45\xa9\x8f\x2e\xaf\x66\x65\x5e\x8d\xb0\xfe\x70\xb1\xb9\x66\xd7\x42\xaa\xee\xd9\xc5\x35\x1f\x81\x1b\x07\x28\x56\xa7\x71\x60\xa2\x3a\xdf\x36\x86\x97\xd4\xb2\x0f\x48\x5f\x74\x45\xfe\x94\x3e\x60\x7c\xcc\xdf\x3a\x93\x22\x19\x27\x04\x4e\xc3\x8a\xae\x2c\x29\x26\xe0\x42\xe7\x8c\x64\x2d\x86\x60\x43\x01\x5a\x81\xb6\xac\x04\xf6\xe8\x94\xbb\x54\x9c\x92\x5a\xc2\xe3\x56\x30\x18\x40\x9a\x23\x33\xee\x20\xc3\x23\x52\x38\x97\x75\x5a\x04\xe1\x86\x87\x03\x75\x64\x13\xc0\x1d\xbf\x73\x52\xe8\xde\x38\x88\x3a\x4f\xa4\x3e\x4f\x3d\x70\x78\x9e\x05\xc1\x81\x7f\x9c\x0f\x3c\x48\xdd\x2e\xc0\xe6\x4c\x5e\xca\x3d\xc3\xec\x3c\x94\x0c\x40\x53\x1c\x9b\xe0\x83\x34\x4f\xab\x54\xdb\xae\x74\x22\xb5\xba\x9b\xd9\xde\x34\x63\x9d\xcc\x74\x14\xbb\x7a\x8f\x4b\x1e\xae\xeb\xf8\x9a\x54\x82\xeb\x87\x6e\x61\xd1\x27\x1e\xab\xfe\x82\xb7\xb5\x7e\xab\xe4\x81\x20\x39\xd6\xa5\x52\x34\x8b\x5a\x72\xee\x43\x24\xd4\x78\xa1\x2f\x4a\x02\x73\x51\x92\x43\xa3\xf6\xbe\x0d\x11\x1b\xab\xf1\x13\x7c\xfd\xc4\x8e\xb0\xa4\x11\x97\xad\x63\x82\x32\xe3\xe2\x53\x0e\x8f\xe0\xed\x16\x2c\xbc\x38\x74\x03\x8f\xcd\x31\xeb\x3a\x2e\xda\x09\xd0\x44\x26\x7c\xbd\x05\x1b\x3f\xd4\xdd\x40\x67\x5b\x60\xfc\xc1\xce\x91\x8d\x47\xb1\x50\xaa\xb2\x72\x8e\x67\x5b\xec\x84\x59\x7f\xb8\x15\xcf\x6f\x7d\xb0\xa2\x6b\x96\x64\x80\x56\x08\x38\x19\x87\x5e\x84\x36\xda\x7c\x3e\xc9\xe5\x8b\x35\xe6\x68\x63\x9e\x56\xf9\x61\xe6\xef\xda\xe9\x1c\x18\xd6\x91\xd9\xc8\x06\x7b\x60\x07\x21\xe5\x5b\xe9\xc7\xda\xad\xf4\xe7\x1b\x08\x57\xe3\x45\x2f\x6e\x6e\x7e\xec\x47\xcd\xec\xc7\x14\x4e\x54\x99\xd2\xc7\xb7\xfb\xcf\xe7\x88\xae\x81\x9e\x5d\xa7\x0f\xf1\x0a\x85\x9a\xeb\xc1\x62\xdb\x8c\xf1\xaf\xef\xb3\xd6\xa3\xe5\x3d\xa9\xa8\x19\x3d\xd2\x77\xa7\x17\xf4\xe8\x1d\x88\x80\x21\xe4\x8f\x1d\x10\xa7\x52\x55\x2e\x4f\x1f\x35\x28\xa3\xd6\x51\x09\x9a\x3f\x61\x60\x48\xf5\x36\xc6\x86\x2e\xd6\xfa\xd8\xe1\x91\xb3\x18\xe4\xc6\x01\xf2\x10\xff\x04\x63\xe4\xad\x24\x76\x9c\xec\x15\x61\x43\x90\xfe\x58\xd9\x84\x22\x18\x82\x87\x82\x37\x5e\x26\x3b\x61\xeb\x90\xe8\x5c\x12\x7b\x12\xde\x80\xa7\xf4\xa3\x61\x08\x02\x41\x9b\x33\xae\x7c\xe2\x7f\x1d\x88\x5c\x2f\x7e\xd3\x27\x8d\xa0\xbb\x1c\xa7\x31\x86\xae\x8b\xfe\x53\x59\xa9\x65\xb6\x66\xab\xd8\x0f\x41\x34\x92\x33\x8c\xac\xbf\x3e\x92\x23\xbc\x3f\xdb\x7a\xb4\xcc\x07\x82\x30\xfe\xe9\xae\x3a\xa1\x48\xb8\xd9\xb4\x98\x9b\x3d\x32\x7b\x01\x26\xb1\x95\x97\x45\xd7\x58\xec\x35\xa8\x43\xf8\x4a\xdb\x64\x8e\xfd\x15\x62\x69\x23\xbf\xe3\x11\xbd\xc4\xba\x54\x8f\xa0\x0d\xed\x53\x25\x03\x10\x2f\x6c\xc9\xe8\xb2\x0c\xe3\x7c\x26\x57\x36\x6e\x89\x01\x53\x8b\x8f\x1c\x55\x98\xd0\x33\xf4\x02\x3d\xd4\x99\x01\xa1\x77\xc2\x2b\x2e\x99\x65\x0c\x0a\x1f\x9e\xb4\xa6\x24\x80\x26\x41\x78\xd1\xc0\x9a\x5b\x75\x7c\xe3\x15\x3d\x47\xbc\x3a\x82\x6e\xc7\xfe\x40\x95\x3c\x2a\x25\x7a\x5b\x58\x71\x13\xf5\x4e\x4f\x46\x17\xc7\xef\x2e\xcf\x4e\x8e\x7a\xb5\xac\x54\x74\x00\x71\xbd\xef\x35\xd5\x17\x3c\xd3\x9f\xd2\xb0\x3b\x11\xac\x64\xbc\xff\x68\x06\x8d\x31\x41\xe1\xb1\xbd\xbd\x3e\x1a\xe9\x9b\x06\x42\x81\xfb\xfd\x77\xd0\x50\xe0\x3b\xbb\xba\xb7\x75\x84\x6c\x6b\x71\x40\xb6\x75\xf2\xee\xe0\xed\xf1\x48\x7b\x90\xf6\xd1\x05\x39\x18\xd4\xe4\x20\x15\x0c\xed\x50\x12\x87\xb2\x70\x97\x39\xcf\x78\xae\x63\x0c\x63\x26\x25\x5e\x2e\x51\xca\xe4\x7d\x21\x43\xf0\x9a\x63\xed\x45\x9a\xbc\x75\x45\x84\x54\xb7\xdb\xa9\x10\xb1\xdb\xcb\xb5\xc3\xaa\xf9\x42\xd1\x39\xed\x2e\x45\xcf\x28\x7c\x67\xf8\xf5\x2d\xf0\xbf\xfe\xd5\xe5\xf3\x90\x1c\x3a\x17\x2b\xba\x3c\xe1\x3b\xbd\x63\xd5\xac\x23\xe7\x25\x55\x1b\x82\x7e\xf9\x2b\xff\x40\x76\x9b\x5c\x71\x35\x9e\xf9\x97\x64\xe8\x4f\x0d\x57\xeb\x8b\x4d\x92\xc3\xdf\x23\xfb\x80\xd6\x90\xfd\xad\xd5\xca\xbe\x0e\x99\xea\x43\x4f\xfb\xd6\x2b\x4a\x1f\xc1\x71\x11\xea\x35\x91\x2b\x1c\x01\x27\xf5\x76\x66\x7e\xf9\x0f\x98\x2f\xa5\xc2\x0f\x9b\x20\xc2\x99\x9e\x26\x94\xec\x19\xeb\x43\xcb\x78\x25\x90\x56\x8f\x3d\x8b\x88\x73\xa4\xd2\xb5\x21\x30\xf4\x50\x27\x72\xfd\x1b\x4c\xdc\x78\x34\xee\x2f\x59\x8b\xab\x64\x37\xfa\xd3\xcb\x75\x7c\x7d\x25\xf1\xa5\x0c\x30\xd2\xbe\x9e\x09\xf6\x57\xea\x44\x56\xd4\x15\xfa\xa3\x54\x0b\x25\xa2\x50\xab\xfd\x95\xf7\xfd\x96\x16\x3f\x37\xcb\x50\xdb\x63\x73\x2b\x27\xd1\x04\xab\x4d\x3c\xa7\x41\x5d\xa3\x3f\x9a\xed\x5f\x4a\xa2\x84\xe2\x85\x06\x8b\x20\x62\xd8\x60\x97\x6f\xd7\x78\x91\x42\x57\xcf\x0f\x15\xba\xf4\x4c\xba\x91\x27\x2d\x32\x93\x55\x07\x4b\xdc\xbb\xc8\x72\x29\xc6\x4c\x36\xb7\xcd\xb6\x9d\xb7\x71\x46\xd9\x92\x89\x7f\xfd\x94\x47\x6e\x50\x50\x31\x06\x07\x41\x26\x74\x52\x0f\x6f\xfd\x4a\xf0\x2f\x2c\xb1\xa7\xf7\x50\xc1\xd8\x0a\x5e\x5d\x7b\x1f\x8f\x7b\x81\xed\x92\xf7\x45\x4e\xcd\x29\x44\x47\x54\xb9\x08\x9d\x6d\x7d\x5f\xf9\xb1\xec\x2b\x49\xf9\x93\x57\x77\x7a\x85\xc0\xcb\xc9\xb4\xf0\xd8\x6d\x7f\xf0\xed\x1b\x1b\x81\xd7\x6d\xf1\x33\x83\x4c\x88\xe5\xc2\xc5\xfe\xe8\x3d\xa6\x64\x36\xb3\xfe\xec\x45\x15\x8f\xc1\xf1\xbb\x1d\x0f\xd4\xe1\x2c\x2d\x2a\xa6\xb9\x1b\x51\x44\x35\x16\x2d\xab\xbe\xcb\x8e\xd5\xfb\x61\x89\x9f\x33\x18\x5f\xa3\xbf\x2e\xb5\x1d\x23\x1a\x16\x04\x6a\x5e\x64\x04\x70\xd5\x1c\xf3\x2a\xd1\x96\x5a\xd6\x6f\x5f\xab\x6e\xdb\x69\x5c\xef\x76\xdf\x75\x5c\x69\x94\x55\x9f\xae\x5a\x27\x0b\xf6\x6d\x15\x14\x77\xaf\x2c\xff\xfa\x6b\x65\xc1\x08\x3a\xda\xac\x64\x42\xb9\x4f\x60\x09\xef\x93\x08\x76\x76\x10\x6b\xf4\x34\xdb\x77\x47\xd4\xdd\xa9\x02\xb3\x67\x44\x1d\xd7\x23\x25\x87\xc0\x71\x52\xbb\x9c\x42\x62\x4e\x56\x32\xbd\x91\x26\xcf\x87\x2a\x81\xab\x04\x0e\xd3\x3c\xb7\x0e\x2b\x63\x7a\xa9\x52\x3b\xe6\xc1\x5d\x2c\xb8\xe0\xda\x15\x87\xc3\x92\x9a\x37\xbb\x2d\x89\x88\x48\x0f\xed\x4f\x68\x05\xee\xeb\x0d\x1d\xbe\xc7\x61\xc0\x28\x9f\xa3\xc9\x5f\xc4\xba\xcd\xa5\xa5\xb6\xb2\xec\x7b\x4a\x05\x86\xe1\x11\xf9\x46\xe5\x51\x6b\x6d\xd9\x56\x1d\x17\xa7\x46\xed\x60\xa7\x1c\x54\x77\xeb\x57\x50\x5f\x73\xfd\xd4\xe6\xb1\x56\x45\x95\xaa\xf1\xa6\x40\x8b\xf6\xa3\xcd\x0b\x49\x85\x61\x0a\x5a\xe8\x81\x6c\xe0\x85\xa3\x9a\xf3\xeb\x24\x83\x5e\xe2\xe1\x62\xfc\x88\x90\x1d\x7b\x73\x3d\x0f\xde\xa1\xd4\x1c\xb0\xaa\xab\xfa\xb0\xf1\x42\xd1\xc4\x41\x99\xac\xb6\x30\xde\x10\xf7\x93\xe8\x25\x0e\xe2\xc5\xe1\x19\xbe\xed\xbb\x15\x91\xa8\xc3\x86\x7a\x9f\xee\x2b\xfc\x5d\x22\xd2\xdd\xa9\x03\x82\xe1\xe5\xa8\x8f\xf9\x22\x0f\x7d\xd0\x44\xef\x0b\xb8\x71\x11\x29\x56\x68\xe3\x19\x01\x69\xe7\x30\x4a\xfe\x24\xe5\xf8\xe9\xad\x12\x10\x30\x12\xaf\xef\xfc\xcb\x5c\x80\x69\x21\xd8\x0d\x2f\x97\xf4\xd1\x40\xb3\x1c\x5f\xb3\x45\x8b\x76\xf1\x6e\xfe\x59\xb0\x31\x2e\xa7\x8e\x41\xe1\x0a\xd3\x9e\x8c\xdd\xbc\x77\x4a\x03\x74\xa4\xb4\xdf\x35\x85\xaa\x54\xd7\xf3\x56\x73\x77\x5b\xe2\x3b\xb6\xa2\x05\x8b\xd2\xb8\x6b\x4b\x78\xd5\xb3\xe6\xfa\x60\x00\x2c\xe3\xaa\x14\x12\xca\x09\xce\x6f\xfa\xe2\x2a\x6d\xb3\x72\xe6\x7f\xe6\x06\x19\x8a\x39\x66\xf8\xed\x63\x59\x12\xae\xe8\x66\x37\xdf\x0b\x2c\xc5\x9d\xb9\xc9\x51\xf3\x61\xa8\x9b\xe3\xc7\x5c\xcc\x5a\xe2\x38\x54\xa1\xb5\x3f\x04\xa2\x03\x05\x3c\x72\xf5\x8f\xb8\xa8\x6a\x87\xfb\x0a\x9c\x2b\xab\xfa\x82\xec\xb1\xd3\x2a\x64\x6f\x41\x77\x9d\x74\xbb\xee\xd0\x43\xdf\xa5\xc4\xd0\xd2\x97\x33\x13\x05\x20\x8b\x95\xdd\x30\xdc\x90\x18\xa1\xfe\x6e\xc7\xf6\x78\x8c\xaf\xe5\xbe\x8b\xeb\x5b\x0b\xcf\xb2\xd5\x4b\x04\xe0\x93\x3a\xfd\x1a\xa6\x3e\xbe\xa0\x23\x36\x96\x40\x8c\xfd\x99\xa2\x9f\x16\x2f\xa2\xea\xd6\x4b\x74\x42\xfe\xee\x1e\x0f\x75\x9c\xc0\xf7\x5d\x06\x89\x60\xb6\xd7\xac\x1c\x3b\x91\x40\xe1\x96\x49\x20\x9c\x2e\x8f\x81\x89\x40\xfb\x74\x3a\x1d\x7b\x97\x15\xae\xd0\xe7\x7a\xc6\x45\x59\x39\xf6\x0f\xde\xf1\x49\x7d\x20\xbc\x83\x1c\x78\x1d\x8d\x4d\xe4\xae\x4d\x1f\x2d\xc4\x6e\x72\x7e\xa9\xbf\x0c\xf5\x17\x6d\x8c\x9b\x99\xcd\x32\x6b\x26\x12\x96\xce\x50\x6c\xa7\xd1\x76\x79\x4e\xad\x5b\x14\x03\xf5\xe4\xc1\xec\x57\x43\x2b\x44\xcb\xc0\xea\x89\xf8\xa8\x81\xb5\xdd\xeb\x59\x66\x49\xb6\xa4\xa1\x66\xd9\x40\xcf\x03\x99\x51\xad\x46\xcf\x0f\xee\x5a\x32\xa7\xd6\x53\xfb\xdd\x34\xbc\x0c\x62\xb2\xcc\x31\x07\x01\x14\x93\xed\xb7\x6b\x56\x00\xa2\xb5\x71\xed\xea\xf6\x0c\x7b\x39\xa1\xeb\x34\xcd\xf3\x72\x25\xe9\x26\x74\x85\x5d\x40\x4a\x51\x18\xaa\x81\x9b\x5c\xfc\xb4\xd7\xba\xb0\x54\x05\x2c\xb2\x78\xfb\x68\xe8\x49\xe7\x10\x40\x6f\x67\x80\x0a\xae\xb4\x76\xbd\x0f\x16\x36\xb3\xda\xd2\x96\xc2\xad\x6c\xcd\xee\x7d\x00\xe8\xe7\xd8\xe0\xdc\xd8\x70\x35\xe1\xfe\xc6\xbb\x09\xbd\x61\x8b\xdd\x71\x5b\x6f\xf9\xaa\xd9\x06\xfe\xae\x05\xf7\xbc\xad\xf4\x05\x1f\x85\x6a\x92\xe5\xb7\xfb\xf3\xc8\xf2\xcc\x29\x9f\x28\x17\xe4\x6a\xa1\x49\x6e\x20\xca\x6b\xf7\xe7\xd2\x64\x8d\xbe\x18\x0a\x9e\x77\x1f\xba\xff\x6f\x00\x16\x9d\xae\x19\x02\xbd\x00\x00")
 
 func templatesServerServerGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -511,12 +723,12 @@ func templatesServerServerGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/server.gotmpl", size: 19451, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/server.gotmpl", size: 48386, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerUrlbuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x51\x6f\xdb\x38\x12\x7e\xd7\xaf\x98\x0a\xd7\xab\x1c\xd8\x72\xef\xf5\x0e\x39\xa0\x4d\xd3\xdd\x2c\xba\x69\x36\x49\xb7\x0f\x45\x51\xd0\xd6\xc8\x26\x2a\x91\x32\x49\x39\xeb\x15\xf4\xdf\x17\x43\x51\xb2\x24\x4b\x6e\x9a\xa4\x0f\x8b\x7d\xb2\x2d\x92\x33\xdf\xcc\x7c\xf3\x71\xe4\xa2\x80\x08\x63\x2e\x10\xfc\x4d\x8e\x6a\x97\x31\xc5\xd2\x45\xce\x93\x08\x95\x0f\x65\xe9\x15\x05\xf0\x18\x84\x34\x10\x5e\xe8\x57\x4a\xb1\x1d\x94\x65\x51\x80\xc1\x34\x4b\x98\x41\xf0\x35\x4f\xb3\x04\x07\x4e\x87\xd5\x4e\x4c\x34\x1e\x9c\x49\xf8\xf2\xd8\x11\x11\x55\xbe\x67\xfb\xaf\x0d\xce\x51\x7f\x0d\xda\xf0\x42\x5f\xe6\x49\xc2\x16\x09\xc2\xac\x2c\xbd\x2d\x53\x50\x14\xb0\x65\x4a\xb0\x14\x21\xbc\x78\x03\x65\x09\xda\x28\x2e\x56\x1e\x8f\x69\x2d\xbc\xc6\x25\xf2\x2d\xaa\x4b\xda\x51\x96\x61\x51\x40\xc6\xf4\x92\x25\xfc\xcf\xe6\xc4\xb3\x53\x10\x3c\x81\xc2\x83\x01\x73\xa7\xe0\x9c\xbf\x95\x2a\x65\xc6\xa0\xaa\x62\xe9\xfc\x0e\x4e\xee\xe9\x6b\xd2\x49\xdc\xbe\x02\x67\xb9\x36\x32\x6d\x9b\x3c\x69\xf2\x75\x4f\xd3\x4d\x8e\x0e\x6d\x85\x37\x36\x27\xc1\xa4\x28\x50\x44\xb4\xd5\x7e\x78\x36\xb1\x7b\x38\xbd\xc8\xff\x7b\xbf\xd0\x1f\x14\xf9\x0f\x0a\xc8\xe5\x8c\xc8\x51\xd5\xbf\x17\xd2\xb3\x53\xf0\x7d\x5b\xe8\x8d\x0e\x6f\xd0\x10\xf8\x4c\x71\x61\x62\xf0\x9f\x6f\x7c\x08\x1d\x9c\xe9\xe1\xd9\x89\xd7\x98\xef\xf1\x96\x38\xcf\x0d\xa6\x0f\xa0\x6e\xf8\x3b\x4b\x72\x3c\xff\x23\x53\xa8\x35\x97\x02\xca\xf2\xa6\x47\xe0\xc3\x1d\x3d\xbe\x0e\xda\xf8\x0e\xd6\x1e\x1e\x6f\x95\x6a\x64\xc7\xa3\xb8\x36\xab\xb2\x32\x88\xfb\x3b\x38\x77\x14\xf7\x93\xc1\x3e\x60\xd4\x20\xec\x3d\xaf\x86\x77\x5c\xc3\x29\xb0\x2c\x43\x11\x8d\x40\xbf\x9e\x8e\xd9\xee\xf3\xae\x43\xbb\x61\xca\xd5\xe4\x3a\x5b\xf3\x24\x1a\x44\xf3\xe9\xb3\x23\x59\x2c\x15\x7c\x99\x1e\xdd\x4d\x35\x51\x4c\xac\x70\x8c\x8e\x55\xd8\xb3\x46\xcd\x2a\x43\x63\xb7\xca\x91\x6e\xa9\x4e\x3e\xe0\x76\x69\x9f\x73\xc5\x2a\xbd\xf6\x15\x57\x41\xba\x62\x0a\x85\xa9\xf9\x77\xa8\x76\xfa\x8e\xad\xc2\x5f\x24\x17\xaf\x77\x15\x35\x82\xa3\x59\xb4\x69\xeb\xa8\xc7\x99\x4c\x12\x5c\x1a\x2e\x45\x75\xde\xca\x46\x05\x03\x37\x03\xcb\x7e\x9a\x27\x86\xdb\x9a\xb9\x42\x6c\xf4\xb6\x93\xef\x1e\x48\xa7\x5c\xaf\xa2\x68\x5c\xb9\x36\x7a\x3b\xe9\x28\x3b\x11\x37\x41\x11\x1c\x4a\x1a\xfc\x1f\x5e\x3a\x9b\x5b\xd7\x7a\xdd\x1d\x9f\x5e\x7e\xf6\x80\xe0\xd3\x86\x3d\xc9\xbf\x2d\x9f\x16\x04\x40\xd9\x17\xcd\xfb\x08\xc0\x8f\x29\xc3\x3e\x09\x83\x5d\xd6\xa4\x62\x64\x83\x76\xf9\x19\x5a\x6b\xb2\x34\x7a\xb6\x9d\xba\x27\x57\x08\xdd\xcb\xf4\xac\x2b\x5b\xf3\x39\x9c\xc9\x08\x61\x85\x02\x15\x33\x18\xc1\x62\x07\x2b\x39\xa3\x34\xaf\x50\xfd\x0f\xde\xbc\x87\xcb\xf7\xb7\x70\xfe\xe6\xe2\x36\xf4\xea\xb6\x09\xcf\x64\xb6\x53\x7c\xb5\xb6\xfd\x32\x9f\x93\xf3\xa5\x4c\x53\x6a\xa0\xee\xda\xbe\xbe\x9e\x97\xb1\xe5\x57\xe6\x94\xe2\xca\x7d\xa7\x85\xf9\x1c\x6e\xd7\x5c\x43\xcc\x13\x84\x3b\xa6\xbb\x60\xcc\x1a\xc1\xa1\x01\x23\x65\x12\xd2\xfe\xf3\x88\x1b\x2e\x56\x60\x9a\x73\xa9\xf5\x98\x29\xb9\x45\x88\x73\x63\x4d\xad\x51\xc0\x4e\xe6\xa0\x70\xa6\x72\x61\x2d\xd5\xa6\x2d\x5c\x26\x22\xcf\xe3\x69\x26\x95\x81\xc0\x03\xf0\xe3\xd4\xf8\xf4\x89\x4a\x49\xa5\xe9\xeb\x4a\x26\x4c\xac\x9c\xff\x8c\x99\xb5\x06\x9f\x3e\xec\xb6\x4a\x26\xed\x3e\x5f\xa0\x99\xe7\x2a\xf1\x3d\xfa\xb1\xe2\x66\x9d\x2f\xc2\xa5\x4c\xe7\x2b\x39\x93\x19\x0a\x96\xf1\x39\x59\xf1\x8f\x2c\x1b\x65\xfd\x4f\x6c\x46\xba\x23\x8f\x6b\x9d\x0f\xd7\xef\x9a\x08\x34\x30\x01\xf4\x80\xc4\x81\x42\x2b\x0a\x58\xe7\x29\x13\xed\x03\x20\x33\xda\xcc\xa5\xf0\xcc\x2e\xc3\x71\xab\xda\xa8\x7c\x69\x6a\x8e\x57\x0a\x13\x5e\x31\xb3\xbe\x22\x31\xd5\x54\x27\x80\xa1\xf1\xb8\x28\xc2\x9f\xe4\xed\x2e\x43\xb7\xa3\x19\xde\xdb\x86\x7e\x23\x59\xfe\xb6\x25\xa2\x16\x13\x11\x04\xed\x37\x8f\x49\x67\x3c\xea\x8e\xbe\x23\xae\x3d\x80\x2f\x0b\xa6\x91\xf0\xd7\x03\x13\x38\xfb\x52\x41\xb0\x32\x10\x24\x28\x3a\x01\x4e\xe0\xe5\xa4\xb5\xd2\x42\x6c\x57\x66\xd6\xc7\x7c\x0e\x6c\x2b\x79\x04\xb9\xf8\x8a\x3b\x8c\x20\xd7\x6c\x85\xe4\xce\x25\xb0\xe8\x23\xa9\xe8\xfd\x91\x9b\xf5\xeb\x06\x10\x1a\x6d\x0b\x46\x10\x81\xc8\xe4\x4a\xc8\x35\xe4\x2a\x01\x77\x73\x4d\x41\x8a\x64\x07\x0a\x37\x39\x57\x18\x55\x6c\xe6\xe6\x85\x86\x88\xc7\x31\xda\xcb\x2a\x56\x32\x25\x53\xe4\x63\x6f\x4d\x67\xb8\xe4\x31\xc7\x08\xb8\xe8\xb4\x0f\x2d\xd8\xf6\xf9\x48\xb6\x68\x65\x4b\x82\x01\x32\xee\xe1\xe1\x96\x5c\x98\x66\x66\x57\xe7\x2f\xce\xc5\x12\x86\xc6\x79\x38\x19\x23\xd5\xa4\x13\x77\xb0\xc8\x9c\xad\x09\x1d\xe9\x9d\xa8\x58\x58\x4b\x6c\x7f\xfe\xbf\x41\xd3\x32\x43\x8a\xa6\xd0\xe4\x4a\x0c\x6d\xa6\x94\x53\x8c\xad\x33\xff\xa4\x94\x77\x53\xd5\x64\x7c\x2c\xb3\xfb\x3e\x39\x85\x45\xe6\xe8\xfa\x9a\xd2\x01\xcc\xa6\xc6\x82\xa3\xa6\xb4\xa3\xd5\xa3\xa0\x59\xb3\xc1\x04\x82\x93\x5c\x25\xe1\x87\xeb\x77\x53\xb0\x42\x5b\xa1\xa3\xb9\x54\xa1\xce\x13\x03\x6e\xd9\x73\x4f\xbf\x64\x15\xbe\xfe\x4d\x6e\x61\xf7\x94\x66\x40\xb2\xfe\xfe\x2f\xae\xf5\xf8\x30\xfa\xbe\x0a\x4d\x8e\xdc\x95\x14\x5e\x63\x96\xb0\x25\x06\xf6\xf9\x14\xfc\x56\xee\x8a\xe7\xba\xdc\x4f\x63\xfe\xc0\xdb\xec\x14\x66\xff\xb1\x63\x43\x15\x5a\xe5\xc1\x75\x9c\xe0\x89\x2b\x9b\x0e\x2f\xf1\x2e\xf0\x87\xa4\x9c\xeb\x7d\x13\x49\xd1\x55\xfb\x7f\xb5\x38\xe1\x57\xc3\x49\xff\xe2\xd8\x93\xd2\x8d\x55\xa3\xac\x6d\x64\x3d\x6c\x7a\xbd\x2c\x79\xdc\xb2\x70\xda\x4e\x52\x8b\xec\x7d\x32\xb5\xce\x5b\x4c\xd6\x70\x3d\x25\x81\x63\x66\xe8\x0e\x1f\x4e\x05\x76\x24\x0d\x1a\x07\xd3\xaa\x20\x13\xaf\x01\x38\x72\xb3\x38\xf3\x1b\x3b\x41\xa6\xec\x2b\x06\x44\x7e\x3b\xc7\xd9\xc9\xed\xf8\x1d\xba\x7f\xfb\x19\xfc\x5b\x6d\x76\x78\x27\xbb\x38\xae\xd9\x9d\xb5\x07\xa7\x34\xac\x9f\x8b\xa5\x8c\x30\x98\x1c\xdc\xa2\xae\xe6\xff\xae\x4e\x4d\xa9\xf8\x4e\x22\x7e\xcd\xb5\xb1\x9a\x05\x6b\x4c\x32\x54\x40\x8a\x40\x73\x06\x18\x09\x19\x13\x7c\x59\xa9\x27\x89\x5c\x4b\x61\x9d\xc5\x4a\xeb\x88\x44\x0f\x53\x12\xf2\x1e\xe4\xd0\xd1\x91\x5a\x4b\xea\x87\xb6\xe6\xf4\x6e\xa5\x54\xfb\x3f\x11\xa8\xd0\x05\xa8\xd4\xc4\x15\x9a\xc7\x90\x13\x4f\xfa\x5b\x7c\x02\xbe\x64\xe2\x85\x81\x05\xd2\xaa\x5f\x9f\x70\x79\xc9\x5d\x32\xaa\xb6\x6d\x62\xb3\xfa\x5f\x3f\xa2\x59\x1c\x85\xb1\x33\x58\xad\xfa\xb6\x57\xef\xb8\x59\x3f\x81\xa8\xd6\x92\x51\x7b\x2c\x8e\x5e\x8e\xa1\xcd\xdc\xd0\x82\x13\xe7\x49\xa3\x41\xed\xbb\xe0\x6d\x9e\xb8\x12\x52\xc5\x63\xfa\x45\xb9\xb1\x21\xe8\xe5\x1a\x53\x9c\xc2\x5a\x6a\x33\x7d\xf2\xeb\x82\x3c\x07\x6d\x17\xcd\x8d\x36\x7c\x8b\xf0\xd8\x01\xea\x34\xfe\x98\x76\xb9\xad\x6d\xb9\xa2\xb9\xa0\x15\x62\x5f\xbd\x0e\xc5\xcb\xfa\xb4\xc8\xee\xe3\xd1\x6e\x7c\x94\x3f\x0f\xec\xd4\x50\x71\x7e\x44\x1f\x5d\x31\x47\x1a\xa0\x87\xad\x6d\x35\xbc\x71\xc9\x73\x59\xac\x1f\xff\x6c\xe3\xb3\x61\xee\xf9\x55\xc1\xd8\x6b\x42\xc5\x1c\xcb\x95\x7b\xb5\x02\xa3\x97\xb0\x2c\x41\x63\x25\xe2\x31\xf4\x1f\x67\xc9\x77\x74\xc5\x78\x26\x0f\xcc\x77\xdb\xe4\xaf\x00\x00\x00\xff\xff\xd8\x0f\xb9\xa4\x4f\x19\x00\x00")
+var _templatesServerUrlbuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x73\xdb\xb8\x11\x7f\xe7\xa7\xd8\xd3\xdc\x1f\xca\x95\xa9\x74\xa6\xd3\x87\xeb\xa9\x33\x89\x93\xf4\xd2\x49\x73\xae\xed\xeb\x3d\xdc\xdc\x64\x60\x71\x29\x61\x42\x02\x34\x00\xda\xd1\xe9\xf4\xdd\x3b\x0b\x80\x24\x48\x91\xb6\x2c\x3b\x4f\xed\x93\x2d\x12\xfb\xef\xb7\xbb\x3f\x00\xcb\xed\x16\x52\xcc\xb8\x40\x98\xdc\x54\xa8\x36\x25\x53\xac\xb8\xae\x78\x9e\xa2\x9a\xc0\x6e\x17\x6d\xb7\xc0\x33\x10\xd2\x40\xf2\x4e\xbf\x54\x8a\x6d\x60\xb7\xdb\x6e\xc1\x60\x51\xe6\xcc\x20\x4c\x34\x2f\xca\x1c\x07\xa4\x13\xb7\x12\x73\x8d\x7b\x32\x39\x5f\xde\x27\x22\x52\x67\xfb\xb4\xfd\xb7\xf1\x73\xd4\x5e\xe3\x6d\xf2\x4e\x7f\xa8\xf2\x9c\x5d\xe7\x08\xa7\xbb\x5d\x74\xcb\x14\x6c\xb7\x70\xcb\x94\x60\x05\x42\xf2\xee\x35\xec\x76\xa0\x8d\xe2\x62\x15\xf1\x8c\xde\x25\x17\xb8\x44\x7e\x8b\xea\x03\xad\xd8\xed\x92\xed\x16\x4a\xa6\x97\x2c\xe7\xbf\x37\x12\x5f\x2d\x40\xf0\x1c\xb6\x11\x0c\xa8\x5b\x80\x37\xfe\x56\xaa\x82\x19\x83\xca\x05\xdd\xf9\x1d\x9f\x1c\x68\x6b\xda\x01\xae\xcd\xc0\x59\xa5\x8d\x2c\x42\x95\x27\x0d\x5e\x07\xaa\x6e\x30\xda\xd7\x95\x5c\x5a\x4c\xe2\xe9\x76\x8b\x22\x25\x8d\xf6\x4f\xb4\x8b\x3a\xee\xf4\x22\xff\xfe\xb0\xd0\x8f\x8a\xfc\x0b\x05\xe4\x31\xa3\xe2\xe0\xd9\x40\x32\xbf\x5a\xc0\x64\x62\x13\x7d\xa3\x93\x4b\x34\x31\x39\xaa\xb8\x30\x19\x4c\xbe\xb9\x99\x40\xe2\xdd\x99\xed\xcb\x4e\x3d\x5a\xfb\x75\x4b\x35\xcf\x0d\x16\x47\x94\x6e\xf2\x1f\x96\x57\xf8\xe6\x73\xa9\x50\x6b\x2e\x05\xec\x76\x97\xbd\x02\xde\x5f\xd1\xab\xd7\x41\x1d\x8f\xa8\xda\x7d\xf1\x20\x55\x23\x2b\x8e\x48\x4d\x5b\x6b\x14\xff\xb0\xda\xcb\xc7\xd4\xdc\xbd\x7e\x3f\x9b\xdb\x7b\x15\x35\xe8\x76\x5b\x57\xc3\x2b\x2e\x60\x01\xac\x2c\x51\xa4\x23\xae\x5f\xcc\xc6\x74\xf7\xeb\xae\x53\x76\xc3\x25\x57\x17\xd7\xd9\x9a\xe7\xe9\x90\x31\xf8\xf5\x37\x5f\x64\x99\x54\xf0\x71\x76\xef\x6a\xca\x89\x62\x62\x85\x23\x1e\xfa\xb0\x4f\x9b\xfd\xc4\x29\x1a\xdb\x55\xee\xe9\x16\x27\x79\xc4\xee\x12\xca\xf9\x64\xed\x22\xdf\x79\x81\x4b\xe7\x4c\xa1\x30\x75\xfd\xf5\xa8\xe1\xfb\x05\xe8\x3b\xb6\x4a\xfe\x29\xb9\x78\xb5\x71\xd5\x16\xdf\x8b\xe2\x0c\xfa\xec\x71\x26\xf3\x1c\x97\x86\x4b\xe1\xe4\x89\xf6\xbc\x1b\x78\x33\xf0\x7a\x52\x54\xb9\xe1\x76\x3f\xf6\x89\xb8\xd1\xb7\x1d\xbc\x7b\x4e\x7a\xe6\x7a\x99\xa6\xe3\xcc\x75\xa3\x6f\xeb\x9a\xa1\x6e\x73\x85\x9b\xa3\x88\xf7\xd4\x4d\xe1\xef\xf0\xc2\xb3\xe1\xad\x6f\xbd\xee\x8a\x5f\x5f\xfc\x16\x01\x25\x96\xfc\x6a\x8b\xfc\x61\xfa\xb4\x4e\x00\xec\x7a\xc5\x7b\x10\x01\x7c\x99\x34\xb4\x20\x0c\xd9\x6d\xa1\x18\x59\xa0\x3d\x3e\x43\xef\x1a\x94\x46\x65\x43\xe8\x9e\x9d\x21\x74\x0f\xe9\xd3\x5d\xe7\xdf\xf9\x1c\xce\x64\x8a\xb0\x42\x81\x8a\x19\x4c\xe1\x7a\x03\x2b\x79\x4a\x30\xaf\x50\xfd\x0d\x5e\xff\x04\x1f\x7e\xba\x82\x37\xaf\xdf\x5d\x25\x51\xdd\x36\xc9\x99\x2c\x37\x8a\xaf\xd6\xb6\x5f\xe6\x73\x02\x79\x29\x8b\x82\x1a\xa8\xfb\xce\x9b\xda\xed\xa2\x28\x2a\xd9\xf2\x13\xf3\x4c\x71\xee\xff\xa7\x17\xf3\x39\x5c\xad\xb9\x86\x8c\xe7\x08\x77\x4c\x77\x9d\x31\x6b\x04\xef\x0d\x18\x29\xf3\x24\x9a\xcf\xe1\x4d\xca\x0d\x17\x2b\x30\x8d\x5c\x61\x2d\x96\x4a\xde\x22\x64\x95\xb1\xaa\xd6\x28\x60\x23\x2b\x50\x78\xaa\x2a\x01\x66\xdd\xc6\x69\xdd\x65\x22\x8d\x22\x5e\x94\x52\x19\x88\x23\x80\x49\x56\x98\x09\xfd\x45\xa5\xa4\xd2\xf4\xef\x4a\xe6\x4c\xac\xbc\xfd\x92\x99\xb5\x86\x09\xfd\xa1\x77\x13\x47\x93\x76\xdd\x44\xa0\x99\xaf\x8d\x29\x9b\x1f\x95\xca\x27\x11\xfd\x58\x71\xb3\xae\xae\x93\xa5\x2c\xe6\x2b\x79\x2a\x4b\x14\xac\xe4\x73\x52\x39\xb9\xe7\xb5\x51\xd6\x99\xa9\x85\xa7\x7b\xfe\xf1\x7d\xf4\xf3\xc5\xfb\x26\x1c\x0d\x4c\x00\x3d\x20\xa6\xa0\x38\xb7\x5b\x58\x57\x05\x13\xa1\x00\xc8\x92\x16\x73\x29\x22\xb3\x29\x71\x5c\xab\x36\xaa\x5a\x9a\xba\xe0\x1d\xdd\x24\xe7\xcc\xac\xcf\x89\x91\x35\x75\x2b\xf4\xa4\x3d\x03\x6d\x93\x7f\xc8\xab\x4d\x89\x7e\x45\x73\x92\x0f\x15\xfd\x9b\x38\xfa\x61\x4d\x54\x67\x4c\xa4\x10\x87\xd7\x90\x69\xe7\xac\xd4\x3d\x07\x8f\x98\x8e\x00\x3e\x5e\x33\x8d\xe4\x7f\x7d\x7a\x02\xf8\x48\x49\x3c\x57\x98\xf1\xcf\xed\x43\x67\x54\x2a\x88\x57\x06\xe2\x1c\x45\x27\xea\x29\xbc\x98\x06\x6f\x82\x30\xec\x1b\x6a\x2c\x80\xf9\x1c\xd8\xad\xe4\x29\x54\xe2\x13\x6e\x30\x85\x4a\xb3\x15\x92\x0f\x64\xa6\x5a\x9a\x6d\xdf\x3d\xd7\x00\xbf\x70\xb3\x7e\xd5\x78\x89\x46\xdb\x6a\x25\xbf\x81\x3c\xf5\x79\xe5\x1a\x2a\x95\x83\xdf\xdb\x66\x20\x45\xbe\x01\x85\x37\x15\x57\x98\xba\x7a\xe7\xe6\x3b\x0d\x29\xcf\x32\xb4\xdb\x59\xa6\x64\x41\xaa\xc8\x46\xab\x4d\x97\xb8\xe4\x19\xc7\x14\xb8\xe8\x34\x18\xbd\xb0\x0d\xf6\x0b\xe9\xa2\x37\xb7\xc4\x35\x20\xb3\x9e\x3f\xdc\x56\x1c\x16\xa5\xd9\x78\xfc\x66\xbd\x15\x5e\x84\x34\x02\xf9\xad\x31\x8d\xb2\x4a\x2c\x61\xe8\x4e\x00\x27\x63\xc5\x38\xed\x40\x13\x5f\x97\xde\xdc\x94\x44\x7a\x12\x56\xa0\xe1\xe9\xfe\x25\xe2\x12\x4d\xa0\x86\x68\x51\xa1\xa9\x94\x18\x5a\x1c\x11\x5f\xcd\xe7\x10\xc8\xfc\x3f\x2b\x9d\xac\x74\xd1\x6c\x92\x32\x06\x7e\xdb\x82\x0b\xb8\x2e\x83\xa2\x3f\x0f\xfa\x90\x00\x66\x50\xba\xae\xac\x99\x8c\x8a\x49\x5b\x70\x8d\xdb\x0e\x0e\x80\x9b\xe4\x5e\x9e\xbf\x23\xc8\xb8\x86\x42\x56\x82\x76\xb6\x4a\xa4\xa8\x08\x20\x96\xd2\xf6\x21\x05\xcb\x2d\x28\x33\xdb\x5d\xf8\x99\x15\x25\xed\x1a\xdc\xac\x81\x98\xdc\xde\x8b\x4b\xe7\x9b\xed\x89\xab\x35\xd6\xce\xad\x24\x6a\xb8\xc6\x4c\x2a\xec\x02\x7c\x7c\x89\xb7\x40\xc4\xde\xc8\x13\x0a\x7d\x4f\xd9\xc3\xe5\xee\xaa\xfd\x7f\x3c\x1d\x2e\x1d\x43\x00\x3e\x5c\xe1\xc1\x8e\xb2\xf0\x8e\xb5\x17\x8d\xe4\x9c\xad\xb8\xb0\x9b\xaf\xbb\xb8\x7c\x2d\x4b\x3a\x34\xfa\xd9\x97\x8d\x32\x58\x93\xfc\x94\x65\x1a\xe9\x6c\x4a\xa0\x7d\xc0\xcf\xe6\x9c\x4e\x4e\x8e\xb0\x2c\xf5\x06\x98\x07\xb9\x59\x59\x52\x50\xa8\xab\xdc\x68\xc8\x64\x9e\xcb\x3b\x77\x4e\x42\x90\xc2\x33\x46\x2f\x63\x64\x41\x2a\x3b\x34\x68\xb2\xa5\xf9\xef\x0d\xbd\x94\x6c\x85\xda\x52\xb8\xf8\x24\xe4\x9d\x68\x31\xfd\x5a\x96\x0f\xc0\x4a\x2b\xfc\x1b\x8b\x6c\x1d\x49\x3c\xbd\x77\xa1\x05\x59\x5a\x08\x66\x90\xf3\x82\x1b\x7f\xc0\x1e\xb0\x98\x90\x7f\xaf\x64\x25\x52\x1d\x53\x95\xd3\x51\xde\x4a\xfc\xb0\xf0\x87\xf6\xa6\xf2\x05\xcf\xed\x61\x38\x6c\x85\x21\x8d\x94\x0d\x97\x80\xd8\x39\x01\x7f\x72\x3a\xa7\xbe\x51\xce\x15\xde\x1e\x93\x90\x52\xe1\x12\xd3\x47\x24\x44\xba\x4d\x38\xe3\x4a\x1b\x9b\xde\xa7\x80\x5f\x7b\xfd\x65\xc1\xf7\x88\x59\xf4\xff\xf8\xe3\x80\x5c\x94\x0a\xed\xfd\xd2\x0b\x9e\x3a\x11\xa7\xcc\xbe\xfb\xa1\x91\xb5\x3f\x17\xf0\xe2\xb1\x59\x24\xb9\x3a\x77\xad\xc7\x5e\xde\x6d\xea\xde\x3a\x9d\x38\x87\x1a\x60\x28\x51\x4f\xc9\x45\x88\x1b\xd4\x55\xc6\x85\xf9\xeb\x5f\x6a\xcc\xed\x8f\x86\x6e\x7a\x53\xc2\xdd\x8e\x67\x63\x71\x0f\x1d\xa7\x83\xa1\x60\x9d\x5b\x58\x38\x13\xf1\xc9\xe1\x7a\xa8\xbf\x82\xf9\x4b\x4f\xd1\x23\xf4\xb4\xd7\x42\xbb\x85\x51\xc3\x59\xd9\x80\x01\xdf\x53\x15\x84\x63\xb9\xe7\x09\xde\x81\xfb\xf4\xd8\xbb\x7a\x8e\x0f\x9d\x62\xfb\x91\xe9\xd7\x98\xb1\x2a\x37\x2e\x36\xaf\xbb\xed\x99\xda\x58\x38\xcb\xb8\x9d\x40\xd2\x4a\xf9\x8e\x68\x94\x87\x56\x7c\x9b\x74\x5a\x9a\x9a\xe1\x09\xe5\x1b\xf4\x96\xa8\x2b\xf5\x3e\x09\x5b\x79\x54\xf3\xd4\xe8\x23\xa0\x0f\x7e\x60\xf1\x73\x15\x77\xb5\xa3\xa9\xb4\x98\x7a\x4d\x43\xe0\xc2\x02\x86\xca\xe5\xdb\x10\x96\xae\x81\x16\x9e\x6f\x49\x69\x38\x91\x1a\xda\x96\xcf\x2a\xa5\xa5\xf2\xdb\x32\x71\xe9\x4b\xf3\xd8\x3d\x40\x1b\xa6\xec\xec\x82\x19\x60\xb0\xb4\x0a\x67\xa4\x2e\xe7\x9f\xb0\xd9\x17\x9c\x52\xba\xd4\x91\x0b\xf4\xb4\x96\x7f\x66\x22\x72\x41\xc4\xce\x8f\xe6\x90\xf3\x0c\xb9\x3c\x3a\x47\xce\x95\xfb\x13\xe3\x6b\x7b\x3e\x87\x57\xc4\xc5\xc0\x2c\x2f\xd3\x09\xcc\x32\xb8\x1d\x1f\xfb\x60\x5a\x70\x1e\x40\xa6\x03\x8b\x55\x4b\xd4\x7c\x52\xa9\x3c\xf9\xf9\xe2\xfd\x0c\xec\x50\xc8\x11\x32\xcd\xd0\x5d\x3e\xc0\xbf\x8e\xfc\x53\x3b\x57\xe8\x75\x2a\x0d\x60\xe9\x5c\xdd\x1f\x84\x0c\x4c\x54\x06\xab\xff\xa0\x0f\x1e\xbd\xd8\x86\x90\xef\x7d\x01\x39\x40\xe2\x88\x4f\x22\xf5\xa8\xb3\x17\x49\x38\xe3\xac\x31\x72\xf9\xd1\xc9\x05\x96\x39\x5b\x62\x6c\x9f\xcf\x60\x12\x60\xb7\xfd\x46\xef\xda\xc9\xf1\x64\xe0\xcb\xdb\x0c\x4e\xff\x4c\x94\xb0\x73\xc4\xdc\x3f\x63\xf8\xb4\xe9\xe4\x03\xde\xc5\x93\x81\x10\xe9\x60\xdb\xdc\x56\xa4\xe8\x0e\xa3\xbe\x0e\x6a\x62\x62\xad\x44\xdd\xe9\x4d\x38\x5b\xf2\x54\x35\x7a\xf3\x6d\xf9\xbe\x19\x29\x58\xb6\x6f\x35\x2c\x42\x90\xda\xa7\x7b\xc5\x14\xc8\x77\x79\xff\xd4\x13\x1a\x55\x66\xe2\x85\xf7\x27\x98\x76\x7c\x1e\x37\x06\x66\x6e\x18\xe6\x8f\x6f\x0f\x5d\x6d\xc2\x44\x1e\x66\xe8\x01\x8d\xb3\x50\x4d\x80\x31\xd5\xd1\xc8\xb0\xcd\xc7\x79\x63\xc7\xee\x05\xfb\x84\x31\x75\xa1\x1d\x7e\xeb\x69\xa7\xc5\x02\xb9\xa6\xc7\xda\x4f\x46\x43\x5f\x8b\xbc\xee\x4e\x8e\xbd\x83\x17\xec\xce\xea\x83\x05\xdc\xe8\xe4\x8d\x58\xca\x14\xe3\x69\x77\x71\x40\x5b\x4e\x6a\x46\x55\xe8\x8f\x9e\xff\xaa\xb4\xa1\x7a\x63\xb0\xc6\xbc\x44\x05\x44\x4d\x74\xd8\x01\x23\xa1\x64\x82\x2f\xdb\xfb\x72\xb8\x95\x04\x3b\x8c\xad\xe6\xe3\x28\x8d\xac\xc7\x15\x74\x08\xad\x26\xb5\xfa\xa1\x2d\x3e\xfa\x20\xa5\x54\xf7\xd8\x64\xbd\x8b\x51\xa9\xa9\xaf\x38\x9e\x41\x05\x8b\xfd\x25\x13\x72\x7c\xc9\xc4\x77\x06\xae\x91\xde\xfa\xbe\x69\x70\xa9\x3c\x18\x8e\x3f\x9a\xd8\x28\x66\x5d\x3f\xa2\x2f\x1b\x28\x8c\xdd\x73\xdb\x53\xb8\x59\xbb\xdd\xf0\xe9\xec\x5e\x73\x57\x6d\x71\xdb\xa6\x6d\x40\x53\x62\x91\x1b\x7a\xe1\x77\x89\x69\x43\x86\x3e\x36\xfb\xfc\x6d\x95\xfb\x14\x52\xc6\x33\xfa\x45\xd8\xd8\x10\xf4\x72\x8d\x05\xce\x60\x2d\xb5\x99\x3d\xfb\xbe\x45\x96\xe3\xd0\x84\x57\x39\xb6\x9d\xf1\x0c\xdc\xea\x0e\x03\x8d\x91\xa8\x5f\x1a\xf2\x26\x9d\x75\x82\x10\xfb\x34\xba\xcf\xa2\xd6\xa6\xf5\xec\x10\x8b\x76\xe1\x93\xec\x45\x60\x47\x74\x56\xed\x18\x51\xfb\x64\x8e\x34\x40\xcf\xb7\x50\x6b\x72\xe9\xc1\xf3\x28\xd6\x8f\x7f\x24\xb7\x17\x36\xc7\x6d\x7d\x91\x40\xc8\x09\x4d\xc2\xde\x4a\x35\x56\x2d\x54\xfe\x1e\xf5\xfa\x7e\x4a\x4a\x81\x59\x44\x50\x1b\xfb\xd5\x4c\xd3\x64\xd9\x48\x7b\x96\xbc\x5b\xf3\xe5\x1a\x98\x1f\x87\x49\x81\xfe\xdc\x88\xb0\xcc\x39\xad\x6b\x78\xa6\xd6\xb0\x94\x05\xd2\x4d\x58\xc9\x6a\xb5\x06\x06\x46\x55\x9a\x86\xa6\xa5\x92\x9f\x37\x4f\xac\xc5\xb7\x52\xc5\x0a\x4e\xec\x14\xef\xc2\xd9\x1b\x2b\x45\x1f\x26\x7d\xe5\x26\x3e\xf2\xd0\x8e\xd6\x68\xfd\x08\x26\xf5\xb7\x37\xbb\x52\x25\x57\xef\x2f\xbb\xf9\xeb\xaf\xb5\x5f\xed\x28\x89\xfd\x51\xc2\x70\x5d\x74\x5a\x4a\xd9\xd4\xd6\x03\x05\xd7\xfb\xb4\xe0\x30\x32\x63\xf4\xed\xb1\xcc\xd1\x58\x92\x3f\x0e\xda\xd6\xe6\x70\x9f\x3f\x82\xd7\x0e\x8c\x99\x0a\xae\x4b\x74\xff\x1d\x00\x5e\x5b\x50\x73\x46\x28\x00\x00")
 
 func templatesServerUrlbuilderGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -531,12 +743,52 @@ func templatesServerUrlbuilderGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/urlbuilder.gotmpl", size: 6479, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/server/urlbuilder.gotmpl", size: 10310, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4b\x8b\xdb\x30\x10\xbe\xfb\x57\x0c\x22\x87\x18\x6a\xe7\x9e\x5b\x9f\x34\xd0\x36\xd0\x84\xd2\x63\x84\x3c\x4e\x55\xf4\xaa\x24\x97\xf5\x0a\xfd\xf7\x45\x8e\xf3\xf0\xe2\x24\xec\x2e\x4b\xf6\x26\x34\xf3\xf9\x7b\x8c\xc6\x21\x40\x85\x35\x57\x08\xc4\x79\xdb\x30\x5f\x73\x14\x15\x81\x18\x33\x80\x10\x0a\xe0\x35\x28\xed\x61\x52\x2e\xdc\x07\xea\x70\xdd\x1a\x84\xa2\xab\x02\xcc\x66\x10\x02\x78\x94\x46\x50\x8f\x40\x2a\xcd\x9c\xb7\x5c\x6d\x09\x94\xd0\xf7\xa4\x6f\x1c\x3b\x8c\xd5\x06\xad\x6f\x7f\x51\xc1\x2b\xea\xb9\x56\x9f\x34\x5b\xed\x31\x07\x52\x54\x55\x8c\x59\x08\x60\xa8\x63\x54\xf0\x7b\x84\xf2\x07\x95\x18\xe3\x90\xd0\xb1\x3f\x28\x69\xd2\xb4\x63\x84\xcd\x5f\xa7\xd5\x9c\x64\xbd\xf2\x49\xf9\x95\x3e\x96\x5d\x74\x45\x14\x0e\x8f\x26\xcb\xa5\xe5\x5b\xae\xa8\x48\x24\x03\xef\x54\x55\x30\x4d\x01\x94\x3f\xf1\x5f\xc3\x2d\x56\x39\x4c\xb5\xed\xef\x16\xee\xbd\xb5\xb4\xcd\xd3\xe9\xb3\x34\xbe\x5d\x4a\xee\x7d\xea\x89\xf1\x9d\x96\x3c\x29\xf5\x6d\x08\xc9\x10\x74\x8e\x8a\xfe\x78\x90\x58\xfe\xfe\xfe\xad\x67\x85\x3b\x29\xe6\x24\x84\xd3\x3b\x32\x04\x27\xc0\xc7\xc6\x79\x2d\xd7\x74\x0b\xbb\x38\x06\x17\x87\xf6\x4d\x76\x44\x76\xd0\xfd\x98\x7d\x63\x04\xde\x78\xca\x43\x53\xcf\x1c\x72\x41\x9e\x1a\x49\xb2\xc2\xba\x0a\x38\xb4\xbc\xe3\xb4\xe7\x72\x3a\x59\x87\x45\x4d\x19\xbe\x81\x9d\x80\x33\x4b\x31\xcd\x2f\x27\x96\xad\xd0\x8f\xe2\x2e\xa2\xf2\xc1\x98\x46\xde\xcf\x2d\x63\x81\xeb\xaf\xe8\xf5\x53\x19\xbc\x17\x63\xf9\xff\xf1\x5f\x28\xa3\x12\x4f\x09\xbe\xa4\xfa\x15\x6d\x17\x48\x46\x17\xf8\x65\x1c\x0f\x01\x00\x00\xff\xff\xce\x54\xf7\x99\x06\x06\x00\x00")
+var _templatesServerVersionsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x4d\x6f\xdb\x46\x10\x3d\x73\x7f\xc5\x94\x40\x50\xb1\x65\xc8\x1c\x7a\x72\xab\x43\x9c\x8f\x3a\x45\xeb\x18\xb1\xdb\x1e\x0c\x23\xd8\x88\x43\x71\x61\x69\x97\xde\x5d\x5a\x51\x69\xfe\xf7\x62\xf6\xcb\x94\x62\x03\xed\xc9\x34\x39\xf3\xe6\xbd\x99\xd9\xb7\xaa\x6b\x78\xa3\x1a\x84\x35\x4a\xd4\xdc\x62\x03\x5f\xf6\xb0\x56\x2f\xcd\x8e\xaf\xd7\xa8\x7f\x86\xb7\x1f\xe1\xfc\xe3\x15\xbc\x7b\xfb\xe1\xaa\x62\x8c\x8d\x23\x88\x16\xaa\x37\xaa\xdf\x6b\xb1\xee\x2c\xbc\x9c\xa6\xba\x86\x71\x84\x95\xda\x6e\x51\xda\xa3\x6f\xe3\x08\x28\x1b\x98\x26\xc6\x58\xcf\x57\xb7\x7c\x8d\x14\x5c\x5d\x84\x67\xfa\x20\xb6\xbd\xd2\x16\x16\x2c\xcb\xdb\xad\xcd\x59\x96\x4b\xb4\x75\x67\x6d\x4f\xcf\xc6\x6a\x21\xd7\x26\x67\x45\xaa\xfe\x87\x1a\xa4\x43\x67\x75\x0d\x6f\xb1\xe5\xc3\xc6\xfe\x85\xda\x08\x25\x41\x18\xc0\x6d\x6f\xf7\x27\x60\x3b\x04\x83\xfa\x1e\x35\x6c\x29\xc1\x40\x23\xda\x16\x35\x91\x7c\x7d\xf1\xc1\x94\xc0\x41\xe3\xdd\x80\xc6\x82\x1a\xac\x11\x0d\x82\x6a\x29\x4d\x68\xf8\xc2\x0d\x42\xcf\x6d\x67\x08\x51\x2a\x0b\xad\x1a\x64\xc3\x56\x4a\x1a\x7b\x5c\x73\x09\x79\xce\x88\x4b\x78\x61\x80\x6b\x24\x20\x57\x07\x6c\x27\xcc\x21\x93\x92\xba\x4c\xdf\x25\xdf\xba\xc0\x3d\xec\x50\xa3\xe7\x89\x0d\xec\x84\xed\xd8\x38\xbe\x04\xdc\x18\x7c\x46\xa8\x03\x34\x94\x1c\x55\x18\xd8\x75\x62\xd5\x81\xc1\x0d\xae\x2c\x48\x05\xf7\x3e\xf6\x39\xd6\xe3\x08\xbd\x16\xd2\xb6\x90\xbf\xb8\xcb\xa1\x0a\x01\xd5\x39\xb1\x9a\xa6\xa7\x25\x05\x4c\x13\x7a\x45\xd3\xec\x86\x2d\x97\xe2\x1f\x84\x98\x49\xba\x0f\x64\xbb\x3f\xc6\x4b\xf2\xeb\x70\xcf\xf5\x23\xf6\x12\xae\x6f\xfc\x9c\x47\xc2\xd3\x5c\xae\x11\xaa\xf4\x79\x9a\x8e\x99\x86\x3a\x25\xa4\xfd\x82\x03\xba\x34\x34\x1e\xa9\x46\xa6\x44\x8a\xcb\xc6\xb5\xac\xe3\xb2\xd9\x04\x62\x42\xae\x41\x58\x66\xf7\x3d\xa6\x7c\x63\xf5\xb0\xb2\x30\xb2\xcc\x95\xf2\xe4\x58\x56\xd7\x70\xca\x0d\x5e\x70\xdb\x51\x09\x42\x4a\x9b\x12\xcb\x98\x1e\x57\xf1\x39\x30\x60\x59\xca\x8a\x48\x67\x81\x00\xd0\x9e\x57\xe1\x3f\x76\x20\xe2\xd2\xcd\x51\x69\xb0\xb8\xd9\xc4\xe1\xde\x1f\x8e\x3f\xad\x70\x09\x5c\xfa\xcd\xf7\x4b\xd5\x52\x5e\x87\xd0\xf8\x99\x26\x26\x73\x95\xa9\x40\x3b\xc8\xd5\x42\xc3\x0f\x8e\xcb\xa7\x08\x18\x52\x0c\x5c\xdf\x84\x84\x22\xd2\x27\x96\xa7\xfb\x47\x51\x0e\xc8\xcc\x25\xc3\xae\x53\x66\xde\x1d\xdb\x1d\xb6\x29\xf0\x06\x63\xb9\xb6\xc6\xad\x7c\xe9\x00\x36\x4a\xae\xe9\x83\x92\x08\xbb\x0e\x49\xea\x3d\x6a\xbe\x81\x2d\xb7\xab\x8e\x11\xd7\x59\xed\xff\x43\x9b\xc6\x49\x7b\xe7\x0f\x08\x36\xb1\x0f\x2c\xa3\x6e\x7d\x4e\xa9\x70\xb2\x0c\x3b\x98\xb0\x46\x96\x65\x4e\xcb\xc9\x32\x80\x99\xea\x4a\x8b\xed\xe5\xd0\xb6\xe2\xeb\x22\xc4\x55\x91\x55\x09\x79\x9d\x17\x2c\xcb\x44\xeb\x5b\xb0\x24\x8f\x80\x87\x07\xd8\xa0\x5c\xd0\x9b\x02\x7e\x59\xba\x7f\x22\x99\x94\x5b\x10\xcd\x2c\x5b\x29\x69\x85\x1c\x90\x65\xd9\xe4\x81\x74\xf5\xe7\xa7\xdf\x2b\x82\x87\xe5\xd2\xc3\x3e\x3c\x24\x36\x67\xdc\x5c\x68\x24\x32\x8f\x71\xa5\x8b\xfa\x91\xb8\x78\xd0\xa4\x7c\x19\xb5\x8f\xb4\xdf\x27\x51\xb9\x3b\xc0\x65\xda\xf1\x13\x97\x4f\xe5\x27\x46\x2c\x34\xda\x41\xcb\xd4\x3f\x17\x1d\x76\xf6\x74\x7f\x86\xbc\x41\xfd\xe4\x2e\x70\xe8\xfc\xc7\xa3\xd9\xd3\xa6\x9a\x38\x51\x9f\xbf\x08\x91\x5e\x56\xf1\xcd\xaa\x8e\x89\xc5\xd3\x4b\xfb\xf9\xe9\xb1\x27\xea\xf3\xd9\xf5\x7c\x85\x0b\x5d\xf9\xc2\xd5\xaf\x68\x43\xf1\xa2\x20\xb5\x5e\xd7\xa1\x69\x26\x1a\xe2\x50\xa0\x89\xef\x67\x97\xce\x8e\x9b\xd9\xb5\x4a\xfb\xcd\x68\xf9\x9e\x01\x74\x6e\x2c\x5a\xc0\x3b\xa8\x2e\x2d\x5d\xc5\xeb\x3d\xe4\x9e\x4f\x0e\xd3\x94\xfa\x73\x6c\x85\xa1\xed\xd3\x54\x90\x17\xd2\x7d\x31\x4d\x47\xe0\x8b\xc7\xe3\x52\xcc\x2e\xe4\xba\x86\x73\xdc\x85\xd8\xe0\x40\xd1\x57\x90\xaf\xba\x34\x26\xe2\x7e\x20\x97\x9e\x93\xe4\x5e\xac\x6e\x0d\xd0\x09\x12\xb6\x84\xa7\x6d\xa7\x62\x75\x4d\xdd\x7c\x9d\x30\x7d\x3a\x59\xef\xa3\x4f\xcf\x9a\xd7\x28\x34\xf2\x7b\xeb\xe9\xc0\x1a\x2d\xf9\xf9\x4f\xaf\x5e\x81\x46\xd3\x2b\x69\x10\x36\xc2\xb8\xf4\x19\x2f\x03\x22\x64\x98\x92\xaa\xf1\x27\xaa\x49\x67\x2b\xc2\x76\x6a\xb0\xc0\x8f\x79\x1e\x5c\xf6\x95\xdf\xcc\x6f\x7a\xb4\x48\xd2\x8f\xfa\x5c\x46\xb8\xf0\x3e\x6c\xdb\xcc\x92\xaa\x2a\xde\x6a\xc5\x81\xf1\xd3\x8a\x86\x2b\xc9\x90\xfb\x6c\xf9\x2d\x2e\xb6\xbc\xbf\xf6\x08\x37\xf3\xd8\xd2\xd9\x46\x84\xa4\x5d\x75\xc7\x28\xa5\xc5\xab\xb4\x84\x57\xdf\x86\xfe\x27\xa3\x8b\x4c\xae\xc3\x5b\x77\xce\x6f\x60\x19\xa3\x22\x13\x96\x85\xd2\x4b\xe0\x7d\x8f\xb2\x59\xb8\x7f\x13\xbc\xcb\x2b\xe6\xce\x31\xd7\xf1\x9e\xce\x2f\x75\x78\xa1\x77\x10\x0e\xb1\x9f\xed\xdf\x5a\x58\xd2\x79\x74\xb8\xbd\x31\x52\x09\xe2\x1d\x87\xb0\xd0\xa9\x9e\x09\x8e\xeb\x42\xbc\xe3\x52\x86\x23\x09\xcb\xa3\xe1\x3c\xfa\xea\x71\xb8\xe3\x72\xae\xec\x7b\xfa\xc5\xb7\xd0\xbb\x12\x34\x01\x07\x0d\x21\x2f\xf4\xa8\x04\x75\x4b\x6c\x52\xcb\x08\xec\xc6\xe3\x7e\xa7\x6e\x67\x80\xef\xb4\x26\xb2\xbb\x12\xda\xad\xad\x2e\xfd\x0f\xaf\x45\x3e\xc8\x5b\xa9\x76\xd2\xfd\x62\x0a\x32\xe0\xc5\x5d\x09\xf8\xb5\x77\x1e\x0b\xb4\xaf\xaa\x85\x17\x26\x2f\xdd\xcd\x5e\x26\x0f\xfb\x4d\x09\x19\x3b\x9e\x97\x90\x17\x45\xe9\xfb\x78\x69\xb9\x1d\xcc\x29\x6f\x62\xe3\x9e\x63\x5f\x5d\xd2\xf1\x3a\xbb\xba\xba\x48\x32\xa7\x82\x4d\xec\xdf\x01\x00\xc7\x2e\xdf\x09\x16\x0c\x00\x00")
+
+func templatesServerVersionsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerVersionsGotmpl,
+		"templates/server/versions.gotmpl",
+	)
+}
+
+func templatesServerVersionsGotmpl() (*asset, error) {
+	bytes, err := templatesServerVersionsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/versions.gotmpl", size: 3094, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerVersionsmainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4b\x6f\xe3\x36\x10\x3e\x8b\xbf\x62\x56\x68\x01\xa9\x50\xa8\xf6\x9a\xc0\x07\x35\xc9\xee\xaa\xd8\x24\x02\x1c\xf4\xba\x60\xa4\x91\xcc\x86\x26\x55\x92\x8a\x9b\x15\xf4\xdf\x0b\x4a\xb4\x23\xbf\xd2\xc7\x1e\x0c\x43\xf3\xf8\x66\xe6\x9b\x07\xd3\x14\xae\x55\x85\xd0\xa0\x44\xcd\x2c\x56\xf0\xf4\x0a\x8d\xba\x30\x1b\xd6\x34\xa8\xaf\xe0\xe6\x01\xee\x1f\x1e\xe1\xf6\x26\x7f\xa4\x84\x90\xbe\x07\x5e\x03\xbd\x56\xed\xab\xe6\xcd\xca\xc2\xc5\x30\xa4\x29\xf4\x3d\x94\x6a\xbd\x46\x69\x0f\x74\x7d\x0f\x28\x2b\x18\x06\x42\x48\xcb\xca\x67\xd6\x20\xac\x19\x97\x84\xf0\x75\xab\xb4\x85\x88\x04\xa1\x50\x4d\x48\x82\x50\x99\x90\x90\x40\x28\x56\x19\x08\x1b\x6e\x57\xdd\x13\x2d\xd5\x3a\x6d\xd4\x85\x6a\x51\xb2\x96\xa7\xa3\x32\x24\x41\x2d\x58\xb3\x6f\xf4\x07\x1a\x83\x2f\xd5\xb3\xb3\x1e\xb5\x0e\xab\xef\x81\x16\x3e\xea\x30\xb8\x2c\x5b\xcd\xa5\xad\x21\xfc\xf1\xcf\x10\x68\x3e\xa5\x30\x0c\xce\xf2\x02\x34\x93\x0d\x02\xfd\x1d\xb5\xe1\x4a\x1a\x2f\xff\xf7\x08\x40\xb3\x22\x7f\xc7\x38\x2b\xf2\x83\x88\x9e\x9a\x98\x90\x34\x85\xc7\x15\x37\x50\x73\x81\xb0\x61\x66\xbf\x21\x76\x85\xe0\x3b\x02\x56\x29\x41\x9d\xfd\x1d\x7b\x46\x30\x9d\x46\x90\xca\x82\x55\xa0\x5e\x50\x6f\x34\xb7\x08\x76\x07\xc5\x6a\x8b\x1a\x5e\x55\x37\x03\xe4\x16\x9e\xb0\x64\x9d\x41\x60\x42\x38\xa5\x06\xac\xb8\x35\xb0\x51\x9d\xa8\xe0\x09\x41\x28\x63\x3f\x10\x52\x77\xb2\x1c\xdb\x15\xc5\xd0\xbf\x4b\x52\xc9\xd6\x28\xf8\x37\x9c\xb3\xb5\x6c\xb1\x4c\x00\xb5\x86\xcb\x05\x8c\x9d\xa3\x99\x64\xe2\xf5\x1b\x56\xd1\x3e\xaf\x74\x39\x15\xf7\xdb\xf2\xe1\x3e\x81\x30\x8c\x49\xc0\xeb\xd1\xf3\xc3\x02\x24\x17\x2e\x78\x20\x54\x43\x3f\x32\xcb\x84\x90\x11\x6a\x1d\x93\xe0\x7c\xe8\xac\xc8\x5d\xd0\xa3\x9e\xd0\x7b\xdc\xb8\x29\x60\xa6\x64\x93\x4f\x56\xe4\xf7\x6c\xed\x7d\xa2\x77\x2a\x89\xcf\xd7\x89\xfa\x05\xf5\x36\xde\x9b\xdc\x05\x9b\x74\xe7\x70\xb3\x22\x8f\xf7\x26\x21\x48\xd3\xa9\xd9\xa3\x1b\xa8\x7a\xfc\xf2\x4b\x77\xa7\x3a\xe9\x66\xad\xe6\xda\x58\xc8\x8a\xdc\x2d\x97\x30\x0e\xa8\xc2\x9a\x75\xc2\xc2\xcb\xd4\x96\xdd\xd6\x81\xe0\xc6\xa2\x34\x09\x30\x59\x4d\xa8\x66\x6c\xfa\x09\xd8\xac\xc8\xcd\x1b\xa2\x47\x32\x6f\x0b\x1c\x98\x79\x9d\x6f\xe5\xdc\x4c\xb1\x8f\x08\x21\x41\x85\x35\x6a\x5f\x0b\x5d\xae\x3a\x5b\xa9\x8d\x8c\x62\x42\x82\x96\x69\x33\x41\x8d\xbb\xea\x98\x2a\x46\x51\x34\x59\x27\x5e\xee\xb1\xe3\xad\x07\x5d\xae\x94\xb6\x37\x68\x4a\xcd\x5b\xcb\x95\x84\xc5\xb6\x8c\x47\x6e\x85\x4b\xfc\x70\xeb\x66\x72\x5f\xda\x3f\x65\xdf\x62\x49\x5d\xc7\xa3\x98\xe6\xb2\x56\x13\xc2\x8c\x07\x9f\xca\x17\x25\x9b\x93\x99\xcc\x85\xc7\xf9\x1c\x69\xff\x67\x56\x33\x9c\x59\x6e\x7d\xff\x9f\x36\x74\x24\x9b\x5e\x2b\x59\xf3\xa6\xd3\xf8\xd1\x91\x1e\xc5\x24\xa8\x95\x86\xaf\x09\xa8\xd6\x9a\x4f\x5a\x75\xad\xeb\xd4\x84\x7b\x06\x29\x2b\x72\x7a\xad\xd6\x6b\x26\xab\x2f\x5c\xe2\xc3\x98\xd7\xe4\x6b\xc6\xed\xe5\x35\x7c\xdd\x1d\x03\xcf\x60\x56\x55\xa3\x45\xb4\x8b\x73\xd4\xdf\x59\x0e\x87\x84\xcf\x55\x3e\x5e\x7c\x75\x78\x34\x4e\x5c\x0d\x77\x36\x26\x4a\xb6\xa4\x9d\xca\x6d\x1c\xc7\xe8\x18\xb0\x74\xaf\xe5\xe5\x02\x7e\x99\x6a\xaa\x31\x01\xf5\xec\xe8\x41\xad\x69\xf4\xd3\x34\xb6\xb7\x5a\x2b\x1d\x5f\x39\x8d\xf3\x99\x0c\xe9\xe3\x6b\x8b\xb0\xd8\x8e\xfc\xad\xd6\x9f\x51\xb4\x23\x39\x1e\x76\x01\x3f\xbb\x8f\x81\x4c\x3f\x65\xe8\xed\x5f\xdc\x46\x4e\xb7\xbb\x76\xdf\xd1\x5d\x77\xe0\xf6\xcf\xcd\x76\xa7\xe9\x12\xed\x67\x26\x2b\x81\xfa\xe8\x32\xa3\xed\xda\x6d\xb4\x3b\x5e\x55\x02\x37\x4c\xe3\xa1\xd9\x3d\x6e\xbc\xd1\x19\x1c\x3f\xd1\xde\x68\x89\x02\x4b\xab\x74\x02\xef\x9a\x25\xe7\xdf\x1c\xd7\xbf\x1f\xe6\x9e\x5e\xdb\xbb\x6b\x7e\x79\xf4\xf6\xfa\x1b\x9f\xc0\xaf\xcc\x60\xc1\xec\xea\xf2\xdc\x24\x8f\x8b\xb6\xb5\x8a\xe2\x04\x7c\x41\xe7\x1d\x26\x0a\x3f\xbd\x51\x18\x0f\xc9\x1e\xcd\x41\x1c\xc7\x64\xf7\xa4\x5d\x2e\x76\x27\xd1\xfd\x9d\x98\xb1\x53\x2f\xdd\x40\xfe\x1e\x00\x3e\x25\xf8\xf5\xab\x09\x00\x00")
+
+func templatesServerVersionsmainGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerVersionsmainGotmpl,
+		"templates/server/versionsmain.gotmpl",
+	)
+}
+
+func templatesServerVersionsmainGotmpl() (*asset, error) {
+	bytes, err := templatesServerVersionsmainGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/versionsmain.gotmpl", size: 2475, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x8b\x14\x31\x10\xbd\xf7\xaf\x28\xc2\x1e\x66\xc0\xc9\xde\xf7\xe6\x27\x0e\xa8\x0b\xee\x22\x1e\x37\x24\x35\x63\x24\x5f\x26\x69\xb1\x2d\xf2\xdf\x25\x6d\xef\x74\xb7\xf4\xb6\xa8\xc8\xec\xb5\xaa\x5e\xde\xab\x57\x55\x21\x02\x85\x07\xed\x10\x58\xca\xb1\x95\xf9\xa0\xd1\x28\x06\xa5\x34\x00\x44\x3b\xd0\x07\x70\x3e\xc3\x05\xdf\xa7\x67\x22\xe1\x6d\x17\x10\x76\x7d\x16\xe0\xf2\x12\x88\x20\xa3\x0d\x46\x64\x04\xa6\xbc\x4c\x39\x6a\x77\x64\xc0\x61\xa8\xa9\x6f\x8c\x15\x21\xfa\x80\x31\x77\x1f\x84\xd1\x4a\x64\xed\xdd\x0b\x2f\x6f\xee\x31\x27\x52\x74\xaa\x94\x86\x08\x82\x48\x52\x18\xfd\x1d\x81\xbf\x13\x16\x4b\x99\x13\x26\xf9\x09\xad\xa8\x9a\x7e\x32\xc2\xdd\xe7\xe4\xdd\x15\x6b\x06\xe5\x17\xfc\xb5\xf8\x55\xf6\xae\x4f\xa2\x49\x38\x36\xc9\xaf\xa3\x3e\x6a\x27\x4c\x25\x99\xf5\x2e\x9c\x82\x4d\x35\x80\xbf\xc7\x2f\xad\x8e\xa8\xb6\xb0\xf1\x71\x88\xed\xd3\xd3\x18\x45\xb7\x05\xbe\x4f\x2f\x6d\xc8\xdd\xb5\xd5\x39\xd7\x9a\x52\x9e\x78\xab\xab\xd2\xdc\x11\x01\x3a\x55\x9f\x1d\x7a\x83\x52\x4e\x12\xf9\xc7\xb7\x6f\x06\x56\xf8\x66\xcd\x15\x23\x9a\xc6\xd8\x1c\x5c\x01\xcf\xdb\x94\xbd\xbd\x15\xc7\x0a\x21\x9a\x07\x4e\xe5\x77\xcd\x88\xec\xa1\xf7\x63\xce\x6d\x30\x78\xe6\x29\x8f\xd2\x2a\xf0\x2f\x87\xbc\x63\x7f\x6a\x49\x5d\x58\xd9\x67\x20\x61\xd4\x3d\x67\x7c\xc8\xa7\xc9\x39\xec\x0f\x42\xe2\x23\xb8\x09\x78\xe0\x28\x36\xdb\x75\xc7\x9a\x1b\xcc\x8b\xb8\x55\xd4\x76\xe2\x0c\xd1\xc2\xfe\x9c\xd3\x96\x41\xd4\xda\x16\xfd\x7f\x57\x66\xfb\x12\xa2\xfe\xba\xfc\x85\x4a\x61\x71\x4a\xf0\xaa\x1e\xdf\x6f\xb4\xad\x90\x2c\x1e\xf0\xbf\x71\xfc\x18\x00\xce\x54\xf7\x99\x06\x06\x00\x00")
 
 func templatesStructfieldGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -556,7 +808,7 @@ func templatesStructfieldGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSwagger_json_embedGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x91\x4f\x6b\x1b\x31\x10\xc5\xef\xf3\x29\x1e\x26\x05\x1b\x6a\xed\xbd\x25\x87\x12\xe7\xe0\x42\xe3\xd0\xf8\x56\x0a\x91\x57\xb3\xb2\x1a\xaf\xb4\xd5\x1f\x9b\x65\xd1\x77\x2f\xda\x38\xc5\xbb\x47\xf1\x7e\x7a\xf3\xde\x4c\x55\xe1\xc1\x29\x86\x66\xcb\x5e\x46\x56\x38\xf4\xd0\x6e\x1d\x2e\x52\x6b\xf6\x5f\xb1\xd9\xe1\x69\xb7\xc7\xe3\x66\xbb\x17\x44\x34\x0c\x30\x0d\xc4\x83\xeb\x7a\x6f\xf4\x31\x62\x9d\x73\x55\x61\x18\x50\xbb\xb6\x65\x1b\x67\xda\x30\x80\xad\x42\xce\x44\xd4\xc9\xfa\x4d\x6a\x2e\xb0\xf8\xf6\xbc\x7d\xbe\x3e\x8b\x56\x55\xd8\x1f\x4d\x40\x63\x4e\x8c\x8b\x0c\xd3\x3c\xf1\xc8\xb8\x06\x42\x74\xee\x24\x0a\xff\xa8\x4c\x34\x56\x23\xfe\xff\xd7\x8e\x43\x3b\xef\xce\x8c\x26\xc5\xd1\xea\xc8\x16\xbd\x4b\xf0\xbc\xf6\xc9\x4e\x9c\x3e\x46\x8c\xc9\xa5\x55\x44\xa6\xed\x9c\x8f\x58\x12\xb0\x60\x5b\x3b\x65\xac\xae\xfe\x04\x67\x17\x44\x28\xb1\xbd\xb4\x9a\x21\x36\xdc\xc8\x74\x8a\xdb\x11\x0f\x18\x5b\x76\xde\xd8\xd8\x60\xf1\xe9\xef\x02\x22\xe7\x77\xfe\x5a\xfd\xe6\xef\xdd\x1b\xf7\x9f\x71\x77\x96\xa7\xc4\xf8\x72\x0f\x31\x31\x29\x2a\x72\xc6\xcc\xef\x8a\xcf\x5c\x57\xe3\xda\x5e\xde\xdb\x7c\x7f\xd9\x3d\x81\xdb\x03\x2b\xc5\x0a\x67\xf6\xc1\x38\x0b\xd7\x4c\x1a\x2b\x57\xa7\xf1\x46\x29\xb0\x82\x8c\x1f\x2b\x28\x68\x34\x2d\xd3\x59\xfa\x89\x61\xe9\x2e\x7e\xca\xcb\x0f\x0e\x41\x6a\x26\x6a\x92\xad\x61\xac\x89\xcb\x15\x06\x02\x26\xf4\xfd\x9c\x5f\xfe\xfa\x7d\xe8\x23\x2f\x5f\xcb\xc5\x6f\xc9\x9c\x5f\x57\x2b\xca\xf4\x2f\x00\x00\xff\xff\x76\xbc\xce\x2f\x7e\x02\x00\x00")
+var _templatesSwagger_json_embedGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x91\x4f\x6b\x1b\x31\x10\xc5\xef\xf3\x29\x1e\x26\x05\x1b\xea\xd5\xbd\x25\x87\x12\xe7\xe0\x42\xe3\xd0\xf8\x56\x0a\x91\x57\xb3\xb2\x1a\xaf\xb4\xd5\x1f\x9b\x45\xe8\xbb\x97\xdd\x38\xc5\xbb\x47\xf1\x7e\xf3\xf4\xde\x8c\x10\x78\x70\x8a\xa1\xd9\xb2\x97\x91\x15\x0e\x3d\xb4\x5b\x87\x8b\xd4\x9a\xfd\x57\x6c\x76\x78\xda\xed\xf1\xb8\xd9\xee\x2b\x22\xca\x19\xa6\x41\xf5\xe0\xba\xde\x1b\x7d\x8c\x58\x97\x22\x04\x72\x46\xed\xda\x96\x6d\x9c\x69\x39\x83\xad\x42\x29\x44\xd4\xc9\xfa\x4d\x6a\x1e\xe0\xea\xdb\xf3\xf6\xf9\xfa\x1c\x34\x21\xb0\x3f\x9a\x80\xc6\x9c\x18\x17\x19\xa6\x79\xe2\x91\x71\x0d\x84\xe8\xdc\xa9\x22\x21\xf0\xa8\x4c\x34\x56\x23\xfe\x9f\x6b\xc7\x40\x9d\x77\x67\x46\x93\xe2\x68\x75\x64\x8b\xde\x25\x78\x5e\xfb\x64\x27\x4e\x1f\x5f\x8c\xc9\xa5\x55\x44\xa6\xed\x9c\x8f\x58\x12\xb0\x60\x5b\x3b\x65\xac\x16\x7f\x82\xb3\x0b\x22\x0c\xb1\xbd\xb4\x9a\x51\x6d\xb8\x91\xe9\x14\xb7\x23\x1e\x50\x4a\xce\xe8\xbc\xb1\xb1\xc1\xe2\xd3\xdf\x05\xaa\x52\xde\xf9\x6b\xf5\x9b\xd9\xbb\x37\xee\x3f\xe3\xee\x2c\x4f\x89\xf1\xe5\x1e\xd5\xc4\x64\x50\x51\x0a\x66\x7e\x57\x7c\xe6\xba\x1a\xd7\xf6\xf2\xde\xe6\xfb\xcb\xee\x09\xdc\x1e\x58\x29\x56\x38\xb3\x0f\xc6\x59\xb8\x66\xd2\x58\xb9\x3a\x8d\x37\x4a\x81\x15\x64\xfc\xd8\xf2\x80\x46\xd3\x32\x9d\xa5\x9f\x18\x0e\xdd\xab\x9f\xf2\xf2\x83\x43\x90\x9a\x89\x9a\x64\x6b\x18\x6b\xe2\x72\x85\x4c\xc0\x84\xbe\x9f\xf3\xcb\x5f\xbf\x0f\x7d\xe4\xe5\xeb\x70\xf1\x5b\xb2\x94\xd7\xd5\x8a\x0a\xfd\x1b\x00\x76\xbc\xce\x2f\x7e\x02\x00\x00")
 
 func templatesSwagger_json_embedGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -576,7 +828,7 @@ func templatesSwagger_json_embedGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesTuplefieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _templatesTuplefieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func templatesTuplefieldGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -596,7 +848,7 @@ func templatesTuplefieldGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesTupleserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\x5f\x6f\xdc\xb8\x11\x7f\xb6\x3e\xc5\x74\xe1\x73\xa5\x74\x23\x37\x46\x9f\x72\x70\x01\xe7\x92\xeb\xf9\x80\xb3\x0f\xc9\x5d\xfb\x60\x18\x39\xae\xc4\xb5\xe9\x68\x29\x85\xa4\xec\x6c\x05\x7d\xf7\x62\x48\xfd\xa1\x44\x6a\x57\x4e\xed\xa0\x40\xf3\x10\x58\x12\xc5\x99\xf9\xcd\x9f\xdf\x0c\xb5\x55\x05\x29\x5d\x33\x4e\x61\xa1\xca\x22\xa3\x1f\xa8\x60\x24\x63\xff\xa6\x62\x01\x75\x1d\x1c\x1f\xc3\xef\x7c\x43\x84\xbc\x25\xd9\xcf\x1f\x2e\x2f\xa0\x6c\xaf\x24\xa8\x5b\x26\x41\xbf\x04\x6a\x5b\x50\x58\x8b\x7c\x03\x04\xf4\x32\x22\x04\xd9\x06\xeb\x92\x27\x10\x56\x55\xfc\x9e\x26\x94\xdd\x53\x71\x41\x36\xb4\xae\xe1\x45\x55\x41\x41\x64\xa2\x05\x41\x8c\x77\xa1\xae\xa3\xa1\xa8\x50\x90\x07\xb8\xba\x5e\x6d\x15\x8d\x80\x0a\x91\x0b\xa8\x02\x80\xe3\x63\x90\x8a\xdc\x50\x78\xb5\x84\x1b\xaa\x40\xdd\x52\x23\x0d\x56\xa5\x82\xbb\x52\x5a\xb7\x02\x80\x7b\x22\xcc\xfa\x57\x70\x75\x7d\x27\x73\x1e\xbf\x27\x0f\xbf\x50\x29\xc9\x0d\x0d\x00\x56\xe5\x1a\x5e\x9f\x02\x0a\x91\xf1\x05\x7d\x78\x53\xae\xd7\x54\xa0\xe8\x28\x00\x48\x69\x82\x4f\xf5\x6b\x17\xf4\xe1\x2d\x4d\xf2\x94\x8a\x70\x55\xae\x9b\xa7\xf1\xef\x92\x5e\x94\x9b\x15\x15\x61\x14\x04\x00\x6c\x8d\x9a\xe2\x3b\xf8\xd0\xac\x0f\x8f\x8c\xfc\xe8\x7b\xfd\xec\x4f\xa7\xc0\x59\xa6\x4d\x01\x10\x54\x95\x82\xe3\xfd\x00\xa0\x0e\x6c\xf3\x4e\xaa\x0a\xb7\x8b\xcf\xd2\x94\x29\x96\x73\x92\x9d\x2b\xba\x91\xe8\x14\x63\x55\x46\xa4\x3a\xe7\x29\xfd\x02\x8c\xab\x00\xa0\xaa\x80\xf2\xd4\x3c\xaf\x2a\x10\x84\xdf\x50\x38\x64\xe9\x97\x25\x1c\xde\x93\x0c\x95\x8a\x7f\x15\x79\x41\x85\x62\x14\xf7\x61\x6b\xc8\x28\x0f\x1b\xed\xe0\xef\xf8\x16\xae\x87\xba\x6e\xd4\x43\x74\x5c\x70\xcc\x0b\x57\xfd\xea\xeb\x48\xaf\xde\x8d\x96\x8b\x17\xde\xf3\x02\x66\x2c\x0f\x79\xae\xb4\xe6\xf1\xb9\xbc\x28\xb3\x8c\xac\x32\x1a\x41\x5d\x1f\x75\x86\xa2\x06\xf8\xdc\x8e\x2e\xa8\xeb\x78\x10\x5d\x7a\x45\x1b\x61\x1e\x17\x8c\x9c\x00\x50\x1b\xf1\x87\x7e\xe4\xc1\xc2\xfd\xd4\x42\xac\xaa\x28\x4f\xf5\x8a\xda\xf5\xc5\xa4\x1f\x1d\x17\x74\x9b\xff\xe5\x55\xa3\xdf\x3a\x17\xf0\x71\x09\x8d\x07\x8d\x57\x1b\x0f\x58\x8b\x5f\x5f\x77\xe6\x60\x6c\xa8\x9c\xa4\x29\x4a\x56\x74\x53\x64\x44\x51\x58\xc8\xe4\x96\x6e\xc8\x6f\xdb\x82\x2e\x26\x94\x99\xf6\xf8\x3d\xc9\xa2\x66\xc1\x3e\x27\xfb\xdd\xbc\xd3\xd1\xe8\xe7\xb1\x46\x96\xcf\x07\x2e\xd7\x86\xf9\xdd\xe8\x38\xd2\xb8\x02\xff\x55\x15\xc4\xff\x24\x59\x49\xdf\x7d\x29\x04\x95\x92\xe5\x1c\x83\xfc\x14\x48\x51\x50\x9e\x86\xfe\xe7\x4b\x03\xa3\xb1\xc0\xf1\x6c\x23\x8c\xb3\x2c\xa8\x03\xac\x93\xbf\x58\x55\x72\xb2\x46\x32\xae\xf2\x79\x35\x72\xa2\x44\x5a\x52\xc2\x08\x42\x53\x1f\x97\xa6\x3e\x46\x1a\x8b\x94\x28\x82\x38\x5f\x5d\x33\xae\xa8\x58\x93\x84\x56\x75\x65\x17\x85\x61\x19\x70\x44\xc7\x5e\xd1\x4b\xdb\x78\xd8\x17\xd8\x6d\xd4\xf6\x31\x8b\x18\x8f\x9d\xec\xf1\x89\xf1\xa6\xb6\xa1\x73\x0f\x5e\x2d\xe1\x3e\xf2\x24\x57\xe3\x05\x1d\x8e\x0d\x34\x7a\x79\x14\xd4\x41\xbf\xce\x22\xb9\x5b\x22\xdf\x32\x99\x08\xb6\x61\x9c\x28\x9a\x3e\x96\xef\xf2\xd5\x1d\x4d\x14\x3c\x30\x75\x0b\x04\x8a\x3c\xdb\x6e\x72\x51\xdc\xb2\xc4\xe5\x40\xa9\x44\x99\xa8\x52\xd0\x67\xe1\x41\x4c\x73\x0d\xd3\x20\xcb\x51\xaf\xbc\x54\x6f\x88\xa4\x98\xea\x6f\xf2\x74\xbb\x80\xd8\x60\xf5\x4d\xb8\x4e\x83\xef\xe4\xe7\x81\x4b\x74\x26\x7a\x72\x01\xf1\xb9\x6c\xd5\xc5\xbf\x3f\x94\x2b\xfd\x67\x53\x92\xd0\xcc\x15\x91\x74\x68\xe6\xcf\xa5\xf4\xdb\x38\x55\xc1\x1a\x23\x8d\x99\x8f\xe0\xa8\x69\x92\x3a\x42\xad\xe6\x11\xca\x20\x66\x07\x89\x78\x96\x65\x97\xeb\x56\x73\xab\x1a\x5a\x90\xd4\x56\x11\xf3\xa5\x6f\x57\xfd\xbc\x80\x86\xcd\x76\xef\xbe\x14\xb9\x50\x34\x8d\xec\x37\x00\x08\xca\xf7\xa7\x7b\x6b\x72\x17\x8b\x55\x85\xd9\x7e\x2e\xcf\xb0\x6c\x69\xf2\xb5\xde\x32\xf9\xfc\x8f\xbc\x51\xf9\x43\xc6\x12\x5a\x55\x34\x93\x74\xbc\xb2\x5b\xd3\x30\x66\x38\x76\x15\x46\x90\xbf\x04\x45\x4b\x10\x25\x57\x6c\x43\x63\x4c\x8c\x1f\x72\x2e\xcb\x0d\xba\x29\xb2\x4c\x6a\x9c\xd5\xb8\xe4\xe8\xa8\xbd\x62\x79\xfc\xee\xf2\x47\x8b\x2d\xbc\x8c\x61\xb3\xc6\xb0\xd2\xb8\xd7\x23\x92\x77\x9b\x2f\x8f\xa3\x8c\x93\x08\x4f\x21\x6c\x3d\xad\xf1\x84\x68\xd2\xe9\x09\xd9\xd0\xbd\xae\xb1\x70\x75\x00\xc5\xd8\xdf\x87\xdc\x6c\xd4\x26\x38\x16\xed\xcf\x24\x6d\x8d\xeb\xcc\xf2\x1a\x85\x39\xed\x33\x0c\x06\x76\xec\xec\x48\xba\x7c\xde\x9b\xd1\x13\x39\x0d\x50\x72\xac\xd8\xe9\xe5\xea\x0e\xb1\xdc\x90\x4f\x34\xdc\x90\xe2\x4a\x2a\xc1\xf8\x8d\xcd\x9d\x3b\x5b\x98\xa3\x7e\x9b\x47\xb7\x25\x6c\xdd\x2a\x31\x91\x82\xf9\x27\x94\xd6\x4b\xc0\x9e\xbb\x10\x8c\xab\x35\x2c\xbe\xfb\xbc\xe8\x56\x5e\x7f\x8f\x4b\x7b\x89\x6c\x0d\x32\x4b\xdc\x4d\x9d\x3d\xbd\x72\xe3\x70\xd0\x3a\x44\xa3\xcd\x3b\x72\x97\x59\xf2\xce\x6e\x4a\x7d\x12\x7b\x4a\x6f\xff\xc9\x2c\xc1\x10\x5c\xc2\xc7\x8e\x6e\x5a\xe2\xd6\x1b\x46\xe3\xd5\x97\xab\x3b\x7f\xc4\x8f\x6a\x8e\x13\xf7\x8d\xa4\x59\x55\xe3\x91\x75\x63\xa2\x72\xd8\xb5\x63\x2a\x7b\x07\x5d\xa7\x27\xb7\x8d\xc5\x91\xb7\x1e\xd5\x4e\xce\xb9\xf5\xa7\x69\x0d\x04\x95\x65\xa6\xfc\x5d\xe4\x80\x83\x0e\x3f\x2e\xe1\xb0\x20\x82\x72\xa5\x47\x44\x0f\x25\x35\x8f\x77\xb3\xd2\x70\xd2\x6c\x5f\xd9\x45\x55\x9c\x76\xcb\xac\x96\x2c\x17\x3f\x32\x9a\xa5\x83\xb9\x2d\x18\x40\x8a\x1a\x99\xb1\xb0\x65\xb5\xe1\x12\x68\x8c\x8f\x6d\x80\xed\xed\x8c\x80\x53\xdd\x57\x4c\x0f\x8b\x43\xa1\xba\xba\x7d\x9d\x18\x97\x64\x77\x88\xe1\xe9\x08\x28\x57\x30\x5b\xef\xd1\x1c\xc3\xb6\xd7\xcd\xbf\x26\x8c\x46\x21\x7d\xfc\x02\x2e\x72\x73\x76\xa2\x3b\xd9\x07\xfa\x67\x41\x21\xcb\xf3\x4f\x8c\xdf\x60\xca\xc7\xf0\xe2\x78\x82\x3c\x73\xa1\xf3\x2e\xfc\xdb\xc9\xc9\x12\x16\x8c\xdf\x93\x8c\xe1\xf8\xd9\x09\xac\x6b\x1c\x60\x4b\xfa\x1a\xbe\xfb\xbc\x58\xee\x51\xdf\x1f\xfb\xfb\xb9\x78\x00\xd4\x7f\x11\x97\x4d\x70\xb9\xb1\xfe\xf4\x3e\xf7\xfa\x77\x9f\xeb\xe0\x14\xdc\x1e\x69\x72\xfb\xa7\xed\x5f\x7a\xdd\x4c\x33\x68\x81\xe4\x2b\x67\x1a\x8e\xde\x48\x6f\x35\xea\x87\xca\x53\x98\xbb\x6f\xbf\xe5\x44\xb7\x68\x6d\x6b\x5b\x87\x03\xd7\xf8\xb8\x08\xda\x64\xe9\xc7\xc9\x39\x43\xfd\x9e\x41\xb0\x1f\xf3\xf7\x8f\x81\x5f\x37\xea\xeb\xc3\x27\x61\xdb\x82\xe5\x7b\x64\x5c\x70\xa0\x07\xa8\x57\x4b\x58\x9d\x34\x43\xa4\xb9\x85\xec\xa6\x77\x0a\x0e\xf0\x29\x5e\x8e\xd8\x78\xdf\x5c\x79\x29\x2e\x72\xde\x56\x60\x33\x80\x45\xc1\xc1\x90\x46\xab\xe0\xe0\xa0\xc7\x54\x8b\x09\x0e\xea\xe0\x60\x75\x32\x4f\xa4\x2d\xef\x8c\xa7\x5f\x2f\xb0\xb9\x27\x1f\xc8\x4d\xfc\x43\xce\x13\xa2\x34\xac\x06\x98\x68\xd9\x78\xdc\x7b\x62\xa0\x53\x79\x78\x4c\x30\x0b\xf9\xb9\x47\x09\xdf\xee\xc8\x60\xe7\xdc\x39\x4c\xf6\xee\x74\xc1\x68\xd4\x71\x45\x55\x39\xa5\xc1\xe5\xf5\xa9\x09\x76\xb8\xe4\x70\x07\x81\x57\x55\x1f\x07\x85\x60\xf7\x46\x8b\x35\x56\x13\x6b\xd4\xdf\x51\x43\xc1\x93\x54\x26\xd3\x26\x4e\x44\xb1\x10\xfc\x81\xc1\xf8\x7a\x81\x35\xa2\xaf\x33\xad\x41\xef\xe9\xe7\x92\x09\xad\xea\x32\xdf\x30\xdc\x46\x6d\xbb\x80\x59\xfc\xe1\xf0\xf8\x00\x17\x33\x09\xff\xcf\xe0\x30\xfa\x10\xf2\xac\x96\x5b\xd7\x0e\xff\x60\xdc\xfd\x44\x64\x7f\x2e\xe8\xe3\xe5\x7e\x66\x1e\x22\x35\xb4\xcc\xb7\x87\x4b\x17\xf3\xdf\xe9\xa8\xcb\x70\x93\x35\x1a\xce\x39\x56\x1f\xd8\xd1\xc2\xfb\xb2\xc1\xca\x0b\xc3\xe4\x91\xbc\xdf\xfc\xf6\x60\xa7\x39\x2e\x8b\x46\xb4\xef\x35\xd2\x0c\x4c\xc3\x26\xc1\x13\x35\xb3\x5e\x1f\xc1\x73\x35\x0b\x95\xd6\xb2\x31\x20\x23\x48\xf4\x7f\xfd\xb4\xad\x8b\xf6\x7b\x4a\xd2\xb6\xb4\x2d\x61\xe2\x98\xd1\x99\xcb\xf4\x4e\x7b\xce\x63\x86\x95\x7c\xea\xf8\xdb\xdb\x74\xd9\x58\xee\xe8\xa6\xfa\x9a\x78\xc6\x73\xbe\xdd\xe4\x65\x2b\xbf\x39\x09\xb9\x27\x82\xe3\x4e\xd3\x07\x21\x3d\x1a\x4e\x8b\x54\xd7\x8f\x3a\x07\x3a\xf2\xc8\x8b\xfa\x28\xf0\xa2\x3d\xf5\x8a\xb6\x71\xbe\x17\x7c\x1f\xea\x30\xa7\x8a\x5f\x49\xf2\x09\xcb\x50\xab\xfc\x62\xd1\xb5\x83\x63\xb9\x63\xa8\x7d\x5f\x03\x1e\xd1\xbe\x3d\x7f\x9b\xd6\x9d\x62\x7f\x2c\x88\x50\x12\xae\xae\x9b\x36\xec\x09\xf8\xd8\xcb\xc6\x3b\x49\x78\x92\x76\xf6\x32\xcd\xae\x2e\xfe\x79\x99\x75\x40\x2a\x03\x2a\x7d\x36\x6b\x9e\x94\x1f\xc7\xfa\xe3\xdf\x8f\x64\xc2\xff\x57\x0a\x9c\xcd\x7d\xb3\x38\x6b\x3f\x06\xcf\x47\x71\x2e\xc1\xed\xa0\xa5\x69\xa6\x69\x7e\x73\x30\x8b\xb2\x9c\x52\x89\x1a\xbd\xd5\xdf\x52\x6d\x66\xfd\x97\x60\x8a\xea\xea\x65\x3e\x9c\x3a\x67\xa1\xc3\x9a\xde\x8d\x55\xad\x1d\x4d\x5d\xeb\x8e\x35\xcd\xf5\xb2\x93\x16\xf9\x4a\xf6\x34\x25\x7a\x6a\xfe\x94\xbe\x13\x48\x4c\x53\xca\xd3\x19\xe7\x63\xc4\x9d\xd4\x04\x13\x13\xa8\xd9\x30\x8e\x63\xcf\x10\x6a\x4f\xa1\x26\xdc\x46\x5f\xab\x51\xc4\xcb\xa6\x1d\x18\x7d\x37\xb5\x30\xfe\x89\xf8\xcf\x6d\xfb\x58\xde\xf5\x51\x3c\x1e\x1e\x17\x75\xfd\x4d\xf3\xb5\x27\x3e\x97\xbf\xe9\x9f\x37\xf4\xab\xfa\x7d\x9d\x5f\x94\xc5\xe0\x79\x7f\x57\xf7\x3f\xd8\x8e\x78\x16\x4e\xec\xce\x53\x78\x69\xff\xfa\xc6\xc6\xe0\xa5\x6f\xef\x19\x10\x20\xd6\xf6\x47\xae\xf0\x46\x41\x98\x51\xde\xd0\x77\x04\x7f\x8d\xc6\xa5\x09\x22\xbf\x34\xe7\x4c\xc1\x55\xbd\x0f\x83\xff\x04\x00\x00\xff\xff\x4a\xa2\x7a\x16\xa3\x27\x00\x00")
+var _templatesTupleserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\x6d\x6f\xdc\xb8\x11\xfe\x6c\xfd\x8a\xe9\xc2\x97\x4a\x81\x22\x37\x6e\x3f\xf9\xe0\x02\xce\x25\xd7\xcb\x01\x67\x1f\x92\x3b\xf4\x83\x61\xe4\xb8\x12\x65\xd3\x91\x28\x85\xa2\xec\xb8\x82\xfe\x7b\x31\x14\xa9\x57\x72\x57\x71\xe3\xa0\xc0\x25\x48\x20\x2d\xc9\x79\x79\x66\x38\x0f\x87\xbb\x4d\x03\x09\x4d\x19\xa7\xb0\x91\x75\x99\xd1\xf7\x54\x30\x92\xb1\xff\x50\xb1\x81\xb6\xf5\x8e\x8e\xe0\x77\x9e\x13\x51\xdd\x90\xec\xe7\xf7\x17\xe7\x50\x9b\xb7\x0a\xe4\x0d\xab\x40\x2d\x02\xf9\x50\x52\x48\x45\x91\x03\x01\x35\x8d\x08\x41\x1e\xbc\xb4\xe6\x31\xf8\x4d\x13\xbd\xa3\x31\x65\x77\x54\x9c\x93\x9c\xb6\x2d\x3c\x6f\x1a\x28\x49\x15\x2b\x45\x10\xe1\xa7\xd0\xb6\xc1\x54\x95\x2f\xc8\x3d\x5c\x5e\x6d\x1f\x24\x0d\x80\x0a\x51\x08\x68\x3c\x80\xa3\x23\xa8\x24\xb9\xa6\xf0\x32\x84\x6b\x2a\x41\xde\xd0\x4e\x1b\x6c\x6b\x09\xb7\x75\x35\xfa\xc8\x03\xb8\x23\xa2\x9b\xff\x12\x2e\xaf\x6e\xab\x82\x47\xef\xc8\xfd\x2f\xb4\xaa\xc8\x35\xf5\x00\xb6\x75\x0a\x27\xa7\x80\x4a\xaa\xe8\x9c\xde\xbf\xaa\xd3\x94\x0a\x54\x1d\x78\x00\x09\x8d\x71\x54\x2d\x3b\xa7\xf7\xaf\x69\x5c\x24\x54\xf8\xdb\x3a\xd5\xa3\xd1\xef\x15\x3d\xaf\xf3\x2d\x15\x7e\xe0\x79\x00\x2c\x45\x4b\x71\x0d\x0e\x76\xf3\xfd\x67\x9d\xfe\xe0\x7b\x35\xf6\x97\x53\xe0\x2c\x53\xae\x00\x08\x2a\x6b\xc1\xf1\x73\x0f\xa0\xf5\xc6\xee\x1d\x7b\x00\x4d\x03\x82\xf0\x6b\x0a\x87\x2c\xf9\x1c\xc2\xe1\x1d\xc9\x50\x76\xf4\xab\x28\x4a\x2a\x24\xa3\x15\xb4\x2d\x4b\x21\xa3\xdc\xd7\x4a\xe0\x9f\xb8\x0a\xe7\x43\xdb\x6a\x2d\xe8\xe4\xd2\xc7\x6e\xc1\xe5\x30\xfb\x2a\x50\xb3\x77\x3b\xbd\x74\x1b\xc0\xe5\x37\x8a\xbe\x23\xd9\x24\xfa\xd0\xb6\xd1\x24\xfa\xe8\x54\x9f\x01\x16\x88\x66\x20\x21\x4c\xdd\xbf\xa6\x01\xca\x13\x4c\x52\xf5\xcc\x52\x88\xce\x92\x84\x49\x56\x70\x92\xbd\x95\x34\x47\x70\xc6\x88\xfe\x3d\x54\x99\x41\x33\x9a\x53\x2e\x2b\xb4\xa1\xcb\x95\x82\xd3\x0a\x8a\x54\x3d\x77\xf9\x4c\x04\x05\xd2\x0b\x03\x86\xd2\xba\xf0\x2e\xa0\xce\x28\x9f\x05\x44\x1b\x9e\x16\x02\x3e\x84\xa0\x63\xd6\xc5\x71\xc0\x7c\xb9\xec\xe4\xaa\xf7\x18\x93\x56\x16\x24\x49\xd0\x31\x49\xf3\x32\x23\x92\xc2\xa6\x8a\x6f\x68\x4e\x7e\x7b\x28\xe9\xc6\xe1\xab\x3b\xda\x77\x24\x0b\xf4\x84\x7d\x01\xb6\x87\xd8\x15\x64\x65\xa7\x2d\xb7\x2d\xa1\xeb\x02\x87\x7f\x9b\x06\x76\xa7\xc5\xdc\x3f\x93\x22\x70\x0a\xa4\x2c\x29\x4f\xfc\x47\x8b\x08\xa1\xb3\xd9\x9e\x4d\xda\x64\xce\x32\xaf\xf5\xb0\xfe\xfd\x32\xaa\x7e\xce\xda\xc7\xb8\x2c\xd6\xd5\xbe\xa9\x89\xda\xa4\x60\xac\xc5\x0f\xc0\xef\xea\x5e\xd8\xd5\xbd\x40\x25\x46\x42\x24\x41\xf0\x2f\xaf\x18\x97\x54\xa4\x24\xa6\x4d\xdb\x8c\xab\xc4\x34\x9f\x16\xaa\x23\xab\xea\x70\xec\x3c\xec\xdb\x4c\x26\xa9\x87\x94\x7e\x7c\x24\xd1\x76\xed\x56\x1f\x54\x7c\x0b\xe1\x2e\xb0\xec\x71\x1d\x18\x55\x8c\x35\x5a\x6a\x7a\xe0\xb5\xde\x30\x6f\xc4\x67\x37\xa4\x7a\xcd\xaa\x58\xb0\x9c\x71\x22\x69\xf2\xa5\xd4\x56\x6c\x6f\x69\x2c\xe1\x9e\xc9\x1b\x20\x50\x16\xd9\x43\x5e\x88\xf2\x86\xc5\x4b\xba\xab\xa4\xa8\x63\x59\x0b\xfa\x24\x94\x87\xe5\x00\x3d\x9d\x56\x03\xb4\xab\xa8\xe5\x2b\x52\x51\x2c\x09\xaf\x8a\xe4\x61\x03\x11\x62\xf0\x8d\x68\x0d\x4d\x5a\x6e\xfc\x83\x25\xa7\x75\xd5\xb9\x10\x10\xbd\xad\x8c\xb9\xf8\xfc\xbe\xde\xaa\x47\x5d\xba\xd0\xcd\x2d\xa9\xe8\xd4\xcd\x9f\xeb\xca\xee\xa3\xab\xd2\x69\x27\x95\x23\xb0\xa3\xcc\x59\xfc\x74\x79\x8a\x56\xd9\x4b\xdc\xc4\xd9\x79\xce\x4e\xf6\xe6\x59\x96\x5d\xa4\xc6\xf2\x0e\x11\x5e\xc8\x09\x24\x7a\xd0\xb9\xa3\xf5\xa8\x03\x50\x5f\x8b\x7b\xf3\xb9\x2c\x84\xa4\x49\x30\x5e\x01\x40\x50\xbf\x35\x09\x43\xe3\x72\x9f\x8b\x4d\x83\x05\xe0\x6d\x75\x86\xe7\xaa\xb6\x9d\xae\xea\x36\xf1\xbf\x0a\x6d\xf2\xfb\x8c\xc5\xb4\x69\x68\x56\xd1\xf9\xcc\x7e\x4e\xd3\x50\x9e\xb4\xad\x3f\x0f\x15\x66\x90\xbd\x2a\x05\x21\x88\x9a\x4b\x96\xd3\x08\x37\xc6\x0f\x05\xaf\xea\x1c\x4f\x59\x86\x8c\x46\xc1\xd2\x21\x79\xf6\xcc\xbc\xb1\x22\x7a\x73\xf1\x63\x1f\x23\x07\x15\x99\x78\xf5\x90\xea\xa8\xd9\xde\xc7\x6f\xb3\x67\x67\xa0\xba\x20\x11\x9e\x80\x6f\x22\xad\xf0\x84\xc0\x19\xf4\x98\xe4\x74\x6f\x68\x46\xb8\x2e\x00\xc5\xdc\xdf\x87\xdc\x6a\xd4\x16\x88\x0d\x58\x64\x15\x35\xce\xf5\x6e\x59\x9d\xc2\x3d\x6d\x73\x0c\x26\x7e\xec\x3c\xb9\xf4\xfb\x79\xef\x8e\x76\xec\x69\x80\x9a\x63\xc5\x4e\x2e\xb6\xb7\x88\x65\x4e\x3e\x52\x3f\x27\xe5\x65\x25\x05\xe3\xd7\x63\x3a\x9d\x61\x34\x2b\x03\x83\x18\x7b\x31\x70\x42\xa6\x04\xea\xd5\xd6\x74\x0f\xa1\xf8\x88\xa6\x0d\x1a\xf0\x8c\x58\x0a\xc6\x65\x0a\x9b\xef\x3e\x6d\xfa\x99\x57\xdf\xe3\xd4\x41\x23\x4b\xa1\xca\xe2\xa5\xd0\x85\x4c\xab\xde\xc8\x9f\x9c\x26\x82\x99\xf0\x9e\xef\xab\x2c\x7e\x33\x3e\xc6\xda\x34\x0e\x94\x6e\xfe\x54\x59\x8c\x29\x18\xc2\x87\x9e\x6e\x0c\x71\x2b\x81\xc1\x7c\xf6\xc5\xf6\xd6\x9e\xf1\xb3\x9a\xb3\xc8\x7b\xad\x69\x55\xd5\xf8\x82\x1d\xe0\x08\xea\x34\xb4\xee\xdd\x3b\x39\xab\x2e\x46\x43\xed\x71\xe0\xd9\x64\xb6\x8b\x3d\xb7\xac\x3f\xfa\x68\x20\x68\x55\x67\xd2\x7e\xb0\x9c\x70\xd0\xe1\x87\x10\x0e\x4b\x22\x28\x97\x18\x10\x1b\x25\xe9\x61\x57\x81\xb2\x75\xa3\x66\xc9\x2e\xaa\xe2\xb4\x9f\x36\x3a\x92\x15\xe2\x47\x46\xb3\x64\xd2\x02\xf6\x0b\x7b\x8b\x70\x70\x60\xb5\xe9\x14\x0c\x0e\x3a\x1f\x8d\x01\x1e\x8b\xeb\x14\x9c\x02\x32\xf8\x94\x69\xdc\x4a\x91\xca\x1e\xa9\x66\x49\xb2\x3b\xd4\x98\x80\xee\x50\xcc\xd2\x3d\x96\x63\xda\x0e\xb6\xd9\xe7\xf8\xc1\x2c\xa5\x8f\x9e\xc3\x79\xd1\xb5\xbe\x58\x1a\xe0\x9e\xfe\x55\x50\xc8\x8a\xe2\x23\xe3\xd7\xb8\xe5\x23\x78\x7e\xe4\xd9\xb7\x40\x21\x14\x81\xfb\xff\x38\x3e\x0e\x61\xc3\xf8\x1d\xc9\x18\xb6\xa9\xbd\xc2\xb6\xc5\x96\xb7\xa6\x27\xf0\xdd\xa7\x4d\xb8\xc7\x7c\x7b\xee\xcf\xc1\x99\xbe\x2f\x80\xfa\x1f\xf2\x52\x59\x6d\xcb\xf5\xaf\x1f\x73\x6b\x7c\xf7\x85\x0e\x4e\x61\x79\x46\x72\x8a\xdf\x8d\x99\x79\x5b\x7b\x7e\x19\x6c\xeb\x0e\x83\x23\x90\xc6\x90\x18\x4b\xd4\x4e\x1b\x9c\x9c\x58\x6c\xe6\xf4\xaa\xe1\x14\xd6\xca\x1d\x44\x3a\x4e\x8b\x6d\x6b\xf5\xee\xb9\xa5\x31\x05\xb3\x59\x86\x76\x72\x4d\x9f\xbf\xa7\x11\x94\xc5\xfa\x36\xf0\x71\xdd\x3f\x82\x75\x28\xc6\xbe\x9c\x9c\x2e\x9c\xf3\x0e\xf0\xb0\xb5\x7d\x19\xc2\xf6\x58\x37\x91\xdd\x47\x48\xa8\x4a\x92\x77\x80\xa3\xf8\x3a\x63\xe3\x7d\x7d\xe5\x85\x38\x2f\xb8\xa9\xc0\x5d\x93\x19\x78\x07\x53\x1a\x6d\xbc\x03\xd3\xfa\x71\x96\x29\x35\xde\x41\xeb\x1d\x6c\x8f\xd7\xa9\x1c\xeb\x3b\xe3\xc9\xe3\x15\xea\xcf\xaa\x7b\x72\x1d\xfd\x50\xf0\x98\x48\x05\x2b\xba\xbe\x3d\x0e\x42\x1d\x71\xeb\x8d\x81\x2a\xdf\xd3\x6b\x82\x55\xc8\xaf\xbd\x4a\xf8\x76\x57\x06\x3b\xfb\xce\xe9\x66\xef\x6f\x17\x3a\x8b\x7a\xae\x68\x9a\x45\x69\xd0\x52\x06\x49\xba\xaf\x31\xb1\x33\x6a\xe6\x53\x0e\x77\x10\x78\xd3\x0c\x79\x50\x0a\x76\xd7\x59\x91\x62\x35\x19\xb5\xfa\xbd\x44\x5d\x0b\x66\x22\xe6\x38\xe1\x51\xd4\x79\x73\x8a\xf5\xe7\x0f\x4c\xc6\x93\x4d\xd3\xf4\xb8\x8e\x1c\x7a\x47\x3f\xd5\x4c\xa8\xb3\x46\x58\xe4\x78\xf9\x5b\xca\x87\x3e\x61\x36\x7f\xcc\x2c\xe2\xc9\xc8\x1e\xd3\x09\xff\xdf\xe0\x30\xfb\xce\xe3\x49\x3d\x1f\xbd\x2f\xf8\x07\xf3\xee\x27\x52\x0d\x97\x81\x93\x24\x1c\x56\x0d\x6d\xe5\x08\x29\xd7\x85\xe2\x20\x63\xe4\x8c\x86\x66\xfd\x9a\x9e\xba\x94\xc1\x30\x6a\x0d\xdd\x49\x64\x93\x37\x4a\xac\x17\x1a\x2b\x2b\x0c\xd6\x9b\x55\xb7\xfb\xe6\x62\x47\x5f\x97\x4d\xee\x75\x56\xdd\xb4\x7a\xce\xac\x59\xb5\x7c\x06\xcf\xe5\x2a\x54\x8c\x67\x73\x40\x66\x90\xa8\xff\x74\x5d\x3f\x39\xed\x8a\xf6\x3b\x4a\x12\x53\xda\x42\x70\x5c\x33\x2e\xfa\x32\x25\x69\xd7\x79\x66\x51\xc9\x5d\x37\xe2\xd6\x43\x97\x19\xf4\x66\x1e\xcc\x9e\xcd\x46\x7a\x5b\x9d\xf1\x82\x3f\xe4\x45\x6d\xf4\xeb\x9b\x90\x3b\x22\x38\xc9\x07\x79\xcb\x8b\x90\x01\x8d\xc5\x11\xa9\x6d\xfb\xda\x3f\x59\xb6\xe8\x87\xf1\xce\x24\x84\x67\x16\x7d\xc1\x90\x05\x56\xb4\x5d\x4b\x94\x8f\xeb\xa3\x30\xa7\x48\x44\x1a\xf7\x54\xf9\x2b\x89\x3f\x62\x19\x32\xc6\x6f\x36\xfd\x71\x70\xae\x77\x0e\xf5\xe4\xe5\xcb\x8f\x6f\x4f\x7f\x4c\xeb\x6f\xb1\x3f\x94\x44\xc8\x0a\x2e\xaf\xf4\x31\xec\x2b\xf0\xb1\x95\x8d\x77\x92\xb0\x93\x76\xf6\x32\xcd\x90\x22\xdf\x9a\x59\x27\xa4\x32\xa1\xd2\x27\xf3\xe6\xab\xf2\xe3\xdc\x7e\x7c\xee\x87\xd7\x31\xe1\x9f\x95\x02\x57\x73\xdf\x2a\xce\xda\x8f\xc1\xd3\x51\xdc\xc4\x61\x53\xb8\x16\xbb\x57\x8f\xbb\x99\x06\x4e\xd7\x53\xd6\xbc\x3a\xaa\xf6\xee\xb5\xfa\x2e\x75\xcc\xac\xff\x16\x4c\x52\x55\xbd\x50\x6d\x30\x62\x1b\x6b\x4d\xef\xdb\x2a\xe3\x87\xae\x6b\xfd\xb5\x66\xf7\x1e\xf6\xda\x82\x85\x1d\x3b\x29\xd1\x52\xf3\x5d\xf6\x3a\x90\x70\x53\xca\xd7\x73\xce\x62\xa5\xd5\xcf\xe1\xc5\xd1\x81\x76\x02\xa3\x28\xb2\x34\xa1\xe3\x2e\xb4\x2b\xa9\xb3\x6f\xab\x51\xc5\x0b\x7d\x1c\xd0\x9b\x61\xba\xa5\x14\xc6\x3f\x91\x05\x0d\xc0\xb4\x5e\xef\xfa\x52\xbc\xef\x32\x46\x65\xcc\x6c\xa4\x4e\xf3\x6f\xea\xd7\x31\xc3\xac\x41\xee\xe2\xc7\x63\x11\x58\xd6\x3b\x4a\xc6\x52\x1c\xb1\x4c\x74\x48\xe7\x09\xbc\x30\x2f\x2c\x9d\x62\xf0\xc2\x26\x7b\x05\x04\x88\xb5\x31\x1b\x4b\x92\x7f\x2d\xc1\x57\x3f\xd9\x51\xf4\x1d\xc0\xdf\x82\x79\x69\x82\xc0\xae\x6d\x71\xa7\xb0\x34\xbd\x69\x80\xf2\x04\xda\xd6\xfb\xef\x00\x8f\xfa\x2d\x11\x8e\x27\x00\x00")
 
 func templatesTupleserializerGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -611,12 +863,12 @@ func templatesTupleserializerGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/tupleserializer.gotmpl", size: 10147, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/tupleserializer.gotmpl", size: 10126, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesValidationCustomformatGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x24\xcd\xc1\x0a\xc2\x30\x0c\xc6\xf1\xfb\x9e\x22\xee\xb4\xc1\xe8\x03\x28\x3b\xea\x49\x50\x10\xbc\x07\x4d\x35\x50\x5b\x49\x33\x11\x42\xde\x5d\xec\x8e\xdf\x9f\x1f\x7c\x1c\x81\x44\x60\x3b\xc3\x07\x13\xdf\x51\x29\x1c\x8a\xbc\x50\x4f\x71\x30\x0b\x67\xd4\xa7\xfb\x04\xbd\x59\x38\x96\x1b\x2a\x97\xec\xde\xaf\x61\x85\x6d\x9a\x85\x2b\xa6\x85\xf6\xdf\xb7\x50\xad\x4d\x85\x8b\x0a\xe7\xc7\x30\x4e\x10\x9b\xac\xe3\xae\x9d\x6d\x66\xc8\x9c\xc0\x3a\x00\x21\x5d\x24\xff\x6b\xe7\xdd\x2f\x00\x00\xff\xff\x2a\x58\x6b\xd3\x8c\x00\x00\x00")
+var _templatesValidationCustomformatGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x24\xcd\xc1\xaa\xc2\x30\x10\x85\xe1\x7d\x9e\xe2\xdc\xae\x5a\x28\x79\x80\x2b\x5d\xea\x4a\x50\x10\xdc\x0f\x3a\xd1\x40\x4c\x64\x32\x15\x61\xc8\xbb\x0b\xe9\xf2\x1c\x3e\xf8\x63\x00\x8b\xe0\x7f\xc1\x87\x52\xbc\x93\xb2\x3f\x14\x79\x91\x9e\xc2\x68\xe6\xcf\xa4\xcf\xd6\x66\x0c\x66\xfe\x58\x6e\xa4\xb1\xe4\xd6\x86\xed\xd8\x60\x9f\x66\xfe\x4a\x69\xe5\xfd\xf7\x2d\x5c\x6b\x57\xfe\xa2\x12\xf3\x63\x9c\x66\x84\x2e\xeb\xb4\xeb\xb1\xbf\x05\x39\x26\x98\x03\x84\x75\x95\x0c\x16\x71\xcd\xfd\x06\x00\x2a\x58\x6b\xd3\x8c\x00\x00\x00")
 
 func templatesValidationCustomformatGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -636,7 +888,7 @@ func templatesValidationCustomformatGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesValidationPrimitiveGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x95\xc1\x8e\xd3\x40\x0c\x86\xef\x79\x0a\x13\x84\x94\x20\x94\x13\xe2\x00\xda\x03\x82\x22\x2a\x2d\xb0\xd2\x22\xce\x3b\xb4\x4e\xd6\xd2\xc4\xe9\x7a\x9c\xd2\xd5\x68\xde\x1d\x4d\x9a\x34\x15\x6c\x23\x22\x7a\xda\xbd\x75\xe6\xf7\xd8\xfe\xbf\xda\x8a\xf7\x54\x42\xf1\x85\xf8\x12\xb9\xd2\xdb\x10\x12\x2a\x01\x45\xe0\xed\x05\x6c\x8d\xa5\xb5\x51\x1c\xe5\xcc\x7b\x88\xf1\x57\x46\x6f\x21\x04\xef\x8f\x7e\xa2\x75\x18\x42\x9a\x7a\x8f\xbc\x0e\xe1\x15\x78\x0f\x1b\x21\xd6\x12\xd2\x17\x77\x29\x14\x97\xcd\xca\x28\x35\x0c\xbd\x18\x13\x2d\xdd\xd7\xd6\x5a\xf3\xd3\x22\x84\x90\xbd\xf4\x1e\x90\xd7\x5d\xba\xe2\x87\xb1\x2d\x2e\x76\x1b\x41\xe7\xa8\xe1\xae\xda\x5f\x4f\xf2\xa3\x17\xbd\xfa\xa1\x75\xda\xd4\x9f\x1a\xa9\x8d\x2a\x0a\x84\x50\x5c\xab\x10\x57\xd9\x18\x1c\xeb\x1f\x9b\xce\xdf\x75\x9e\x9f\x5d\x00\x93\x05\x9f\x00\x08\x6a\x2b\x1c\x6f\x93\x90\xf4\x96\x92\x1e\x96\xd9\x4d\xc2\x1a\xe4\xc7\x05\x6b\x34\x3d\x0b\xd6\x55\x97\x97\x1f\x46\xd5\x8b\x8f\x07\xd4\x8d\xf7\xa3\xe3\x9b\x79\x53\x45\x4c\x75\x5b\x9f\x5c\xc0\x28\xee\xbb\xc1\x3b\x28\xae\x7f\x99\xaa\x42\xf9\x7e\xbf\x41\x48\x89\x15\x2b\x94\x14\x42\x58\xb2\x1e\xda\x39\x37\xd6\xa9\xba\xb4\xaf\x6b\x5d\xc4\x57\xda\xc6\x8c\x6d\xbc\x79\x9d\x3d\xc4\x78\xfa\x5f\xc9\x87\x0d\xdd\x33\xe9\x4e\x8b\xdd\xca\xb6\x8e\xb6\x78\xb8\x9e\xbb\xb6\x13\x80\xf7\xe2\x93\x03\x3c\x30\xf9\x03\xf0\x70\x3d\x0f\x70\x6b\x95\x36\x16\xbf\x95\x27\x18\x1f\xf4\xf3\x81\xeb\x48\xfc\x0f\x80\xa3\x9e\x67\x99\x5d\xf0\xa9\x51\x8a\xca\xb9\x27\xc3\xf0\x1a\x32\x6e\x34\x3a\x7c\x2f\x62\xee\xf3\xfe\xf8\xd9\xb8\x8f\xe4\x56\x42\x35\xb1\xd1\x46\xf2\x43\xd8\x92\x15\xa5\x34\x2b\xcc\x67\x61\x99\xfd\x65\x18\x7b\x7f\xbe\x4d\x07\x2e\xff\x88\xf2\x77\x00\x00\x00\xff\xff\x27\x99\x58\x83\x7d\x08\x00\x00")
+var _templatesValidationPrimitiveGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x94\x41\x6f\xd3\x40\x10\x85\xef\xfe\x15\x83\x11\x92\x8d\x90\x4f\x88\x03\xa8\x07\x04\x45\x44\x2a\x50\xa9\x88\x73\x87\x64\xec\x8e\xb4\x1e\xa7\xbb\xeb\xb0\xd5\x6a\xfe\x3b\xda\xc4\x49\x2c\x91\x44\xb8\xe4\x54\x6e\xc9\xbc\xdd\x99\x79\x9f\x9f\x1d\x23\xd7\x50\x7d\x61\xb9\x22\x69\xfc\x9d\x6a\xc6\x35\x90\xb5\xf0\xf6\x02\x56\x68\x78\x81\x9e\xf6\x72\x11\x23\xa4\xf3\xd7\xe8\xef\x40\x35\xc6\xd1\x4f\x32\x8e\x54\xf3\x3c\x46\x92\x85\xea\x2b\x88\x11\x96\x96\xc5\xd7\x90\xbf\xb8\xcf\xa1\xba\xea\xe6\xe8\xb9\x13\x18\xc4\xd4\x68\xe6\xbe\xf6\xc6\xe0\x4f\x43\xa0\x5a\xbc\x8c\x11\x48\x16\xeb\x76\xd5\x0f\x34\x3d\x5d\x86\xa5\x25\xe7\xb8\x93\x54\xfb\xf3\x4a\x39\xba\x31\xa8\x1f\x7a\xe7\xbb\xf6\x53\x67\x5b\xf4\x9e\x2c\xa8\x56\x37\xde\xb2\x34\xc5\xfe\x70\x9a\x3f\x36\x5d\xbe\x5b\x7b\x7e\x76\x01\xc2\x06\x62\x06\x60\xc9\xf7\x56\x52\x35\xd3\x6c\xb0\x94\x0d\xb0\x30\x9c\x84\x85\xe1\x29\xc2\xc2\xf0\x28\x58\xd7\xeb\xbe\x72\x18\xd5\x20\x3e\x1d\x50\xb7\x31\x6e\x4d\xa9\xde\x4e\x4b\x15\x0b\xb7\x7d\x7b\x24\x53\x1b\x71\x63\x8f\xee\xa1\xba\xf9\x85\x4d\x43\xf6\xfb\xc3\x92\x20\x67\xf1\xd4\x90\xcd\x41\x75\x26\x7e\xb7\xce\xb9\xb1\x9e\x9a\xcb\x9b\xb9\xc6\x25\xe2\xb5\xe9\x70\xbf\xc6\x9b\xd7\xc5\x21\xc6\xa7\x9f\x4a\xb9\x7d\x43\x93\xed\x21\x82\x97\x61\x6e\x7a\xc7\x2b\xda\x95\xa7\x01\xc6\x70\x02\x30\x86\xff\x12\x30\x86\x83\x80\x31\x3c\x06\x70\x6f\x3c\x2f\x0d\x7d\xab\x8f\x30\xde\xe9\xe7\x03\xb7\x8e\xda\xbf\x00\x18\xed\x3c\xc9\xec\xa5\x1c\x8b\x52\x52\xce\x67\x70\xd3\x08\x65\x01\x85\x74\x3e\x39\x7c\x6f\x2d\x3e\x94\xc3\xdf\xcf\xe8\x3e\xb2\x9b\x5b\x6e\x59\xd0\x77\x76\x5b\x9f\xb9\x99\x78\xb2\x35\xce\xa9\x9c\x84\x65\xe2\x07\x6f\xbc\xfb\xf3\x55\xbe\xe5\xf2\x97\x28\x7f\x0f\x00\x27\x99\x58\x83\x7d\x08\x00\x00")
 
 func templatesValidationPrimitiveGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -656,7 +908,7 @@ func templatesValidationPrimitiveGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesValidationStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\xb1\x4e\xc3\x30\x10\x86\xf7\x3c\xc5\xa9\x3b\x74\xaf\x10\x13\x0c\x48\xa0\x20\x10\xec\x56\x7d\x29\x27\x39\x97\xd4\xb5\x51\x2b\x2b\xef\x8e\x12\x3b\x89\x1d\xd9\x8c\xff\xfd\xf7\x7d\xb9\x5a\x75\x0e\x24\x36\xc4\x08\xbb\x5e\x77\x3d\x6a\x73\xfb\x16\x8a\xa4\x30\xd4\xf1\x53\x77\xfc\x34\x9a\xf8\xb4\x83\x61\xa8\x2a\xe7\xee\x80\x1a\xb8\xff\xc0\xb3\x25\x8d\x72\x1c\xee\xf7\x30\xc7\x03\x18\x6d\x71\xda\x42\x96\x1b\x42\xc8\x9a\xd5\x6d\x21\x84\x84\x31\xff\x83\xbc\x89\x2b\xb5\xb6\x0d\x44\x48\x07\x70\x6e\x6a\x9f\xaf\x47\x65\x2f\xf4\x8b\xeb\xda\xc3\xd8\x79\x8b\x73\x09\x9f\xd5\x13\xc7\x7a\x9f\x32\xfa\x65\xed\x71\xa3\x5f\xf9\xac\xde\x2a\x43\xbd\xc2\xba\x99\xbf\x10\x06\x50\x37\xd3\x57\x36\x1b\x85\x17\x78\x45\x3e\x99\x9f\xf5\x0d\xc0\x0f\x82\x21\xee\x0b\xbf\x31\x15\x10\xa7\x82\xb8\xcf\x09\xde\x85\x31\xa8\x39\xe0\x21\x79\x36\xaa\x0a\xb7\xbf\x18\x6c\x2f\xd1\xe9\x53\x5e\x2e\x5f\xda\xc2\xe1\x09\x4d\x9c\xd0\x51\x9b\xa3\xbf\x98\xce\x16\x63\x81\x9f\x14\xfe\x6c\xc8\x72\x18\xaa\xbf\x00\x00\x00\xff\xff\xac\xce\xff\x27\x08\x03\x00\x00")
+var _templatesValidationStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\xc1\x6a\xeb\x30\x10\x45\xf7\xf9\x8a\xc1\xf0\x96\xaf\xd9\x87\xd2\x55\xb3\x28\xb4\xa4\xb4\xb4\x7b\x11\x8d\xd3\x01\x6b\xec\x28\x52\x50\x10\xfe\xf7\x22\x59\x76\x2c\xa3\x7a\x97\x99\xb9\xe7\xdc\x80\xe5\x3d\x48\xac\x89\x11\xaa\x4e\xb7\x1d\x6a\x73\xfb\x16\x0d\x49\x61\xa8\xe5\xe7\xf6\xf8\x69\x34\xf1\xa9\x82\xbe\xdf\x6c\xbc\xff\x0f\x54\xc3\xc3\x07\x9e\x2d\x69\x94\x61\xb9\xdd\xc2\x38\xee\xc0\x68\x8b\x31\x85\x2c\x17\x84\x90\x07\x6e\x6e\x13\x21\x24\x84\x79\x05\x79\x13\x8e\x94\x55\x89\x48\xd3\x0e\xbc\x8f\xd7\xbd\x3b\x36\xf6\x42\x57\xbc\xc7\x1e\xc3\x6d\x28\xf6\x3e\xe3\x8b\x7a\xe2\xf1\x1c\xf4\xc4\x7f\xe8\xa7\xd8\xd3\x42\x4f\xbc\xaa\xb7\x8d\xa1\xae\xc1\x43\x3d\x36\xa4\x05\x1c\xea\xd8\xb2\x48\x14\x1d\xc2\xbd\x22\x9f\xcc\xcf\xa8\x10\x0e\x86\x45\x32\xcc\xef\x45\x01\x71\x2e\x20\xce\x05\xc4\xeb\x82\x77\x61\x0c\x6a\x4e\x78\x9a\x86\xf2\xd9\xa9\x58\x2d\xdc\x8b\x41\x75\x19\x9b\x85\x83\x38\x4f\xff\x7c\xba\x16\x69\xe2\x8c\x26\xce\x68\xe2\x55\xfa\x8b\xe9\x6c\x71\x2e\x18\x36\x2b\x8f\x6d\xcf\xd3\x53\x08\x3f\x63\x4f\xa7\x89\x4d\x0d\xd5\xbf\x6b\x75\x0f\x14\x61\x27\x54\xf8\xb2\x89\x1f\xa6\xa8\x38\xb6\x4a\x21\x9b\x2c\xb2\x34\x20\xcb\xbe\xdf\xfc\x0e\x00\xcc\xc6\xbb\x87\x87\x03\x00\x00")
 
 func templatesValidationStructfieldGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -671,7 +923,7 @@ func templatesValidationStructfieldGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/validation/structfield.gotmpl", size: 776, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
+	info := bindataFileInfo{name: "templates/validation/structfield.gotmpl", size: 903, mode: os.FileMode(420), modTime: time.Unix(1517521234, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -729,27 +981,39 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"templates/additionalpropertiesserializer.gotmpl": templatesAdditionalpropertiesserializerGotmpl,
+	"templates/cli/main.gotmpl": templatesCliMainGotmpl,
+	"templates/client/cache.gotmpl": templatesClientCacheGotmpl,
 	"templates/client/client.gotmpl": templatesClientClientGotmpl,
 	"templates/client/facade.gotmpl": templatesClientFacadeGotmpl,
+	"templates/client/mock.gotmpl": templatesClientMockGotmpl,
 	"templates/client/parameter.gotmpl": templatesClientParameterGotmpl,
 	"templates/client/response.gotmpl": templatesClientResponseGotmpl,
+	"templates/client/signing.gotmpl": templatesClientSigningGotmpl,
+	"templates/docs/html.gotmpl": templatesDocsHtmlGotmpl,
+	"templates/docs/markdown.gotmpl": templatesDocsMarkdownGotmpl,
 	"templates/docstring.gotmpl": templatesDocstringGotmpl,
 	"templates/header.gotmpl": templatesHeaderGotmpl,
 	"templates/model.gotmpl": templatesModelGotmpl,
 	"templates/modelvalidator.gotmpl": templatesModelvalidatorGotmpl,
+	"templates/oneof.gotmpl": templatesOneofGotmpl,
 	"templates/schema.gotmpl": templatesSchemaGotmpl,
 	"templates/schemabody.gotmpl": templatesSchemabodyGotmpl,
 	"templates/schematype.gotmpl": templatesSchematypeGotmpl,
 	"templates/schemavalidator.gotmpl": templatesSchemavalidatorGotmpl,
 	"templates/server/builder.gotmpl": templatesServerBuilderGotmpl,
 	"templates/server/configureapi.gotmpl": templatesServerConfigureapiGotmpl,
+	"templates/server/configureversions.gotmpl": templatesServerConfigureversionsGotmpl,
 	"templates/server/doc.gotmpl": templatesServerDocGotmpl,
+	"templates/server/jwt.gotmpl": templatesServerJwtGotmpl,
 	"templates/server/main.gotmpl": templatesServerMainGotmpl,
 	"templates/server/operation.gotmpl": templatesServerOperationGotmpl,
+	"templates/server/operation_test.gotmpl": templatesServerOperation_testGotmpl,
 	"templates/server/parameter.gotmpl": templatesServerParameterGotmpl,
 	"templates/server/responses.gotmpl": templatesServerResponsesGotmpl,
 	"templates/server/server.gotmpl": templatesServerServerGotmpl,
 	"templates/server/urlbuilder.gotmpl": templatesServerUrlbuilderGotmpl,
+	"templates/server/versions.gotmpl": templatesServerVersionsGotmpl,
+	"templates/server/versionsmain.gotmpl": templatesServerVersionsmainGotmpl,
 	"templates/structfield.gotmpl": templatesStructfieldGotmpl,
 	"templates/swagger_json_embed.gotmpl": templatesSwagger_json_embedGotmpl,
 	"templates/tuplefield.gotmpl": templatesTuplefieldGotmpl,
@@ -801,16 +1065,27 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
 		"additionalpropertiesserializer.gotmpl": &bintree{templatesAdditionalpropertiesserializerGotmpl, map[string]*bintree{}},
+		"cli": &bintree{nil, map[string]*bintree{
+			"main.gotmpl": &bintree{templatesCliMainGotmpl, map[string]*bintree{}},
+		}},
 		"client": &bintree{nil, map[string]*bintree{
+			"cache.gotmpl": &bintree{templatesClientCacheGotmpl, map[string]*bintree{}},
 			"client.gotmpl": &bintree{templatesClientClientGotmpl, map[string]*bintree{}},
 			"facade.gotmpl": &bintree{templatesClientFacadeGotmpl, map[string]*bintree{}},
+			"mock.gotmpl": &bintree{templatesClientMockGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesClientParameterGotmpl, map[string]*bintree{}},
 			"response.gotmpl": &bintree{templatesClientResponseGotmpl, map[string]*bintree{}},
+			"signing.gotmpl": &bintree{templatesClientSigningGotmpl, map[string]*bintree{}},
+		}},
+		"docs": &bintree{nil, map[string]*bintree{
+			"html.gotmpl": &bintree{templatesDocsHtmlGotmpl, map[string]*bintree{}},
+			"markdown.gotmpl": &bintree{templatesDocsMarkdownGotmpl, map[string]*bintree{}},
 		}},
 		"docstring.gotmpl": &bintree{templatesDocstringGotmpl, map[string]*bintree{}},
 		"header.gotmpl": &bintree{templatesHeaderGotmpl, map[string]*bintree{}},
 		"model.gotmpl": &bintree{templatesModelGotmpl, map[string]*bintree{}},
 		"modelvalidator.gotmpl": &bintree{templatesModelvalidatorGotmpl, map[string]*bintree{}},
+		"oneof.gotmpl": &bintree{templatesOneofGotmpl, map[string]*bintree{}},
 		"schema.gotmpl": &bintree{templatesSchemaGotmpl, map[string]*bintree{}},
 		"schemabody.gotmpl": &bintree{templatesSchemabodyGotmpl, map[string]*bintree{}},
 		"schematype.gotmpl": &bintree{templatesSchematypeGotmpl, map[string]*bintree{}},
@@ -818,13 +1093,18 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"server": &bintree{nil, map[string]*bintree{
 			"builder.gotmpl": &bintree{templatesServerBuilderGotmpl, map[string]*bintree{}},
 			"configureapi.gotmpl": &bintree{templatesServerConfigureapiGotmpl, map[string]*bintree{}},
+			"configureversions.gotmpl": &bintree{templatesServerConfigureversionsGotmpl, map[string]*bintree{}},
 			"doc.gotmpl": &bintree{templatesServerDocGotmpl, map[string]*bintree{}},
+			"jwt.gotmpl": &bintree{templatesServerJwtGotmpl, map[string]*bintree{}},
 			"main.gotmpl": &bintree{templatesServerMainGotmpl, map[string]*bintree{}},
 			"operation.gotmpl": &bintree{templatesServerOperationGotmpl, map[string]*bintree{}},
+			"operation_test.gotmpl": &bintree{templatesServerOperation_testGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesServerParameterGotmpl, map[string]*bintree{}},
 			"responses.gotmpl": &bintree{templatesServerResponsesGotmpl, map[string]*bintree{}},
 			"server.gotmpl": &bintree{templatesServerServerGotmpl, map[string]*bintree{}},
 			"urlbuilder.gotmpl": &bintree{templatesServerUrlbuilderGotmpl, map[string]*bintree{}},
+			"versions.gotmpl": &bintree{templatesServerVersionsGotmpl, map[string]*bintree{}},
+			"versionsmain.gotmpl": &bintree{templatesServerVersionsmainGotmpl, map[string]*bintree{}},
 		}},
 		"structfield.gotmpl": &bintree{templatesStructfieldGotmpl, map[string]*bintree{}},
 		"swagger_json_embed.gotmpl": &bintree{templatesSwagger_json_embedGotmpl, map[string]*bintree{}},
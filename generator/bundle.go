@@ -0,0 +1,458 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+)
+
+// LoadSpec loads a spec. A local spec which includes other local documents with relative $refs is bundled by BundleSpec,
+// the others are loaded as they are.
+func LoadSpec(path string) (*loads.Document, error) {
+	if isRemoteDocument(path) {
+		return loads.Spec(path)
+	}
+	root, err := readDocument(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range documentRefs(root) {
+		if file := strings.SplitN(ref, "#", 2)[0]; file != "" && !isRemoteDocument(file) {
+			return BundleSpec(path)
+		}
+	}
+	return loads.Spec(path)
+}
+
+// BundleSpec loads a spec written in several local documents as a single document: typically a root document with
+// relative $refs to the files of paths/, definitions/, parameters/ and responses/ directories.
+//
+// A $ref is relative to the document it is written in, and a $ref to #/... in an included document points into that
+// document. The included schemas are moved to the definitions, named after their file unless a definition of the root
+// document refers to them, and the path items, parameters and responses are written where they are used, unless
+// a parameter or response of the root document refers to them. The $refs to remote documents are kept as they are.
+func BundleSpec(path string) (*loads.Document, error) {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	b := &bundler{
+		root:      root,
+		documents: make(map[string]interface{}),
+		names:     make(map[string]string),
+		locals:    make(map[string]string),
+		taken:     make(map[string]bool),
+		inlining:  make(map[string]bool),
+	}
+	doc, err := b.document(root)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not a swagger spec", path)
+	}
+	if err := b.bundle(spec); err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return loads.Analyzed(raw, "")
+}
+
+// the kinds of the objects of a spec a $ref can point to
+const (
+	refSchema = iota
+	refPathItem
+	refOperation
+	refParameter
+	refResponse
+)
+
+type bundler struct {
+	root      string
+	documents map[string]interface{}
+	// definitions are the definitions of the bundled spec, names the ones of the included schemas by target
+	definitions map[string]interface{}
+	names       map[string]string
+	// locals are the parameters and responses of the root document which refer to an included document, by target
+	locals   map[string]string
+	taken    map[string]bool
+	inlining map[string]bool
+}
+
+// bundle replaces the $refs to the other documents in the root document
+func (b *bundler) bundle(spec map[string]interface{}) error {
+	definitions, _ := spec["definitions"].(map[string]interface{})
+	if definitions == nil {
+		definitions = make(map[string]interface{})
+	}
+	b.definitions = definitions
+	for name := range definitions {
+		b.taken[name] = true
+	}
+	// the entries of the root document which only refer to another document keep their name
+	for _, section := range []string{"definitions", "parameters", "responses"} {
+		entries, _ := spec[section].(map[string]interface{})
+		for _, name := range sortedKeys(entries) {
+			ref, ok := refOf(entries[name])
+			if !ok || isRemoteDocument(ref) || strings.HasPrefix(ref, "#") {
+				continue
+			}
+			target := b.target(b.root, ref)
+			if section == "definitions" {
+				if _, known := b.names[target]; !known {
+					b.names[target] = name
+				}
+				continue
+			}
+			if _, known := b.locals[target]; !known {
+				b.locals[target] = "#/" + section + "/" + jsonpointer.Escape(name)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(definitions) {
+		ref, ok := refOf(definitions[name])
+		if target := b.target(b.root, ref); ok && b.names[target] == name {
+			// the definition is the content of the document it refers to
+			value, err := b.resolve(target)
+			if err != nil {
+				return err
+			}
+			if definitions[name], err = b.walk(value, strings.SplitN(target, "#", 2)[0], refSchema); err != nil {
+				return err
+			}
+			continue
+		}
+		value, err := b.walk(definitions[name], b.root, refSchema)
+		if err != nil {
+			return err
+		}
+		definitions[name] = value
+	}
+	for _, section := range []string{"parameters", "responses"} {
+		kind := refParameter
+		if section == "responses" {
+			kind = refResponse
+		}
+		entries, _ := spec[section].(map[string]interface{})
+		for _, name := range sortedKeys(entries) {
+			value := entries[name]
+			if ref, ok := refOf(value); ok && !isRemoteDocument(ref) && !strings.HasPrefix(ref, "#") {
+				var err error
+				if value, err = b.inline(b.target(b.root, ref), kind); err != nil {
+					return err
+				}
+				entries[name] = value
+				continue
+			}
+			value, err := b.walk(value, b.root, kind)
+			if err != nil {
+				return err
+			}
+			entries[name] = value
+		}
+	}
+	if paths, ok := spec["paths"].(map[string]interface{}); ok {
+		for _, pth := range sortedKeys(paths) {
+			value, err := b.walk(paths[pth], b.root, refPathItem)
+			if err != nil {
+				return err
+			}
+			paths[pth] = value
+		}
+	}
+	if len(definitions) > 0 {
+		spec["definitions"] = definitions
+	}
+	return nil
+}
+
+// walk replaces the $refs to other documents in an object of a kind, read from a document
+func (b *bundler) walk(node interface{}, file string, kind int) (interface{}, error) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := refOf(n); ok {
+			return b.ref(ref, file, kind)
+		}
+		for _, key := range sortedKeys(n) {
+			var err error
+			switch {
+			case strings.HasPrefix(key, "x-"):
+			case kind == refPathItem && key == "parameters", kind == refOperation && key == "parameters":
+				n[key], err = b.walkAll(n[key], file, refParameter)
+			case kind == refPathItem && isMethod(key):
+				n[key], err = b.walk(n[key], file, refOperation)
+			case kind == refOperation && key == "responses":
+				if responses, ok := n[key].(map[string]interface{}); ok {
+					for _, code := range sortedKeys(responses) {
+						if responses[code], err = b.walk(responses[code], file, refResponse); err != nil {
+							break
+						}
+					}
+				}
+			case (kind == refParameter || kind == refResponse) && key == "schema":
+				n[key], err = b.walk(n[key], file, refSchema)
+			case kind == refSchema && (key == "properties" || key == "patternProperties" || key == "definitions"):
+				if schemas, ok := n[key].(map[string]interface{}); ok {
+					for _, name := range sortedKeys(schemas) {
+						if schemas[name], err = b.walk(schemas[name], file, refSchema); err != nil {
+							break
+						}
+					}
+				}
+			case kind == refSchema && (key == "items" || key == "allOf" || key == "anyOf" || key == "oneOf"):
+				n[key], err = b.walkAll(n[key], file, refSchema)
+			case kind == refSchema && (key == "additionalProperties" || key == "additionalItems" || key == "not"):
+				n[key], err = b.walk(n[key], file, refSchema)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	}
+	return node, nil
+}
+
+// walkAll walks an object, or each object of a list
+func (b *bundler) walkAll(node interface{}, file string, kind int) (interface{}, error) {
+	list, ok := node.([]interface{})
+	if !ok {
+		return b.walk(node, file, kind)
+	}
+	for i := range list {
+		var err error
+		if list[i], err = b.walk(list[i], file, kind); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// ref rewrites a $ref read from a document: a schema becomes a $ref to a definition, the other objects are inlined
+func (b *bundler) ref(ref, file string, kind int) (interface{}, error) {
+	if isRemoteDocument(ref) || file == b.root && strings.HasPrefix(ref, "#") {
+		return map[string]interface{}{"$ref": ref}, nil
+	}
+	target := b.target(file, ref)
+	if strings.HasPrefix(target, b.root+"#") {
+		return map[string]interface{}{"$ref": strings.TrimPrefix(target, b.root)}, nil
+	}
+	if kind != refSchema {
+		if local, ok := b.locals[target]; ok {
+			return map[string]interface{}{"$ref": local}, nil
+		}
+		return b.inline(target, kind)
+	}
+
+	name, ok := b.names[target]
+	if ok && b.definitions[name] != nil {
+		if _, pending := refOf(b.definitions[name]); !pending || b.inlining[target] {
+			return map[string]interface{}{"$ref": "#/definitions/" + jsonpointer.Escape(name)}, nil
+		}
+	}
+	if !ok {
+		name = b.definitionName(target)
+		b.names[target] = name
+	}
+	// the definition is named before its schema is walked, for the schemas referring to themselves
+	b.inlining[target] = true
+	b.definitions[name] = map[string]interface{}{"$ref": "#/definitions/" + jsonpointer.Escape(name)}
+	value, err := b.resolve(target)
+	if err == nil {
+		value, err = b.walk(value, strings.SplitN(target, "#", 2)[0], refSchema)
+	}
+	delete(b.inlining, target)
+	if err != nil {
+		return nil, err
+	}
+	b.definitions[name] = value
+	return map[string]interface{}{"$ref": "#/definitions/" + jsonpointer.Escape(name)}, nil
+}
+
+// inline is the content of a target, with its own $refs replaced
+func (b *bundler) inline(target string, kind int) (interface{}, error) {
+	if b.inlining[target] {
+		return nil, fmt.Errorf("%s refers to itself", b.relative(target))
+	}
+	value, err := b.resolve(target)
+	if err != nil {
+		return nil, err
+	}
+	b.inlining[target] = true
+	defer delete(b.inlining, target)
+	return b.walk(value, strings.SplitN(target, "#", 2)[0], kind)
+}
+
+// target is the absolute path of the document a $ref points to, with the pointer into it
+func (b *bundler) target(file, ref string) string {
+	parts := strings.SplitN(ref, "#", 2)
+	target := file
+	if parts[0] != "" {
+		pth := filepath.FromSlash(strings.TrimPrefix(parts[0], "file://"))
+		if !filepath.IsAbs(pth) {
+			pth = filepath.Join(filepath.Dir(file), pth)
+		}
+		target = filepath.Clean(pth)
+	}
+	if len(parts) == 2 && parts[1] != "" && parts[1] != "/" {
+		target += "#" + parts[1]
+	}
+	return target
+}
+
+// resolve is a copy of the object a target points to
+func (b *bundler) resolve(target string) (interface{}, error) {
+	parts := strings.SplitN(target, "#", 2)
+	doc, err := b.document(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	value := doc
+	if len(parts) == 2 {
+		pointer, err := jsonpointer.New(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", b.relative(target), err)
+		}
+		if value, _, err = pointer.Get(doc); err != nil {
+			return nil, fmt.Errorf("%s: %v", b.relative(target), err)
+		}
+	}
+	// the same object may be inlined in several places, which are then rewritten independently
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var copied interface{}
+	err = json.Unmarshal(raw, &copied)
+	return copied, err
+}
+
+func (b *bundler) document(file string) (interface{}, error) {
+	if doc, ok := b.documents[file]; ok {
+		return doc, nil
+	}
+	doc, err := readDocument(file)
+	if err != nil {
+		return nil, err
+	}
+	b.documents[file] = doc
+	return doc, nil
+}
+
+// definitionName names an included schema after its file, or after the last token of its pointer
+func (b *bundler) definitionName(target string) string {
+	parts := strings.SplitN(target, "#", 2)
+	base := strings.TrimSuffix(filepath.Base(parts[0]), filepath.Ext(parts[0]))
+	if len(parts) == 2 {
+		tokens := strings.Split(parts[1], "/")
+		base = jsonpointer.Unescape(tokens[len(tokens)-1])
+	}
+	name := swag.ToGoName(base)
+	if name == "" {
+		name = "Definition"
+	}
+	unique := name
+	for i := 2; b.taken[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	b.taken[unique] = true
+	return unique
+}
+
+// relative is a target relative to the directory of the root document, for the errors
+func (b *bundler) relative(target string) string {
+	if rel, err := filepath.Rel(filepath.Dir(b.root), target); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return target
+}
+
+// readDocument reads a local json or yaml document
+func readDocument(path string) (interface{}, error) {
+	raw, err := swag.YAMLDoc(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return doc, nil
+}
+
+// documentRefs are the $refs of a document
+func documentRefs(node interface{}) []string {
+	var refs []string
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, documentRefs(value)...)
+		}
+	case []interface{}:
+		for _, value := range n {
+			refs = append(refs, documentRefs(value)...)
+		}
+	}
+	return refs
+}
+
+func refOf(node interface{}) (string, bool) {
+	n, ok := node.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	ref, ok := n["$ref"].(string)
+	return ref, ok
+}
+
+func isRemoteDocument(pth string) bool {
+	return strings.HasPrefix(pth, "http://") || strings.HasPrefix(pth, "https://")
+}
+
+func isMethod(key string) bool {
+	switch key {
+	case "get", "put", "post", "delete", "options", "head", "patch":
+		return true
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/analysis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleSpec(t *testing.T) {
+	doc, err := LoadSpec("../fixtures/codegen/multi-file/swagger.yml")
+	require.NoError(t, err)
+	sw := doc.Spec()
+
+	// the included schemas are definitions, named after their file unless the root document names them
+	var names []string
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{"Error", "Owner", "Pet", "Vaccine"}, names)
+	owner, parent := sw.Definitions["Pet"].Properties["owner"], sw.Definitions["Pet"].Properties["parent"]
+	assert.Equal(t, "#/definitions/Owner", owner.Ref.String())
+	assert.Equal(t, "#/definitions/Pet", parent.Ref.String())
+	assert.Equal(t, "#/definitions/Vaccine", sw.Definitions["Pet"].Properties["vaccines"].Items.Schema.Ref.String())
+
+	// the path items are inlined, their refs are relative to their own document
+	pets := sw.Paths.Paths["/pets"].Get
+	require.NotNil(t, pets)
+	require.Len(t, pets.Parameters, 2)
+	assert.Equal(t, "#/parameters/limit", pets.Parameters[0].Ref.String())
+	assert.Equal(t, "tag", pets.Parameters[1].Name)
+	assert.Equal(t, "#/definitions/Pet", pets.Responses.StatusCodeResponses[200].Schema.Items.Schema.Ref.String())
+	assert.Equal(t, "#/definitions/Error", pets.Responses.Default.Schema.Ref.String())
+
+	pet404 := sw.Paths.Paths["/pets/{id}"].Get.Responses.StatusCodeResponses[404]
+	assert.Equal(t, "#/responses/NotFound", pet404.Ref.String())
+	assert.Equal(t, "the pet isn't found", sw.Responses["NotFound"].Description)
+	assert.Equal(t, "#/definitions/Error", sw.Responses["NotFound"].Schema.Ref.String())
+	assert.Equal(t, "limit", sw.Parameters["limit"].Name)
+
+	// nothing is left to resolve
+	require.NoError(t, analysis.Flatten(analysis.FlattenOpts{Spec: analysis.New(sw), BasePath: "."}))
+}
+
+func TestBundleSpec_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "paths"), 0755))
+
+	root := filepath.Join(dir, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(root, []byte(`swagger: '2.0'
+info: {title: loop, version: '1.0'}
+paths:
+  /pets:
+    $ref: paths/pets.yml
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "paths", "pets.yml"), []byte(`$ref: pets.yml`), 0644))
+	_, err = LoadSpec(root)
+	assert.EqualError(t, err, "paths/pets.yml refers to itself")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "paths", "pets.yml"), []byte(`$ref: ../missing.yml`), 0644))
+	_, err = LoadSpec(root)
+	assert.Error(t, err)
+}
@@ -0,0 +1,231 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	parametersPointer = "#/parameters/"
+	responsesPointer  = "#/responses/"
+)
+
+// CanonicalizeSpec rewrites a spec in a normal form, so that the changes of its marshaled form are the changes of the api:
+//
+//   - the parameters and responses of the spec which a single operation uses are inlined where they are used,
+//     the ones shared by several operations stay $refs
+//   - the required properties of the schemas are sorted
+//   - the media types and schemes listed twice are only listed once
+//
+// MarshalCanonical then writes it with its keys sorted.
+func CanonicalizeSpec(sw *spec.Swagger) {
+	inlineSingleUses(sw)
+
+	sw.Consumes = uniqueStrings(sw.Consumes)
+	sw.Produces = uniqueStrings(sw.Produces)
+	sw.Schemes = uniqueStrings(sw.Schemes)
+	for name, schema := range sw.Definitions {
+		canonicalSchema(&schema)
+		sw.Definitions[name] = schema
+	}
+	for _, param := range sw.Parameters {
+		canonicalSchema(param.Schema)
+	}
+	for _, response := range sw.Responses {
+		canonicalSchema(response.Schema)
+	}
+	if sw.Paths == nil {
+		return
+	}
+	for _, item := range sw.Paths.Paths {
+		for _, param := range item.Parameters {
+			canonicalSchema(param.Schema)
+		}
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			op := pathItemOperation(item, method)
+			if op == nil {
+				continue
+			}
+			op.Consumes = uniqueStrings(op.Consumes)
+			op.Produces = uniqueStrings(op.Produces)
+			op.Schemes = uniqueStrings(op.Schemes)
+			for _, param := range op.Parameters {
+				canonicalSchema(param.Schema)
+			}
+			if op.Responses == nil {
+				continue
+			}
+			if op.Responses.Default != nil {
+				canonicalSchema(op.Responses.Default.Schema)
+			}
+			for _, response := range op.Responses.StatusCodeResponses {
+				canonicalSchema(response.Schema)
+			}
+		}
+	}
+}
+
+// MarshalCanonical writes a spec with the keys of its objects sorted, as indented json, compact json, or yaml.
+// The numbers are written the same way whatever their form in the source, 1.0 and 1e3 as 1 and 1000.
+func MarshalCanonical(sw *spec.Swagger, asYAML, compact bool) ([]byte, error) {
+	// json and yaml sort the keys of the maps, which the spec is decoded to
+	doc := swag.ToDynamicJSON(sw)
+	switch {
+	case asYAML:
+		return yaml.Marshal(doc)
+	case compact:
+		return json.Marshal(doc)
+	default:
+		return json.MarshalIndent(doc, "", "  ")
+	}
+}
+
+// inlineSingleUses replaces the $refs to the parameters and responses of the spec which are used once with their value
+func inlineSingleUses(sw *spec.Swagger) {
+	if sw.Paths == nil {
+		return
+	}
+	paramUses := make(map[string]int)
+	responseUses := make(map[string]int)
+	forEachParamList(sw, func(params []spec.Parameter) {
+		for _, param := range params {
+			if name, ok := localRefName(param.Ref, parametersPointer); ok {
+				paramUses[name]++
+			}
+		}
+	})
+	forEachResponses(sw, func(response *spec.Response) {
+		if name, ok := localRefName(response.Ref, responsesPointer); ok {
+			responseUses[name]++
+		}
+	})
+
+	forEachParamList(sw, func(params []spec.Parameter) {
+		for i, param := range params {
+			name, ok := localRefName(param.Ref, parametersPointer)
+			if target, found := sw.Parameters[name]; ok && found && paramUses[name] == 1 {
+				params[i] = target
+				delete(sw.Parameters, name)
+			}
+		}
+	})
+	forEachResponses(sw, func(response *spec.Response) {
+		name, ok := localRefName(response.Ref, responsesPointer)
+		if target, found := sw.Responses[name]; ok && found && responseUses[name] == 1 {
+			*response = target
+			delete(sw.Responses, name)
+		}
+	})
+}
+
+func forEachParamList(sw *spec.Swagger, fn func([]spec.Parameter)) {
+	for _, item := range sw.Paths.Paths {
+		fn(item.Parameters)
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			if op := pathItemOperation(item, method); op != nil {
+				fn(op.Parameters)
+			}
+		}
+	}
+}
+
+func forEachResponses(sw *spec.Swagger, fn func(*spec.Response)) {
+	for _, item := range sw.Paths.Paths {
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			op := pathItemOperation(item, method)
+			if op == nil || op.Responses == nil {
+				continue
+			}
+			if op.Responses.Default != nil {
+				fn(op.Responses.Default)
+			}
+			for code, response := range op.Responses.StatusCodeResponses {
+				fn(&response)
+				op.Responses.StatusCodeResponses[code] = response
+			}
+		}
+	}
+}
+
+// localRefName is the name of the object a $ref points to in a section of the spec, like #/parameters/
+func localRefName(ref spec.Ref, pointer string) (string, bool) {
+	str := ref.String()
+	if !strings.HasPrefix(str, pointer) || strings.Contains(strings.TrimPrefix(str, pointer), "/") {
+		return "", false
+	}
+	return jsonpointer.Unescape(strings.TrimPrefix(str, pointer)), true
+}
+
+// canonicalSchema sorts the required properties of a schema, and of the schemas it has
+func canonicalSchema(schema *spec.Schema) {
+	if schema == nil {
+		return
+	}
+	sort.Strings(schema.Required)
+	for name, property := range schema.Properties {
+		canonicalSchema(&property)
+		schema.Properties[name] = property
+	}
+	for name, property := range schema.PatternProperties {
+		canonicalSchema(&property)
+		schema.PatternProperties[name] = property
+	}
+	for name, definition := range schema.Definitions {
+		canonicalSchema(&definition)
+		schema.Definitions[name] = definition
+	}
+	if schema.Items != nil {
+		canonicalSchema(schema.Items.Schema)
+		for i := range schema.Items.Schemas {
+			canonicalSchema(&schema.Items.Schemas[i])
+		}
+	}
+	for _, schemas := range [][]spec.Schema{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for i := range schemas {
+			canonicalSchema(&schemas[i])
+		}
+	}
+	canonicalSchema(schema.Not)
+	if schema.AdditionalProperties != nil {
+		canonicalSchema(schema.AdditionalProperties.Schema)
+	}
+	if schema.AdditionalItems != nil {
+		canonicalSchema(schema.AdditionalItems.Schema)
+	}
+}
+
+// uniqueStrings removes the repeated values of a list, keeping the first of each
+func uniqueStrings(values []string) []string {
+	if len(values) < 2 {
+		return values
+	}
+	seen := make(map[string]bool, len(values))
+	unique := values[:0]
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
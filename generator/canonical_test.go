@@ -0,0 +1,50 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeSpec(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/canonical.yml")
+	require.NoError(t, err)
+	sw := specDoc.Spec()
+
+	CanonicalizeSpec(sw)
+	assert.Equal(t, []string{"application/json"}, sw.Produces)
+	assert.Equal(t, []string{"name", "tag"}, sw.Definitions["Pet"].Required)
+	assert.Equal(t, []string{"id", "name"}, sw.Paths.Paths["/owners"].Get.Responses.StatusCodeResponses[200].Schema.Items.Schema.Required)
+
+	// the shared parameters and responses stay $refs, the others are inlined
+	assert.Len(t, sw.Parameters, 1)
+	assert.Contains(t, sw.Parameters, "limit")
+	assert.Len(t, sw.Responses, 1)
+	assert.Contains(t, sw.Responses, "error")
+	pets := sw.Paths.Paths["/pets"].Get
+	assert.Equal(t, "#/parameters/limit", pets.Parameters[0].Ref.String())
+	assert.Equal(t, "tag", pets.Parameters[1].Name)
+	assert.Equal(t, "the owner was created", sw.Paths.Paths["/owners"].Post.Responses.StatusCodeResponses[201].Description)
+
+	b, err := MarshalCanonical(sw, false, true)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"maximum":100,`)
+	assert.Contains(t, string(b), `"maxItems":1000,`)
+	assert.Contains(t, string(b), `"multipleOf":0.5,`)
+}
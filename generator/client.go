@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sort"
 
 	"github.com/go-openapi/analysis"
@@ -30,12 +29,21 @@ import (
 
 // GenerateClient generates a client library for a swagger spec document.
 func GenerateClient(name string, modelNames, operationIDs []string, opts *GenOpts) error {
+	if err := opts.checkCompiles(func(check *GenOpts) error {
+		return GenerateClient(name, modelNames, operationIDs, check)
+	}); err != nil {
+		return err
+	}
+
 	if opts == nil {
 		return errors.New("gen opts are required")
 	}
 	if err := opts.EnsureDefaults(true); err != nil {
 		return err
 	}
+	if err := opts.checkClean(modelNames, operationIDs); err != nil {
+		return err
+	}
 
 	if opts.TemplateDir != "" {
 		if err := templates.LoadDir(opts.TemplateDir); err != nil {
@@ -57,6 +65,10 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts *GenOpt
 		return err
 	}
 
+	if err := checkDefinitionNames(opts.Spec, specDoc.Spec()); err != nil {
+		return err
+	}
+
 	analyzed := analysis.New(specDoc.Spec())
 
 	models, err := gatherModels(specDoc, modelNames)
@@ -65,6 +77,10 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts *GenOpt
 	}
 
 	operations := gatherOperations(analyzed, operationIDs)
+	operations, err = filterOperations(operations, opts.IncludeOperations, opts.ExcludeOperations)
+	if err != nil {
+		return err
+	}
 
 	if len(operations) == 0 {
 		return errors.New("no operations were selected")
@@ -93,11 +109,11 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts *GenOpt
 		Operations:      operations,
 		Target:          opts.Target,
 		DumpData:        opts.DumpData,
-		Package:         opts.LanguageOpts.MangleName(swag.ToFileName(opts.ClientPackage), "client"),
+		Package:         opts.LanguageOpts.ManglePackageName(opts.ClientPackage, "client"),
 		APIPackage:      opts.LanguageOpts.MangleName(swag.ToFileName(opts.APIPackage), "api"),
-		ModelsPackage:   opts.LanguageOpts.MangleName(swag.ToFileName(opts.ModelPackage), "definitions"),
-		ServerPackage:   opts.LanguageOpts.MangleName(swag.ToFileName(opts.ServerPackage), "server"),
-		ClientPackage:   opts.LanguageOpts.MangleName(swag.ToFileName(opts.ClientPackage), "client"),
+		ModelsPackage:   opts.LanguageOpts.ManglePackageName(opts.ModelPackage, "definitions"),
+		ServerPackage:   opts.LanguageOpts.ManglePackageName(opts.ServerPackage, "server"),
+		ClientPackage:   opts.LanguageOpts.ManglePackageName(opts.ClientPackage, "client"),
 		Principal:       opts.Principal,
 		DefaultScheme:   defaultScheme,
 		DefaultProduces: defaultProduces,
@@ -106,7 +122,10 @@ func GenerateClient(name string, modelNames, operationIDs []string, opts *GenOpt
 	}
 	generator.Receiver = "o"
 
-	return (&clientGenerator{generator}).Generate()
+	if err := (&clientGenerator{generator}).Generate(); err != nil {
+		return err
+	}
+	return opts.recordGeneration(clientGeneration, modelNames, operationIDs)
 }
 
 type clientGenerator struct {
@@ -120,7 +139,7 @@ func (c *clientGenerator) Generate() error {
 	}
 	app.DefaultImports = []string{c.GenOpts.ExistingModels}
 	if c.GenOpts.ExistingModels == "" {
-		app.DefaultImports = []string{filepath.ToSlash(filepath.Join(baseImport(c.Target), c.ModelsPackage))}
+		app.DefaultImports = []string{c.GenOpts.packageImport(c.Target, c.GenOpts.ModelPackage, "definitions")}
 	}
 	if err != nil {
 		return err
@@ -175,7 +194,7 @@ func (c *clientGenerator) Generate() error {
 				}
 				// })
 			}
-			app.DefaultImports = append(app.DefaultImports, filepath.ToSlash(filepath.Join(baseImport(c.Target), c.ClientPackage, opGroup.Name)))
+			app.DefaultImports = append(app.DefaultImports, c.GenOpts.packageImport(c.Target, c.GenOpts.ClientPackage, "client", opGroup.Name))
 
 			// wg.Do(func() {
 			if err := c.GenOpts.renderOperationGroup(&opGroup); err != nil {
@@ -195,6 +214,14 @@ func (c *clientGenerator) Generate() error {
 		// })
 	}
 
+	if c.GenOpts.IncludeCLI {
+		cliApp := app
+		cliApp.DefaultImports = append(append([]string{}, app.DefaultImports...), c.GenOpts.packageImport(c.Target, c.GenOpts.ClientPackage, "client"))
+		if err := c.GenOpts.renderCLI(&cliApp); err != nil {
+			return err
+		}
+	}
+
 	// wg.Wait()
 
 	// if len(errChan) > 0 {
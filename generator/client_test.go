@@ -15,9 +15,15 @@
 package generator
 
 import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
 	"testing"
 
+	"github.com/go-openapi/spec"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_InvalidSpec(t *testing.T) {
@@ -26,3 +32,275 @@ func TestClient_InvalidSpec(t *testing.T) {
 	opts.ValidateSpec = true
 	assert.Error(t, GenerateClient("foo", nil, nil, &opts))
 }
+
+func TestClient_CLISections(t *testing.T) {
+	var opts GenOpts
+	if assert.NoError(t, opts.EnsureDefaults(true)) && assert.Len(t, opts.Sections.CLI, 1) {
+		assert.Equal(t, "asset:cliMain", opts.Sections.CLI[0].Source)
+		assert.Equal(t, "main.go", opts.Sections.CLI[0].FileName)
+	}
+
+	var srvOpts GenOpts
+	if assert.NoError(t, srvOpts.EnsureDefaults(false)) {
+		assert.Empty(t, srvOpts.Sections.CLI)
+	}
+
+	_, err := templates.Get("cliMain")
+	assert.NoError(t, err)
+}
+
+func clientTestApp(t testing.TB) (*appGenerator, GenApp) {
+	gen := testGenOpts()
+	gen.defaultsEnsured = false
+	gen.Spec = "../fixtures/codegen/todolist.tests.yml"
+	require.NoError(t, gen.EnsureDefaults(true))
+	appGen, err := newAppGenerator("todo", nil, nil, &gen)
+	require.NoError(t, err)
+	app, err := appGen.makeCodegenApp()
+	require.NoError(t, err)
+	return appGen, app
+}
+
+func clientTestGroup(t testing.TB, app GenApp, name string) GenOperationGroup {
+	for _, group := range app.OperationGroups {
+		if group.Name == name {
+			return group
+		}
+	}
+	t.Fatalf("no operation group %s", name)
+	return GenOperationGroup{}
+}
+
+func TestClient_Mocks(t *testing.T) {
+	var opts GenOpts
+	if assert.NoError(t, opts.EnsureDefaults(true)) {
+		assert.Len(t, opts.Sections.OperationGroups, 1)
+	}
+	mockOpts := GenOpts{IncludeMocks: true}
+	if assert.NoError(t, mockOpts.EnsureDefaults(true)) && assert.Len(t, mockOpts.Sections.OperationGroups, 2) {
+		assert.Equal(t, "asset:clientMock", mockOpts.Sections.OperationGroups[1].Source)
+	}
+
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	appGen, app := clientTestApp(t)
+	tasks := clientTestGroup(t, app, "tasks")
+	require.Len(t, tasks.Operations, 3)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientClient").Execute(buf, tasks))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "type ClientService interface {", res)
+	assertInCode(t, "ListTasks(params *ListTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListTasksOK, error)\n", res)
+	assertInCode(t, "func (a *Client) ListTasks(params *ListTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListTasksOK, error) {", res)
+
+	buf.Reset()
+	require.NoError(t, templates.MustGet("clientMock").Execute(buf, tasks))
+	ff, err = appGen.GenOpts.LanguageOpts.FormatContent("tasks_client_mock.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res = string(ff)
+	assertInCode(t, "var _ ClientService = new(MockClient)", res)
+	assertInCode(t, "ListTasksFunc func(params *ListTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListTasksOK, error)", res)
+	assertInCode(t, `m.record("listTasks", params, authInfo)`, res)
+	assertInCode(t, "func (m *MockClient) StubListTasks(listTasksOK *ListTasksOK, err error) {", res)
+	assertInCode(t, "return listTasksOK, err", res)
+	assertInCode(t, "func (m *MockClient) ListTasksCalls() []*ListTasksParams {", res)
+	assertInCode(t, "func (m *MockClient) SetTransport(transport runtime.ClientTransport) {", res)
+}
+
+func TestClient_Interceptors(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	appGen, app := clientTestApp(t)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientFacade").Execute(buf, app))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "return New(Intercept(transport, cfg.Interceptors...), formats)", res)
+	assertInCode(t, "type Interceptor func(next http.RoundTripper) http.RoundTripper", res)
+	assertInCode(t, "func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {", res)
+	assertInCode(t, "func (cfg *TransportConfig) WithInterceptors(interceptors ...Interceptor) *TransportConfig {", res)
+	assertInCode(t, "func Intercept(transport *httptransport.Runtime, interceptors ...Interceptor) runtime.ClientTransport {", res)
+	assertInCode(t, "client.Transport = intercepted(signed(client.Transport), t.interceptors)", res)
+	assertInCode(t, "Tasks tasks.ClientService", res)
+
+	buf.Reset()
+	require.NoError(t, templates.MustGet("clientClient").Execute(buf, clientTestGroup(t, app, "tasks")))
+	ff, err = appGen.GenOpts.LanguageOpts.FormatContent("tasks_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res = string(ff)
+	assertInCode(t, "func WithInterceptors(interceptors ...func(http.RoundTripper) http.RoundTripper) ClientOption {", res)
+	assertInCode(t, "next = interceptors[i](next)", res)
+}
+
+func TestClient_TransportSettings(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	appGen, app := clientTestApp(t)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientFacade").Execute(buf, app))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "transport.Transport = Signing(cfg.HTTPTransport())", res)
+	assertInCode(t, "MaxIdleConnsPerHost int", res)
+	assertInCode(t, "TLSConfig *tls.Config", res)
+	assertInCode(t, "Proxy func(*http.Request) (*url.URL, error)", res)
+	assertInCode(t, "func (cfg *TransportConfig) WithMaxIdleConns(total, perHost int) *TransportConfig {", res)
+	assertInCode(t, "func (cfg *TransportConfig) WithDialTimeout(timeout, keepAlive time.Duration) *TransportConfig {", res)
+	assertInCode(t, "func (cfg *TransportConfig) WithTLSConfig(tlsConfig *tls.Config) *TransportConfig {", res)
+	assertInCode(t, "cfg.Proxy = http.ProxyURL(proxyURL)", res)
+	assertInCode(t, "return http.DefaultTransport", res)
+	assertInCode(t, "dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}", res)
+}
+
+func TestClient_Cache(t *testing.T) {
+	var opts GenOpts
+	if assert.NoError(t, opts.EnsureDefaults(true)) && assert.Len(t, opts.Sections.Application, 3) {
+		assert.Equal(t, "asset:clientCache", opts.Sections.Application[1].Source)
+		assert.Equal(t, "cache.go", opts.Sections.Application[1].FileName)
+	}
+
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	appGen, app := clientTestApp(t)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientCache").Execute(buf, app))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("cache.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "type CacheStore interface {", res)
+	assertInCode(t, "func Caching(store CacheStore) Interceptor {", res)
+	assertInCode(t, `sent.Header.Set("If-None-Match", etag)`, res)
+	assertInCode(t, "if found && resp.StatusCode == http.StatusNotModified {", res)
+	assertInCode(t, "func NewMemoryCache(maxEntries int) CacheStore {", res)
+
+	buf.Reset()
+	require.NoError(t, templates.MustGet("clientFacade").Execute(buf, app))
+	ff, err = appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res = string(ff)
+	assertInCode(t, "transport.Transport = Caching(cfg.Cache)(transport.Transport)", res)
+	assertInCode(t, "func (cfg *TransportConfig) WithCache(store CacheStore) *TransportConfig {", res)
+}
+
+func TestClient_AsyncAndBatch(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	appGen, app := clientTestApp(t)
+	tasks := clientTestGroup(t, app, "tasks")
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientClient").Execute(buf, tasks))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "ListTasksAsync(params *ListTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) <-chan ListTasksResult\n", res)
+	assertInCode(t, "type ListTasksResult struct {\n\tListTasksOK *ListTasksOK\n\tErr         error\n}", res)
+	assertInCode(t, "func (a *Client) ListTasksAsync(params *ListTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) <-chan ListTasksResult {", res)
+	assertInCode(t, "r.ListTasksOK, r.Err = a.ListTasks(params, authInfo, opts...)", res)
+
+	buf.Reset()
+	require.NoError(t, templates.MustGet("clientMock").Execute(buf, tasks))
+	ff, err = appGen.GenOpts.LanguageOpts.FormatContent("tasks_client_mock.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	assertInCode(t, "r.ListTasksOK, r.Err = m.ListTasks(params, authInfo, opts...)", string(ff))
+
+	buf.Reset()
+	require.NoError(t, templates.MustGet("clientFacade").Execute(buf, app))
+	ff, err = appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res = string(ff)
+	assertInCode(t, "type BatchCall func() error", res)
+	assertInCode(t, "func Batch(workers int, calls ...BatchCall) error {", res)
+	assertInCode(t, "return &BatchError{Errors: errs}", res)
+}
+
+func TestClient_Signing(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	gen := testGenOpts()
+	gen.defaultsEnsured = false
+	gen.Spec = "../fixtures/codegen/todolist.signing.yml"
+	require.NoError(t, gen.EnsureDefaults(true))
+	appGen, err := newAppGenerator("todo", nil, nil, &gen)
+	require.NoError(t, err)
+	app, err := appGen.makeCodegenApp()
+	require.NoError(t, err)
+
+	signings := make(map[string]*GenSigning)
+	for _, scheme := range app.SecurityDefinitions {
+		signings[scheme.ID] = scheme.Signing
+	}
+	if assert.NotNil(t, signings["hmacKey"]) {
+		assert.Equal(t, "hmac-sha512", signings["hmacKey"].Algorithm)
+		assert.Equal(t, []string{"(request-target)", "host", "date", "digest", "content-type"}, signings["hmacKey"].Headers)
+		assert.False(t, signings["hmacKey"].IsSigV4())
+	}
+	if assert.NotNil(t, signings["aws"]) {
+		assert.True(t, signings["aws"].IsSigV4())
+		assert.Equal(t, "eu-west-1", signings["aws"].Region)
+	}
+	if assert.NotNil(t, signings["gateway"]) {
+		assert.True(t, signings["gateway"].IsSigV4())
+		assert.Equal(t, "execute-api", signings["gateway"].Service)
+		assert.Empty(t, signings["gateway"].Region)
+	}
+	assert.Nil(t, signings["token"])
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientSigning").Execute(buf, app))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("signing.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "func Signing(next http.RoundTripper) http.RoundTripper {", res)
+	assertInCode(t, "func (s *HMACSigner) Sign(req *http.Request, body []byte) error {", res)
+	assertInCode(t, "func (s *SigV4Signer) Sign(req *http.Request, body []byte) error {", res)
+	assertInCode(t, "func NewHmacKeySigner(keyID string, secret []byte) *HMACSigner {", res)
+	assertInCode(t, `Headers:   []string{"(request-target)", "host", "date", "digest", "content-type"},`, res)
+	assertInCode(t, `Header:    "Signature",`, res)
+	assertInCode(t, "func NewAwsSigner(accessKeyID, secretAccessKey string) *SigV4Signer {", res)
+	assertInCode(t, `Region:          "eu-west-1",`, res)
+	assertInCode(t, "func NewGatewaySigner(accessKeyID, secretAccessKey string) *SigV4Signer {", res)
+	assertNotInCode(t, "func NewTokenSigner", res)
+
+	buf.Reset()
+	require.NoError(t, templates.MustGet("clientFacade").Execute(buf, app))
+	ff, err = appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res = string(ff)
+	assertInCode(t, "transport.Transport = Signing(cfg.HTTPTransport())", res)
+	assertInCode(t, "client.Transport = intercepted(signed(client.Transport), t.interceptors)", res)
+}
+
+func TestClient_SigningErrors(t *testing.T) {
+	for _, scheme := range []spec.SecurityScheme{
+		*spec.BasicAuth(),
+		*spec.APIKeyAuth("key", "query"),
+	} {
+		scheme.AddExtension("x-signing", map[string]interface{}{"algorithm": "hmac-sha256"})
+		_, err := makeSigning("scheme", &scheme)
+		assert.Error(t, err)
+	}
+
+	for _, ext := range []interface{}{
+		"hmac-sha256",
+		map[string]interface{}{"algorithm": "md5"},
+		map[string]interface{}{"algorithm": "hmac-sha256", "region": "eu-west-1"},
+		map[string]interface{}{"algorithm": "aws-sigv4", "headers": []interface{}{"date"}},
+		map[string]interface{}{"algorithm": "hmac-sha256", "headers": "date"},
+		map[string]interface{}{"algorithm": "hmac-sha256", "expires": 300},
+	} {
+		scheme := spec.APIKeyAuth("Authorization", "header")
+		scheme.AddExtension("x-signing", ext)
+		_, err := makeSigning("scheme", scheme)
+		assert.Error(t, err, "%v", ext)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// CompileCheckError is returned when the code of a generation doesn't build, before anything is written to the target
+type CompileCheckError struct {
+	Command string
+	Output  string
+}
+
+func (e *CompileCheckError) Error() string {
+	return fmt.Sprintf("the generated code fails %s, nothing was written to the target:\n%s", e.Command, e.Output)
+}
+
+// checkCompiles checks that the code a generation produces builds, when asked, before it runs for real
+func (g *GenOpts) checkCompiles(generate func(*GenOpts) error) error {
+	if g == nil || !g.CompileCheck {
+		return nil
+	}
+	return g.compileCheck(generate)
+}
+
+// compileCheck runs a generation in a copy of the target, at the same import path in a temporary GOPATH,
+// and builds (and vets) what it generated there.
+//
+// The GOPATH of the generation comes after the temporary one, and the vendor directories enclosing the target
+// are linked at their place in the copy, so the generated code finds the packages it imports.
+func (g *GenOpts) compileCheck(generate func(*GenOpts) error) error {
+	importPath := g.baseImport(g.Target)
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		gopath = filepath.Join(os.Getenv("HOME"), "go")
+	}
+
+	tmp, err := ioutil.TempDir("", "swagger-compile-check")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	target := filepath.Join(tmp, "src", filepath.FromSlash(importPath))
+	if err := copyTree(g.Target, target); err != nil {
+		return err
+	}
+	if err := linkVendors(gopath, tmp, importPath); err != nil {
+		return err
+	}
+
+	check := *g
+	check.Target = target
+	check.CompileCheck = false
+	check.DryRun = false
+	check.generated = nil
+	check.planned = nil
+
+	// the import paths of the generation are found from the GOPATH
+	checkPath := tmp + string(filepath.ListSeparator) + gopath
+	previous, isSet := os.LookupEnv("GOPATH")
+	os.Setenv("GOPATH", checkPath)
+	defer func() {
+		if isSet {
+			os.Setenv("GOPATH", previous)
+		} else {
+			os.Unsetenv("GOPATH")
+		}
+	}()
+
+	log.Printf("generating in %s to check that the code builds", target)
+	if err := generate(&check); err != nil {
+		return err
+	}
+
+	commands := [][]string{{"build", "./..."}}
+	if g.CompileCheckVet {
+		commands = append(commands, []string{"vet", "./..."})
+	}
+	abs, err := filepath.Abs(g.Target)
+	if err != nil {
+		return err
+	}
+	for _, args := range commands {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = target
+		cmd.Env = append(os.Environ(), "GOPATH="+checkPath, "GO111MODULE=off")
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			continue
+		}
+		if _, ok := err.(*exec.ExitError); !ok {
+			return fmt.Errorf("running go %s: %v", strings.Join(args, " "), err)
+		}
+		return &CompileCheckError{
+			Command: "go " + strings.Join(args, " "),
+			// the errors point at the files of the target, not at their copy
+			Output: strings.Replace(string(out), target, abs, -1),
+		}
+	}
+	log.Printf("the generated code passes go %s", strings.Join(commands[len(commands)-1], " "))
+	return nil
+}
+
+// copyTree copies the files of a directory, when it exists
+func copyTree(from, to string) error {
+	return filepath.Walk(from, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && pth == from {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(from, pth)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(to, rel)
+		if info.IsDir() {
+			return os.MkdirAll(dest, 0755)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		src, err := os.Open(pth)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		dst, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(dst, src); err != nil {
+			dst.Close()
+			return err
+		}
+		return dst.Close()
+	})
+}
+
+// linkVendors links the vendor directories of the packages enclosing an import path into a temporary GOPATH
+func linkVendors(gopath, tmp, importPath string) error {
+	var src string
+	for _, gp := range filepath.SplitList(gopath) {
+		if _, err := os.Stat(filepath.Join(gp, "src", filepath.FromSlash(importPath))); err == nil {
+			src = filepath.Join(gp, "src")
+			break
+		}
+	}
+	if src == "" {
+		// a new target: its parents may exist still
+		src = filepath.Join(filepath.SplitList(gopath)[0], "src")
+	}
+
+	parts := strings.Split(importPath, "/")
+	for i := len(parts) - 1; i > 0; i-- {
+		parent := filepath.Join(parts[:i]...)
+		vendor := filepath.Join(src, parent, "vendor")
+		if info, err := os.Stat(vendor); err != nil || !info.IsDir() {
+			continue
+		}
+		link := filepath.Join(tmp, "src", parent, "vendor")
+		if err := os.MkdirAll(filepath.Dir(link), 0755); err != nil {
+			return err
+		}
+		if err := os.Symlink(vendor, link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateServer_DryRun(t *testing.T) {
+	target, err := ioutil.TempDir(".", "dry-run")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := manifestGenOpts(target, "../fixtures/codegen/trim.yml")
+	opts.DryRun = true
+	require.NoError(t, GenerateServer("trim", nil, nil, opts))
+	planned := opts.PlannedFiles()
+	assert.Contains(t, planned, filepath.Join(target, "models", "pet.go"))
+	assert.Contains(t, planned, filepath.Join(target, "restapi", "configure_trim.go"))
+	files, err := ioutil.ReadDir(target)
+	require.NoError(t, err)
+	assert.Empty(t, files, "a dry run must not write anything, not even the manifest")
+
+	require.NoError(t, GenerateServer("trim", nil, nil, manifestGenOpts(target, "../fixtures/codegen/trim.yml")))
+	opts = manifestGenOpts(target, "../fixtures/codegen/trim.yml")
+	opts.DryRun = true
+	require.NoError(t, GenerateServer("trim", nil, nil, opts))
+	assert.Empty(t, opts.PlannedFiles(), "the unchanged files wouldn't be written")
+}
+
+func TestGenerateServer_CompileCheck(t *testing.T) {
+	target, err := ioutil.TempDir(".", "compile-check")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := manifestGenOpts(target, "../fixtures/codegen/compile-check.yml")
+	opts.CompileCheck = true
+	err = GenerateServer("compile check", nil, nil, opts)
+	if assert.Error(t, err) {
+		assert.IsType(t, &CompileCheckError{}, err)
+		assert.Contains(t, err.Error(), "go build ./...")
+		assert.Contains(t, err.Error(), `models/pet.go:14:2: cannot find package "example.com/nope/money"`)
+	}
+	files, err := ioutil.ReadDir(target)
+	require.NoError(t, err)
+	assert.Empty(t, files, "nothing is written when the code doesn't build")
+}
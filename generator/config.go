@@ -2,8 +2,10 @@ package generator
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -11,15 +13,96 @@ import (
 // LanguageDefinition in the configuration file.
 type LanguageDefinition struct {
 	Layout SectionOpts `mapstructure:"layout"`
+	// Packages overrides the packages given on the command line
+	Packages PackageOpts `mapstructure:"packages"`
+	// OperationGrouping puts the operations in a package per tag ("tag", the default), or all in the api package ("flat")
+	OperationGrouping string `mapstructure:"operation_grouping"`
+	// FileNames overrides the file name of the templates of the layout, by template name
+	FileNames map[string]string `mapstructure:"file_names"`
+	// Copyright is the header of the generated files, or CopyrightFile the file which holds it, unless given on the command line
+	Copyright     string `mapstructure:"copyright"`
+	CopyrightFile string `mapstructure:"copyright_file"`
+	// BuildTags constrain the build of the generated go files, unless given on the command line
+	BuildTags string `mapstructure:"build_tags"`
+}
+
+// PackageOpts are the packages of a generation: the models, server and client packages
+// are slash separated paths in the target, like internal/models
+type PackageOpts struct {
+	API    string `mapstructure:"api"`
+	Models string `mapstructure:"models"`
+	Server string `mapstructure:"server"`
+	Client string `mapstructure:"client"`
 }
 
 // ConfigureOpts for generation
 func (d *LanguageDefinition) ConfigureOpts(opts *GenOpts) error {
-	opts.Sections = d.Layout
+	// a configuration without layout keeps the default one
+	if !d.Layout.isEmpty() {
+		opts.Sections = d.Layout
+	}
 	opts.LanguageOpts = GoLangOpts()
+
+	if strings.ContainsAny(d.Packages.API, `/\`) {
+		return fmt.Errorf("the api package %q can't be a path: it is a package of the server package", d.Packages.API)
+	}
+	for _, p := range []struct {
+		value string
+		opt   *string
+	}{
+		{d.Packages.API, &opts.APIPackage},
+		{d.Packages.Models, &opts.ModelPackage},
+		{d.Packages.Server, &opts.ServerPackage},
+		{d.Packages.Client, &opts.ClientPackage},
+	} {
+		if p.value != "" {
+			*p.opt = strings.Trim(filepath.ToSlash(p.value), "/")
+		}
+	}
+
+	switch d.OperationGrouping {
+	case "", "tag":
+	case "flat":
+		opts.FlatOperations = true
+	default:
+		return fmt.Errorf("unknown operation grouping %q, expected tag or flat", d.OperationGrouping)
+	}
+
+	if opts.Copyright == "" {
+		opts.Copyright = d.Copyright
+		if d.Copyright == "" && d.CopyrightFile != "" {
+			b, err := ioutil.ReadFile(d.CopyrightFile)
+			if err != nil {
+				return err
+			}
+			opts.Copyright = string(b)
+		}
+	}
+	if opts.BuildTags == "" {
+		opts.BuildTags = d.BuildTags
+	}
+
+	// the names of a shared configuration may be for the templates of another generation: they don't need to match
+	for name, fileName := range d.FileNames {
+		opts.Sections.setFileName(name, fileName)
+	}
 	return nil
 }
 
+func (s *SectionOpts) isEmpty() bool {
+	return len(s.Application) == 0 && len(s.Operations) == 0 && len(s.OperationGroups) == 0 && len(s.Models) == 0 && len(s.CLI) == 0 && len(s.Versions) == 0
+}
+
+func (s *SectionOpts) setFileName(name, fileName string) {
+	for _, section := range [][]TemplateOpts{s.Application, s.Operations, s.OperationGroups, s.Models, s.CLI, s.Versions} {
+		for i := range section {
+			if section[i].Name == name {
+				section[i].FileName = fileName
+			}
+		}
+	}
+}
+
 // LanguageConfig structure that is obtained from parsing a config file
 type LanguageConfig map[string]LanguageDefinition
 
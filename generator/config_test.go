@@ -0,0 +1,113 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const layoutConfig = `
+packages:
+  models: internal/models
+  server: internal/server
+  api: handlers
+operation_grouping: flat
+file_names:
+  definition: "{{ snakize (pascalize .Name) }}_model.go"
+  handler: "{{ snakize (pascalize .Name) }}_handler.go"
+`
+
+func readLanguageDefinition(t testing.TB, config string) LanguageDefinition {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(config)))
+	var def LanguageDefinition
+	require.NoError(t, v.Unmarshal(&def))
+	return def
+}
+
+func TestLanguageDefinition_ConfigureOpts(t *testing.T) {
+	def := readLanguageDefinition(t, layoutConfig)
+	opts := testGenOpts()
+	require.NoError(t, def.ConfigureOpts(&opts))
+
+	assert.Equal(t, "internal/models", opts.ModelPackage)
+	assert.Equal(t, "internal/server", opts.ServerPackage)
+	assert.Equal(t, "handlers", opts.APIPackage)
+	assert.Equal(t, "client", opts.ClientPackage, "the packages which aren't configured are kept")
+	assert.True(t, opts.FlatOperations)
+
+	// a configuration without layout keeps the default templates, with the file names it overrides
+	require.Len(t, opts.Sections.Models, 1)
+	assert.Equal(t, "{{ snakize (pascalize .Name) }}_model.go", opts.Sections.Models[0].FileName)
+	for _, tpl := range opts.Sections.Operations {
+		if tpl.Name == "parameters" {
+			assert.Equal(t, "{{ (snakize (pascalize .Name)) }}_parameters.go", tpl.FileName)
+		}
+	}
+
+	def = readLanguageDefinition(t, "copyright: Copyright 2018 Example Corp\nbuild_tags: integration\n")
+	opts = testGenOpts()
+	require.NoError(t, def.ConfigureOpts(&opts))
+	assert.Equal(t, "Copyright 2018 Example Corp", opts.Copyright)
+	assert.Equal(t, "integration", opts.BuildTags)
+	opts = testGenOpts()
+	opts.Copyright, opts.BuildTags = "from the command line", "e2e"
+	require.NoError(t, def.ConfigureOpts(&opts))
+	assert.Equal(t, "from the command line", opts.Copyright)
+	assert.Equal(t, "e2e", opts.BuildTags)
+
+	for _, config := range []string{"operation_grouping: nested\n", "packages:\n  api: server/handlers\n"} {
+		opts := testGenOpts()
+		def := readLanguageDefinition(t, config)
+		assert.Error(t, def.ConfigureOpts(&opts), config)
+	}
+}
+
+func TestGenerateServer_ConfiguredLayout(t *testing.T) {
+	target, err := ioutil.TempDir(".", "layout")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	def := readLanguageDefinition(t, layoutConfig)
+	opts := manifestGenOpts(target, "../fixtures/golden/petstore/swagger.yml")
+	require.NoError(t, def.ConfigureOpts(opts))
+	require.NoError(t, GenerateServer("petstore", nil, nil, opts))
+
+	for _, file := range []string{
+		"internal/models/pet_model.go",
+		"internal/server/configure_petstore.go",
+		"internal/server/handlers/petstore_api.go",
+		"internal/server/handlers/get_pet_handler.go",
+		"internal/server/handlers/get_pet_parameters.go",
+	} {
+		_, err := os.Stat(filepath.Join(target, filepath.FromSlash(file)))
+		assert.NoError(t, err, file)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(target, "internal", "server", "handlers", "get_pet_responses.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "package handlers")
+	assert.Contains(t, string(b), "/"+filepath.Base(target)+`/internal/models"`)
+	assert.Contains(t, string(b), "*models.Pet")
+}
@@ -0,0 +1,536 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+// GenerateMarkdown generates the reference documentation of a swagger spec as a markdown file
+func GenerateMarkdown(fileName string, opts *GenOpts) error {
+	return generateDocs(&TemplateOpts{
+		Name:       "markdown",
+		Source:     "asset:docsMarkdown",
+		Target:     "{{ .Target }}",
+		FileName:   fileName,
+		SkipFormat: true,
+	}, opts)
+}
+
+// GenerateHTML generates the reference documentation of a swagger spec as a standalone HTML page
+func GenerateHTML(fileName string, opts *GenOpts) error {
+	return generateDocs(&TemplateOpts{
+		Name:       "html",
+		Source:     "asset:docsHtml",
+		Target:     "{{ .Target }}",
+		FileName:   fileName,
+		SkipFormat: true,
+	}, opts)
+}
+
+func generateDocs(templ *TemplateOpts, opts *GenOpts) error {
+	if opts == nil {
+		return errors.New("gen opts are required")
+	}
+	if err := opts.EnsureDefaults(false); err != nil {
+		return err
+	}
+
+	if opts.TemplateDir != "" {
+		if err := templates.LoadDir(opts.TemplateDir); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	var specDoc *loads.Document
+	opts.Spec, specDoc, err = loadSpec(opts.Spec)
+	if err != nil {
+		return err
+	}
+
+	specDoc, err = validateAndFlattenSpec(opts, specDoc)
+	if err != nil {
+		return err
+	}
+
+	docs := makeGenDocs(specDoc)
+	docs.Copyright = opts.Copyright
+	return opts.write(templ, &docs)
+}
+
+// GenDocs contains the data needed to render the reference documentation of an API
+type GenDocs struct {
+	GenCommon
+	Name                string
+	Info                *spec.Info
+	Host                string
+	BasePath            string
+	Schemes             []string
+	Consumes            []string
+	Produces            []string
+	SecurityDefinitions []GenDocsSecurityScheme
+	OperationGroups     []GenDocsOperationGroup
+	Models              []GenDocsModel
+}
+
+// Title returns the title of the API, or a humanized version of its name when the spec has none
+func (g *GenDocs) Title() string {
+	if g.Info != nil && strings.TrimSpace(g.Info.Title) != "" {
+		return g.Info.Title
+	}
+	return swag.ToHumanNameTitle(g.Name)
+}
+
+// GenDocsSecurityScheme represents a security definition in the documentation
+type GenDocsSecurityScheme struct {
+	ID     string
+	Scopes []GenDocsScope
+	spec.SecurityScheme
+}
+
+// GenDocsScope represents an oauth2 scope in the documentation
+type GenDocsScope struct {
+	Name        string
+	Description string
+}
+
+// GenDocsOperationGroup represents the operations sharing the same tag in the documentation
+type GenDocsOperationGroup struct {
+	Name        string
+	Description string
+	Operations  []GenDocsOperation
+}
+
+// GenDocsOperation represents an operation in the documentation
+type GenDocsOperation struct {
+	ID          string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Deprecated  bool
+	Consumes    []string
+	Produces    []string
+	Security    []string
+	Params      []GenDocsParam
+	Responses   []GenDocsResponse
+}
+
+type genDocsOperations []GenDocsOperation
+
+func (g genDocsOperations) Len() int      { return len(g) }
+func (g genDocsOperations) Swap(i, j int) { g[i], g[j] = g[j], g[i] }
+func (g genDocsOperations) Less(i, j int) bool {
+	if g[i].Path == g[j].Path {
+		return g[i].Method < g[j].Method
+	}
+	return g[i].Path < g[j].Path
+}
+
+// GenDocsParam represents an operation parameter in the documentation
+type GenDocsParam struct {
+	GenDocsType
+	Name        string
+	In          string
+	Description string
+	Required    bool
+	Default     string
+}
+
+// GenDocsResponse represents an operation response in the documentation
+type GenDocsResponse struct {
+	GenDocsType
+	Code        string
+	Description string
+	Headers     []GenDocsParam
+}
+
+// GenDocsModel represents a definition in the documentation
+type GenDocsModel struct {
+	GenDocsType
+	Name        string
+	Title       string
+	Description string
+	Composes    []string
+	Properties  []GenDocsProperty
+}
+
+// GenDocsProperty represents a property of a definition in the documentation
+type GenDocsProperty struct {
+	GenDocsType
+	Name        string
+	Description string
+	Required    bool
+	ReadOnly    bool
+}
+
+// GenDocsType describes the type of a documented item.
+//
+// Ref is the name of the model the type refers to, when there is one.
+type GenDocsType struct {
+	Type string
+	Ref  string
+	Enum string
+}
+
+func makeGenDocs(specDoc *loads.Document) GenDocs {
+	sw := specDoc.Spec()
+	docs := GenDocs{
+		Name:     appNameOrDefault(specDoc, "", "rest"),
+		Info:     sw.Info,
+		Host:     sw.Host,
+		BasePath: sw.BasePath,
+		Schemes:  sw.Schemes,
+		Consumes: sw.Consumes,
+		Produces: sw.Produces,
+	}
+	if docs.BasePath == "" {
+		docs.BasePath = "/"
+	}
+
+	var ids []string
+	for id := range sw.SecurityDefinitions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		scheme := GenDocsSecurityScheme{ID: id, SecurityScheme: *sw.SecurityDefinitions[id]}
+		var scopes []string
+		for scope := range scheme.SecurityScheme.Scopes {
+			scopes = append(scopes, scope)
+		}
+		sort.Strings(scopes)
+		for _, scope := range scopes {
+			scheme.Scopes = append(scheme.Scopes, GenDocsScope{Name: scope, Description: scheme.SecurityScheme.Scopes[scope]})
+		}
+		docs.SecurityDefinitions = append(docs.SecurityDefinitions, scheme)
+	}
+
+	docs.OperationGroups = makeGenDocsOperationGroups(sw, analysis.New(sw))
+
+	var names []string
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		docs.Models = append(docs.Models, makeGenDocsModel(name, sw.Definitions[name]))
+	}
+	return docs
+}
+
+func makeGenDocsOperationGroups(sw *spec.Swagger, analyzed *analysis.Spec) []GenDocsOperationGroup {
+	byTag := make(map[string]*GenDocsOperationGroup)
+	var names []string
+	tagFor := func(name string) *GenDocsOperationGroup {
+		if tag, ok := byTag[name]; ok {
+			return tag
+		}
+		tag := &GenDocsOperationGroup{Name: name}
+		for _, t := range sw.Tags {
+			if t.Name == name {
+				tag.Description = t.Description
+			}
+		}
+		byTag[name] = tag
+		names = append(names, name)
+		return tag
+	}
+
+	for method, ops := range analyzed.Operations() {
+		for pth, op := range ops {
+			gop := makeGenDocsOperation(sw, analyzed, method, pth, op)
+			name := "default"
+			if len(op.Tags) > 0 {
+				name = op.Tags[0]
+			}
+			tag := tagFor(name)
+			tag.Operations = append(tag.Operations, gop)
+		}
+	}
+
+	sort.Strings(names)
+	tags := make([]GenDocsOperationGroup, 0, len(names))
+	for _, name := range names {
+		tag := byTag[name]
+		sort.Sort(genDocsOperations(tag.Operations))
+		tags = append(tags, *tag)
+	}
+	return tags
+}
+
+func makeGenDocsOperation(sw *spec.Swagger, analyzed *analysis.Spec, method, pth string, op *spec.Operation) GenDocsOperation {
+	gop := GenDocsOperation{
+		ID:          op.ID,
+		Method:      strings.ToUpper(method),
+		Path:        pth,
+		Summary:     op.Summary,
+		Description: op.Description,
+		Deprecated:  op.Deprecated,
+		Consumes:    op.Consumes,
+		Produces:    op.Produces,
+	}
+	if len(gop.Consumes) == 0 {
+		gop.Consumes = sw.Consumes
+	}
+	if len(gop.Produces) == 0 {
+		gop.Produces = sw.Produces
+	}
+
+	security := op.Security
+	if security == nil {
+		security = sw.Security
+	}
+	for _, requirement := range security {
+		var schemes []string
+		for name, scopes := range requirement {
+			if len(scopes) > 0 {
+				name += " (" + strings.Join(scopes, ", ") + ")"
+			}
+			schemes = append(schemes, name)
+		}
+		sort.Strings(schemes)
+		if len(schemes) > 0 {
+			gop.Security = append(gop.Security, strings.Join(schemes, " and "))
+		}
+	}
+
+	// the parameters are the ones the generated code gets, merged by the analysis of the spec,
+	// in the order they are declared: on the path first, then on the operation
+	var declared []spec.Parameter
+	if sw.Paths != nil {
+		declared = append(declared, sw.Paths.Paths[pth].Parameters...)
+	}
+	declared = append(declared, op.Parameters...)
+	position := func(param spec.Parameter) int {
+		for i, p := range declared {
+			p = resolveDocsParam(sw, p)
+			if p.In == param.In && p.Name == param.Name {
+				return i
+			}
+		}
+		return len(declared)
+	}
+
+	merged := analyzed.ParamsFor(method, pth)
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return position(merged[keys[i]]) < position(merged[keys[j]])
+	})
+	for _, key := range keys {
+		gop.Params = append(gop.Params, makeGenDocsParam(merged[key]))
+	}
+
+	if op.Responses != nil {
+		var codes []int
+		for code := range op.Responses.StatusCodeResponses {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			gop.Responses = append(gop.Responses, makeGenDocsResponse(sw, fmt.Sprintf("%d", code), op.Responses.StatusCodeResponses[code]))
+		}
+		if op.Responses.Default != nil {
+			gop.Responses = append(gop.Responses, makeGenDocsResponse(sw, "default", *op.Responses.Default))
+		}
+	}
+	return gop
+}
+
+func resolveDocsParam(sw *spec.Swagger, param spec.Parameter) spec.Parameter {
+	if ref := param.Ref.String(); ref != "" {
+		if resolved, ok := sw.Parameters[path.Base(ref)]; ok {
+			return resolved
+		}
+	}
+	return param
+}
+
+func makeGenDocsParam(param spec.Parameter) GenDocsParam {
+	p := GenDocsParam{
+		Name:        param.Name,
+		In:          param.In,
+		Description: param.Description,
+		Required:    param.Required,
+	}
+	if param.Default != nil {
+		p.Default = docsValue(param.Default)
+	}
+	if param.In == "body" {
+		p.GenDocsType = makeGenDocsSchemaType(param.Schema)
+		return p
+	}
+	p.GenDocsType = GenDocsType{Type: docsSimpleType(param.Type, param.Format, param.Items, param.CollectionFormat), Enum: docsEnum(param.Enum)}
+	if param.Items != nil && len(param.Enum) == 0 {
+		p.Enum = docsEnum(param.Items.Enum)
+	}
+	return p
+}
+
+func makeGenDocsResponse(sw *spec.Swagger, code string, resp spec.Response) GenDocsResponse {
+	if ref := resp.Ref.String(); ref != "" {
+		if resolved, ok := sw.Responses[path.Base(ref)]; ok {
+			resp = resolved
+		}
+	}
+	r := GenDocsResponse{
+		Code:        code,
+		Description: resp.Description,
+		GenDocsType: makeGenDocsSchemaType(resp.Schema),
+	}
+	var headers []string
+	for name := range resp.Headers {
+		headers = append(headers, name)
+	}
+	sort.Strings(headers)
+	for _, name := range headers {
+		header := resp.Headers[name]
+		r.Headers = append(r.Headers, GenDocsParam{
+			Name:        name,
+			In:          "header",
+			Description: header.Description,
+			GenDocsType: GenDocsType{Type: docsSimpleType(header.Type, header.Format, header.Items, header.CollectionFormat), Enum: docsEnum(header.Enum)},
+		})
+	}
+	return r
+}
+
+func makeGenDocsModel(name string, schema spec.Schema) GenDocsModel {
+	m := GenDocsModel{
+		Name:        name,
+		Title:       schema.Title,
+		Description: schema.Description,
+		GenDocsType: makeGenDocsSchemaType(&schema),
+	}
+
+	// properties of inline allOf members are documented along with the ones of the model
+	parts := []spec.Schema{schema}
+	for _, part := range schema.AllOf {
+		if ref := part.Ref.String(); ref != "" {
+			m.Composes = append(m.Composes, path.Base(ref))
+			continue
+		}
+		parts = append(parts, part)
+	}
+	for _, part := range parts {
+		var props []string
+		for prop := range part.Properties {
+			props = append(props, prop)
+		}
+		sort.Strings(props)
+		for _, prop := range props {
+			ps := part.Properties[prop]
+			m.Properties = append(m.Properties, GenDocsProperty{
+				Name:        prop,
+				Description: ps.Description,
+				Required:    containsString(part.Required, prop),
+				ReadOnly:    ps.ReadOnly,
+				GenDocsType: makeGenDocsSchemaType(&ps),
+			})
+		}
+	}
+	return m
+}
+
+func makeGenDocsSchemaType(schema *spec.Schema) GenDocsType {
+	if schema == nil {
+		return GenDocsType{}
+	}
+	if ref := schema.Ref.String(); ref != "" {
+		name := path.Base(ref)
+		return GenDocsType{Type: name, Ref: name}
+	}
+
+	t := GenDocsType{Enum: docsEnum(schema.Enum)}
+	switch {
+	case schema.Type.Contains("array"):
+		var items *spec.Schema
+		if schema.Items != nil {
+			items = schema.Items.Schema
+		}
+		it := makeGenDocsSchemaType(items)
+		if it.Type == "" {
+			it.Type = "any"
+		}
+		t.Type, t.Ref = "[]"+it.Type, it.Ref
+		if t.Enum == "" {
+			t.Enum = it.Enum
+		}
+	case schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil && len(schema.Properties) == 0:
+		vt := makeGenDocsSchemaType(schema.AdditionalProperties.Schema)
+		if vt.Type == "" {
+			vt.Type = "any"
+		}
+		t.Type, t.Ref = "map[string]"+vt.Type, vt.Ref
+	case len(schema.Type) > 0:
+		t.Type = schema.Type[0]
+		if schema.Format != "" {
+			t.Type += " (" + schema.Format + ")"
+		}
+	case len(schema.Properties) > 0 || len(schema.AllOf) > 0:
+		t.Type = "object"
+	default:
+		t.Type = "any"
+	}
+	return t
+}
+
+func docsSimpleType(tpe, format string, items *spec.Items, collectionFormat string) string {
+	if tpe == "array" {
+		var it string
+		if items != nil {
+			it = docsSimpleType(items.Type, items.Format, items.Items, items.CollectionFormat)
+		}
+		if collectionFormat != "" {
+			return "[]" + it + " (" + collectionFormat + ")"
+		}
+		return "[]" + it
+	}
+	if format != "" {
+		return tpe + " (" + format + ")"
+	}
+	return tpe
+}
+
+func docsEnum(values []interface{}) string {
+	enum := make([]string, 0, len(values))
+	for _, v := range values {
+		enum = append(enum, docsValue(v))
+	}
+	return strings.Join(enum, ", ")
+}
+
+func docsValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocs_Markdown(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/swagger-codegen-tests.json")
+	if assert.NoError(t, err) {
+		docs := makeGenDocs(specDoc)
+		assert.Equal(t, "Swagger Petstore", docs.Title())
+		if assert.Len(t, docs.SecurityDefinitions, 2) {
+			assert.Equal(t, "api_key", docs.SecurityDefinitions[0].ID)
+			assert.Len(t, docs.SecurityDefinitions[1].Scopes, 2)
+		}
+
+		var buf bytes.Buffer
+		if assert.NoError(t, templates.MustGet("docsMarkdown").Execute(&buf, &docs)) {
+			res := buf.String()
+			assertInCode(t, "# Swagger Petstore", res)
+			assertInCode(t, "#### <a name=\"operation-add-pet\"></a>`POST /pet` Add a new pet to the store", res)
+			assertInCode(t, "* **Security:** petstore_auth (write:pets, read:pets)", res)
+			assertInCode(t, "| body | body | [Pet](#model-pet) | no |", res)
+			assertInCode(t, "### <a name=\"model-pet\"></a>Pet", res)
+			assertInCode(t, "Allowed values: \"available\", \"pending\", \"sold\"", res)
+		}
+	}
+}
+
+func TestDocs_HTML(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/swagger-codegen-tests.json")
+	if assert.NoError(t, err) {
+		docs := makeGenDocs(specDoc)
+
+		var buf bytes.Buffer
+		if assert.NoError(t, templates.MustGet("docsHtml").Execute(&buf, &docs)) {
+			res := buf.String()
+			assertInCode(t, "<title>Swagger Petstore</title>", res)
+			assertInCode(t, "<h4 id=\"operation-add-pet\"><span class=\"method\">POST</span> <code>/pet</code>", res)
+			assertInCode(t, "<a href=\"#model-pet\">Pet</a>", res)
+			assertInCode(t, "&lt;a href=&#34;http://swagger.io&#34;&gt;", res)
+		}
+	}
+}
+
+func TestDocs_Params(t *testing.T) {
+	doc := `{
+  "swagger": "2.0",
+  "info": {"title": "params", "version": "1.0"},
+  "paths": {
+    "/users/{id}": {
+      "parameters": [
+        {"name": "id", "in": "path", "required": true, "type": "string", "description": "on the path"},
+        {"name": "user_id", "in": "query", "type": "string"},
+        {"name": "limit", "in": "query", "type": "integer"}
+      ],
+      "get": {
+        "parameters": [
+          {"name": "userId", "in": "query", "type": "string", "description": "on the operation"},
+          {"name": "id", "in": "path", "required": true, "type": "string", "description": "on the operation"}
+        ],
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`
+	specDoc, err := loads.Analyzed(json.RawMessage(doc), "")
+	if assert.NoError(t, err) {
+		docs := makeGenDocs(specDoc)
+		if assert.Len(t, docs.OperationGroups, 1) && assert.Len(t, docs.OperationGroups[0].Operations, 1) {
+			params := docs.OperationGroups[0].Operations[0].Params
+			// the parameters are merged as in the generated code: user_id and userId are the same UserID field
+			if assert.Len(t, params, 3) {
+				assert.Equal(t, "id", params[0].Name)
+				assert.Equal(t, "on the operation", params[0].Description)
+				assert.Equal(t, "limit", params[1].Name)
+				assert.Equal(t, "userId", params[2].Name)
+				assert.Equal(t, "on the operation", params[2].Description)
+			}
+		}
+	}
+}
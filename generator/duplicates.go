@@ -0,0 +1,276 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+// DuplicateSchema is an inline schema of the body parameters or of the responses which is repeated across the spec,
+// or which repeats a definition: each copy gets its own model in the generated code
+type DuplicateSchema struct {
+	// Definition is the definition the schemas are identical to when Existing, or the name of the one to extract them to
+	Definition string
+	Existing   bool
+	// Uses are the locations of the inline schemas, like POST /pets (addPet) body or GET /pets (listPets) 200 items
+	Uses []string
+
+	schemas []*spec.Schema
+}
+
+func (d DuplicateSchema) String() string {
+	if d.Existing {
+		return fmt.Sprintf("the inline schemas of %s are identical to the definition %q: use a $ref to it",
+			strings.Join(d.Uses, ", "), d.Definition)
+	}
+	return fmt.Sprintf("the inline schemas of %s are identical: extract them to a definition, like %q",
+		strings.Join(d.Uses, ", "), d.Definition)
+}
+
+// LintDuplicateSchemas finds the inline object schemas of the body parameters and of the responses which are
+// structurally identical to each other, or to a definition. The descriptions, titles and examples don't count.
+func LintDuplicateSchemas(sw *spec.Swagger) []DuplicateSchema {
+	uses := inlineSchemaUses(sw)
+
+	definitions := make(map[string]string, len(sw.Definitions))
+	var names []string
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schema := sw.Definitions[name]
+		key := schemaKey(&schema)
+		if _, ok := definitions[key]; !ok && isObjectSchema(&schema) {
+			definitions[key] = name
+		}
+	}
+
+	var keys []string
+	grouped := make(map[string][]schemaUse)
+	for _, use := range uses {
+		key := schemaKey(use.schema)
+		if _, ok := grouped[key]; !ok {
+			keys = append(keys, key)
+		}
+		grouped[key] = append(grouped[key], use)
+	}
+
+	taken := make(map[string]bool, len(sw.Definitions))
+	for name := range sw.Definitions {
+		taken[name] = true
+	}
+	var found []DuplicateSchema
+	for _, key := range keys {
+		group := grouped[key]
+		duplicate := DuplicateSchema{Definition: definitions[key], Existing: definitions[key] != ""}
+		if !duplicate.Existing {
+			if len(group) < 2 {
+				continue
+			}
+			duplicate.Definition = uniqueDefinitionName(group[0].name, taken)
+			taken[duplicate.Definition] = true
+		}
+		for _, use := range group {
+			duplicate.Uses = append(duplicate.Uses, use.where)
+			duplicate.schemas = append(duplicate.schemas, use.schema)
+		}
+		found = append(found, duplicate)
+	}
+	return found
+}
+
+// ExtractDuplicateSchemas replaces the duplicate inline schemas found by LintDuplicateSchemas with $refs:
+// to the definition they are identical to, or to a new definition made of the first of them
+func ExtractDuplicateSchemas(sw *spec.Swagger) []DuplicateSchema {
+	found := LintDuplicateSchemas(sw)
+	for _, duplicate := range found {
+		if !duplicate.Existing {
+			if sw.Definitions == nil {
+				sw.Definitions = make(spec.Definitions)
+			}
+			sw.Definitions[duplicate.Definition] = *duplicate.schemas[0]
+		}
+		ref := spec.MustCreateRef(definitionsPointer + duplicate.Definition)
+		for _, schema := range duplicate.schemas {
+			*schema = spec.Schema{SchemaProps: spec.SchemaProps{Ref: ref}}
+		}
+	}
+	return found
+}
+
+// schemaUse is an inline schema, where it is used and the name of a definition for it
+type schemaUse struct {
+	where  string
+	name   string
+	schema *spec.Schema
+}
+
+// inlineSchemaUses are the inline object schemas of the body parameters and of the responses, the spec ones first,
+// sorted by path and method. For an array, it is the schema of its items.
+func inlineSchemaUses(sw *spec.Swagger) []schemaUse {
+	var uses []schemaUse
+	add := func(schema *spec.Schema, where, name string) {
+		for schema != nil && schema.Ref.String() == "" && schema.Items != nil && schema.Items.Schema != nil {
+			schema, where, name = schema.Items.Schema, where+" items", name+" Items"
+		}
+		if schema != nil && schema.Ref.String() == "" && isObjectSchema(schema) {
+			if schema.Title != "" {
+				name = schema.Title
+			}
+			uses = append(uses, schemaUse{where: where, name: swag.ToGoName(name), schema: schema})
+		}
+	}
+
+	var names []string
+	for name := range sw.Parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		add(sw.Parameters[name].Schema, "#/parameters/"+name, name+" Body")
+	}
+	names = names[:0]
+	for name := range sw.Responses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		add(sw.Responses[name].Schema, "#/responses/"+name, name+" Body")
+	}
+
+	if sw.Paths == nil {
+		return uses
+	}
+	var paths []string
+	for pth := range sw.Paths.Paths {
+		paths = append(paths, pth)
+	}
+	sort.Strings(paths)
+	for _, pth := range paths {
+		item := sw.Paths.Paths[pth]
+		for _, param := range item.Parameters {
+			add(param.Schema, pth+" body", swag.ToGoName(pth)+" Body")
+		}
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			op := pathItemOperation(item, method)
+			if op == nil {
+				continue
+			}
+			where := method + " " + pth
+			name := op.ID
+			if op.ID != "" {
+				where += " (" + op.ID + ")"
+			} else {
+				name = strings.ToLower(method) + " " + pth
+			}
+			for _, param := range op.Parameters {
+				add(param.Schema, where+" body", name+" Body")
+			}
+			if op.Responses == nil {
+				continue
+			}
+			var codes []int
+			for code := range op.Responses.StatusCodeResponses {
+				codes = append(codes, code)
+			}
+			sort.Ints(codes)
+			for _, code := range codes {
+				add(op.Responses.StatusCodeResponses[code].Schema, where+" "+strconv.Itoa(code), name+" "+http.StatusText(code)+" Body")
+			}
+			if op.Responses.Default != nil {
+				add(op.Responses.Default.Schema, where+" default", name+" Default Body")
+			}
+		}
+	}
+	return uses
+}
+
+func isObjectSchema(schema *spec.Schema) bool {
+	return len(schema.Properties) > 0 || len(schema.AllOf) > 0
+}
+
+func uniqueDefinitionName(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		if candidate := name + strconv.Itoa(i); !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
+// schemaKey is the json of a schema without its descriptions, titles and examples, with the keys sorted
+func schemaKey(schema *spec.Schema) string {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return ""
+	}
+	b, _ = json.Marshal(stripDocumentation(value))
+	return string(b)
+}
+
+// stripDocumentation removes the documentation of a schema decoded from json, and of the schemas it has
+func stripDocumentation(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		stripped := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if strings.HasPrefix(key, "x-") {
+				stripped[key] = item
+				continue
+			}
+			switch key {
+			case "description", "title", "example", "externalDocs":
+			case "properties", "patternProperties", "definitions":
+				// maps of schemas, by names which may well be "description" or "title"
+				if schemas, ok := item.(map[string]interface{}); ok {
+					named := make(map[string]interface{}, len(schemas))
+					for name, schema := range schemas {
+						named[name] = stripDocumentation(schema)
+					}
+					stripped[key] = named
+				} else {
+					stripped[key] = item
+				}
+			case "default", "enum":
+				stripped[key] = item
+			default:
+				stripped[key] = stripDocumentation(item)
+			}
+		}
+		return stripped
+	case []interface{}:
+		stripped := make([]interface{}, len(v))
+		for i, item := range v {
+			stripped[i] = stripDocumentation(item)
+		}
+		return stripped
+	}
+	return value
+}
@@ -0,0 +1,56 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintDuplicateSchemas(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/duplicates.yml")
+	require.NoError(t, err)
+
+	found := LintDuplicateSchemas(specDoc.Spec())
+	require.Len(t, found, 3)
+	assert.Equal(t, "NotFoundBody", found[0].Definition)
+	assert.False(t, found[0].Existing)
+	assert.Equal(t, []string{"#/responses/notFound", "POST /pets (addPet) default"}, found[0].Uses)
+	assert.Equal(t, `the inline schemas of GET /pets (listPets) 200 items, GET /pets/{id} (getPet) 200 are identical: extract them to a definition, like "ListPetsOKBodyItems"`, found[1].String())
+	// the descriptions don't count, even of a property named description
+	assert.Equal(t, `the inline schemas of POST /pets (addPet) body are identical to the definition "NewPet": use a $ref to it`, found[2].String())
+}
+
+func TestExtractDuplicateSchemas(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/duplicates.yml")
+	require.NoError(t, err)
+	sw := specDoc.Spec()
+
+	ExtractDuplicateSchemas(sw)
+	assert.Empty(t, LintDuplicateSchemas(sw))
+	assert.Len(t, sw.Definitions, 3)
+	assert.Len(t, sw.Definitions["NotFoundBody"].Properties, 2)
+
+	pets := sw.Paths.Paths["/pets"]
+	assert.Equal(t, "#/definitions/ListPetsOKBodyItems", pets.Get.Responses.StatusCodeResponses[200].Schema.Items.Schema.Ref.String())
+	assert.Equal(t, "#/definitions/NewPet", pets.Post.Parameters[0].Schema.Ref.String())
+	assert.Equal(t, "#/definitions/NotFoundBody", pets.Post.Responses.Default.Schema.Ref.String())
+	assert.Equal(t, "#/definitions/NotFoundBody", sw.Responses["notFound"].Schema.Ref.String())
+	// the schema without a duplicate stays inline
+	assert.Empty(t, sw.Paths.Paths["/pets/{id}"].Put.Parameters[0].Schema.Ref.String())
+}
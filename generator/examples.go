@@ -0,0 +1,476 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// maxExampleDepth is the depth past which the examples only have the required properties of the objects, and no $ref is followed
+const maxExampleDepth = 4
+
+// ExamplesResult tells what AddExamples did
+type ExamplesResult struct {
+	// Added are the locations of the examples added, like #/definitions/Pet or GET /pets 200
+	Added []string
+	// Skipped are the locations of the examples which couldn't be made valid against their schema, with the reason
+	Skipped []string
+}
+
+// AddExamples fills the example of the definitions, and the examples of the responses with a schema, which have none.
+//
+// The values are made from the schemas: an example, default or enum value is used first, then a value of the format,
+// or one fitting the name of the property, within the bounds of the schema. An example which doesn't validate against
+// its schema, like a string with a pattern, is skipped.
+func AddExamples(sw *spec.Swagger) ExamplesResult {
+	maker := &exampleMaker{sw: sw}
+	var result ExamplesResult
+
+	var names []string
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schema := sw.Definitions[name]
+		if schema.Example != nil {
+			continue
+		}
+		location := definitionsPointer + name
+		value, err := maker.example(&schema, name)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", location, err))
+			continue
+		}
+		schema.Example = value
+		sw.Definitions[name] = schema
+		result.Added = append(result.Added, location)
+	}
+
+	names = names[:0]
+	for name := range sw.Responses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		response := sw.Responses[name]
+		maker.response(&response, "#/responses/"+name, sw.Produces, &result)
+		sw.Responses[name] = response
+	}
+
+	if sw.Paths == nil {
+		return result
+	}
+	var paths []string
+	for pth := range sw.Paths.Paths {
+		paths = append(paths, pth)
+	}
+	sort.Strings(paths)
+	for _, pth := range paths {
+		item := sw.Paths.Paths[pth]
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"} {
+			op := pathItemOperation(item, method)
+			if op == nil || op.Responses == nil {
+				continue
+			}
+			produces := op.Produces
+			if len(produces) == 0 {
+				produces = sw.Produces
+			}
+			if op.Responses.Default != nil {
+				maker.response(op.Responses.Default, fmt.Sprintf("%s %s default", method, pth), produces, &result)
+			}
+			var codes []int
+			for code := range op.Responses.StatusCodeResponses {
+				codes = append(codes, code)
+			}
+			sort.Ints(codes)
+			for _, code := range codes {
+				response := op.Responses.StatusCodeResponses[code]
+				maker.response(&response, fmt.Sprintf("%s %s %d", method, pth, code), produces, &result)
+				op.Responses.StatusCodeResponses[code] = response
+			}
+		}
+	}
+	return result
+}
+
+func pathItemOperation(item spec.PathItem, method string) *spec.Operation {
+	switch method {
+	case "GET":
+		return item.Get
+	case "PUT":
+		return item.Put
+	case "POST":
+		return item.Post
+	case "DELETE":
+		return item.Delete
+	case "OPTIONS":
+		return item.Options
+	case "HEAD":
+		return item.Head
+	case "PATCH":
+		return item.Patch
+	}
+	return nil
+}
+
+// exampleMaker makes the examples of the schemas of a spec
+type exampleMaker struct {
+	sw *spec.Swagger
+}
+
+// response fills the examples of a response with a schema, for the json media type it produces
+func (e *exampleMaker) response(response *spec.Response, location string, produces []string, result *ExamplesResult) {
+	if response.Ref.String() != "" || response.Schema == nil || len(response.Examples) > 0 {
+		return
+	}
+	value, err := e.example(response.Schema, "")
+	if err != nil {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", location, err))
+		return
+	}
+	mediaType := "application/json"
+	for _, produced := range produces {
+		if isJSONMediaType(produced) {
+			mediaType = produced
+			break
+		}
+	}
+	response.Examples = map[string]interface{}{mediaType: value}
+	result.Added = append(result.Added, location)
+}
+
+// example makes the value of a schema as it is decoded from json, and checks it validates against the schema
+func (e *exampleMaker) example(schema *spec.Schema, kind string) (interface{}, error) {
+	value := e.value(schema, "", kind, 0)
+	if value == nil {
+		return nil, fmt.Errorf("no example can be made for the schema")
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil, err
+	}
+	// the validator expands the $refs of the schema it is given, in place
+	b, err = json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var expanded spec.Schema
+	if err := json.Unmarshal(b, &expanded); err != nil {
+		return nil, err
+	}
+	res := validate.NewSchemaValidator(&expanded, e.sw, "", strfmt.Default).Validate(decoded)
+	if res != nil && !res.IsValid() {
+		return nil, fmt.Errorf("the example made for the schema doesn't validate: %v", res.Errors[0])
+	}
+	return decoded, nil
+}
+
+// value is a value of a schema, the field and the kind are the names of the property and of the definition it belongs to
+func (e *exampleMaker) value(schema *spec.Schema, field, kind string, depth int) interface{} {
+	if schema.Ref.String() != "" {
+		if depth >= maxExampleDepth {
+			return nil
+		}
+		target, err := spec.ResolveRef(e.sw, &schema.Ref)
+		if err != nil {
+			return nil
+		}
+		// the kind of a value is the definition of the object it is in
+		if name := strings.TrimPrefix(schema.Ref.String(), definitionsPointer); name != schema.Ref.String() && (len(target.Properties) > 0 || len(target.AllOf) > 0) {
+			kind = name
+		}
+		return e.value(target, field, kind, depth+1)
+	}
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if schema.Default != nil {
+		return schema.Default
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+	if len(schema.AllOf) > 0 {
+		merged := make(map[string]interface{})
+		for i := range schema.AllOf {
+			member := &schema.AllOf[i]
+			// the members keep the kind of the composed definition, for its discriminator
+			if member.Ref.String() != "" {
+				target, err := spec.ResolveRef(e.sw, &member.Ref)
+				if err != nil || depth >= maxExampleDepth {
+					continue
+				}
+				member = target
+			}
+			if object, ok := e.value(member, field, kind, depth+1).(map[string]interface{}); ok {
+				for key, value := range object {
+					merged[key] = value
+				}
+			}
+		}
+		return merged
+	}
+
+	tpe := ""
+	if len(schema.Type) > 0 {
+		tpe = schema.Type[0]
+	}
+	switch {
+	case tpe == "array":
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return []interface{}{}
+		}
+		count := 1
+		if schema.MinItems != nil && *schema.MinItems > 1 {
+			count = int(*schema.MinItems)
+		}
+		items := make([]interface{}, 0, count)
+		for i := 0; i < count; i++ {
+			item := e.value(schema.Items.Schema, field, kind, depth+1)
+			if item == nil {
+				return []interface{}{}
+			}
+			items = append(items, item)
+		}
+		return items
+	case tpe == "object" || tpe == "" && len(schema.Properties) > 0:
+		object := make(map[string]interface{})
+		required := make(map[string]bool, len(schema.Required))
+		for _, name := range schema.Required {
+			required[name] = true
+		}
+		var names []string
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if depth >= maxExampleDepth && !required[name] {
+				continue
+			}
+			property := schema.Properties[name]
+			if value := e.value(&property, name, kind, depth+1); value != nil {
+				object[name] = value
+			}
+		}
+		if schema.Discriminator != "" && kind != "" {
+			object[schema.Discriminator] = kind
+		}
+		return object
+	case tpe == "integer":
+		return int64(boundedNumber(schema, float64(integerExample(field)), 1))
+	case tpe == "number":
+		return boundedNumber(schema, numberExample(field), 0.5)
+	case tpe == "boolean":
+		return true
+	case tpe == "string" && schema.Format == "binary":
+		// the content of a file isn't documented by an example
+		return nil
+	case tpe == "string":
+		return boundedString(schema, stringExample(schema.Format, field, kind))
+	}
+	return nil
+}
+
+// the values of the string formats
+var formatExamples = map[string]string{
+	"date":         "2019-06-30",
+	"date-time":    "2019-06-30T12:00:00Z",
+	"uuid":         "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+	"uuid3":        "a3bb189e-8bf9-3888-9912-ace4e6543002",
+	"uuid4":        "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+	"uuid5":        "74738ff5-5367-5958-9aee-98fffdcd1876",
+	"email":        "jane.doe@example.com",
+	"uri":          "https://example.com",
+	"hostname":     "example.com",
+	"ipv4":         "192.0.2.1",
+	"ipv6":         "2001:db8::1",
+	"mac":          "00:1a:2b:3c:4d:5e",
+	"byte":         "ZXhhbXBsZQ==",
+	"password":     "s3cr3t!",
+	"duration":     "1h30m",
+	"isbn":         "978-3-16-148410-0",
+	"isbn10":       "3-16-148410-X",
+	"isbn13":       "978-3-16-148410-0",
+	"creditcard":   "4111111111111111",
+	"ssn":          "123-45-6789",
+	"hexcolor":     "#1e90ff",
+	"rgbcolor":     "rgb(30,144,255)",
+	"bsonobjectid": "507f1f77bcf86cd799439011",
+}
+
+// the values of the strings by the name of their property: the first suffix it ends with wins
+var nameExamples = []struct {
+	suffix string
+	value  string
+}{
+	{"emailaddress", "jane.doe@example.com"},
+	{"email", "jane.doe@example.com"},
+	{"ipaddress", "192.0.2.1"},
+	{"firstname", "Jane"},
+	{"givenname", "Jane"},
+	{"lastname", "Doe"},
+	{"surname", "Doe"},
+	{"familyname", "Doe"},
+	{"username", "jdoe"},
+	{"login", "jdoe"},
+	{"fullname", "Jane Doe"},
+	{"displayname", "Jane Doe"},
+	{"author", "Jane Doe"},
+	{"phone", "+1-202-555-0143"},
+	{"mobile", "+1-202-555-0143"},
+	{"city", "Springfield"},
+	{"countrycode", "US"},
+	{"country", "United States"},
+	{"street", "742 Evergreen Terrace"},
+	{"address", "742 Evergreen Terrace"},
+	{"zipcode", "94105"},
+	{"zip", "94105"},
+	{"postalcode", "94105"},
+	{"company", "Acme Corp"},
+	{"organization", "Acme Corp"},
+	{"imageurl", "https://example.com/image.png"},
+	{"photourl", "https://example.com/image.png"},
+	{"avatar", "https://example.com/image.png"},
+	{"url", "https://example.com"},
+	{"website", "https://example.com"},
+	{"href", "https://example.com"},
+	{"link", "https://example.com"},
+	{"currency", "USD"},
+	{"locale", "en-US"},
+	{"language", "en"},
+	{"timezone", "America/New_York"},
+	{"color", "blue"},
+	{"colour", "blue"},
+	{"description", "Lorem ipsum dolor sit amet."},
+	{"summary", "Lorem ipsum dolor sit amet."},
+	{"comment", "Lorem ipsum dolor sit amet."},
+	{"message", "Lorem ipsum dolor sit amet."},
+	{"title", "Lorem ipsum"},
+	{"password", "s3cr3t!"},
+	{"token", "eyJhbGciOiJIUzI1NiJ9"},
+}
+
+// the kinds of definitions describing a person, which names are the ones of a person
+var personKinds = []string{"user", "person", "owner", "customer", "employee", "author", "contact", "member", "account"}
+
+func stringExample(format, field, kind string) string {
+	if value, ok := formatExamples[format]; ok {
+		return value
+	}
+	if field == "id" || strings.HasSuffix(field, "Id") || strings.HasSuffix(field, "ID") || strings.HasSuffix(field, "_id") {
+		return "d290f1ee-6c54-4b01-90e6-d701748f0851"
+	}
+	name := normalizedFieldName(field)
+	for _, example := range nameExamples {
+		if strings.HasSuffix(name, example.suffix) {
+			return example.value
+		}
+	}
+	if name == "name" {
+		lowerKind := strings.ToLower(kind)
+		for _, person := range personKinds {
+			if strings.HasSuffix(lowerKind, person) {
+				return "Jane Doe"
+			}
+		}
+		if kind != "" {
+			return "Example " + swag.ToHumanNameLower(kind)
+		}
+	}
+	if field != "" {
+		return swag.ToHumanNameLower(field)
+	}
+	return "example"
+}
+
+func integerExample(field string) int {
+	name := normalizedFieldName(field)
+	switch {
+	case name == "age":
+		return 30
+	case strings.HasSuffix(name, "year"):
+		return 2019
+	case strings.HasSuffix(name, "port"):
+		return 8080
+	case strings.HasSuffix(name, "count"), strings.HasSuffix(name, "total"), strings.HasSuffix(name, "size"),
+		strings.HasSuffix(name, "quantity"), strings.HasSuffix(name, "limit"):
+		return 10
+	}
+	return 1
+}
+
+func numberExample(field string) float64 {
+	name := normalizedFieldName(field)
+	switch {
+	case strings.HasSuffix(name, "price"), strings.HasSuffix(name, "amount"), strings.HasSuffix(name, "cost"), strings.HasSuffix(name, "total"):
+		return 9.99
+	case name == "lat", strings.HasSuffix(name, "latitude"):
+		return 37.7749
+	case name == "lng", name == "lon", strings.HasSuffix(name, "longitude"):
+		return -122.4194
+	case strings.HasSuffix(name, "rate"), strings.HasSuffix(name, "ratio"), strings.HasSuffix(name, "percentage"):
+		return 0.5
+	}
+	return 1.5
+}
+
+func normalizedFieldName(field string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "", " ", "").Replace(field))
+}
+
+// boundedNumber moves a value within the bounds of its schema, step is the distance kept from an exclusive bound
+func boundedNumber(schema *spec.Schema, value, step float64) float64 {
+	if schema.Minimum != nil && (value < *schema.Minimum || value == *schema.Minimum && schema.ExclusiveMinimum) {
+		value = *schema.Minimum
+		if schema.ExclusiveMinimum {
+			value += step
+		}
+	}
+	if schema.Maximum != nil && (value > *schema.Maximum || value == *schema.Maximum && schema.ExclusiveMaximum) {
+		value = *schema.Maximum
+		if schema.ExclusiveMaximum {
+			value -= step
+		}
+	}
+	return value
+}
+
+// boundedString pads or cuts a value to the lengths of its schema
+func boundedString(schema *spec.Schema, value string) string {
+	if schema.MinLength != nil {
+		for int64(len(value)) < *schema.MinLength {
+			value += "x"
+		}
+	}
+	if schema.MaxLength != nil && int64(len(value)) > *schema.MaxLength {
+		value = value[:*schema.MaxLength]
+	}
+	return value
+}
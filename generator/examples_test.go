@@ -0,0 +1,77 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddExamples(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/examples.yml")
+	require.NoError(t, err)
+	sw := specDoc.Spec()
+
+	result := AddExamples(sw)
+	assert.Equal(t, []string{
+		"#/definitions/Dog",
+		"#/definitions/Error",
+		"#/definitions/Owner",
+		"#/definitions/Pet",
+		"#/responses/NotFound",
+		"GET /owners/{id} 200",
+		"GET /pets 200",
+	}, result.Added)
+	require.Len(t, result.Skipped, 1)
+	assert.Contains(t, result.Skipped[0], "#/definitions/Code: ")
+
+	example := func(value interface{}) string {
+		b, err := json.Marshal(value)
+		require.NoError(t, err)
+		return string(b)
+	}
+	assert.JSONEq(t, `{
+		"name": "Exampl",
+		"petType": "Pet",
+		"birthday": "2019-06-30",
+		"price": 5,
+		"status": "available"
+	}`, example(sw.Definitions["Pet"].Example))
+	// the kind of a composed definition is its own
+	assert.Equal(t, "Dog", sw.Definitions["Dog"].Example.(map[string]interface{})["petType"])
+
+	owner := sw.Definitions["Owner"].Example.(map[string]interface{})
+	assert.Equal(t, "Jane Doe", owner["name"])
+	assert.Equal(t, "Jane", owner["firstName"])
+	assert.Equal(t, "jane.doe@example.com", owner["email"])
+	assert.Equal(t, "https://example.com", owner["homepage"])
+	assert.EqualValues(t, 41, owner["age"])
+	assert.Len(t, owner["pets"], 2)
+	assert.Equal(t, "kept", sw.Definitions["Tag"].Example)
+
+	assert.JSONEq(t, `{"code": 400, "message": "Lorem ipsum dolor sit amet."}`, example(sw.Responses["NotFound"].Examples["application/vnd.pets+json"]))
+	responses := sw.Paths.Paths["/pets"].Get.Responses
+	// the examples are for the json media type the operation produces
+	assert.Contains(t, responses.StatusCodeResponses[200].Examples, "application/vnd.pets+json")
+	assert.Empty(t, responses.StatusCodeResponses[404].Examples)
+	assert.Equal(t, "kept", responses.Default.Examples["application/json"].(map[string]interface{})["message"])
+	assert.Equal(t, "#/definitions/Pet", responses.StatusCodeResponses[200].Schema.Items.Schema.Ref.String())
+	assert.Contains(t, sw.Paths.Paths["/owners/{id}"].Get.Responses.StatusCodeResponses[200].Examples, "application/json")
+	assert.Empty(t, sw.Paths.Paths["/owners/{id}"].Get.Responses.StatusCodeResponses[204].Examples)
+}
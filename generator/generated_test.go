@@ -0,0 +1,1382 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runGeneratedTests writes a test file in a package of generated code and runs its tests, with the extra args of go test.
+// The code is generated in the repository, the vendored packages of which it imports.
+func runGeneratedTests(t *testing.T, pkg, name, source string, args ...string) {
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pkg, name), []byte(source), 0644))
+	// the go tool finds the import path of the package from its directory in the GOPATH, which may be a link
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	dir := filepath.Join(wd, pkg)
+	cmd := exec.Command("go", append(append([]string{"test", "-count=1"}, args...), ".")...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO111MODULE=off", "PWD="+dir)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "the tests of the generated code failed:\n%s", out)
+}
+
+// clientGenOpts are the options of the generate client command
+func clientGenOpts(target, spec string) *GenOpts {
+	opts := &GenOpts{
+		Spec:              spec,
+		Target:            target,
+		APIPackage:        "operations",
+		ModelPackage:      "models",
+		ServerPackage:     "restapi",
+		ClientPackage:     "client",
+		DefaultScheme:     "http",
+		IncludeModel:      true,
+		IncludeValidator:  true,
+		IncludeHandler:    true,
+		IncludeParameters: true,
+		IncludeResponses:  true,
+		IncludeSupport:    true,
+	}
+	_ = opts.EnsureDefaults(true)
+	return opts
+}
+
+const clientEndpointTests = `package pets
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingTransport struct {
+	calls int
+	next  http.RoundTripper
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.calls++
+	return c.next.RoundTrip(r)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithEndpoint_RuntimeTransport(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(` + "`" + `{"name": "rex"}` + "`" + `))
+	}))
+	defer server.Close()
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	// the jar gives the cookies of the host of the runtime, the endpoint is rewritten by the round tripper
+	jar.SetCookies(&url.URL{Scheme: "http", Host: "unused.example.com"}, []*http.Cookie{{Name: "session", Value: "abc"}})
+	counting := &countingTransport{next: http.DefaultTransport}
+	rt := httptransport.New("unused.example.com", "/api", []string{"http"})
+	rt.Transport = counting
+	rt.Jar = jar
+
+	// without an http client on the params, the call goes through the round tripper and the jar of the runtime
+	_, err = New(rt, strfmt.Default).GetPet(NewGetPetParams().WithPetID(1), WithEndpoint("", target.Host, "/v2"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, counting.calls)
+	assert.Equal(t, "/v2/pets/1", received.URL.Path)
+	cookie, err := received.Cookie("session")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", cookie.Value)
+
+	_, err = New(rt, strfmt.Default).GetPet(NewGetPetParams().WithPetID(1), WithEndpoint("", target.Host, ""),
+		WithInterceptors(func(next http.RoundTripper) http.RoundTripper { return next }))
+	require.NoError(t, err)
+	assert.Equal(t, 2, counting.calls)
+	assert.Equal(t, "/api/pets/1", received.URL.Path)
+}
+
+func TestEndpointTransport_Rewrite(t *testing.T) {
+	var sent *http.Request
+	e := &endpointTransport{
+		next: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			sent = r
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+		}),
+		host:     "new.example.com",
+		basePath: "/v2 beta",
+		segments: pathSegments("/pets/{petId}"),
+	}
+
+	cases := map[string]string{
+		// an escaped slash of a path parameter isn't a separator
+		"http://old.example.com/api/pets/a%2Fb": "/v2%20beta/pets/a%2Fb",
+		"http://old.example.com/pets/1":         "/v2%20beta/pets/1",
+		"http://old.example.com/a/b/pets/1/":    "/v2%20beta/pets/1/",
+	}
+	for given, expected := range cases {
+		u, err := url.Parse(given)
+		require.NoError(t, err)
+		req := &http.Request{Method: "GET", URL: u, Header: http.Header{}}
+		_, err = e.RoundTrip(req)
+		require.NoError(t, err)
+		assert.Equal(t, expected, sent.URL.EscapedPath(), given)
+		assert.Equal(t, "new.example.com", sent.URL.Host)
+		assert.Equal(t, "new.example.com", sent.Host)
+		// the given request is left as it was
+		assert.Equal(t, given, req.URL.String())
+	}
+}
+`
+
+const facadeEndpointTests = `package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"%s/client/pets"
+)
+
+func TestInterceptors_WithEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(` + "`" + `{"name": "rex"}` + "`" + `))
+	}))
+	defer server.Close()
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	var intercepted int
+	cfg := DefaultTransportConfig().WithHost("unused.example.com").WithSchemes([]string{"http"}).
+		WithInterceptors(func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				intercepted++
+				return next.RoundTrip(r)
+			})
+		})
+	_, err = NewHTTPClientWithConfig(nil, cfg).Pets.GetPet(pets.NewGetPetParams().WithPetID(1), pets.WithEndpoint("", target.Host, ""))
+	require.NoError(t, err)
+	// the interceptors of the client apply once to a call sent to another endpoint
+	assert.Equal(t, 1, intercepted)
+}
+`
+
+func TestClient_WithEndpoint(t *testing.T) {
+	target, err := ioutil.TempDir(".", "client-endpoint")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := clientGenOpts(target, "../fixtures/codegen/trim.yml")
+	require.NoError(t, GenerateClient("trim", nil, nil, opts))
+	runGeneratedTests(t, filepath.Join(target, "client", "pets"), "endpoint_test.go", clientEndpointTests)
+	runGeneratedTests(t, filepath.Join(target, "client"), "endpoint_test.go", fmt.Sprintf(facadeEndpointTests, opts.baseImport(target)))
+}
+
+const cliSpec = `swagger: "2.0"
+info:
+  title: pets
+  description: the pets of the store
+  version: 1.0.0
+host: localhost
+basePath: /api
+consumes:
+  - application/json
+produces:
+  - application/json
+paths:
+  /pets:
+    get:
+      tags: [pets]
+      operationId: listPets
+      summary: lists the pets
+      parameters:
+        - name: limit
+          in: query
+          type: integer
+          format: int32
+          description: the number of pets to list
+        - name: tags
+          in: query
+          type: array
+          items:
+            type: string
+      responses:
+        200:
+          description: the pets
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Pet"
+    post:
+      tags: [pets]
+      operationId: addPet
+      summary: adds a pet
+      parameters:
+        - name: pet
+          in: body
+          required: true
+          schema:
+            $ref: "#/definitions/Pet"
+      responses:
+        201:
+          description: the pet was added
+          schema:
+            $ref: "#/definitions/Pet"
+  /pets/{petId}:
+    get:
+      tags: [pets]
+      operationId: getPet
+      summary: gets a pet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          type: integer
+          format: int64
+      responses:
+        200:
+          description: the pet
+          schema:
+            $ref: "#/definitions/Pet"
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+`
+
+const cliTests = `package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCLI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pets-cli")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bin := filepath.Join(dir, "pets-cli")
+	out, err := exec.Command("go", "build", "-o", bin, ".").CombinedOutput()
+	require.NoError(t, err, "%s", out)
+
+	out, err = exec.Command(bin, "--help").CombinedOutput()
+	require.NoError(t, err, "%s", out)
+	assert.Contains(t, string(out), "pets")
+	assert.Contains(t, string(out), "--host=")
+	out, err = exec.Command(bin, "pets", "--help").CombinedOutput()
+	require.NoError(t, err, "%s", out)
+	for _, command := range []string{"add-pet", "get-pet", "list-pets"} {
+		assert.Contains(t, string(out), command)
+	}
+	out, err = exec.Command(bin, "pets", "list-pets", "--help").CombinedOutput()
+	require.NoError(t, err, "%s", out)
+	assert.Contains(t, string(out), "--limit=")
+	assert.Contains(t, string(out), "the number of pets to list")
+	assert.Contains(t, string(out), "--tags=")
+
+	var received []*http.Request
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = append(received, r)
+		bodies = append(bodies, string(b))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write(b)
+		default:
+			if strings.HasSuffix(r.URL.Path, "/pets") {
+				w.Write([]byte(` + "`" + `[{"name": "rex"}]` + "`" + `))
+				return
+			}
+			w.Write([]byte(` + "`" + `{"name": "rex"}` + "`" + `))
+		}
+	}))
+	defer server.Close()
+	host, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	out, err = exec.Command(bin, "--host", host.Host, "pets", "get-pet", "--pet-id", "7").Output()
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"name\": \"rex\"\n}\n", string(out))
+	assert.Equal(t, "/api/pets/7", received[0].URL.Path)
+
+	out, err = exec.Command(bin, "--host", host.Host, "pets", "list-pets", "--limit", "2", "--tags", "a", "--tags", "b").Output()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "\"rex\"")
+	assert.Equal(t, "2", received[1].URL.Query().Get("limit"))
+	assert.Equal(t, "a,b", received[1].URL.Query().Get("tags"))
+
+	// the body is read from stdin
+	cmd := exec.Command(bin, "--host", host.Host, "pets", "add-pet", "--pet", "-")
+	cmd.Stdin = strings.NewReader(` + "`" + `{"name": "felix"}` + "`" + `)
+	out, err = cmd.Output()
+	require.NoError(t, err)
+	assert.JSONEq(t, ` + "`" + `{"name": "felix"}` + "`" + `, bodies[2])
+	assert.Contains(t, string(out), "\"felix\"")
+
+	// a flag which isn't a parameter of the operation fails the command
+	out, err = exec.Command(bin, "--host", host.Host, "pets", "get-pet", "--pet-id", "7", "--limit", "2").CombinedOutput()
+	assert.Error(t, err)
+	assert.Contains(t, string(out), "unknown flag")
+	assert.Len(t, received, 3)
+}
+`
+
+func TestClient_CLI(t *testing.T) {
+	target, err := ioutil.TempDir(".", "client-cli")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	spec := filepath.Join(target, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(cliSpec), 0644))
+
+	opts := clientGenOpts(target, spec)
+	opts.IncludeCLI = true
+	require.NoError(t, GenerateClient("pets", nil, nil, opts))
+	runGeneratedTests(t, filepath.Join(target, "cmd", "pets-cli"), "cli_test.go", cliTests)
+}
+
+// serverGenOpts are the options of the generate server command
+func serverGenOpts(target, spec string) *GenOpts {
+	opts := testGenOpts()
+	opts.Spec = spec
+	opts.Target = target
+	return &opts
+}
+
+const jwtTests = `package operations
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func segment(t *testing.T, value interface{}) string {
+	b, err := json.Marshal(value)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// sign makes a token of claims, signed with the algorithm and the key
+func sign(t *testing.T, alg, kid string, key interface{}, claims map[string]interface{}) string {
+	header := map[string]string{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	signed := segment(t, header) + "." + segment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	var signature []byte
+	switch alg {
+	case "none":
+	case "HS256":
+		mac := hmac.New(sha256.New, key.([]byte))
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case "RS256":
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key.(*rsa.PrivateKey), crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, key.(*ecdsa.PrivateKey), digest[:])
+		require.NoError(t, err)
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func claims(overrides map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"sub": "alice",
+		"iss": "https://auth.example.com/",
+		"aud": "todo-list",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"nbf": time.Now().Add(-time.Minute).Unix(),
+	}
+	for name, value := range overrides {
+		result[name] = value
+	}
+	return result
+}
+
+func encode(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func TestJWTValidator_Validate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	secret := []byte("a secret of at least 32 bytes, for HS256")
+
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+		}})
+	}))
+	defer jwks.Close()
+
+	withKeySet := NewJWTValidator(JWTConfig{JWKSURL: jwks.URL, Issuer: "https://auth.example.com/", Audience: "todo-list"})
+	withSecret := NewJWTValidator(JWTConfig{Key: secret, Issuer: "https://auth.example.com/", Audience: "todo-list"})
+	withPublicKey := NewJWTValidator(JWTConfig{Key: &rsaKey.PublicKey, Issuer: "https://auth.example.com/", Audience: "todo-list"})
+
+	for name, token := range map[string]string{
+		"RS256": sign(t, "RS256", "rsa", rsaKey, claims(nil)),
+		"ES256": sign(t, "ES256", "ec", ecKey, claims(nil)),
+	} {
+		validated, err := withKeySet.Validate(token)
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, "alice", validated.Subject(), name)
+		}
+	}
+	validated, err := withSecret.Validate(sign(t, "HS256", "", secret, claims(nil)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "alice", validated.Subject())
+	}
+	_, err = withPublicKey.Validate(sign(t, "RS256", "", rsaKey, claims(nil)))
+	assert.NoError(t, err)
+
+	public, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public})
+
+	for name, check := range map[string]struct {
+		validator *JWTValidator
+		token     string
+	}{
+		"alg none":                        {withKeySet, sign(t, "none", "rsa", nil, claims(nil))},
+		"alg none with a secret":          {withSecret, sign(t, "none", "", nil, claims(nil))},
+		"HS256 signed with the RSA key":   {withPublicKey, sign(t, "HS256", "", publicPEM, claims(nil))},
+		"HS256 signed with the RSA n":     {withKeySet, sign(t, "HS256", "rsa", rsaKey.N.Bytes(), claims(nil))},
+		"expired":                         {withKeySet, sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()}))},
+		"not valid yet":                   {withKeySet, sign(t, "ES256", "ec", ecKey, claims(map[string]interface{}{"nbf": time.Now().Add(time.Hour).Unix()}))},
+		"wrong audience":                  {withSecret, sign(t, "HS256", "", secret, claims(map[string]interface{}{"aud": "another-api"}))},
+		"wrong issuer":                    {withSecret, sign(t, "HS256", "", secret, claims(map[string]interface{}{"iss": "https://evil.example.com/"}))},
+		"wrong secret":                    {withSecret, sign(t, "HS256", "", []byte("another secret"), claims(nil))},
+		"signed by the EC key as the RSA": {withKeySet, sign(t, "ES256", "rsa", ecKey, claims(nil))},
+	} {
+		_, err := check.validator.Validate(check.token)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestJWTValidator_SingleFetch(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	var fetches int32
+	release := make(chan struct{})
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+		}})
+	}))
+	defer jwks.Close()
+
+	validator := NewJWTValidator(JWTConfig{JWKSURL: jwks.URL})
+	token := sign(t, "ES256", "ec", ecKey, claims(nil))
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := validator.Validate(token)
+			errs <- err
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt32(&fetches))
+}
+
+func TestJWTValidator_FetchTimeout(t *testing.T) {
+	validator := NewJWTValidator(JWTConfig{JWKSURL: "http://example.com"})
+	assert.Equal(t, jwksTimeout, validator.client.Timeout)
+}
+`
+
+func TestServer_JWTValidate(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-jwt")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := serverGenOpts(target, "../fixtures/codegen/todolist.jwt.yml")
+	require.NoError(t, GenerateServer("jwt", nil, nil, opts))
+	runGeneratedTests(t, filepath.Join(target, "restapi", "operations"), "jwt_test.go", jwtTests)
+}
+
+const timeoutTests = `package operations
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateReport_Timeout(t *testing.T) {
+	doc, err := loads.Spec("../../swagger.yml")
+	require.NoError(t, err)
+	api := NewTimeoutsAPI(doc)
+	release := make(chan struct{})
+	defer close(release)
+	deadline := make(chan bool, 1)
+	api.GenerateReportHandler = GenerateReportHandlerFunc(func(ctx context.Context, params GenerateReportParams) middleware.Responder {
+		_, ok := ctx.Deadline()
+		_, bound := params.HTTPRequest.Context().Deadline()
+		deadline <- ok && bound
+		<-release
+		return NewGenerateReportOK()
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/reports", strings.NewReader("{}"))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	api.Serve(nil).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	assert.Contains(t, rec.Body.String(), "operation generateReport timed out after 200ms")
+	// the handler generated with the context gets the deadline of x-timeout
+	select {
+	case ok := <-deadline:
+		assert.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("the handler wasn't called")
+	}
+}
+`
+
+const timeoutParamsTests = `package operations
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateReport_TimeoutParams(t *testing.T) {
+	doc, err := loads.Spec("../../swagger.yml")
+	require.NoError(t, err)
+	api := NewTimeoutsAPI(doc)
+	stopped := make(chan bool, 1)
+	api.GenerateReportHandler = GenerateReportHandlerFunc(func(params GenerateReportParams) middleware.Responder {
+		// without the context argument, the request of the params carries the deadline of x-timeout
+		ctx := params.HTTPRequest.Context()
+		_, ok := ctx.Deadline()
+		select {
+		case <-ctx.Done():
+			stopped <- ok
+		case <-time.After(5 * time.Second):
+			stopped <- false
+		}
+		return NewGenerateReportOK()
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/reports", strings.NewReader("{}"))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	api.Serve(nil).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	// the handler watching the deadline stops with the 504
+	select {
+	case ok := <-stopped:
+		assert.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("the handler didn't stop at the deadline")
+	}
+}
+`
+
+const timeoutSpec = `swagger: "2.0"
+info:
+  title: timeouts
+  version: 1.0.0
+consumes:
+  - application/json
+produces:
+  - application/json
+paths:
+  /reports:
+    post:
+      operationId: generateReport
+      x-timeout: 200ms
+      responses:
+        200:
+          description: the report was generated
+`
+
+func TestServer_Timeout(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-timeout")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	spec := filepath.Join(target, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(timeoutSpec), 0644))
+
+	opts := serverGenOpts(target, spec)
+	opts.WithContext = true
+	require.NoError(t, GenerateServer("timeouts", nil, nil, opts))
+	runGeneratedTests(t, filepath.Join(target, "restapi", "operations"), "timeout_test.go", timeoutTests)
+}
+
+func TestServer_TimeoutParams(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-timeout-params")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	spec := filepath.Join(target, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(timeoutSpec), 0644))
+
+	require.NoError(t, GenerateServer("timeouts", nil, nil, serverGenOpts(target, spec)))
+	runGeneratedTests(t, filepath.Join(target, "restapi", "operations"), "timeout_params_test.go", timeoutParamsTests)
+}
+
+const routerTests = `package operations
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRouterAPI(t *testing.T) (*RouterAPI, *string) {
+	doc, err := loads.Spec("../../swagger.yml")
+	require.NoError(t, err)
+	api := NewRouterAPI(doc)
+	served := new(string)
+	api.GetPetHandler = GetPetHandlerFunc(func(params GetPetParams) middleware.Responder {
+		*served = "pet " + params.ID + " at " + params.HTTPRequest.URL.Path
+		return NewGetPetOK()
+	})
+	api.GetMyPetsHandler = GetMyPetsHandlerFunc(func(params GetMyPetsParams) middleware.Responder {
+		*served = "mine at " + params.HTTPRequest.URL.Path
+		return NewGetMyPetsOK()
+	})
+	api.ListOwnersHandler = ListOwnersHandlerFunc(func(params ListOwnersParams) middleware.Responder {
+		*served = "owners at " + params.HTTPRequest.URL.Path
+		return NewListOwnersOK()
+	})
+	return api, served
+}
+
+func serve(api *RouterAPI, method, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	api.Serve(nil).ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+func TestRouter_Defaults(t *testing.T) {
+	api, served := newRouterAPI(t)
+	// the trailing slash is ignored, the case is not
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/pets/rex/").Code)
+	assert.Equal(t, "pet rex at /api/pets/rex/", *served)
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/Owners").Code)
+	assert.Equal(t, http.StatusNotFound, serve(api, http.MethodGet, "/api/PETS/rex").Code)
+	// the encoded slashes of a parameter are decoded
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/pets/rex%2Fjr").Code)
+	assert.Equal(t, "pet rex/jr at /api/pets/rex/jr", *served)
+}
+
+func TestRouter_StrictSlash(t *testing.T) {
+	api, served := newRouterAPI(t)
+	api.StrictSlash = true
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/pets/rex").Code)
+	assert.Equal(t, http.StatusNotFound, serve(api, http.MethodGet, "/api/pets/rex/").Code)
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/Owners/").Code)
+	assert.Equal(t, "owners at /api/Owners/", *served)
+	assert.Equal(t, http.StatusNotFound, serve(api, http.MethodGet, "/api/Owners").Code)
+}
+
+func TestRouter_RedirectSlash(t *testing.T) {
+	api, _ := newRouterAPI(t)
+	api.RedirectSlash = true
+	rec := serve(api, http.MethodGet, "/api/pets/rex/?legs=4")
+	assert.Equal(t, http.StatusPermanentRedirect, rec.Code)
+	assert.Equal(t, "/api/pets/rex?legs=4", rec.Header().Get("Location"))
+	rec = serve(api, http.MethodGet, "/api/Owners")
+	assert.Equal(t, http.StatusPermanentRedirect, rec.Code)
+	assert.Equal(t, "/api/Owners/", rec.Header().Get("Location"))
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/pets/mine").Code)
+}
+
+func TestRouter_CaseInsensitivePaths(t *testing.T) {
+	api, served := newRouterAPI(t)
+	api.CaseInsensitivePaths = true
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/PETS/Rex").Code)
+	assert.Equal(t, "pet Rex at /api/pets/Rex", *served)
+	// the literal segments win over the parameters
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/Pets/MINE").Code)
+	assert.Equal(t, "mine at /api/pets/mine", *served)
+	assert.Equal(t, http.StatusOK, serve(api, http.MethodGet, "/api/owners/").Code)
+	assert.Equal(t, "owners at /api/Owners/", *served)
+	assert.Equal(t, http.StatusNotFound, serve(api, http.MethodGet, "/api/cats/rex").Code)
+
+	api.RedirectSlash = true
+	rec := serve(api, http.MethodGet, "/api/OWNERS")
+	assert.Equal(t, http.StatusPermanentRedirect, rec.Code)
+	assert.Equal(t, "/api/Owners/", rec.Header().Get("Location"))
+}
+`
+
+const routerSpec = `swagger: "2.0"
+info:
+  title: router
+  version: 1.0.0
+basePath: /api
+produces:
+  - application/json
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          type: string
+      responses:
+        200:
+          description: the pet
+  /pets/mine:
+    get:
+      operationId: getMyPets
+      responses:
+        200:
+          description: the pets of the user
+  /Owners/:
+    get:
+      operationId: listOwners
+      responses:
+        200:
+          description: the owners
+`
+
+func TestServer_Router(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-router")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	spec := filepath.Join(target, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(routerSpec), 0644))
+
+	require.NoError(t, GenerateServer("router", nil, nil, serverGenOpts(target, spec)))
+	runGeneratedTests(t, filepath.Join(target, "restapi", "operations"), "router_test.go", routerTests)
+}
+
+const mountTests = `package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pets "TARGET/pets/restapi"
+	petsoperations "TARGET/pets/restapi/operations"
+	store "TARGET/store/restapi"
+	storeoperations "TARGET/store/restapi/operations"
+)
+
+func TestMount(t *testing.T) {
+	assert.Equal(t, "", DefaultVersion)
+	assert.Equal(t, []string{"pets", "store"}, Versions)
+
+	petsSpec, err := loads.Analyzed(pets.SwaggerJSON, "")
+	require.NoError(t, err)
+	petsAPI := petsoperations.NewPetsAPI(petsSpec)
+	petsAPI.ListPetsHandler = petsoperations.ListPetsHandlerFunc(func(petsoperations.ListPetsParams) middleware.Responder {
+		return petsoperations.NewListPetsOK()
+	})
+	storeSpec, err := loads.Analyzed(store.SwaggerJSON, "")
+	require.NoError(t, err)
+	storeAPI := storeoperations.NewStoreAPI(storeSpec)
+	storeAPI.ListOrdersHandler = storeoperations.ListOrdersHandlerFunc(func(storeoperations.ListOrdersParams) middleware.Responder {
+		return storeoperations.NewListOrdersNoContent()
+	})
+
+	handler := SetupVersionsMiddleware(NewVersionHandler(DefaultVersionSelector, DefaultVersion,
+		Version{Name: "pets", BasePath: petsSpec.BasePath(), Handler: petsAPI.Serve(nil)},
+		Version{Name: "store", BasePath: storeSpec.BasePath(), Handler: storeAPI.Serve(nil)},
+	))
+	for target, status := range map[string]int{
+		"/pets-api/pets": http.StatusOK,
+		"/store/orders":  http.StatusNoContent,
+		// each api only serves its own paths
+		"/store/pets": http.StatusNotFound,
+		// a request outside of the base paths selects no api
+		"/pets": http.StatusNotFound,
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, status, rec.Code, target)
+	}
+}
+`
+
+const petsMountSpec = `swagger: "2.0"
+info:
+  title: pets
+  version: 1.0.0
+basePath: /pets-api
+produces:
+  - application/json
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        200:
+          description: the pets
+        default:
+          description: an error
+          schema:
+            $ref: "#/definitions/Error"
+definitions:
+  Error:
+    type: object
+    properties:
+      message:
+        type: string
+`
+
+const storeMountSpec = `swagger: "2.0"
+info:
+  title: store
+  version: 2.0.0
+basePath: /store
+produces:
+  - application/json
+paths:
+  /orders:
+    get:
+      operationId: listOrders
+      responses:
+        204:
+          description: no orders
+        default:
+          description: an error
+          schema:
+            $ref: "#/definitions/Error"
+definitions:
+  Error:
+    type: object
+    properties:
+      message:
+        type: string
+`
+
+func TestServer_Mount(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-mount")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	petsSpec := filepath.Join(target, "pets.yml")
+	require.NoError(t, ioutil.WriteFile(petsSpec, []byte(petsMountSpec), 0644))
+	storeSpec := filepath.Join(target, "store.yml")
+	require.NoError(t, ioutil.WriteFile(storeSpec, []byte(storeMountSpec), 0644))
+
+	opts := serverGenOpts(target, "")
+	opts.ExcludeSpec = false
+	opts.IncludeMain = true
+	// the generated main builds
+	opts.CompileCheck = true
+	mounts := VersionOpts{Mount: true, Versions: []APIVersion{{Name: "pets", Spec: petsSpec}, {Name: "store", Spec: storeSpec}}}
+	require.NoError(t, GenerateVersionedServer("", mounts, opts))
+	tests := strings.Replace(mountTests, "TARGET", opts.baseImport(target), -1)
+	runGeneratedTests(t, filepath.Join(target, "restapi"), "mount_test.go", tests)
+}
+
+const forwardedTests = `package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardedHeaders(t *testing.T) {
+	trusted, err := parseCIDRs([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+	var received *http.Request
+	handler := forwardedHeaders(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		received = r
+	}), trusted)
+
+	for name, check := range map[string]struct {
+		peer      string
+		forwarded string
+		client    string
+	}{
+		// an untrusted peer can't spoof its address
+		"spoofed by an untrusted peer": {"203.0.113.5:4000", "1.2.3.4", "203.0.113.5:4000"},
+		"from a trusted proxy":         {"10.0.0.1:4000", "198.51.100.7", "198.51.100.7:0"},
+		// the addresses before the first untrusted one, from the right, are the client's own claims
+		"through several proxies": {"10.0.0.1:4000", "1.2.3.4, 198.51.100.7, 192.168.1.1, 10.0.0.2", "198.51.100.7:0"},
+		"through trusted proxies":  {"10.0.0.1:4000", "10.1.1.1, 10.0.0.2", "10.1.1.1:0"},
+		"with an invalid address":  {"10.0.0.1:4000", "1.2.3.4, unknown, 10.0.0.2", "10.0.0.2:0"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "http://api.example.com/pets", nil)
+		req.RemoteAddr = check.peer
+		req.Header.Set("X-Forwarded-For", check.forwarded)
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "public.example.com")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, check.client, received.RemoteAddr, name)
+		if check.peer == check.client {
+			assert.Empty(t, received.Header.Get("X-Forwarded-For"), name)
+			assert.Empty(t, received.Header.Get("X-Forwarded-Proto"), name)
+			assert.Empty(t, received.Header.Get("X-Forwarded-Host"), name)
+			assert.Equal(t, "api.example.com", received.Host, name)
+			continue
+		}
+		assert.Equal(t, "https", received.URL.Scheme, name)
+		assert.Equal(t, "public.example.com", received.Host, name)
+	}
+}
+`
+
+func TestServer_ForwardedHeaders(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-forwarded")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := serverGenOpts(target, "../fixtures/codegen/trim.yml")
+	require.NoError(t, GenerateServer("trim", nil, nil, opts))
+	runGeneratedTests(t, filepath.Join(target, "restapi"), "forwarded_test.go", forwardedTests)
+}
+
+const benchmarkTests = `package operations
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"TARGET/models"
+)
+
+// allocsBudget is the number of allocations the server may make to serve a pet, from routing to the JSON of the response.
+// The CI fails when a change goes over it: raise it deliberately, with the benchmarks which show why.
+const allocsBudget = 31
+
+// discard is a response writer which doesn't count in the allocations of the server
+type discard struct {
+	header http.Header
+	code   int
+}
+
+func (d *discard) Header() http.Header         { return d.header }
+func (d *discard) Write(b []byte) (int, error) { return len(b), nil }
+func (d *discard) WriteHeader(code int)        { d.code = code }
+
+func newBenchmarkAPI(t testing.TB, pets int) http.Handler {
+	doc, err := loads.Spec("../../swagger.yml")
+	require.NoError(t, err)
+	api := NewBenchmarksAPI(doc)
+	found := make([]*models.Pet, pets)
+	for i := range found {
+		name := "pet " + strconv.Itoa(i)
+		found[i] = &models.Pet{ID: int64(i), Name: &name, Tags: []string{"small", "brown"}}
+	}
+	api.PingHandler = PingHandlerFunc(func(params PingParams) middleware.Responder {
+		return NewPingNoContent()
+	})
+	api.GetPetHandler = GetPetHandlerFunc(func(params GetPetParams) middleware.Responder {
+		return NewGetPetOK().WithPayload(found[0])
+	})
+	api.FindPetsHandler = FindPetsHandlerFunc(func(params FindPetsParams) middleware.Responder {
+		return NewFindPetsOK().WithPayload(found)
+	})
+	api.AddPetHandler = AddPetHandlerFunc(func(params AddPetParams) middleware.Responder {
+		return NewAddPetCreated()
+	})
+	return api.Serve(nil)
+}
+
+func benchmarkServe(b *testing.B, handler http.Handler, newRequest func() *http.Request, code int) {
+	rw := &discard{header: make(http.Header)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rw.code = 0
+		handler.ServeHTTP(rw, newRequest())
+		if rw.code != code {
+			b.Fatalf("expected a %d, got a %d", code, rw.code)
+		}
+	}
+}
+
+// BenchmarkServe_Routing routes a request to an operation without parameters nor response body
+func BenchmarkServe_Routing(b *testing.B) {
+	benchmarkServe(b, newBenchmarkAPI(b, 1), func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/api/ping", nil)
+	}, http.StatusNoContent)
+}
+
+// BenchmarkServe_BindQuery binds and validates the path and query parameters of a request
+func BenchmarkServe_BindQuery(b *testing.B) {
+	benchmarkServe(b, newBenchmarkAPI(b, 0), func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/api/owners/7/pets?limit=20&tags=small,brown&name=rex", nil)
+	}, http.StatusOK)
+}
+
+// BenchmarkServe_BindBody consumes and validates the JSON body of a request
+func BenchmarkServe_BindBody(b *testing.B) {
+	benchmarkServe(b, newBenchmarkAPI(b, 1), func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/pets", strings.NewReader(` + "`" + `{"id": 7, "name": "rex", "tags": ["small", "brown"]}` + "`" + `))
+		r.Header.Set("Content-Type", "application/json")
+		return r
+	}, http.StatusCreated)
+}
+
+// BenchmarkServe_ProduceJSON produces a pet, then a list of a hundred pets
+func BenchmarkServe_ProduceJSON(b *testing.B) {
+	b.Run("pet", func(b *testing.B) {
+		benchmarkServe(b, newBenchmarkAPI(b, 1), func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/api/pets/7", nil)
+		}, http.StatusOK)
+	})
+	b.Run("pets", func(b *testing.B) {
+		benchmarkServe(b, newBenchmarkAPI(b, 100), func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/api/owners/7/pets", nil)
+		}, http.StatusOK)
+	})
+}
+
+// BenchmarkJSONProducer compares the pooled JSON producer of the server to the one of the runtime
+func BenchmarkJSONProducer(b *testing.B) {
+	pets := make([]*models.Pet, 100)
+	for i := range pets {
+		name := "pet " + strconv.Itoa(i)
+		pets[i] = &models.Pet{ID: int64(i), Name: &name, Tags: []string{"small", "brown"}}
+	}
+	for _, producer := range []struct {
+		name     string
+		producer runtime.Producer
+	}{
+		{"pooled", PooledJSONProducer()},
+		{"runtime", runtime.JSONProducer()},
+	} {
+		for _, payload := range []struct {
+			name string
+			data interface{}
+		}{
+			{"pet", pets[0]},
+			{"pets", pets},
+		} {
+			b.Run(producer.name+"/"+payload.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if err := producer.producer.Produce(ioutil.Discard, payload.data); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// failing fails to marshal, after the JSON of what comes before it
+type failing struct{}
+
+func (failing) MarshalJSON() ([]byte, error) { return nil, errors.New("can't marshal") }
+
+func TestPooledJSONProducer(t *testing.T) {
+	name := "rex"
+	pets := []*models.Pet{{ID: 7, Name: &name, Tags: []string{"small", "<brown>"}}}
+	for _, data := range []interface{}{pets, pets[0], "<b>", nil, strings.Repeat("x", 100<<10)} {
+		var expected, actual bytes.Buffer
+		require.NoError(t, runtime.JSONProducer().Produce(&expected, data))
+		require.NoError(t, PooledJSONProducer().Produce(&actual, data))
+		assert.Equal(t, expected.String(), actual.String())
+	}
+
+	// an error of the encoding writes nothing, and leaves nothing in the buffers
+	var out bytes.Buffer
+	assert.Error(t, PooledJSONProducer().Produce(&out, []interface{}{pets[0], failing{}}))
+	assert.Empty(t, out.String())
+	require.NoError(t, PooledJSONProducer().Produce(&out, pets[0]))
+	assert.JSONEq(t, ` + "`" + `{"id": 7, "name": "rex", "tags": ["small", "<brown>"]}` + "`" + `, out.String())
+}
+
+func TestServe_AllocsBudget(t *testing.T) {
+	handler := newBenchmarkAPI(t, 1)
+	rw := &discard{header: make(http.Header)}
+	// the request is made outside of the measure, only the server counts
+	requests := make(chan *http.Request, 101)
+	for i := 0; i < cap(requests); i++ {
+		requests <- httptest.NewRequest(http.MethodGet, "/api/pets/7", nil)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		handler.ServeHTTP(rw, <-requests)
+	})
+	require.Equal(t, http.StatusOK, rw.code)
+	assert.True(t, allocs <= allocsBudget, "serving a pet takes %v allocations, over the budget of %d", allocs, allocsBudget)
+}
+`
+
+const benchmarkSpec = `swagger: "2.0"
+info:
+  title: benchmarks
+  version: 1.0.0
+basePath: /api
+consumes:
+  - application/json
+produces:
+  - application/json
+paths:
+  /ping:
+    get:
+      operationId: ping
+      responses:
+        204:
+          description: the server is up
+  /pets:
+    post:
+      operationId: addPet
+      parameters:
+        - name: pet
+          in: body
+          required: true
+          schema:
+            $ref: "#/definitions/Pet"
+      responses:
+        201:
+          description: the pet was added
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          type: integer
+          format: int64
+      responses:
+        200:
+          description: the pet
+          schema:
+            $ref: "#/definitions/Pet"
+  /owners/{ownerId}/pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: ownerId
+          in: path
+          required: true
+          type: integer
+          format: int64
+        - name: limit
+          in: query
+          type: integer
+          format: int32
+          minimum: 1
+          maximum: 100
+        - name: tags
+          in: query
+          type: array
+          items:
+            type: string
+        - name: name
+          in: query
+          type: string
+          maxLength: 64
+      responses:
+        200:
+          description: the pets of the owner
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Pet"
+definitions:
+  Pet:
+    type: object
+    required:
+      - name
+    properties:
+      id:
+        type: integer
+        format: int64
+      name:
+        type: string
+        minLength: 1
+      tags:
+        type: array
+        items:
+          type: string
+`
+
+// TestServer_Benchmarks runs the benchmarks of a generated server once, and checks its allocations against their budget.
+// The benchmarks are run for real from the generated package: go test -run NONE -bench . -benchmem
+func TestServer_Benchmarks(t *testing.T) {
+	target, err := ioutil.TempDir(".", "server-benchmarks")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	spec := filepath.Join(target, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(benchmarkSpec), 0644))
+
+	opts := serverGenOpts(target, spec)
+	require.NoError(t, GenerateServer("benchmarks", nil, nil, opts))
+	tests := strings.Replace(benchmarkTests, "TARGET", opts.baseImport(target), -1)
+	runGeneratedTests(t, filepath.Join(target, "restapi", "operations"), "benchmark_test.go", tests, "-bench", ".", "-benchtime", "1x")
+}
+
+const oneOfTests = `package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPet_UnmarshalJSON(t *testing.T) {
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(` + "`" + `{"meows": true}` + "`" + `), &pet))
+	assert.IsType(t, &Cat{}, pet.OneOf)
+	require.NoError(t, json.Unmarshal([]byte(` + "`" + `{"barks": true}` + "`" + `), &pet))
+	assert.IsType(t, &Dog{}, pet.OneOf)
+
+	// valid against both a cat and a dog, the data is ambiguous
+	err := json.Unmarshal([]byte(` + "`" + `{"meows": true, "barks": true}` + "`" + `), &pet)
+	require.Error(t, err)
+	assert.EqualValues(t, 422, err.(errors.Error).Code())
+	assert.Contains(t, err.Error(), "it is valid against 2 of them")
+	assert.Nil(t, pet.OneOf)
+
+	err = json.Unmarshal([]byte(` + "`" + `{"purrs": true}` + "`" + `), &pet)
+	require.Error(t, err)
+	assert.EqualValues(t, 422, err.(errors.Error).Code())
+
+	var owner Owner
+	err = json.Unmarshal([]byte(` + "`" + `{"pet": {"meows": true, "barks": true}}` + "`" + `), &owner)
+	require.Error(t, err)
+	assert.EqualValues(t, 422, err.(errors.Error).Code())
+}
+`
+
+func TestModel_OneOfAmbiguous(t *testing.T) {
+	target, err := ioutil.TempDir(".", "model-one-of")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := serverGenOpts(target, "../fixtures/codegen/x-one-of.yml")
+	require.NoError(t, GenerateDefinition([]string{"Pet", "Cat", "Dog", "Owner"}, opts))
+	runGeneratedTests(t, filepath.Join(target, "models"), "one_of_test.go", oneOfTests)
+}
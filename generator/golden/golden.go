@@ -0,0 +1,368 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package golden compares the code generated for specs with a golden copy of it, so the changes of the templates
+// are reviewed as diffs of the golden files, and the regressions fail the tests.
+//
+// A case is a spec next to a directory of golden files for each kind of generation it checks:
+//
+//	petstore/
+//	  swagger.yml
+//	  server/models/pet.go.golden
+//	  client/client/pets/pets_client.go.golden
+//
+// The golden files are written, or rewritten after a change, by running the tests with SWAGGER_GOLDEN_UPDATE=1.
+package golden
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sidewalklabs/go-swagger/generator"
+)
+
+const (
+	// Server generates a whole server: models, operations and supporting files
+	Server = "server"
+	// Client generates a whole client
+	Client = "client"
+	// Models generates the models only
+	Models = "models"
+
+	// UpdateEnv is the environment variable which makes the tests write the golden files instead of checking them
+	UpdateEnv = "SWAGGER_GOLDEN_UPDATE"
+
+	// Suffix keeps the go tool away from the golden copies of go files
+	Suffix = ".golden"
+)
+
+// Kinds are the kinds of generation a case can check
+var Kinds = []string{Server, Client, Models}
+
+// Case is a spec whose generated code is compared with its golden copy
+type Case struct {
+	// Name of the case, and of the generated application
+	Name string
+	// Spec is the path of the spec
+	Spec string
+	// Dir holds a directory of golden files for each kind of generation
+	Dir string
+	// Kinds of generation to check: the directories found in Dir by default, or a server
+	Kinds []string
+	// ImportPath the code is generated at, golden/<name> by default: it shows in the imports of the golden files
+	ImportPath string
+	// Configure adjusts the options of a generation, when set
+	Configure func(kind string, opts *generator.GenOpts)
+}
+
+// Corpus loads the cases of a directory: each of its directories with a swagger.{yml,yaml,json} spec is a case
+func Corpus(dir string) ([]Case, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var cases []Case
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		caseDir := filepath.Join(dir, entry.Name())
+		for _, name := range []string{"swagger.yml", "swagger.yaml", "swagger.json"} {
+			spec := filepath.Join(caseDir, name)
+			if _, err := os.Stat(spec); err == nil {
+				cases = append(cases, Case{Name: entry.Name(), Spec: spec, Dir: caseDir})
+				break
+			}
+		}
+	}
+	return cases, nil
+}
+
+// Run generates the code of each case, and compares it with the golden files, or writes them when updating
+func Run(t *testing.T, cases ...Case) {
+	update := os.Getenv(UpdateEnv) != ""
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			for _, kind := range c.kinds() {
+				kind := kind
+				t.Run(kind, func(t *testing.T) {
+					generated, err := c.Generate(kind)
+					if err != nil {
+						t.Fatalf("generating the %s of %s: %v", kind, c.Spec, err)
+					}
+					dir := filepath.Join(c.Dir, kind)
+					if update {
+						if err := Write(dir, generated); err != nil {
+							t.Fatal(err)
+						}
+						return
+					}
+					golden, err := Read(dir)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if len(golden) == 0 {
+						t.Fatalf("%s has no golden files, write them with %s=1", dir, UpdateEnv)
+					}
+					for _, problem := range Compare(golden, generated) {
+						t.Error(problem)
+					}
+				})
+			}
+		})
+	}
+}
+
+func (c Case) kinds() []string {
+	if len(c.Kinds) > 0 {
+		return c.Kinds
+	}
+	var kinds []string
+	for _, kind := range Kinds {
+		if info, err := os.Stat(filepath.Join(c.Dir, kind)); err == nil && info.IsDir() {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(kinds) == 0 {
+		return []string{Server}
+	}
+	return kinds
+}
+
+// Generate generates a kind of code for the case in a temporary GOPATH, and returns the files by their slash separated path
+func (c Case) Generate(kind string) (map[string]string, error) {
+	spec, err := filepath.Abs(c.Spec)
+	if err != nil {
+		return nil, err
+	}
+	importPath := c.ImportPath
+	if importPath == "" {
+		importPath = path.Join("golden", c.Name)
+	}
+
+	gopath, err := ioutil.TempDir("", "swagger-golden")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(gopath)
+	target := filepath.Join(gopath, "src", filepath.FromSlash(importPath))
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return nil, err
+	}
+
+	// the spec is copied next to the target, which is the working directory of the generation:
+	// the paths the generated code mentions don't depend on where the corpus is
+	specDir := filepath.Join(gopath, "spec")
+	if err := c.copySpec(filepath.Dir(spec), specDir); err != nil {
+		return nil, err
+	}
+	spec = filepath.Join(specDir, filepath.Base(spec))
+
+	opts := &generator.GenOpts{
+		Spec:              spec,
+		Target:            target,
+		Name:              c.Name,
+		APIPackage:        "operations",
+		ModelPackage:      "models",
+		ServerPackage:     "restapi",
+		ClientPackage:     "client",
+		DefaultScheme:     "http",
+		FlagStrategy:      "go-flags",
+		CompatibilityMode: "modern",
+		IncludeModel:      true,
+		IncludeValidator:  true,
+		IncludeHandler:    kind != Models,
+		IncludeParameters: kind != Models,
+		IncludeResponses:  kind != Models,
+		IncludeURLBuilder: kind != Models,
+		IncludeSupport:    kind != Models,
+		IncludeMain:       kind == Server,
+		// the embedded spec is only a copy of the spec
+		ExcludeSpec: true,
+		FlattenSpec: true,
+	}
+	if c.Configure != nil {
+		c.Configure(kind, opts)
+	}
+	if opts.TemplateDir != "" {
+		// relative to the working directory of the test
+		if opts.TemplateDir, err = filepath.Abs(opts.TemplateDir); err != nil {
+			return nil, err
+		}
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chdir(target); err != nil {
+		return nil, err
+	}
+	defer os.Chdir(cwd)
+
+	// the import paths of the generated code are found from the GOPATH
+	previous, isSet := os.LookupEnv("GOPATH")
+	os.Setenv("GOPATH", gopath)
+	defer func() {
+		if isSet {
+			os.Setenv("GOPATH", previous)
+		} else {
+			os.Unsetenv("GOPATH")
+		}
+	}()
+
+	switch kind {
+	case Server, Models:
+		err = generator.GenerateServer(c.Name, nil, nil, opts)
+	case Client:
+		err = generator.GenerateClient(c.Name, nil, nil, opts)
+	default:
+		err = fmt.Errorf("unknown kind of generation %q, expected one of %s", kind, strings.Join(Kinds, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readTree(target, "")
+}
+
+// copySpec copies the directory of the spec, for the documents it refers to, without the golden files
+func (c Case) copySpec(from, to string) error {
+	golden, err := filepath.Abs(c.Dir)
+	if err != nil {
+		return err
+	}
+	return filepath.Walk(from, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		for _, kind := range Kinds {
+			if info.IsDir() && pth == filepath.Join(golden, kind) {
+				return filepath.SkipDir
+			}
+		}
+		rel, err := filepath.Rel(from, pth)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(to, rel)
+		if info.IsDir() {
+			return os.MkdirAll(dest, 0755)
+		}
+		if strings.HasSuffix(pth, Suffix) {
+			return nil
+		}
+		b, err := ioutil.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(dest, b, 0644)
+	})
+}
+
+// Read reads the golden files of a directory, by the slash separated path of the file they are a copy of
+func Read(dir string) (map[string]string, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+	return readTree(dir, Suffix)
+}
+
+// Write replaces the golden files of a directory with the generated ones
+func Write(dir string, generated map[string]string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	for name, content := range generated {
+		pth := filepath.Join(dir, filepath.FromSlash(name)+Suffix)
+		if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(pth, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Compare tells how the generated files differ from the golden ones, with a unified diff for each changed file
+func Compare(golden, generated map[string]string) []string {
+	var problems []string
+	for _, name := range sortedNames(golden, generated) {
+		want, inGolden := golden[name]
+		got, isGenerated := generated[name]
+		switch {
+		case !isGenerated:
+			problems = append(problems, fmt.Sprintf("%s isn't generated anymore", name))
+		case !inGolden:
+			problems = append(problems, fmt.Sprintf("%s is generated, but has no golden copy", name))
+		case want != got:
+			diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(want),
+				B:        difflib.SplitLines(got),
+				FromFile: name + Suffix,
+				ToFile:   name,
+				Context:  3,
+			})
+			problems = append(problems, fmt.Sprintf("%s differs from its golden copy:\n%s", name, diff))
+		}
+	}
+	return problems
+}
+
+// readTree reads the files of a directory, the manifest of the generation left aside
+func readTree(dir, suffix string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(pth string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		if info.Name() == generator.ManifestFile || !strings.HasSuffix(info.Name(), suffix) {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, pth)
+		if err != nil {
+			return err
+		}
+		b, err := ioutil.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		files[strings.TrimSuffix(filepath.ToSlash(rel), suffix)] = string(b)
+		return nil
+	})
+	return files, err
+}
+
+func sortedNames(maps ...map[string]string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, m := range maps {
+		for name := range m {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,34 @@
+package golden
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorpus(t *testing.T) {
+	cases, err := Corpus("../../fixtures/golden")
+	require.NoError(t, err)
+	require.NotEmpty(t, cases)
+	Run(t, cases...)
+}
+
+func TestCompare(t *testing.T) {
+	golden := map[string]string{
+		"models/pet.go":   "package models\n\ntype Pet struct{}\n",
+		"models/store.go": "package models\n",
+	}
+	generated := map[string]string{
+		"models/pet.go":   "package models\n\ntype Pet struct {\n\tName string\n}\n",
+		"models/owner.go": "package models\n",
+	}
+
+	problems := Compare(golden, generated)
+	require.Len(t, problems, 3)
+	assert.Equal(t, "models/owner.go is generated, but has no golden copy", problems[0])
+	assert.Contains(t, problems[1], "models/pet.go differs from its golden copy:\n--- models/pet.go.golden\n+++ models/pet.go\n")
+	assert.Contains(t, problems[1], "-type Pet struct{}\n+type Pet struct {\n+\tName string\n+}\n")
+	assert.Equal(t, "models/store.go isn't generated anymore", problems[2])
+	assert.Empty(t, Compare(golden, golden))
+}
@@ -0,0 +1,230 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+const (
+	jsonSchemaDraft4   = "http://json-schema.org/draft-04/schema#"
+	definitionsPointer = "#/definitions/"
+)
+
+// keywords of swagger schemas that json schema doesn't know about
+var swaggerOnlyKeywords = map[string]struct{}{
+	"discriminator": struct{}{},
+	"readOnly":      struct{}{},
+	"xml":           struct{}{},
+	"externalDocs":  struct{}{},
+	"example":       struct{}{},
+}
+
+// GenerateJSONSchema writes a standalone draft 4 json schema for each of the named definitions,
+// or for all the definitions of the spec when no name is given
+func GenerateJSONSchema(names []string, opts *GenOpts) error {
+	if opts == nil {
+		return errors.New("gen opts are required")
+	}
+	if err := opts.EnsureDefaults(false); err != nil {
+		return err
+	}
+
+	var err error
+	var specDoc *loads.Document
+	opts.Spec, specDoc, err = loadSpec(opts.Spec)
+	if err != nil {
+		return err
+	}
+
+	specDoc, err = validateAndFlattenSpec(opts, specDoc)
+	if err != nil {
+		return err
+	}
+
+	schemas, err := makeJSONSchemas(specDoc.Spec(), names)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.Target, 0755); err != nil {
+		return err
+	}
+	for name, schema := range schemas {
+		b, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return err
+		}
+		fileName := filepath.Join(opts.Target, name+".json")
+		if err := ioutil.WriteFile(fileName, append(b, '\n'), 0644); err != nil {
+			return err
+		}
+		log.Printf("wrote the json schema of %s to %s", name, fileName)
+	}
+	return nil
+}
+
+// makeJSONSchemas builds the json schemas of the named definitions, by name.
+//
+// Each schema is standalone: the definitions it refers to are copied in its own definitions,
+// so its $refs keep pointing at #/definitions/{name}, and $refs to the definition itself point at #.
+func makeJSONSchemas(sw *spec.Swagger, names []string) (map[string]map[string]interface{}, error) {
+	if len(names) == 0 {
+		for name := range sw.Definitions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	result := make(map[string]map[string]interface{}, len(names))
+	for _, name := range names {
+		if _, ok := sw.Definitions[name]; !ok {
+			return nil, fmt.Errorf("no definition named %q in the spec", name)
+		}
+
+		var root map[string]interface{}
+		definitions := make(map[string]interface{})
+		pending := []string{name}
+		seen := map[string]bool{name: true}
+		for len(pending) > 0 {
+			current := pending[0]
+			pending = pending[1:]
+
+			schema, err := jsonSchemaValue(sw.Definitions[current])
+			if err != nil {
+				return nil, err
+			}
+			var refErr error
+			cleanJSONSchema(schema, func(ref string) string {
+				if !strings.HasPrefix(ref, definitionsPointer) {
+					refErr = fmt.Errorf("definition %s refers to %q, only refs to definitions can be converted: flatten the spec first", current, ref)
+					return ref
+				}
+				target := strings.TrimPrefix(ref, definitionsPointer)
+				if _, ok := sw.Definitions[target]; !ok {
+					refErr = fmt.Errorf("definition %s refers to %q, which doesn't exist", current, ref)
+					return ref
+				}
+				if target == name {
+					return "#"
+				}
+				if !seen[target] {
+					seen[target] = true
+					pending = append(pending, target)
+				}
+				return ref
+			})
+			if refErr != nil {
+				return nil, refErr
+			}
+
+			if current == name {
+				root = schema
+				continue
+			}
+			definitions[current] = schema
+		}
+
+		if _, ok := root["title"]; !ok {
+			root["title"] = name
+		}
+		root["$schema"] = jsonSchemaDraft4
+		if len(definitions) > 0 {
+			root["definitions"] = definitions
+		}
+		result[name] = root
+	}
+	return result, nil
+}
+
+func jsonSchemaValue(schema spec.Schema) (map[string]interface{}, error) {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// cleanJSONSchema turns a swagger schema into a json schema, in place: the keywords only swagger knows about are
+// dropped, x-nullable becomes a null type, x-one-of becomes a oneOf and the $refs are rewritten with rewriteRef.
+func cleanJSONSchema(schema map[string]interface{}, rewriteRef func(string) string) {
+	if nullable, ok := schema[xNullable].(bool); ok && nullable {
+		if tpe, ok := schema["type"].(string); ok {
+			schema["type"] = []interface{}{tpe, "null"}
+		}
+	}
+	if nullable, ok := schema[xIsNullable].(bool); ok && nullable {
+		if tpe, ok := schema["type"].(string); ok {
+			schema["type"] = []interface{}{tpe, "null"}
+		}
+	}
+	if oneOf, ok := schema[xOneOf].([]interface{}); ok {
+		schema["oneOf"] = oneOf
+	}
+	for k := range schema {
+		if _, ok := swaggerOnlyKeywords[k]; ok || strings.HasPrefix(strings.ToLower(k), "x-") {
+			delete(schema, k)
+		}
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		schema["$ref"] = rewriteRef(ref)
+	}
+
+	for _, k := range []string{"properties", "patternProperties", "definitions"} {
+		if children, ok := schema[k].(map[string]interface{}); ok {
+			for _, child := range children {
+				if sch, ok := child.(map[string]interface{}); ok {
+					cleanJSONSchema(sch, rewriteRef)
+				}
+			}
+		}
+	}
+	for _, k := range []string{"additionalProperties", "additionalItems", "items", "not"} {
+		switch child := schema[k].(type) {
+		case map[string]interface{}:
+			cleanJSONSchema(child, rewriteRef)
+		case []interface{}:
+			for _, item := range child {
+				if sch, ok := item.(map[string]interface{}); ok {
+					cleanJSONSchema(sch, rewriteRef)
+				}
+			}
+		}
+	}
+	for _, k := range []string{"allOf", "anyOf", "oneOf"} {
+		if children, ok := schema[k].([]interface{}); ok {
+			for _, child := range children {
+				if sch, ok := child.(map[string]interface{}); ok {
+					cleanJSONSchema(sch, rewriteRef)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchema_Standalone(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/json-schema.yml")
+	require.NoError(t, err)
+
+	schemas, err := makeJSONSchemas(specDoc.Spec(), []string{"Order", "Pet"})
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	b, err := json.Marshal(schemas["Order"])
+	require.NoError(t, err)
+	order := string(b)
+	assert.Contains(t, order, `"$schema":"http://json-schema.org/draft-04/schema#"`)
+	assert.Contains(t, order, `"title":"Order"`)
+	assert.Contains(t, order, `"pet":{"$ref":"#/definitions/Pet"}`)
+	assert.Contains(t, order, `"note":{"type":["string","null"]}`)
+	assert.NotContains(t, order, `readOnly`)
+	assert.NotContains(t, order, `Unused`)
+	definitions := schemas["Order"]["definitions"].(map[string]interface{})
+	assert.Len(t, definitions, 4)
+	for _, name := range []string{"Pet", "Tag", "Food", "Kibble"} {
+		assert.Contains(t, definitions, name)
+	}
+
+	b, err = json.Marshal(schemas["Pet"])
+	require.NoError(t, err)
+	pet := string(b)
+	assert.Contains(t, pet, `"parent":{"$ref":"#"}`)
+	assert.Contains(t, pet, `"example":{"description":"a property named like a swagger keyword","type":"string"}`)
+	assert.Contains(t, pet, `"Food":{"oneOf":[{"$ref":"#/definitions/Kibble"},{"$ref":"#/definitions/Tag"}]}`)
+	assert.NotContains(t, pet, `doggie`)
+	assert.NotContains(t, pet, `x-one-of`)
+
+	_, err = makeJSONSchemas(specDoc.Spec(), []string{"Order", "Missing"})
+	assert.Error(t, err)
+}
+
+func TestJSONSchema_Generate(t *testing.T) {
+	target, err := ioutil.TempDir("", "json-schema")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := &GenOpts{
+		Spec:   "../fixtures/codegen/json-schema.yml",
+		Target: target,
+	}
+	require.NoError(t, GenerateJSONSchema(nil, opts))
+
+	files, err := filepath.Glob(filepath.Join(target, "*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 6)
+
+	b, err := ioutil.ReadFile(filepath.Join(target, "Unused.json"))
+	require.NoError(t, err)
+	var unused map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &unused))
+	assert.Equal(t, "boolean", unused["type"])
+}
@@ -0,0 +1,220 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+const (
+	// ManifestFile is the name of the file which lists the files generated in a target
+	ManifestFile = ".swagger-gen.json"
+
+	serverGeneration = "server"
+	clientGeneration = "client"
+)
+
+// genManifest records, by kind of generation, what was generated in a target
+type genManifest map[string]*genRecord
+
+// genRecord is what a kind of generation produced
+type genRecord struct {
+	Spec     string     `json:"spec"`
+	SpecHash string     `json:"specHash"`
+	Options  genOptions `json:"options"`
+	Files    []string   `json:"files"`
+}
+
+// genOptions are the options of a generation which decide the files it produces
+type genOptions struct {
+	Name              string   `json:"name,omitempty"`
+	APIPackage        string   `json:"apiPackage,omitempty"`
+	ModelPackage      string   `json:"modelPackage,omitempty"`
+	ServerPackage     string   `json:"serverPackage,omitempty"`
+	ClientPackage     string   `json:"clientPackage,omitempty"`
+	Principal         string   `json:"principal,omitempty"`
+	ExistingModels    string   `json:"existingModels,omitempty"`
+	TemplateDir       string   `json:"templateDir,omitempty"`
+	Models            []string `json:"models,omitempty"`
+	Operations        []string `json:"operations,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	IncludeOperations []string `json:"include,omitempty"`
+	ExcludeOperations []string `json:"exclude,omitempty"`
+	Partial           bool     `json:"partial,omitempty"`
+}
+
+// isPartial tells if a generation produces only some of the files a full generation of the spec would
+func (g *GenOpts) isPartial(models, operations []string) bool {
+	return len(models) > 0 || len(operations) > 0 || len(g.Tags) > 0 ||
+		len(g.IncludeOperations) > 0 || len(g.ExcludeOperations) > 0 ||
+		!g.IncludeModel || !g.IncludeHandler || !g.IncludeSupport
+}
+
+// checkClean refuses to clean after a partial generation, which would remove the files it doesn't select
+func (g *GenOpts) checkClean(models, operations []string) error {
+	if g.Clean && g.isPartial(models, operations) {
+		return errors.New("clean only applies to a full generation, without selected models, operations or tags and without skipped files")
+	}
+	return nil
+}
+
+// recordGeneration lists the files of a generation in the manifest of the target.
+//
+// A partial generation adds its files to the ones of the previous generation of the same kind.
+// When cleaning, the files the previous generation produced and this one didn't are removed:
+// only the files listed in the manifest are ever removed.
+func (g *GenOpts) recordGeneration(kind string, models, operations []string) error {
+	if g.DumpData || g.DryRun {
+		return nil
+	}
+	target, err := filepath.Abs(g.Target)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readManifest(target)
+	if err != nil {
+		return err
+	}
+	previous := manifest[kind]
+
+	files := make(map[string]bool, len(g.generated))
+	for _, file := range g.generated {
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(target, abs)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		files[filepath.ToSlash(rel)] = true
+	}
+
+	partial := g.isPartial(models, operations)
+	if previous != nil {
+		for _, file := range previous.Files {
+			if files[file] {
+				continue
+			}
+			if partial {
+				files[file] = true
+				continue
+			}
+			if g.Clean {
+				if err := removeGenerated(target, file); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	record := &genRecord{
+		Spec: g.Spec,
+		Options: genOptions{
+			Name:              g.Name,
+			APIPackage:        g.APIPackage,
+			ModelPackage:      g.ModelPackage,
+			ServerPackage:     g.ServerPackage,
+			ClientPackage:     g.ClientPackage,
+			Principal:         g.Principal,
+			ExistingModels:    g.ExistingModels,
+			TemplateDir:       g.TemplateDir,
+			Models:            models,
+			Operations:        operations,
+			Tags:              g.Tags,
+			IncludeOperations: g.IncludeOperations,
+			ExcludeOperations: g.ExcludeOperations,
+			Partial:           partial,
+		},
+	}
+	load := swag.LoadFromFileOrHTTP
+	if isRemoteDocument(g.Spec) {
+		// through the loaders of the command, which authenticate with the registry of the spec
+		load = func(pth string) ([]byte, error) { return spec.PathLoader(pth) }
+	}
+	if b, err := load(g.Spec); err == nil {
+		sum := sha256.Sum256(b)
+		record.SpecHash = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	for file := range files {
+		record.Files = append(record.Files, file)
+	}
+	sort.Strings(record.Files)
+	manifest[kind] = record
+	g.generated = nil
+
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(target, ManifestFile), append(b, '\n'), 0644)
+}
+
+func readManifest(target string) (genManifest, error) {
+	manifest := make(genManifest)
+	b, err := ioutil.ReadFile(filepath.Join(target, ManifestFile))
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// removeGenerated removes a file of a previous generation, and the directories it leaves empty
+func removeGenerated(target, file string) error {
+	path := filepath.Join(target, filepath.FromSlash(file))
+	if !strings.HasPrefix(path, target+string(filepath.Separator)) {
+		return nil
+	}
+	if err := os.Remove(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	log.Printf("removed %s, which isn't generated anymore", path)
+
+	for dir := filepath.Dir(path); dir != target && strings.HasPrefix(dir, target); dir = filepath.Dir(dir) {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil || len(entries) > 0 {
+			break
+		}
+		if err := os.Remove(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
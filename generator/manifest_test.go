@@ -0,0 +1,125 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func manifestGenOpts(target, spec string) *GenOpts {
+	opts := testGenOpts()
+	opts.Target = target
+	opts.Spec = spec
+	return &opts
+}
+
+func TestGenerationManifest(t *testing.T) {
+	target, err := ioutil.TempDir(".", "manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	b, err := ioutil.ReadFile("../fixtures/codegen/trim.yml")
+	require.NoError(t, err)
+	spec := filepath.Join(target, "swagger.yml")
+	require.NoError(t, ioutil.WriteFile(spec, b, 0644))
+
+	require.NoError(t, GenerateServer("trim", nil, nil, manifestGenOpts(target, spec)))
+	manifest, err := readManifest(target)
+	require.NoError(t, err)
+	require.Contains(t, manifest, serverGeneration)
+	record := manifest[serverGeneration]
+	assert.True(t, strings.HasPrefix(record.SpecHash, "sha256:"))
+	assert.False(t, record.Options.Partial)
+	assert.Contains(t, record.Files, "models/pet.go")
+	assert.Contains(t, record.Files, "restapi/operations/pets/get_pet.go")
+	// written only once, so owned by the user
+	assert.NotContains(t, record.Files, "restapi/configure_trim.go")
+
+	// a file next to the generated ones, which the generator doesn't know about
+	handlers := filepath.Join(target, "restapi", "operations", "pets", "handlers.go")
+	require.NoError(t, ioutil.WriteFile(handlers, []byte("package pets\n"), 0644))
+
+	// renaming an operation leaves orphans, unless cleaning
+	renamed := strings.Replace(string(b), "getPet", "fetchPet", -1)
+	require.NoError(t, ioutil.WriteFile(spec, []byte(renamed), 0644))
+	opts := manifestGenOpts(target, spec)
+	opts.Clean = true
+	require.NoError(t, GenerateServer("trim", nil, nil, opts))
+
+	pets := filepath.Join(target, "restapi", "operations", "pets")
+	assert.True(t, fileExists(pets, "fetch_pet.go"))
+	assert.False(t, fileExists(pets, "get_pet.go"))
+	assert.False(t, fileExists(pets, "get_pet_parameters.go"))
+	assert.True(t, fileExists(pets, "handlers.go"))
+	assert.True(t, fileExists(filepath.Join(target, "restapi"), "configure_trim.go"))
+
+	manifest, err = readManifest(target)
+	require.NoError(t, err)
+	assert.NotContains(t, manifest[serverGeneration].Files, "restapi/operations/pets/get_pet.go")
+	assert.NotEqual(t, record.SpecHash, manifest[serverGeneration].SpecHash)
+}
+
+func TestGenerationManifest_Partial(t *testing.T) {
+	target, err := ioutil.TempDir(".", "manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	spec := "../fixtures/codegen/trim.yml"
+	opts := manifestGenOpts(target, spec)
+	opts.IncludeHandler, opts.IncludeParameters, opts.IncludeResponses, opts.IncludeSupport = false, false, false, false
+	require.NoError(t, GenerateServer("trim", []string{"Pet"}, nil, opts))
+
+	opts = manifestGenOpts(target, spec)
+	opts.IncludeHandler, opts.IncludeParameters, opts.IncludeResponses, opts.IncludeSupport = false, false, false, false
+	require.NoError(t, GenerateServer("trim", []string{"Store"}, nil, opts))
+
+	manifest, err := readManifest(target)
+	require.NoError(t, err)
+	record := manifest[serverGeneration]
+	assert.True(t, record.Options.Partial)
+	assert.Equal(t, []string{"models/pet.go", "models/store.go"}, record.Files)
+
+	opts = manifestGenOpts(target, spec)
+	opts.Clean = true
+	assert.Error(t, GenerateServer("trim", []string{"Pet"}, nil, opts))
+	assert.True(t, fileExists(filepath.Join(target, "models"), "store.go"))
+}
+
+func TestGenerateServer_UnchangedFiles(t *testing.T) {
+	target, err := ioutil.TempDir(".", "unchanged")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	require.NoError(t, GenerateServer("trim", nil, nil, manifestGenOpts(target, "../fixtures/codegen/trim.yml")))
+	model := filepath.Join(target, "models", "pet.go")
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(model, past, past))
+
+	require.NoError(t, GenerateServer("trim", nil, nil, manifestGenOpts(target, "../fixtures/codegen/trim.yml")))
+	info, err := os.Stat(model)
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().Equal(past), "an unchanged file must not be written again")
+	manifest, err := readManifest(target)
+	require.NoError(t, err)
+	assert.Contains(t, manifest[serverGeneration].Files, "models/pet.go")
+}
@@ -64,6 +64,10 @@ func GenerateDefinition(modelNames []string, opts *GenOpts) error {
 		return err
 	}
 
+	if err := checkDefinitionNames(specPath, specDoc.Spec()); err != nil {
+		return err
+	}
+
 	if len(modelNames) == 0 {
 		for k := range specDoc.Spec().Definitions {
 			modelNames = append(modelNames, k)
@@ -394,9 +398,7 @@ func (sg *schemaGenContext) NewSliceBranch(schema *spec.Schema) *schemaGenContex
 	pg.Schema = *schema
 	pg.Required = false
 	if sg.IsVirtual {
-		resolver := newTypeResolver(sg.TypeResolver.ModelsPackage, sg.TypeResolver.Doc)
-		resolver.ModelName = sg.TypeResolver.ModelName
-		pg.TypeResolver = resolver
+		pg.TypeResolver = sg.TypeResolver.NewWithModelName(sg.TypeResolver.ModelName)
 	}
 
 	// when this is an anonymous complex object, this needs to become a ref
@@ -425,7 +427,7 @@ func (sg *schemaGenContext) NewAdditionalItems(schema *spec.Schema) *schemaGenCo
 		pg.Path = pg.Path + "+ \".\" + strconv.Itoa(" + indexVar + mod + ")"
 	}
 	pg.IndexVar = indexVar
-	pg.ValueExpr = sg.ValueExpr + "." + sg.GoName() + "Items[" + indexVar + "]"
+	pg.ValueExpr = sg.ValueExpr + "." + swag.ToGoName(sg.GoName()) + "Items[" + indexVar + "]"
 	pg.Schema = spec.Schema{}
 	if schema != nil {
 		pg.Schema = *schema
@@ -682,27 +684,9 @@ func (sg *schemaGenContext) buildProperties() error {
 			sg.GenSchema.NeedsValidation = true
 		}
 		if emprop.Schema.Ref.String() != "" {
-			ref := emprop.Schema.Ref
-			var sch *spec.Schema
-			for ref.String() != "" {
-				var rsch *spec.Schema
-				var err error
-				specDoc := sg.TypeResolver.Doc
-				if specDoc.SpecFilePath() != "" {
-					rsch, err = spec.ResolveRefWithBase(specDoc.Spec(), &ref, &spec.ExpandOptions{RelativeBase: specDoc.SpecFilePath()})
-				} else {
-					rsch, err = spec.ResolveRef(specDoc.Spec(), &ref)
-				}
-				if err != nil {
-					return err
-				}
-				ref = rsch.Ref
-				if rsch != nil && rsch.Ref.String() != "" {
-					ref = rsch.Ref
-					continue
-				}
-				ref = spec.Ref{}
-				sch = rsch
+			sch, err := sg.resolveRefTarget(emprop.Schema.Ref)
+			if err != nil {
+				return err
 			}
 			if emprop.Discrimination != nil {
 				if _, ok := emprop.Discrimination.Discriminators[emprop.Schema.Ref.String()]; ok {
@@ -732,7 +716,7 @@ func (sg *schemaGenContext) buildProperties() error {
 				emprop.GenSchema.IsAliased = true
 			}
 			nv, hv := hasValidations(sch, false)
-			if hv {
+			if hv || holdsDefinitions(sch) {
 				emprop.GenSchema.HasValidations = true
 			}
 			if nv {
@@ -759,6 +743,41 @@ func (sg *schemaGenContext) buildProperties() error {
 	return nil
 }
 
+// resolveRefTarget follows a chain of $refs up to the schema it ends on
+func (sg *schemaGenContext) resolveRefTarget(ref spec.Ref) (*spec.Schema, error) {
+	specDoc := sg.TypeResolver.Doc
+	for {
+		var rsch *spec.Schema
+		var err error
+		if specDoc.SpecFilePath() != "" {
+			rsch, err = spec.ResolveRefWithBase(specDoc.Spec(), &ref, &spec.ExpandOptions{RelativeBase: specDoc.SpecFilePath()})
+		} else {
+			rsch, err = spec.ResolveRef(specDoc.Spec(), &ref)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rsch.Ref.String() == "" {
+			return rsch, nil
+		}
+		ref = rsch.Ref
+	}
+}
+
+// holdsDefinitions tells if a map or an array holds values of other definitions, which have to be validated
+func holdsDefinitions(model *spec.Schema) bool {
+	var value *spec.Schema
+	switch {
+	case model.AdditionalProperties != nil && model.AdditionalProperties.Schema != nil:
+		value = model.AdditionalProperties.Schema
+	case model.Items != nil && model.Items.Schema != nil:
+		value = model.Items.Schema
+	default:
+		return false
+	}
+	return value.Ref.String() != "" || len(value.Properties) > 0 || holdsDefinitions(value)
+}
+
 func (sg *schemaGenContext) buildAllOf() error {
 	if len(sg.Schema.AllOf) > 0 {
 		if sg.Container == "" {
@@ -847,6 +866,9 @@ func (mt *mapStack) Build() error {
 		if err := cp.makeGenSchema(); err != nil {
 			return err
 		}
+		if err := cp.flagValueValidations(mt.Context.GenSchema.ElemType); err != nil {
+			return err
+		}
 		mt.Context.MergeResult(cp, false)
 		mt.Context.GenSchema.AdditionalProperties = &cp.GenSchema
 		if Debug {
@@ -887,6 +909,9 @@ func (mt *mapStack) Build() error {
 			if err := cur.Context.makeGenSchema(); err != nil {
 				return err
 			}
+			if err := cur.Context.flagValueValidations(cur.Previous.Context.GenSchema.ElemType); err != nil {
+				return err
+			}
 		}
 		if cur.Next != nil {
 			cur.Context.MergeResult(cur.Next.Context, false)
@@ -902,6 +927,40 @@ func (mt *mapStack) Build() error {
 	return nil
 }
 
+// flagValueValidations flags the values of a map as needing validation when they are, or hold, other
+// definitions which validate anything
+func (sg *schemaGenContext) flagValueValidations(elem *resolvedType) error {
+	if sg.GenSchema.IsArray || sg.GenSchema.IsMap {
+		// an empty collection is a valid value
+		sg.GenSchema.Required = false
+	}
+	if sg.Schema.Ref.String() == "" {
+		if elem != nil && elem.IsArray && sg.GenSchema.Items != nil {
+			// the elements are held as declared by the type of the map
+			sg.GenSchema.GoType = elem.GoType
+			sg.GenSchema.Items.IsNullable = elem.ElemType.IsNullable
+		}
+		if holdsDefinitions(&sg.Schema) {
+			sg.GenSchema.HasValidations = true
+			sg.GenSchema.NeedsValidation = true
+		}
+		return nil
+	}
+	if elem != nil {
+		// the values are held as declared by the type of the map
+		sg.GenSchema.IsNullable = elem.IsNullable
+	}
+	sch, err := sg.resolveRefTarget(sg.Schema.Ref)
+	if err != nil {
+		return err
+	}
+	if nv, hv := hasValidations(sch, false); nv || hv || holdsDefinitions(sch) {
+		sg.GenSchema.HasValidations = true
+		sg.GenSchema.NeedsValidation = true
+	}
+	return nil
+}
+
 func (mt *mapStack) HasMore() bool {
 	return mt.Type.AdditionalProperties != nil && (mt.Type.AdditionalProperties.Schema != nil || mt.Type.AdditionalProperties.Allows)
 }
@@ -1050,9 +1109,8 @@ func (sg *schemaGenContext) makeNewStruct(name string, schema spec.Schema) *sche
 		IncludeModel:     sg.IncludeModel,
 	}
 	if schema.Ref.String() == "" {
-		resolver := newTypeResolver(sg.TypeResolver.ModelsPackage, sg.TypeResolver.Doc)
-		resolver.ModelName = name //sg.TypeResolver.ModelName
-		pg.TypeResolver = resolver
+		// the new struct lives next to the schema it comes from, so it isn't a known definition
+		pg.TypeResolver = sg.TypeResolver.NewWithModelName(name)
 	}
 	pg.GenSchema.IsVirtual = true
 
@@ -1108,6 +1166,47 @@ func (sg *schemaGenContext) buildArray() error {
 	return nil
 }
 
+// buildOneOf resolves the schemas listed by the x-one-of extension: the value is one of them,
+// the first one it is valid against when unmarshalling
+func (sg *schemaGenContext) buildOneOf() error {
+	ext, ok := sg.Schema.Extensions[xOneOf]
+	if !ok {
+		return nil
+	}
+	if len(sg.Schema.Properties) > 0 || len(sg.Schema.AllOf) > 0 || sg.Schema.AdditionalProperties != nil {
+		return fmt.Errorf("%s: a schema with %s can't have properties, allOf or additionalProperties", sg.Name, xOneOf)
+	}
+
+	var candidates []spec.Schema
+	b, err := json.Marshal(ext)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &candidates); err != nil || len(candidates) == 0 {
+		return fmt.Errorf("%s: %s must be a list of schemas", sg.Name, xOneOf)
+	}
+
+	for i := range candidates {
+		candidate := candidates[i]
+		if candidate.Ref.String() == "" {
+			return fmt.Errorf("%s: the schemas of %s must be $refs to definitions", sg.Name, xOneOf)
+		}
+		tpe, err := sg.TypeResolver.ResolveSchema(&candidate, false, true)
+		if err != nil {
+			return err
+		}
+		if tpe.IsBaseType || tpe.IsInterface || tpe.IsArray || tpe.IsMap {
+			return fmt.Errorf("%s: %s of %s must be an object, not a polymorphic, array, map or untyped schema", sg.Name, candidate.Ref.String(), xOneOf)
+		}
+		sg.GenSchema.OneOf = append(sg.GenSchema.OneOf, GenSchema{
+			resolvedType: tpe,
+			Name:         filepath.Base(candidate.Ref.GetURL().Fragment),
+		})
+	}
+	sg.GenSchema.HasValidations = true
+	return nil
+}
+
 func (sg *schemaGenContext) buildItems() error {
 	presentsAsSingle := sg.Schema.Items != nil && sg.Schema.Items.Schema != nil
 	if presentsAsSingle && sg.Schema.AdditionalItems != nil { // unsure if htis a valid of invalid schema
@@ -1333,7 +1432,12 @@ func (sg *schemaGenContext) makeGenSchema() error {
 
 	ex := ""
 	if sg.Schema.Example != nil {
-		ex = fmt.Sprintf("%#v", sg.Schema.Example)
+		// the example is documented as it would be sent, strings without their quotes
+		if str, ok := sg.Schema.Example.(string); ok {
+			ex = str
+		} else if b, err := json.Marshal(sg.Schema.Example); err == nil {
+			ex = string(b)
+		}
 	}
 	sg.GenSchema.IsExported = true
 	sg.GenSchema.Example = ex
@@ -1463,6 +1567,10 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		return err
 	}
 
+	if err := sg.buildOneOf(); err != nil {
+		return err
+	}
+
 	if Debug {
 		log.Printf("finished gen schema for %q\n", sg.Name)
 	}
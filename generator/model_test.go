@@ -1080,14 +1080,9 @@ func TestGenerateModel_SimpleTuple(t *testing.T) {
 			assertInCode(t, "json.Marshal(data)", res)
 			assert.NotRegexp(t, regexp.MustCompile("lastIndex"), res)
 
-			for i, p := range genModel.Properties {
-				r := "m.P" + strconv.Itoa(i)
-				if !p.IsNullable {
-					r = "&" + r
-				}
-
+			for i := range genModel.Properties {
 				assertInCode(t, fmt.Sprintf("buf = bytes.NewBuffer(stage1[%d])", i), res)
-				assertInCode(t, fmt.Sprintf("dec.Decode(%s)", r), res)
+				assertInCode(t, fmt.Sprintf("dec.Decode(&m.P%d)", i), res)
 				assertInCode(t, "P"+strconv.Itoa(i)+",", res)
 			}
 		}
@@ -1128,25 +1123,21 @@ func TestGenerateModel_TupleWithExtra(t *testing.T) {
 					assertInCode(t, k+") UnmarshalJSON", res)
 					assertInCode(t, k+") MarshalJSON", res)
 
-					for i, p := range genModel.Properties {
-						r := "m.P" + strconv.Itoa(i)
-						if !p.IsNullable {
-							r = "&" + r
-						}
-						assertInCode(t, fmt.Sprintf("lastIndex = %d", i), res)
+					for i := range genModel.Properties {
 						assertInCode(t, fmt.Sprintf("buf = bytes.NewBuffer(stage1[%d])", i), res)
 						assertInCode(t, "dec := json.NewDecoder(buf)", res)
-						assertInCode(t, fmt.Sprintf("dec.Decode(%s)", r), res)
+						assertInCode(t, fmt.Sprintf("dec.Decode(&m.P%d)", i), res)
 						assertInCode(t, "P"+strconv.Itoa(i)+",", res)
 					}
-					assertInCode(t, "var lastIndex int", res)
+					assertNotInCode(t, "lastIndex", res)
 					assertInCode(t, "var toadd float64", res)
-					assertInCode(t, "for _, val := range stage1[lastIndex+1:]", res)
+					assertInCode(t, "for _, val := range stage1[4:]", res)
 					assertInCode(t, "buf = bytes.NewBuffer(val)", res)
 					assertInCode(t, "dec := json.NewDecoder(buf)", res)
 					assertInCode(t, "dec.Decode(&toadd)", res)
 					assertInCode(t, "json.Marshal(data)", res)
-					assertInCode(t, "for _, v := range m."+k+"Items", res)
+					assertInCode(t, "m."+k+"Items = append(m."+k+"Items, toadd)", res)
+					assertInCode(t, "for _, v := range m."+k+"Items {", res)
 				}
 			}
 		}
@@ -1187,26 +1178,22 @@ func TestGenerateModel_TupleWithComplex(t *testing.T) {
 					assertInCode(t, k+") UnmarshalJSON", res)
 					assertInCode(t, k+") MarshalJSON", res)
 
-					for i, p := range genModel.Properties {
-						r := "m.P" + strconv.Itoa(i)
-						if !p.IsNullable {
-							r = "&" + r
-						}
-						assertInCode(t, fmt.Sprintf("lastIndex = %d", i), res)
+					for i := range genModel.Properties {
 						assertInCode(t, fmt.Sprintf("buf = bytes.NewBuffer(stage1[%d])", i), res)
 						assertInCode(t, "dec := json.NewDecoder(buf)", res)
-						assertInCode(t, fmt.Sprintf("dec.Decode(%s)", r), res)
+						assertInCode(t, fmt.Sprintf("dec.Decode(&m.P%d)", i), res)
 						assertInCode(t, "P"+strconv.Itoa(i)+",", res)
 					}
 
-					assertInCode(t, "var lastIndex int", res)
+					assertNotInCode(t, "lastIndex", res)
 					assertInCode(t, "var toadd *TupleWithComplexItems", res)
-					assertInCode(t, "for _, val := range stage1[lastIndex+1:]", res)
+					assertInCode(t, "for _, val := range stage1[4:]", res)
 					assertInCode(t, "buf = bytes.NewBuffer(val)", res)
 					assertInCode(t, "dec := json.NewDecoder(buf)", res)
-					assertInCode(t, "dec.Decode(toadd)", res)
+					assertInCode(t, "dec.Decode(&toadd)", res)
 					assertInCode(t, "json.Marshal(data)", res)
-					assertInCode(t, "for _, v := range m."+k+"Items", res)
+					assertInCode(t, "m."+k+"Items = append(m."+k+"Items, toadd)", res)
+					assertInCode(t, "for _, v := range m."+k+"Items {", res)
 				}
 			}
 		}
@@ -1258,14 +1245,10 @@ func TestGenerateModel_WithTuple(t *testing.T) {
 					assertInCode(t, "json.Marshal(data)", res)
 					assert.NotRegexp(t, regexp.MustCompile("lastIndex"), res)
 
-					for i, p := range sch.Properties {
-						r := "m.P" + strconv.Itoa(i)
-						if !p.IsNullable {
-							r = "&" + r
-						}
+					for i := range sch.Properties {
 						assertInCode(t, fmt.Sprintf("buf = bytes.NewBuffer(stage1[%d])", i), res)
 						assertInCode(t, "dec := json.NewDecoder(buf)", res)
-						assertInCode(t, fmt.Sprintf("dec.Decode(%s)", r), res)
+						assertInCode(t, fmt.Sprintf("dec.Decode(&m.P%d)", i), res)
 						assertInCode(t, "P"+strconv.Itoa(i)+",", res)
 					}
 				}
@@ -1320,26 +1303,164 @@ func TestGenerateModel_WithTupleWithExtra(t *testing.T) {
 					assertInCode(t, k+"FlagsTuple0) MarshalJSON", res)
 					assertInCode(t, "json.Marshal(data)", res)
 
-					for i, p := range sch.Properties {
-						r := "m.P" + strconv.Itoa(i)
-						if !p.IsNullable {
-							r = "&" + r
-						}
-						assertInCode(t, fmt.Sprintf("lastIndex = %d", i), res)
+					for i := range sch.Properties {
 						assertInCode(t, fmt.Sprintf("buf = bytes.NewBuffer(stage1[%d])", i), res)
 						assertInCode(t, "dec := json.NewDecoder(buf)", res)
-						assertInCode(t, fmt.Sprintf("dec.Decode(%s)", r), res)
+						assertInCode(t, fmt.Sprintf("dec.Decode(&m.P%d)", i), res)
 						assertInCode(t, "P"+strconv.Itoa(i)+",", res)
 					}
 
-					assertInCode(t, "var lastIndex int", res)
+					assertNotInCode(t, "lastIndex", res)
 					assertInCode(t, "var toadd float32", res)
-					assertInCode(t, "for _, val := range stage1[lastIndex+1:]", res)
+					assertInCode(t, "for _, val := range stage1[2:]", res)
 					assertInCode(t, "buf = bytes.NewBuffer(val)", res)
 					assertInCode(t, "dec := json.NewDecoder(buf)", res)
 					assertInCode(t, "dec.Decode(&toadd)", res)
 					assertInCode(t, "json.Marshal(data)", res)
-					assertInCode(t, "for _, v := range m."+k+"FlagsTuple0Items", res)
+					assertInCode(t, "m."+k+"FlagsTuple0Items = append(m."+k+"FlagsTuple0Items, toadd)", res)
+					assertInCode(t, "for _, v := range m."+k+"FlagsTuple0Items {", res)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateModel_TupleWithValidatedExtra(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/tuples.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "labeledValues"
+		opts := opts()
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+		if assert.NoError(t, err) {
+			assert.True(t, genModel.IsTuple)
+			assert.True(t, genModel.HasAdditionalItems)
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("model").Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("labeled_values.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "LabeledValuesItems []int64 `json:\"-\"`", res)
+					assertInCode(t, "for _, val := range stage1[1:]", res)
+					assertInCode(t, "m.LabeledValuesItems = append(m.LabeledValuesItems, toadd)", res)
+					assertInCode(t, "for i := range m.LabeledValuesItems {", res)
+					assertInCode(t, "validate.MinimumInt(strconv.Itoa(i+1), \"body\", int64(m.LabeledValuesItems[i]), 10, false)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		k = "Pair"
+		genModel, err = makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("model").Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("pair.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "P1 *Thing `json:\"-\"`", res)
+					assertInCode(t, "dec.Decode(&m.P1)", res)
+					assertInCode(t, "PairItems []*Thing `json:\"-\"`", res)
+					assertInCode(t, "var toadd *Thing", res)
+					assertInCode(t, "dec.Decode(&toadd)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateModel_OneOf(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/x-one-of.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		k := "Pet"
+		opts := opts()
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+		if assert.NoError(t, err) && assert.Len(t, genModel.OneOf, 2) {
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("model").Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("pet.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type Pet struct {", res)
+					assertInCode(t, "OneOf PetOneOf `json:\"-\"`", res)
+					assertInCode(t, "type PetOneOf interface {", res)
+					assertInCode(t, "var cat Cat", res)
+					assertInCode(t, "if err := swag.ReadJSON(raw, &cat); err == nil && cat.Validate(strfmt.Default) == nil {", res)
+					assertInCode(t, "m.OneOf = &dog", res)
+					assertInCode(t, `return errors.New(422, "Pet in body must be exactly one of *Cat, *Dog, it is valid against %d of them", matched)`, res)
+					assertInCode(t, "return json.Marshal(m.OneOf)", res)
+					assertInCode(t, "case *Cat, *Dog:", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		k = "Owner"
+		genModel, err = makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("model").Execute(buf, genModel)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("owner.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Pet *Pet `json:\"pet\"`", res)
+					assertInCode(t, "Pets []*Pet `json:\"pets\"`", res)
+					assertInCode(t, "m.Pet.Validate(formats)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		for _, k := range []string{"Inline", "Mixed"} {
+			_, err = makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+			assert.Error(t, err, k)
+		}
+	}
+}
+
+func TestGenerateModel_DocComments(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/model-docs.yml")
+	if assert.NoError(t, err) {
+		definitions := specDoc.Spec().Definitions
+		opts := opts()
+		expected := map[string][]string{
+			"Pet": {
+				"// Pet A pet\n//\n// A pet of the store.\n// Example: {\"name\":\"doggie\"}\n// swagger:model Pet",
+				"// The name of the pet.\n\t// Required: true\n\t// Min Length: 1\n\t// Example: doggie\n",
+				"// Minimum: 0\n\t// Example: 3\n",
+				"// Enum: [small large]\n",
+				"// Example: [\"cute\",\"small\"]\n",
+			},
+			"Status": {
+				"// Status The status of a pet",
+				"// Enum: [available pending sold]\n// Example: available\n// swagger:model Status",
+			},
+		}
+		for k, exprs := range expected {
+			genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				err := templates.MustGet("model").Execute(buf, genModel)
+				if assert.NoError(t, err) {
+					ff, err := opts.LanguageOpts.FormatContent(swag.ToFileName(k)+".go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						for _, expr := range exprs {
+							assertInCode(t, expr, res)
+						}
+					} else {
+						fmt.Println(buf.String())
+					}
 				}
 			}
 		}
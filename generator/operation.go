@@ -19,11 +19,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
+	units "github.com/docker/go-units"
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime"
@@ -58,9 +62,18 @@ func sortedResponses(input map[int]spec.Response) responses {
 // It also generates an operation handler interface that uses the parameter model for handling a valid request.
 // Allows for specifying a list of tags to include only certain tags for the generation
 func GenerateServerOperation(operationNames []string, opts *GenOpts) error {
+	if err := opts.checkCompiles(func(check *GenOpts) error {
+		return GenerateServerOperation(operationNames, check)
+	}); err != nil {
+		return err
+	}
+
 	if opts == nil {
 		return errors.New("gen opts are required")
 	}
+	if err := opts.checkClean(nil, operationNames); err != nil {
+		return err
+	}
 	if opts.TemplateDir != "" {
 		if err := templates.LoadDir(opts.TemplateDir); err != nil {
 			return err
@@ -85,6 +98,15 @@ func GenerateServerOperation(operationNames []string, opts *GenOpts) error {
 	if len(ops) == 0 {
 		return errors.New("no operations were selected")
 	}
+	var unknownOperations []string
+	for _, name := range operationNames {
+		if _, ok := ops[name]; !ok {
+			unknownOperations = append(unknownOperations, name)
+		}
+	}
+	if len(unknownOperations) != 0 {
+		return fmt.Errorf("unknown operations: %s", strings.Join(unknownOperations, ", "))
+	}
 
 	for operationName, opRef := range ops {
 		method, path, operation := opRef.Method, opRef.Path, opRef.Op
@@ -102,21 +124,21 @@ func GenerateServerOperation(operationNames []string, opts *GenOpts) error {
 		}
 
 		apiPackage := opts.LanguageOpts.MangleName(swag.ToFileName(opts.APIPackage), "api")
-		serverPackage := opts.LanguageOpts.MangleName(swag.ToFileName(opts.ServerPackage), "server")
+		serverPackage := opts.LanguageOpts.ManglePackageName(opts.ServerPackage, "server")
 		generator := operationGenerator{
 			Name:                 operationName,
 			Method:               method,
 			Path:                 path,
 			BasePath:             specDoc.BasePath(),
 			APIPackage:           apiPackage,
-			ModelsPackage:        opts.LanguageOpts.MangleName(swag.ToFileName(opts.ModelPackage), "definitions"),
-			ClientPackage:        opts.LanguageOpts.MangleName(swag.ToFileName(opts.ClientPackage), "client"),
+			ModelsPackage:        opts.LanguageOpts.ManglePackageName(opts.ModelPackage, "definitions"),
+			ClientPackage:        opts.LanguageOpts.ManglePackageName(opts.ClientPackage, "client"),
 			ServerPackage:        serverPackage,
 			Operation:            *operation,
 			SecurityRequirements: analyzed.SecurityRequirementsFor(operation),
 			SecurityDefinitions:  analyzed.SecurityDefinitionsFor(operation),
 			Principal:            opts.Principal,
-			Target:               filepath.Join(opts.Target, serverPackage),
+			Target:               filepath.Join(opts.Target, opts.LanguageOpts.ManglePackagePath(opts.ServerPackage, "server")),
 			Base:                 opts.Target,
 			Tags:                 opts.Tags,
 			IncludeHandler:       opts.IncludeHandler,
@@ -135,7 +157,7 @@ func GenerateServerOperation(operationNames []string, opts *GenOpts) error {
 			return err
 		}
 	}
-	return nil
+	return opts.recordGeneration(serverGeneration, nil, operationNames)
 }
 
 type operationGenerator struct {
@@ -214,7 +236,7 @@ func (o *operationGenerator) Generate() error {
 
 	bldr.DefaultImports = []string{o.GenOpts.ExistingModels}
 	if o.GenOpts.ExistingModels == "" {
-		bldr.DefaultImports = []string{filepath.ToSlash(filepath.Join(baseImport(o.Base), o.ModelsPackage))}
+		bldr.DefaultImports = []string{o.GenOpts.packageImport(o.Base, o.GenOpts.ModelPackage, "definitions")}
 	}
 
 	bldr.APIPackage = bldr.RootAPIPackage
@@ -223,7 +245,7 @@ func (o *operationGenerator) Generate() error {
 		st = o.GenOpts.Tags
 	}
 	intersected := intersectTags(o.Operation.Tags, st)
-	if len(intersected) == 1 {
+	if len(intersected) == 1 && !o.GenOpts.FlatOperations {
 		tag := intersected[0]
 		bldr.APIPackage = o.GenOpts.LanguageOpts.MangleName(swag.ToFileName(tag), o.APIPackage)
 	}
@@ -307,6 +329,21 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 	resolver := newTypeResolver(b.ModelsPackage, b.Doc/*.ResetDefinitions()*/)
 	receiver := "o"
 
+	// the types of the schemas declared inline in this operation go to the operation package:
+	// the definitions added to the spec to build them must not leak into the models of other operations
+	definitions := b.Doc.Spec().Definitions
+	known := make(map[string]struct{}, len(definitions))
+	for k := range definitions {
+		known[k] = struct{}{}
+	}
+	defer func() {
+		for k := range b.Doc.Spec().Definitions {
+			if _, ok := known[k]; !ok {
+				delete(b.Doc.Spec().Definitions, k)
+			}
+		}
+	}()
+
 	operation := b.Operation
 	var params, qp, pp, hp, fp GenParameters
 	var hasQueryParams, hasFormParams, hasFileParams, hasFormValueParams bool
@@ -321,8 +358,18 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		"body":     make(map[string]string, len(paramsForOperation)),
 	}
 
+	// the parameters of the path item are merged with the ones of the operation, which override them by name and location.
+	// When a name is used in several locations, the parameters are visited in a stable order, so they get the same names
+	// on every generation.
+	ids := make([]string, 0, len(paramsForOperation))
+	for id := range paramsForOperation {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
 	seenIds := make(map[string][]string, len(paramsForOperation))
-	for id, p := range paramsForOperation {
+	for _, id := range ids {
+		p := paramsForOperation[id]
 		if _, ok := seenIds[p.Name]; ok {
 			idMapping[p.In][p.Name] = swag.ToGoName(id)
 		} else {
@@ -368,6 +415,32 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 	sort.Sort(hp)
 	sort.Sort(fp)
 
+	imports := map[string]string{
+		"common_models": "github.com/sidewalklabs/parking/common/models",
+	}
+	for alias, pkg := range b.GenOpts.goTypeImports() {
+		imports[alias] = pkg
+	}
+	for _, p := range params {
+		for _, s := range p.Sanitizers {
+			if s.Import != "" {
+				imports[s.Alias] = s.Import
+			}
+		}
+		for it := p.Child; it != nil; it = it.Child {
+			for _, s := range it.Sanitizers {
+				if s.Import != "" {
+					imports[s.Alias] = s.Import
+				}
+			}
+		}
+	}
+
+	pagination, err := makePagination(b.Name, operation, qp)
+	if err != nil {
+		return GenOperation{}, err
+	}
+
 	var srs responses
 	if operation.Responses != nil {
 		srs = sortedResponses(operation.Responses.StatusCodeResponses)
@@ -383,7 +456,13 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 			}
 			name = swag.ToJSONName(b.Name + " " + name)
 			isSuccess := v.Code/100 == 2
-			gr, err := b.MakeResponse(receiver, name, isSuccess, resolver, v.Code, v.Response)
+			resp := v.Response
+			if isSuccess && pagination != nil {
+				if resp, err = b.withLinkHeader(resp); err != nil {
+					return GenOperation{}, err
+				}
+			}
+			gr, err := b.MakeResponse(receiver, name, isSuccess, resolver, v.Code, resp)
 			if err != nil {
 				return GenOperation{}, err
 			}
@@ -431,6 +510,29 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		extraSchemes = concatUnique(ess1, extraSchemes)
 	}
 	sort.Strings(extraSchemes)
+	timeout, err := operationTimeout(b.Name, operation)
+	if err != nil {
+		return GenOperation{}, err
+	}
+	csrf, err := operationCSRF(b.Name, b.Method, operation, swsp.Extensions)
+	if err != nil {
+		return GenOperation{}, err
+	}
+	maxBodySize, err := operationMaxBodySize(b.Name, operation, swsp.Extensions)
+	if err != nil {
+		return GenOperation{}, err
+	}
+	deprecation, err := operationDeprecation(b.Name, operation)
+	if err != nil {
+		return GenOperation{}, err
+	}
+	if deprecation != nil && b.GenOpts != nil {
+		deprecation.Headers = b.GenOpts.WarnDeprecated
+	}
+	dependencies, dependentParams, err := operationDependencies(b.Name, operation, paramsForOperation, params)
+	if err != nil {
+		return GenOperation{}, err
+	}
 	schemes := concatUnique(swsp.Schemes, operation.Schemes)
 	sort.Strings(schemes)
 	produces := producesOrDefault(operation.Produces, swsp.Produces, b.DefaultProduces)
@@ -503,13 +605,399 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		ExtraSchemes:         extraSchemes,
 		WithContext:          b.WithContext,
 		TimeoutName:          timeoutName,
+		Timeout:              timeout,
+		MaxBodySize:          maxBodySize,
+		Pagination:           pagination,
+		Polling:              makePolling(successResponses, hasStreamingResponse),
+		CSRF:                 csrf,
+		Deprecation:          deprecation,
+		Dependencies:         dependencies,
+		DependentParams:      dependentParams,
 		Extensions:           operation.Extensions,
-		Imports: map[string]string{
-			"common_models": "github.com/sidewalklabs/parking/common/models",
-		},
+		Imports:              imports,
 	}, nil
 }
 
+// operationTimeout reads the x-timeout extension of an operation,
+// either a duration like "30s" or a number of seconds
+func operationTimeout(name string, operation spec.Operation) (time.Duration, error) {
+	value, ok := operation.Extensions[xTimeout]
+	if !ok {
+		return 0, nil
+	}
+
+	var timeout time.Duration
+	switch v := value.(type) {
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s for operation %q: %v", xTimeout, name, err)
+		}
+		timeout = d
+	case float64:
+		timeout = time.Duration(v * float64(time.Second))
+	default:
+		return 0, fmt.Errorf("invalid %s for operation %q: expected a duration or a number of seconds, got %v", xTimeout, name, value)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid %s for operation %q: the timeout must be positive", xTimeout, name)
+	}
+	return timeout, nil
+}
+
+// operationMaxBodySize reads the maximum size of the request body from the x-max-body-size extension of the operation,
+// or of the spec when the operation doesn't set it: a number of bytes or a size like 10MB
+func operationMaxBodySize(name string, operation spec.Operation, root spec.Extensions) (int64, error) {
+	value, ok := operation.Extensions[xMaxBody]
+	if !ok {
+		value, ok = root[xMaxBody]
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	var size int64
+	switch v := value.(type) {
+	case string:
+		sz, err := units.FromHumanSize(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s for operation %q: %v", xMaxBody, name, err)
+		}
+		size = sz
+	case float64:
+		size = int64(v)
+	default:
+		return 0, fmt.Errorf("invalid %s for operation %q: expected a size or a number of bytes, got %v", xMaxBody, name, value)
+	}
+	if size <= 0 {
+		return 0, fmt.Errorf("invalid %s for operation %q: the size must be positive", xMaxBody, name)
+	}
+	return size, nil
+}
+
+// operationDeprecation reads the deprecation of an operation: deprecated, or a x-deprecated extension
+// which is true or the message telling what to use instead, and the x-sunset date it is removed after
+func operationDeprecation(name string, operation spec.Operation) (*GenDeprecation, error) {
+	deprecation, err := readDeprecation(operation.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s for operation %q: %v", xDeprecated, name, err)
+	}
+	if deprecation == nil && operation.Deprecated {
+		deprecation = &GenDeprecation{}
+	}
+
+	value, ok := operation.Extensions[xSunset]
+	if !ok {
+		return deprecation, nil
+	}
+	if deprecation == nil {
+		return nil, fmt.Errorf("operation %q has a %s date but isn't deprecated", name, xSunset)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s for operation %q: expected a date like 2018-12-31, got %v", xSunset, name, value)
+	}
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if deprecation.Sunset, err = time.Parse(layout, str); err == nil {
+			return deprecation, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid %s for operation %q: expected a date like 2018-12-31, got %q", xSunset, name, str)
+}
+
+// operationDependencies reads the groups of parameters of the x-requires and x-mutually-exclusive extensions
+// of an operation, which its binder checks once the parameters are bound
+func operationDependencies(name string, operation spec.Operation, specParams map[string]spec.Parameter, params GenParameters) ([]GenParamDependency, GenParameters, error) {
+	all := make([]spec.Parameter, 0, len(specParams))
+	for _, param := range specParams {
+		all = append(all, param)
+	}
+	found, messages := readParamDependencies(operation.Extensions, all)
+	if len(messages) > 0 {
+		return nil, nil, fmt.Errorf("operation %q: %s", name, strings.Join(messages, "; "))
+	}
+
+	var dependencies []GenParamDependency
+	var dependent GenParameters
+	seen := make(map[string]bool)
+	for _, dep := range found {
+		dependency := GenParamDependency{Exclusive: dep.exclusive}
+		for _, param := range dep.params {
+			dependency.Names = append(dependency.Names, param.Name)
+			if seen[param.Name] {
+				continue
+			}
+			seen[param.Name] = true
+			for _, gp := range params {
+				if gp.Name == param.Name && gp.Location == param.In {
+					dependent = append(dependent, gp)
+				}
+			}
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	sort.Sort(dependent)
+	return dependencies, dependent, nil
+}
+
+// readDeprecation reads a x-deprecated extension: true, false or a message
+func readDeprecation(ext spec.Extensions) (*GenDeprecation, error) {
+	value, ok := ext[xDeprecated]
+	if !ok {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return &GenDeprecation{}, nil
+		}
+		return nil, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, errors.New("the message must not be empty")
+		}
+		return &GenDeprecation{Message: v}, nil
+	default:
+		return nil, fmt.Errorf("expected true or a message, got %v", value)
+	}
+}
+
+const (
+	csrfDoubleSubmit = "double-submit"
+	csrfHeader       = "header"
+)
+
+// operationCSRF reads the CSRF protection of an operation from the x-csrf extensions of the spec and of the operation:
+// true, false, or an object with the mode, the cookie and the header of the protection.
+// The extension of the spec protects the operations with an unsafe method, the one of an operation overrides it.
+func operationCSRF(name, method string, operation spec.Operation, root spec.Extensions) (*GenCSRF, error) {
+	csrf := &GenCSRF{Mode: csrfDoubleSubmit}
+	enabled := false
+	if value, ok := root[xCSRF]; ok {
+		on, err := readCSRF(value, csrf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s for the spec: %v", xCSRF, err)
+		}
+		switch strings.ToUpper(method) {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		default:
+			enabled = on
+		}
+	}
+	if value, ok := operation.Extensions[xCSRF]; ok {
+		on, err := readCSRF(value, csrf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s for operation %q: %v", xCSRF, name, err)
+		}
+		enabled = on
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	if csrf.Header == "" {
+		csrf.Header = "X-CSRF-Token"
+		if csrf.Mode == csrfHeader {
+			csrf.Header = "X-Requested-With"
+		}
+	}
+	if csrf.Cookie == "" && csrf.Mode == csrfDoubleSubmit {
+		csrf.Cookie = "csrf_token"
+	}
+	return csrf, nil
+}
+
+// readCSRF reads the value of a x-csrf extension into a protection, and tells if it is enabled
+func readCSRF(value interface{}, csrf *GenCSRF) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case map[string]interface{}:
+		for key, raw := range v {
+			str, ok := raw.(string)
+			if !ok || str == "" {
+				return false, fmt.Errorf("%s must be a non empty string", key)
+			}
+			switch key {
+			case "mode":
+				if str != csrfDoubleSubmit && str != csrfHeader {
+					return false, fmt.Errorf("the mode must be %s or %s, got %q", csrfDoubleSubmit, csrfHeader, str)
+				}
+				csrf.Mode = str
+			case "cookie":
+				csrf.Cookie = str
+			case "header":
+				csrf.Header = str
+			default:
+				return false, fmt.Errorf("unknown key %s", key)
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("expected true, false or an object with the mode, the cookie and the header of the protection")
+	}
+}
+
+// makePagination reads the x-pagination extension of an operation, which names the query parameters
+// used to paginate its results: either limit and offset, or cursor and an optional limit
+func makePagination(name string, operation spec.Operation, queryParams GenParameters) (*GenPagination, error) {
+	value, ok := operation.Extensions[xPagination]
+	if !ok {
+		return nil, nil
+	}
+	ext, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid %s for operation %q: expected an object naming the limit, offset or cursor query parameters", xPagination, name)
+	}
+
+	param := func(key string, types ...string) (*GenParameter, error) {
+		raw, ok := ext[key]
+		if !ok {
+			return nil, nil
+		}
+		paramName, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s for operation %q: %s must be the name of a query parameter", xPagination, name, key)
+		}
+		for i := range queryParams {
+			p := &queryParams[i]
+			if p.Name != paramName {
+				continue
+			}
+			if p.IsArray || !containsString(types, p.GoType) {
+				return nil, fmt.Errorf("invalid %s for operation %q: the %s parameter %q must be of type %s", xPagination, name, key, paramName, strings.Join(types, " or "))
+			}
+			return p, nil
+		}
+		return nil, fmt.Errorf("invalid %s for operation %q: no query parameter named %q for %s", xPagination, name, paramName, key)
+	}
+
+	var pagination GenPagination
+	var err error
+	if pagination.Limit, err = param("limit", "int32", "int64"); err != nil {
+		return nil, err
+	}
+	if pagination.Offset, err = param("offset", "int32", "int64"); err != nil {
+		return nil, err
+	}
+	if pagination.Cursor, err = param("cursor", "string"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pagination.Offset != nil && pagination.Cursor != nil:
+		return nil, fmt.Errorf("invalid %s for operation %q: offset and cursor can't be used together", xPagination, name)
+	case pagination.Offset != nil && pagination.Limit == nil:
+		return nil, fmt.Errorf("invalid %s for operation %q: an offset needs a limit", xPagination, name)
+	case pagination.Offset == nil && pagination.Cursor == nil:
+		return nil, fmt.Errorf("invalid %s for operation %q: either an offset or a cursor is required", xPagination, name)
+	}
+	return &pagination, nil
+}
+
+// makePolling finds the 202 Accepted response of an operation with a Location header,
+// which the client polls until the operation completes with one of its other success responses
+func makePolling(successResponses []GenResponse, hasStreamingResponse bool) *GenPolling {
+	if hasStreamingResponse {
+		return nil
+	}
+	var polling *GenPolling
+	completes := false
+	for _, sr := range successResponses {
+		if sr.Code != http.StatusAccepted {
+			completes = true
+			continue
+		}
+		for _, header := range sr.Headers {
+			switch {
+			case strings.EqualFold(header.Name, "Location"):
+				if polling == nil {
+					polling = &GenPolling{Response: sr.Name}
+				}
+				polling.Location = header.Name
+			case strings.EqualFold(header.Name, "Retry-After"):
+				if polling == nil {
+					polling = &GenPolling{Response: sr.Name}
+				}
+				polling.RetryAfter = header.Name
+			}
+		}
+	}
+	if polling == nil || polling.Location == "" || !completes {
+		return nil
+	}
+	return polling
+}
+
+// linkCreatedLocations finds the operation serving the resources created by the operations with a 201 Created response
+// and a Location header of type string: the GET operation of the same package, on the path of the creation followed by
+// a path parameter, e.g. GET /tasks/{id} for POST /tasks
+func linkCreatedLocations(ops GenOperations) {
+	for i := range ops {
+		op := &ops[i]
+		for _, sr := range op.SuccessResponses {
+			if sr.Code != http.StatusCreated {
+				continue
+			}
+			for _, header := range sr.Headers {
+				if !strings.EqualFold(header.Name, "Location") || header.GoType != "string" {
+					continue
+				}
+				prefix := strings.TrimSuffix(op.Path, "/") + "/{"
+				for _, get := range ops {
+					if get.Method != http.MethodGet || get.Package != op.Package {
+						continue
+					}
+					if !strings.HasPrefix(get.Path, prefix) || !strings.HasSuffix(get.Path, "}") ||
+						strings.ContainsAny(get.Path[len(prefix):len(get.Path)-1], "/{}") {
+						continue
+					}
+					// the arguments of the helper follow the order of the path
+					pathParams := append(GenParameters(nil), get.PathParams...)
+					path := get.Path
+					sort.SliceStable(pathParams, func(i, j int) bool {
+						return strings.Index(path, "{"+pathParams[i].Name+"}") < strings.Index(path, "{"+pathParams[j].Name+"}")
+					})
+					op.CreatedLocation = &GenCreatedLocation{
+						Response:   sr.Name,
+						Location:   header.Name,
+						Operation:  get.Name,
+						PathParams: pathParams,
+					}
+				}
+			}
+		}
+	}
+}
+
+// withLinkHeader adds a Link header to a response of a paginated operation, for the links to the other pages
+func (b *codeGenOpBuilder) withLinkHeader(resp spec.Response) (spec.Response, error) {
+	if resp.Ref.String() != "" {
+		resolved, err := spec.ResolveResponse(b.Doc.Spec(), resp.Ref)
+		if err != nil {
+			return resp, err
+		}
+		if resolved == nil {
+			return resp, fmt.Errorf("could not resolve response ref: %s", resp.Ref.String())
+		}
+		resp = *resolved
+	}
+	for hName := range resp.Headers {
+		if strings.EqualFold(hName, "Link") {
+			return resp, nil
+		}
+	}
+
+	// the headers are copied, the response may be shared with other operations
+	headers := make(map[string]spec.Header, len(resp.Headers)+1)
+	for k, v := range resp.Headers {
+		headers[k] = v
+	}
+	headers["Link"] = *spec.ResponseHeader().Typed(str, "").WithDescription("The links to the other pages of the results (RFC 5988)")
+	resp.Headers = headers
+	return resp, nil
+}
+
 func producesOrDefault(produces []string, fallback []string, defaultProduces string) []string {
 	if len(produces) > 0 {
 		return produces
@@ -773,6 +1261,11 @@ func (b *codeGenOpBuilder) MakeParameterItem(receiver, paramName, indexVar, path
 	res.Converter = stringConverters[res.GoType]
 	res.Formatter = stringFormatters[res.GoType]
 	res.IndexVar = indexVar
+	sanitizers, err := b.makeSanitizers(paramName, items.Extensions, items.Format)
+	if err != nil {
+		return GenItems{}, err
+	}
+	res.Sanitizers = sanitizers
 	hasNumberValidation := items.Maximum != nil || items.Minimum != nil || items.MultipleOf != nil
 	hasStringValidation := items.MaxLength != nil || items.MinLength != nil || items.Pattern != ""
 	hasSliceValidations := items.MaxItems != nil || items.MinItems != nil || items.UniqueItems
@@ -792,6 +1285,67 @@ func (b *codeGenOpBuilder) MakeParameterItem(receiver, paramName, indexVar, path
 	return res, nil
 }
 
+// builtinSanitizers are the sanitizers of x-go-sanitize known by their name
+var builtinSanitizers = map[string]GenSanitizer{
+	"trim":      {Call: "strings.TrimSpace"},
+	"lowercase": {Call: "strings.ToLower"},
+	"uppercase": {Call: "strings.ToUpper"},
+	"nfc":       {Call: "norm.NFC.String", Alias: "norm", Import: "golang.org/x/text/unicode/norm"},
+	"nfkc":      {Call: "norm.NFKC.String", Alias: "norm", Import: "golang.org/x/text/unicode/norm"},
+}
+
+// makeSanitizers reads the sanitizers of a parameter from its x-go-sanitize extension,
+// or from the one of the spec for its format when the parameter doesn't have any.
+// A sanitizer is either builtin or a func(string) string qualified with its import path, like github.com/acme/text.Slugify
+func (b *codeGenOpBuilder) makeSanitizers(name string, extensions spec.Extensions, format string) ([]GenSanitizer, error) {
+	value, ok := extensions[xGoSanitize]
+	if !ok && format != "" {
+		if formats, found := b.Doc.Spec().Extensions[xGoSanitize]; found {
+			byFormat, isMap := formats.(map[string]interface{})
+			if !isMap {
+				return nil, fmt.Errorf("invalid %s for the spec: expected the sanitizers by format, got %v", xGoSanitize, formats)
+			}
+			value, ok = byFormat[format]
+		}
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = []string{v}
+	case []interface{}:
+		for _, n := range v {
+			str, isStr := n.(string)
+			if !isStr {
+				return nil, fmt.Errorf("invalid %s for parameter %q of operation %q: expected the names of the sanitizers, got %v", xGoSanitize, name, b.Name, n)
+			}
+			names = append(names, str)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s for parameter %q of operation %q: expected the names of the sanitizers, got %v", xGoSanitize, name, b.Name, value)
+	}
+
+	result := make([]GenSanitizer, 0, len(names))
+	for _, n := range names {
+		if s, isBuiltin := builtinSanitizers[n]; isBuiltin {
+			s.Name = n
+			result = append(result, s)
+			continue
+		}
+		dot := strings.LastIndex(n, ".")
+		if dot <= 0 || dot == len(n)-1 || strings.LastIndex(n, "/") > dot {
+			return nil, fmt.Errorf("invalid %s for parameter %q of operation %q: %q is neither a builtin sanitizer nor a qualified function", xGoSanitize, name, b.Name, n)
+		}
+		pkg := n[:dot]
+		alias := "sanitize" + swag.ToGoName(path.Base(pkg))
+		result = append(result, GenSanitizer{Name: n, Call: alias + "." + n[dot+1:], Alias: alias, Import: pkg})
+	}
+	return result, nil
+}
+
 func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver, param spec.Parameter, idMapping map[string]map[string]string) (GenParameter, error) {
 	if Debug {
 		log.Printf("[%s %s] making parameter %q", b.Method, b.Path, param.Name)
@@ -807,6 +1361,13 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		}
 		param = *param2
 	}
+	if err := checkParameter(param); err != nil {
+		return GenParameter{}, fmt.Errorf("operation %q: %v", b.Name, err)
+	}
+	deprecation, err := readDeprecation(param.Extensions)
+	if err != nil {
+		return GenParameter{}, fmt.Errorf("operation %q: invalid %s for parameter %q: %v", b.Name, xDeprecated, param.Name, err)
+	}
 
 	var child *GenItems
 	id := swag.ToGoName(param.Name)
@@ -829,6 +1390,7 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		Child:            child,
 		Location:         param.In,
 		AllowEmptyValue:  (param.In == "query" || param.In == "formData") && param.AllowEmptyValue,
+		Deprecation:      deprecation,
 		Extensions:       param.Extensions,
 	}
 
@@ -864,6 +1426,13 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 			return GenParameter{}, err
 		}
 
+		for k, v := range sc.ExtraSchemas {
+			if b.ExtraSchemas == nil {
+				b.ExtraSchemas = make(map[string]GenSchema)
+			}
+			b.ExtraSchemas[k] = v
+		}
+
 		schema := sc.GenSchema
 		if named {
 			if b.ExtraSchemas == nil {
@@ -876,22 +1445,23 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 			nm := schema.Name
 			schema.GoType = nm
 			schema.IsAnonymous = false
-			if len(schema.Properties) > 0 {
+			// an object without properties is still worth a type when it's composed with allOf
+			hasType := len(schema.Properties) > 0 || len(schema.AllOf) > 0
+			if hasType {
 				if b.ExtraSchemas == nil {
 					b.ExtraSchemas = make(map[string]GenSchema)
 				}
 				b.ExtraSchemas[nm] = schema
 			}
-			prevSchema := schema
 			schema = GenSchema{}
 			schema.IsAnonymous = false
 			schema.GoType = nm
 			schema.SwaggerType = nm
-			if len(prevSchema.Properties) == 0 {
+			if !hasType {
 				schema.GoType = iface
 			}
 			schema.IsComplexObject = true
-			schema.IsInterface = len(schema.Properties) == 0
+			schema.IsInterface = !hasType
 		}
 		res.Schema = &schema
 		it := res.Schema.Items
@@ -943,6 +1513,11 @@ func (b *codeGenOpBuilder) MakeParameter(receiver string, resolver *typeResolver
 		}
 		res.IsNullable = !param.Required && !param.AllowEmptyValue
 
+		sanitizers, err := b.makeSanitizers(param.Name, param.Extensions, param.Format)
+		if err != nil {
+			return GenParameter{}, err
+		}
+		res.Sanitizers = sanitizers
 	}
 
 	hasNumberValidation := param.Maximum != nil || param.Minimum != nil || param.MultipleOf != nil
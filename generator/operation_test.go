@@ -23,11 +23,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUniqueOperationNames(t *testing.T) {
@@ -150,7 +152,7 @@ func TestMakeResponse_WithAllOfSchema(t *testing.T) {
 				if assert.NotEmpty(t, body.Properties) {
 					prop := body.Properties[0]
 					assert.Equal(t, "data", prop.Name)
-					assert.Equal(t, "[]*models.DataItems0", prop.GoType)
+					assert.Equal(t, "[]*DataItems0", prop.GoType)
 				}
 				items := b.ExtraSchemas["DataItems0"]
 				if assert.NotEmpty(t, items.AllOf) {
@@ -582,6 +584,7 @@ func TestGenClientIssue890_ValidationTrueFlatteningTrue(t *testing.T) {
 	defer func() {
 		dr, _ := os.Getwd()
 		os.RemoveAll(dr+"/restapi/")
+		os.Remove(filepath.Join(dr, ManifestFile))
 	}()
 	opts := testGenOpts()
 	opts.Spec = "../fixtures/bugs/890/swagger.yaml"
@@ -639,6 +642,7 @@ func TestGenClientIssue890_ValidationFalseFlatteningTrue(t *testing.T) {
 	defer func() {
 		dr, _ := os.Getwd()
 		os.RemoveAll(dr+"/restapi/")
+		os.Remove(filepath.Join(dr, ManifestFile))
 	}()
 	opts := testGenOpts()
 	opts.Spec = "../fixtures/bugs/890/swagger.yaml"
@@ -681,6 +685,7 @@ func TestGenClientIssue890_ValidationFalseFlattenFalse(t *testing.T) {
 	defer func() {
 		dr, _ := os.Getwd()
 		os.RemoveAll(dr+"/restapi/")
+		os.Remove(filepath.Join(dr, ManifestFile))
 	}()
 	opts := testGenOpts()
 	opts.Spec = "../fixtures/bugs/890/swagger.yaml"
@@ -723,6 +728,7 @@ func TestGenClientIssue890_ValidationTrueFlattenFalse(t *testing.T) {
 	defer func() {
 		dr, _ := os.Getwd()
 		os.RemoveAll(dr+"/restapi/")
+		os.Remove(filepath.Join(dr, ManifestFile))
 	}()
 	opts := testGenOpts()
 	opts.Spec = "../fixtures/bugs/890/swagger.yaml"
@@ -732,3 +738,584 @@ func TestGenClientIssue890_ValidationTrueFlattenFalse(t *testing.T) {
 	// If this doesn't get resolved then there will be an error definitely.
 	assert.Error(t, GenerateClient("foo", nil, nil, &opts))
 }
+
+func TestGenOperation_Timeout(t *testing.T) {
+	b, err := opBuilder("generateReport", "../fixtures/codegen/x-timeout.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2*time.Second, op.Timeout)
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverOperation").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("generate_report.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "timeout := time.Duration(2000000000) // 2s, from x-timeout", res)
+					assertInCode(t, "ctx, cancel := context.WithTimeout(r.Context(), timeout)", res)
+					assertRegexpInCode(t, `r = r.WithContext\(ctx\)\s+if err := o.Context.BindValidRequest\(r, route, &Params\); err != nil {`, res)
+					assertInCode(t, "errors.New(http.StatusGatewayTimeout, \"operation generateReport timed out after %v\", timeout)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			err = templates.MustGet("clientParameter").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("generate_report_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "timeout: time.Duration(2000000000), // 2s, from x-timeout", res)
+					assertNotInCode(t, "cr.DefaultTimeout", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("getReport", "../fixtures/codegen/x-timeout.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Equal(t, 5*time.Second, op.Timeout)
+		}
+	}
+
+	b, err = opBuilder("deleteReport", "../fixtures/codegen/x-timeout.yml")
+	if assert.NoError(t, err) {
+		_, err := b.MakeOperation()
+		assert.Error(t, err)
+	}
+}
+
+func TestGenOperation_Pagination(t *testing.T) {
+	b, err := opBuilder("listBooks", "../fixtures/codegen/x-pagination.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			if assert.NotNil(t, op.Pagination) {
+				assert.Equal(t, "limit", op.Pagination.Limit.Name)
+				assert.Equal(t, "offset", op.Pagination.Offset.Name)
+				assert.Nil(t, op.Pagination.Cursor)
+			}
+			if assert.Len(t, op.Responses, 1) {
+				assert.Len(t, op.Responses[0].Headers, 1)
+				assert.Equal(t, "Link", op.Responses[0].Headers[0].Name)
+			}
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverUrlbuilder").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("list_books_urlbuilder.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func (o *ListBooksURL) NextPage() *ListBooksURL {", res)
+					assertInCode(t, "func (o *ListBooksURL) PrevPage() *ListBooksURL {", res)
+					assertInCode(t, "limit = 20", res)
+					assertInCode(t, "page.Offset = &offset", res)
+					assertNotInCode(t, "PageAt(", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			err = templates.MustGet("serverParameter").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("list_books_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func (o *ListBooksParams) PageURL() *ListBooksURL {", res)
+					assertInCode(t, "Shelf:  o.Shelf,", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			err = templates.MustGet("serverResponses").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("list_books_responses.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func (o *ListBooksOK) WithPageLink(page *ListBooksURL, rel string) *ListBooksOK {", res)
+					assertInCode(t, "link := fmt.Sprintf(\"<%s>; rel=%q\", page.String(), rel)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("listAuthors", "../fixtures/codegen/x-pagination.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			if assert.NotNil(t, op.Pagination) {
+				assert.Equal(t, "after", op.Pagination.Cursor.Name)
+				assert.Nil(t, op.Pagination.Offset)
+			}
+			// the link header declared in the spec is kept
+			if assert.Len(t, op.Responses, 1) && assert.Len(t, op.Responses[0].Headers, 1) {
+				assert.Equal(t, "links to the other pages", op.Responses[0].Headers[0].Description)
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("serverUrlbuilder").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts().LanguageOpts.FormatContent("list_authors_urlbuilder.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func (o *ListAuthorsURL) PageAt(cursor string) *ListAuthorsURL {", res)
+					assertNotInCode(t, "NextPage()", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("listTags", "../fixtures/codegen/x-pagination.yml")
+	if assert.NoError(t, err) {
+		_, err := b.MakeOperation()
+		assert.Error(t, err)
+	}
+}
+
+func TestGenOperation_InlineBodySchemas(t *testing.T) {
+	b, err := opBuilder("createThing", "../fixtures/codegen/inline-body-schemas.yml")
+	if assert.NoError(t, err) {
+		b.IncludeValidator = true
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			if assert.Len(t, op.Params, 1) {
+				assert.Equal(t, "CreateThingBody", op.Params[0].GoType)
+				assert.False(t, op.Params[0].Schema.IsInterface)
+			}
+			extra := make(map[string]GenSchema, len(op.ExtraSchemas))
+			for _, sch := range op.ExtraSchemas {
+				extra[sch.Name] = sch
+			}
+			body, ok := extra["CreateThingBody"]
+			if assert.True(t, ok) {
+				assert.Len(t, body.AllOf, 2)
+				assert.True(t, body.HasValidations)
+			}
+			assert.Contains(t, extra, "CreateThingCreatedBody")
+			assert.Contains(t, extra, "CreateThingDefaultBody")
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverOperation").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("create_thing.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type CreateThingBody struct {", res)
+					assertInCode(t, "func (o *CreateThingBody) Validate(formats strfmt.Registry) error {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("replaceThings", "../fixtures/codegen/inline-body-schemas.yml")
+	if assert.NoError(t, err) {
+		b.IncludeValidator = true
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			extra := make(map[string]GenSchema, len(op.ExtraSchemas))
+			for _, sch := range op.ExtraSchemas {
+				extra[sch.Name] = sch
+			}
+			if assert.Len(t, op.Params, 1) {
+				assert.Equal(t, "[]*ReplaceThingsParamsBodyItems0", op.Params[0].GoType)
+			}
+			assert.Contains(t, extra, "ReplaceThingsParamsBodyItems0")
+			if assert.Len(t, op.Responses, 1) {
+				assert.Equal(t, "[]*ReplaceThingsOKBodyItems0", op.Responses[0].Schema.GoType)
+			}
+			assert.Contains(t, extra, "ReplaceThingsOKBodyItems0")
+		}
+		// the types of inline schemas don't become definitions of the spec
+		assert.Len(t, b.Doc.Spec().Definitions, 1)
+	}
+}
+
+func TestGenOperation_PathItemParams(t *testing.T) {
+	b, err := opBuilder("getPet", "../fixtures/codegen/path-item-params.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			params := make(map[string]GenParameter, len(op.Params))
+			for _, p := range op.Params {
+				params[p.Location+"#"+p.Name] = p
+			}
+			assert.Len(t, params, 4)
+			assert.Contains(t, params, "path#id")
+			assert.Contains(t, params, "header#X-Request-Id")
+			if assert.Contains(t, params, "query#limit") {
+				// the operation overrides the parameter of the path item
+				assert.Equal(t, "int64", params["query#limit"].GoType)
+				assert.EqualValues(t, 10, *params["query#limit"].Maximum)
+			}
+		}
+
+		// the same name in two locations gets the same names on every generation
+		for i := 0; i < 10; i++ {
+			op, err := b.MakeOperation()
+			if assert.NoError(t, err) {
+				for _, p := range op.Params {
+					if p.Name == "id" {
+						assert.Equal(t, map[string]string{"path": "ID", "query": "QueryID"}[p.Location], p.ID)
+					}
+				}
+			}
+		}
+	}
+
+	// an operation without parameters gets the ones of the path item
+	b, err = opBuilder("deletePet", "../fixtures/codegen/path-item-params.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.Len(t, op.Params, 3) {
+			for _, p := range op.Params {
+				if p.Name == "limit" {
+					assert.Equal(t, "int32", p.GoType)
+				}
+			}
+		}
+	}
+}
+
+func TestGenOperation_WrongParams(t *testing.T) {
+	for name, expected := range map[string]string{
+		"bodyWithoutSchema": `operation "bodyWithoutSchema": body parameter "pet" has no schema`,
+		"queryWithSchema":   `operation "queryWithSchema": query parameter "filter" has a schema, only body parameters have one: use a type`,
+		"headerWithoutType": `operation "headerWithoutType": header parameter "X-Trace" has no type`,
+		"fileInQuery":       `operation "fileInQuery": query parameter "photo" has the file type, which only formData parameters may have`,
+	} {
+		b, err := opBuilder(name, "../fixtures/codegen/wrong-params.yml")
+		if assert.NoError(t, err) {
+			_, err := b.MakeOperation()
+			if assert.Error(t, err, name) {
+				assert.Equal(t, expected, err.Error())
+			}
+		}
+	}
+}
+
+func TestGenOperation_Polling(t *testing.T) {
+	for _, name := range []string{"createExport", "getExport", "deleteArchives"} {
+		b, err := opBuilder(name, "../fixtures/codegen/todolist.polling.yml")
+		if !assert.NoError(t, err) {
+			continue
+		}
+		op, err := b.MakeOperation()
+		if !assert.NoError(t, err) {
+			continue
+		}
+		switch name {
+		case "createExport":
+			if assert.NotNil(t, op.Polling) {
+				assert.Equal(t, "createExportAccepted", op.Polling.Response)
+				assert.Equal(t, "Location", op.Polling.Location)
+				assert.Equal(t, "Retry-After", op.Polling.RetryAfter)
+			}
+		case "getExport":
+			if assert.NotNil(t, op.Polling) {
+				assert.Empty(t, op.Polling.RetryAfter)
+			}
+		case "deleteArchives":
+			// the 202 response has no location to poll
+			assert.Nil(t, op.Polling)
+		}
+	}
+
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen := testGenOpts()
+	gen.defaultsEnsured = false
+	gen.Spec = "../fixtures/codegen/todolist.polling.yml"
+	require.NoError(t, gen.EnsureDefaults(true))
+	appGen, err := newAppGenerator("exports", nil, nil, &gen)
+	require.NoError(t, err)
+	app, err := appGen.makeCodegenApp()
+	require.NoError(t, err)
+	require.Len(t, app.OperationGroups, 1)
+	group := app.OperationGroups[0]
+	assert.True(t, group.HasPolling)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("clientClient").Execute(buf, group))
+	ff, err := appGen.GenOpts.LanguageOpts.FormatContent("exports_client.go", buf.Bytes())
+	require.NoError(t, err, buf.String())
+	res := string(ff)
+	assertInCode(t, "CreateExportAndWait(params *CreateExportParams, authInfo runtime.ClientAuthInfoWriter, timeout time.Duration, opts ...ClientOption) (*CreateExportCreated, *CreateExportAccepted, error)\n", res)
+	assertInCode(t, "if err != nil || createExportAccepted == nil || createExportAccepted.Location == \"\" {", res)
+	assertInCode(t, "delay := pollDelay(fmt.Sprint(createExportAccepted.RetryAfter))", res)
+	assertInCode(t, "delay := pollDelay(\"\")", res)
+	assertInCode(t, "case *CreateExportCreated:\n\t\t\treturn value, nil, nil", res)
+	assertInCode(t, "case *CreateExportAccepted:\n\t\t\tlocation, delay = poll.location, pollDelay(poll.retryAfter)", res)
+	assertInCode(t, "type pollTransport struct {", res)
+	assertNotInCode(t, "DeleteArchivesAndWait", res)
+}
+
+func TestGenOperation_CSRF(t *testing.T) {
+	b, err := opBuilder("addTask", "../fixtures/codegen/todolist.csrf.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, op.CSRF) {
+			assert.True(t, op.CSRF.IsDoubleSubmit())
+			assert.Equal(t, "csrf_token", op.CSRF.Cookie)
+			assert.Equal(t, "X-CSRF-Token", op.CSRF.Header)
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverOperation").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("add_task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, `token := r.Header.Get("X-CSRF-Token")`, res)
+					assertInCode(t, `cookie, err := r.Cookie("csrf_token")`, res)
+					assertInCode(t, "subtle.ConstantTimeCompare([]byte(token), []byte(cookie.Value)) != 1", res)
+					assertInCode(t, `errors.New(http.StatusForbidden, "missing or invalid CSRF token")`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("deleteTask", "../fixtures/codegen/todolist.csrf.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, op.CSRF) {
+			assert.False(t, op.CSRF.IsDoubleSubmit())
+			assert.Equal(t, "X-Requested-With", op.CSRF.Header)
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverOperation").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("delete_task.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, `if r.Header.Get("X-Requested-With") == "" {`, res)
+					assertNotInCode(t, "subtle.ConstantTimeCompare", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("updateTask", "../fixtures/codegen/todolist.csrf.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, op.CSRF) {
+			assert.Equal(t, "XSRF-TOKEN", op.CSRF.Cookie)
+			assert.Equal(t, "X-XSRF-TOKEN", op.CSRF.Header)
+		}
+	}
+
+	for _, name := range []string{"listTasks", "login"} {
+		b, err = opBuilder(name, "../fixtures/codegen/todolist.csrf.yml")
+		if assert.NoError(t, err) {
+			op, err := b.MakeOperation()
+			if assert.NoError(t, err) {
+				assert.Nil(t, op.CSRF, name)
+			}
+		}
+	}
+
+	_, err = operationCSRF("addTask", "POST", spec.Operation{VendorExtensible: spec.VendorExtensible{Extensions: spec.Extensions{xCSRF: map[string]interface{}{"mode": "origin"}}}}, nil)
+	assert.Error(t, err)
+}
+
+func TestGenOperation_MaxBodySize(t *testing.T) {
+	for name, size := range map[string]int64{"addTask": 1024, "importTasks": 10000000, "listTasks": 64000} {
+		b, err := opBuilder(name, "../fixtures/codegen/todolist.limits.yml")
+		if assert.NoError(t, err) {
+			op, err := b.MakeOperation()
+			if assert.NoError(t, err) {
+				assert.Equal(t, size, op.MaxBodySize, name)
+			}
+		}
+	}
+
+	for _, value := range []interface{}{"-1", float64(0), "large", true} {
+		_, err := operationMaxBodySize("addTask", spec.Operation{VendorExtensible: spec.VendorExtensible{Extensions: spec.Extensions{xMaxBody: value}}}, nil)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestGenOperation_Deprecation(t *testing.T) {
+	b, err := opBuilder("listPets", "../fixtures/codegen/deprecation.yml")
+	if assert.NoError(t, err) {
+		b.GenOpts.WarnDeprecated = true
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, op.Deprecation) {
+			assert.Equal(t, "Use searchPets instead. It is removed after 2019-06-30.", op.Deprecation.Notice())
+			assert.Equal(t, "Sun, 30 Jun 2019 00:00:00 GMT", op.Deprecation.SunsetHeader())
+			for _, param := range op.Params {
+				if param.Name == "limit" {
+					assert.NotNil(t, param.Deprecation)
+				} else {
+					assert.Nil(t, param.Deprecation, param.Name)
+				}
+			}
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverOperation").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("list_pets.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "Deprecated: Use searchPets instead. It is removed after 2019-06-30.", res)
+					assertInCode(t, `rw.Header().Set("Warning", "299 - \"Deprecated: Use searchPets instead. It is removed after 2019-06-30.\"")`, res)
+					assertInCode(t, `rw.Header().Set("Sunset", "Sun, 30 Jun 2019 00:00:00 GMT")`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			err = templates.MustGet("serverParameter").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("list_pets_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					assertInCode(t, "Deprecated: Use pageSize instead.", string(ff))
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	// the headers are only set when asked
+	b, err = opBuilder("getPet", "../fixtures/codegen/deprecation.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, op.Deprecation) {
+			assert.Equal(t, "Do not use.", op.Deprecation.Notice())
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("serverOperation").Execute(buf, op)
+			if assert.NoError(t, err) {
+				assertInCode(t, "Deprecated: Do not use.", buf.String())
+				assertNotInCode(t, "rw.Header().Set(\"Warning\"", buf.String())
+			}
+		}
+	}
+
+	for _, ext := range []spec.Extensions{
+		{xDeprecated: float64(1)},
+		{xDeprecated: " "},
+		{xSunset: "2019-06-30"},
+		{xDeprecated: true, xSunset: "next year"},
+	} {
+		_, err := operationDeprecation("listPets", spec.Operation{VendorExtensible: spec.VendorExtensible{Extensions: ext}})
+		assert.Error(t, err, "%v", ext)
+	}
+}
+
+func TestGenOperation_ParamDependencies(t *testing.T) {
+	b, err := opBuilder("importPets", "../fixtures/codegen/param-dependencies.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Equal(t, []GenParamDependency{
+				{Names: []string{"data", "url"}},
+				{Names: []string{"X-Owner", "owner"}},
+				{Exclusive: true, Names: []string{"data", "url"}},
+			}, op.Dependencies)
+			assert.Len(t, op.DependentParams, 4)
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverParameter").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("import_pets_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "res = append(res, o.validateDependencies(r)...)", res)
+					assertInCode(t, `"X-Owner": r.Header.Get("X-Owner") != "",`, res)
+					assertInCode(t, `"data":    r.MultipartForm != nil && len(r.MultipartForm.File["data"]) > 0,`, res)
+					assertInCode(t, `"url":     r.PostForm.Get("url") != "",`, res)
+					assertInCode(t, `if len(given("X-Owner", "owner")) == 0 {`, res)
+					assertInCode(t, `errors.New(http.StatusUnprocessableEntity, "one of the parameters %s is required", "X-Owner, owner")`, res)
+					assertInCode(t, `if found := given("data", "url"); len(found) > 1 {`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	b, err = opBuilder("updatePet", "../fixtures/codegen/param-dependencies.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			err := templates.MustGet("serverParameter").Execute(buf, op)
+			if assert.NoError(t, err) {
+				assertInCode(t, `"pet": runtime.HasBody(r)`, buf.String())
+				assertInCode(t, `"name": r.URL.Query().Get("name") != ""`, buf.String())
+			}
+		}
+	}
+
+	params := map[string]spec.Parameter{
+		"id":   *spec.QueryParam("id").Typed("integer", ""),
+		"name": *spec.QueryParam("name").Typed("string", ""),
+	}
+	for _, ext := range []spec.Extensions{
+		{xRequires: "id"},
+		{xRequires: []interface{}{"id"}},
+		{xRequires: []interface{}{"id", "owner"}},
+		{xMutuallyExclusive: []interface{}{[]interface{}{"id", "name"}, "name"}},
+	} {
+		_, _, err := operationDependencies("findPets", spec.Operation{VendorExtensible: spec.VendorExtensible{Extensions: ext}}, params, nil)
+		assert.Error(t, err, "%v", ext)
+	}
+}
+
+func TestGenOperation_Sanitizers(t *testing.T) {
+	b, err := opBuilder("listTasks", "../fixtures/codegen/todolist.sanitize.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "golang.org/x/text/unicode/norm", op.Imports["norm"])
+			assert.Equal(t, "html", op.Imports["sanitizeHTML"])
+
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverParameter").Execute(buf, op)
+			if assert.NoError(t, err) {
+				ff, err := opts.LanguageOpts.FormatContent("list_tasks_parameters.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertRegexpInCode(t, `raw = strings.TrimSpace\(raw\)\s+// trim, from x-go-sanitize\s+raw = strings.ToLower\(raw\)\s+// lowercase, from x-go-sanitize\s+if raw == ""`, res)
+					assertRegexpInCode(t, `raw = norm.NFC.String\(raw\)\s+// nfc, from x-go-sanitize`, res)
+					assertInCode(t, "raw = sanitizeHTML.EscapeString(raw) // html.EscapeString, from x-go-sanitize", res)
+					assertRegexpInCode(t, `tagsIV = strings.ToLower\(tagsIV\)\s+// lowercase, from x-go-sanitize`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	for _, value := range []interface{}{"rot13", "strings.", 42} {
+		_, err := b.makeSanitizers("title", spec.Extensions{xGoSanitize: value}, "")
+		assert.Error(t, err, value)
+	}
+}
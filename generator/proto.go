@@ -0,0 +1,732 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+const (
+	protoEmpty      = "google.protobuf.Empty"
+	protoValue      = "google.protobuf.Value"
+	protoListValue  = "google.protobuf.ListValue"
+	protoTimestamp  = "google.protobuf.Timestamp"
+	protoAnnotation = "google/api/annotations.proto"
+)
+
+// the files to import for the well known types
+var protoImports = map[string]string{
+	protoEmpty:     "google/protobuf/empty.proto",
+	protoValue:     "google/protobuf/struct.proto",
+	protoListValue: "google/protobuf/struct.proto",
+	protoTimestamp: "google/protobuf/timestamp.proto",
+}
+
+// the order in which the methods of a path are turned into rpcs
+var protoVerbs = []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH"}
+
+// the order in which parameters become fields of a request message
+var protoParamLocations = map[string]int{"path": 0, "query": 1, "header": 2, "formData": 3, "body": 4}
+
+// GenerateProto writes a proto3 file with a message for each definition of the spec
+// and a gRPC service with a method, annotated with its http rule, for each operation.
+//
+// The constructs which have no proto3 equivalent are logged and flagged with a comment in the file.
+func GenerateProto(fileName, pkg string, opts *GenOpts) error {
+	if opts == nil {
+		return errors.New("gen opts are required")
+	}
+	if err := opts.EnsureDefaults(false); err != nil {
+		return err
+	}
+
+	var err error
+	var specDoc *loads.Document
+	opts.Spec, specDoc, err = loadSpec(opts.Spec)
+	if err != nil {
+		return err
+	}
+
+	specDoc, err = validateAndFlattenSpec(opts, specDoc)
+	if err != nil {
+		return err
+	}
+
+	proto := makeProto(specDoc.Spec(), pkg)
+	for _, msg := range proto.Unmapped {
+		log.Printf("warning: %s", msg)
+	}
+
+	if err := os.MkdirAll(opts.Target, 0755); err != nil {
+		return err
+	}
+	if fileName == "" {
+		fileName = proto.Package + ".proto"
+	}
+	fileName = filepath.Join(opts.Target, fileName)
+	if err := ioutil.WriteFile(fileName, proto.Render(), 0644); err != nil {
+		return err
+	}
+	log.Printf("wrote the proto definitions to %s", fileName)
+	return nil
+}
+
+type protoFile struct {
+	Package  string
+	Service  string
+	Imports  []string
+	Enums    []protoEnum
+	Messages []protoMessage
+	Methods  []protoMethod
+	Unmapped []string
+}
+
+type protoMessage struct {
+	Name        string
+	Description string
+	Fields      []protoField
+	OneOf       []protoField
+	Enums       []protoEnum
+	Messages    []protoMessage
+	Unmapped    []string
+}
+
+type protoField struct {
+	Name     string
+	Type     string
+	Repeated bool
+	Number   int
+	Unmapped string
+}
+
+type protoEnum struct {
+	Name        string
+	Description string
+	Values      []string
+}
+
+type protoMethod struct {
+	Name        string
+	Description string
+	Request     string
+	Response    string
+	Verb        string
+	Path        string
+	Body        string
+}
+
+// protoBuilder maps a flattened spec to proto3
+type protoBuilder struct {
+	spec     *spec.Swagger
+	imports  map[string]bool
+	unmapped []string
+	// the definitions being resolved, to break cycles of aliases
+	resolving map[string]bool
+}
+
+// makeProto maps the definitions of a spec to messages and enums, and its operations to the methods of a service
+func makeProto(sw *spec.Swagger, pkg string) *protoFile {
+	b := &protoBuilder{
+		spec:      sw,
+		imports:   make(map[string]bool),
+		resolving: make(map[string]bool),
+	}
+
+	title := "api"
+	if sw.Info != nil && sw.Info.Title != "" {
+		title = sw.Info.Title
+	}
+	if pkg == "" {
+		pkg = swag.ToFileName(title)
+	}
+	result := &protoFile{
+		Package: pkg,
+		Service: swag.ToGoName(title),
+	}
+
+	names := make([]string, 0, len(sw.Definitions))
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		schema := sw.Definitions[name]
+		path := "definition " + name
+		switch {
+		case isProtoEnum(&schema):
+			result.Enums = append(result.Enums, b.buildEnum(name, &schema))
+		case isProtoMessage(&schema):
+			result.Messages = append(result.Messages, b.buildMessage(path, swag.ToGoName(name), &schema))
+		}
+		// the other definitions alias a scalar or an array: they are inlined where they are used
+	}
+
+	result.Methods, result.Messages = b.buildMethods(result.Messages)
+
+	for imp := range b.imports {
+		result.Imports = append(result.Imports, imp)
+	}
+	sort.Strings(result.Imports)
+	result.Unmapped = b.unmapped
+	return result
+}
+
+func (b *protoBuilder) flag(format string, args ...interface{}) string {
+	msg := fmt.Sprintf(format, args...)
+	b.unmapped = append(b.unmapped, msg)
+	return msg
+}
+
+func (b *protoBuilder) use(tpe string) string {
+	if imp, ok := protoImports[tpe]; ok {
+		b.imports[imp] = true
+	}
+	return tpe
+}
+
+func isProtoEnum(schema *spec.Schema) bool {
+	return len(schema.Enum) > 0 && schema.Type.Contains("string")
+}
+
+func isProtoMessage(schema *spec.Schema) bool {
+	if schema.Ref.String() != "" || isProtoEnum(schema) {
+		return false
+	}
+	if len(schema.Properties) > 0 || len(schema.AllOf) > 0 {
+		return true
+	}
+	if _, ok := schema.Extensions[xOneOf]; ok {
+		return true
+	}
+	return schema.Type.Contains("object") && schema.AdditionalProperties == nil
+}
+
+func isProtoMap(schema *spec.Schema) bool {
+	return schema.Ref.String() == "" && len(schema.Properties) == 0 && len(schema.AllOf) == 0 && schema.AdditionalProperties != nil
+}
+
+func (b *protoBuilder) buildEnum(name string, schema *spec.Schema) protoEnum {
+	enumName := swag.ToGoName(name)
+	prefix := strings.ToUpper(swag.ToFileName(enumName))
+	result := protoEnum{
+		Name:        enumName,
+		Description: schemaDescription(schema),
+		// proto3 enums default to their first value, which must be 0
+		Values: []string{prefix + "_UNSPECIFIED"},
+	}
+	for _, value := range schema.Enum {
+		result.Values = append(result.Values, prefix+"_"+strings.ToUpper(swag.ToFileName(fmt.Sprintf("%v", value))))
+	}
+	return result
+}
+
+func schemaDescription(schema *spec.Schema) string {
+	if schema.Description != "" {
+		return schema.Description
+	}
+	return schema.Title
+}
+
+// buildMessage maps an object schema to a message, the properties of an allOf are merged in a single message
+func (b *protoBuilder) buildMessage(path, name string, schema *spec.Schema) protoMessage {
+	result := protoMessage{
+		Name:        name,
+		Description: schemaDescription(schema),
+	}
+
+	if oneOf, ok := schema.Extensions[xOneOf]; ok {
+		b.buildOneOf(path, &result, oneOf)
+		return result
+	}
+
+	properties := make(map[string]spec.Schema)
+	b.collectProperties(path, schema, properties, &result)
+	if schema.AdditionalProperties != nil && len(properties) > 0 {
+		result.Unmapped = append(result.Unmapped, b.flag("%s: additional properties next to properties can't be mapped, they are dropped", path))
+	}
+
+	names := make([]string, 0, len(properties))
+	for propName := range properties {
+		names = append(names, propName)
+	}
+	sort.Strings(names)
+	for i, propName := range names {
+		prop := properties[propName]
+		field := b.buildField(path+"."+propName, propName, &prop, &result)
+		field.Number = i + 1
+		result.Fields = append(result.Fields, field)
+	}
+	return result
+}
+
+// collectProperties gathers the properties of a schema and of the schemas it is composed of
+func (b *protoBuilder) collectProperties(path string, schema *spec.Schema, properties map[string]spec.Schema, msg *protoMessage) {
+	if schema.Discriminator != "" {
+		msg.Unmapped = append(msg.Unmapped, b.flag("%s: polymorphism with a discriminator can't be mapped, only the properties of the base type are kept", path))
+	}
+	for name, prop := range schema.Properties {
+		properties[name] = prop
+	}
+	for i := range schema.AllOf {
+		part := &schema.AllOf[i]
+		if ref := part.Ref.String(); ref != "" {
+			target, ok := b.definition(ref)
+			if !ok {
+				msg.Unmapped = append(msg.Unmapped, b.flag("%s: allOf member %q can't be resolved", path, ref))
+				continue
+			}
+			part = target
+		}
+		b.collectProperties(path, part, properties, msg)
+	}
+}
+
+func (b *protoBuilder) buildOneOf(path string, msg *protoMessage, value interface{}) {
+	members, ok := value.([]interface{})
+	if !ok {
+		msg.Unmapped = append(msg.Unmapped, b.flag("%s: %s must be a list of schemas", path, xOneOf))
+		return
+	}
+	for i, member := range members {
+		m, ok := member.(map[string]interface{})
+		if !ok {
+			msg.Unmapped = append(msg.Unmapped, b.flag("%s: %s must be a list of schemas", path, xOneOf))
+			continue
+		}
+		var schema spec.Schema
+		name := "option_" + strconv.Itoa(i+1)
+		if ref, ok := m["$ref"].(string); ok {
+			schema.Ref = spec.MustCreateRef(ref)
+			name = swag.ToFileName(strings.TrimPrefix(ref, definitionsPointer))
+		} else if tpe, ok := m["type"].(string); ok {
+			schema.Type = spec.StringOrArray{tpe}
+			if format, ok := m["format"].(string); ok {
+				schema.Format = format
+			}
+		}
+		field := b.buildField(path+"."+name, name, &schema, msg)
+		if field.Repeated {
+			field.Unmapped = b.flag("%s.%s: the members of a oneof can't be repeated", path, name)
+			field.Type, field.Repeated = b.use(protoListValue), false
+		}
+		field.Number = i + 1
+		msg.OneOf = append(msg.OneOf, field)
+	}
+}
+
+// buildField maps a property to a field, inline objects and enums become nested in msg
+func (b *protoBuilder) buildField(path, name string, schema *spec.Schema, msg *protoMessage) protoField {
+	field := protoField{Name: swag.ToFileName(name)}
+	field.Type, field.Repeated, field.Unmapped = b.fieldType(path, name, schema, msg)
+	return field
+}
+
+func (b *protoBuilder) definition(ref string) (*spec.Schema, bool) {
+	if !strings.HasPrefix(ref, definitionsPointer) {
+		return nil, false
+	}
+	schema, ok := b.spec.Definitions[strings.TrimPrefix(ref, definitionsPointer)]
+	return &schema, ok
+}
+
+// fieldType resolves the type of a field for a schema, with the reason it couldn't be mapped when it couldn't
+func (b *protoBuilder) fieldType(path, name string, schema *spec.Schema, msg *protoMessage) (string, bool, string) {
+	if ref := schema.Ref.String(); ref != "" {
+		target, ok := b.definition(ref)
+		if !ok {
+			return b.use(protoValue), false, b.flag("%s: %q can't be resolved, only refs to definitions are supported: flatten the spec first", path, ref)
+		}
+		defName := strings.TrimPrefix(ref, definitionsPointer)
+		if isProtoEnum(target) || isProtoMessage(target) {
+			return swag.ToGoName(defName), false, ""
+		}
+		if b.resolving[defName] {
+			return b.use(protoValue), false, b.flag("%s: the definition %s refers to itself", path, defName)
+		}
+		// aliases of scalars, arrays and maps are inlined
+		b.resolving[defName] = true
+		defer delete(b.resolving, defName)
+		return b.fieldType(path, name, target, msg)
+	}
+
+	switch {
+	case isProtoEnum(schema):
+		enum := b.buildEnum(name, schema)
+		msg.Enums = append(msg.Enums, enum)
+		return enum.Name, false, ""
+
+	case isProtoMessage(schema):
+		nested := b.buildMessage(path, swag.ToGoName(name), schema)
+		msg.Messages = append(msg.Messages, nested)
+		return nested.Name, false, ""
+
+	case isProtoMap(schema):
+		value := schema.AdditionalProperties.Schema
+		if value == nil {
+			return "map<string, " + b.use(protoValue) + ">", false, ""
+		}
+		tpe, repeated, unmapped := b.fieldType(path+".additionalProperties", name+" value", value, msg)
+		if unmapped != "" {
+			return tpe, repeated, unmapped
+		}
+		if repeated || strings.HasPrefix(tpe, "map<") {
+			return b.use(protoValue), false, b.flag("%s: maps of arrays or maps can't be mapped", path)
+		}
+		return "map<string, " + tpe + ">", false, ""
+
+	case schema.Type.Contains("array"):
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return b.use(protoListValue), false, b.flag("%s: tuples and arrays without items can't be mapped", path)
+		}
+		tpe, repeated, unmapped := b.fieldType(path+".items", name+" item", schema.Items.Schema, msg)
+		if unmapped != "" {
+			return tpe, true, unmapped
+		}
+		if repeated || strings.HasPrefix(tpe, "map<") {
+			return b.use(protoListValue), true, b.flag("%s: arrays of arrays or maps can't be mapped", path)
+		}
+		return tpe, true, ""
+	}
+
+	if len(schema.Type) == 0 {
+		return b.use(protoValue), false, ""
+	}
+	if len(schema.Enum) > 0 {
+		return b.scalarType(schema.Type[0], schema.Format), false, b.flag("%s: only enums of strings can be mapped to proto enums, the values aren't enforced", path)
+	}
+	return b.scalarType(schema.Type[0], schema.Format), false, ""
+}
+
+func (b *protoBuilder) scalarType(tpe, format string) string {
+	switch tpe {
+	case "integer":
+		switch format {
+		case "int32", "int8", "int16":
+			return "int32"
+		case "uint32", "uint8", "uint16":
+			return "uint32"
+		case "uint64", "uint":
+			return "uint64"
+		}
+		return "int64"
+	case "number":
+		if format == "float" {
+			return "float"
+		}
+		return "double"
+	case "boolean":
+		return "bool"
+	case "file":
+		return "bytes"
+	case "string":
+		switch format {
+		case "byte", "binary":
+			return "bytes"
+		case "date-time":
+			return b.use(protoTimestamp)
+		}
+		return "string"
+	}
+	return b.use(protoValue)
+}
+
+// buildMethods makes an rpc for each operation, the request and response messages are added to messages
+func (b *protoBuilder) buildMethods(messages []protoMessage) ([]protoMethod, []protoMessage) {
+	an := analysis.New(b.spec)
+	operations := an.Operations()
+	paths := make([]string, 0, len(b.spec.Paths.Paths))
+	for path := range b.spec.Paths.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	basePath := strings.TrimSuffix(b.spec.BasePath, "/")
+	var methods []protoMethod
+	for _, path := range paths {
+		for _, verb := range protoVerbs {
+			op, ok := operations[verb][path]
+			if !ok {
+				continue
+			}
+			name := swag.ToGoName(op.ID)
+			if op.ID == "" {
+				name = swag.ToGoName(strings.ToLower(verb) + " " + path)
+			}
+			method := protoMethod{
+				Name:        name,
+				Description: op.Summary,
+				Verb:        strings.ToLower(verb),
+			}
+			if method.Description == "" {
+				method.Description = op.Description
+			}
+
+			var request *protoMessage
+			method.Request, method.Body, request = b.buildRequest(name, an.ParamsFor(verb, path))
+			if request != nil {
+				messages = append(messages, *request)
+			}
+			var response *protoMessage
+			method.Response, response = b.buildResponse(name, op)
+			if response != nil {
+				messages = append(messages, *response)
+			}
+
+			// the http rule binds the path parameters to fields with their proto names
+			method.Path = basePath + path
+			for _, param := range an.ParamsFor(verb, path) {
+				if param.In == "path" {
+					method.Path = strings.Replace(method.Path, "{"+param.Name+"}", "{"+swag.ToFileName(param.Name)+"}", -1)
+				}
+			}
+			methods = append(methods, method)
+		}
+	}
+	return methods, messages
+}
+
+func (b *protoBuilder) buildRequest(name string, params map[string]spec.Parameter) (string, string, *protoMessage) {
+	if len(params) == 0 {
+		return b.use(protoEmpty), "", nil
+	}
+
+	sorted := make([]spec.Parameter, 0, len(params))
+	for _, param := range params {
+		sorted = append(sorted, param)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].In != sorted[j].In {
+			return protoParamLocations[sorted[i].In] < protoParamLocations[sorted[j].In]
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	msg := &protoMessage{Name: name + "Request"}
+	var body string
+	for _, param := range sorted {
+		path := "operation " + name + " parameter " + param.Name
+		var field protoField
+		switch param.In {
+		case "header":
+			msg.Unmapped = append(msg.Unmapped, b.flag("%s: http headers can't be bound to fields, send it as grpc metadata", path))
+			continue
+		case "body":
+			schema := param.Schema
+			if schema == nil {
+				schema = new(spec.Schema)
+			}
+			field = b.buildField(path, param.Name, schema, msg)
+			body = field.Name
+		default:
+			field = b.buildField(path, param.Name, paramSchema(&param.SimpleSchema), msg)
+			if param.In == "formData" {
+				msg.Unmapped = append(msg.Unmapped, b.flag("%s: form parameters are read from a json body", path))
+				body = "*"
+			}
+		}
+		field.Number = len(msg.Fields) + 1
+		msg.Fields = append(msg.Fields, field)
+	}
+	return msg.Name, body, msg
+}
+
+// paramSchema turns the simple schema of a non body parameter, or of its items, into a schema
+func paramSchema(simple *spec.SimpleSchema) *spec.Schema {
+	schema := new(spec.Schema)
+	schema.Typed(simple.Type, simple.Format)
+	if simple.Type == "array" {
+		if simple.Items == nil {
+			schema.Items = nil
+		} else {
+			schema.Items = &spec.SchemaOrArray{Schema: paramSchema(&simple.Items.SimpleSchema)}
+		}
+	}
+	return schema
+}
+
+// buildResponse picks the first success response of an operation, or its default one, as the response of the rpc
+func (b *protoBuilder) buildResponse(name string, op *spec.Operation) (string, *protoMessage) {
+	if op.Responses == nil {
+		return b.use(protoEmpty), nil
+	}
+	var response *spec.Response
+	codes := make([]int, 0, len(op.Responses.StatusCodeResponses))
+	for code := range op.Responses.StatusCodeResponses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		if code >= 200 && code < 300 {
+			resp := op.Responses.StatusCodeResponses[code]
+			response = &resp
+			break
+		}
+	}
+	if response == nil {
+		response = op.Responses.Default
+	}
+	if response == nil {
+		return b.use(protoEmpty), nil
+	}
+	if ref := response.Ref.String(); ref != "" {
+		resolved, ok := b.spec.Responses[strings.TrimPrefix(ref, "#/responses/")]
+		if !ok {
+			b.flag("operation %s: the response %q can't be resolved", name, ref)
+			return b.use(protoEmpty), nil
+		}
+		response = &resolved
+	}
+	if response.Schema == nil {
+		return b.use(protoEmpty), nil
+	}
+
+	// a response with a message is returned as is, the others are wrapped
+	if ref := response.Schema.Ref.String(); ref != "" {
+		if target, ok := b.definition(ref); ok && isProtoMessage(target) {
+			return swag.ToGoName(strings.TrimPrefix(ref, definitionsPointer)), nil
+		}
+	}
+	msg := &protoMessage{Name: name + "Response"}
+	field := b.buildField("operation "+name+" response", "result", response.Schema, msg)
+	field.Number = 1
+	msg.Fields = append(msg.Fields, field)
+	return msg.Name, msg
+}
+
+// Render writes the proto file
+func (f *protoFile) Render() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go-swagger; DO NOT EDIT.\n\n")
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&buf, "package %s;\n", f.Package)
+
+	imports := f.Imports
+	if len(f.Methods) > 0 {
+		imports = append([]string{protoAnnotation}, imports...)
+	}
+	if len(imports) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, imp := range imports {
+		fmt.Fprintf(&buf, "import %q;\n", imp)
+	}
+
+	for _, enum := range f.Enums {
+		buf.WriteString("\n")
+		enum.render(&buf, "")
+	}
+	for _, msg := range f.Messages {
+		buf.WriteString("\n")
+		msg.render(&buf, "")
+	}
+
+	if len(f.Methods) > 0 {
+		buf.WriteString("\n")
+		fmt.Fprintf(&buf, "service %s {\n", f.Service)
+		for i, method := range f.Methods {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			method.render(&buf, "  ")
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.Bytes()
+}
+
+func renderComment(buf *bytes.Buffer, indent, text string) {
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fmt.Fprintf(buf, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+func (e *protoEnum) render(buf *bytes.Buffer, indent string) {
+	renderComment(buf, indent, e.Description)
+	fmt.Fprintf(buf, "%senum %s {\n", indent, e.Name)
+	for i, value := range e.Values {
+		fmt.Fprintf(buf, "%s  %s = %d;\n", indent, value, i)
+	}
+	fmt.Fprintf(buf, "%s}\n", indent)
+}
+
+func (m *protoMessage) render(buf *bytes.Buffer, indent string) {
+	renderComment(buf, indent, m.Description)
+	for _, msg := range m.Unmapped {
+		fmt.Fprintf(buf, "%s// unmapped: %s\n", indent, msg)
+	}
+	fmt.Fprintf(buf, "%smessage %s {\n", indent, m.Name)
+	for i := range m.Enums {
+		m.Enums[i].render(buf, indent+"  ")
+	}
+	for i := range m.Messages {
+		m.Messages[i].render(buf, indent+"  ")
+	}
+	for _, field := range m.Fields {
+		field.render(buf, indent+"  ")
+	}
+	if len(m.OneOf) > 0 {
+		fmt.Fprintf(buf, "%s  oneof value {\n", indent)
+		for _, field := range m.OneOf {
+			field.render(buf, indent+"    ")
+		}
+		fmt.Fprintf(buf, "%s  }\n", indent)
+	}
+	fmt.Fprintf(buf, "%s}\n", indent)
+}
+
+func (f *protoField) render(buf *bytes.Buffer, indent string) {
+	if f.Unmapped != "" {
+		fmt.Fprintf(buf, "%s// unmapped: %s\n", indent, f.Unmapped)
+	}
+	repeated := ""
+	if f.Repeated {
+		repeated = "repeated "
+	}
+	fmt.Fprintf(buf, "%s%s%s %s = %d;\n", indent, repeated, f.Type, f.Name, f.Number)
+}
+
+func (m *protoMethod) render(buf *bytes.Buffer, indent string) {
+	renderComment(buf, indent, m.Description)
+	fmt.Fprintf(buf, "%srpc %s(%s) returns (%s) {\n", indent, m.Name, m.Request, m.Response)
+	fmt.Fprintf(buf, "%s  option (google.api.http) = {\n", indent)
+	switch m.Verb {
+	case "get", "put", "post", "delete", "patch":
+		fmt.Fprintf(buf, "%s    %s: %q\n", indent, m.Verb, m.Path)
+	default:
+		fmt.Fprintf(buf, "%s    custom: { kind: %q path: %q }\n", indent, strings.ToUpper(m.Verb), m.Path)
+	}
+	if m.Body != "" {
+		fmt.Fprintf(buf, "%s    body: %q\n", indent, m.Body)
+	}
+	fmt.Fprintf(buf, "%s  };\n", indent)
+	fmt.Fprintf(buf, "%s}\n", indent)
+}
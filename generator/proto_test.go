@@ -0,0 +1,91 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProto_Messages(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/proto.yml")
+	require.NoError(t, err)
+
+	proto := makeProto(specDoc.Spec(), "")
+	assert.Equal(t, "pet_store", proto.Package)
+	assert.Equal(t, "PetStore", proto.Service)
+	res := string(proto.Render())
+
+	assert.Contains(t, res, "syntax = \"proto3\";\n")
+	assert.Contains(t, res, "import \"google/protobuf/timestamp.proto\";\n")
+	assert.Contains(t, res, "enum Status {\n  STATUS_UNSPECIFIED = 0;\n  STATUS_AVAILABLE = 1;\n  STATUS_SOLD_OUT = 2;\n}\n")
+	assert.Contains(t, res, "// a pet in the store\nmessage Pet {\n")
+	assert.Contains(t, res, "  enum Size {\n    SIZE_UNSPECIFIED = 0;\n")
+	assert.Contains(t, res, "  message Owner {\n    string name = 1;\n  }\n")
+	assert.Contains(t, res, "  map<string, string> attributes = 1;\n")
+	assert.Contains(t, res, "  google.protobuf.Timestamp born_at = 2;\n")
+	assert.Contains(t, res, "  int64 id = 4;\n")
+	assert.Contains(t, res, "  bytes photo = 7;\n")
+	assert.Contains(t, res, "  Status status = 9;\n")
+	assert.Contains(t, res, "  repeated string tags = 10;\n")
+	assert.Contains(t, res, "  bool barks = 2;\n")
+	assert.Contains(t, res, "message Animal {\n  oneof value {\n    Dog dog = 1;\n    Cat cat = 2;\n  }\n}\n")
+	assert.NotContains(t, res, "message Tags")
+
+	assert.Contains(t, res, "  // unmapped: definition Pet.grid: arrays of arrays or maps can't be mapped\n  repeated google.protobuf.ListValue grid = 3;\n")
+	assert.Contains(t, res, "// unmapped: definition Cat: polymorphism with a discriminator can't be mapped")
+	assert.Len(t, proto.Unmapped, 4)
+}
+
+func TestProto_Service(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/proto.yml")
+	require.NoError(t, err)
+
+	res := string(makeProto(specDoc.Spec(), "store.v1").Render())
+	assert.Contains(t, res, "package store.v1;\n")
+	assert.Contains(t, res, "import \"google/api/annotations.proto\";\n")
+	assert.Contains(t, res, "service PetStore {\n")
+	assert.Contains(t, res, "  // lists the pets\n  rpc ListPets(ListPetsRequest) returns (ListPetsResponse) {\n    option (google.api.http) = {\n      get: \"/api/pets\"\n    };\n  }\n")
+	assert.Contains(t, res, "message ListPetsRequest {\n  int32 limit = 1;\n  repeated string tags = 2;\n}\n")
+	assert.Contains(t, res, "message ListPetsResponse {\n  repeated Pet result = 1;\n}\n")
+	assert.Contains(t, res, "// unmapped: operation ListPets parameter X-Request-Id: http headers can't be bound to fields")
+	assert.Contains(t, res, "  rpc CreatePet(CreatePetRequest) returns (Pet) {\n    option (google.api.http) = {\n      post: \"/api/pets\"\n      body: \"pet\"\n    };\n")
+	assert.Contains(t, res, "  // removes a pet\n  // from the store\n  rpc DeletePet(DeletePetRequest) returns (Error) {\n")
+	assert.Contains(t, res, "      delete: \"/api/pets/{pet_id}\"\n")
+	assert.Contains(t, res, "  rpc CheckPet(CheckPetRequest) returns (google.protobuf.Empty) {\n")
+	assert.Contains(t, res, "      custom: { kind: \"HEAD\" path: \"/api/pets/{pet_id}\" }\n")
+}
+
+func TestProto_Generate(t *testing.T) {
+	target, err := ioutil.TempDir("", "proto")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := &GenOpts{
+		Spec:   "../fixtures/codegen/proto.yml",
+		Target: target,
+	}
+	require.NoError(t, GenerateProto("", "", opts))
+
+	b, err := ioutil.ReadFile(filepath.Join(target, "pet_store.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "service PetStore {\n")
+}
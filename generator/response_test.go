@@ -66,6 +66,31 @@ func TestDefaultResponseRender(t *testing.T) {
 	}
 }
 
+func TestClientResponse_TypedErrors(t *testing.T) {
+	b, err := opBuilder("updateTask", "../fixtures/codegen/todolist.responses.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			var buf bytes.Buffer
+			opts := opts()
+			if assert.NoError(t, templates.MustGet("clientResponse").Execute(&buf, op)) {
+				ff, err := opts.LanguageOpts.FormatContent("update_task_responses.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func (o *UpdateTaskOK) Code() int {\n\treturn 200\n}", res)
+					assertInCode(t, "func (o *UpdateTaskOK) IsSuccess() bool {\n\treturn true\n}", res)
+					assertInCode(t, "func (o *UpdateTaskOK) GetPayload() *models.Task {", res)
+					assertInCode(t, "func (o *UpdateTaskDefault) IsClientError() bool {\n\treturn o._statusCode/100 == 4\n}", res)
+					assertInCode(t, "func (o *UpdateTaskDefault) GetPayload() *models.Error {", res)
+					assertInCode(t, "return nil, result", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
 func TestSimpleResponses(t *testing.T) {
 	b, err := opBuilder("updateTask", "../fixtures/codegen/todolist.responses.yml")
 
@@ -138,7 +163,7 @@ func TestInlinedSchemaResponses(t *testing.T) {
 							}
 						}
 						assert.Len(t, b.ExtraSchemas, 1)
-						assert.Equal(t, "[]*models.SuccessBodyItems0", res.Schema.GoType)
+						assert.Equal(t, "[]*SuccessBodyItems0", res.Schema.GoType)
 					}
 				}
 			}
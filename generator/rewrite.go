@@ -0,0 +1,142 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// RewriteRefs renames definitions, parameters and responses of a spec, and updates every $ref to them.
+//
+// The mapping goes from a $ref to its new value, like #/definitions/Pet to #/definitions/Animal. A local $ref to a
+// definition, a parameter or a response renames it within its section, and the $refs into it follow it, like
+// #/definitions/Pet/properties/owner. The other $refs, like the ones to another document, are only rewritten.
+// The entries are all renamed at once, so a mapping can swap two names, but not rename one to a name still in use.
+func RewriteRefs(sw *spec.Swagger, mapping map[string]string) error {
+	b, err := json.Marshal(sw)
+	if err != nil {
+		return err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+
+	var froms []string
+	for from := range mapping {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+
+	type renamed struct {
+		section, name string
+		value         interface{}
+	}
+	var moved []renamed
+	for _, from := range froms {
+		section, name, ok := localEntry(from)
+		if !ok {
+			continue
+		}
+		toSection, toName, ok := localEntry(mapping[from])
+		if !ok || toSection != section {
+			return fmt.Errorf("can't rename %s to %s: the %s are renamed within their section", from, mapping[from], section)
+		}
+		entries, _ := doc[section].(map[string]interface{})
+		value, ok := entries[name]
+		if !ok {
+			// the $refs are rewritten all the same
+			continue
+		}
+		delete(entries, name)
+		moved = append(moved, renamed{section: section, name: toName, value: value})
+	}
+	for _, entry := range moved {
+		entries := doc[entry.section].(map[string]interface{})
+		if _, taken := entries[entry.name]; taken {
+			return fmt.Errorf("can't rename to #/%s/%s, which already exists", entry.section, jsonpointer.Escape(entry.name))
+		}
+		entries[entry.name] = entry.value
+	}
+
+	// the longest prefix wins, for the $refs into an entry which is renamed too
+	sort.Slice(froms, func(i, j int) bool { return len(froms[i]) > len(froms[j]) })
+	rewriteRefs(doc, froms, mapping)
+
+	if b, err = json.Marshal(doc); err != nil {
+		return err
+	}
+	var rewritten spec.Swagger
+	if err := json.Unmarshal(b, &rewritten); err != nil {
+		return err
+	}
+	*sw = rewritten
+	return nil
+}
+
+// localEntry tells the section and the name of a $ref to a definition, a parameter or a response of the spec
+func localEntry(ref string) (string, string, bool) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 3 || parts[0] != "#" || parts[2] == "" {
+		return "", "", false
+	}
+	switch parts[1] {
+	case "definitions", "parameters", "responses":
+		return parts[1], jsonpointer.Unescape(parts[2]), true
+	}
+	return "", "", false
+}
+
+// rewriteRefs rewrites the $refs of a spec decoded from json which start with a $ref of the mapping.
+// The values of the examples, defaults and enums are data, their $ref keys are left alone.
+func rewriteRefs(node interface{}, froms []string, mapping map[string]string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			switch key {
+			case "example", "examples", "default", "enum":
+				continue
+			case "definitions", "parameters", "responses", "headers", "properties", "patternProperties":
+				// maps by names which may well be "default", like the default response of an operation
+				if named, ok := value.(map[string]interface{}); ok {
+					for _, item := range named {
+						rewriteRefs(item, froms, mapping)
+					}
+					continue
+				}
+			}
+			if ref, ok := value.(string); ok && key == "$ref" {
+				for _, from := range froms {
+					if ref == from || strings.HasPrefix(ref, from+"/") {
+						n[key] = mapping[from] + strings.TrimPrefix(ref, from)
+						break
+					}
+				}
+				continue
+			}
+			rewriteRefs(value, froms, mapping)
+		}
+	case []interface{}:
+		for _, value := range n {
+			rewriteRefs(value, froms, mapping)
+		}
+	}
+}
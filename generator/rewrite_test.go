@@ -0,0 +1,101 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteRefs(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/rewrite-refs.yml")
+	require.NoError(t, err)
+	sw := specDoc.Spec()
+
+	require.NoError(t, RewriteRefs(sw, map[string]string{
+		"#/definitions/Pet":             "#/definitions/Animal",
+		"#/definitions/Owner":           "#/definitions/Person",
+		"#/parameters/petId":            "#/parameters/animalId",
+		"#/responses/notFound":          "#/responses/missing",
+		"breeds.yml#/definitions/Breed": "animals.yml#/definitions/Breed",
+	}))
+
+	assert.Len(t, sw.Definitions, 3)
+	assert.Contains(t, sw.Definitions, "Animal")
+	assert.Contains(t, sw.Definitions, "Person")
+	assert.Contains(t, sw.Parameters, "animalId")
+	assert.Contains(t, sw.Responses, "missing")
+
+	animal, person := sw.Definitions["Animal"], sw.Definitions["Person"]
+	assert.Equal(t, "#/definitions/Person", propertyRef(animal, "owner"))
+	// a property named default is a schema, not a default value
+	assert.Equal(t, "#/definitions/Person", propertyRef(animal, "default"))
+	assert.Equal(t, "animals.yml#/definitions/Breed", propertyRef(animal, "breed"))
+	assert.Equal(t, "#/definitions/Animal", person.Properties["pets"].Items.Schema.Ref.String())
+
+	pet := sw.Paths.Paths["/pets/{id}"]
+	assert.Equal(t, "#/parameters/animalId", pet.Parameters[0].Ref.String())
+	ok, notFound := pet.Get.Responses.StatusCodeResponses[200], pet.Get.Responses.StatusCodeResponses[404]
+	assert.Equal(t, "#/definitions/Animal", ok.Schema.Ref.String())
+	assert.Equal(t, "#/responses/missing", notFound.Ref.String())
+	assert.Equal(t, "#/responses/missing", pet.Get.Responses.Default.Ref.String())
+
+	owner := sw.Paths.Paths["/pets/{id}/owner"].Get.Responses.StatusCodeResponses[200]
+	// the $refs into a renamed entry follow it, the examples are data
+	assert.Equal(t, "#/definitions/Animal/properties/owner", owner.Schema.Ref.String())
+	assert.Equal(t, map[string]interface{}{"$ref": "#/definitions/Pet"}, owner.Examples["application/json"])
+}
+
+func TestRewriteRefs_Swap(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/rewrite-refs.yml")
+	require.NoError(t, err)
+	sw := specDoc.Spec()
+	pets := sw.Definitions["Pet"]
+
+	require.NoError(t, RewriteRefs(sw, map[string]string{
+		"#/definitions/Pet":   "#/definitions/Owner",
+		"#/definitions/Owner": "#/definitions/Pet",
+	}))
+	owner := sw.Definitions["Owner"]
+	assert.Len(t, owner.Properties, len(pets.Properties))
+	assert.Equal(t, "#/definitions/Pet", propertyRef(owner, "owner"))
+	assert.Equal(t, "#/definitions/Owner", sw.Definitions["Pet"].Properties["pets"].Items.Schema.Ref.String())
+}
+
+func propertyRef(schema spec.Schema, name string) string {
+	property := schema.Properties[name]
+	return property.Ref.String()
+}
+
+func TestRewriteRefs_Invalid(t *testing.T) {
+	for _, mapping := range []map[string]string{
+		{"#/definitions/Pet": "#/definitions/Owner"},
+		{"#/definitions/Pet": "#/parameters/pet"},
+		{"#/definitions/Pet": "other.yml#/definitions/Pet"},
+	} {
+		specDoc, err := loads.Spec("../fixtures/codegen/rewrite-refs.yml")
+		require.NoError(t, err)
+		sw := specDoc.Spec()
+		assert.Error(t, RewriteRefs(sw, mapping), "%v", mapping)
+	}
+
+	// the refs to a missing entry are rewritten all the same
+	sw := &spec.Swagger{}
+	assert.NoError(t, RewriteRefs(sw, map[string]string{"#/definitions/Pet": "#/definitions/Animal"}))
+}
@@ -1144,3 +1144,97 @@ func TestSchemaValidation_UpdateOrg(t *testing.T) {
 		}
 	}
 }
+
+func TestSchemaValidation_RefMaps(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/ref-maps.yml")
+	if assert.NoError(t, err) {
+		expected := map[string][]string{
+			"ThingsByName": {
+				"type ThingsByName map[string]Thing",
+				"if val, ok := m[k]; ok {",
+				"if err := val.Validate(formats); err != nil {",
+				"return ve.ValidateName(k)",
+			},
+			"ThingsByGroup": {
+				"type ThingsByGroup map[string]map[string]Thing",
+				"for kk := range m[k] {",
+				"if val, ok := m[k][kk]; ok {",
+				"return ve.ValidateName(k + \".\" + kk)",
+			},
+			"ThingLists": {
+				"type ThingLists map[string][]Thing",
+				"for i := 0; i < len(m[k]); i++ {",
+				"if err := m[k][i].Validate(formats); err != nil {",
+			},
+			"Holder": {
+				"if err := m.ByName.Validate(formats); err != nil {",
+			},
+		}
+		for k, exprs := range expected {
+			opts := opts()
+			gm, err := makeGenDefinition(k, "models", specDoc.Spec().Definitions[k], specDoc, opts)
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				err := templates.MustGet("model").Execute(buf, gm)
+				if assert.NoError(t, err) {
+					formatted, err := opts.LanguageOpts.FormatContent(swag.ToFileName(k)+".go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						for _, expr := range exprs {
+							assertInCode(t, expr, res)
+						}
+						// an empty map is valid
+						assertNotInCode(t, "validate.Required(\"\", \"body\"", res)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSchemaValidation_AliasedPrimitives(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/aliased-primitives.yml")
+	if assert.NoError(t, err) {
+		expected := map[string][]string{
+			"Code": {
+				"type Code string",
+				"func (m Code) Validate(formats strfmt.Registry) error {",
+				"validate.MaxLength(\"\", \"body\", string(m), 10)",
+			},
+			"Rank": {
+				"type Rank int64",
+				"m.validateRankEnum(\"\", \"body\", m)",
+			},
+			"Thing": {
+				"Code Code `json:\"code\"`",
+				"Other Code `json:\"other,omitempty\"`",
+				"Codes []Code `json:\"codes\"`",
+				"ByName map[string]Code `json:\"byName,omitempty\"`",
+				"if err := validate.Required(\"code\", \"body\", m.Code); err != nil {",
+				"if err := m.Code.Validate(formats); err != nil {",
+				"if err := m.Other.Validate(formats); err != nil {",
+				"if err := m.Rank.Validate(formats); err != nil {",
+				"if err := m.Codes[i].Validate(formats); err != nil {",
+				"if err := val.Validate(formats); err != nil {",
+			},
+		}
+		for k, exprs := range expected {
+			opts := opts()
+			gm, err := makeGenDefinition(k, "models", specDoc.Spec().Definitions[k], specDoc, opts)
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				err := templates.MustGet("model").Execute(buf, gm)
+				if assert.NoError(t, err) {
+					formatted, err := opts.LanguageOpts.FormatContent(swag.ToFileName(k)+".go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						for _, expr := range exprs {
+							assertInCode(t, expr, res)
+						}
+						assertNotInCode(t, "validate.Required(\"other\"", res)
+					}
+				}
+			}
+		}
+	}
+}
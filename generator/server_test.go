@@ -113,6 +113,73 @@ func TestServer_UrlEncoded(t *testing.T) {
 	}
 }
 
+func TestServer_MediaTypes(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/media-types.yml", "mediaTypes")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			var produces, consumes []string
+			for _, ser := range app.Produces {
+				for _, mt := range ser.AllSerializers {
+					produces = append(produces, mt.MediaType)
+				}
+			}
+			for _, ser := range app.Consumes {
+				for _, mt := range ser.AllSerializers {
+					consumes = append(consumes, mt.MediaType)
+				}
+			}
+			// the media types are resolved for each operation: listPets inherits the produces of the spec,
+			// getPhoto consumes the default media type, and image/png gets a producer named after its subtype
+			assert.Equal(t, []string{"application/json", "application/x-protobuf", "application/xml", "image/png"}, produces)
+			assert.Equal(t, []string{"application/json", "application/xml"}, consumes)
+
+			assert.Contains(t, logs.String(), `warning: operations getPhoto produce "image/png", which no producer is known for: register one as api.PngProducer`)
+			assert.Contains(t, logs.String(), `warning: operations getPhoto produce "application/x-protobuf", which has no built-in producer: register one as api.ProtobufProducer`)
+			assert.NotContains(t, logs.String(), `"application/xml", which`)
+
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverConfigureapi").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("configure_media_types_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `// TODO: register the producer of "image/png", this stub fails with a not implemented error`, res)
+					assertInCode(t, "api.PngProducer = runtime.ProducerFunc(", res)
+					assertInCode(t, "api.XMLProducer = runtime.XMLProducer()", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("media_types_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertRegexpInCode(t, `case "image/png":\s+result\["image/png"\] = o.PngProducer`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	// the default media type applies when neither the operation nor the spec declare one
+	gen, err = testAppGenerator(t, "../fixtures/codegen/media-types.yml", "mediaTypes")
+	if assert.NoError(t, err) {
+		gen.SpecDoc.Spec().Produces = nil
+		gen.DefaultProduces = "application/x-yaml"
+		produces, _ := gen.makeProduces()
+		var names []string
+		for _, ser := range produces {
+			names = append(names, ser.Name)
+		}
+		assert.Equal(t, []string{"protobuf", "yaml", "xml", "png"}, names)
+	}
+}
+
 func TestServer_MultipartForm(t *testing.T) {
 	log.SetOutput(ioutil.Discard)
 	defer log.SetOutput(os.Stderr)
@@ -185,7 +252,7 @@ func TestServer_Issue987(t *testing.T) {
 				if assert.NoError(t, err) {
 					res := string(formatted)
 					assertRegexpInCode(t, `JSONConsumer:\s+runtime.JSONConsumer()`, res)
-					assertRegexpInCode(t, `JSONProducer:\s+runtime.JSONProducer()`, res)
+					assertRegexpInCode(t, `JSONProducer:\s+PooledJSONProducer()`, res)
 					assertInCode(t, `result["application/json"] = o.JSONConsumer`, res)
 					assertInCode(t, `result["application/json"] = o.JSONProducer`, res)
 				} else {
@@ -218,3 +285,753 @@ func TestServer_FilterByTag(t *testing.T) {
 		}
 	}
 }
+
+func TestServer_ServerAPI(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/swagger-codegen-tests.json", "Petstore")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("petstore_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "type ServerAPI interface {", res)
+					assertInCode(t, "PetAddPet(params pet.AddPetParams, principal interface{}) middleware.Responder", res)
+					assertInCode(t, "func (o *PetstoreAPI) Configure(impl ServerAPI) {", res)
+					assertInCode(t, "o.PetAddPetHandler = pet.AddPetHandlerFunc(impl.PetAddPet)", res)
+					assertInCode(t, "type PetServerAPI interface {", res)
+					assertInCode(t, "AddPet(params pet.AddPetParams, principal interface{}) middleware.Responder", res)
+					assertInCode(t, "func (o *PetstoreAPI) ConfigurePet(impl PetServerAPI) {", res)
+					assertInCode(t, "o.PetAddPetHandler = pet.AddPetHandlerFunc(impl.AddPet)", res)
+					assertInCode(t, "type StoreServerAPI interface {", res)
+					assertInCode(t, "func (o *PetstoreAPI) ConfigureStore(impl StoreServerAPI) {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_OperationTests(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.tests.yml", "tests")
+	if assert.NoError(t, err) {
+		gen.GenOpts.IncludeTests = true
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			cases := make(map[string][]GenTestCase)
+			for _, op := range app.Operations {
+				if assert.NotNil(t, op.Tests, op.Name) {
+					cases[op.Name] = op.Tests.Cases
+				}
+			}
+
+			list := cases["listTasks"]
+			if assert.Len(t, list, 8) {
+				// the required parameters get their x-example, the value of their format or their first enum value
+				assert.Equal(t, "/api/tasks?code=ABC&since=2017-01-01T00%3A00%3A00Z", list[0].URL)
+				assert.Equal(t, map[string]string{"X-Rate-Limit": "low", "X-Token": "token"}, list[0].Headers)
+				assert.Equal(t, "listTasksOK", list[0].Response)
+				assert.Equal(t, 200, list[0].Status)
+				assert.Equal(t, "listTasks default", list[1].Response)
+				assert.True(t, list[1].Default)
+				assert.Equal(t, 500, list[1].Status)
+				assert.Equal(t, "the header parameter X-Rate-Limit isn't one of its values", list[3].Name)
+				assert.Equal(t, 422, list[3].Status)
+				assert.Equal(t, "the query parameter tags has too many items", list[6].Name)
+				assert.Contains(t, list[6].URL, "tags=sample%2Csample%2Csample%2Csample")
+				assert.Equal(t, "without credentials", list[7].Name)
+				assert.Equal(t, 401, list[7].Status)
+				assert.Equal(t, map[string]string{"X-Rate-Limit": "low"}, list[7].Headers)
+			}
+
+			create := cases["createTask"]
+			if assert.Len(t, create, 5) {
+				// the body has the required properties of the model
+				assert.JSONEq(t, `{"priority":1,"tags":["sample"],"title":"sample"}`, create[0].Body)
+				assert.Equal(t, "Basic dXNlcjpwYXNzd29yZA==", create[0].Headers["Authorization"])
+				assert.Equal(t, "without body", create[2].Name)
+				assert.Equal(t, "", create[2].Body)
+				assert.Equal(t, "with a malformed body", create[3].Name)
+				assert.Equal(t, 400, create[3].Status)
+			}
+
+			update := cases["updateTask"]
+			if assert.True(t, len(update) > 1) {
+				assert.Equal(t, "/api/tasks/1", update[0].URL)
+				assert.Equal(t, "title=sample", update[0].Body)
+				assert.Equal(t, "application/x-www-form-urlencoded", update[0].Headers["Content-Type"])
+			}
+
+			// no credentials are needed to delete a task
+			for _, tc := range cases["deleteTask"] {
+				assert.NotEqual(t, 401, tc.Status)
+			}
+
+			op := app.Operations[0]
+			for _, candidate := range app.Operations {
+				if candidate.Name == "listTasks" {
+					op = candidate
+				}
+			}
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverOperationTest").Execute(buf, op)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("list_tasks_test.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "package tasks_test", res)
+					assertInCode(t, "api := operations.NewTestsAPI(swaggerSpec)", res)
+					assertInCode(t, "api.KeyAuth = func(token string) (interface{}, error) {", res)
+					assertInCode(t, "api.TasksListTasksHandler = tasks.ListTasksHandlerFunc(func(params tasks.ListTasksParams, principal interface{}) middleware.Responder {", res)
+					assertInCode(t, "response: tasks.NewListTasksDefault(500),", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	// without the option, no test is planned
+	gen, err = testAppGenerator(t, "../fixtures/codegen/todolist.tests.yml", "tests")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			for _, op := range app.Operations {
+				assert.Nil(t, op.Tests)
+			}
+		}
+	}
+}
+
+func TestServer_JWT(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.jwt.yml", "jwt")
+	if assert.NoError(t, err) {
+		gen.Principal = "models.Principal"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverJwt").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("jwt.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func NewJWTValidator(config JWTConfig) *JWTValidator {", res)
+					assertInCode(t, "func (v *JWTValidator) OAuth2Auth(convert func(JWTClaims) (*models.Principal, error)) func(string, []string) (*models.Principal, error) {", res)
+					assertInCode(t, "func (v *JWTValidator) BearerAuth(convert func(JWTClaims) (*models.Principal, error)) func(string) (*models.Principal, error) {", res)
+					assertInCode(t, `return nil, errors.New(http.StatusForbidden, "the token doesn't grant the %s scope", scope)`, res)
+					assertInCode(t, "err = rsa.VerifyPKCS1v15(public, hash, digest, signature)", res)
+					assertInCode(t, "if !ecdsa.Verify(public, digest, r, s) {", res)
+					assertInCode(t, "if !hmac.Equal(mac.Sum(nil), signature) {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_ScopeAuthorizer(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.jwt.yml", "jwt")
+	if assert.NoError(t, err) {
+		gen.Principal = "models.Principal"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("jwt_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertRegexpInCode(t, `"listTasks":\s+\{\s+"oauth": \[\]string\{"tasks:read"\},\s+\},`, res)
+					assertRegexpInCode(t, `"addTask":\s+\{\s+"bearer": nil,\s+\},`, res)
+					assertInCode(t, `"GET /tasks":  "listTasks",`, res)
+					assertInCode(t, "AuthorizeScopes(principal *models.Principal, operation string, scopes map[string][]string) error", res)
+					assertInCode(t, "func (o *JwtAPI) AuthorizeScopes(authorizer ScopeAuthorizer) {", res)
+					assertInCode(t, "p, ok := principal.(*models.Principal)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_CSRF(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.csrf.yml", "csrf")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"XSRF-TOKEN", "csrf_token"}, app.CSRFCookies)
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("csrf_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func IssueCSRFToken(rw http.ResponseWriter, r *http.Request) (string, error) {", res)
+					assertInCode(t, `for _, name := range []string{"XSRF-TOKEN", "csrf_token"} {`, res)
+					assertInCode(t, "SameSite: http.SameSiteStrictMode,", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_RequestLimits(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.limits.yml", "limits")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("limits_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `"POST /tasks":        1024,`, res)
+					assertInCode(t, `"POST /tasks/import": 10000000,`, res)
+					assertInCode(t, `"GET /tasks":         64000,`, res)
+					assertInCode(t, "return o.limitRequests(o.context.APIHandler(builder))", res)
+					assertInCode(t, "errors.New(http.StatusRequestEntityTooLarge, \"the request body is larger than %d bytes\", limit)", res)
+					assertInCode(t, "errors.New(http.StatusRequestHeaderFieldsTooLarge, \"the request has more than %d headers\", o.MaxHeaderCount)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `long:"max-body-size"`, res)
+					assertInCode(t, `long:"max-header-count"`, res)
+					assertInCode(t, "s.api.MaxBodySize = int64(s.MaxBodySize)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_ErrorHandlers(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.jwt.yml", "jwt")
+	if assert.NoError(t, err) {
+		gen.Principal = "models.Principal"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("jwt_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "ServeNotFound             func(http.ResponseWriter, *http.Request, error)", res)
+					assertInCode(t, "ServeNotImplemented       func(http.ResponseWriter, *http.Request, error)", res)
+					assertInCode(t, "return o.serveError", res)
+					assertRegexpInCode(t, `case http.StatusUnsupportedMediaType:\s+handler = o.ServeUnsupportedMediaType`, res)
+					assertInCode(t, "func (o *JwtAPI) NotImplemented(r *http.Request, message string) middleware.Responder {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverConfigureapi").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("configure_jwt.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "// api.ServeNotFound = func(rw http.ResponseWriter, r *http.Request, err error) { ... }", res)
+					assertInCode(t, `//   return middleware.NotImplemented("operation addTask has not yet been implemented")`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_StrictHandlers(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.jwt.yml", "jwt")
+	if assert.NoError(t, err) {
+		gen.Principal = "models.Principal"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("jwt_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (o *JwtAPI) Unimplemented() []string {", res)
+					assertRegexpInCode(t, `if o.AddTaskHandler == nil {\s+ids = append\(ids, "addTask"\)`, res)
+					assertNotInCode(t, "AddTaskHandler: AddTaskHandlerFunc(", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `long:"strict-handlers"`, res)
+					assertInCode(t, "if missing := s.api.Unimplemented(); len(missing) > 0 {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			for _, op := range app.Operations {
+				if op.Name != "addTask" {
+					continue
+				}
+				buf = bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("serverOperation").Execute(buf, op)) {
+					formatted, err := app.GenOpts.LanguageOpts.FormatContent("add_task.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						assertRegexpInCode(t, `if o.Handler == nil {\s+o.Context.Respond\(rw, r, route.Produces, route, errors.NotImplemented\("operation addTask has not yet been implemented"\)\)`, res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestServer_WatchSpec(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.jwt.yml", "jwt")
+	if assert.NoError(t, err) {
+		gen.Principal = "models.Principal"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("jwt_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (o *JwtAPI) Reload(spec *loads.Document) error {", res)
+					assertInCode(t, `o.remapHandler(handlers, "addTask", "POST", "/tasks")`, res)
+					assertInCode(t, "o.spec.Analyzer.OperationForName(operationID)", res)
+					assertInCode(t, "o.served = o.routes(o.builder)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `long:"watch-spec"`, res)
+					assertInCode(t, "if err = s.watchSpec(string(s.WatchSpec)); err != nil {", res)
+					assertInCode(t, "err = s.api.Reload(doc)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_HTTP2(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `long:"h2c"`, res)
+					assertInCode(t, `long:"http2-max-concurrent-streams" description:"the maximum number of concurrent streams of an HTTP/2 connection" default:"250"`, res)
+					assertInCode(t, `long:"http2-max-frame-size"`, res)
+					assertInCode(t, "if err = http2.ConfigureServer(httpsServer.Server, s.http2Server()); err != nil {", res)
+					assertInCode(t, "httpServer.Handler = serveH2C(s.handler, s.http2Server())", res)
+					assertInCode(t, `NextProtos: []string{"h2", "http/1.1"},`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Health(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.csrf.yml", "health")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			assert.False(t, app.WithHealth)
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				assertNotInCode(t, "serveHealth", buf.String())
+			}
+		}
+
+		gen.GenOpts.IncludeHealth = true
+		app, err = gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			assert.True(t, app.WithHealth)
+			assert.NotContains(t, app.SwaggerJSON, `"/healthz"`)
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("health_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (o *HealthAPI) AddHealthCheck(name string, check func(context.Context) error) {", res)
+					assertInCode(t, "func (o *HealthAPI) AddReadinessCheck(name string, check func(context.Context) error) {", res)
+					assertRegexpInCode(t, `case "/api/healthz":\s+checks = o.healthChecks`, res)
+					assertRegexpInCode(t, `case "/api/readyz":\s+checks = o.readinessChecks`, res)
+					assertRegexpInCode(t, `if o.serveHealth\(rw, r\) {\s+return\s+}\s+if o.StrictSlash \|\| o.RedirectSlash \|\| o.CaseInsensitivePaths {`, res)
+					assertRegexpInCode(t, `if r.URL.Path == "/api/readyz" && o.Draining\(\) {`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		gen.GenOpts.HealthInSpec = true
+		app, err = gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			assert.Contains(t, app.SwaggerJSON, `"/healthz"`)
+			assert.Contains(t, app.SwaggerJSON, `"operationId": "readyz"`)
+			// the probes are documented, but not generated as operations
+			for _, op := range app.Operations {
+				assert.NotEqual(t, "healthz", op.Name)
+			}
+		}
+	}
+}
+
+func TestServer_Debug(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		for _, strategy := range []string{"go-flags", "pflag"} {
+			gen.GenOpts.FlagStrategy = strategy
+			app, err := gen.makeCodegenApp()
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+					formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						if strategy == "pflag" {
+							assertInCode(t, `flag.StringVar(&debugPrefix, "debug-prefix", "",`, res)
+							assertInCode(t, `s.DebugPassword = stringEnvOverride(debugPassword, "", "DEBUG_PASSWORD")`, res)
+						} else {
+							assertInCode(t, `long:"debug-prefix"`, res)
+							assertInCode(t, `long:"debug-password" description:"the password of the basic auth guarding the debug endpoints" env:"DEBUG_PASSWORD"`, res)
+						}
+						assertRegexpInCode(t, `} else {\s+s.SetHandler\(s.mountDebug\(s.handler\)\)`, res)
+						assertInCode(t, `debug.HandleFunc("/debug/pprof/", pprof.Index)`, res)
+						assertInCode(t, `debug.Handle("/debug/vars", expvar.Handler())`, res)
+						assertInCode(t, "subtle.ConstantTimeCompare([]byte(password), []byte(s.DebugPassword)) != 1", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Listeners(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		for _, strategy := range []string{"go-flags", "pflag"} {
+			gen.GenOpts.FlagStrategy = strategy
+			app, err := gen.makeCodegenApp()
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+					formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						if strategy == "pflag" {
+							assertInCode(t, `flag.IntVar(&adminPort, "admin-port", 0,`, res)
+							assertInCode(t, `s.AdminPort = intEnvOverride(adminPort, 0, "ADMIN_PORT")`, res)
+						} else {
+							assertInCode(t, `long:"admin-host" description:"the IP of the admin listener" default:"localhost" env:"ADMIN_HOST"`, res)
+						}
+						assertInCode(t, `strconv.Atoi(os.Getenv("LISTEN_PID")); err != nil || pid != os.Getpid()`, res)
+						assertInCode(t, `f := os.NewFile(uintptr(listenFDsStart+i), scheme)`, res)
+						assertRegexpInCode(t, `listener, ok := s.activated\[schemeHTTP\]\s+if !ok {`, res)
+						assertInCode(t, `s.adminL, err = net.Listen("tcp", net.JoinHostPort(s.AdminHost, strconv.Itoa(s.AdminPort)))`, res)
+						assertInCode(t, "configureServer(adminServer, schemeAdmin, s.adminL.Addr().String())", res)
+						assertRegexpInCode(t, `if s.adminL != nil {\s+adminHandler = s.mountDebug\(s.handler\)`, res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestServer_StdFlags(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		gen.GenOpts.FlagStrategy = "stdlib"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertNotInCode(t, "github.com/jessevdk/go-flags", res)
+					assertNotInCode(t, "github.com/spf13/pflag", res)
+					assertInCode(t, "TLSCertificate    string\n", res)
+					assertInCode(t, `s.Host = stringEnvOverride(s.Host, "", "HOST")`, res)
+					assertInCode(t, "func (s *Server) RegisterFlags(fs *flag.FlagSet) {", res)
+					assertInCode(t, `fs.StringVar(&s.Spec, "spec", s.Spec,`, res)
+					assertInCode(t, `fs.IntVar(&s.Port, "port", s.Port,`, res)
+					assertInCode(t, `fs.Var(&stringsValue{values: &s.EnabledListeners}, "scheme",`, res)
+					assertInCode(t, `fs.Var((*uint32Value)(&s.HTTP2MaxConcurrentStreams), "http2-max-concurrent-streams",`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverMain").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("main.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertRegexpInCode(t, `server := restapi.NewServer\(nil\)\s+server.RegisterFlags\(flag.CommandLine\)`, res)
+					assertInCode(t, "flag.PrintDefaults()", res)
+					assertNotInCode(t, "flags.NewParser", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Embed(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "s.CleanupTimeout = 10 * time.Second", res)
+					assertInCode(t, `s.Host = "localhost"`, res)
+					assertNotInCode(t, "s.EnabledListeners = defaultSchemes", res)
+					assertInCode(t, "NoSignalHandling bool\n", res)
+					assertRegexpInCode(t, `s.addServer\(httpServer\)\s+wg.Add\(1\)`, res)
+					assertRegexpInCode(t, `if !server.Interrupted {\s+server.Stop\(s.CleanupTimeout\)`, res)
+					assertInCode(t, "<-server.StopChan()", res)
+					assertInCode(t, "func (s *Server) Addr(scheme string) net.Addr {", res)
+					assertInCode(t, "func (s *Server) ListenPort(scheme string) int {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_RouterFlags(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		for _, strategy := range []string{"go-flags", "pflag", "stdlib"} {
+			gen.GenOpts.FlagStrategy = strategy
+			app, err := gen.makeCodegenApp()
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+					formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						switch strategy {
+						case "pflag":
+							assertInCode(t, `flag.BoolVar(&strictSlash, "strict-slash", false,`, res)
+							assertInCode(t, `flag.BoolVar(&caseInsensitivePaths, "case-insensitive-paths", false,`, res)
+						case "stdlib":
+							assertInCode(t, `fs.BoolVar(&s.RedirectSlash, "redirect-slash", s.RedirectSlash,`, res)
+						default:
+							assertInCode(t, `long:"strict-slash"`, res)
+							assertInCode(t, `long:"redirect-slash"`, res)
+						}
+						assertRegexpInCode(t, `if s.CaseInsensitivePaths {\s+s.api.CaseInsensitivePaths = true\s+}`, res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestServer_TrustedProxies(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		for _, strategy := range []string{"go-flags", "pflag"} {
+			gen.GenOpts.FlagStrategy = strategy
+			app, err := gen.makeCodegenApp()
+			if assert.NoError(t, err) {
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+					formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						if strategy == "pflag" {
+							assertInCode(t, `flag.StringSliceVar(&trustedProxies, "trusted-proxy", nil,`, res)
+						} else {
+							assertInCode(t, `long:"trusted-proxy"`, res)
+							assertInCode(t, `env:"TRUSTED_PROXIES" env-delim:","`, res)
+						}
+						assertRegexpInCode(t, `trusted, err := parseCIDRs\(s.TrustedProxies\)\s+if err != nil {\s+return err\s+}\s+s.SetHandler\(forwardedHeaders\(s.handler, trusted\)\)`, res)
+						assertInCode(t, `r.Header.Del("X-Forwarded-For")`, res)
+						assertInCode(t, `r.RemoteAddr = net.JoinHostPort(client, "0")`, res)
+						assertInCode(t, "r.URL.Scheme = proto", res)
+						assertInCode(t, "r.Host = forwardedHost", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+
+	b, err := opBuilder("listBooks", "../fixtures/codegen/x-pagination.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverUrlbuilder").Execute(buf, op)) {
+				formatted, err := opts().LanguageOpts.FormatContent("list_books_urlbuilder.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (o *ListBooksURL) BuildFullFor(r *http.Request) (*url.URL, error) {", res)
+					assertInCode(t, "return o.BuildFull(scheme, r.Host)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_CreatedLocation(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.location.yml", "location")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			for _, op := range app.Operations {
+				switch op.Name {
+				case "archiveTask":
+					// the archived tasks are served by the operation of another package
+					assert.Nil(t, op.CreatedLocation)
+				case "addTask", "addListTask":
+					if assert.NotNil(t, op.CreatedLocation) {
+						assert.Equal(t, "Location", op.CreatedLocation.Location)
+					}
+					buf := bytes.NewBuffer(nil)
+					if assert.NoError(t, templates.MustGet("serverResponses").Execute(buf, op)) {
+						formatted, err := app.GenOpts.LanguageOpts.FormatContent(op.Name+"_responses.go", buf.Bytes())
+						if assert.NoError(t, err) {
+							res := string(formatted)
+							if op.Name == "addTask" {
+								assertInCode(t, "func (o *AddTaskCreated) WithLocationOf(r *http.Request, id int64) *AddTaskCreated {", res)
+								assertInCode(t, "created := &GetTaskURL{ID: id}", res)
+							} else {
+								assertInCode(t, "func (o *AddListTaskCreated) WithLocationOf(r *http.Request, listID string, id int64) *AddListTaskCreated {", res)
+								assertInCode(t, "created := &GetListTaskURL{ListID: listID, ID: id}", res)
+							}
+							assertInCode(t, "o.Location = created.Must(created.BuildFullFor(r)).String()", res)
+						} else {
+							fmt.Println(buf.String())
+						}
+					}
+				default:
+					assert.Nil(t, op.CreatedLocation)
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Drain(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/todolist.simple.yml", "simple")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("simple_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (o *SimpleAPI) Drain(ctx context.Context) error {", res)
+					assertInCode(t, "return &DrainError{InFlight: o.InFlight()}", res)
+					assertInCode(t, "func (o *SimpleAPI) InFlight() map[string]int {", res)
+					assertRegexpInCode(t, `if !o.startRequest\(operationID\) {\s+o.ServeError\(rw, r, errors.New\(http.StatusServiceUnavailable, "the server is shutting down"\)\)`, res)
+					assertRegexpInCode(t, `defer o.endRequest\(operationID\)\s+served.ServeHTTP\(rw, r\)`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "httpServer.BeforeShutdown = s.drainAPI", res)
+					assertInCode(t, "httpsServer.BeforeShutdown = s.drainAPI", res)
+					assertInCode(t, "ctx, cancel = context.WithTimeout(ctx, s.CleanupTimeout)", res)
+					assertInCode(t, `s.Logf("Shutting down with %v", err)`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
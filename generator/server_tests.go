@@ -0,0 +1,643 @@
+package generator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// the depth of the nested objects of a sample body
+const maxSampleDepth = 5
+
+// the longest string a test case sends to exceed a max length
+const maxSampleLength = 1024
+
+// sample values of the string formats, the values of the other formats aren't known
+var sampleFormats = map[string]string{
+	"":          "sample",
+	"date":      "2017-01-01",
+	"date-time": "2017-01-01T00:00:00Z",
+	"uuid":      "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+	"email":     "user@example.com",
+	"hostname":  "example.com",
+	"ipv4":      "127.0.0.1",
+	"ipv6":      "::1",
+	"uri":       "http://example.com",
+	"byte":      "c2FtcGxl",
+	"password":  "sample",
+	"duration":  "1s",
+}
+
+// GenOperationTests is what the generated tests of a server operation need:
+// the API serving the operation, and the requests sent to it
+type GenOperationTests struct {
+	APIName       string
+	APIPackage    string
+	APIImport     string
+	PackageImport string
+	ServerPackage string
+	ServerImport  string
+	// PrincipalImports are the imports of the principal type, its authentication functions create one
+	PrincipalImports []string
+	Security         GenSecuritySchemes
+	Cases            []GenTestCase
+}
+
+// GenTestCase is a request of the generated tests of an operation, with the status of the expected response
+type GenTestCase struct {
+	Name    string
+	Method  string
+	URL     string
+	Headers map[string]string
+	Body    string
+	// Response is the response the handler returns, the request isn't expected to reach the handler when it is empty
+	Response string
+	// Default tells that Response is the default response, created with the status
+	Default bool
+	Status  int
+}
+
+// makeOperationTests plans the test cases of an operation from the constraints and the examples of its parameters:
+// a request for each response of the operation, with valid values for its parameters,
+// then a request breaking each constraint in turn and a request without credentials, which don't reach the handler.
+//
+// When a valid value can't be made for a parameter (a pattern without example, an unknown format),
+// only the request without credentials is planned.
+func (a *appGenerator) makeOperationTests(op *GenOperation, method, pth string, security GenSecuritySchemes) *GenOperationTests {
+	base := a.GenOpts.baseImport(a.Target)
+	server := a.GenOpts.languageOpts().ManglePackagePath(a.GenOpts.ServerPackage, "server")
+	tests := &GenOperationTests{
+		APIName:       a.Name,
+		APIPackage:    a.APIPackage,
+		APIImport:     filepath.ToSlash(filepath.Join(base, server, a.APIPackage)),
+		PackageImport: filepath.ToSlash(filepath.Join(base, server, a.APIPackage, op.Package)),
+		ServerPackage: a.ServerPackage,
+		ServerImport:  filepath.ToSlash(filepath.Join(base, server)),
+		Security:      security,
+	}
+	if op.Package == a.APIPackage {
+		tests.PackageImport = tests.APIImport
+	}
+	if len(security) > 0 && strings.Contains(op.Principal, ".") {
+		tests.PrincipalImports = op.DefaultImports
+	}
+
+	params := a.Analyzed.ParamsFor(method, pth)
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sampler := &testSampler{root: a.SpecDoc.Spec(), certain: true}
+	valid := newTestRequest(method, a.SpecDoc.BasePath(), pth)
+	for _, key := range keys {
+		sampler.sample(valid, op.ConsumesMediaTypes, params[key])
+	}
+	anonymous := valid.copy()
+	if op.Authorized {
+		addCredentials(valid, op)
+	}
+
+	var cases []GenTestCase
+	if sampler.certain {
+		if producesJSON(op.ProducesMediaTypes) {
+			for _, response := range op.Responses {
+				tc := valid.testCase("responds "+strconv.Itoa(response.Code), response.Code)
+				tc.Response = response.Name
+				cases = append(cases, tc)
+			}
+			if op.DefaultResponse != nil {
+				tc := valid.testCase("responds with the default response", undeclaredStatus(op.Responses))
+				tc.Response, tc.Default = op.DefaultResponse.Name, true
+				cases = append(cases, tc)
+			}
+		}
+		for _, key := range keys {
+			cases = append(cases, sampler.broken(valid, op.ConsumesMediaTypes, params[key])...)
+		}
+	}
+	if op.Authorized {
+		cases = append(cases, anonymous.testCase("without credentials", 401))
+	}
+	tests.Cases = cases
+	return tests
+}
+
+// producesJSON tells whether the responses of an operation can be written by the tests, which don't set their payload
+func producesJSON(mediaTypes []string) bool {
+	if len(mediaTypes) == 0 {
+		return true
+	}
+	for _, mediaType := range mediaTypes {
+		if isJSONMediaType(mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+func isJSONMediaType(mediaType string) bool {
+	mediaType = strings.TrimSpace(strings.SplitN(mediaType, ";", 2)[0])
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// undeclaredStatus is a status the test of a default response uses, which isn't a response of the operation
+func undeclaredStatus(responses GenStatusCodeResponses) int {
+	for _, status := range []int{500, 502, 503, 599} {
+		declared := false
+		for _, response := range responses {
+			if response.Code == status {
+				declared = true
+			}
+		}
+		if !declared {
+			return status
+		}
+	}
+	return 599
+}
+
+// testRequest is a request of a test case being built
+type testRequest struct {
+	method      string
+	basePath    string
+	path        string
+	pathValues  map[string]string
+	query       url.Values
+	form        url.Values
+	headers     map[string]string
+	body        string
+	contentType string
+}
+
+func newTestRequest(method, basePath, pth string) *testRequest {
+	return &testRequest{
+		method:     strings.ToUpper(method),
+		basePath:   basePath,
+		path:       pth,
+		pathValues: map[string]string{},
+		query:      url.Values{},
+		form:       url.Values{},
+		headers:    map[string]string{},
+	}
+}
+
+func (r *testRequest) copy() *testRequest {
+	c := *r
+	c.pathValues, c.query, c.form, c.headers = map[string]string{}, url.Values{}, url.Values{}, map[string]string{}
+	for k, v := range r.pathValues {
+		c.pathValues[k] = v
+	}
+	for k, v := range r.query {
+		c.query[k] = append([]string(nil), v...)
+	}
+	for k, v := range r.form {
+		c.form[k] = append([]string(nil), v...)
+	}
+	for k, v := range r.headers {
+		c.headers[k] = v
+	}
+	return &c
+}
+
+func (r *testRequest) testCase(name string, status int) GenTestCase {
+	pth := r.path
+	for param, value := range r.pathValues {
+		pth = strings.Replace(pth, "{"+param+"}", url.PathEscape(value), -1)
+	}
+	u := path.Join("/", r.basePath, pth)
+	if strings.HasSuffix(pth, "/") && !strings.HasSuffix(u, "/") {
+		u += "/"
+	}
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+	tc := GenTestCase{Name: name, Method: r.method, URL: u, Body: r.body, Status: status, Headers: map[string]string{}}
+	for k, v := range r.headers {
+		tc.Headers[k] = v
+	}
+	if len(r.form) > 0 {
+		tc.Body = r.form.Encode()
+	}
+	if tc.Body != "" && r.contentType != "" {
+		tc.Headers["Content-Type"] = r.contentType
+	}
+	return tc
+}
+
+// addCredentials adds the credentials of the first security scheme of an operation to a request,
+// the generated tests accept any credentials
+func addCredentials(r *testRequest, op *GenOperation) {
+	names := make([]string, 0, len(op.Security))
+	for _, requirement := range op.Security {
+		names = append(names, requirement.Name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		scheme, ok := op.SecurityDefinitions[name]
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(scheme.Type) {
+		case "basic":
+			r.headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte("user:password"))
+		case "oauth2":
+			r.headers["Authorization"] = "Bearer token"
+		case "apikey":
+			if scheme.In == "query" {
+				r.query.Set(scheme.Name, "token")
+			} else {
+				r.headers[scheme.Name] = "token"
+			}
+		default:
+			continue
+		}
+		return
+	}
+}
+
+// testSampler makes the values of the test cases, certain is false once a value isn't known to be valid
+type testSampler struct {
+	root    *spec.Swagger
+	certain bool
+}
+
+// sample sets a valid value of a parameter in a request, when it is required
+func (s *testSampler) sample(r *testRequest, consumes []string, param spec.Parameter) {
+	if param.In == "body" {
+		if !param.Required {
+			return
+		}
+		contentType := jsonMediaType(consumes)
+		if contentType == "" || param.Schema == nil {
+			s.certain = false
+			return
+		}
+		value := s.schemaValue(param.Schema, 0)
+		b, err := json.Marshal(value)
+		if err != nil || value == nil {
+			s.certain = false
+			return
+		}
+		r.body, r.contentType = string(b), contentType
+		return
+	}
+	if !param.Required && param.In != "path" {
+		return
+	}
+	if param.Type == "file" || param.In == "formData" && !containsMediaType(consumes, "application/x-www-form-urlencoded") {
+		// a multipart form isn't sampled
+		s.certain = false
+		return
+	}
+	setParam(r, param, s.simpleValues(&param.SimpleSchema, &param.CommonValidations, param.Extensions))
+}
+
+func setParam(r *testRequest, param spec.Parameter, values []string) {
+	switch param.In {
+	case "path":
+		r.pathValues[param.Name] = strings.Join(values, "")
+	case "query":
+		r.query[param.Name] = values
+	case "header":
+		r.headers[param.Name] = strings.Join(values, "")
+	case "formData":
+		r.form[param.Name] = values
+		r.contentType = "application/x-www-form-urlencoded"
+	}
+}
+
+func unsetParam(r *testRequest, param spec.Parameter) {
+	switch param.In {
+	case "query":
+		delete(r.query, param.Name)
+	case "header":
+		delete(r.headers, param.Name)
+	case "formData":
+		delete(r.form, param.Name)
+	case "body":
+		r.body = ""
+	}
+}
+
+// broken are the copies of a valid request breaking a constraint of a parameter, which don't reach the handler
+func (s *testSampler) broken(valid *testRequest, consumes []string, param spec.Parameter) []GenTestCase {
+	var cases []GenTestCase
+	if param.In == "body" {
+		contentType := jsonMediaType(consumes)
+		if contentType == "" {
+			return nil
+		}
+		if param.Required {
+			r := valid.copy()
+			unsetParam(r, param)
+			cases = append(cases, r.testCase("without body", 422))
+		}
+		r := valid.copy()
+		r.body, r.contentType = "{", contentType
+		return append(cases, r.testCase("with a malformed body", 400))
+	}
+	if param.Type == "file" || param.In == "formData" && !containsMediaType(consumes, "application/x-www-form-urlencoded") {
+		return nil
+	}
+
+	with := func(constraint string, values ...string) {
+		r := valid.copy()
+		setParam(r, param, values)
+		cases = append(cases, r.testCase(fmt.Sprintf("the %s parameter %s %s", param.In, param.Name, constraint), 422))
+	}
+	if param.Required && param.In != "path" {
+		r := valid.copy()
+		unsetParam(r, param)
+		cases = append(cases, r.testCase(fmt.Sprintf("without the %s parameter %s", param.In, param.Name), 422))
+	}
+	if param.Type != "array" {
+		for _, value := range invalidSimpleValues(&param.SimpleSchema, &param.CommonValidations) {
+			with(value[0], value[1])
+		}
+	} else if param.MaxItems != nil && *param.MaxItems < maxSampleLength && param.Items != nil {
+		item := s.simpleValues(&param.Items.SimpleSchema, &param.Items.CommonValidations, nil)[0]
+		items := make([]string, *param.MaxItems+1)
+		for i := range items {
+			items[i] = item
+		}
+		with("has too many items", joinItems(param.CollectionFormat, items)...)
+	}
+	return cases
+}
+
+// simpleValues is a valid value of a simple parameter, with one value for each item of a multi collection
+func (s *testSampler) simpleValues(schema *spec.SimpleSchema, validations *spec.CommonValidations, extensions spec.Extensions) []string {
+	if example, ok := extensions["x-example"]; ok {
+		return []string{fmt.Sprintf("%v", example)}
+	}
+	if schema.Default != nil {
+		return []string{fmt.Sprintf("%v", schema.Default)}
+	}
+	if len(validations.Enum) > 0 {
+		return []string{fmt.Sprintf("%v", validations.Enum[0])}
+	}
+	if schema.Type != "array" {
+		return []string{s.simpleValue(schema.Type, schema.Format, validations)}
+	}
+	if schema.Items == nil {
+		s.certain = false
+		return []string{""}
+	}
+	count := int64(1)
+	if validations.MinItems != nil && *validations.MinItems > 1 {
+		count = *validations.MinItems
+		if validations.UniqueItems || count > maxSampleLength {
+			s.certain = false
+		}
+	}
+	item := s.simpleValues(&schema.Items.SimpleSchema, &schema.Items.CommonValidations, nil)[0]
+	items := make([]string, count)
+	for i := range items {
+		items[i] = item
+	}
+	return joinItems(schema.CollectionFormat, items)
+}
+
+func joinItems(collectionFormat string, items []string) []string {
+	switch collectionFormat {
+	case "multi":
+		return items
+	case "ssv":
+		return []string{strings.Join(items, " ")}
+	case "tsv":
+		return []string{strings.Join(items, "\t")}
+	case "pipes":
+		return []string{strings.Join(items, "|")}
+	}
+	return []string{strings.Join(items, ",")}
+}
+
+func (s *testSampler) simpleValue(tpe, format string, validations *spec.CommonValidations) string {
+	switch tpe {
+	case "integer", "number":
+		return strconv.FormatFloat(s.number(tpe, validations), 'f', -1, 64)
+	case "boolean":
+		return "true"
+	case "string":
+		return s.str(format, validations)
+	}
+	s.certain = false
+	return ""
+}
+
+func (s *testSampler) number(tpe string, validations *spec.CommonValidations) float64 {
+	value := 1.0
+	if validations.Minimum != nil {
+		value = *validations.Minimum
+		if validations.ExclusiveMinimum {
+			value++
+		}
+	} else if validations.Maximum != nil && *validations.Maximum < value {
+		value = *validations.Maximum
+		if validations.ExclusiveMaximum {
+			value--
+		}
+	}
+	if tpe == "integer" {
+		value = math.Ceil(value)
+	}
+	if validations.MultipleOf != nil && *validations.MultipleOf > 0 {
+		value = math.Ceil(value / *validations.MultipleOf) * *validations.MultipleOf
+	}
+	if validations.Maximum != nil && (value > *validations.Maximum || validations.ExclusiveMaximum && value == *validations.Maximum) {
+		s.certain = false
+	}
+	return value
+}
+
+func (s *testSampler) str(format string, validations *spec.CommonValidations) string {
+	value, ok := sampleFormats[format]
+	if !ok {
+		s.certain = false
+		return "sample"
+	}
+	if validations.Pattern != "" {
+		value, ok = matchingSample(value, validations)
+		if !ok {
+			s.certain = false
+		}
+		return value
+	}
+	if validations.MinLength != nil && int64(len(value)) < *validations.MinLength {
+		if format != "" || *validations.MinLength > maxSampleLength {
+			s.certain = false
+		}
+		value += strings.Repeat("x", int(*validations.MinLength)-len(value))
+	}
+	if validations.MaxLength != nil && int64(len(value)) > *validations.MaxLength {
+		if format != "" {
+			s.certain = false
+		}
+		value = value[:*validations.MaxLength]
+	}
+	return value
+}
+
+// the candidates tried for a string with a pattern
+var patternSamples = []string{"Sample", "SAMPLE", "sample1", "Sample-1", "1", "a"}
+
+// matchingSample is the first value of a format, or a candidate, which matches the pattern and the lengths of a string
+func matchingSample(value string, validations *spec.CommonValidations) (string, bool) {
+	pattern, err := regexp.Compile(validations.Pattern)
+	if err != nil {
+		return value, false
+	}
+	for _, candidate := range append([]string{value}, patternSamples...) {
+		if validations.MinLength != nil && int64(len(candidate)) < *validations.MinLength ||
+			validations.MaxLength != nil && int64(len(candidate)) > *validations.MaxLength {
+			continue
+		}
+		if pattern.MatchString(candidate) {
+			return candidate, true
+		}
+	}
+	return value, false
+}
+
+// invalidSimpleValues are the values breaking the constraints of a simple parameter, with the constraint they break
+func invalidSimpleValues(schema *spec.SimpleSchema, validations *spec.CommonValidations) [][2]string {
+	var values [][2]string
+	switch schema.Type {
+	case "integer", "number":
+		values = append(values, [2]string{"isn't a number", "not-a-number"})
+		if validations.Maximum != nil {
+			values = append(values, [2]string{"is above its maximum", strconv.FormatFloat(math.Floor(*validations.Maximum)+1, 'f', -1, 64)})
+		}
+		if validations.Minimum != nil {
+			values = append(values, [2]string{"is below its minimum", strconv.FormatFloat(math.Ceil(*validations.Minimum)-1, 'f', -1, 64)})
+		}
+	case "string":
+		if len(validations.Enum) > 0 {
+			values = append(values, [2]string{"isn't one of its values", "not-one-of-its-values"})
+		}
+		if validations.MaxLength != nil && *validations.MaxLength < maxSampleLength {
+			values = append(values, [2]string{"is longer than its max length", strings.Repeat("x", int(*validations.MaxLength)+1)})
+		}
+		if validations.MinLength != nil && *validations.MinLength > 1 {
+			values = append(values, [2]string{"is shorter than its min length", strings.Repeat("x", int(*validations.MinLength)-1)})
+		}
+	}
+	return values
+}
+
+// jsonMediaType is the json media type an operation consumes, or "" when it doesn't consume json
+func jsonMediaType(consumes []string) string {
+	if len(consumes) == 0 {
+		return "application/json"
+	}
+	for _, mediaType := range consumes {
+		if isJSONMediaType(mediaType) {
+			return mediaType
+		}
+	}
+	return ""
+}
+
+// schemaValue is a valid value of a schema, with its required properties
+func (s *testSampler) schemaValue(schema *spec.Schema, depth int) interface{} {
+	if schema.Ref.String() != "" {
+		resolved, err := spec.ResolveRef(s.root, &schema.Ref)
+		if err != nil || depth > maxSampleDepth {
+			s.certain = false
+			return nil
+		}
+		return s.schemaValue(resolved, depth+1)
+	}
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if schema.Default != nil {
+		return schema.Default
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+	if schema.Discriminator != "" {
+		s.certain = false
+		return nil
+	}
+	if len(schema.AllOf) > 0 {
+		merged := make(map[string]interface{})
+		for i := range schema.AllOf {
+			object, ok := s.schemaValue(&schema.AllOf[i], depth+1).(map[string]interface{})
+			if !ok {
+				s.certain = false
+				return nil
+			}
+			for key, value := range object {
+				merged[key] = value
+			}
+		}
+		return merged
+	}
+
+	tpe := ""
+	if len(schema.Type) > 0 {
+		tpe = schema.Type[0]
+	}
+	validations := &spec.CommonValidations{
+		Maximum: schema.Maximum, ExclusiveMaximum: schema.ExclusiveMaximum,
+		Minimum: schema.Minimum, ExclusiveMinimum: schema.ExclusiveMinimum,
+		MaxLength: schema.MaxLength, MinLength: schema.MinLength, Pattern: schema.Pattern,
+		MultipleOf: schema.MultipleOf,
+	}
+	switch {
+	case tpe == "array":
+		if schema.Items == nil || schema.Items.Schema == nil || schema.MinItems == nil || *schema.MinItems == 0 {
+			return []interface{}{}
+		}
+		if depth >= maxSampleDepth || *schema.MinItems > 1 && schema.UniqueItems {
+			s.certain = false
+		}
+		items := make([]interface{}, *schema.MinItems)
+		for i := range items {
+			items[i] = s.schemaValue(schema.Items.Schema, depth+1)
+		}
+		return items
+	case tpe == "object" || tpe == "" && len(schema.Properties) > 0:
+		object := make(map[string]interface{})
+		for _, name := range schema.Required {
+			property, ok := schema.Properties[name]
+			if !ok || depth >= maxSampleDepth {
+				s.certain = false
+				continue
+			}
+			object[name] = s.schemaValue(&property, depth+1)
+		}
+		return object
+	case tpe == "integer" || tpe == "number":
+		return s.number(tpe, validations)
+	case tpe == "boolean":
+		return true
+	case tpe == "string":
+		return s.str(schema.Format, validations)
+	}
+	s.certain = false
+	return nil
+}
+
+func containsMediaType(mediaTypes []string, mediaType string) bool {
+	for _, candidate := range mediaTypes {
+		if strings.TrimSpace(strings.SplitN(candidate, ";", 2)[0]) == mediaType {
+			return true
+		}
+	}
+	return false
+}
@@ -16,21 +16,28 @@ package generator
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"mime"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 
 	swaggererrors "github.com/go-openapi/errors"
 
 	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/jsonpointer"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -67,6 +74,26 @@ func (l *LanguageOpts) MangleName(name, suffix string) string {
 	return strings.Join([]string{name, suffix}, "_")
 }
 
+// ManglePackagePath makes sure each element of a slash separated package path gets a safe name
+func (l *LanguageOpts) ManglePackagePath(name, suffix string) string {
+	if name == "" {
+		return name
+	}
+	parts := strings.Split(filepath.ToSlash(name), "/")
+	for i, part := range parts {
+		parts[i] = l.MangleName(swag.ToFileName(part), suffix)
+	}
+	return strings.Join(parts, "/")
+}
+
+// ManglePackageName makes sure the package at a package path, its last element, gets a safe name
+func (l *LanguageOpts) ManglePackageName(name, suffix string) string {
+	if name == "" {
+		return name
+	}
+	return l.MangleName(swag.ToFileName(path.Base(filepath.ToSlash(name))), suffix)
+}
+
 // MangleVarName makes sure a reserved word gets a safe name
 func (l *LanguageOpts) MangleVarName(name string) string {
 	nm := swag.ToVarName(name)
@@ -176,7 +203,7 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 				ops = append(ops, TemplateOpts{
 					Name:     "parameters",
 					Source:   "asset:serverParameter",
-					Target:   "{{ if eq (len .Tags) 1 }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
+					Target:   "{{ if and (eq (len .Tags) 1) (ne .Package .APIPackage) }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
 					FileName: "{{ (snakize (pascalize .Name)) }}_parameters.go",
 				})
 			}
@@ -184,7 +211,7 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 				ops = append(ops, TemplateOpts{
 					Name:     "urlbuilder",
 					Source:   "asset:serverUrlbuilder",
-					Target:   "{{ if eq (len .Tags) 1 }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
+					Target:   "{{ if and (eq (len .Tags) 1) (ne .Package .APIPackage) }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
 					FileName: "{{ (snakize (pascalize .Name)) }}_urlbuilder.go",
 				})
 			}
@@ -192,7 +219,7 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 				ops = append(ops, TemplateOpts{
 					Name:     "responses",
 					Source:   "asset:serverResponses",
-					Target:   "{{ if eq (len .Tags) 1 }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
+					Target:   "{{ if and (eq (len .Tags) 1) (ne .Package .APIPackage) }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
 					FileName: "{{ (snakize (pascalize .Name)) }}_responses.go",
 				})
 			}
@@ -200,10 +227,18 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 				ops = append(ops, TemplateOpts{
 					Name:     "handler",
 					Source:   "asset:serverOperation",
-					Target:   "{{ if eq (len .Tags) 1 }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
+					Target:   "{{ if and (eq (len .Tags) 1) (ne .Package .APIPackage) }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
 					FileName: "{{ (snakize (pascalize .Name)) }}.go",
 				})
 			}
+			if gen.IncludeTests {
+				ops = append(ops, TemplateOpts{
+					Name:     "test",
+					Source:   "asset:serverOperationTest",
+					Target:   "{{ if and (eq (len .Tags) 1) (ne .Package .APIPackage) }}{{ joinFilePath .Target .ServerPackage .APIPackage .Package  }}{{ else }}{{ joinFilePath .Target .ServerPackage .Package  }}{{ end }}",
+					FileName: "{{ (snakize (pascalize .Name)) }}_test.go",
+				})
+			}
 			sec.Operations = ops
 		}
 	}
@@ -218,6 +253,14 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 					FileName: "{{ (snakize (pascalize .Name)) }}_client.go",
 				},
 			}
+			if gen.IncludeMocks {
+				sec.OperationGroups = append(sec.OperationGroups, TemplateOpts{
+					Name:     "mock",
+					Source:   "asset:clientMock",
+					Target:   "{{ joinFilePath .Target .ClientPackage .Name }}",
+					FileName: "{{ (snakize (pascalize .Name)) }}_client_mock.go",
+				})
+			}
 		} else {
 			sec.OperationGroups = []TemplateOpts{}
 		}
@@ -232,6 +275,18 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 					Target:   "{{ joinFilePath .Target .ClientPackage }}",
 					FileName: "{{ .Name }}Client.go",
 				},
+				{
+					Name:     "cache",
+					Source:   "asset:clientCache",
+					Target:   "{{ joinFilePath .Target .ClientPackage }}",
+					FileName: "cache.go",
+				},
+				{
+					Name:     "signing",
+					Source:   "asset:clientSigning",
+					Target:   "{{ joinFilePath .Target .ClientPackage }}",
+					FileName: "signing.go",
+				},
 			}
 		} else {
 			sec.Application = []TemplateOpts{
@@ -266,6 +321,12 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 					Target:   "{{ joinFilePath .Target .ServerPackage .Package }}",
 					FileName: "{{ snakize (pascalize .Name) }}_api.go",
 				},
+				{
+					Name:     "jwt",
+					Source:   "asset:serverJwt",
+					Target:   "{{ joinFilePath .Target .ServerPackage .Package }}",
+					FileName: "jwt.go",
+				},
 				{
 					Name:     "doc",
 					Source:   "asset:serverDoc",
@@ -275,6 +336,39 @@ func DefaultSectionOpts(gen *GenOpts, client bool) {
 			}
 		}
 	}
+	if len(sec.CLI) == 0 && client {
+		sec.CLI = []TemplateOpts{
+			{
+				Name:     "main",
+				Source:   "asset:cliMain",
+				Target:   "{{ joinFilePath .Target \"cmd\" (dasherize (pascalize .Name)) }}-cli",
+				FileName: "main.go",
+			},
+		}
+	}
+	if len(sec.Versions) == 0 && !client {
+		sec.Versions = []TemplateOpts{
+			{
+				Name:     "versions",
+				Source:   "asset:serverVersions",
+				Target:   "{{ joinFilePath .Target .ServerPackage }}",
+				FileName: "versions.go",
+			},
+			{
+				Name:       "configure_versions",
+				Source:     "asset:serverConfigureversions",
+				Target:     "{{ joinFilePath .Target .ServerPackage }}",
+				FileName:   "configure_versions.go",
+				SkipExists: true,
+			},
+			{
+				Name:     "versions_main",
+				Source:   "asset:serverVersionsmain",
+				Target:   "{{ joinFilePath .Target \"cmd\" (dasherize (pascalize .Name)) }}-server",
+				FileName: "main.go",
+			},
+		}
+	}
 	gen.Sections = sec
 
 }
@@ -295,6 +389,8 @@ type SectionOpts struct {
 	Operations      []TemplateOpts `mapstructure:"operations"`
 	OperationGroups []TemplateOpts `mapstructure:"operation_groups"`
 	Models          []TemplateOpts `mapstructure:"models"`
+	CLI             []TemplateOpts `mapstructure:"cli"`
+	Versions        []TemplateOpts `mapstructure:"versions"`
 }
 
 // GenOpts the options for the generator
@@ -307,12 +403,29 @@ type GenOpts struct {
 	IncludeURLBuilder bool
 	IncludeMain       bool
 	IncludeSupport    bool
+	IncludeCLI        bool
+	IncludeTests      bool
+	IncludeHealth     bool
+	HealthInSpec      bool
+	IncludeMocks      bool
 	ExcludeSpec       bool
 	DumpData          bool
 	WithContext       bool
 	ValidateSpec      bool
 	FlattenSpec				bool
+	Clean             bool
+	DryRun            bool
+	CompileCheck      bool
+	CompileCheckVet   bool
+	FlatOperations    bool
+	WarnDeprecated    bool
 	defaultsEnsured   bool
+	// the files written by the generation, for its manifest
+	generated []string
+	// the files a dry run would have written
+	planned []string
+	// the definitions generated in another package, by name
+	goTypes map[string]xGoType
 
 	Spec              string
 	APIPackage        string
@@ -332,11 +445,15 @@ type GenOpts struct {
 	Operations        []string
 	Models            []string
 	Tags              []string
+	IncludeOperations []string
+	ExcludeOperations []string
 	Name              string
 	FlagStrategy      string
 	CompatibilityMode string
 	ExistingModels    string
 	Copyright         string
+	BuildTags         string
+	TargetImportPath  string
 }
 
 // TargetPath returns the target path relative to the server package
@@ -376,6 +493,19 @@ func (g *GenOpts) SpecPath() string {
 	return specRel
 }
 
+// packageImport is the import path of a package of the generation, given by its path in the target
+func (g *GenOpts) packageImport(target, pkg, suffix string, elems ...string) string {
+	parts := append([]string{g.baseImport(target), g.languageOpts().ManglePackagePath(pkg, suffix)}, elems...)
+	return filepath.ToSlash(filepath.Join(parts...))
+}
+
+func (g *GenOpts) languageOpts() *LanguageOpts {
+	if g.LanguageOpts == nil {
+		return golang
+	}
+	return g.LanguageOpts
+}
+
 // EnsureDefaults for these gen opts
 func (g *GenOpts) EnsureDefaults(client bool) error {
 	if g.defaultsEnsured {
@@ -427,10 +557,10 @@ func (g *GenOpts) location(t *TemplateOpts, data interface{}) (string, string, e
 	}{
 		Name:          name,
 		Package:       pkg,
-		APIPackage:    g.APIPackage,
-		ServerPackage: g.ServerPackage,
-		ClientPackage: g.ClientPackage,
-		ModelPackage:  g.ModelPackage,
+		APIPackage:    g.languageOpts().ManglePackageName(g.APIPackage, "api"),
+		ServerPackage: g.languageOpts().ManglePackagePath(g.ServerPackage, "server"),
+		ClientPackage: g.languageOpts().ManglePackagePath(g.ClientPackage, "client"),
+		ModelPackage:  g.languageOpts().ManglePackagePath(g.ModelPackage, "definitions"),
 		Target:        g.Target,
 		Tags:          tags,
 	}
@@ -498,8 +628,11 @@ func (g *GenOpts) write(t *TemplateOpts, data interface{}) error {
 	if err != nil {
 		return err
 	}
+	if content, err = g.buildConstraint(fname, content); err != nil {
+		return err
+	}
 
-	if dir != "" {
+	if dir != "" && !g.DryRun {
 		if Debug {
 			log.Printf("skipping creating directory %q for %s because it's an empty string", dir, t.Name)
 		}
@@ -517,13 +650,47 @@ func (g *GenOpts) write(t *TemplateOpts, data interface{}) error {
 		}
 	}
 
-	writeerr := ioutil.WriteFile(filepath.Join(dir, fname), formatted, 0644)
+	pth := filepath.Join(dir, fname)
+	var writeerr error
+	if current, e := ioutil.ReadFile(pth); e == nil && bytes.Equal(current, formatted) {
+		// an unchanged file is left alone, so the tools watching the generated code don't see a change
+		log.Printf("%s is unchanged", pth)
+	} else if g.DryRun {
+		g.planned = append(g.planned, pth)
+		return err
+	} else {
+		writeerr = ioutil.WriteFile(pth, formatted, 0644)
+	}
 	if writeerr != nil {
 		log.Printf("Failed to write %q: %s", fname, writeerr)
+	} else if !t.SkipExists {
+		// the files written only once belong to the user, they are never cleaned
+		g.generated = append(g.generated, pth)
 	}
 	return err
 }
 
+// buildConstraint puts the build tags of the generation at the top of a go file
+func (g *GenOpts) buildConstraint(fname string, content []byte) ([]byte, error) {
+	if strings.TrimSpace(g.BuildTags) == "" || filepath.Ext(fname) != ".go" {
+		return content, nil
+	}
+	for _, r := range g.BuildTags {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_.!, ", r) {
+			return nil, fmt.Errorf("invalid build tags %q: the tags are separated by spaces (or) and commas (and), and negated with !", g.BuildTags)
+		}
+	}
+	constraint := "// +build " + strings.Join(strings.Fields(g.BuildTags), " ") + "\n\n"
+	return append([]byte(constraint), content...), nil
+}
+
+// PlannedFiles lists the files a dry run would have written, the unchanged ones left aside
+func (g *GenOpts) PlannedFiles() []string {
+	planned := append([]string(nil), g.planned...)
+	sort.Strings(planned)
+	return planned
+}
+
 func fileName(in string) string {
 	ext := filepath.Ext(in)
 	return swag.ToFileName(strings.TrimSuffix(in, ext)) + ext
@@ -557,6 +724,16 @@ func (g *GenOpts) renderApplication(app *GenApp) error {
 	return nil
 }
 
+func (g *GenOpts) renderCLI(app *GenApp) error {
+	log.Printf("rendering %d templates for command line tool %s", len(g.Sections.CLI), app.Name)
+	for _, templ := range g.Sections.CLI {
+		if err := g.write(&templ, app); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *GenOpts) renderOperationGroup(gg *GenOperationGroup) error {
 	log.Printf("rendering %d templates for operation group %s", len(g.Sections.OperationGroups), g.Name)
 	for _, templ := range g.Sections.OperationGroups {
@@ -606,16 +783,38 @@ func validateSpec(path string, doc *loads.Document) (err error) {
 		}
 	}
 
-	result := validate.Spec(doc, strfmt.Default)
-	if result == nil {
+	if err := CheckSpecVersion(path, doc); err != nil {
+		return err
+	}
+
+	var errs []error
+	if result := validate.Spec(doc, strfmt.Default); result != nil {
+		errs = result.(*swaggererrors.CompositeError).Errors
+	}
+	checks := append(CheckParameters(doc.Spec()), CheckConsumes(doc.Spec())...)
+	for _, problem := range append(checks, CheckParamDependencies(doc.Spec())...) {
+		errs = append(errs, errors.New(problem.String()))
+	}
+	if len(errs) == 0 {
 		return nil
 	}
 
-	str := fmt.Sprintf("The swagger spec at %q is invalid against swagger specification %s. see errors :\n", path, doc.Version())
-	for _, desc := range result.(*swaggererrors.CompositeError).Errors {
+	return &SpecValidationError{Spec: path, Version: doc.Version(), Errors: errs}
+}
+
+// SpecValidationError is returned when the spec fails the validation prior to generation
+type SpecValidationError struct {
+	Spec    string
+	Version string
+	Errors  []error
+}
+
+func (e *SpecValidationError) Error() string {
+	str := fmt.Sprintf("The swagger spec at %q is invalid against swagger specification %s. see errors :\n", e.Spec, e.Version)
+	for _, desc := range e.Errors {
 		str += fmt.Sprintf("- %s\n", desc)
 	}
-	return errors.New(str)
+	return str
 }
 
 func loadSpec(specFile string) (string, *loads.Document, error) {
@@ -634,9 +833,50 @@ func loadSpec(specFile string) (string, *loads.Document, error) {
 	if err != nil {
 		return "", nil, err
 	}
+	if err := CheckSpecVersion(specPath, specDoc); err != nil {
+		return "", nil, err
+	}
 	return specPath, specDoc, nil
 }
 
+// UnsupportedVersionError is returned for a document which isn't a swagger 2.0 spec:
+// validating it against the swagger 2.0 schema would only report as many errors as it has properties
+type UnsupportedVersionError struct {
+	Spec    string
+	Version string
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	if e.Version == "" {
+		return fmt.Sprintf("%q is not a swagger spec: it has no swagger version, expected 2.0", e.Spec)
+	}
+	return fmt.Sprintf("unsupported spec version %s, expected 2.0", e.Version)
+}
+
+// CheckSpecVersion tells a swagger 2.0 spec from a swagger 1.2 or an OpenAPI 3 spec,
+// and from a document which isn't a spec at all
+func CheckSpecVersion(path string, doc *loads.Document) error {
+	var versions struct {
+		Swagger        interface{} `json:"swagger"`
+		SwaggerVersion interface{} `json:"swaggerVersion"`
+		OpenAPI        interface{} `json:"openapi"`
+	}
+	if err := json.Unmarshal(doc.Raw(), &versions); err != nil {
+		return &UnsupportedVersionError{Spec: path}
+	}
+	switch {
+	case versions.Swagger == "2.0":
+		return nil
+	case versions.OpenAPI != nil:
+		return &UnsupportedVersionError{Spec: path, Version: fmt.Sprintf("%v (OpenAPI)", versions.OpenAPI)}
+	case versions.Swagger != nil:
+		return &UnsupportedVersionError{Spec: path, Version: fmt.Sprintf("%v", versions.Swagger)}
+	case versions.SwaggerVersion != nil:
+		return &UnsupportedVersionError{Spec: path, Version: fmt.Sprintf("%v", versions.SwaggerVersion)}
+	}
+	return &UnsupportedVersionError{Spec: path}
+}
+
 func fileExists(target, name string) bool {
 	_, err := os.Stat(filepath.Join(target, name))
 	return !os.IsNotExist(err)
@@ -745,6 +985,507 @@ func gatherOperations(specDoc *analysis.Spec, operationIDs []string) map[string]
 	return operations
 }
 
+// OperationIDWarning is a problem of the operation id of an operation for the generation of its code
+type OperationIDWarning struct {
+	Method  string
+	Path    string
+	ID      string
+	Message string
+}
+
+func (w OperationIDWarning) String() string {
+	return w.Message
+}
+
+// LintOperationIDs finds the operations without an operation id, which get a name made of their method and path,
+// the operation ids which don't make a valid exported Go name, and the ones which make the same Go name
+func LintOperationIDs(sw *spec.Swagger) []OperationIDWarning {
+	var oprefs opRefs
+	for method, pathItem := range analysis.New(sw).Operations() {
+		for path, operation := range pathItem {
+			oprefs = append(oprefs, opRef{
+				Key:    swag.ToGoName(strings.ToLower(method) + " " + path),
+				Method: strings.ToUpper(method),
+				Path:   path,
+				ID:     operation.ID,
+			})
+		}
+	}
+	sort.Slice(oprefs, func(i, j int) bool {
+		if oprefs[i].Path != oprefs[j].Path {
+			return oprefs[i].Path < oprefs[j].Path
+		}
+		return oprefs[i].Method < oprefs[j].Method
+	})
+
+	var warnings []OperationIDWarning
+	warn := func(opr opRef, format string, args ...interface{}) {
+		warnings = append(warnings, OperationIDWarning{Method: opr.Method, Path: opr.Path, ID: opr.ID, Message: fmt.Sprintf(format, args...)})
+	}
+	describe := func(opr opRef) string {
+		if opr.ID == "" {
+			return fmt.Sprintf("%s %s", opr.Method, opr.Path)
+		}
+		return fmt.Sprintf("%q (%s %s)", opr.ID, opr.Method, opr.Path)
+	}
+
+	named := make(map[string]opRef)
+	for _, opr := range oprefs {
+		name := opr.Key
+		if opr.ID == "" {
+			warn(opr, "%s %s has no operationId: its code is named %s after its method and path, and gets renamed when they change", opr.Method, opr.Path, name)
+		} else {
+			name = pascalize(opr.ID)
+			if !isExportedGoName(name) {
+				warn(opr, "the operationId %q of %s %s doesn't make a valid exported Go name, use ASCII letters and digits", opr.ID, opr.Method, opr.Path)
+				continue
+			}
+		}
+		if other, ok := named[name]; ok {
+			warn(opr, "the code of %s and %s is named %s for both", describe(other), describe(opr), name)
+			continue
+		}
+		named[name] = opr
+	}
+	return warnings
+}
+
+// DeprecatedParameter is a parameter marked with x-deprecated which an operation still uses
+type DeprecatedParameter struct {
+	Method  string
+	Path    string
+	ID      string
+	Name    string
+	In      string
+	Message string
+}
+
+func (p DeprecatedParameter) String() string {
+	return p.Message
+}
+
+// LintDeprecatedParameters finds the parameters marked with x-deprecated which the operations still use,
+// directly or with a reference
+func LintDeprecatedParameters(sw *spec.Swagger) []DeprecatedParameter {
+	var found []DeprecatedParameter
+	for method, pathItem := range analysis.New(sw).Operations() {
+		for pth, operation := range pathItem {
+			for _, param := range operationParameters(sw, pth, operation) {
+				deprecation, err := readDeprecation(param.Extensions)
+				if err != nil || deprecation == nil {
+					continue
+				}
+				found = append(found, DeprecatedParameter{
+					Method:  strings.ToUpper(method),
+					Path:    pth,
+					ID:      operation.ID,
+					Name:    param.Name,
+					In:      param.In,
+					Message: fmt.Sprintf("the %s parameter %q is deprecated: %s", param.In, param.Name, deprecation.Notice()),
+				})
+			}
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Path != found[j].Path {
+			return found[i].Path < found[j].Path
+		}
+		if found[i].Method != found[j].Method {
+			return found[i].Method < found[j].Method
+		}
+		return found[i].In+found[i].Name < found[j].In+found[j].Name
+	})
+	return found
+}
+
+// operationParameters are the resolved parameters of an operation, with the ones of its path it doesn't override
+func operationParameters(sw *spec.Swagger, pth string, operation *spec.Operation) []spec.Parameter {
+	params := append([]spec.Parameter(nil), operation.Parameters...)
+	if item, ok := sw.Paths.Paths[pth]; ok {
+		params = append(params, item.Parameters...)
+	}
+
+	var resolved []spec.Parameter
+	seen := make(map[string]bool)
+	for _, param := range params {
+		if param.Ref.String() != "" {
+			target, err := spec.ResolveParameter(sw, param.Ref)
+			if err != nil || target == nil {
+				continue
+			}
+			param = *target
+		}
+		if seen[param.In+" "+param.Name] {
+			continue
+		}
+		seen[param.In+" "+param.Name] = true
+		resolved = append(resolved, param)
+	}
+	return resolved
+}
+
+// the media types a request with formData parameters is sent in
+const (
+	urlEncodedMediaType = "application/x-www-form-urlencoded"
+	multipartMediaType  = "multipart/form-data"
+)
+
+// OperationProblem tells why the code generated for an operation can't work as the spec describes it
+type OperationProblem struct {
+	Method  string
+	Path    string
+	ID      string
+	Message string
+}
+
+func (p OperationProblem) String() string {
+	if p.ID == "" {
+		return fmt.Sprintf("%s %s: %s", p.Method, p.Path, p.Message)
+	}
+	return fmt.Sprintf("%s %s (%s): %s", p.Method, p.Path, p.ID, p.Message)
+}
+
+// CheckConsumes finds the operations with formData parameters which consume neither urlencoded forms nor multipart forms,
+// and the ones with file parameters which don't consume multipart forms: their generated code can't read the parameters.
+// An operation consuming nothing consumes the media types of the spec, or application/json.
+func CheckConsumes(sw *spec.Swagger) []OperationProblem {
+	var problems []OperationProblem
+	for method, pathItem := range analysis.New(sw).Operations() {
+		for pth, operation := range pathItem {
+			consumes := operation.Consumes
+			if len(consumes) == 0 {
+				consumes = sw.Consumes
+			}
+			if len(consumes) == 0 {
+				consumes = []string{runtime.JSONMime}
+			}
+			consumed := make(map[string]bool, len(consumes))
+			for _, mediaType := range consumes {
+				if parsed, _, err := mime.ParseMediaType(mediaType); err == nil {
+					consumed[parsed] = true
+				}
+			}
+
+			problem := func(format string, args ...interface{}) {
+				problems = append(problems, OperationProblem{
+					Method:  strings.ToUpper(method),
+					Path:    pth,
+					ID:      operation.ID,
+					Message: fmt.Sprintf(format, args...),
+				})
+			}
+			var form []string
+			for _, param := range operationParameters(sw, pth, operation) {
+				if param.In != "formData" {
+					continue
+				}
+				form = append(form, param.Name)
+				if param.Type == "file" && !consumed[multipartMediaType] {
+					problem("the file parameter %q can only be sent in a multipart form, but the operation doesn't consume %s", param.Name, multipartMediaType)
+				}
+			}
+			if len(form) > 0 && !consumed[urlEncodedMediaType] && !consumed[multipartMediaType] {
+				sort.Strings(form)
+				problem("the operation has formData parameters (%s), but consumes neither %s nor %s",
+					strings.Join(form, ", "), urlEncodedMediaType, multipartMediaType)
+			}
+		}
+	}
+	sortOperationProblems(problems)
+	return problems
+}
+
+// CheckParamDependencies finds the x-requires and x-mutually-exclusive extensions of the operations
+// which the generated binders can't enforce
+func CheckParamDependencies(sw *spec.Swagger) []OperationProblem {
+	var problems []OperationProblem
+	for method, pathItem := range analysis.New(sw).Operations() {
+		for pth, operation := range pathItem {
+			_, messages := readParamDependencies(operation.Extensions, operationParameters(sw, pth, operation))
+			for _, message := range messages {
+				problems = append(problems, OperationProblem{
+					Method:  strings.ToUpper(method),
+					Path:    pth,
+					ID:      operation.ID,
+					Message: message,
+				})
+			}
+		}
+	}
+	sortOperationProblems(problems)
+	return problems
+}
+
+// checkParameter reports the structural mistakes in a parameter which would otherwise break the generation:
+// body parameters have a schema, the other ones have a type, and files are form data
+func checkParameter(param spec.Parameter) error {
+	switch {
+	case param.In == "body" && param.Schema == nil:
+		return fmt.Errorf("body parameter %q has no schema", param.Name)
+	case param.In != "body" && param.Schema != nil:
+		return fmt.Errorf("%s parameter %q has a schema, only body parameters have one: use a type", param.In, param.Name)
+	case param.In != "body" && param.Type == "":
+		return fmt.Errorf("%s parameter %q has no type", param.In, param.Name)
+	case param.Type == file && param.In != "formData":
+		return fmt.Errorf("%s parameter %q has the file type, which only formData parameters may have", param.In, param.Name)
+	}
+	return nil
+}
+
+// CheckParameters finds the structurally wrong parameters of the operations: the ones checkParameter reports,
+// which would break the generation, and the path parameters which aren't required
+func CheckParameters(sw *spec.Swagger) []OperationProblem {
+	var problems []OperationProblem
+	for method, pathItem := range analysis.New(sw).Operations() {
+		for pth, operation := range pathItem {
+			for _, param := range operationParameters(sw, pth, operation) {
+				err := checkParameter(param)
+				if err == nil && param.In == "path" && !param.Required {
+					err = fmt.Errorf("path parameter %q isn't required, path parameters always are: add required: true", param.Name)
+				}
+				if err != nil {
+					problems = append(problems, OperationProblem{
+						Method:  strings.ToUpper(method),
+						Path:    pth,
+						ID:      operation.ID,
+						Message: err.Error(),
+					})
+				}
+			}
+		}
+	}
+	sortOperationProblems(problems)
+	return problems
+}
+
+func sortOperationProblems(problems []OperationProblem) {
+	sort.Slice(problems, func(i, j int) bool {
+		if problems[i].Path != problems[j].Path {
+			return problems[i].Path < problems[j].Path
+		}
+		if problems[i].Method != problems[j].Method {
+			return problems[i].Method < problems[j].Method
+		}
+		return problems[i].Message < problems[j].Message
+	})
+}
+
+// paramDependency is a group of parameters of an operation which require or exclude one another
+type paramDependency struct {
+	exclusive bool
+	params    []spec.Parameter
+}
+
+// readParamDependencies reads the groups of parameters of the x-requires extension, one of which must be given,
+// and the ones of the x-mutually-exclusive extension, which can't be given together.
+// Each extension is a list of parameter names for a single group, or a list of such lists.
+// The messages tell why the groups can't be enforced, the groups are only valid without them.
+func readParamDependencies(ext spec.Extensions, params []spec.Parameter) ([]paramDependency, []string) {
+	var dependencies []paramDependency
+	var messages []string
+	for _, key := range []string{xRequires, xMutuallyExclusive} {
+		value, ok := ext[key]
+		if !ok {
+			continue
+		}
+		groups, ok := dependencyGroups(value)
+		if !ok {
+			messages = append(messages, fmt.Sprintf("%s must be a list of parameter names, or a list of such lists, got %v", key, value))
+			continue
+		}
+		for _, names := range groups {
+			dependency := paramDependency{exclusive: key == xMutuallyExclusive}
+			var required []string
+			seen := make(map[string]bool, len(names))
+			for _, name := range names {
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+
+				var found []spec.Parameter
+				for _, param := range params {
+					if param.Name == name {
+						found = append(found, param)
+					}
+				}
+				switch {
+				case len(found) == 0:
+					messages = append(messages, fmt.Sprintf("%s names the parameter %q, which the operation doesn't have", key, name))
+				case len(found) > 1:
+					messages = append(messages, fmt.Sprintf("%s names the parameter %q, which the operation has in several locations", key, name))
+				case found[0].In == "path":
+					messages = append(messages, fmt.Sprintf("%s names the path parameter %q, which is always given", key, name))
+				default:
+					dependency.params = append(dependency.params, found[0])
+					if found[0].Required {
+						required = append(required, name)
+					}
+				}
+			}
+			if len(seen) < 2 {
+				messages = append(messages, fmt.Sprintf("the groups of %s must name at least 2 parameters, got [%s]", key, strings.Join(names, ", ")))
+			}
+			if dependency.exclusive && len(required) > 1 {
+				messages = append(messages, fmt.Sprintf("%s names the required parameters %s, which are always given together", key, strings.Join(required, ", ")))
+			}
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	if len(messages) > 0 {
+		return nil, messages
+	}
+	return dependencies, nil
+}
+
+// dependencyGroups reads the value of a x-requires or x-mutually-exclusive extension
+func dependencyGroups(value interface{}) ([][]string, bool) {
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, false
+	}
+	if _, single := list[0].(string); single {
+		names, ok := dependencyNames(list)
+		return [][]string{names}, ok
+	}
+	groups := make([][]string, 0, len(list))
+	for _, item := range list {
+		group, ok := item.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		names, ok := dependencyNames(group)
+		if !ok {
+			return nil, false
+		}
+		groups = append(groups, names)
+	}
+	return groups, true
+}
+
+func dependencyNames(list []interface{}) ([]string, bool) {
+	names := make([]string, 0, len(list))
+	for _, item := range list {
+		name, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// DefinitionNameProblem tells why the code generated for a definition doesn't compile
+type DefinitionNameProblem struct {
+	Name    string
+	Message string
+}
+
+func (p DefinitionNameProblem) String() string {
+	return fmt.Sprintf("%s: %s", definitionsPointer+jsonpointer.Escape(p.Name), p.Message)
+}
+
+// the GOOS and GOARCH values the go tool knows about: a file name ending with one of them is only built for it
+var (
+	knownOS   = []string{"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios", "js", "linux", "nacl", "netbsd", "openbsd", "plan9", "solaris", "wasip1", "windows", "zos"}
+	knownArch = []string{"386", "amd64", "amd64p32", "arm", "armbe", "arm64", "arm64be", "loong64", "mips", "mipsle", "mips64", "mips64le", "mips64p32", "mips64p32le", "ppc", "ppc64", "ppc64le", "riscv", "riscv64", "s390", "s390x", "sparc", "sparc64", "wasm"}
+)
+
+// CheckDefinitionNames finds the definitions whose name doesn't make a valid exported Go name, the ones which
+// make the same Go type once mangled, and the ones whose file the go tool leaves out of the build
+func CheckDefinitionNames(sw *spec.Swagger) []DefinitionNameProblem {
+	names := make([]string, 0, len(sw.Definitions))
+	for name, schema := range sw.Definitions {
+		if _, ok := schema.Extensions["x-go-type"]; ok {
+			// no code is generated for these
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []DefinitionNameProblem
+	typed := make(map[string]string)
+	for _, name := range names {
+		goName := pascalize(name)
+		if !isExportedGoName(goName) {
+			problems = append(problems, DefinitionNameProblem{Name: name, Message: fmt.Sprintf("the definition %q doesn't make a valid exported Go name, use ASCII letters and digits", name)})
+			continue
+		}
+		if other, ok := typed[goName]; ok {
+			problems = append(problems, DefinitionNameProblem{Name: name, Message: fmt.Sprintf("the definitions %q and %q are both generated as the type %s", other, name, goName)})
+			continue
+		}
+		typed[goName] = name
+		if reason := excludedFileName(swag.ToFileName(goName)); reason != "" {
+			problems = append(problems, DefinitionNameProblem{Name: name, Message: fmt.Sprintf("the definition %q is generated in %s.go, which %s", name, swag.ToFileName(goName), reason)})
+		}
+	}
+	return problems
+}
+
+// excludedFileName tells why the go tool doesn't always build a file with this name, if it doesn't
+func excludedFileName(name string) string {
+	parts := strings.Split(name, "_")
+	if len(parts) < 2 {
+		// the suffixes only count after an underscore
+		return ""
+	}
+	last := parts[len(parts)-1]
+	if last == "test" {
+		return "the go tool takes for a test file"
+	}
+	for _, known := range knownOS {
+		if last == known {
+			return "only builds on " + known
+		}
+	}
+	for _, known := range knownArch {
+		if last == known {
+			return "only builds on " + known
+		}
+	}
+	return ""
+}
+
+// DefinitionNamesError is returned when the code generated for the definitions of the spec wouldn't compile
+type DefinitionNamesError struct {
+	Spec     string
+	Problems []DefinitionNameProblem
+}
+
+func (e *DefinitionNamesError) Error() string {
+	str := fmt.Sprintf("The definitions of the swagger spec at %q can't be generated, rename them. see errors :\n", e.Spec)
+	for _, problem := range e.Problems {
+		str += fmt.Sprintf("- %s\n", problem)
+	}
+	return str
+}
+
+// checkDefinitionNames fails when the code generated for the definitions of the spec wouldn't compile
+func checkDefinitionNames(path string, sw *spec.Swagger) error {
+	if problems := CheckDefinitionNames(sw); len(problems) > 0 {
+		return &DefinitionNamesError{Spec: path, Problems: problems}
+	}
+	return nil
+}
+
+// isExportedGoName tells whether a mangled name can be the name of an exported type
+func isExportedGoName(name string) bool {
+	if name == "" || !utf8.ValidString(name) {
+		return false
+	}
+	for i, r := range name {
+		if i == 0 && !unicode.IsUpper(r) {
+			return false
+		}
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
 func pascalize(arg string) string {
 	if len(arg) == 0 || arg[0] > '9' {
 		return swag.ToGoName(arg)
@@ -1 +1,190 @@
 package generator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSpecVersion(t *testing.T) {
+	for doc, expected := range map[string]string{
+		`{"swagger": "2.0", "info": {"title": "pets", "version": "1.0"}, "paths": {}}`:       "",
+		`{"openapi": "3.0.1", "info": {"title": "pets", "version": "1.0"}, "paths": {}}`:     "unsupported spec version 3.0.1 (OpenAPI), expected 2.0",
+		`{"swaggerVersion": "1.2", "apis": []}`:                                              "unsupported spec version 1.2, expected 2.0",
+		`{"swagger": "1.2"}`:                                                                 "unsupported spec version 1.2, expected 2.0",
+		`{"name": "my-package", "version": "1.0.0", "dependencies": {"left-pad": "^1.0.0"}}`: `"package.json" is not a swagger spec: it has no swagger version, expected 2.0`,
+	} {
+		specDoc, err := loads.Analyzed(json.RawMessage(doc), "")
+		require.NoError(t, err)
+		err = CheckSpecVersion("package.json", specDoc)
+		if expected == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		if assert.Error(t, err, doc) {
+			assert.IsType(t, &UnsupportedVersionError{}, err)
+			assert.Equal(t, expected, err.Error())
+		}
+	}
+}
+
+func TestLintOperationIDs(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "operation ids", "version": "1.0"},
+  "paths": {
+    "/pets": {
+      "get": {"operationId": "getPets", "responses": {"200": {"description": "the pets"}}},
+      "post": {"operationId": "add_pet", "responses": {"201": {"description": "added"}}}
+    },
+    "/pets/{id}": {
+      "get": {"operationId": "addPet", "responses": {"200": {"description": "a pet"}}},
+      "delete": {"responses": {"204": {"description": "deleted"}}},
+      "put": {"operationId": "ペット", "responses": {"200": {"description": "replaced"}}}
+    },
+    "/ok": {
+      "get": {"operationId": "getOK", "responses": {"200": {"description": "ok"}}}
+    }
+  }
+}`), &sw))
+
+	warnings := LintOperationIDs(&sw)
+	require.Len(t, warnings, 3)
+	assert.Equal(t, "DELETE", warnings[0].Method)
+	assert.Equal(t, "DELETE /pets/{id} has no operationId: its code is named DeletePetsID after its method and path, and gets renamed when they change", warnings[0].Message)
+	assert.Equal(t, "GET", warnings[1].Method)
+	assert.Equal(t, `the code of "add_pet" (POST /pets) and "addPet" (GET /pets/{id}) is named AddPet for both`, warnings[1].Message)
+	assert.Equal(t, `the operationId "ペット" of PUT /pets/{id} doesn't make a valid exported Go name, use ASCII letters and digits`, warnings[2].Message)
+}
+
+func TestCheckDefinitionNames(t *testing.T) {
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "definition names", "version": "1.0"},
+  "paths": {},
+  "definitions": {
+    "Pet": {"type": "object"},
+    "pet test": {"type": "object"},
+    "UserProfile": {"type": "object"},
+    "user_profile": {"type": "object"},
+    "Server_amd64": {"type": "object"},
+    "ペット": {"type": "object"},
+    "money_test": {"type": "string", "x-go-type": {"type": "Money", "import": {"package": "example.com/money"}}}
+  }
+}`), &sw))
+
+	problems := CheckDefinitionNames(&sw)
+	require.Len(t, problems, 4)
+	assert.Equal(t, `#/definitions/Server_amd64: the definition "Server_amd64" is generated in server_amd64.go, which only builds on amd64`, problems[0].String())
+	assert.Equal(t, `#/definitions/pet test: the definition "pet test" is generated in pet_test.go, which the go tool takes for a test file`, problems[1].String())
+	assert.Equal(t, `the definitions "UserProfile" and "user_profile" are both generated as the type UserProfile`, problems[2].Message)
+	assert.Equal(t, "ペット", problems[3].Name)
+	assert.Equal(t, `the definition "ペット" doesn't make a valid exported Go name, use ASCII letters and digits`, problems[3].Message)
+
+	dir, err := ioutil.TempDir("", "definition-names")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	b, err := json.Marshal(sw)
+	require.NoError(t, err)
+	specPath := filepath.Join(dir, "swagger.json")
+	require.NoError(t, ioutil.WriteFile(specPath, b, 0644))
+
+	opts := &GenOpts{Spec: specPath, Target: dir, IncludeModel: true}
+	require.NoError(t, opts.EnsureDefaults(false))
+	err = GenerateDefinition(nil, opts)
+	if assert.Error(t, err) {
+		assert.IsType(t, &DefinitionNamesError{}, err)
+		assert.Contains(t, err.Error(), "- #/definitions/user_profile: the definitions")
+	}
+	_, err = os.Stat(filepath.Join(dir, "models"))
+	assert.True(t, os.IsNotExist(err), "no model should be generated")
+}
+
+func TestGenerateServer_BuildTags(t *testing.T) {
+	target, err := ioutil.TempDir(".", "build-tags")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := manifestGenOpts(target, "../fixtures/codegen/trim.yml")
+	opts.Copyright = "Copyright 2018 Example Corp\nAll rights reserved."
+	opts.BuildTags = "integration linux,!arm"
+	require.NoError(t, GenerateServer("trim", nil, nil, opts))
+
+	for _, file := range []string{"models/pet.go", "restapi/server.go", "restapi/operations/trim_api.go"} {
+		b, err := ioutil.ReadFile(filepath.Join(target, filepath.FromSlash(file)))
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "// +build integration linux,!arm\n", file)
+		assert.Contains(t, string(b), "// Copyright 2018 Example Corp\n// All rights reserved.\n", file)
+	}
+
+	opts = manifestGenOpts(target, "../fixtures/codegen/trim.yml")
+	opts.BuildTags = "integration; rm -rf"
+	err = GenerateServer("trim", nil, nil, opts)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `invalid build tags "integration; rm -rf"`)
+	}
+}
+
+func TestLintDeprecatedParameters(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/deprecation.yml")
+	require.NoError(t, err)
+
+	found := LintDeprecatedParameters(doc.Spec())
+	require.Len(t, found, 2)
+	assert.Equal(t, DeprecatedParameter{
+		Method:  "GET",
+		Path:    "/pets",
+		ID:      "listPets",
+		Name:    "limit",
+		In:      "query",
+		Message: `the query parameter "limit" is deprecated: Use pageSize instead.`,
+	}, found[0])
+	assert.Equal(t, "/pets/search", found[1].Path)
+	assert.Equal(t, "tag", found[1].Name)
+}
+
+func TestCheckConsumes(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/consumes.yml")
+	require.NoError(t, err)
+
+	problems := CheckConsumes(doc.Spec())
+	require.Len(t, problems, 2)
+	assert.Equal(t, OperationProblem{
+		Method:  "POST",
+		Path:    "/pets",
+		ID:      "addPet",
+		Message: "the operation has formData parameters (name, note), but consumes neither application/x-www-form-urlencoded nor multipart/form-data",
+	}, problems[0])
+	// a urlencoded form can't carry a file
+	assert.Equal(t, `POST /pets/{id}/photo: the file parameter "photo" can only be sent in a multipart form, but the operation doesn't consume multipart/form-data`, problems[1].String())
+}
+
+func TestCheckParamDependencies(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/codegen/param-dependencies.yml")
+	require.NoError(t, err)
+	assert.Empty(t, CheckParamDependencies(doc.Spec()))
+
+	sw := doc.Spec()
+	get := sw.Paths.Paths["/pets"].Get
+	get.Extensions[xRequires] = []interface{}{"id", "owner"}
+	get.Parameters[1].Required = true
+	get.Parameters[0].Required = true
+	put := sw.Paths.Paths["/pets/{petId}"].Put
+	put.Extensions[xMutuallyExclusive] = []interface{}{"petId"}
+
+	problems := CheckParamDependencies(sw)
+	require.Len(t, problems, 4)
+	assert.Equal(t, `GET /pets (findPets): x-mutually-exclusive names the required parameters id, name, which are always given together`, problems[0].String())
+	assert.Equal(t, `x-requires names the parameter "owner", which the operation doesn't have`, problems[1].Message)
+	assert.Equal(t, "the groups of x-mutually-exclusive must name at least 2 parameters, got [petId]", problems[2].Message)
+	assert.Equal(t, `x-mutually-exclusive names the path parameter "petId", which is always given`, problems[3].Message)
+}
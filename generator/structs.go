@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/spec"
@@ -66,6 +68,7 @@ type GenSchema struct {
 	CustomTag               string
 	Properties              GenSchemaList
 	AllOf                   []GenSchema
+	OneOf                   []GenSchema
 	HasAdditionalProperties bool
 	IsAdditionalProperties  bool
 	AdditionalProperties    *GenSchema
@@ -184,6 +187,9 @@ type GenParameter struct {
 	Description     string
 	Converter       string
 	Formatter       string
+	Sanitizers      []GenSanitizer
+	// Deprecation is set when the parameter is deprecated, with the x-deprecated extension
+	Deprecation *GenDeprecation
 
 	Schema *GenSchema
 
@@ -252,6 +258,7 @@ type GenItems struct {
 	Parent           *GenItems
 	Converter        string
 	Formatter        string
+	Sanitizers       []GenSanitizer
 
 	Location string
 	IndexVar string
@@ -269,6 +276,8 @@ type GenOperationGroup struct {
 	DefaultImports []string
 	RootPackage    string
 	WithContext    bool
+	// HasPolling is true when an operation of the group is polled until it completes
+	HasPolling bool
 }
 
 // GenOperationGroups is a sorted collection of operation groups
@@ -367,10 +376,132 @@ type GenOperation struct {
 	ConsumesMediaTypes []string
 	WithContext        bool
 	TimeoutName        string
+	Timeout            time.Duration
+	MaxBodySize        int64
+	Pagination         *GenPagination
+	Polling            *GenPolling
+	CreatedLocation    *GenCreatedLocation
+	// CSRF is the CSRF protection of the operation, nil when its requests aren't checked
+	CSRF *GenCSRF
+	// Deprecation is set when the operation is deprecated
+	Deprecation *GenDeprecation
+	// Dependencies are the groups of parameters which require or exclude one another,
+	// and DependentParams the parameters they name
+	Dependencies    []GenParamDependency
+	DependentParams GenParameters
+	// Tests is set when a _test.go file is generated for the operation
+	Tests *GenOperationTests
 
 	Extensions map[string]interface{}
 }
 
+// GenPagination represents the query parameters an operation is paginated with,
+// declared with the x-pagination extension
+type GenPagination struct {
+	Limit  *GenParameter
+	Offset *GenParameter
+	Cursor *GenParameter
+}
+
+// GenParamDependency represents a group of parameters of an operation, one of which is required when declared
+// with the x-requires extension, or which can't be given together with the x-mutually-exclusive extension
+type GenParamDependency struct {
+	Exclusive bool
+	Names     []string
+}
+
+// List is the names of the parameters, separated by commas
+func (g GenParamDependency) List() string {
+	return strings.Join(g.Names, ", ")
+}
+
+// GenSanitizer represents a function cleaning up the raw value of a parameter before it is validated,
+// declared with the x-go-sanitize extension
+type GenSanitizer struct {
+	Name string
+	// Call is the function called with the raw value, which returns the sanitized one
+	Call string
+	// Alias and Import are the package of the function, when it needs one besides strings
+	Alias  string
+	Import string
+}
+
+// GenCSRF represents the CSRF protection of an operation, declared with the x-csrf extension
+// of the operation or of the spec
+type GenCSRF struct {
+	// Mode is double-submit: the Header carries the value of the Cookie,
+	// or header: the request only needs the Header, which browsers don't send across origins
+	Mode   string
+	Cookie string
+	Header string
+}
+
+// IsDoubleSubmit is true when the header must carry the value of the cookie
+func (g *GenCSRF) IsDoubleSubmit() bool {
+	return g.Mode == csrfDoubleSubmit
+}
+
+// GenDeprecation represents the deprecation of an operation, with deprecated or the x-deprecated extension,
+// or of a parameter, with the x-deprecated extension
+type GenDeprecation struct {
+	// Message tells what to use instead, from a x-deprecated string
+	Message string
+	// Sunset is the date the operation is removed after, from the x-sunset extension
+	Sunset time.Time
+	// Headers is set when the server tells the clients of a deprecated operation with the Warning and Sunset headers
+	Headers bool
+}
+
+// Notice is the sentence of the Deprecated: paragraph of the godoc
+func (g *GenDeprecation) Notice() string {
+	notice := strings.TrimSpace(g.Message)
+	if notice == "" {
+		notice = "do not use"
+	}
+	notice = strings.ToUpper(notice[:1]) + notice[1:]
+	if !strings.HasSuffix(notice, ".") {
+		notice += "."
+	}
+	if !g.Sunset.IsZero() {
+		notice += " It is removed after " + g.Sunset.Format("2006-01-02") + "."
+	}
+	return notice
+}
+
+// Warning is the value of the Warning header of the responses to a deprecated operation
+func (g *GenDeprecation) Warning() string {
+	return `299 - "Deprecated: ` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(g.Notice()) + `"`
+}
+
+// SunsetHeader is the value of the Sunset header of the responses to a deprecated operation, empty without a sunset
+func (g *GenDeprecation) SunsetHeader() string {
+	if g.Sunset.IsZero() {
+		return ""
+	}
+	return g.Sunset.UTC().Format(http.TimeFormat)
+}
+
+// GenPolling represents the 202 Accepted response of an operation with a Location header,
+// which the client polls until the operation completes
+type GenPolling struct {
+	// Response is the name of the 202 response
+	Response string
+	// Location and RetryAfter are the names of its headers, RetryAfter is empty when it doesn't declare one
+	Location   string
+	RetryAfter string
+}
+
+// GenCreatedLocation represents the Location header of the 201 Created response of an operation,
+// and the GET operation serving the created resource, whose url builder builds the header
+type GenCreatedLocation struct {
+	// Response is the name of the 201 response, and Location the name of its header
+	Response string
+	Location string
+	// Operation is the name of the GET operation, and PathParams its path parameters
+	Operation  string
+	PathParams GenParameters
+}
+
 // GenOperations represents a list of operations to generate
 // this implements a sort by operation id
 type GenOperations []GenOperation
@@ -401,12 +532,14 @@ type GenApp struct {
 	Consumes            GenSerGroups
 	Produces            GenSerGroups
 	SecurityDefinitions []GenSecurityScheme
+	CSRFCookies         []string
 	Models              []GenDefinition
 	Operations          GenOperations
 	OperationGroups     GenOperationGroups
 	SwaggerJSON         string
 	ExcludeSpec         bool
 	WithContext         bool
+	WithHealth          bool
 	GenOpts             *GenOpts
 }
 
@@ -423,6 +556,11 @@ func (g *GenApp) UsePFlags() bool {
 	return g.GenOpts != nil && strings.HasPrefix(g.GenOpts.FlagStrategy, "pflag")
 }
 
+// UseStdFlags returns true when the flag strategy is set to stdlib
+func (g *GenApp) UseStdFlags() bool {
+	return g.GenOpts != nil && g.GenOpts.FlagStrategy == "stdlib"
+}
+
 // UseIntermediateMode for https://wiki.mozilla.org/Security/Server_Side_TLS#Intermediate_compatibility_.28default.29
 func (g *GenApp) UseIntermediateMode() bool {
 	return g.GenOpts != nil && g.GenOpts.CompatibilityMode == "intermediate"
@@ -486,4 +624,23 @@ type GenSecurityScheme struct {
 	Scopes       []string
 	Source       string
 	Principal    string
+	// Signing is the request signing of an apiKey scheme, nil when its key is sent as it is
+	Signing *GenSigning
+}
+
+// GenSigning represents the x-signing extension of a security scheme:
+// the client signs each request with a secret, and sends the signature in the header of the scheme
+type GenSigning struct {
+	// Algorithm is hmac-sha256, hmac-sha512 or aws-sigv4
+	Algorithm string
+	// Headers are the headers signed with an HMAC algorithm, empty for the default ones
+	Headers []string
+	// Region and Service scope the AWS signatures, an empty region is taken from the host
+	Region  string
+	Service string
+}
+
+// IsSigV4 is true when the requests are signed with AWS credentials
+func (g *GenSigning) IsSigV4() bool {
+	return g.Algorithm == sigV4
 }
@@ -19,8 +19,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	goruntime "runtime"
@@ -36,24 +39,57 @@ import (
 
 // GenerateServer generates a server application
 func GenerateServer(name string, modelNames, operationIDs []string, opts *GenOpts) error {
+	if err := opts.checkCompiles(func(check *GenOpts) error {
+		return GenerateServer(name, modelNames, operationIDs, check)
+	}); err != nil {
+		return err
+	}
+
 	generator, err := newAppGenerator(name, modelNames, operationIDs, opts)
 	if err != nil {
 		return err
 	}
-	return generator.Generate()
+	if err := opts.checkClean(modelNames, operationIDs); err != nil {
+		return err
+	}
+	if err := generator.Generate(); err != nil {
+		return err
+	}
+	return opts.recordGeneration(serverGeneration, modelNames, operationIDs)
 }
 
 // GenerateSupport generates the supporting files for an API
 func GenerateSupport(name string, modelNames, operationIDs []string, opts *GenOpts) error {
+	if err := opts.checkCompiles(func(check *GenOpts) error {
+		return GenerateSupport(name, modelNames, operationIDs, check)
+	}); err != nil {
+		return err
+	}
 
-	generator, err := newAppGenerator(name, modelNames, operationIDs, opts)
+	// the supporting files are built from the operations
+	generator, err := planAppGenerator(name, modelNames, operationIDs, opts, true)
 	if err != nil {
 		return err
 	}
-	return generator.GenerateSupport(nil)
+	if err := opts.checkClean(modelNames, operationIDs); err != nil {
+		return err
+	}
+	if err := generator.GenerateSupport(nil); err != nil {
+		return err
+	}
+	return opts.recordGeneration(serverGeneration, modelNames, operationIDs)
 }
 
 func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOpts) (*appGenerator, error) {
+	if opts == nil {
+		return nil, errors.New("gen opts are required")
+	}
+	return planAppGenerator(name, modelNames, operationIDs, opts, opts.IncludeSupport)
+}
+
+// planAppGenerator loads the spec and gathers the models and operations of an app generator:
+// the operations are only gathered when they, or the supporting files built from them, are generated
+func planAppGenerator(name string, modelNames, operationIDs []string, opts *GenOpts, withSupport bool) (*appGenerator, error) {
 	if opts == nil {
 		return nil, errors.New("gen opts are required")
 	}
@@ -80,6 +116,11 @@ func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOp
 	if err != nil {
 		return nil, err
 	}
+	opts.markGoTypes(specDoc.Spec())
+
+	if err := checkDefinitionNames(opts.Spec, specDoc.Spec()); err != nil {
+		return nil, err
+	}
 
 	analyzed := analysis.New(specDoc.Spec())
 
@@ -88,9 +129,17 @@ func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOp
 		return nil, err
 	}
 
-	operations := gatherOperations(analyzed, operationIDs)
-	if len(operations) == 0 {
-		return nil, errors.New("no operations were selected")
+	// when only models are generated, the operations aren't planned
+	var operations map[string]opRef
+	if opts.shouldRenderOperations() || withSupport {
+		operations = gatherOperations(analyzed, operationIDs)
+		operations, err = filterOperations(operations, opts.IncludeOperations, opts.ExcludeOperations)
+		if err != nil {
+			return nil, err
+		}
+		if len(operations) == 0 {
+			return nil, errors.New("no operations were selected")
+		}
 	}
 
 	defaultScheme := opts.DefaultScheme
@@ -121,9 +170,9 @@ func newAppGenerator(name string, modelNames, operationIDs []string, opts *GenOp
 		DumpData:        opts.DumpData,
 		Package:         apiPackage,
 		APIPackage:      apiPackage,
-		ModelsPackage:   opts.LanguageOpts.MangleName(swag.ToFileName(opts.ModelPackage), "definitions"),
-		ServerPackage:   opts.LanguageOpts.MangleName(swag.ToFileName(opts.ServerPackage), "server"),
-		ClientPackage:   opts.LanguageOpts.MangleName(swag.ToFileName(opts.ClientPackage), "client"),
+		ModelsPackage:   opts.LanguageOpts.ManglePackageName(opts.ModelPackage, "definitions"),
+		ServerPackage:   opts.LanguageOpts.ManglePackageName(opts.ServerPackage, "server"),
+		ClientPackage:   opts.LanguageOpts.ManglePackageName(opts.ClientPackage, "client"),
 		Principal:       opts.Principal,
 		DefaultScheme:   defaultScheme,
 		DefaultProduces: defaultProduces,
@@ -170,11 +219,22 @@ func checkPrefixAndFetchRelativePath(childpath string, parentpath string) (bool,
 
 }
 
+// baseImport finds the import path of a target from the GOPATH it resides in, or the module enclosing it.
+//
+// Like the go tool, a module comes first with GO111MODULE=on, and is only looked for outside the GOPATH otherwise.
+// A target in a vendor directory is imported by its path in the vendor directory.
 func baseImport(tgt string) string {
 	tgtAbsPath, err := filepath.Abs(tgt)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	target := tgtAbsPath
+	modules := os.Getenv("GO111MODULE")
+	if modules == "on" {
+		if pth, ok := moduleImport(target); ok {
+			return pth
+		}
+	}
 	var tgtAbsPathExtended string
 	tgtAbsPathExtended, err = filepath.EvalSymlinks(tgtAbsPath)
 	if err != nil {
@@ -243,12 +303,70 @@ func baseImport(tgt string) string {
 
 	}
 
-	if pth == "" {
-		log.Fatalln("target must reside inside a location in the $GOPATH/src")
+	if pth != "" {
+		return vendoredImport(pth)
+	}
+	if modules != "off" {
+		if pth, ok := moduleImport(target); ok {
+			return pth
+		}
+	}
+	log.Fatalln("target must reside inside a location in the $GOPATH/src or in a module, or its import path must be given with --with-target-import")
+	return ""
+}
+
+// vendoredImport is the import path of a package in a vendor directory: its path in the vendor directory
+func vendoredImport(pth string) string {
+	parts := strings.Split(pth, string(filepath.Separator))
+	for i := len(parts) - 2; i >= 0; i-- {
+		if parts[i] == "vendor" {
+			return filepath.Join(parts[i+1:]...)
+		}
 	}
 	return pth
 }
 
+// moduleImport finds the import path of a directory from the go.mod of the module enclosing it
+func moduleImport(dir string) (string, bool) {
+	for root := dir; ; {
+		if b, err := ioutil.ReadFile(filepath.Join(root, "go.mod")); err == nil {
+			module := modulePath(b)
+			if module == "" {
+				return "", false
+			}
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", false
+			}
+			return filepath.FromSlash(path.Join(module, filepath.ToSlash(rel))), true
+		}
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", false
+		}
+		root = parent
+	}
+}
+
+// modulePath reads the path of a module from its go.mod
+func modulePath(gomod []byte) string {
+	for _, line := range strings.Split(string(gomod), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"`")
+		}
+	}
+	return ""
+}
+
+// baseImport is the import path of the target of a generation: the one given with the options, or the one found from its location
+func (g *GenOpts) baseImport(tgt string) string {
+	if g != nil && g.TargetImportPath != "" {
+		return filepath.FromSlash(strings.Trim(filepath.ToSlash(g.TargetImportPath), "/"))
+	}
+	return baseImport(tgt)
+}
+
 func (a *appGenerator) Generate() error {
 
 	app, err := a.makeCodegenApp()
@@ -337,10 +455,10 @@ func (a *appGenerator) GenerateSupport(ap *GenApp) error {
 		app = &ca
 	}
 
-	importPath := filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ServerPackage, a.APIPackage))
+	importPath := a.GenOpts.packageImport(a.Target, a.GenOpts.ServerPackage, "server", a.APIPackage)
 	app.DefaultImports = append(
 		app.DefaultImports,
-		filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ServerPackage)),
+		a.GenOpts.packageImport(a.Target, a.GenOpts.ServerPackage, "server"),
 		importPath,
 	)
 
@@ -409,18 +527,76 @@ func mediaTypeName(tn string) (string, bool) {
 	return "", false
 }
 
+// serializerName names the consumer or producer of a media type in the api: after the family of the media type
+// when it is known, e.g. "xml", or else after its subtype, e.g. "png" for "image/png". It is empty for a wildcard.
+func serializerName(mediaType string) (name string, known bool) {
+	if nm, ok := mediaTypeName(mediaType); ok {
+		return swag.ToJSONName(nm), true
+	}
+	mt := strings.TrimSpace(strings.SplitN(mediaType, ";", 2)[0])
+	return swag.ToJSONName(mt[strings.LastIndex(mt, "/")+1:]), false
+}
+
+// resolvedMediaTypes gathers the media types the operations consume, or produce: their own ones, or the ones of the spec
+// when they don't declare any, or the default one when the spec doesn't either.
+//
+// It warns about the media types which have to be configured: the ones no serializer is known for, and the ones
+// without a built-in serializer, get a stub which responds with a 501 in the api until they are.
+func (a *appGenerator) resolvedMediaTypes(produces bool) []string {
+	sw := a.SpecDoc.Spec()
+	verb, serializer, global, defaultMediaType, known := "consume", "consumer", sw.Consumes, a.DefaultConsumes, knownConsumers
+	declared := func(op *spec.Operation) []string { return op.Consumes }
+	if produces {
+		verb, serializer, global, defaultMediaType, known = "produce", "producer", sw.Produces, a.DefaultProduces, knownProducers
+		declared = func(op *spec.Operation) []string { return op.Produces }
+	}
+
+	byMediaType := make(map[string][]string)
+	if len(a.Operations) == 0 {
+		for _, mediaType := range producesOrDefault(global, nil, defaultMediaType) {
+			byMediaType[mediaType] = nil
+		}
+	}
+	for name, opr := range a.Operations {
+		for _, mediaType := range producesOrDefault(declared(opr.Op), global, defaultMediaType) {
+			byMediaType[mediaType] = append(byMediaType[mediaType], name)
+		}
+	}
+
+	mediaTypes := make([]string, 0, len(byMediaType))
+	for mediaType, operations := range byMediaType {
+		mediaTypes = append(mediaTypes, mediaType)
+		who := "the api"
+		if len(operations) > 0 {
+			sort.Strings(operations)
+			who = "operations " + strings.Join(operations, ", ")
+		}
+		nm, ok := serializerName(mediaType)
+		field := "api." + pascalize(nm) + swag.ToGoName(serializer)
+		switch {
+		case nm == "":
+			log.Printf("warning: %s %s %q, which no %s can be registered for: it won't be served", who, verb, mediaType, serializer)
+		case !ok:
+			log.Printf("warning: %s %s %q, which no %s is known for: register one as %s", who, verb, mediaType, serializer, field)
+		case known[nm] == "":
+			log.Printf("warning: %s %s %q, which has no built-in %s: register one as %s", who, verb, mediaType, serializer, field)
+		}
+	}
+	sort.Strings(mediaTypes)
+	return mediaTypes
+}
+
 func (a *appGenerator) makeConsumes() (consumes GenSerGroups, consumesJSON bool) {
-	for _, cons := range a.Analyzed.RequiredConsumes() {
-		cn, ok := mediaTypeName(cons)
-		if !ok {
+	for _, cons := range a.resolvedMediaTypes(false) {
+		nm, _ := serializerName(cons)
+		if nm == "" {
 			continue
 		}
-		nm := swag.ToJSONName(cn)
 		if nm == "json" {
 			consumesJSON = true
 		}
 
-		if ser, ok := getSerializer(consumes, cn); ok {
+		if ser, ok := getSerializer(consumes, nm); ok {
 			ser.AllSerializers = append(ser.AllSerializers, GenSerializer{
 				AppName:        ser.AppName,
 				ReceiverName:   ser.ReceiverName,
@@ -471,17 +647,16 @@ func (a *appGenerator) makeConsumes() (consumes GenSerGroups, consumesJSON bool)
 }
 
 func (a *appGenerator) makeProduces() (produces GenSerGroups, producesJSON bool) {
-	for _, prod := range a.Analyzed.RequiredProduces() {
-		pn, ok := mediaTypeName(prod)
-		if !ok {
+	for _, prod := range a.resolvedMediaTypes(true) {
+		nm, _ := serializerName(prod)
+		if nm == "" {
 			continue
 		}
-		nm := swag.ToJSONName(pn)
 		if nm == "json" {
 			producesJSON = true
 		}
 
-		if ser, ok := getSerializer(produces, pn); ok {
+		if ser, ok := getSerializer(produces, nm); ok {
 			ser.AllSerializers = append(ser.AllSerializers, GenSerializer{
 				AppName:        ser.AppName,
 				ReceiverName:   ser.ReceiverName,
@@ -530,7 +705,7 @@ func (a *appGenerator) makeProduces() (produces GenSerGroups, producesJSON bool)
 	return
 }
 
-func (a *appGenerator) makeSecuritySchemes() (security GenSecuritySchemes) {
+func (a *appGenerator) makeSecuritySchemes() (security GenSecuritySchemes, err error) {
 
 	prin := a.Principal
 	if prin == "" {
@@ -545,6 +720,10 @@ func (a *appGenerator) makeSecuritySchemes() (security GenSecuritySchemes) {
 					scopes = append(scopes, k)
 				}
 			}
+			signing, err := makeSigning(scheme, req)
+			if err != nil {
+				return nil, err
+			}
 
 			security = append(security, GenSecurityScheme{
 				AppName:      a.Name,
@@ -557,6 +736,7 @@ func (a *appGenerator) makeSecuritySchemes() (security GenSecuritySchemes) {
 				Scopes:       scopes,
 				Principal:    prin,
 				Source:       req.In,
+				Signing:      signing,
 			})
 		}
 	}
@@ -564,6 +744,72 @@ func (a *appGenerator) makeSecuritySchemes() (security GenSecuritySchemes) {
 	return
 }
 
+// makeSigning reads the request signing of a security scheme from its x-signing extension,
+// the apiKey schemes of the AWS API gateway with the awsSigv4 auth type are signed with AWS credentials
+func makeSigning(name string, scheme *spec.SecurityScheme) (*GenSigning, error) {
+	value, ok := scheme.Extensions[xSigning]
+	if !ok {
+		if authType, _ := scheme.Extensions.GetString("x-amazon-apigateway-authtype"); strings.EqualFold(authType, "awsSigv4") {
+			return &GenSigning{Algorithm: sigV4, Service: "execute-api"}, nil
+		}
+		return nil, nil
+	}
+	if strings.ToLower(scheme.Type) != "apikey" || strings.ToLower(scheme.In) != "header" {
+		return nil, fmt.Errorf("invalid %s for security scheme %q: only the apiKey schemes in a header can be signed", xSigning, name)
+	}
+	ext, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid %s for security scheme %q: expected an object with the algorithm of the signature", xSigning, name)
+	}
+
+	signing := new(GenSigning)
+	for key, raw := range ext {
+		switch key {
+		case "algorithm", "region", "service":
+			str, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s for security scheme %q: %s must be a string", xSigning, name, key)
+			}
+			switch key {
+			case "algorithm":
+				signing.Algorithm = strings.ToLower(str)
+			case "region":
+				signing.Region = str
+			default:
+				signing.Service = str
+			}
+		case "headers":
+			headers, ok := raw.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid %s for security scheme %q: headers must be a list of header names", xSigning, name)
+			}
+			for _, header := range headers {
+				str, ok := header.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid %s for security scheme %q: headers must be a list of header names", xSigning, name)
+				}
+				signing.Headers = append(signing.Headers, strings.ToLower(str))
+			}
+		default:
+			return nil, fmt.Errorf("invalid %s for security scheme %q: unknown key %s", xSigning, name, key)
+		}
+	}
+
+	switch signing.Algorithm {
+	case "hmac-sha256", "hmac-sha512":
+		if signing.Region != "" || signing.Service != "" {
+			return nil, fmt.Errorf("invalid %s for security scheme %q: region and service are only used by %s", xSigning, name, sigV4)
+		}
+	case sigV4:
+		if len(signing.Headers) > 0 {
+			return nil, fmt.Errorf("invalid %s for security scheme %q: the headers signed by %s can't be chosen", xSigning, name, sigV4)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s for security scheme %q: the algorithm must be hmac-sha256, hmac-sha512 or %s, got %q", xSigning, name, sigV4, signing.Algorithm)
+	}
+	return signing, nil
+}
+
 func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 	log.Println("building a plan for generation")
 	sw := a.SpecDoc.Spec()
@@ -571,7 +817,21 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 
 	var defaultImports []string
 
-	jsonb, _ := json.MarshalIndent(a.SpecDoc.OrigSpec(), "", "  ")
+	withHealth := a.GenOpts != nil && a.GenOpts.IncludeHealth
+	served := a.SpecDoc.OrigSpec()
+	if withHealth {
+		if sw.Paths != nil {
+			for _, probe := range healthProbes {
+				if _, ok := sw.Paths.Paths[probe]; ok {
+					log.Printf("warning: the %s probe of --with-health is served instead of the path of the spec", probe)
+				}
+			}
+		}
+		if a.GenOpts.HealthInSpec {
+			served = withHealthProbes(served)
+		}
+	}
+	jsonb, _ := json.MarshalIndent(served, "", "  ")
 
 	consumes, _ := a.makeConsumes()
 	produces, _ := a.makeProduces()
@@ -581,12 +841,15 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 	if prin == "" {
 		prin = "interface{}"
 	}
-	security := a.makeSecuritySchemes()
+	security, err := a.makeSecuritySchemes()
+	if err != nil {
+		return GenApp{}, err
+	}
 
 	var genMods []GenDefinition
 	importPath := a.GenOpts.ExistingModels
 	if a.GenOpts.ExistingModels == "" {
-		importPath = filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ModelsPackage))
+		importPath = a.GenOpts.packageImport(a.Target, a.GenOpts.ModelPackage, "definitions")
 	}
 
 	defaultImports = append(defaultImports, importPath)
@@ -610,6 +873,9 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 	}
 
 	log.Println("planning operations")
+	for _, warning := range LintOperationIDs(a.SpecDoc.Spec()) {
+		log.Printf("warning: %s", warning)
+	}
 	tns := make(map[string]struct{})
 	var genOps GenOperations
 	for on, opp := range a.Operations {
@@ -626,6 +892,7 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		bldr.Analyzed = a.Analyzed
 		bldr.BasePath = a.SpecDoc.BasePath()
 		bldr.GenOpts = a.GenOpts
+		bldr.IncludeValidator = true // the inline body schemas get validated when bound
 
 		// TODO: change operation name to something safe
 		bldr.Name = on
@@ -648,7 +915,7 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 			continue
 		}
 
-		if len(intersected) == 1 {
+		if len(intersected) == 1 && !a.GenOpts.FlatOperations {
 			tag := intersected[0]
 			bldr.APIPackage = a.GenOpts.LanguageOpts.MangleName(swag.ToFileName(tag), a.APIPackage)
 			for _, t := range intersected {
@@ -661,14 +928,18 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		}
 		op.ReceiverName = receiver
 		op.Tags = intersected
+		if a.GenOpts != nil && a.GenOpts.IncludeTests {
+			op.Tests = a.makeOperationTests(&op, opp.Method, opp.Path, security)
+		}
 		genOps = append(genOps, op)
 
 	}
 	for k := range tns {
-		importPath := filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ServerPackage, a.APIPackage, swag.ToFileName(k)))
+		importPath := a.GenOpts.packageImport(a.Target, a.GenOpts.ServerPackage, "server", a.APIPackage, swag.ToFileName(k))
 		defaultImports = append(defaultImports, importPath)
 	}
 	sort.Sort(genOps)
+	linkCreatedLocations(genOps)
 
 	log.Println("grouping operations into packages")
 	opsGroupedByPackage := make(map[string]GenOperations)
@@ -688,16 +959,22 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 			},
 			Name:           k,
 			Operations:     v,
-			DefaultImports: []string{filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ModelsPackage))},
+			DefaultImports: []string{a.GenOpts.packageImport(a.Target, a.GenOpts.ModelPackage, "definitions")},
+			Imports:        a.GenOpts.goTypeImports(),
 			RootPackage:    a.APIPackage,
 			WithContext:    a.GenOpts != nil && a.GenOpts.WithContext,
 		}
+		for _, op := range v {
+			if op.Polling != nil {
+				opGroup.HasPolling = true
+			}
+		}
 		opGroups = append(opGroups, opGroup)
 		var importPath string
 		if k == a.APIPackage {
-			importPath = filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ServerPackage, a.APIPackage))
+			importPath = a.GenOpts.packageImport(a.Target, a.GenOpts.ServerPackage, "server", a.APIPackage)
 		} else {
-			importPath = filepath.ToSlash(filepath.Join(baseImport(a.Target), a.ServerPackage, a.APIPackage, k))
+			importPath = a.GenOpts.packageImport(a.Target, a.GenOpts.ServerPackage, "server", a.APIPackage, k)
 		}
 		defaultImports = append(defaultImports, importPath)
 	}
@@ -707,12 +984,17 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 
 	var collectedSchemes []string
 	var extraSchemes []string
+	var csrfCookies []string
 	for _, op := range genOps {
 		collectedSchemes = concatUnique(collectedSchemes, op.Schemes)
 		extraSchemes = concatUnique(extraSchemes, op.ExtraSchemes)
+		if op.CSRF != nil && op.CSRF.IsDoubleSubmit() {
+			csrfCookies = concatUnique(csrfCookies, []string{op.CSRF.Cookie})
+		}
 	}
 	sort.Strings(collectedSchemes)
 	sort.Strings(extraSchemes)
+	sort.Strings(csrfCookies)
 
 	host := "localhost"
 	if sw.Host != "" {
@@ -743,7 +1025,9 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		DefaultConsumes:     a.DefaultConsumes,
 		DefaultProduces:     a.DefaultProduces,
 		DefaultImports:      defaultImports,
+		Imports:             a.GenOpts.goTypeImports(),
 		SecurityDefinitions: security,
+		CSRFCookies:         csrfCookies,
 		Models:              genMods,
 		Operations:          genOps,
 		OperationGroups:     opGroups,
@@ -751,10 +1035,40 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 		SwaggerJSON:         generateReadableSpec(jsonb),
 		ExcludeSpec:         a.GenOpts != nil && a.GenOpts.ExcludeSpec,
 		WithContext:         a.GenOpts != nil && a.GenOpts.WithContext,
+		WithHealth:          withHealth,
 		GenOpts:             a.GenOpts,
 	}, nil
 }
 
+// healthProbes are the paths of the probes served with --with-health, relative to the base path
+var healthProbes = []string{"/healthz", "/readyz"}
+
+// withHealthProbes documents the probes of --with-health in a copy of the spec
+func withHealthProbes(sw *spec.Swagger) *spec.Swagger {
+	documented := *sw
+	paths := spec.Paths{Paths: make(map[string]spec.PathItem)}
+	if sw.Paths != nil {
+		paths.VendorExtensible = sw.Paths.VendorExtensible
+		for k, v := range sw.Paths.Paths {
+			paths.Paths[k] = v
+		}
+	}
+	descriptions := map[string]string{
+		"/healthz": "The service is alive when the health checks registered in the api pass.",
+		"/readyz":  "The service is ready to serve requests when the readiness checks registered in the api pass.",
+	}
+	for _, probe := range healthProbes {
+		op := spec.NewOperation(strings.TrimPrefix(probe, "/")).
+			WithDescription(descriptions[probe]).
+			WithProduces(runtime.JSONMime).
+			RespondsWith(http.StatusOK, spec.NewResponse().WithDescription("the checks pass")).
+			RespondsWith(http.StatusServiceUnavailable, spec.NewResponse().WithDescription("a check fails"))
+		paths.Paths[probe] = spec.PathItem{PathItemProps: spec.PathItemProps{Get: op}}
+	}
+	documented.Paths = &paths
+	return &documented
+}
+
 // generateReadableSpec makes swagger json spec as a string instead of bytes
 // the only character that needs to be escaped is '`' symbol, since it cannot be escaped in the GO string
 // that is quoted as `string data`. The function doesn't care about the beginning or the ending of the
@@ -1,10 +1,15 @@
 package generator
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	goruntime "runtime"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var checkprefixandfetchrelativepathtests = []struct {
@@ -107,3 +112,96 @@ func TestBaseImport(t *testing.T) {
 	}
 
 }
+
+func setEnv(t testing.TB, key, value string) func() {
+	previous, isSet := os.LookupEnv(key)
+	require.NoError(t, os.Setenv(key, value))
+	return func() {
+		if isSet {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestBaseImport_VendorAndModules(t *testing.T) {
+	root, err := ioutil.TempDir("", "base-import")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	root, err = filepath.EvalSymlinks(root)
+	require.NoError(t, err)
+
+	gopath := filepath.Join(root, "go")
+	vendored := filepath.Join(gopath, "src", "github.com", "me", "app", "vendor", "github.com", "them", "api")
+	require.NoError(t, os.MkdirAll(vendored, 0755))
+	module := filepath.Join(root, "mono")
+	target := filepath.Join(module, "services", "pets")
+	require.NoError(t, os.MkdirAll(target, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(module, "go.mod"), []byte("module \"example.com/mono\"\n\nrequire github.com/go-openapi/runtime v0.1.0\n"), 0644))
+	// a module in the GOPATH
+	inGopath := filepath.Join(gopath, "src", "github.com", "me", "svc")
+	require.NoError(t, os.MkdirAll(inGopath, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(inGopath, "go.mod"), []byte("module example.com/svc\n"), 0644))
+
+	defer setEnv(t, "GOPATH", gopath)()
+	restore := setEnv(t, "GO111MODULE", "auto")
+	defer restore()
+
+	assert.Equal(t, filepath.FromSlash("github.com/them/api"), baseImport(vendored), "a vendored package is imported by its path in the vendor directory")
+	assert.Equal(t, filepath.FromSlash("example.com/mono/services/pets"), baseImport(target))
+	assert.Equal(t, filepath.FromSlash("example.com/mono"), baseImport(module))
+	assert.Equal(t, filepath.FromSlash("github.com/me/svc"), baseImport(inGopath), "the GOPATH comes first unless the modules are on")
+
+	require.NoError(t, os.Setenv("GO111MODULE", "on"))
+	assert.Equal(t, filepath.FromSlash("example.com/svc"), baseImport(inGopath))
+
+	opts := &GenOpts{TargetImportPath: "example.com/given/"}
+	assert.Equal(t, filepath.FromSlash("example.com/given"), opts.baseImport(target))
+	assert.Equal(t, "example.com/given/models", opts.packageImport(target, "models", "definitions"))
+}
+
+func TestGenerateServer_TargetImport(t *testing.T) {
+	target, err := ioutil.TempDir("", "target-import")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := manifestGenOpts(target, "../fixtures/codegen/trim.yml")
+	opts.TargetImportPath = "example.com/outside/gopath"
+	require.NoError(t, GenerateServer("trim", nil, nil, opts))
+
+	b, err := ioutil.ReadFile(filepath.Join(target, "restapi", "operations", "pets", "get_pet_responses.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"example.com/outside/gopath/models"`)
+	b, err = ioutil.ReadFile(filepath.Join(target, "restapi", "configure_trim.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"example.com/outside/gopath/restapi/operations"`)
+	assert.Contains(t, string(b), "--with-target-import example.com/outside/gopath")
+}
+
+func TestGenerateSupport_DefaultOpts(t *testing.T) {
+	target, err := ioutil.TempDir(".", "support")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	// the options of the generate support command, which doesn't ask for the supporting files explicitly
+	opts := &GenOpts{
+		Spec:          "../fixtures/codegen/trim.yml",
+		Target:        target,
+		APIPackage:    "operations",
+		ModelPackage:  "models",
+		ServerPackage: "restapi",
+		ClientPackage: "client",
+	}
+	require.NoError(t, GenerateSupport("trim", nil, nil, opts))
+	assert.False(t, opts.IncludeSupport)
+
+	assert.True(t, fileExists(filepath.Join(target, "restapi"), "server.go"))
+	// the api and its configuration are built from the operations
+	b, err := ioutil.ReadFile(filepath.Join(target, "restapi", "operations", "trim_api.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "GetPetHandler")
+	b, err = ioutil.ReadFile(filepath.Join(target, "restapi", "configure_trim.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "api.PetsGetPetHandler")
+}
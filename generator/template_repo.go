@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 	"text/template/parse"
@@ -103,6 +104,12 @@ var FuncMap template.FuncMap = map[string]interface{}{
 		b, _ := json.Marshal(v)
 		return strings.Replace(string(b), "\"", "'", -1)
 	},
+	"markdownCell": func(str string) string {
+		return strings.Replace(strings.Replace(strings.TrimSpace(str), "|", "\\|", -1), "\n", "<br>", -1)
+	},
+	"flagDescription": func(str string) string {
+		return strings.Replace(strconv.Quote(str), "`", "\\x60", -1)
+	},
 }
 
 func init() {
@@ -120,6 +127,7 @@ var assets = map[string][]byte{
 	"structfield.gotmpl":                    MustAsset("templates/structfield.gotmpl"),
 	"tupleserializer.gotmpl":                MustAsset("templates/tupleserializer.gotmpl"),
 	"additionalpropertiesserializer.gotmpl": MustAsset("templates/additionalpropertiesserializer.gotmpl"),
+	"oneof.gotmpl":                          MustAsset("templates/oneof.gotmpl"),
 	"schematype.gotmpl":                     MustAsset("templates/schematype.gotmpl"),
 	"schemabody.gotmpl":                     MustAsset("templates/schemabody.gotmpl"),
 	"schema.gotmpl":                         MustAsset("templates/schema.gotmpl"),
@@ -128,20 +136,33 @@ var assets = map[string][]byte{
 	"header.gotmpl":                         MustAsset("templates/header.gotmpl"),
 	"swagger_json_embed.gotmpl":             MustAsset("templates/swagger_json_embed.gotmpl"),
 
-	"server/parameter.gotmpl":    MustAsset("templates/server/parameter.gotmpl"),
-	"server/urlbuilder.gotmpl":   MustAsset("templates/server/urlbuilder.gotmpl"),
-	"server/responses.gotmpl":    MustAsset("templates/server/responses.gotmpl"),
-	"server/operation.gotmpl":    MustAsset("templates/server/operation.gotmpl"),
-	"server/builder.gotmpl":      MustAsset("templates/server/builder.gotmpl"),
-	"server/server.gotmpl":       MustAsset("templates/server/server.gotmpl"),
-	"server/configureapi.gotmpl": MustAsset("templates/server/configureapi.gotmpl"),
-	"server/main.gotmpl":         MustAsset("templates/server/main.gotmpl"),
-	"server/doc.gotmpl":          MustAsset("templates/server/doc.gotmpl"),
+	"server/parameter.gotmpl":         MustAsset("templates/server/parameter.gotmpl"),
+	"server/urlbuilder.gotmpl":        MustAsset("templates/server/urlbuilder.gotmpl"),
+	"server/responses.gotmpl":         MustAsset("templates/server/responses.gotmpl"),
+	"server/operation.gotmpl":         MustAsset("templates/server/operation.gotmpl"),
+	"server/operation_test.gotmpl":    MustAsset("templates/server/operation_test.gotmpl"),
+	"server/builder.gotmpl":           MustAsset("templates/server/builder.gotmpl"),
+	"server/jwt.gotmpl":               MustAsset("templates/server/jwt.gotmpl"),
+	"server/server.gotmpl":            MustAsset("templates/server/server.gotmpl"),
+	"server/configureapi.gotmpl":      MustAsset("templates/server/configureapi.gotmpl"),
+	"server/main.gotmpl":              MustAsset("templates/server/main.gotmpl"),
+	"server/doc.gotmpl":               MustAsset("templates/server/doc.gotmpl"),
+	"server/versions.gotmpl":          MustAsset("templates/server/versions.gotmpl"),
+	"server/versionsmain.gotmpl":      MustAsset("templates/server/versionsmain.gotmpl"),
+	"server/configureversions.gotmpl": MustAsset("templates/server/configureversions.gotmpl"),
 
 	"client/parameter.gotmpl": MustAsset("templates/client/parameter.gotmpl"),
 	"client/response.gotmpl":  MustAsset("templates/client/response.gotmpl"),
 	"client/client.gotmpl":    MustAsset("templates/client/client.gotmpl"),
 	"client/facade.gotmpl":    MustAsset("templates/client/facade.gotmpl"),
+	"client/mock.gotmpl":      MustAsset("templates/client/mock.gotmpl"),
+	"client/cache.gotmpl":     MustAsset("templates/client/cache.gotmpl"),
+	"client/signing.gotmpl":   MustAsset("templates/client/signing.gotmpl"),
+
+	"cli/main.gotmpl": MustAsset("templates/cli/main.gotmpl"),
+
+	"docs/markdown.gotmpl": MustAsset("templates/docs/markdown.gotmpl"),
+	"docs/html.gotmpl":     MustAsset("templates/docs/html.gotmpl"),
 }
 
 var protectedTemplates = map[string]bool{
@@ -184,6 +205,9 @@ var protectedTemplates = map[string]bool{
 	"structfield":                    true,
 	"hasDiscriminatedSerializer":     true,
 	"discriminatedSerializer":        true,
+	"oneof":                          true,
+	"oneOfSchema":                    true,
+	"oneOfTypes":                     true,
 }
 
 // AddFile adds a file to the default repository. It will create a new template based on the filename.
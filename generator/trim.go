@@ -0,0 +1,258 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// OperationFilter selects operations of a spec
+type OperationFilter struct {
+	// Operations are the ids of the operations to keep
+	Operations []string
+	// Tags are the tags of the operations to keep
+	Tags []string
+	// Include and Exclude are regular expressions matched against the id
+	// and the "METHOD /path" of the operations to keep or to drop
+	Include []string
+	Exclude []string
+}
+
+// filterOperations keeps the operations that match one of the include patterns, when there are some,
+// and none of the exclude patterns
+func filterOperations(operations map[string]opRef, include, exclude []string) (map[string]opRef, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return operations, nil
+	}
+	includeRx, err := compilePatterns(include)
+	if err != nil {
+		return nil, err
+	}
+	excludeRx, err := compilePatterns(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make(map[string]opRef, len(operations))
+	for name, opr := range operations {
+		if len(includeRx) > 0 && !matchOperation(includeRx, name, opr) {
+			continue
+		}
+		if matchOperation(excludeRx, name, opr) {
+			continue
+		}
+		filtered[name] = opr
+	}
+	return filtered, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		rx, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid operation pattern %q: %v", pattern, err)
+		}
+		compiled = append(compiled, rx)
+	}
+	return compiled, nil
+}
+
+func matchOperation(patterns []*regexp.Regexp, name string, opr opRef) bool {
+	route := strings.ToUpper(opr.Method) + " " + opr.Path
+	for _, rx := range patterns {
+		if rx.MatchString(name) || rx.MatchString(route) {
+			return true
+		}
+	}
+	return false
+}
+
+// TrimSpec removes the operations the filter doesn't select from a spec,
+// with the definitions, parameters, responses and tags only they were using
+func TrimSpec(sw *spec.Swagger, filter OperationFilter) error {
+	operations := gatherOperations(analysis.New(sw), filter.Operations)
+	operations, err := filterOperations(operations, filter.Include, filter.Exclude)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[string]bool, len(operations))
+	for _, opr := range operations {
+		if len(filter.Tags) > 0 && len(intersectTags(pruneEmpty(opr.Op.Tags), filter.Tags)) == 0 {
+			continue
+		}
+		kept[strings.ToUpper(opr.Method)+" "+opr.Path] = true
+	}
+	if len(kept) == 0 {
+		return errors.New("no operations were selected")
+	}
+
+	if sw.Paths != nil {
+		for path, item := range sw.Paths.Paths {
+			ops := map[string]**spec.Operation{
+				"GET":     &item.Get,
+				"PUT":     &item.Put,
+				"POST":    &item.Post,
+				"DELETE":  &item.Delete,
+				"OPTIONS": &item.Options,
+				"HEAD":    &item.Head,
+				"PATCH":   &item.Patch,
+			}
+			remaining := 0
+			for method, op := range ops {
+				if *op == nil {
+					continue
+				}
+				if !kept[method+" "+path] {
+					*op = nil
+					continue
+				}
+				remaining++
+			}
+			if remaining == 0 {
+				delete(sw.Paths.Paths, path)
+				continue
+			}
+			sw.Paths.Paths[path] = item
+		}
+	}
+
+	return pruneUnused(sw)
+}
+
+// pruneUnused removes the definitions, parameters, responses and tags the paths don't use, directly or through refs
+func pruneUnused(sw *spec.Swagger) error {
+	used := map[string]map[string]bool{
+		"definitions": make(map[string]bool),
+		"parameters":  make(map[string]bool),
+		"responses":   make(map[string]bool),
+	}
+	tags := make(map[string]bool)
+
+	var pending []interface{}
+	if sw.Paths != nil {
+		pending = append(pending, sw.Paths)
+		for _, item := range sw.Paths.Paths {
+			for _, op := range []*spec.Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch} {
+				if op != nil {
+					for _, tag := range op.Tags {
+						tags[tag] = true
+					}
+				}
+			}
+		}
+	}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		refs, err := collectRefs(current)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			parts := strings.SplitN(strings.TrimPrefix(ref, "#/"), "/", 3)
+			if !strings.HasPrefix(ref, "#/") || len(parts) < 2 {
+				// refs to other documents are kept as they are
+				continue
+			}
+			section, name := parts[0], jsonpointer.Unescape(parts[1])
+			if used[section] == nil || used[section][name] {
+				continue
+			}
+			used[section][name] = true
+			switch section {
+			case "definitions":
+				if sch, ok := sw.Definitions[name]; ok {
+					pending = append(pending, sch)
+				}
+			case "parameters":
+				if param, ok := sw.Parameters[name]; ok {
+					pending = append(pending, param)
+				}
+			case "responses":
+				if resp, ok := sw.Responses[name]; ok {
+					pending = append(pending, resp)
+				}
+			}
+		}
+	}
+
+	for name := range sw.Definitions {
+		if !used["definitions"][name] {
+			delete(sw.Definitions, name)
+		}
+	}
+	for name := range sw.Parameters {
+		if !used["parameters"][name] {
+			delete(sw.Parameters, name)
+		}
+	}
+	for name := range sw.Responses {
+		if !used["responses"][name] {
+			delete(sw.Responses, name)
+		}
+	}
+	var keptTags []spec.Tag
+	for _, tag := range sw.Tags {
+		if tags[tag.Name] {
+			keptTags = append(keptTags, tag)
+		}
+	}
+	sw.Tags = keptTags
+	return nil
+}
+
+// collectRefs returns all the $refs in a part of a spec
+func collectRefs(value interface{}) ([]string, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	var refs []string
+	var walk func(interface{})
+	walk = func(node interface{}) {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for k, v := range n {
+				if ref, ok := v.(string); ok && k == "$ref" {
+					refs = append(refs, ref)
+					continue
+				}
+				walk(v)
+			}
+		case []interface{}:
+			for _, v := range n {
+				walk(v)
+			}
+		}
+	}
+	walk(doc)
+	return refs, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTrimSpec(t *testing.T) *spec.Swagger {
+	specDoc, err := loads.Spec("../fixtures/codegen/trim.yml")
+	require.NoError(t, err)
+	return specDoc.Spec()
+}
+
+func definitionNames(sw *spec.Swagger) []string {
+	var names []string
+	for name := range sw.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestFilterOperations(t *testing.T) {
+	operations := gatherOperations(analysis.New(loadTrimSpec(t)), nil)
+	require.Len(t, operations, 4)
+
+	filtered, err := filterOperations(operations, []string{`^GET /pets`}, nil)
+	require.NoError(t, err)
+	assert.Len(t, filtered, 2)
+	assert.Contains(t, filtered, "listPets")
+	assert.Contains(t, filtered, "getPet")
+
+	filtered, err = filterOperations(operations, []string{`^list`}, []string{`Stores$`})
+	require.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Contains(t, filtered, "listPets")
+
+	filtered, err = filterOperations(operations, nil, []string{`^DELETE `})
+	require.NoError(t, err)
+	assert.Len(t, filtered, 3)
+	assert.NotContains(t, filtered, "deletePet")
+
+	_, err = filterOperations(operations, []string{`(`}, nil)
+	assert.Error(t, err)
+}
+
+func TestTrimSpec(t *testing.T) {
+	sw := loadTrimSpec(t)
+	require.NoError(t, TrimSpec(sw, OperationFilter{Operations: []string{"getPet"}}))
+
+	require.Len(t, sw.Paths.Paths, 1)
+	item := sw.Paths.Paths["/pets/{petId}"]
+	assert.NotNil(t, item.Get)
+	assert.Nil(t, item.Delete)
+	assert.Equal(t, []string{"Address", "Error", "Owner", "Pet"}, definitionNames(sw))
+	assert.Contains(t, sw.Parameters, "petId")
+	assert.NotContains(t, sw.Parameters, "limit")
+	assert.Contains(t, sw.Responses, "notFound")
+	assert.NotContains(t, sw.Responses, "unauthorized")
+	require.Len(t, sw.Tags, 1)
+	assert.Equal(t, "pets", sw.Tags[0].Name)
+}
+
+func TestTrimSpec_TagsAndPatterns(t *testing.T) {
+	sw := loadTrimSpec(t)
+	require.NoError(t, TrimSpec(sw, OperationFilter{Tags: []string{"stores", "admin"}}))
+	assert.Len(t, sw.Paths.Paths, 2)
+	assert.NotNil(t, sw.Paths.Paths["/pets/{petId}"].Delete)
+	assert.Nil(t, sw.Paths.Paths["/pets/{petId}"].Get)
+	assert.Equal(t, []string{"Address", "Store"}, definitionNames(sw))
+	assert.Contains(t, sw.Responses, "unauthorized")
+	assert.Len(t, sw.Tags, 3)
+
+	sw = loadTrimSpec(t)
+	require.NoError(t, TrimSpec(sw, OperationFilter{Exclude: []string{`/pets`}}))
+	assert.Len(t, sw.Paths.Paths, 1)
+	assert.Empty(t, sw.Parameters)
+	assert.Empty(t, sw.Responses)
+
+	sw = loadTrimSpec(t)
+	assert.Error(t, TrimSpec(sw, OperationFilter{Include: []string{`^PATCH `}}))
+}
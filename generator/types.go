@@ -40,8 +40,21 @@ const (
 	xNullable   = "x-nullable"
 	xIsNullable = "x-isnullable"
 	xOmitEmpty  = "x-omitempty"
+	xTimeout    = "x-timeout"
+	xPagination = "x-pagination"
+	xOneOf      = "x-one-of"
+	xSigning    = "x-signing"
+	xCSRF       = "x-csrf"
+	xMaxBody    = "x-max-body-size"
+	xGoSanitize = "x-go-sanitize"
+	xDeprecated = "x-deprecated"
+	xSunset     = "x-sunset"
+	sigV4       = "aws-sigv4"
 	sHTTP       = "http"
 	body        = "body"
+
+	xRequires          = "x-requires"
+	xMutuallyExclusive = "x-mutually-exclusive"
 )
 
 var zeroes = map[string]string{
@@ -282,7 +295,8 @@ func (t *typeResolver) NewWithModelName(name string) *typeResolver {
 
 func (t *typeResolver) IsNullable(schema *spec.Schema) bool {
 	nullable := t.isNullable(schema)
-	return nullable || len(schema.AllOf) > 0
+	_, oneOf := schema.Extensions[xOneOf]
+	return nullable || len(schema.AllOf) > 0 || oneOf
 }
 
 func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (returns bool, result resolvedType, err error) {
@@ -487,6 +501,13 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 		result.Pkg = pkg
 		result.PkgAlias = alias
 	}
+	if _, ok := schema.Extensions[xOneOf]; ok {
+		// a union of the schemas listed in the extension, generated as a struct wrapping one of them
+		result.IsComplexObject = true
+		result.IsNullable = t.IsNullable(schema)
+		result.SwaggerType = object
+		return
+	}
 	if len(schema.AllOf) > 0 {
 		result.GoType = t.goTypeName(t.ModelName)
 		result.IsComplexObject = true
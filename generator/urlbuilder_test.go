@@ -155,6 +155,8 @@ func testArrayQueryParams(t testing.TB, filePath, basePath string) {
 					assertInCode(t, `_path = strings.Replace(_path, "{id}", id, -1)`, res)
 					assertInCode(t, `return nil, errors.New("ID is required on ArrayQueryParamsURL")`, res)
 					assertInCode(t, "_basePath := o._basePath", res)
+					assertInCode(t, "func (o *ArrayQueryParamsURL) WithPathPrefix(prefix string) *ArrayQueryParamsURL", res)
+					assertInCode(t, "result.Path = golangswaggerpaths.Join(o._pathPrefix, result.Path)", res)
 					if basePath != "" {
 						assertInCode(t, `if _basePath == ""`, res)
 						assertInCode(t, `_basePath = "`+basePath+`"`, res)
@@ -0,0 +1,509 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+const (
+	// VersionByBasePath selects the version of a request by the base path of its spec the path of the request starts with
+	VersionByBasePath = "path"
+	// VersionByHeader selects the version of a request by a header naming it
+	VersionByHeader = "header"
+	// DefaultVersionHeader is the header naming the version of a request, by default
+	DefaultVersionHeader = "X-API-Version"
+)
+
+// APIVersion is a version of an api, generated from its own spec
+type APIVersion struct {
+	Name string
+	Spec string
+}
+
+// VersionOpts are the versions a server serves together, and how it selects the version of a request
+type VersionOpts struct {
+	Versions []APIVersion
+	// Strategy is VersionByBasePath (the default) or VersionByHeader
+	Strategy string
+	// Header names the version of a request with VersionByHeader, DefaultVersionHeader by default
+	Header string
+	// Default serves the requests which select no version, the last version by default
+	Default string
+	// Mount serves different apis by their base path instead of versions of the same api: each one is named after
+	// its spec, and a request outside of their base paths is not found
+	Mount bool
+}
+
+// GenAPIVersion is a version of an api served by a versioned server
+type GenAPIVersion struct {
+	// Name of the version, as given
+	Name string
+	// Package is the name of the server package of the version, which prefixes its variables in main
+	Package    string
+	Import     string
+	APIPackage string
+	APIImport  string
+	// APIName is the name of the api of the version, like its NewXXXAPI constructor
+	APIName string
+}
+
+// GenVersionedApp is the data of the files serving the versions of an api together
+type GenVersionedApp struct {
+	GenCommon
+	Name         string
+	Package      string
+	Import       string
+	Strategy     string
+	Header       string
+	Default      GenAPIVersion
+	Versions     []GenAPIVersion
+	SharedModels []string
+	Title        string
+	Description  string
+	Mount        bool
+	GenOpts      *GenOpts
+}
+
+// xGoType is a definition generated in another package than the models of the spec
+type xGoType struct {
+	Type    string
+	Package string
+	Alias   string
+}
+
+func (x xGoType) extension() map[string]interface{} {
+	return map[string]interface{}{
+		"type": x.Type,
+		"import": map[string]interface{}{
+			"package": x.Package,
+			"alias":   x.Alias,
+		},
+	}
+}
+
+// markGoTypes marks the definitions generated in another package with an x-go-type extension, so they are imported
+func (g *GenOpts) markGoTypes(sw *spec.Swagger) {
+	if g == nil {
+		return
+	}
+	for name, xt := range g.goTypes {
+		schema, ok := sw.Definitions[name]
+		if !ok {
+			continue
+		}
+		schema.AddExtension("x-go-type", xt.extension())
+		sw.Definitions[name] = schema
+	}
+}
+
+// goTypeImports lists the packages of the definitions generated in another package, by their alias
+func (g *GenOpts) goTypeImports() map[string]string {
+	if g == nil {
+		return nil
+	}
+	imports := make(map[string]string, len(g.goTypes))
+	for _, xt := range g.goTypes {
+		imports[xt.Alias] = xt.Package
+	}
+	return imports
+}
+
+// GenerateVersionedServer generates a server serving several versions of an api, each one from its own spec.
+//
+// Each version gets its server and models packages in a directory named after it, like v1/restapi and v1/models.
+// The definitions which are the same in all the versions defining them are generated once, in the models package,
+// and the server package selects the version serving each request. With Mount, the specs are different apis,
+// each one served under its own base path.
+func GenerateVersionedServer(name string, versions VersionOpts, opts *GenOpts) error {
+	if err := opts.checkCompiles(func(check *GenOpts) error {
+		return GenerateVersionedServer(name, versions, check)
+	}); err != nil {
+		return err
+	}
+
+	if opts == nil {
+		return errors.New("gen opts are required")
+	}
+	if err := opts.EnsureDefaults(false); err != nil {
+		return err
+	}
+	if err := versions.check(opts); err != nil {
+		return err
+	}
+	if err := opts.checkClean(nil, nil); err != nil {
+		return err
+	}
+
+	docs := make([]*loads.Document, len(versions.Versions))
+	for i, version := range versions.Versions {
+		var err error
+		if versions.Versions[i].Spec, docs[i], err = loadSpec(version.Spec); err != nil {
+			return err
+		}
+	}
+	if versions.Strategy == VersionByBasePath {
+		if err := checkBasePaths(versions.Versions, docs); err != nil {
+			return err
+		}
+	}
+
+	shared := sharedDefinitions(docs)
+	goTypes := make(map[string]xGoType, len(shared))
+	// the versions import the shared models with an alias, their own models package having the same name
+	alias := "shared" + opts.languageOpts().ManglePackageName(opts.ModelPackage, "definitions")
+	for defName := range shared {
+		goTypes[defName] = xGoType{
+			Type:    swag.ToGoName(defName),
+			Package: opts.packageImport(opts.Target, opts.ModelPackage, "definitions"),
+			Alias:   alias,
+		}
+	}
+	if len(shared) > 0 && opts.IncludeModel {
+		if err := opts.generateSharedModels(name, shared); err != nil {
+			return err
+		}
+	}
+
+	app := GenVersionedApp{
+		GenCommon: GenCommon{Copyright: opts.Copyright},
+		Package:   opts.languageOpts().ManglePackageName(opts.ServerPackage, "server"),
+		Import:    opts.packageImport(opts.Target, opts.ServerPackage, "server"),
+		Strategy:  versions.Strategy,
+		Header:    versions.Header,
+		Mount:     versions.Mount,
+		GenOpts:   opts,
+	}
+	for defName := range shared {
+		app.SharedModels = append(app.SharedModels, defName)
+	}
+	sort.Strings(app.SharedModels)
+
+	var titles []string
+	for i, version := range versions.Versions {
+		pkg := opts.languageOpts().ManglePackageName(version.Name, "version")
+		vopts := *opts
+		vopts.Spec = version.Spec
+		vopts.ServerPackage = path.Join(pkg, filepath.ToSlash(opts.ServerPackage))
+		vopts.ModelPackage = path.Join(pkg, filepath.ToSlash(opts.ModelPackage))
+		// a single main serves all the versions
+		vopts.IncludeMain = false
+		vopts.CompileCheck = false
+		vopts.goTypes = goTypes
+		vopts.generated, vopts.planned = nil, nil
+
+		apiName := name
+		if versions.Mount {
+			// the mounted apis are named after their spec, the name is the one of the server mounting them
+			apiName = ""
+		}
+		generator, err := newAppGenerator(apiName, nil, nil, &vopts)
+		if err != nil {
+			return fmt.Errorf("version %s: %v", version.Name, err)
+		}
+		if err := generator.Generate(); err != nil {
+			return fmt.Errorf("version %s: %v", version.Name, err)
+		}
+		opts.generated = append(opts.generated, vopts.generated...)
+		opts.planned = append(opts.planned, vopts.planned...)
+
+		genVersion := GenAPIVersion{
+			Name:       version.Name,
+			Package:    pkg,
+			Import:     vopts.packageImport(opts.Target, vopts.ServerPackage, "server"),
+			APIPackage: pkg + generator.APIPackage,
+			APIImport:  vopts.packageImport(opts.Target, vopts.ServerPackage, "server", generator.APIPackage),
+			APIName:    generator.Name,
+		}
+		app.Versions = append(app.Versions, genVersion)
+		if versions.Mount {
+			if info := docs[i].Spec().Info; info != nil && info.Title != "" {
+				titles = append(titles, info.Title)
+			}
+			continue
+		}
+		if version.Name == versions.Default {
+			app.Default = genVersion
+			app.Name = generator.Name
+			if info := docs[i].Spec().Info; info != nil {
+				app.Title, app.Description = info.Title, info.Description
+			}
+		}
+	}
+
+	if versions.Mount {
+		// the server of the first api listens, and serves all of them
+		app.Default = app.Versions[0]
+		app.Name = swag.ToGoName(name)
+		if app.Name == "" {
+			app.Name = "Gateway"
+		}
+		app.Title = swag.ToHumanNameLower(app.Name)
+		app.Description = "Serves the " + strings.Join(titles, ", ") + " APIs by their base path"
+	}
+
+	if opts.IncludeSupport {
+		if err := opts.renderVersions(&app); err != nil {
+			return err
+		}
+	}
+	return opts.recordGeneration(serverGeneration, nil, nil)
+}
+
+// check completes the version options with their defaults, and checks them
+func (v *VersionOpts) check(opts *GenOpts) error {
+	if len(v.Versions) == 0 {
+		return errors.New("a versioned server needs at least a version")
+	}
+	if opts.ExcludeSpec {
+		return errors.New("the versions of a versioned server are built from their embedded spec, it can't be excluded")
+	}
+	if opts.FlagStrategy != "" && opts.FlagStrategy != "go-flags" {
+		return fmt.Errorf("a versioned server only supports the go-flags flag strategy, not %s", opts.FlagStrategy)
+	}
+	switch v.Strategy {
+	case "":
+		v.Strategy = VersionByBasePath
+	case VersionByBasePath, VersionByHeader:
+		if v.Mount && v.Strategy != VersionByBasePath {
+			return fmt.Errorf("the mounted apis are selected by their base path, not by %s", v.Strategy)
+		}
+	default:
+		return fmt.Errorf("unknown version strategy %q, expected %s or %s", v.Strategy, VersionByBasePath, VersionByHeader)
+	}
+	if v.Header == "" {
+		v.Header = DefaultVersionHeader
+	}
+
+	packages := make(map[string]string, len(v.Versions))
+	for _, version := range v.Versions {
+		pkg := opts.languageOpts().ManglePackageName(version.Name, "version")
+		if pkg == "" || !unicode.IsLetter([]rune(pkg)[0]) {
+			return fmt.Errorf("the version %q doesn't make a package name: it must start with a letter, like v1", version.Name)
+		}
+		if top := strings.SplitN(filepath.ToSlash(opts.ModelPackage), "/", 2)[0]; pkg == top {
+			return fmt.Errorf("the version %q is generated in the directory %s, like the shared models", version.Name, pkg)
+		}
+		if top := strings.SplitN(filepath.ToSlash(opts.ServerPackage), "/", 2)[0]; pkg == top {
+			return fmt.Errorf("the version %q is generated in the directory %s, like the server package", version.Name, pkg)
+		}
+		if other, ok := packages[pkg]; ok {
+			return fmt.Errorf("the versions %q and %q are both generated in the package %s", other, version.Name, pkg)
+		}
+		packages[pkg] = version.Name
+	}
+	if v.Mount {
+		if v.Default != "" {
+			return errors.New("the mounted apis have no default: a request outside of their base paths is not found")
+		}
+		return nil
+	}
+	if v.Default == "" {
+		v.Default = v.Versions[len(v.Versions)-1].Name
+	}
+	for _, version := range v.Versions {
+		if version.Name == v.Default {
+			return nil
+		}
+	}
+	return fmt.Errorf("the default version %q isn't one of the versions", v.Default)
+}
+
+// checkBasePaths makes sure that each version can be selected by its base path
+func checkBasePaths(versions []APIVersion, docs []*loads.Document) error {
+	seen := make(map[string]string, len(versions))
+	for i, version := range versions {
+		base := strings.TrimSuffix(docs[i].BasePath(), "/")
+		if base == "" {
+			return fmt.Errorf("the version %s has no base path: it can't be selected by the path of the requests, use the header strategy", version.Name)
+		}
+		if other, ok := seen[base]; ok {
+			return fmt.Errorf("the versions %s and %s have the same base path %s: they can't be selected by the path of the requests, use the header strategy", other, version.Name, base)
+		}
+		seen[base] = version.Name
+	}
+	return nil
+}
+
+// sharedDefinitions finds the definitions which are the same in all the versions defining them, at least two.
+//
+// A definition is only shared when the definitions it refers to are shared too. The definitions
+// with a discriminator, an x-go-name or an x-go-type stay in each version.
+func sharedDefinitions(docs []*loads.Document) map[string]spec.Schema {
+	candidates := make(map[string]spec.Schema)
+	refs := make(map[string][]string)
+	rejected := make(map[string]bool)
+	count := make(map[string]int)
+	for _, doc := range docs {
+		for name, schema := range doc.Spec().Definitions {
+			if rejected[name] {
+				continue
+			}
+			count[name]++
+			b, err := json.Marshal(schema)
+			if err != nil {
+				rejected[name] = true
+				continue
+			}
+			if previous, ok := candidates[name]; ok {
+				pb, _ := json.Marshal(previous)
+				if string(pb) != string(b) {
+					rejected[name] = true
+				}
+				continue
+			}
+			_, goName := schema.Extensions["x-go-name"]
+			_, goType := schema.Extensions["x-go-type"]
+			if schema.Discriminator != "" || goName || goType {
+				rejected[name] = true
+				continue
+			}
+			candidates[name] = schema
+			refs[name] = localRefs(b)
+		}
+	}
+	for name := range candidates {
+		if count[name] < 2 {
+			rejected[name] = true
+		}
+	}
+
+	// a definition referring to a definition which isn't shared isn't either
+	for changed := true; changed; {
+		changed = false
+		for name := range candidates {
+			if rejected[name] {
+				continue
+			}
+			for _, ref := range refs[name] {
+				if _, ok := candidates[ref]; !ok || rejected[ref] {
+					rejected[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	shared := make(map[string]spec.Schema)
+	for name, schema := range candidates {
+		if !rejected[name] {
+			shared[name] = schema
+		}
+	}
+	return shared
+}
+
+// localRefs lists the definitions a schema refers to, an empty name standing for a reference to another document
+func localRefs(schema []byte) []string {
+	var doc interface{}
+	if err := json.Unmarshal(schema, &doc); err != nil {
+		return []string{""}
+	}
+	var refs []string
+	var walk func(interface{})
+	walk = func(node interface{}) {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for k, v := range n {
+				if ref, ok := v.(string); ok && k == "$ref" {
+					if !strings.HasPrefix(ref, "#/definitions/") || strings.Count(ref, "/") != 2 {
+						refs = append(refs, "")
+						continue
+					}
+					refs = append(refs, jsonpointer.Unescape(strings.TrimPrefix(ref, "#/definitions/")))
+					continue
+				}
+				walk(v)
+			}
+		case []interface{}:
+			for _, v := range n {
+				walk(v)
+			}
+		}
+	}
+	walk(doc)
+	return refs
+}
+
+// generateSharedModels generates the definitions shared by the versions, from a spec holding them only
+func (g *GenOpts) generateSharedModels(name string, shared map[string]spec.Schema) error {
+	dir, err := ioutil.TempDir("", "swagger-shared-models")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	sw := spec.Swagger{SwaggerProps: spec.SwaggerProps{
+		Swagger:     "2.0",
+		Info:        &spec.Info{InfoProps: spec.InfoProps{Title: name, Version: "shared"}},
+		Paths:       &spec.Paths{Paths: map[string]spec.PathItem{}},
+		Definitions: spec.Definitions(shared),
+	}}
+	b, err := json.MarshalIndent(sw, "", "  ")
+	if err != nil {
+		return err
+	}
+	specPath := filepath.Join(dir, "swagger.json")
+	if err := ioutil.WriteFile(specPath, b, 0644); err != nil {
+		return err
+	}
+
+	sopts := *g
+	sopts.Spec = specPath
+	// the definitions come from the specs of the versions, which are validated
+	sopts.ValidateSpec = false
+	sopts.IncludeHandler, sopts.IncludeParameters, sopts.IncludeResponses, sopts.IncludeURLBuilder = false, false, false, false
+	sopts.IncludeSupport, sopts.IncludeMain, sopts.IncludeTests = false, false, false
+	sopts.CompileCheck = false
+	sopts.generated, sopts.planned = nil, nil
+
+	generator, err := newAppGenerator(name, nil, nil, &sopts)
+	if err != nil {
+		return fmt.Errorf("shared models: %v", err)
+	}
+	if err := generator.Generate(); err != nil {
+		return fmt.Errorf("shared models: %v", err)
+	}
+	g.generated = append(g.generated, sopts.generated...)
+	g.planned = append(g.planned, sopts.planned...)
+	return nil
+}
+
+func (g *GenOpts) renderVersions(app *GenVersionedApp) error {
+	for _, templ := range g.Sections.Versions {
+		if templ.Name == "versions_main" && !g.IncludeMain {
+			continue
+		}
+		if err := g.write(&templ, app); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testVersions = []APIVersion{
+	{Name: "v1", Spec: "../fixtures/codegen/versions-v1.yml"},
+	{Name: "v2", Spec: "../fixtures/codegen/versions-v2.yml"},
+}
+
+func TestSharedDefinitions(t *testing.T) {
+	var docs []*loads.Document
+	for _, version := range testVersions {
+		doc, err := loads.Spec(version.Spec)
+		require.NoError(t, err)
+		docs = append(docs, doc)
+	}
+
+	shared := sharedDefinitions(docs)
+	// Pet changed in v2, Owner is only in v2
+	assert.Len(t, shared, 1)
+	assert.Contains(t, shared, "Error")
+
+	// a definition referring to one which isn't shared isn't either
+	docs[0].Spec().Definitions["Owner"] = docs[1].Spec().Definitions["Owner"]
+	docs[0].Spec().Definitions["Pet"] = docs[1].Spec().Definitions["Pet"]
+	shared = sharedDefinitions(docs)
+	assert.Len(t, shared, 3)
+	docs[0].Spec().Definitions["Owner"] = docs[0].Spec().Definitions["Error"]
+	shared = sharedDefinitions(docs)
+	assert.Len(t, shared, 1)
+	assert.NotContains(t, shared, "Pet")
+}
+
+func TestVersionOpts_Check(t *testing.T) {
+	opts := testGenOpts()
+	opts.ExcludeSpec = false
+	versions := VersionOpts{Versions: testVersions}
+	require.NoError(t, versions.check(&opts))
+	assert.Equal(t, VersionByBasePath, versions.Strategy)
+	assert.Equal(t, DefaultVersionHeader, versions.Header)
+	assert.Equal(t, "v2", versions.Default)
+
+	for _, invalid := range []VersionOpts{
+		{},
+		{Versions: testVersions, Strategy: "query"},
+		{Versions: testVersions, Default: "v3"},
+		{Versions: []APIVersion{{Name: "1.0"}}},
+		{Versions: []APIVersion{{Name: "v1"}, {Name: "V1"}}},
+		{Versions: []APIVersion{{Name: "models"}}},
+		// the mounted apis are selected by their base path, and have no default
+		{Versions: testVersions, Mount: true, Strategy: VersionByHeader},
+		{Versions: testVersions, Mount: true, Default: "v1"},
+	} {
+		opts := testGenOpts()
+		opts.ExcludeSpec = false
+		assert.Error(t, invalid.check(&opts), "%v", invalid.Versions)
+	}
+
+	mounts := VersionOpts{Versions: testVersions, Mount: true}
+	require.NoError(t, mounts.check(&opts))
+	assert.Empty(t, mounts.Default)
+
+	opts = testGenOpts()
+	opts.ExcludeSpec = true
+	assert.Error(t, (&VersionOpts{Versions: testVersions}).check(&opts))
+}
+
+func TestGenerateVersionedServer(t *testing.T) {
+	target, err := ioutil.TempDir(".", "versions")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+
+	opts := manifestGenOpts(target, "")
+	opts.IncludeMain = true
+	opts.ExcludeSpec = false
+	require.NoError(t, GenerateVersionedServer("petstore", VersionOpts{Versions: testVersions}, opts))
+
+	for _, file := range []string{
+		"models/error.go",
+		"v1/models/pet.go",
+		"v2/models/pet.go",
+		"v2/models/owner.go",
+		"v1/restapi/server.go",
+		"v2/restapi/operations/pets/list_pets.go",
+		"restapi/versions.go",
+		"restapi/configure_versions.go",
+		"cmd/petstore-server/main.go",
+	} {
+		_, err := os.Stat(filepath.Join(target, filepath.FromSlash(file)))
+		assert.NoError(t, err, file)
+	}
+	for _, file := range []string{"v1/models/error.go", "v2/models/error.go"} {
+		_, err := os.Stat(filepath.Join(target, filepath.FromSlash(file)))
+		assert.True(t, os.IsNotExist(err), "the shared definitions aren't generated in %s", file)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(target, "v1", "restapi", "operations", "pets", "get_pet_responses.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `sharedmodels "`)
+	assert.Contains(t, string(b), "*sharedmodels.Error")
+	assert.Contains(t, string(b), "/"+filepath.Base(target)+`/v1/models"`)
+
+	b, err = ioutil.ReadFile(filepath.Join(target, "restapi", "versions.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `const DefaultVersion = "v2"`)
+	assert.Contains(t, string(b), "var DefaultVersionSelector = VersionSelector(ByBasePath)")
+
+	b, err = ioutil.ReadFile(filepath.Join(target, "cmd", "petstore-server", "main.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "v1operations.NewPetstoreAPI(v1Spec)")
+	assert.Contains(t, string(b), "restapi.SetupVersionsMiddleware(restapi.NewVersionHandler(restapi.DefaultVersionSelector, restapi.DefaultVersion,")
+
+	// the header strategy selects the versions of specs with the same base path
+	opts = manifestGenOpts(target, "")
+	opts.ExcludeSpec = false
+	versions := VersionOpts{Versions: testVersions, Strategy: VersionByHeader, Header: "Accept-Version", Default: "v1"}
+	require.NoError(t, GenerateVersionedServer("petstore", versions, opts))
+	b, err = ioutil.ReadFile(filepath.Join(target, "restapi", "versions.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `const DefaultVersion = "v1"`)
+	assert.Contains(t, string(b), `var DefaultVersionSelector = ByHeader("Accept-Version")`)
+}